@@ -0,0 +1,67 @@
+// Code generated by hertz generator.
+
+package audit
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+
+	audit "github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/audit"
+	auditservice "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/audit"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+)
+
+// 全局审计日志服务实例（通过Wire注入）
+var auditService auditservice.Service
+
+// SetAuditService 设置审计日志服务实例（由Wire在启动时调用）
+func SetAuditService(service auditservice.Service) {
+	auditService = service
+}
+
+// ListAuditEvents .
+// @Summary 查询审计事件
+// @Description 分页查询变更类操作的审计事件，按发生时间倒序排列
+// @Tags 审计日志
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param page query int false "页码" default(1)
+// @Param limit query int false "每页数量" default(20)
+// @Param actorID query string false "操作者用户ID"
+// @Param action query string false "操作名称，如 AssignRoleToUser"
+// @Param targetType query string false "操作对象类型，如 user、role、menu"
+// @Param targetID query string false "操作对象ID"
+// @Param result query string false "执行结果：success 或 failure"
+// @Param requestID query string false "请求ID"
+// @Param startTime query int false "起始时间（含，毫秒时间戳）"
+// @Param endTime query int false "截止时间（不含，毫秒时间戳）"
+// @Success 200 {object} audit.ListAuditEventsResponseDTO "成功"
+// @Failure 400 {object} errors.Error "请求参数错误"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 403 {object} errors.Error "权限不足"
+// @Failure 500 {object} errors.Error "内部错误"
+// @router /api/v1/audit/events [GET]
+func ListAuditEvents(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req audit.ListAuditEventsRequestDTO
+
+	// 绑定查询参数
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := auditService.ListAuditEvents(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "查询审计事件失败")
+		return
+	}
+
+	// 返回响应（自动填充追踪字段）
+	errors.JSON(c, consts.StatusOK, resp)
+}
@@ -37,7 +37,7 @@ func SetIdentityService(service identityservice.Service, middleware jwtMw.JWTMid
 
 // Login
 // @Summary 用户登录
-// @Description 验证用户凭据并返回访问令牌和用户信息
+// @Description 验证用户凭据（登录账号可为用户名、邮箱或手机号）并返回访问令牌和用户信息
 // @Tags 认证管理
 // @Accept json
 // @Produce json
@@ -195,6 +195,193 @@ func RefreshToken(ctx context.Context, c *app.RequestContext) {
 	jwtMiddlewareInstance.RefreshHandler(ctx, c)
 }
 
+// VerifyMFA
+// @Summary 多因素认证校验
+// @Description 登录第二步：校验挑战令牌与验证码，通过后返回访问令牌和用户信息
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Param req body identity.MFAVerifyRequestDTO true "请求体"
+// @Success 200 {object} identity.LoginResponseDTO "成功"
+// @Failure 400 {object} errors.Error "请求参数错误"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/identity/auth/mfa/verify [POST]
+func VerifyMFA(ctx context.Context, c *app.RequestContext) {
+	// 使用全局JWT中间件实例的多因素认证处理器
+	jwtMiddlewareInstance.MFAVerifyHandler(ctx, c)
+}
+
+// SwitchOrganization
+// @Summary 切换组织
+// @Description 切换当前代表的组织，按用户在该组织的角色返回菜单与权限并签发新的访问令牌
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param req body identity.SwitchOrganizationRequestDTO true "请求体"
+// @Success 200 {object} identity.LoginResponseDTO "成功"
+// @Failure 400 {object} errors.Error "请求参数错误"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 404 {object} errors.Error "不是该组织的成员"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/identity/auth/switch-organization [POST]
+func SwitchOrganization(ctx context.Context, c *app.RequestContext) {
+	// 使用全局JWT中间件实例的切换组织处理器
+	jwtMiddlewareInstance.SwitchOrganizationHandler(ctx, c)
+}
+
+// EnrollMFA
+// @Summary 开始绑定多因素认证
+// @Description 为当前用户生成 TOTP 密钥，确认前不生效
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} identity.MFAEnrollResponseDTO "成功"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/identity/auth/mfa/enroll [POST]
+func EnrollMFA(ctx context.Context, c *app.RequestContext) {
+	var err error
+
+	// 获取当前用户ID
+	userID, authErr := auth_context.GetCurrentUserProfileID(c)
+	if !authErr {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.EnrollMFA(ctx, userID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "生成多因素认证密钥失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// ConfirmMFAEnrollment
+// @Summary 确认绑定多因素认证
+// @Description 提交认证器生成的验证码，成功后启用多因素认证并返回恢复码；因角色要求而绑定的用户需重新登录
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param req body identity.MFACodeRequestDTO true "请求体"
+// @Success 200 {object} identity.MFARecoveryCodesResponseDTO "成功"
+// @Failure 400 {object} errors.Error "请求参数错误"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/identity/auth/mfa/enroll/confirm [POST]
+func ConfirmMFAEnrollment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.MFACodeRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		// 参数绑定和验证错误，使用统一错误处理
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 获取当前用户ID
+	userID, authErr := auth_context.GetCurrentUserProfileID(c)
+	if !authErr {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.ConfirmMFAEnrollment(ctx, &req, userID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "确认绑定多因素认证失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// RegenerateMFARecoveryCodes
+// @Summary 重新生成恢复码
+// @Description 提交 TOTP 验证码后生成新的恢复码，原有恢复码全部失效
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param req body identity.MFACodeRequestDTO true "请求体"
+// @Success 200 {object} identity.MFARecoveryCodesResponseDTO "成功"
+// @Failure 400 {object} errors.Error "请求参数错误"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/identity/auth/mfa/recovery-codes [POST]
+func RegenerateMFARecoveryCodes(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.MFACodeRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		// 参数绑定和验证错误，使用统一错误处理
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 获取当前用户ID
+	userID, authErr := auth_context.GetCurrentUserProfileID(c)
+	if !authErr {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.RegenerateMFARecoveryCodes(ctx, &req, userID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "重新生成恢复码失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// DisableMFA
+// @Summary 关闭多因素认证
+// @Description 提交 TOTP 验证码或恢复码后关闭当前用户的多因素认证
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param req body identity.MFACodeRequestDTO true "请求体"
+// @Success 200 {object} http_base.OperationStatusResponseDTO "成功"
+// @Failure 400 {object} errors.Error "请求参数错误"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/identity/auth/mfa/disable [POST]
+func DisableMFA(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.MFACodeRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		// 参数绑定和验证错误，使用统一错误处理
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 获取当前用户ID
+	userID, authErr := auth_context.GetCurrentUserProfileID(c)
+	if !authErr {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.DisableMFA(ctx, &req, userID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "关闭多因素认证失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
 // CreateUser
 // @Summary 创建用户
 // @Description 管理员创建新用户账户
@@ -421,7 +608,7 @@ func DeleteUser(ctx context.Context, c *app.RequestContext) {
 
 // ListUsers
 // @Summary 获取用户列表
-// @Description 分页查询用户列表，支持按组织、状态等条件筛选
+// @Description 分页查询用户列表，支持按组织、状态、账户即将过期等条件筛选
 // @Tags 用户管理
 // @Accept json
 // @Produce json
@@ -435,6 +622,7 @@ func DeleteUser(ctx context.Context, c *app.RequestContext) {
 // @Param include_total query bool false "是否返回总数" default(false)
 // @Param organization_id query string false "按组织ID筛选"
 // @Param status query int false "按用户状态筛选"
+// @Param account_expires_before query int false "仅返回在该时间（毫秒时间戳）之前到期、尚未过期的账户"
 // @Param fetch_all query bool false "是否获取所有数据（不分页）" default(false)
 // @Success 200 {object} identity.ListUsersResponseDTO "成功"
 // @Failure 400 {object} errors.Error "请求参数错误"
@@ -1188,3 +1376,214 @@ func BindLogoToOrganization(ctx context.Context, c *app.RequestContext) {
 
 	errors.JSON(c, consts.StatusOK, resp)
 }
+
+// ListMySessions
+// @Summary 获取当前用户的登录会话
+// @Description 列出当前用户所有有效的登录会话（设备、IP、登录时间、最近活跃时间）
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} identity.ListSessionsResponseDTO "成功"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/identity/auth/sessions [GET]
+func ListMySessions(ctx context.Context, c *app.RequestContext) {
+	var err error
+
+	// 获取当前用户ID
+	userID, authErr := auth_context.GetCurrentUserProfileID(c)
+	if !authErr {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	sessionID, _ := auth_context.GetCurrentSessionID(c)
+
+	// 调用业务服务层
+	resp, err := identityService.ListSessions(ctx, userID, sessionID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "获取登录会话失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// RevokeMySession
+// @Summary 吊销当前用户的指定会话
+// @Description 使指定会话的访问令牌与刷新令牌立即失效
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param sessionID path string true "会话ID"
+// @Success 200 {object} http_base.OperationStatusResponseDTO "成功"
+// @Failure 400 {object} errors.Error "请求参数错误"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 404 {object} errors.Error "会话不存在"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/identity/auth/sessions/{sessionID} [DELETE]
+func RevokeMySession(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.RevokeMySessionRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		// 参数绑定和验证错误，使用统一错误处理
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 获取当前用户ID
+	userID, authErr := auth_context.GetCurrentUserProfileID(c)
+	if !authErr {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.RevokeSession(ctx, userID, req.GetSessionID())
+	if err != nil {
+		errors.HandleServiceError(c, err, "吊销登录会话失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// RevokeMyOtherSessions
+// @Summary 吊销当前用户的其他会话
+// @Description 保留当前会话，使其他设备上的登录全部失效
+// @Tags 认证管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} http_base.OperationStatusResponseDTO "成功"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/identity/auth/sessions/revoke-others [POST]
+func RevokeMyOtherSessions(ctx context.Context, c *app.RequestContext) {
+	var err error
+
+	// 获取当前用户ID
+	userID, authErr := auth_context.GetCurrentUserProfileID(c)
+	if !authErr {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	sessionID, _ := auth_context.GetCurrentSessionID(c)
+
+	// 调用业务服务层
+	resp, err := identityService.RevokeOtherSessions(ctx, userID, sessionID)
+	if err != nil {
+		errors.HandleServiceError(c, err, "吊销其他登录会话失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// ListUserSessions
+// @Summary 获取用户登录会话
+// @Description 管理员查看指定用户所有有效的登录会话
+// @Tags 用户管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param userID path string true "用户ID"
+// @Success 200 {object} identity.ListSessionsResponseDTO "成功"
+// @Failure 400 {object} errors.Error "请求参数错误"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 403 {object} errors.Error "权限不足"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/identity/users/{userID}/sessions [GET]
+func ListUserSessions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.ListUserSessionsRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		// 参数绑定和验证错误，使用统一错误处理
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.ListSessions(ctx, req.GetUserID(), "")
+	if err != nil {
+		errors.HandleServiceError(c, err, "获取用户登录会话失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// RevokeUserSession
+// @Summary 吊销用户指定会话
+// @Description 管理员强制下线指定用户的单个会话
+// @Tags 用户管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param userID path string true "用户ID"
+// @Param sessionID path string true "会话ID"
+// @Success 200 {object} http_base.OperationStatusResponseDTO "成功"
+// @Failure 400 {object} errors.Error "请求参数错误"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 403 {object} errors.Error "权限不足"
+// @Failure 404 {object} errors.Error "会话不存在"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/identity/users/{userID}/sessions/{sessionID} [DELETE]
+func RevokeUserSession(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.RevokeUserSessionRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		// 参数绑定和验证错误，使用统一错误处理
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.RevokeSession(ctx, req.GetUserID(), req.GetSessionID())
+	if err != nil {
+		errors.HandleServiceError(c, err, "吊销用户登录会话失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// RevokeUserSessions
+// @Summary 吊销用户全部会话
+// @Description 管理员强制下线指定用户的所有会话
+// @Tags 用户管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param userID path string true "用户ID"
+// @Success 200 {object} http_base.OperationStatusResponseDTO "成功"
+// @Failure 400 {object} errors.Error "请求参数错误"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 403 {object} errors.Error "权限不足"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/identity/users/{userID}/sessions [DELETE]
+func RevokeUserSessions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req identity.RevokeUserSessionsRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		// 参数绑定和验证错误，使用统一错误处理
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := identityService.RevokeAllSessions(ctx, req.GetUserID())
+	if err != nil {
+		errors.HandleServiceError(c, err, "吊销用户全部登录会话失败")
+		return
+	}
+
+	errors.JSON(c, consts.StatusOK, resp)
+}
@@ -258,6 +258,7 @@ func GetLastUserRoleAssignment(ctx context.Context, c *app.RequestContext) {
 // @Param include_total query bool false "是否返回总数" default(false)
 // @Param user_id query string false "用户ID"
 // @Param role_id query string false "角色ID"
+// @Param expiringBefore query int false "仅返回在该时间之前过期的分配（毫秒时间戳）"
 // @Success 200 {object} permission.UserRoleListResponseDTO "成功"
 // @Failure 400 {object} errors.Error "请求参数错误"
 // @Failure 401 {object} errors.Error "认证失败"
@@ -461,15 +462,18 @@ func HasMenuPermission(ctx context.Context, c *app.RequestContext) {
 
 // UploadMenu .
 // @Summary 上传菜单配置文件
-// @Description 上传YAML格式的菜单配置文件，用于更新系统菜单
+// @Description 上传YAML格式的菜单配置文件，用于更新系统菜单。上传前校验菜单配置，dry_run 时只返回校验结果、版本变更与角色权限影响而不保存；会导致角色失去菜单权限的上传需指定 force
 // @Tags 菜单权限管理
 // @Accept multipart/form-data
 // @Produce json
 // @Security ApiKeyAuth
 // @Param menu_file formData file true "YAML格式的菜单配置文件"
-// @Success 200 {object} permission.GetMenuTreeResponseDTO "菜单上传成功"
-// @Failure 400 {object} errors.Error "请求参数错误"
+// @Param dry_run formData bool false "仅预检查，不保存"
+// @Param force formData bool false "强制上传，即使角色会失去菜单权限"
+// @Success 200 {object} permission.UploadMenuResponseDTO "菜单上传成功或预检查完成"
+// @Failure 400 {object} errors.Error "请求参数错误或菜单配置校验未通过"
 // @Failure 401 {object} errors.Error "认证失败"
+// @Failure 409 {object} errors.Error "上传将导致角色失去菜单权限"
 // @Failure 500 {object} errors.Error "内部错误"
 // @Router /api/v1/permission/menu/upload [POST]
 func UploadMenu(ctx context.Context, c *app.RequestContext) {
@@ -502,10 +506,42 @@ func UploadMenu(ctx context.Context, c *app.RequestContext) {
 	}
 	req.MenuFile = fileContent
 
+	// 获取当前用户ID
+	userID, authErr := auth_context.GetCurrentUserProfileID(c)
+	if !authErr {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
 	// 调用业务服务层
-	resp, err := permissionService.UploadMenu(ctx, &req)
+	resp, err := permissionService.UploadMenu(ctx, userID, &req)
 	if err != nil {
-		errors.HandleServiceError(c, err, "列出用户角色分配失败")
+		errors.HandleServiceError(c, err, "上传菜单配置失败")
+		return
+	}
+
+	// 返回响应（自动填充追踪字段）
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// GetPermissionCatalog .
+// @Summary 获取权限目录
+// @Description 获取网关路由可声明的全部接口权限（resource:action）及其分组与描述，角色定义中的权限必须来自该目录
+// @Tags 权限管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} permission.GetPermissionCatalogResponseDTO "成功"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/permission/catalog [GET]
+func GetPermissionCatalog(ctx context.Context, c *app.RequestContext) {
+	var err error
+
+	// 调用业务服务层
+	resp, err := permissionService.GetPermissionCatalog(ctx)
+	if err != nil {
+		errors.HandleServiceError(c, err, "获取权限目录失败")
 		return
 	}
 
@@ -614,3 +650,125 @@ func BatchBindUsersToRole(ctx context.Context, c *app.RequestContext) {
 	// 返回响应（自动填充追踪字段）
 	errors.JSON(c, consts.StatusOK, resp)
 }
+
+// ListMenuVersions .
+// @Summary 列出菜单版本
+// @Description 列出全部菜单版本及其上传者、上传时间和生效状态，按上传时间倒序排列
+// @Tags 菜单权限管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} permission.ListMenuVersionsResponseDTO "成功"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/permission/menu/versions [GET]
+func ListMenuVersions(ctx context.Context, c *app.RequestContext) {
+	// 调用业务服务层
+	resp, err := permissionService.ListMenuVersions(ctx)
+	if err != nil {
+		errors.HandleServiceError(c, err, "列出菜单版本失败")
+		return
+	}
+
+	// 返回响应（自动填充追踪字段）
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// DiffMenuVersions .
+// @Summary 比较菜单版本
+// @Description 按语义ID比较两个菜单版本，返回新增、删除、移动和重命名的菜单；未指定目标版本时与生效版本比较
+// @Tags 菜单权限管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param from query string true "基准版本"
+// @Param to query string false "目标版本，默认为生效版本"
+// @Success 200 {object} permission.DiffMenuVersionsResponseDTO "成功"
+// @Failure 400 {object} errors.Error "请求参数错误"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 404 {object} errors.Error "菜单版本不存在"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/permission/menu/versions/diff [GET]
+func DiffMenuVersions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req permission.DiffMenuVersionsRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := permissionService.DiffMenuVersions(ctx, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "比较菜单版本失败")
+		return
+	}
+
+	// 返回响应（自动填充追踪字段）
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// ActivateMenuVersion .
+// @Summary 激活菜单版本
+// @Description 将指定的历史菜单版本设为生效版本，并返回指向该版本中不存在菜单的角色菜单映射
+// @Tags 菜单权限管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param version path string true "菜单版本"
+// @Success 200 {object} permission.ActivateMenuVersionResponseDTO "成功"
+// @Failure 400 {object} errors.Error "请求参数错误"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 404 {object} errors.Error "菜单版本不存在"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/permission/menu/versions/{version}/activate [POST]
+func ActivateMenuVersion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req permission.ActivateMenuVersionRequestDTO
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	// 获取当前用户ID
+	userID, authErr := auth_context.GetCurrentUserProfileID(c)
+	if !authErr {
+		errors.AbortWithError(c, errors.ErrJWTValidationFail)
+		return
+	}
+
+	// 调用业务服务层
+	resp, err := permissionService.ActivateMenuVersion(ctx, userID, &req)
+	if err != nil {
+		errors.HandleServiceError(c, err, "激活菜单版本失败")
+		return
+	}
+
+	// 返回响应（自动填充追踪字段）
+	errors.JSON(c, consts.StatusOK, resp)
+}
+
+// GetOrphanRoleMenuMappings .
+// @Summary 查询孤立的角色菜单映射
+// @Description 列出指向生效菜单版本中不存在菜单的角色菜单映射，用于菜单更新后的权限清理
+// @Tags 菜单权限管理
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} permission.GetOrphanRoleMenuMappingsResponseDTO "成功"
+// @Failure 401 {object} errors.Error "认证失败"
+// @Failure 500 {object} errors.Error "内部错误"
+// @Router /api/v1/permission/menu/orphan-mappings [GET]
+func GetOrphanRoleMenuMappings(ctx context.Context, c *app.RequestContext) {
+	// 调用业务服务层
+	resp, err := permissionService.GetOrphanRoleMenuMappings(ctx)
+	if err != nil {
+		errors.HandleServiceError(c, err, "查询孤立的角色菜单映射失败")
+		return
+	}
+
+	// 返回响应（自动填充追踪字段）
+	errors.JSON(c, consts.StatusOK, resp)
+}
@@ -0,0 +1,1950 @@
+// Code generated by thriftgo (0.4.3). DO NOT EDIT.
+
+package audit
+
+import (
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/core"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/http_base"
+)
+
+/** 审计事件DTO */
+type AuditEventDTO struct {
+	/** 审计事件ID */
+	ID *string `thrift:"id,1,optional" json:"id" form:"id" query:"id"`
+	/** 操作者用户ID，未认证的操作（如登录）为空 */
+	ActorID *string `thrift:"actorID,2,optional" json:"actor_id,omitempty" form:"actorID" query:"actorID"`
+	/** 操作者当前代表的组织ID */
+	ActorOrganizationID *string `thrift:"actorOrganizationID,3,optional" json:"actor_organization_id,omitempty" form:"actorOrganizationID" query:"actorOrganizationID"`
+	/** 操作名称，如 AssignRoleToUser */
+	Action *string `thrift:"action,4,optional" json:"action" form:"action" query:"action"`
+	/** 操作对象类型，如 user、role、menu */
+	TargetType *string `thrift:"targetType,5,optional" json:"target_type" form:"targetType" query:"targetType"`
+	/** 操作对象ID（登录类操作为用户名） */
+	TargetID *string `thrift:"targetID,6,optional" json:"target_id,omitempty" form:"targetID" query:"targetID"`
+	/** 变更前内容（JSON），敏感字段已脱敏 */
+	Before *string `thrift:"before,7,optional" json:"before,omitempty" form:"before" query:"before"`
+	/** 变更后内容（JSON），敏感字段已脱敏 */
+	After *string `thrift:"after,8,optional" json:"after,omitempty" form:"after" query:"after"`
+	/** 发生变化的顶层字段 */
+	ChangedFields []string `thrift:"changedFields,9,optional,list<string>" json:"changed_fields,omitempty" form:"changedFields" query:"changedFields"`
+	/** 请求ID */
+	RequestID *string `thrift:"requestID,10,optional" json:"request_id,omitempty" form:"requestID" query:"requestID"`
+	/** 链路追踪ID */
+	TraceID *string `thrift:"traceID,11,optional" json:"trace_id,omitempty" form:"traceID" query:"traceID"`
+	/** 客户端IP地址 */
+	IpAddress *string `thrift:"ipAddress,12,optional" json:"ip_address,omitempty" form:"ipAddress" query:"ipAddress"`
+	/** 执行结果：success 或 failure */
+	Result *string `thrift:"result,13,optional" json:"result" form:"result" query:"result"`
+	/** 失败时的业务错误码 */
+	ErrorCode *int32 `thrift:"errorCode,14,optional" json:"error_code,omitempty" form:"errorCode" query:"errorCode"`
+	/** 失败时的错误信息 */
+	ErrorMessage *string `thrift:"errorMessage,15,optional" json:"error_message,omitempty" form:"errorMessage" query:"errorMessage"`
+	/** 发生时间 */
+	CreatedAt *core.TimestampMS `thrift:"createdAt,16,optional" json:"created_at" form:"createdAt" query:"createdAt"`
+}
+
+func NewAuditEventDTO() *AuditEventDTO {
+	return &AuditEventDTO{}
+}
+
+func (p *AuditEventDTO) InitDefault() {
+}
+
+var AuditEventDTO_ID_DEFAULT string
+
+func (p *AuditEventDTO) GetID() (v string) {
+	if !p.IsSetID() {
+		return AuditEventDTO_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var AuditEventDTO_ActorID_DEFAULT string
+
+func (p *AuditEventDTO) GetActorID() (v string) {
+	if !p.IsSetActorID() {
+		return AuditEventDTO_ActorID_DEFAULT
+	}
+	return *p.ActorID
+}
+
+var AuditEventDTO_ActorOrganizationID_DEFAULT string
+
+func (p *AuditEventDTO) GetActorOrganizationID() (v string) {
+	if !p.IsSetActorOrganizationID() {
+		return AuditEventDTO_ActorOrganizationID_DEFAULT
+	}
+	return *p.ActorOrganizationID
+}
+
+var AuditEventDTO_Action_DEFAULT string
+
+func (p *AuditEventDTO) GetAction() (v string) {
+	if !p.IsSetAction() {
+		return AuditEventDTO_Action_DEFAULT
+	}
+	return *p.Action
+}
+
+var AuditEventDTO_TargetType_DEFAULT string
+
+func (p *AuditEventDTO) GetTargetType() (v string) {
+	if !p.IsSetTargetType() {
+		return AuditEventDTO_TargetType_DEFAULT
+	}
+	return *p.TargetType
+}
+
+var AuditEventDTO_TargetID_DEFAULT string
+
+func (p *AuditEventDTO) GetTargetID() (v string) {
+	if !p.IsSetTargetID() {
+		return AuditEventDTO_TargetID_DEFAULT
+	}
+	return *p.TargetID
+}
+
+var AuditEventDTO_Before_DEFAULT string
+
+func (p *AuditEventDTO) GetBefore() (v string) {
+	if !p.IsSetBefore() {
+		return AuditEventDTO_Before_DEFAULT
+	}
+	return *p.Before
+}
+
+var AuditEventDTO_After_DEFAULT string
+
+func (p *AuditEventDTO) GetAfter() (v string) {
+	if !p.IsSetAfter() {
+		return AuditEventDTO_After_DEFAULT
+	}
+	return *p.After
+}
+
+var AuditEventDTO_ChangedFields_DEFAULT []string
+
+func (p *AuditEventDTO) GetChangedFields() (v []string) {
+	if !p.IsSetChangedFields() {
+		return AuditEventDTO_ChangedFields_DEFAULT
+	}
+	return p.ChangedFields
+}
+
+var AuditEventDTO_RequestID_DEFAULT string
+
+func (p *AuditEventDTO) GetRequestID() (v string) {
+	if !p.IsSetRequestID() {
+		return AuditEventDTO_RequestID_DEFAULT
+	}
+	return *p.RequestID
+}
+
+var AuditEventDTO_TraceID_DEFAULT string
+
+func (p *AuditEventDTO) GetTraceID() (v string) {
+	if !p.IsSetTraceID() {
+		return AuditEventDTO_TraceID_DEFAULT
+	}
+	return *p.TraceID
+}
+
+var AuditEventDTO_IpAddress_DEFAULT string
+
+func (p *AuditEventDTO) GetIpAddress() (v string) {
+	if !p.IsSetIpAddress() {
+		return AuditEventDTO_IpAddress_DEFAULT
+	}
+	return *p.IpAddress
+}
+
+var AuditEventDTO_Result_DEFAULT string
+
+func (p *AuditEventDTO) GetResult() (v string) {
+	if !p.IsSetResult() {
+		return AuditEventDTO_Result_DEFAULT
+	}
+	return *p.Result
+}
+
+var AuditEventDTO_ErrorCode_DEFAULT int32
+
+func (p *AuditEventDTO) GetErrorCode() (v int32) {
+	if !p.IsSetErrorCode() {
+		return AuditEventDTO_ErrorCode_DEFAULT
+	}
+	return *p.ErrorCode
+}
+
+var AuditEventDTO_ErrorMessage_DEFAULT string
+
+func (p *AuditEventDTO) GetErrorMessage() (v string) {
+	if !p.IsSetErrorMessage() {
+		return AuditEventDTO_ErrorMessage_DEFAULT
+	}
+	return *p.ErrorMessage
+}
+
+var AuditEventDTO_CreatedAt_DEFAULT core.TimestampMS
+
+func (p *AuditEventDTO) GetCreatedAt() (v core.TimestampMS) {
+	if !p.IsSetCreatedAt() {
+		return AuditEventDTO_CreatedAt_DEFAULT
+	}
+	return *p.CreatedAt
+}
+
+var fieldIDToName_AuditEventDTO = map[int16]string{
+	1:  "id",
+	2:  "actorID",
+	3:  "actorOrganizationID",
+	4:  "action",
+	5:  "targetType",
+	6:  "targetID",
+	7:  "before",
+	8:  "after",
+	9:  "changedFields",
+	10: "requestID",
+	11: "traceID",
+	12: "ipAddress",
+	13: "result",
+	14: "errorCode",
+	15: "errorMessage",
+	16: "createdAt",
+}
+
+func (p *AuditEventDTO) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *AuditEventDTO) IsSetActorID() bool {
+	return p.ActorID != nil
+}
+
+func (p *AuditEventDTO) IsSetActorOrganizationID() bool {
+	return p.ActorOrganizationID != nil
+}
+
+func (p *AuditEventDTO) IsSetAction() bool {
+	return p.Action != nil
+}
+
+func (p *AuditEventDTO) IsSetTargetType() bool {
+	return p.TargetType != nil
+}
+
+func (p *AuditEventDTO) IsSetTargetID() bool {
+	return p.TargetID != nil
+}
+
+func (p *AuditEventDTO) IsSetBefore() bool {
+	return p.Before != nil
+}
+
+func (p *AuditEventDTO) IsSetAfter() bool {
+	return p.After != nil
+}
+
+func (p *AuditEventDTO) IsSetChangedFields() bool {
+	return p.ChangedFields != nil
+}
+
+func (p *AuditEventDTO) IsSetRequestID() bool {
+	return p.RequestID != nil
+}
+
+func (p *AuditEventDTO) IsSetTraceID() bool {
+	return p.TraceID != nil
+}
+
+func (p *AuditEventDTO) IsSetIpAddress() bool {
+	return p.IpAddress != nil
+}
+
+func (p *AuditEventDTO) IsSetResult() bool {
+	return p.Result != nil
+}
+
+func (p *AuditEventDTO) IsSetErrorCode() bool {
+	return p.ErrorCode != nil
+}
+
+func (p *AuditEventDTO) IsSetErrorMessage() bool {
+	return p.ErrorMessage != nil
+}
+
+func (p *AuditEventDTO) IsSetCreatedAt() bool {
+	return p.CreatedAt != nil
+}
+
+func (p *AuditEventDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 12:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 15:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField15(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 16:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField16(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AuditEventDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AuditEventDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ActorID = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ActorOrganizationID = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Action = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetType = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetID = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Before = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.After = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField9(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.ChangedFields = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RequestID = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TraceID = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IpAddress = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Result = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField14(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ErrorCode = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField15(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ErrorMessage = _field
+	return nil
+}
+func (p *AuditEventDTO) ReadField16(iprot thrift.TProtocol) error {
+
+	var _field *core.TimestampMS
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.CreatedAt = _field
+	return nil
+}
+
+func (p *AuditEventDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("AuditEventDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
+		if err = p.writeField15(oprot); err != nil {
+			fieldId = 15
+			goto WriteFieldError
+		}
+		if err = p.writeField16(oprot); err != nil {
+			fieldId = 16
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActorID() {
+		if err = oprot.WriteFieldBegin("actorID", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ActorID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActorOrganizationID() {
+		if err = oprot.WriteFieldBegin("actorOrganizationID", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ActorOrganizationID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAction() {
+		if err = oprot.WriteFieldBegin("action", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Action); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetType() {
+		if err = oprot.WriteFieldBegin("targetType", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetID() {
+		if err = oprot.WriteFieldBegin("targetID", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBefore() {
+		if err = oprot.WriteFieldBegin("before", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Before); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAfter() {
+		if err = oprot.WriteFieldBegin("after", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.After); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChangedFields() {
+		if err = oprot.WriteFieldBegin("changedFields", thrift.LIST, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.ChangedFields)); err != nil {
+			return err
+		}
+		for _, v := range p.ChangedFields {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRequestID() {
+		if err = oprot.WriteFieldBegin("requestID", thrift.STRING, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RequestID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTraceID() {
+		if err = oprot.WriteFieldBegin("traceID", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TraceID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIpAddress() {
+		if err = oprot.WriteFieldBegin("ipAddress", thrift.STRING, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.IpAddress); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResult() {
+		if err = oprot.WriteFieldBegin("result", thrift.STRING, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Result); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorCode() {
+		if err = oprot.WriteFieldBegin("errorCode", thrift.I32, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.ErrorCode); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField15(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErrorMessage() {
+		if err = oprot.WriteFieldBegin("errorMessage", thrift.STRING, 15); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ErrorMessage); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 15 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) writeField16(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCreatedAt() {
+		if err = oprot.WriteFieldBegin("createdAt", thrift.I64, 16); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.CreatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 16 end error: ", p), err)
+}
+
+func (p *AuditEventDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AuditEventDTO(%+v)", *p)
+
+}
+
+/** 审计事件查询请求DTO */
+type ListAuditEventsRequestDTO struct {
+	/** 操作者用户ID */
+	ActorID *string `thrift:"actorID,1,optional" json:"actor_id,omitempty" query:"actorID" vd:"@:len($)==0 || len($)==36; msg:'操作者ID格式不正确'"`
+	/** 操作名称 */
+	Action *string `thrift:"action,2,optional" json:"action,omitempty" query:"action" `
+	/** 操作对象类型 */
+	TargetType *string `thrift:"targetType,3,optional" json:"target_type,omitempty" query:"targetType" `
+	/** 操作对象ID */
+	TargetID *string `thrift:"targetID,4,optional" json:"target_id,omitempty" query:"targetID" `
+	/** 执行结果：success 或 failure */
+	Result *string `thrift:"result,5,optional" json:"result,omitempty" query:"result" vd:"@:len($)==0 || $=='success' || $=='failure'; msg:'执行结果只能为 success 或 failure'"`
+	/** 请求ID */
+	RequestID *string `thrift:"requestID,6,optional" json:"request_id,omitempty" query:"requestID" `
+	/** 起始时间（含，毫秒时间戳） */
+	StartTime *core.TimestampMS `thrift:"startTime,7,optional" json:"start_time,omitempty" query:"startTime" `
+	/** 截止时间（不含，毫秒时间戳） */
+	EndTime *core.TimestampMS `thrift:"endTime,8,optional" json:"end_time,omitempty" query:"endTime" `
+	/** 分页请求参数 */
+	Page *http_base.PageRequestDTO `thrift:"page,9,optional" json:"page,omitempty" form:"-" query:"-"`
+}
+
+func NewListAuditEventsRequestDTO() *ListAuditEventsRequestDTO {
+	return &ListAuditEventsRequestDTO{}
+}
+
+func (p *ListAuditEventsRequestDTO) InitDefault() {
+}
+
+var ListAuditEventsRequestDTO_ActorID_DEFAULT string
+
+func (p *ListAuditEventsRequestDTO) GetActorID() (v string) {
+	if !p.IsSetActorID() {
+		return ListAuditEventsRequestDTO_ActorID_DEFAULT
+	}
+	return *p.ActorID
+}
+
+var ListAuditEventsRequestDTO_Action_DEFAULT string
+
+func (p *ListAuditEventsRequestDTO) GetAction() (v string) {
+	if !p.IsSetAction() {
+		return ListAuditEventsRequestDTO_Action_DEFAULT
+	}
+	return *p.Action
+}
+
+var ListAuditEventsRequestDTO_TargetType_DEFAULT string
+
+func (p *ListAuditEventsRequestDTO) GetTargetType() (v string) {
+	if !p.IsSetTargetType() {
+		return ListAuditEventsRequestDTO_TargetType_DEFAULT
+	}
+	return *p.TargetType
+}
+
+var ListAuditEventsRequestDTO_TargetID_DEFAULT string
+
+func (p *ListAuditEventsRequestDTO) GetTargetID() (v string) {
+	if !p.IsSetTargetID() {
+		return ListAuditEventsRequestDTO_TargetID_DEFAULT
+	}
+	return *p.TargetID
+}
+
+var ListAuditEventsRequestDTO_Result_DEFAULT string
+
+func (p *ListAuditEventsRequestDTO) GetResult() (v string) {
+	if !p.IsSetResult() {
+		return ListAuditEventsRequestDTO_Result_DEFAULT
+	}
+	return *p.Result
+}
+
+var ListAuditEventsRequestDTO_RequestID_DEFAULT string
+
+func (p *ListAuditEventsRequestDTO) GetRequestID() (v string) {
+	if !p.IsSetRequestID() {
+		return ListAuditEventsRequestDTO_RequestID_DEFAULT
+	}
+	return *p.RequestID
+}
+
+var ListAuditEventsRequestDTO_StartTime_DEFAULT core.TimestampMS
+
+func (p *ListAuditEventsRequestDTO) GetStartTime() (v core.TimestampMS) {
+	if !p.IsSetStartTime() {
+		return ListAuditEventsRequestDTO_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var ListAuditEventsRequestDTO_EndTime_DEFAULT core.TimestampMS
+
+func (p *ListAuditEventsRequestDTO) GetEndTime() (v core.TimestampMS) {
+	if !p.IsSetEndTime() {
+		return ListAuditEventsRequestDTO_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+var ListAuditEventsRequestDTO_Page_DEFAULT *http_base.PageRequestDTO
+
+func (p *ListAuditEventsRequestDTO) GetPage() (v *http_base.PageRequestDTO) {
+	if !p.IsSetPage() {
+		return ListAuditEventsRequestDTO_Page_DEFAULT
+	}
+	return p.Page
+}
+
+var fieldIDToName_ListAuditEventsRequestDTO = map[int16]string{
+	1: "actorID",
+	2: "action",
+	3: "targetType",
+	4: "targetID",
+	5: "result",
+	6: "requestID",
+	7: "startTime",
+	8: "endTime",
+	9: "page",
+}
+
+func (p *ListAuditEventsRequestDTO) IsSetActorID() bool {
+	return p.ActorID != nil
+}
+
+func (p *ListAuditEventsRequestDTO) IsSetAction() bool {
+	return p.Action != nil
+}
+
+func (p *ListAuditEventsRequestDTO) IsSetTargetType() bool {
+	return p.TargetType != nil
+}
+
+func (p *ListAuditEventsRequestDTO) IsSetTargetID() bool {
+	return p.TargetID != nil
+}
+
+func (p *ListAuditEventsRequestDTO) IsSetResult() bool {
+	return p.Result != nil
+}
+
+func (p *ListAuditEventsRequestDTO) IsSetRequestID() bool {
+	return p.RequestID != nil
+}
+
+func (p *ListAuditEventsRequestDTO) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *ListAuditEventsRequestDTO) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *ListAuditEventsRequestDTO) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListAuditEventsRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListAuditEventsRequestDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListAuditEventsRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ActorID = _field
+	return nil
+}
+func (p *ListAuditEventsRequestDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Action = _field
+	return nil
+}
+func (p *ListAuditEventsRequestDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetType = _field
+	return nil
+}
+func (p *ListAuditEventsRequestDTO) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.TargetID = _field
+	return nil
+}
+func (p *ListAuditEventsRequestDTO) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Result = _field
+	return nil
+}
+func (p *ListAuditEventsRequestDTO) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RequestID = _field
+	return nil
+}
+func (p *ListAuditEventsRequestDTO) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *core.TimestampMS
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.StartTime = _field
+	return nil
+}
+func (p *ListAuditEventsRequestDTO) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *core.TimestampMS
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EndTime = _field
+	return nil
+}
+func (p *ListAuditEventsRequestDTO) ReadField9(iprot thrift.TProtocol) error {
+	_field := http_base.NewPageRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Page = _field
+	return nil
+}
+
+func (p *ListAuditEventsRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListAuditEventsRequestDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListAuditEventsRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActorID() {
+		if err = oprot.WriteFieldBegin("actorID", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ActorID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListAuditEventsRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAction() {
+		if err = oprot.WriteFieldBegin("action", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Action); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListAuditEventsRequestDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetType() {
+		if err = oprot.WriteFieldBegin("targetType", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetType); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ListAuditEventsRequestDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTargetID() {
+		if err = oprot.WriteFieldBegin("targetID", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.TargetID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *ListAuditEventsRequestDTO) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResult() {
+		if err = oprot.WriteFieldBegin("result", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Result); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *ListAuditEventsRequestDTO) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRequestID() {
+		if err = oprot.WriteFieldBegin("requestID", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RequestID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *ListAuditEventsRequestDTO) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStartTime() {
+		if err = oprot.WriteFieldBegin("startTime", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.StartTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *ListAuditEventsRequestDTO) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEndTime() {
+		if err = oprot.WriteFieldBegin("endTime", thrift.I64, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EndTime); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *ListAuditEventsRequestDTO) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.STRUCT, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Page.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *ListAuditEventsRequestDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAuditEventsRequestDTO(%+v)", *p)
+
+}
+
+/** 审计事件查询响应DTO */
+type ListAuditEventsResponseDTO struct {
+	/** 响应状态码 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 审计事件列表 */
+	Events []*AuditEventDTO `thrift:"events,2,optional,list<AuditEventDTO>" json:"events" form:"events" query:"events"`
+	/** 分页响应参数 */
+	Page *http_base.PageResponseDTO `thrift:"page,3,optional" json:"page" form:"page" query:"page"`
+}
+
+func NewListAuditEventsResponseDTO() *ListAuditEventsResponseDTO {
+	return &ListAuditEventsResponseDTO{}
+}
+
+func (p *ListAuditEventsResponseDTO) InitDefault() {
+}
+
+var ListAuditEventsResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+
+func (p *ListAuditEventsResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return ListAuditEventsResponseDTO_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var ListAuditEventsResponseDTO_Events_DEFAULT []*AuditEventDTO
+
+func (p *ListAuditEventsResponseDTO) GetEvents() (v []*AuditEventDTO) {
+	if !p.IsSetEvents() {
+		return ListAuditEventsResponseDTO_Events_DEFAULT
+	}
+	return p.Events
+}
+
+var ListAuditEventsResponseDTO_Page_DEFAULT *http_base.PageResponseDTO
+
+func (p *ListAuditEventsResponseDTO) GetPage() (v *http_base.PageResponseDTO) {
+	if !p.IsSetPage() {
+		return ListAuditEventsResponseDTO_Page_DEFAULT
+	}
+	return p.Page
+}
+
+var fieldIDToName_ListAuditEventsResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "events",
+	3: "page",
+}
+
+func (p *ListAuditEventsResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListAuditEventsResponseDTO) IsSetEvents() bool {
+	return p.Events != nil
+}
+
+func (p *ListAuditEventsResponseDTO) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListAuditEventsResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListAuditEventsResponseDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListAuditEventsResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ListAuditEventsResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*AuditEventDTO, 0, size)
+	values := make([]AuditEventDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Events = _field
+	return nil
+}
+func (p *ListAuditEventsResponseDTO) ReadField3(iprot thrift.TProtocol) error {
+	_field := http_base.NewPageResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Page = _field
+	return nil
+}
+
+func (p *ListAuditEventsResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListAuditEventsResponseDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListAuditEventsResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListAuditEventsResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEvents() {
+		if err = oprot.WriteFieldBegin("events", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Events)); err != nil {
+			return err
+		}
+		for _, v := range p.Events {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListAuditEventsResponseDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Page.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ListAuditEventsResponseDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAuditEventsResponseDTO(%+v)", *p)
+
+}
@@ -0,0 +1,448 @@
+// Code generated by thriftgo (0.4.3). DO NOT EDIT.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+)
+
+// =================================================================
+//                        审计日志服务 (Audit Service)
+// =================================================================
+/**
+ * AuditService：审计日志服务
+ *
+ * 提供对管理类与安全类操作审计事件的查询功能。
+ */
+type AuditService interface {
+	/**
+	 * ListAuditEvents：查询审计事件
+	 *
+	 * 按操作者、操作、对象、结果、时间范围等条件分页查询审计事件，按发生时间倒序排列。
+	 *
+	 * @param req (ListAuditEventsRequestDTO) - 过滤条件及分页参数
+	 * @return resp (ListAuditEventsResponseDTO) - 审计事件列表及分页信息
+	 */
+	ListAuditEvents(ctx context.Context, req *ListAuditEventsRequestDTO) (r *ListAuditEventsResponseDTO, err error)
+}
+
+type AuditServiceClient struct {
+	c thrift.TClient
+}
+
+func NewAuditServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *AuditServiceClient {
+	return &AuditServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewAuditServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *AuditServiceClient {
+	return &AuditServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewAuditServiceClient(c thrift.TClient) *AuditServiceClient {
+	return &AuditServiceClient{
+		c: c,
+	}
+}
+
+func (p *AuditServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *AuditServiceClient) ListAuditEvents(ctx context.Context, req *ListAuditEventsRequestDTO) (r *ListAuditEventsResponseDTO, err error) {
+	var _args AuditServiceListAuditEventsArgs
+	_args.Req = req
+	var _result AuditServiceListAuditEventsResult
+	if err = p.Client_().Call(ctx, "ListAuditEvents", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type AuditServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      AuditService
+}
+
+func (p *AuditServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *AuditServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *AuditServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewAuditServiceProcessor(handler AuditService) *AuditServiceProcessor {
+	self := &AuditServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("ListAuditEvents", &auditServiceProcessorListAuditEvents{handler: handler})
+	return self
+}
+func (p *AuditServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type auditServiceProcessorListAuditEvents struct {
+	handler AuditService
+}
+
+func (p *auditServiceProcessorListAuditEvents) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := AuditServiceListAuditEventsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListAuditEvents", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := AuditServiceListAuditEventsResult{}
+	var retval *ListAuditEventsResponseDTO
+	if retval, err2 = p.handler.ListAuditEvents(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListAuditEvents: "+err2.Error())
+		oprot.WriteMessageBegin("ListAuditEvents", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListAuditEvents", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type AuditServiceListAuditEventsArgs struct {
+	Req *ListAuditEventsRequestDTO `thrift:"req,1"`
+}
+
+func NewAuditServiceListAuditEventsArgs() *AuditServiceListAuditEventsArgs {
+	return &AuditServiceListAuditEventsArgs{}
+}
+
+func (p *AuditServiceListAuditEventsArgs) InitDefault() {
+}
+
+var AuditServiceListAuditEventsArgs_Req_DEFAULT *ListAuditEventsRequestDTO
+
+func (p *AuditServiceListAuditEventsArgs) GetReq() (v *ListAuditEventsRequestDTO) {
+	if !p.IsSetReq() {
+		return AuditServiceListAuditEventsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_AuditServiceListAuditEventsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *AuditServiceListAuditEventsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AuditServiceListAuditEventsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AuditServiceListAuditEventsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AuditServiceListAuditEventsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListAuditEventsRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *AuditServiceListAuditEventsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListAuditEvents_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AuditServiceListAuditEventsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *AuditServiceListAuditEventsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AuditServiceListAuditEventsArgs(%+v)", *p)
+
+}
+
+type AuditServiceListAuditEventsResult struct {
+	Success *ListAuditEventsResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewAuditServiceListAuditEventsResult() *AuditServiceListAuditEventsResult {
+	return &AuditServiceListAuditEventsResult{}
+}
+
+func (p *AuditServiceListAuditEventsResult) InitDefault() {
+}
+
+var AuditServiceListAuditEventsResult_Success_DEFAULT *ListAuditEventsResponseDTO
+
+func (p *AuditServiceListAuditEventsResult) GetSuccess() (v *ListAuditEventsResponseDTO) {
+	if !p.IsSetSuccess() {
+		return AuditServiceListAuditEventsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_AuditServiceListAuditEventsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *AuditServiceListAuditEventsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AuditServiceListAuditEventsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AuditServiceListAuditEventsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *AuditServiceListAuditEventsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListAuditEventsResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *AuditServiceListAuditEventsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListAuditEvents_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *AuditServiceListAuditEventsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *AuditServiceListAuditEventsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AuditServiceListAuditEventsResult(%+v)", *p)
+
+}
@@ -34,6 +34,8 @@ type JWTClaimsDTO struct {
 	Exp *int64 `thrift:"exp,8,optional" json:"exp,omitempty" form:"exp" query:"exp"`
 	// 签发时间（Unix时间戳）
 	Iat *int64 `thrift:"iat,9,optional" json:"iat,omitempty" form:"iat" query:"iat"`
+	// 登录会话ID
+	SessionID *string `thrift:"sessionID,10,optional" json:"session_id,omitempty" form:"session_id" query:"session_id"`
 }
 
 func NewJWTClaimsDTO() *JWTClaimsDTO {
@@ -124,16 +126,26 @@ func (p *JWTClaimsDTO) GetIat() (v int64) {
 	return *p.Iat
 }
 
+var JWTClaimsDTO_SessionID_DEFAULT string
+
+func (p *JWTClaimsDTO) GetSessionID() (v string) {
+	if !p.IsSetSessionID() {
+		return JWTClaimsDTO_SessionID_DEFAULT
+	}
+	return *p.SessionID
+}
+
 var fieldIDToName_JWTClaimsDTO = map[int16]string{
-	1: "userProfileID",
-	2: "username",
-	3: "status",
-	4: "roleID",
-	5: "organizationID",
-	6: "departmentID",
-	7: "permission",
-	8: "exp",
-	9: "iat",
+	1:  "userProfileID",
+	2:  "username",
+	3:  "status",
+	4:  "roleID",
+	5:  "organizationID",
+	6:  "departmentID",
+	7:  "permission",
+	8:  "exp",
+	9:  "iat",
+	10: "sessionID",
 }
 
 func (p *JWTClaimsDTO) IsSetUserProfileID() bool {
@@ -172,6 +184,10 @@ func (p *JWTClaimsDTO) IsSetIat() bool {
 	return p.Iat != nil
 }
 
+func (p *JWTClaimsDTO) IsSetSessionID() bool {
+	return p.SessionID != nil
+}
+
 func (p *JWTClaimsDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -263,6 +279,14 @@ func (p *JWTClaimsDTO) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -392,6 +416,17 @@ func (p *JWTClaimsDTO) ReadField9(iprot thrift.TProtocol) error {
 	p.Iat = _field
 	return nil
 }
+func (p *JWTClaimsDTO) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SessionID = _field
+	return nil
+}
 
 func (p *JWTClaimsDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -435,6 +470,10 @@ func (p *JWTClaimsDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 9
 			goto WriteFieldError
 		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -624,6 +663,25 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
 }
 
+func (p *JWTClaimsDTO) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSessionID() {
+		if err = oprot.WriteFieldBegin("sessionID", thrift.STRING, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SessionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
 func (p *JWTClaimsDTO) String() string {
 	if p == nil {
 		return "<nil>"
@@ -642,6 +700,10 @@ type TokenInfoDTO struct {
 	ExpiresIn *int64 `thrift:"expiresIn,2,optional" json:"expires_in,omitempty" form:"expires_in" query:"expires_in"`
 	// 令牌类型（通常是"Bearer"）
 	TokenType *string `thrift:"tokenType,3,optional" json:"token_type,omitempty" form:"token_type" query:"token_type"`
+	// 刷新令牌（每次刷新后轮换）
+	RefreshToken *string `thrift:"refreshToken,4,optional" json:"refresh_token,omitempty" form:"refresh_token" query:"refresh_token"`
+	// 刷新令牌过期时间（秒）
+	RefreshExpiresIn *int64 `thrift:"refreshExpiresIn,5,optional" json:"refresh_expires_in,omitempty" form:"refresh_expires_in" query:"refresh_expires_in"`
 }
 
 func NewTokenInfoDTO() *TokenInfoDTO {
@@ -678,10 +740,30 @@ func (p *TokenInfoDTO) GetTokenType() (v string) {
 	return *p.TokenType
 }
 
+var TokenInfoDTO_RefreshToken_DEFAULT string
+
+func (p *TokenInfoDTO) GetRefreshToken() (v string) {
+	if !p.IsSetRefreshToken() {
+		return TokenInfoDTO_RefreshToken_DEFAULT
+	}
+	return *p.RefreshToken
+}
+
+var TokenInfoDTO_RefreshExpiresIn_DEFAULT int64
+
+func (p *TokenInfoDTO) GetRefreshExpiresIn() (v int64) {
+	if !p.IsSetRefreshExpiresIn() {
+		return TokenInfoDTO_RefreshExpiresIn_DEFAULT
+	}
+	return *p.RefreshExpiresIn
+}
+
 var fieldIDToName_TokenInfoDTO = map[int16]string{
 	1: "accessToken",
 	2: "expiresIn",
 	3: "tokenType",
+	4: "refreshToken",
+	5: "refreshExpiresIn",
 }
 
 func (p *TokenInfoDTO) IsSetAccessToken() bool {
@@ -696,6 +778,14 @@ func (p *TokenInfoDTO) IsSetTokenType() bool {
 	return p.TokenType != nil
 }
 
+func (p *TokenInfoDTO) IsSetRefreshToken() bool {
+	return p.RefreshToken != nil
+}
+
+func (p *TokenInfoDTO) IsSetRefreshExpiresIn() bool {
+	return p.RefreshExpiresIn != nil
+}
+
 func (p *TokenInfoDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -739,6 +829,22 @@ func (p *TokenInfoDTO) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -801,6 +907,28 @@ func (p *TokenInfoDTO) ReadField3(iprot thrift.TProtocol) error {
 	p.TokenType = _field
 	return nil
 }
+func (p *TokenInfoDTO) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RefreshToken = _field
+	return nil
+}
+func (p *TokenInfoDTO) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RefreshExpiresIn = _field
+	return nil
+}
 
 func (p *TokenInfoDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -820,6 +948,14 @@ func (p *TokenInfoDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -895,6 +1031,44 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
+func (p *TokenInfoDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRefreshToken() {
+		if err = oprot.WriteFieldBegin("refreshToken", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RefreshToken); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *TokenInfoDTO) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRefreshExpiresIn() {
+		if err = oprot.WriteFieldBegin("refreshExpiresIn", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.RefreshExpiresIn); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
 func (p *TokenInfoDTO) String() string {
 	if p == nil {
 		return "<nil>"
@@ -915,6 +1089,8 @@ type BaseResponseDTO struct {
 	Code int32 `thrift:"code,1,required" json:"code" form:"code,required" query:"code,required"`
 	// 人类可读的提示信息
 	Message string `thrift:"message,2,required" json:"message" form:"message,required" query:"message,required"`
+	// 错误明细（如逐条列出违反的密码规则）
+	Details []*ErrorDetailDTO `thrift:"details,3,optional,list<ErrorDetailDTO>" json:"details,omitempty" form:"details" query:"details"`
 }
 
 func NewBaseResponseDTO() *BaseResponseDTO {
@@ -937,9 +1113,23 @@ func (p *BaseResponseDTO) GetMessage() (v string) {
 	return p.Message
 }
 
+var BaseResponseDTO_Details_DEFAULT []*ErrorDetailDTO
+
+func (p *BaseResponseDTO) GetDetails() (v []*ErrorDetailDTO) {
+	if !p.IsSetDetails() {
+		return BaseResponseDTO_Details_DEFAULT
+	}
+	return p.Details
+}
+
 var fieldIDToName_BaseResponseDTO = map[int16]string{
 	1: "code",
 	2: "message",
+	3: "details",
+}
+
+func (p *BaseResponseDTO) IsSetDetails() bool {
+	return p.Details != nil
 }
 
 func (p *BaseResponseDTO) Read(iprot thrift.TProtocol) (err error) {
@@ -981,6 +1171,14 @@ func (p *BaseResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1043,6 +1241,29 @@ func (p *BaseResponseDTO) ReadField2(iprot thrift.TProtocol) error {
 	p.Message = _field
 	return nil
 }
+func (p *BaseResponseDTO) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*ErrorDetailDTO, 0, size)
+	values := make([]ErrorDetailDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Details = _field
+	return nil
+}
 
 func (p *BaseResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1058,6 +1279,10 @@ func (p *BaseResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1110,6 +1335,33 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
+func (p *BaseResponseDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDetails() {
+		if err = oprot.WriteFieldBegin("details", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Details)); err != nil {
+			return err
+		}
+		for _, v := range p.Details {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
 func (p *BaseResponseDTO) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1118,6 +1370,213 @@ func (p *BaseResponseDTO) String() string {
 
 }
 
+/**
+ * 错误明细DTO
+ * 用于一次请求存在多个错误原因时逐条返回
+ */
+type ErrorDetailDTO struct {
+	// 明细标识，如违反的规则名称
+	Rule string `thrift:"rule,1,required" json:"rule" form:"rule,required" query:"rule,required"`
+	// 人类可读的明细说明
+	Message string `thrift:"message,2,required" json:"message" form:"message,required" query:"message,required"`
+}
+
+func NewErrorDetailDTO() *ErrorDetailDTO {
+	return &ErrorDetailDTO{}
+}
+
+func (p *ErrorDetailDTO) InitDefault() {
+}
+
+func (p *ErrorDetailDTO) GetRule() (v string) {
+	return p.Rule
+}
+
+func (p *ErrorDetailDTO) GetMessage() (v string) {
+	return p.Message
+}
+
+var fieldIDToName_ErrorDetailDTO = map[int16]string{
+	1: "rule",
+	2: "message",
+}
+
+func (p *ErrorDetailDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+	var issetRule bool = false
+	var issetMessage bool = false
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetRule = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+				issetMessage = true
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	if !issetRule {
+		fieldId = 1
+		goto RequiredFieldNotSetError
+	}
+
+	if !issetMessage {
+		fieldId = 2
+		goto RequiredFieldNotSetError
+	}
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ErrorDetailDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+RequiredFieldNotSetError:
+	return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("required field %s is not set", fieldIDToName_ErrorDetailDTO[fieldId]))
+}
+
+func (p *ErrorDetailDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Rule = _field
+	return nil
+}
+func (p *ErrorDetailDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = v
+	}
+	p.Message = _field
+	return nil
+}
+
+func (p *ErrorDetailDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ErrorDetailDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ErrorDetailDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("rule", thrift.STRING, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Rule); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ErrorDetailDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := oprot.WriteString(p.Message); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ErrorDetailDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ErrorDetailDTO(%+v)", *p)
+
+}
+
 /**
  * 通用操作状态响应DTO
  * 适用于删除、更新等只需返回操作状态的接口
@@ -19,10 +19,12 @@ import (
  * 用户通过用户名密码进行身份认证的请求数据
  */
 type LoginRequestDTO struct {
-	/** 用户名 */
+	/** 登录账号：用户名、邮箱或手机号 */
 	Username *string `thrift:"username,1,optional" json:"username" form:"username" vd:"@:len($) > 0; msg:'用户名不能为空'"`
 	/** 密码 */
 	Password *string `thrift:"password,2,optional" json:"password" form:"password" vd:"@:len($) > 0; msg:'密码不能为空'"`
+	/** 登录后代表的组织ID，为空时使用主要成员关系所在组织 */
+	OrganizationID *string `thrift:"organizationID,3,optional" json:"organization_id,omitempty" form:"organization_id" vd:"@:len($)==0 || len($)==36; msg:'组织ID格式不正确'"`
 }
 
 func NewLoginRequestDTO() *LoginRequestDTO {
@@ -50,9 +52,19 @@ func (p *LoginRequestDTO) GetPassword() (v string) {
 	return *p.Password
 }
 
+var LoginRequestDTO_OrganizationID_DEFAULT string
+
+func (p *LoginRequestDTO) GetOrganizationID() (v string) {
+	if !p.IsSetOrganizationID() {
+		return LoginRequestDTO_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
+
 var fieldIDToName_LoginRequestDTO = map[int16]string{
 	1: "username",
 	2: "password",
+	3: "organizationID",
 }
 
 func (p *LoginRequestDTO) IsSetUsername() bool {
@@ -63,6 +75,10 @@ func (p *LoginRequestDTO) IsSetPassword() bool {
 	return p.Password != nil
 }
 
+func (p *LoginRequestDTO) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
 func (p *LoginRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -98,6 +114,14 @@ func (p *LoginRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -149,6 +173,17 @@ func (p *LoginRequestDTO) ReadField2(iprot thrift.TProtocol) error {
 	p.Password = _field
 	return nil
 }
+func (p *LoginRequestDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.OrganizationID = _field
+	return nil
+}
 
 func (p *LoginRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -164,6 +199,10 @@ func (p *LoginRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -220,6 +259,25 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
+func (p *LoginRequestDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrganizationID() {
+		if err = oprot.WriteFieldBegin("organizationID", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.OrganizationID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
 func (p *LoginRequestDTO) String() string {
 	if p == nil {
 		return "<nil>"
@@ -245,6 +303,14 @@ type LoginResponseDTO struct {
 	Memberships []*UserMembershipDTO `thrift:"memberships,5,optional,list<UserMembershipDTO>" json:"memberships,omitempty" form:"memberships" query:"memberships"`
 	/** 用户角色ID列表 */
 	RoleIDs []string `thrift:"roleIDs,6,optional,list<string>" json:"role_ids,omitempty" form:"roleIDs" query:"roleIDs"`
+	/** 是否需要完成多因素认证（为 true 时不返回令牌，需携带挑战令牌调用 MFA 校验接口） */
+	MfaRequired *bool `thrift:"mfaRequired,7,optional" json:"mfa_required,omitempty" form:"mfaRequired" query:"mfaRequired"`
+	/** 多因素认证挑战信息 */
+	MfaChallenge *MFAChallengeDTO `thrift:"mfaChallenge,8,optional" json:"mfa_challenge,omitempty" form:"mfaChallenge" query:"mfaChallenge"`
+	/** 所属角色要求多因素认证但尚未绑定，登录后仅可访问绑定相关接口 */
+	MfaEnrollmentRequired *bool `thrift:"mfaEnrollmentRequired,9,optional" json:"mfa_enrollment_required,omitempty" form:"mfaEnrollmentRequired" query:"mfaEnrollmentRequired"`
+	/** 当前代表的组织ID，菜单树、角色与权限均按该组织计算 */
+	ActiveOrganizationID *string `thrift:"activeOrganizationID,10,optional" json:"active_organization_id,omitempty" form:"activeOrganizationID" query:"activeOrganizationID"`
 }
 
 func NewLoginResponseDTO() *LoginResponseDTO {
@@ -308,13 +374,53 @@ func (p *LoginResponseDTO) GetRoleIDs() (v []string) {
 	return p.RoleIDs
 }
 
+var LoginResponseDTO_MfaRequired_DEFAULT bool
+
+func (p *LoginResponseDTO) GetMfaRequired() (v bool) {
+	if !p.IsSetMfaRequired() {
+		return LoginResponseDTO_MfaRequired_DEFAULT
+	}
+	return *p.MfaRequired
+}
+
+var LoginResponseDTO_MfaChallenge_DEFAULT *MFAChallengeDTO
+
+func (p *LoginResponseDTO) GetMfaChallenge() (v *MFAChallengeDTO) {
+	if !p.IsSetMfaChallenge() {
+		return LoginResponseDTO_MfaChallenge_DEFAULT
+	}
+	return p.MfaChallenge
+}
+
+var LoginResponseDTO_MfaEnrollmentRequired_DEFAULT bool
+
+func (p *LoginResponseDTO) GetMfaEnrollmentRequired() (v bool) {
+	if !p.IsSetMfaEnrollmentRequired() {
+		return LoginResponseDTO_MfaEnrollmentRequired_DEFAULT
+	}
+	return *p.MfaEnrollmentRequired
+}
+
+var LoginResponseDTO_ActiveOrganizationID_DEFAULT string
+
+func (p *LoginResponseDTO) GetActiveOrganizationID() (v string) {
+	if !p.IsSetActiveOrganizationID() {
+		return LoginResponseDTO_ActiveOrganizationID_DEFAULT
+	}
+	return *p.ActiveOrganizationID
+}
+
 var fieldIDToName_LoginResponseDTO = map[int16]string{
-	1: "baseResp",
-	2: "userProfile",
-	3: "menuTree",
-	4: "tokenInfo",
-	5: "memberships",
-	6: "roleIDs",
+	1:  "baseResp",
+	2:  "userProfile",
+	3:  "menuTree",
+	4:  "tokenInfo",
+	5:  "memberships",
+	6:  "roleIDs",
+	7:  "mfaRequired",
+	8:  "mfaChallenge",
+	9:  "mfaEnrollmentRequired",
+	10: "activeOrganizationID",
 }
 
 func (p *LoginResponseDTO) IsSetBaseResp() bool {
@@ -341,6 +447,22 @@ func (p *LoginResponseDTO) IsSetRoleIDs() bool {
 	return p.RoleIDs != nil
 }
 
+func (p *LoginResponseDTO) IsSetMfaRequired() bool {
+	return p.MfaRequired != nil
+}
+
+func (p *LoginResponseDTO) IsSetMfaChallenge() bool {
+	return p.MfaChallenge != nil
+}
+
+func (p *LoginResponseDTO) IsSetMfaEnrollmentRequired() bool {
+	return p.MfaEnrollmentRequired != nil
+}
+
+func (p *LoginResponseDTO) IsSetActiveOrganizationID() bool {
+	return p.ActiveOrganizationID != nil
+}
+
 func (p *LoginResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -408,6 +530,38 @@ func (p *LoginResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -530,6 +684,47 @@ func (p *LoginResponseDTO) ReadField6(iprot thrift.TProtocol) error {
 	p.RoleIDs = _field
 	return nil
 }
+func (p *LoginResponseDTO) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MfaRequired = _field
+	return nil
+}
+func (p *LoginResponseDTO) ReadField8(iprot thrift.TProtocol) error {
+	_field := NewMFAChallengeDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.MfaChallenge = _field
+	return nil
+}
+func (p *LoginResponseDTO) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MfaEnrollmentRequired = _field
+	return nil
+}
+func (p *LoginResponseDTO) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ActiveOrganizationID = _field
+	return nil
+}
 
 func (p *LoginResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -561,6 +756,22 @@ func (p *LoginResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 6
 			goto WriteFieldError
 		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -717,6 +928,82 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
+func (p *LoginResponseDTO) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMfaRequired() {
+		if err = oprot.WriteFieldBegin("mfaRequired", thrift.BOOL, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.MfaRequired); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *LoginResponseDTO) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMfaChallenge() {
+		if err = oprot.WriteFieldBegin("mfaChallenge", thrift.STRUCT, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.MfaChallenge.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *LoginResponseDTO) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMfaEnrollmentRequired() {
+		if err = oprot.WriteFieldBegin("mfaEnrollmentRequired", thrift.BOOL, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.MfaEnrollmentRequired); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *LoginResponseDTO) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActiveOrganizationID() {
+		if err = oprot.WriteFieldBegin("activeOrganizationID", thrift.STRING, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ActiveOrganizationID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
 func (p *LoginResponseDTO) String() string {
 	if p == nil {
 		return "<nil>"
@@ -726,39 +1013,39 @@ func (p *LoginResponseDTO) String() string {
 }
 
 /**
- * 用户注销请求
- * 用户主动登出时的请求数据
+ * 切换当前组织请求
+ * 已登录用户切换所代表的组织，成功后重新签发访问令牌
  */
-type LogoutRequestDTO struct {
-	/** 刷新令牌（可选，用于撤销） */
-	RefreshToken *string `thrift:"refreshToken,1,optional" json:"refresh_token" form:"refresh_token" vd:"@:len($) > 0; msg:'刷新令牌不能为空'"`
+type SwitchOrganizationRequestDTO struct {
+	/** 目标组织ID */
+	OrganizationID *string `thrift:"organizationID,1,optional" json:"organization_id" form:"organization_id" vd:"@:len($)==36; msg:'组织ID格式不正确'"`
 }
 
-func NewLogoutRequestDTO() *LogoutRequestDTO {
-	return &LogoutRequestDTO{}
+func NewSwitchOrganizationRequestDTO() *SwitchOrganizationRequestDTO {
+	return &SwitchOrganizationRequestDTO{}
 }
 
-func (p *LogoutRequestDTO) InitDefault() {
+func (p *SwitchOrganizationRequestDTO) InitDefault() {
 }
 
-var LogoutRequestDTO_RefreshToken_DEFAULT string
+var SwitchOrganizationRequestDTO_OrganizationID_DEFAULT string
 
-func (p *LogoutRequestDTO) GetRefreshToken() (v string) {
-	if !p.IsSetRefreshToken() {
-		return LogoutRequestDTO_RefreshToken_DEFAULT
+func (p *SwitchOrganizationRequestDTO) GetOrganizationID() (v string) {
+	if !p.IsSetOrganizationID() {
+		return SwitchOrganizationRequestDTO_OrganizationID_DEFAULT
 	}
-	return *p.RefreshToken
+	return *p.OrganizationID
 }
 
-var fieldIDToName_LogoutRequestDTO = map[int16]string{
-	1: "refreshToken",
+var fieldIDToName_SwitchOrganizationRequestDTO = map[int16]string{
+	1: "organizationID",
 }
 
-func (p *LogoutRequestDTO) IsSetRefreshToken() bool {
-	return p.RefreshToken != nil
+func (p *SwitchOrganizationRequestDTO) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
 }
 
-func (p *LogoutRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *SwitchOrganizationRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -804,7 +1091,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LogoutRequestDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SwitchOrganizationRequestDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -814,7 +1101,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *LogoutRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+func (p *SwitchOrganizationRequestDTO) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -822,13 +1109,13 @@ func (p *LogoutRequestDTO) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.RefreshToken = _field
+	p.OrganizationID = _field
 	return nil
 }
 
-func (p *LogoutRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *SwitchOrganizationRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LogoutRequestDTO"); err != nil {
+	if err = oprot.WriteStructBegin("SwitchOrganizationRequestDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -854,12 +1141,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *LogoutRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRefreshToken() {
-		if err = oprot.WriteFieldBegin("refreshToken", thrift.STRING, 1); err != nil {
+func (p *SwitchOrganizationRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrganizationID() {
+		if err = oprot.WriteFieldBegin("organizationID", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.RefreshToken); err != nil {
+		if err := oprot.WriteString(*p.OrganizationID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -873,65 +1160,48 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *LogoutRequestDTO) String() string {
+func (p *SwitchOrganizationRequestDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("LogoutRequestDTO(%+v)", *p)
+	return fmt.Sprintf("SwitchOrganizationRequestDTO(%+v)", *p)
 
 }
 
-// ---- 密码管理 ----
 /**
- * 修改密码请求
- * 用户主动修改自己密码的请求数据
+ * 用户注销请求
+ * 用户主动登出时的请求数据
  */
-type ChangePasswordRequestDTO struct {
-	/** 当前密码 */
-	OldPassword *string `thrift:"oldPassword,1,optional" json:"old_password" form:"old_password" vd:"@:len($)>0; msg:'当前密码不能为空'"`
-	/** 新密码 */
-	NewPassword *string `thrift:"newPassword,2,optional" json:"new_password" form:"new_password" vd:"@:len($)>0 && len($)>=6; msg:'新密码不能为空且长度至少为6位'"`
-}
-
-func NewChangePasswordRequestDTO() *ChangePasswordRequestDTO {
-	return &ChangePasswordRequestDTO{}
+type LogoutRequestDTO struct {
+	/** 刷新令牌（可选，用于撤销） */
+	RefreshToken *string `thrift:"refreshToken,1,optional" json:"refresh_token" form:"refresh_token" vd:"@:len($) > 0; msg:'刷新令牌不能为空'"`
 }
 
-func (p *ChangePasswordRequestDTO) InitDefault() {
+func NewLogoutRequestDTO() *LogoutRequestDTO {
+	return &LogoutRequestDTO{}
 }
 
-var ChangePasswordRequestDTO_OldPassword_DEFAULT string
-
-func (p *ChangePasswordRequestDTO) GetOldPassword() (v string) {
-	if !p.IsSetOldPassword() {
-		return ChangePasswordRequestDTO_OldPassword_DEFAULT
-	}
-	return *p.OldPassword
+func (p *LogoutRequestDTO) InitDefault() {
 }
 
-var ChangePasswordRequestDTO_NewPassword_DEFAULT string
+var LogoutRequestDTO_RefreshToken_DEFAULT string
 
-func (p *ChangePasswordRequestDTO) GetNewPassword() (v string) {
-	if !p.IsSetNewPassword() {
-		return ChangePasswordRequestDTO_NewPassword_DEFAULT
+func (p *LogoutRequestDTO) GetRefreshToken() (v string) {
+	if !p.IsSetRefreshToken() {
+		return LogoutRequestDTO_RefreshToken_DEFAULT
 	}
-	return *p.NewPassword
-}
-
-var fieldIDToName_ChangePasswordRequestDTO = map[int16]string{
-	1: "oldPassword",
-	2: "newPassword",
+	return *p.RefreshToken
 }
 
-func (p *ChangePasswordRequestDTO) IsSetOldPassword() bool {
-	return p.OldPassword != nil
+var fieldIDToName_LogoutRequestDTO = map[int16]string{
+	1: "refreshToken",
 }
 
-func (p *ChangePasswordRequestDTO) IsSetNewPassword() bool {
-	return p.NewPassword != nil
+func (p *LogoutRequestDTO) IsSetRefreshToken() bool {
+	return p.RefreshToken != nil
 }
 
-func (p *ChangePasswordRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *LogoutRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -958,14 +1228,6 @@ func (p *ChangePasswordRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -985,7 +1247,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChangePasswordRequestDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_LogoutRequestDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -995,18 +1257,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ChangePasswordRequestDTO) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.OldPassword = _field
-	return nil
-}
-func (p *ChangePasswordRequestDTO) ReadField2(iprot thrift.TProtocol) error {
+func (p *LogoutRequestDTO) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1014,13 +1265,13 @@ func (p *ChangePasswordRequestDTO) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.NewPassword = _field
+	p.RefreshToken = _field
 	return nil
 }
 
-func (p *ChangePasswordRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *LogoutRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ChangePasswordRequestDTO"); err != nil {
+	if err = oprot.WriteStructBegin("LogoutRequestDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1028,10 +1279,6 @@ func (p *ChangePasswordRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1050,12 +1297,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ChangePasswordRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetOldPassword() {
-		if err = oprot.WriteFieldBegin("oldPassword", thrift.STRING, 1); err != nil {
+func (p *LogoutRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRefreshToken() {
+		if err = oprot.WriteFieldBegin("refreshToken", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.OldPassword); err != nil {
+		if err := oprot.WriteString(*p.RefreshToken); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1069,99 +1316,65 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ChangePasswordRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetNewPassword() {
-		if err = oprot.WriteFieldBegin("newPassword", thrift.STRING, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.NewPassword); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *ChangePasswordRequestDTO) String() string {
+func (p *LogoutRequestDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ChangePasswordRequestDTO(%+v)", *p)
+	return fmt.Sprintf("LogoutRequestDTO(%+v)", *p)
 
 }
 
+// ---- 多因素认证 ----
 /**
- * 重置密码请求
- * 管理员为用户重置密码的请求数据
+ * 多因素认证挑战
+ * 密码校验通过后签发的短期挑战令牌，用于完成第二步校验
  */
-type ResetPasswordRequestDTO struct {
-	/** 目标用户ID */
-	UserID *string `thrift:"userID,1,optional" json:"user_id" form:"user_id" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
-	/** 新密码（可选，为空时系统生成） */
-	NewPassword *string `thrift:"newPassword,2,optional" json:"new_password,omitempty" form:"new_password" vd:"@:len($)==0 || len($)>=6; msg:'新密码长度至少为6位'"`
-	/** 重置原因 */
-	ResetReason *string `thrift:"resetReason,3,optional" json:"reset_reason,omitempty" form:"reset_reason" vd:"@:len($)<=200; msg:'重置原因不能超过200个字符'"`
-}
-
-func NewResetPasswordRequestDTO() *ResetPasswordRequestDTO {
-	return &ResetPasswordRequestDTO{}
+type MFAChallengeDTO struct {
+	/** 挑战令牌 */
+	ChallengeToken *string `thrift:"challengeToken,1,optional" json:"challenge_token" form:"challengeToken" query:"challengeToken"`
+	/** 挑战令牌有效期（秒） */
+	ExpiresIn *int64 `thrift:"expiresIn,2,optional" json:"expires_in" form:"expiresIn" query:"expiresIn"`
 }
 
-func (p *ResetPasswordRequestDTO) InitDefault() {
+func NewMFAChallengeDTO() *MFAChallengeDTO {
+	return &MFAChallengeDTO{}
 }
 
-var ResetPasswordRequestDTO_UserID_DEFAULT string
-
-func (p *ResetPasswordRequestDTO) GetUserID() (v string) {
-	if !p.IsSetUserID() {
-		return ResetPasswordRequestDTO_UserID_DEFAULT
-	}
-	return *p.UserID
+func (p *MFAChallengeDTO) InitDefault() {
 }
 
-var ResetPasswordRequestDTO_NewPassword_DEFAULT string
+var MFAChallengeDTO_ChallengeToken_DEFAULT string
 
-func (p *ResetPasswordRequestDTO) GetNewPassword() (v string) {
-	if !p.IsSetNewPassword() {
-		return ResetPasswordRequestDTO_NewPassword_DEFAULT
+func (p *MFAChallengeDTO) GetChallengeToken() (v string) {
+	if !p.IsSetChallengeToken() {
+		return MFAChallengeDTO_ChallengeToken_DEFAULT
 	}
-	return *p.NewPassword
+	return *p.ChallengeToken
 }
 
-var ResetPasswordRequestDTO_ResetReason_DEFAULT string
+var MFAChallengeDTO_ExpiresIn_DEFAULT int64
 
-func (p *ResetPasswordRequestDTO) GetResetReason() (v string) {
-	if !p.IsSetResetReason() {
-		return ResetPasswordRequestDTO_ResetReason_DEFAULT
+func (p *MFAChallengeDTO) GetExpiresIn() (v int64) {
+	if !p.IsSetExpiresIn() {
+		return MFAChallengeDTO_ExpiresIn_DEFAULT
 	}
-	return *p.ResetReason
-}
-
-var fieldIDToName_ResetPasswordRequestDTO = map[int16]string{
-	1: "userID",
-	2: "newPassword",
-	3: "resetReason",
+	return *p.ExpiresIn
 }
 
-func (p *ResetPasswordRequestDTO) IsSetUserID() bool {
-	return p.UserID != nil
+var fieldIDToName_MFAChallengeDTO = map[int16]string{
+	1: "challengeToken",
+	2: "expiresIn",
 }
 
-func (p *ResetPasswordRequestDTO) IsSetNewPassword() bool {
-	return p.NewPassword != nil
+func (p *MFAChallengeDTO) IsSetChallengeToken() bool {
+	return p.ChallengeToken != nil
 }
 
-func (p *ResetPasswordRequestDTO) IsSetResetReason() bool {
-	return p.ResetReason != nil
+func (p *MFAChallengeDTO) IsSetExpiresIn() bool {
+	return p.ExpiresIn != nil
 }
 
-func (p *ResetPasswordRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *MFAChallengeDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1189,21 +1402,13 @@ func (p *ResetPasswordRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.I64 {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1223,7 +1428,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResetPasswordRequestDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MFAChallengeDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1233,18 +1438,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ResetPasswordRequestDTO) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
-		return err
-	} else {
-		_field = &v
-	}
-	p.UserID = _field
-	return nil
-}
-func (p *ResetPasswordRequestDTO) ReadField2(iprot thrift.TProtocol) error {
+func (p *MFAChallengeDTO) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1252,24 +1446,24 @@ func (p *ResetPasswordRequestDTO) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.NewPassword = _field
+	p.ChallengeToken = _field
 	return nil
 }
-func (p *ResetPasswordRequestDTO) ReadField3(iprot thrift.TProtocol) error {
+func (p *MFAChallengeDTO) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.ResetReason = _field
+	p.ExpiresIn = _field
 	return nil
 }
 
-func (p *ResetPasswordRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *MFAChallengeDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ResetPasswordRequestDTO"); err != nil {
+	if err = oprot.WriteStructBegin("MFAChallengeDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1281,10 +1475,6 @@ func (p *ResetPasswordRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1303,12 +1493,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ResetPasswordRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserID() {
-		if err = oprot.WriteFieldBegin("userID", thrift.STRING, 1); err != nil {
+func (p *MFAChallengeDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChallengeToken() {
+		if err = oprot.WriteFieldBegin("challengeToken", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.UserID); err != nil {
+		if err := oprot.WriteString(*p.ChallengeToken); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1322,12 +1512,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ResetPasswordRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetNewPassword() {
-		if err = oprot.WriteFieldBegin("newPassword", thrift.STRING, 2); err != nil {
+func (p *MFAChallengeDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExpiresIn() {
+		if err = oprot.WriteFieldBegin("expiresIn", thrift.I64, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.NewPassword); err != nil {
+		if err := oprot.WriteI64(*p.ExpiresIn); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1341,83 +1531,64 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ResetPasswordRequestDTO) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetResetReason() {
-		if err = oprot.WriteFieldBegin("resetReason", thrift.STRING, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.ResetReason); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *ResetPasswordRequestDTO) String() string {
+func (p *MFAChallengeDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ResetPasswordRequestDTO(%+v)", *p)
+	return fmt.Sprintf("MFAChallengeDTO(%+v)", *p)
 
 }
 
 /**
- * 强制修改密码请求
- * 管理员强制用户在下次登录时修改密码
+ * 多因素认证校验请求
+ * 登录第二步：提交挑战令牌与 TOTP 验证码（或恢复码）
  */
-type ForcePasswordChangeRequestDTO struct {
-	/** 目标用户ID */
-	UserID *string `thrift:"userID,1,optional" json:"user_id" form:"user_id" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
-	/** 强制修改原因 */
-	Reason *string `thrift:"reason,2,optional" json:"reason,omitempty" form:"reason" vd:"@:len($)<=200; msg:'原因不能超过200个字符'"`
+type MFAVerifyRequestDTO struct {
+	/** 挑战令牌 */
+	ChallengeToken *string `thrift:"challengeToken,1,optional" json:"challenge_token" form:"challenge_token" vd:"@:len($) > 0; msg:'挑战令牌不能为空'"`
+	/** TOTP 验证码或恢复码 */
+	Code *string `thrift:"code,2,optional" json:"code" form:"code" vd:"@:len($) > 0; msg:'验证码不能为空'"`
 }
 
-func NewForcePasswordChangeRequestDTO() *ForcePasswordChangeRequestDTO {
-	return &ForcePasswordChangeRequestDTO{}
+func NewMFAVerifyRequestDTO() *MFAVerifyRequestDTO {
+	return &MFAVerifyRequestDTO{}
 }
 
-func (p *ForcePasswordChangeRequestDTO) InitDefault() {
+func (p *MFAVerifyRequestDTO) InitDefault() {
 }
 
-var ForcePasswordChangeRequestDTO_UserID_DEFAULT string
+var MFAVerifyRequestDTO_ChallengeToken_DEFAULT string
 
-func (p *ForcePasswordChangeRequestDTO) GetUserID() (v string) {
-	if !p.IsSetUserID() {
-		return ForcePasswordChangeRequestDTO_UserID_DEFAULT
+func (p *MFAVerifyRequestDTO) GetChallengeToken() (v string) {
+	if !p.IsSetChallengeToken() {
+		return MFAVerifyRequestDTO_ChallengeToken_DEFAULT
 	}
-	return *p.UserID
+	return *p.ChallengeToken
 }
 
-var ForcePasswordChangeRequestDTO_Reason_DEFAULT string
+var MFAVerifyRequestDTO_Code_DEFAULT string
 
-func (p *ForcePasswordChangeRequestDTO) GetReason() (v string) {
-	if !p.IsSetReason() {
-		return ForcePasswordChangeRequestDTO_Reason_DEFAULT
+func (p *MFAVerifyRequestDTO) GetCode() (v string) {
+	if !p.IsSetCode() {
+		return MFAVerifyRequestDTO_Code_DEFAULT
 	}
-	return *p.Reason
+	return *p.Code
 }
 
-var fieldIDToName_ForcePasswordChangeRequestDTO = map[int16]string{
-	1: "userID",
-	2: "reason",
+var fieldIDToName_MFAVerifyRequestDTO = map[int16]string{
+	1: "challengeToken",
+	2: "code",
 }
 
-func (p *ForcePasswordChangeRequestDTO) IsSetUserID() bool {
-	return p.UserID != nil
+func (p *MFAVerifyRequestDTO) IsSetChallengeToken() bool {
+	return p.ChallengeToken != nil
 }
 
-func (p *ForcePasswordChangeRequestDTO) IsSetReason() bool {
-	return p.Reason != nil
+func (p *MFAVerifyRequestDTO) IsSetCode() bool {
+	return p.Code != nil
 }
 
-func (p *ForcePasswordChangeRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *MFAVerifyRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1471,7 +1642,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ForcePasswordChangeRequestDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MFAVerifyRequestDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1481,7 +1652,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *ForcePasswordChangeRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+func (p *MFAVerifyRequestDTO) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1489,10 +1660,10 @@ func (p *ForcePasswordChangeRequestDTO) ReadField1(iprot thrift.TProtocol) error
 	} else {
 		_field = &v
 	}
-	p.UserID = _field
+	p.ChallengeToken = _field
 	return nil
 }
-func (p *ForcePasswordChangeRequestDTO) ReadField2(iprot thrift.TProtocol) error {
+func (p *MFAVerifyRequestDTO) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1500,13 +1671,13 @@ func (p *ForcePasswordChangeRequestDTO) ReadField2(iprot thrift.TProtocol) error
 	} else {
 		_field = &v
 	}
-	p.Reason = _field
+	p.Code = _field
 	return nil
 }
 
-func (p *ForcePasswordChangeRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *MFAVerifyRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("ForcePasswordChangeRequestDTO"); err != nil {
+	if err = oprot.WriteStructBegin("MFAVerifyRequestDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1536,12 +1707,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *ForcePasswordChangeRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserID() {
-		if err = oprot.WriteFieldBegin("userID", thrift.STRING, 1); err != nil {
+func (p *MFAVerifyRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChallengeToken() {
+		if err = oprot.WriteFieldBegin("challengeToken", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.UserID); err != nil {
+		if err := oprot.WriteString(*p.ChallengeToken); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1555,12 +1726,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *ForcePasswordChangeRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetReason() {
-		if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
+func (p *MFAVerifyRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCode() {
+		if err = oprot.WriteFieldBegin("code", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Reason); err != nil {
+		if err := oprot.WriteString(*p.Code); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1574,49 +1745,2888 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *ForcePasswordChangeRequestDTO) String() string {
+func (p *MFAVerifyRequestDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ForcePasswordChangeRequestDTO(%+v)", *p)
+	return fmt.Sprintf("MFAVerifyRequestDTO(%+v)", *p)
 
 }
 
-// ---- 令牌管理 ----
 /**
- * 刷新访问令牌请求
- * 使用刷新令牌获取新的访问令牌
+ * 多因素认证验证码请求
+ * 确认绑定、重新生成恢复码、关闭多因素认证时提交的验证码
  */
-type RefreshTokenRequestDTO struct {
-	/** 刷新令牌 */
-	RefreshToken *string `thrift:"refreshToken,1,optional" json:"refresh_token" form:"refresh_token" vd:"@:len($)>0; msg:'刷新令牌不能为空'"`
+type MFACodeRequestDTO struct {
+	/** TOTP 验证码或恢复码 */
+	Code *string `thrift:"code,1,optional" json:"code" form:"code" vd:"@:len($) > 0; msg:'验证码不能为空'"`
+}
+
+func NewMFACodeRequestDTO() *MFACodeRequestDTO {
+	return &MFACodeRequestDTO{}
+}
+
+func (p *MFACodeRequestDTO) InitDefault() {
+}
+
+var MFACodeRequestDTO_Code_DEFAULT string
+
+func (p *MFACodeRequestDTO) GetCode() (v string) {
+	if !p.IsSetCode() {
+		return MFACodeRequestDTO_Code_DEFAULT
+	}
+	return *p.Code
+}
+
+var fieldIDToName_MFACodeRequestDTO = map[int16]string{
+	1: "code",
+}
+
+func (p *MFACodeRequestDTO) IsSetCode() bool {
+	return p.Code != nil
+}
+
+func (p *MFACodeRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MFACodeRequestDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MFACodeRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Code = _field
+	return nil
+}
+
+func (p *MFACodeRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MFACodeRequestDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MFACodeRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCode() {
+		if err = oprot.WriteFieldBegin("code", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Code); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MFACodeRequestDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MFACodeRequestDTO(%+v)", *p)
+
+}
+
+/**
+ * 开始绑定多因素认证响应
+ * 返回密钥及供认证器扫码的 otpauth 链接，需提交验证码确认后生效
+ */
+type MFAEnrollResponseDTO struct {
+	/** 基础响应信息 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** Base32 编码的 TOTP 密钥（用于手动输入） */
+	Secret *string `thrift:"secret,2,optional" json:"secret" form:"secret" query:"secret"`
+	/** otpauth:// 格式的密钥配置链接（用于生成二维码） */
+	ProvisioningURI *string `thrift:"provisioningURI,3,optional" json:"provisioning_uri" form:"provisioningURI" query:"provisioningURI"`
+}
+
+func NewMFAEnrollResponseDTO() *MFAEnrollResponseDTO {
+	return &MFAEnrollResponseDTO{}
+}
+
+func (p *MFAEnrollResponseDTO) InitDefault() {
+}
+
+var MFAEnrollResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+
+func (p *MFAEnrollResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return MFAEnrollResponseDTO_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var MFAEnrollResponseDTO_Secret_DEFAULT string
+
+func (p *MFAEnrollResponseDTO) GetSecret() (v string) {
+	if !p.IsSetSecret() {
+		return MFAEnrollResponseDTO_Secret_DEFAULT
+	}
+	return *p.Secret
+}
+
+var MFAEnrollResponseDTO_ProvisioningURI_DEFAULT string
+
+func (p *MFAEnrollResponseDTO) GetProvisioningURI() (v string) {
+	if !p.IsSetProvisioningURI() {
+		return MFAEnrollResponseDTO_ProvisioningURI_DEFAULT
+	}
+	return *p.ProvisioningURI
+}
+
+var fieldIDToName_MFAEnrollResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "secret",
+	3: "provisioningURI",
+}
+
+func (p *MFAEnrollResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *MFAEnrollResponseDTO) IsSetSecret() bool {
+	return p.Secret != nil
+}
+
+func (p *MFAEnrollResponseDTO) IsSetProvisioningURI() bool {
+	return p.ProvisioningURI != nil
+}
+
+func (p *MFAEnrollResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MFAEnrollResponseDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MFAEnrollResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *MFAEnrollResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Secret = _field
+	return nil
+}
+func (p *MFAEnrollResponseDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ProvisioningURI = _field
+	return nil
+}
+
+func (p *MFAEnrollResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MFAEnrollResponseDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MFAEnrollResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MFAEnrollResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSecret() {
+		if err = oprot.WriteFieldBegin("secret", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Secret); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *MFAEnrollResponseDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetProvisioningURI() {
+		if err = oprot.WriteFieldBegin("provisioningURI", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ProvisioningURI); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *MFAEnrollResponseDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MFAEnrollResponseDTO(%+v)", *p)
+
+}
+
+/**
+ * 多因素认证恢复码响应
+ * 恢复码仅在生成时返回一次，每个恢复码只能使用一次
+ */
+type MFARecoveryCodesResponseDTO struct {
+	/** 基础响应信息 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 一次性恢复码 */
+	RecoveryCodes []string `thrift:"recoveryCodes,2,optional,list<string>" json:"recovery_codes" form:"recoveryCodes" query:"recoveryCodes"`
+}
+
+func NewMFARecoveryCodesResponseDTO() *MFARecoveryCodesResponseDTO {
+	return &MFARecoveryCodesResponseDTO{}
+}
+
+func (p *MFARecoveryCodesResponseDTO) InitDefault() {
+}
+
+var MFARecoveryCodesResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+
+func (p *MFARecoveryCodesResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return MFARecoveryCodesResponseDTO_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var MFARecoveryCodesResponseDTO_RecoveryCodes_DEFAULT []string
+
+func (p *MFARecoveryCodesResponseDTO) GetRecoveryCodes() (v []string) {
+	if !p.IsSetRecoveryCodes() {
+		return MFARecoveryCodesResponseDTO_RecoveryCodes_DEFAULT
+	}
+	return p.RecoveryCodes
+}
+
+var fieldIDToName_MFARecoveryCodesResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "recoveryCodes",
+}
+
+func (p *MFARecoveryCodesResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *MFARecoveryCodesResponseDTO) IsSetRecoveryCodes() bool {
+	return p.RecoveryCodes != nil
+}
+
+func (p *MFARecoveryCodesResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MFARecoveryCodesResponseDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MFARecoveryCodesResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *MFARecoveryCodesResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.RecoveryCodes = _field
+	return nil
+}
+
+func (p *MFARecoveryCodesResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MFARecoveryCodesResponseDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MFARecoveryCodesResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MFARecoveryCodesResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRecoveryCodes() {
+		if err = oprot.WriteFieldBegin("recoveryCodes", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.RecoveryCodes)); err != nil {
+			return err
+		}
+		for _, v := range p.RecoveryCodes {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *MFARecoveryCodesResponseDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MFARecoveryCodesResponseDTO(%+v)", *p)
+
+}
+
+// ---- 密码管理 ----
+/**
+ * 修改密码请求
+ * 用户主动修改自己密码的请求数据
+ */
+type ChangePasswordRequestDTO struct {
+	/** 当前密码 */
+	OldPassword *string `thrift:"oldPassword,1,optional" json:"old_password" form:"old_password" vd:"@:len($)>0; msg:'当前密码不能为空'"`
+	/** 新密码 */
+	NewPassword *string `thrift:"newPassword,2,optional" json:"new_password" form:"new_password" vd:"@:len($)>0 && len($)>=6; msg:'新密码不能为空且长度至少为6位'"`
+}
+
+func NewChangePasswordRequestDTO() *ChangePasswordRequestDTO {
+	return &ChangePasswordRequestDTO{}
+}
+
+func (p *ChangePasswordRequestDTO) InitDefault() {
+}
+
+var ChangePasswordRequestDTO_OldPassword_DEFAULT string
+
+func (p *ChangePasswordRequestDTO) GetOldPassword() (v string) {
+	if !p.IsSetOldPassword() {
+		return ChangePasswordRequestDTO_OldPassword_DEFAULT
+	}
+	return *p.OldPassword
+}
+
+var ChangePasswordRequestDTO_NewPassword_DEFAULT string
+
+func (p *ChangePasswordRequestDTO) GetNewPassword() (v string) {
+	if !p.IsSetNewPassword() {
+		return ChangePasswordRequestDTO_NewPassword_DEFAULT
+	}
+	return *p.NewPassword
+}
+
+var fieldIDToName_ChangePasswordRequestDTO = map[int16]string{
+	1: "oldPassword",
+	2: "newPassword",
+}
+
+func (p *ChangePasswordRequestDTO) IsSetOldPassword() bool {
+	return p.OldPassword != nil
+}
+
+func (p *ChangePasswordRequestDTO) IsSetNewPassword() bool {
+	return p.NewPassword != nil
+}
+
+func (p *ChangePasswordRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ChangePasswordRequestDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ChangePasswordRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.OldPassword = _field
+	return nil
+}
+func (p *ChangePasswordRequestDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NewPassword = _field
+	return nil
+}
+
+func (p *ChangePasswordRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ChangePasswordRequestDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ChangePasswordRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOldPassword() {
+		if err = oprot.WriteFieldBegin("oldPassword", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.OldPassword); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ChangePasswordRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNewPassword() {
+		if err = oprot.WriteFieldBegin("newPassword", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NewPassword); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ChangePasswordRequestDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ChangePasswordRequestDTO(%+v)", *p)
+
+}
+
+/**
+ * 重置密码请求
+ * 管理员为用户重置密码的请求数据
+ */
+type ResetPasswordRequestDTO struct {
+	/** 目标用户ID */
+	UserID *string `thrift:"userID,1,optional" json:"user_id" form:"user_id" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+	/** 新密码（可选，为空时系统生成） */
+	NewPassword *string `thrift:"newPassword,2,optional" json:"new_password,omitempty" form:"new_password" vd:"@:len($)==0 || len($)>=6; msg:'新密码长度至少为6位'"`
+	/** 重置原因 */
+	ResetReason *string `thrift:"resetReason,3,optional" json:"reset_reason,omitempty" form:"reset_reason" vd:"@:len($)<=200; msg:'重置原因不能超过200个字符'"`
+}
+
+func NewResetPasswordRequestDTO() *ResetPasswordRequestDTO {
+	return &ResetPasswordRequestDTO{}
+}
+
+func (p *ResetPasswordRequestDTO) InitDefault() {
+}
+
+var ResetPasswordRequestDTO_UserID_DEFAULT string
+
+func (p *ResetPasswordRequestDTO) GetUserID() (v string) {
+	if !p.IsSetUserID() {
+		return ResetPasswordRequestDTO_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var ResetPasswordRequestDTO_NewPassword_DEFAULT string
+
+func (p *ResetPasswordRequestDTO) GetNewPassword() (v string) {
+	if !p.IsSetNewPassword() {
+		return ResetPasswordRequestDTO_NewPassword_DEFAULT
+	}
+	return *p.NewPassword
+}
+
+var ResetPasswordRequestDTO_ResetReason_DEFAULT string
+
+func (p *ResetPasswordRequestDTO) GetResetReason() (v string) {
+	if !p.IsSetResetReason() {
+		return ResetPasswordRequestDTO_ResetReason_DEFAULT
+	}
+	return *p.ResetReason
+}
+
+var fieldIDToName_ResetPasswordRequestDTO = map[int16]string{
+	1: "userID",
+	2: "newPassword",
+	3: "resetReason",
+}
+
+func (p *ResetPasswordRequestDTO) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *ResetPasswordRequestDTO) IsSetNewPassword() bool {
+	return p.NewPassword != nil
+}
+
+func (p *ResetPasswordRequestDTO) IsSetResetReason() bool {
+	return p.ResetReason != nil
+}
+
+func (p *ResetPasswordRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ResetPasswordRequestDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ResetPasswordRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *ResetPasswordRequestDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.NewPassword = _field
+	return nil
+}
+func (p *ResetPasswordRequestDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ResetReason = _field
+	return nil
+}
+
+func (p *ResetPasswordRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ResetPasswordRequestDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ResetPasswordRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserID() {
+		if err = oprot.WriteFieldBegin("userID", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ResetPasswordRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNewPassword() {
+		if err = oprot.WriteFieldBegin("newPassword", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.NewPassword); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ResetPasswordRequestDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResetReason() {
+		if err = oprot.WriteFieldBegin("resetReason", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ResetReason); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ResetPasswordRequestDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ResetPasswordRequestDTO(%+v)", *p)
+
+}
+
+/**
+ * 强制修改密码请求
+ * 管理员强制用户在下次登录时修改密码
+ */
+type ForcePasswordChangeRequestDTO struct {
+	/** 目标用户ID */
+	UserID *string `thrift:"userID,1,optional" json:"user_id" form:"user_id" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+	/** 强制修改原因 */
+	Reason *string `thrift:"reason,2,optional" json:"reason,omitempty" form:"reason" vd:"@:len($)<=200; msg:'原因不能超过200个字符'"`
+}
+
+func NewForcePasswordChangeRequestDTO() *ForcePasswordChangeRequestDTO {
+	return &ForcePasswordChangeRequestDTO{}
+}
+
+func (p *ForcePasswordChangeRequestDTO) InitDefault() {
+}
+
+var ForcePasswordChangeRequestDTO_UserID_DEFAULT string
+
+func (p *ForcePasswordChangeRequestDTO) GetUserID() (v string) {
+	if !p.IsSetUserID() {
+		return ForcePasswordChangeRequestDTO_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var ForcePasswordChangeRequestDTO_Reason_DEFAULT string
+
+func (p *ForcePasswordChangeRequestDTO) GetReason() (v string) {
+	if !p.IsSetReason() {
+		return ForcePasswordChangeRequestDTO_Reason_DEFAULT
+	}
+	return *p.Reason
+}
+
+var fieldIDToName_ForcePasswordChangeRequestDTO = map[int16]string{
+	1: "userID",
+	2: "reason",
+}
+
+func (p *ForcePasswordChangeRequestDTO) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *ForcePasswordChangeRequestDTO) IsSetReason() bool {
+	return p.Reason != nil
+}
+
+func (p *ForcePasswordChangeRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ForcePasswordChangeRequestDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ForcePasswordChangeRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserID = _field
+	return nil
+}
+func (p *ForcePasswordChangeRequestDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Reason = _field
+	return nil
+}
+
+func (p *ForcePasswordChangeRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ForcePasswordChangeRequestDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ForcePasswordChangeRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserID() {
+		if err = oprot.WriteFieldBegin("userID", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ForcePasswordChangeRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReason() {
+		if err = oprot.WriteFieldBegin("reason", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Reason); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ForcePasswordChangeRequestDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ForcePasswordChangeRequestDTO(%+v)", *p)
+
+}
+
+// ---- 令牌管理 ----
+/**
+ * 刷新访问令牌请求
+ * 使用刷新令牌获取新的访问令牌
+ */
+type RefreshTokenRequestDTO struct {
+	/** 刷新令牌 */
+	RefreshToken *string `thrift:"refreshToken,1,optional" json:"refresh_token" form:"refresh_token" vd:"@:len($)>0; msg:'刷新令牌不能为空'"`
+}
+
+func NewRefreshTokenRequestDTO() *RefreshTokenRequestDTO {
+	return &RefreshTokenRequestDTO{}
+}
+
+func (p *RefreshTokenRequestDTO) InitDefault() {
+}
+
+var RefreshTokenRequestDTO_RefreshToken_DEFAULT string
+
+func (p *RefreshTokenRequestDTO) GetRefreshToken() (v string) {
+	if !p.IsSetRefreshToken() {
+		return RefreshTokenRequestDTO_RefreshToken_DEFAULT
+	}
+	return *p.RefreshToken
+}
+
+var fieldIDToName_RefreshTokenRequestDTO = map[int16]string{
+	1: "refreshToken",
+}
+
+func (p *RefreshTokenRequestDTO) IsSetRefreshToken() bool {
+	return p.RefreshToken != nil
+}
+
+func (p *RefreshTokenRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RefreshTokenRequestDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RefreshTokenRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RefreshToken = _field
+	return nil
+}
+
+func (p *RefreshTokenRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RefreshTokenRequestDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RefreshTokenRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRefreshToken() {
+		if err = oprot.WriteFieldBegin("refreshToken", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RefreshToken); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RefreshTokenRequestDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RefreshTokenRequestDTO(%+v)", *p)
+
+}
+
+/**
+ * 刷新令牌响应
+ * 返回新的访问令牌和刷新令牌
+ */
+type RefreshTokenResponseDTO struct {
+	/** 基础响应信息 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 新的令牌信息 */
+	TokenInfo *http_base.TokenInfoDTO `thrift:"tokenInfo,2,optional" json:"token_info,omitempty" form:"tokenInfo" query:"tokenInfo"`
+}
+
+func NewRefreshTokenResponseDTO() *RefreshTokenResponseDTO {
+	return &RefreshTokenResponseDTO{}
+}
+
+func (p *RefreshTokenResponseDTO) InitDefault() {
+}
+
+var RefreshTokenResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+
+func (p *RefreshTokenResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return RefreshTokenResponseDTO_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var RefreshTokenResponseDTO_TokenInfo_DEFAULT *http_base.TokenInfoDTO
+
+func (p *RefreshTokenResponseDTO) GetTokenInfo() (v *http_base.TokenInfoDTO) {
+	if !p.IsSetTokenInfo() {
+		return RefreshTokenResponseDTO_TokenInfo_DEFAULT
+	}
+	return p.TokenInfo
+}
+
+var fieldIDToName_RefreshTokenResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "tokenInfo",
+}
+
+func (p *RefreshTokenResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RefreshTokenResponseDTO) IsSetTokenInfo() bool {
+	return p.TokenInfo != nil
+}
+
+func (p *RefreshTokenResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RefreshTokenResponseDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RefreshTokenResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *RefreshTokenResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+	_field := http_base.NewTokenInfoDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.TokenInfo = _field
+	return nil
+}
+
+func (p *RefreshTokenResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RefreshTokenResponseDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RefreshTokenResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RefreshTokenResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTokenInfo() {
+		if err = oprot.WriteFieldBegin("tokenInfo", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.TokenInfo.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RefreshTokenResponseDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RefreshTokenResponseDTO(%+v)", *p)
+
+}
+
+// ---- 登录会话管理 ----
+/**
+ * 登录会话数据传输对象
+ * 每次登录产生一个会话，刷新令牌在会话内轮换
+ */
+type SessionDTO struct {
+	/** 会话ID */
+	SessionID *string `thrift:"sessionID,1,optional" json:"session_id" form:"sessionID" query:"sessionID"`
+	/** 设备名称 */
+	Device *string `thrift:"device,2,optional" json:"device,omitempty" form:"device" query:"device"`
+	/** 登录IP地址 */
+	IpAddress *string `thrift:"ipAddress,3,optional" json:"ip_address,omitempty" form:"ipAddress" query:"ipAddress"`
+	/** 客户端User-Agent */
+	UserAgent *string `thrift:"userAgent,4,optional" json:"user_agent,omitempty" form:"userAgent" query:"userAgent"`
+	/** 登录时间（Unix时间戳，秒） */
+	IssuedAt *int64 `thrift:"issuedAt,5,optional" json:"issued_at" form:"issuedAt" query:"issuedAt"`
+	/** 最近活跃时间（Unix时间戳，秒） */
+	LastSeenAt *int64 `thrift:"lastSeenAt,6,optional" json:"last_seen_at" form:"lastSeenAt" query:"lastSeenAt"`
+	/** 会话过期时间（Unix时间戳，秒） */
+	ExpiresAt *int64 `thrift:"expiresAt,7,optional" json:"expires_at" form:"expiresAt" query:"expiresAt"`
+	/** 是否为当前请求所属会话 */
+	Current *bool `thrift:"current,8,optional" json:"current" form:"current" query:"current"`
+}
+
+func NewSessionDTO() *SessionDTO {
+	return &SessionDTO{}
+}
+
+func (p *SessionDTO) InitDefault() {
+}
+
+var SessionDTO_SessionID_DEFAULT string
+
+func (p *SessionDTO) GetSessionID() (v string) {
+	if !p.IsSetSessionID() {
+		return SessionDTO_SessionID_DEFAULT
+	}
+	return *p.SessionID
+}
+
+var SessionDTO_Device_DEFAULT string
+
+func (p *SessionDTO) GetDevice() (v string) {
+	if !p.IsSetDevice() {
+		return SessionDTO_Device_DEFAULT
+	}
+	return *p.Device
+}
+
+var SessionDTO_IpAddress_DEFAULT string
+
+func (p *SessionDTO) GetIpAddress() (v string) {
+	if !p.IsSetIpAddress() {
+		return SessionDTO_IpAddress_DEFAULT
+	}
+	return *p.IpAddress
+}
+
+var SessionDTO_UserAgent_DEFAULT string
+
+func (p *SessionDTO) GetUserAgent() (v string) {
+	if !p.IsSetUserAgent() {
+		return SessionDTO_UserAgent_DEFAULT
+	}
+	return *p.UserAgent
+}
+
+var SessionDTO_IssuedAt_DEFAULT int64
+
+func (p *SessionDTO) GetIssuedAt() (v int64) {
+	if !p.IsSetIssuedAt() {
+		return SessionDTO_IssuedAt_DEFAULT
+	}
+	return *p.IssuedAt
+}
+
+var SessionDTO_LastSeenAt_DEFAULT int64
+
+func (p *SessionDTO) GetLastSeenAt() (v int64) {
+	if !p.IsSetLastSeenAt() {
+		return SessionDTO_LastSeenAt_DEFAULT
+	}
+	return *p.LastSeenAt
+}
+
+var SessionDTO_ExpiresAt_DEFAULT int64
+
+func (p *SessionDTO) GetExpiresAt() (v int64) {
+	if !p.IsSetExpiresAt() {
+		return SessionDTO_ExpiresAt_DEFAULT
+	}
+	return *p.ExpiresAt
+}
+
+var SessionDTO_Current_DEFAULT bool
+
+func (p *SessionDTO) GetCurrent() (v bool) {
+	if !p.IsSetCurrent() {
+		return SessionDTO_Current_DEFAULT
+	}
+	return *p.Current
+}
+
+var fieldIDToName_SessionDTO = map[int16]string{
+	1: "sessionID",
+	2: "device",
+	3: "ipAddress",
+	4: "userAgent",
+	5: "issuedAt",
+	6: "lastSeenAt",
+	7: "expiresAt",
+	8: "current",
+}
+
+func (p *SessionDTO) IsSetSessionID() bool {
+	return p.SessionID != nil
+}
+
+func (p *SessionDTO) IsSetDevice() bool {
+	return p.Device != nil
+}
+
+func (p *SessionDTO) IsSetIpAddress() bool {
+	return p.IpAddress != nil
+}
+
+func (p *SessionDTO) IsSetUserAgent() bool {
+	return p.UserAgent != nil
+}
+
+func (p *SessionDTO) IsSetIssuedAt() bool {
+	return p.IssuedAt != nil
+}
+
+func (p *SessionDTO) IsSetLastSeenAt() bool {
+	return p.LastSeenAt != nil
+}
+
+func (p *SessionDTO) IsSetExpiresAt() bool {
+	return p.ExpiresAt != nil
+}
+
+func (p *SessionDTO) IsSetCurrent() bool {
+	return p.Current != nil
+}
+
+func (p *SessionDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SessionDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *SessionDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SessionID = _field
+	return nil
+}
+func (p *SessionDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Device = _field
+	return nil
+}
+func (p *SessionDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IpAddress = _field
+	return nil
+}
+func (p *SessionDTO) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserAgent = _field
+	return nil
+}
+func (p *SessionDTO) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IssuedAt = _field
+	return nil
+}
+func (p *SessionDTO) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.LastSeenAt = _field
+	return nil
+}
+func (p *SessionDTO) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExpiresAt = _field
+	return nil
+}
+func (p *SessionDTO) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Current = _field
+	return nil
+}
+
+func (p *SessionDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SessionDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *SessionDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSessionID() {
+		if err = oprot.WriteFieldBegin("sessionID", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SessionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *SessionDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDevice() {
+		if err = oprot.WriteFieldBegin("device", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Device); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *SessionDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIpAddress() {
+		if err = oprot.WriteFieldBegin("ipAddress", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.IpAddress); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *SessionDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserAgent() {
+		if err = oprot.WriteFieldBegin("userAgent", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserAgent); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *SessionDTO) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIssuedAt() {
+		if err = oprot.WriteFieldBegin("issuedAt", thrift.I64, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.IssuedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *SessionDTO) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLastSeenAt() {
+		if err = oprot.WriteFieldBegin("lastSeenAt", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.LastSeenAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *SessionDTO) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExpiresAt() {
+		if err = oprot.WriteFieldBegin("expiresAt", thrift.I64, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExpiresAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *SessionDTO) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCurrent() {
+		if err = oprot.WriteFieldBegin("current", thrift.BOOL, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Current); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *SessionDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SessionDTO(%+v)", *p)
+
+}
+
+/**
+ * 会话列表响应
+ */
+type ListSessionsResponseDTO struct {
+	/** 基础响应信息 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 会话列表（按最近活跃时间倒序） */
+	Sessions []*SessionDTO `thrift:"sessions,2,optional,list<SessionDTO>" json:"sessions" form:"sessions" query:"sessions"`
+}
+
+func NewListSessionsResponseDTO() *ListSessionsResponseDTO {
+	return &ListSessionsResponseDTO{}
+}
+
+func (p *ListSessionsResponseDTO) InitDefault() {
+}
+
+var ListSessionsResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+
+func (p *ListSessionsResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return ListSessionsResponseDTO_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var ListSessionsResponseDTO_Sessions_DEFAULT []*SessionDTO
+
+func (p *ListSessionsResponseDTO) GetSessions() (v []*SessionDTO) {
+	if !p.IsSetSessions() {
+		return ListSessionsResponseDTO_Sessions_DEFAULT
+	}
+	return p.Sessions
+}
+
+var fieldIDToName_ListSessionsResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "sessions",
+}
+
+func (p *ListSessionsResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListSessionsResponseDTO) IsSetSessions() bool {
+	return p.Sessions != nil
+}
+
+func (p *ListSessionsResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListSessionsResponseDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListSessionsResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ListSessionsResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*SessionDTO, 0, size)
+	values := make([]SessionDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Sessions = _field
+	return nil
+}
+
+func (p *ListSessionsResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListSessionsResponseDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListSessionsResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListSessionsResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSessions() {
+		if err = oprot.WriteFieldBegin("sessions", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Sessions)); err != nil {
+			return err
+		}
+		for _, v := range p.Sessions {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListSessionsResponseDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListSessionsResponseDTO(%+v)", *p)
+
+}
+
+/**
+ * 吊销当前用户指定会话请求
+ */
+type RevokeMySessionRequestDTO struct {
+	/** 会话ID */
+	SessionID *string `thrift:"sessionID,1,optional" json:"-" path:"sessionID" vd:"@:len($)==36; msg:'会话ID格式不正确'"`
+}
+
+func NewRevokeMySessionRequestDTO() *RevokeMySessionRequestDTO {
+	return &RevokeMySessionRequestDTO{}
+}
+
+func (p *RevokeMySessionRequestDTO) InitDefault() {
+}
+
+var RevokeMySessionRequestDTO_SessionID_DEFAULT string
+
+func (p *RevokeMySessionRequestDTO) GetSessionID() (v string) {
+	if !p.IsSetSessionID() {
+		return RevokeMySessionRequestDTO_SessionID_DEFAULT
+	}
+	return *p.SessionID
+}
+
+var fieldIDToName_RevokeMySessionRequestDTO = map[int16]string{
+	1: "sessionID",
+}
+
+func (p *RevokeMySessionRequestDTO) IsSetSessionID() bool {
+	return p.SessionID != nil
+}
+
+func (p *RevokeMySessionRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevokeMySessionRequestDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *RevokeMySessionRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SessionID = _field
+	return nil
+}
+
+func (p *RevokeMySessionRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RevokeMySessionRequestDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *RevokeMySessionRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSessionID() {
+		if err = oprot.WriteFieldBegin("sessionID", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SessionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *RevokeMySessionRequestDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RevokeMySessionRequestDTO(%+v)", *p)
+
+}
+
+/**
+ * 获取指定用户会话列表请求（管理员）
+ */
+type ListUserSessionsRequestDTO struct {
+	/** 用户ID */
+	UserID *string `thrift:"userID,1,optional" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+}
+
+func NewListUserSessionsRequestDTO() *ListUserSessionsRequestDTO {
+	return &ListUserSessionsRequestDTO{}
+}
+
+func (p *ListUserSessionsRequestDTO) InitDefault() {
+}
+
+var ListUserSessionsRequestDTO_UserID_DEFAULT string
+
+func (p *ListUserSessionsRequestDTO) GetUserID() (v string) {
+	if !p.IsSetUserID() {
+		return ListUserSessionsRequestDTO_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var fieldIDToName_ListUserSessionsRequestDTO = map[int16]string{
+	1: "userID",
+}
+
+func (p *ListUserSessionsRequestDTO) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *ListUserSessionsRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListUserSessionsRequestDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListUserSessionsRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UserID = _field
+	return nil
+}
+
+func (p *ListUserSessionsRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListUserSessionsRequestDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListUserSessionsRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserID() {
+		if err = oprot.WriteFieldBegin("userID", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UserID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListUserSessionsRequestDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListUserSessionsRequestDTO(%+v)", *p)
+
+}
+
+/**
+ * 吊销指定用户的单个会话请求（管理员）
+ */
+type RevokeUserSessionRequestDTO struct {
+	/** 用户ID */
+	UserID *string `thrift:"userID,1,optional" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
+	/** 会话ID */
+	SessionID *string `thrift:"sessionID,2,optional" json:"-" path:"sessionID" vd:"@:len($)==36; msg:'会话ID格式不正确'"`
 }
 
-func NewRefreshTokenRequestDTO() *RefreshTokenRequestDTO {
-	return &RefreshTokenRequestDTO{}
+func NewRevokeUserSessionRequestDTO() *RevokeUserSessionRequestDTO {
+	return &RevokeUserSessionRequestDTO{}
 }
 
-func (p *RefreshTokenRequestDTO) InitDefault() {
+func (p *RevokeUserSessionRequestDTO) InitDefault() {
 }
 
-var RefreshTokenRequestDTO_RefreshToken_DEFAULT string
+var RevokeUserSessionRequestDTO_UserID_DEFAULT string
 
-func (p *RefreshTokenRequestDTO) GetRefreshToken() (v string) {
-	if !p.IsSetRefreshToken() {
-		return RefreshTokenRequestDTO_RefreshToken_DEFAULT
+func (p *RevokeUserSessionRequestDTO) GetUserID() (v string) {
+	if !p.IsSetUserID() {
+		return RevokeUserSessionRequestDTO_UserID_DEFAULT
 	}
-	return *p.RefreshToken
+	return *p.UserID
 }
 
-var fieldIDToName_RefreshTokenRequestDTO = map[int16]string{
-	1: "refreshToken",
+var RevokeUserSessionRequestDTO_SessionID_DEFAULT string
+
+func (p *RevokeUserSessionRequestDTO) GetSessionID() (v string) {
+	if !p.IsSetSessionID() {
+		return RevokeUserSessionRequestDTO_SessionID_DEFAULT
+	}
+	return *p.SessionID
 }
 
-func (p *RefreshTokenRequestDTO) IsSetRefreshToken() bool {
-	return p.RefreshToken != nil
+var fieldIDToName_RevokeUserSessionRequestDTO = map[int16]string{
+	1: "userID",
+	2: "sessionID",
 }
 
-func (p *RefreshTokenRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *RevokeUserSessionRequestDTO) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *RevokeUserSessionRequestDTO) IsSetSessionID() bool {
+	return p.SessionID != nil
+}
+
+func (p *RevokeUserSessionRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1643,6 +4653,14 @@ func (p *RefreshTokenRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1662,7 +4680,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RefreshTokenRequestDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevokeUserSessionRequestDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1672,7 +4690,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RefreshTokenRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+func (p *RevokeUserSessionRequestDTO) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -1680,13 +4698,24 @@ func (p *RefreshTokenRequestDTO) ReadField1(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.RefreshToken = _field
+	p.UserID = _field
 	return nil
 }
+func (p *RevokeUserSessionRequestDTO) ReadField2(iprot thrift.TProtocol) error {
 
-func (p *RefreshTokenRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SessionID = _field
+	return nil
+}
+
+func (p *RevokeUserSessionRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RefreshTokenRequestDTO"); err != nil {
+	if err = oprot.WriteStructBegin("RevokeUserSessionRequestDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1694,6 +4723,10 @@ func (p *RefreshTokenRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1712,12 +4745,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RefreshTokenRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRefreshToken() {
-		if err = oprot.WriteFieldBegin("refreshToken", thrift.STRING, 1); err != nil {
+func (p *RevokeUserSessionRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserID() {
+		if err = oprot.WriteFieldBegin("userID", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.RefreshToken); err != nil {
+		if err := oprot.WriteString(*p.UserID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1731,64 +4764,66 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RefreshTokenRequestDTO) String() string {
+func (p *RevokeUserSessionRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSessionID() {
+		if err = oprot.WriteFieldBegin("sessionID", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SessionID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *RevokeUserSessionRequestDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RefreshTokenRequestDTO(%+v)", *p)
+	return fmt.Sprintf("RevokeUserSessionRequestDTO(%+v)", *p)
 
 }
 
 /**
- * 刷新令牌响应
- * 返回新的访问令牌和刷新令牌
+ * 吊销指定用户全部会话请求（管理员）
  */
-type RefreshTokenResponseDTO struct {
-	/** 基础响应信息 */
-	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
-	/** 新的令牌信息 */
-	TokenInfo *http_base.TokenInfoDTO `thrift:"tokenInfo,2,optional" json:"token_info,omitempty" form:"tokenInfo" query:"tokenInfo"`
-}
-
-func NewRefreshTokenResponseDTO() *RefreshTokenResponseDTO {
-	return &RefreshTokenResponseDTO{}
+type RevokeUserSessionsRequestDTO struct {
+	/** 用户ID */
+	UserID *string `thrift:"userID,1,optional" json:"-" path:"userID" vd:"@:len($)==36; msg:'用户ID格式不正确'"`
 }
 
-func (p *RefreshTokenResponseDTO) InitDefault() {
+func NewRevokeUserSessionsRequestDTO() *RevokeUserSessionsRequestDTO {
+	return &RevokeUserSessionsRequestDTO{}
 }
 
-var RefreshTokenResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
-
-func (p *RefreshTokenResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
-	if !p.IsSetBaseResp() {
-		return RefreshTokenResponseDTO_BaseResp_DEFAULT
-	}
-	return p.BaseResp
+func (p *RevokeUserSessionsRequestDTO) InitDefault() {
 }
 
-var RefreshTokenResponseDTO_TokenInfo_DEFAULT *http_base.TokenInfoDTO
+var RevokeUserSessionsRequestDTO_UserID_DEFAULT string
 
-func (p *RefreshTokenResponseDTO) GetTokenInfo() (v *http_base.TokenInfoDTO) {
-	if !p.IsSetTokenInfo() {
-		return RefreshTokenResponseDTO_TokenInfo_DEFAULT
+func (p *RevokeUserSessionsRequestDTO) GetUserID() (v string) {
+	if !p.IsSetUserID() {
+		return RevokeUserSessionsRequestDTO_UserID_DEFAULT
 	}
-	return p.TokenInfo
-}
-
-var fieldIDToName_RefreshTokenResponseDTO = map[int16]string{
-	1: "baseResp",
-	2: "tokenInfo",
+	return *p.UserID
 }
 
-func (p *RefreshTokenResponseDTO) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+var fieldIDToName_RevokeUserSessionsRequestDTO = map[int16]string{
+	1: "userID",
 }
 
-func (p *RefreshTokenResponseDTO) IsSetTokenInfo() bool {
-	return p.TokenInfo != nil
+func (p *RevokeUserSessionsRequestDTO) IsSetUserID() bool {
+	return p.UserID != nil
 }
 
-func (p *RefreshTokenResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *RevokeUserSessionsRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -1808,21 +4843,13 @@ func (p *RefreshTokenResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1842,7 +4869,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RefreshTokenResponseDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_RevokeUserSessionsRequestDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -1852,26 +4879,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *RefreshTokenResponseDTO) ReadField1(iprot thrift.TProtocol) error {
-	_field := http_base.NewBaseResponseDTO()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.BaseResp = _field
-	return nil
-}
-func (p *RefreshTokenResponseDTO) ReadField2(iprot thrift.TProtocol) error {
-	_field := http_base.NewTokenInfoDTO()
-	if err := _field.Read(iprot); err != nil {
+func (p *RevokeUserSessionsRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.TokenInfo = _field
+	p.UserID = _field
 	return nil
 }
 
-func (p *RefreshTokenResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *RevokeUserSessionsRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("RefreshTokenResponseDTO"); err != nil {
+	if err = oprot.WriteStructBegin("RevokeUserSessionsRequestDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -1879,10 +4901,6 @@ func (p *RefreshTokenResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1901,12 +4919,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *RefreshTokenResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseResp() {
-		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+func (p *RevokeUserSessionsRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserID() {
+		if err = oprot.WriteFieldBegin("userID", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.BaseResp.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.UserID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -1920,30 +4938,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *RefreshTokenResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTokenInfo() {
-		if err = oprot.WriteFieldBegin("tokenInfo", thrift.STRUCT, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.TokenInfo.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *RefreshTokenResponseDTO) String() string {
+func (p *RevokeUserSessionsRequestDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("RefreshTokenResponseDTO(%+v)", *p)
+	return fmt.Sprintf("RevokeUserSessionsRequestDTO(%+v)", *p)
 
 }
 
@@ -2006,6 +5005,8 @@ type UserProfileDTO struct {
 	PrimaryOrganizationID *string `thrift:"primaryOrganizationID,24,optional" json:"primary_organization_id,omitempty" form:"primaryOrganizationID" query:"primaryOrganizationID"`
 	/** 主部门ID */
 	PrimaryDepartmentID *string `thrift:"primaryDepartmentID,25,optional" json:"primary_department_id,omitempty" form:"primaryDepartmentID" query:"primaryDepartmentID"`
+	/** 是否已启用多因素认证 */
+	MfaEnabled *bool `thrift:"mfaEnabled,26,optional" json:"mfa_enabled" form:"mfaEnabled" query:"mfaEnabled"`
 }
 
 func NewUserProfileDTO() *UserProfileDTO {
@@ -2240,6 +5241,15 @@ func (p *UserProfileDTO) GetPrimaryDepartmentID() (v string) {
 	return *p.PrimaryDepartmentID
 }
 
+var UserProfileDTO_MfaEnabled_DEFAULT bool
+
+func (p *UserProfileDTO) GetMfaEnabled() (v bool) {
+	if !p.IsSetMfaEnabled() {
+		return UserProfileDTO_MfaEnabled_DEFAULT
+	}
+	return *p.MfaEnabled
+}
+
 var fieldIDToName_UserProfileDTO = map[int16]string{
 	1:  "id",
 	2:  "username",
@@ -2266,6 +5276,7 @@ var fieldIDToName_UserProfileDTO = map[int16]string{
 	23: "roleIDs",
 	24: "primaryOrganizationID",
 	25: "primaryDepartmentID",
+	26: "mfaEnabled",
 }
 
 func (p *UserProfileDTO) IsSetID() bool {
@@ -2368,6 +5379,10 @@ func (p *UserProfileDTO) IsSetPrimaryDepartmentID() bool {
 	return p.PrimaryDepartmentID != nil
 }
 
+func (p *UserProfileDTO) IsSetMfaEnabled() bool {
+	return p.MfaEnabled != nil
+}
+
 func (p *UserProfileDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -2587,6 +5602,14 @@ func (p *UserProfileDTO) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 26:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField26(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2915,6 +5938,17 @@ func (p *UserProfileDTO) ReadField25(iprot thrift.TProtocol) error {
 	p.PrimaryDepartmentID = _field
 	return nil
 }
+func (p *UserProfileDTO) ReadField26(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MfaEnabled = _field
+	return nil
+}
 
 func (p *UserProfileDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -3022,6 +6056,10 @@ func (p *UserProfileDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 25
 			goto WriteFieldError
 		}
+		if err = p.writeField26(oprot); err != nil {
+			fieldId = 26
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3531,6 +6569,25 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 25 end error: ", p), err)
 }
 
+func (p *UserProfileDTO) writeField26(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMfaEnabled() {
+		if err = oprot.WriteFieldBegin("mfaEnabled", thrift.BOOL, 26); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.MfaEnabled); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 26 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 26 end error: ", p), err)
+}
+
 func (p *UserProfileDTO) String() string {
 	if p == nil {
 		return "<nil>"
@@ -7018,6 +10075,8 @@ type ListUsersRequestDTO struct {
 	OrganizationID *string `thrift:"organizationID,2,optional" json:"organization_id,omitempty" query:"organization_id" `
 	/** 按用户状态筛选 */
 	Status *int32 `thrift:"status,3,optional" json:"status,omitempty" query:"status" `
+	/** 仅返回在该时间（毫秒时间戳）之前到期、尚未过期的账户，按过期时间升序 */
+	AccountExpiresBefore *core.TimestampMS `thrift:"accountExpiresBefore,4,optional" json:"account_expires_before,omitempty" query:"account_expires_before" `
 }
 
 func NewListUsersRequestDTO() *ListUsersRequestDTO {
@@ -7054,10 +10113,20 @@ func (p *ListUsersRequestDTO) GetStatus() (v int32) {
 	return *p.Status
 }
 
+var ListUsersRequestDTO_AccountExpiresBefore_DEFAULT core.TimestampMS
+
+func (p *ListUsersRequestDTO) GetAccountExpiresBefore() (v core.TimestampMS) {
+	if !p.IsSetAccountExpiresBefore() {
+		return ListUsersRequestDTO_AccountExpiresBefore_DEFAULT
+	}
+	return *p.AccountExpiresBefore
+}
+
 var fieldIDToName_ListUsersRequestDTO = map[int16]string{
 	1: "page",
 	2: "organizationID",
 	3: "status",
+	4: "accountExpiresBefore",
 }
 
 func (p *ListUsersRequestDTO) IsSetPage() bool {
@@ -7072,6 +10141,10 @@ func (p *ListUsersRequestDTO) IsSetStatus() bool {
 	return p.Status != nil
 }
 
+func (p *ListUsersRequestDTO) IsSetAccountExpiresBefore() bool {
+	return p.AccountExpiresBefore != nil
+}
+
 func (p *ListUsersRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -7115,6 +10188,14 @@ func (p *ListUsersRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -7174,6 +10255,17 @@ func (p *ListUsersRequestDTO) ReadField3(iprot thrift.TProtocol) error {
 	p.Status = _field
 	return nil
 }
+func (p *ListUsersRequestDTO) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *core.TimestampMS
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.AccountExpiresBefore = _field
+	return nil
+}
 
 func (p *ListUsersRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -7193,6 +10285,10 @@ func (p *ListUsersRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -7268,6 +10364,25 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
+func (p *ListUsersRequestDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAccountExpiresBefore() {
+		if err = oprot.WriteFieldBegin("accountExpiresBefore", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.AccountExpiresBefore); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
 func (p *ListUsersRequestDTO) String() string {
 	if p == nil {
 		return "<nil>"
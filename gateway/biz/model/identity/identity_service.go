@@ -58,6 +58,51 @@ type IdentityService interface {
 	 * 使用刷新令牌获取新的访问令牌
 	 */
 	RefreshToken(ctx context.Context, req *RefreshTokenRequestDTO) (r *RefreshTokenResponseDTO, err error)
+	/**
+	 * 多因素认证校验
+	 * 登录第二步：校验挑战令牌与验证码，通过后返回访问令牌和用户信息
+	 */
+	VerifyMFA(ctx context.Context, req *MFAVerifyRequestDTO) (r *LoginResponseDTO, err error)
+	/**
+	 * 切换当前组织
+	 * 按目标组织重新计算菜单、角色与权限，并签发代表该组织的访问令牌
+	 */
+	SwitchOrganization(ctx context.Context, req *SwitchOrganizationRequestDTO) (r *LoginResponseDTO, err error)
+	/**
+	 * 开始绑定多因素认证
+	 * 为当前用户生成 TOTP 密钥，确认前不生效
+	 */
+	EnrollMFA(ctx context.Context) (r *MFAEnrollResponseDTO, err error)
+	/**
+	 * 确认绑定多因素认证
+	 * 提交认证器生成的验证码，成功后启用多因素认证并返回恢复码
+	 */
+	ConfirmMFAEnrollment(ctx context.Context, req *MFACodeRequestDTO) (r *MFARecoveryCodesResponseDTO, err error)
+	/**
+	 * 重新生成恢复码
+	 * 提交 TOTP 验证码后生成新的恢复码，原有恢复码全部失效
+	 */
+	RegenerateMFARecoveryCodes(ctx context.Context, req *MFACodeRequestDTO) (r *MFARecoveryCodesResponseDTO, err error)
+	/**
+	 * 关闭多因素认证
+	 * 提交 TOTP 验证码或恢复码后关闭当前用户的多因素认证
+	 */
+	DisableMFA(ctx context.Context, req *MFACodeRequestDTO) (r *http_base.OperationStatusResponseDTO, err error)
+	/**
+	 * 获取当前用户的登录会话
+	 * 列出当前用户所有有效的登录会话（设备、IP、登录时间、最近活跃时间）
+	 */
+	ListMySessions(ctx context.Context) (r *ListSessionsResponseDTO, err error)
+	/**
+	 * 吊销当前用户的指定会话
+	 * 使指定会话的访问令牌与刷新令牌立即失效
+	 */
+	RevokeMySession(ctx context.Context, req *RevokeMySessionRequestDTO) (r *http_base.OperationStatusResponseDTO, err error)
+	/**
+	 * 吊销当前用户的其他会话
+	 * 保留当前会话，使其他设备上的登录全部失效
+	 */
+	RevokeMyOtherSessions(ctx context.Context) (r *http_base.OperationStatusResponseDTO, err error)
 	// =================================================================
 	// 2. 用户管理模块 (User Management)
 	// =================================================================
@@ -111,6 +156,21 @@ type IdentityService interface {
 	 * 管理员解锁被锁定的用户
 	 */
 	UnlockUser(ctx context.Context, req *UnlockUserRequestDTO) (r *http_base.OperationStatusResponseDTO, err error)
+	/**
+	 * 获取用户登录会话
+	 * 管理员查看指定用户所有有效的登录会话
+	 */
+	ListUserSessions(ctx context.Context, req *ListUserSessionsRequestDTO) (r *ListSessionsResponseDTO, err error)
+	/**
+	 * 吊销用户指定会话
+	 * 管理员强制下线指定用户的单个会话
+	 */
+	RevokeUserSession(ctx context.Context, req *RevokeUserSessionRequestDTO) (r *http_base.OperationStatusResponseDTO, err error)
+	/**
+	 * 吊销用户全部会话
+	 * 管理员强制下线指定用户的所有会话
+	 */
+	RevokeUserSessions(ctx context.Context, req *RevokeUserSessionsRequestDTO) (r *http_base.OperationStatusResponseDTO, err error)
 	// =================================================================
 	// 3. 成员关系管理模块 (Membership Management)
 	// =================================================================
@@ -292,6 +352,84 @@ func (p *IdentityServiceClient) RefreshToken(ctx context.Context, req *RefreshTo
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *IdentityServiceClient) VerifyMFA(ctx context.Context, req *MFAVerifyRequestDTO) (r *LoginResponseDTO, err error) {
+	var _args IdentityServiceVerifyMFAArgs
+	_args.Req = req
+	var _result IdentityServiceVerifyMFAResult
+	if err = p.Client_().Call(ctx, "verifyMFA", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *IdentityServiceClient) SwitchOrganization(ctx context.Context, req *SwitchOrganizationRequestDTO) (r *LoginResponseDTO, err error) {
+	var _args IdentityServiceSwitchOrganizationArgs
+	_args.Req = req
+	var _result IdentityServiceSwitchOrganizationResult
+	if err = p.Client_().Call(ctx, "switchOrganization", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *IdentityServiceClient) EnrollMFA(ctx context.Context) (r *MFAEnrollResponseDTO, err error) {
+	var _args IdentityServiceEnrollMFAArgs
+	var _result IdentityServiceEnrollMFAResult
+	if err = p.Client_().Call(ctx, "enrollMFA", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *IdentityServiceClient) ConfirmMFAEnrollment(ctx context.Context, req *MFACodeRequestDTO) (r *MFARecoveryCodesResponseDTO, err error) {
+	var _args IdentityServiceConfirmMFAEnrollmentArgs
+	_args.Req = req
+	var _result IdentityServiceConfirmMFAEnrollmentResult
+	if err = p.Client_().Call(ctx, "confirmMFAEnrollment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *IdentityServiceClient) RegenerateMFARecoveryCodes(ctx context.Context, req *MFACodeRequestDTO) (r *MFARecoveryCodesResponseDTO, err error) {
+	var _args IdentityServiceRegenerateMFARecoveryCodesArgs
+	_args.Req = req
+	var _result IdentityServiceRegenerateMFARecoveryCodesResult
+	if err = p.Client_().Call(ctx, "regenerateMFARecoveryCodes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *IdentityServiceClient) DisableMFA(ctx context.Context, req *MFACodeRequestDTO) (r *http_base.OperationStatusResponseDTO, err error) {
+	var _args IdentityServiceDisableMFAArgs
+	_args.Req = req
+	var _result IdentityServiceDisableMFAResult
+	if err = p.Client_().Call(ctx, "disableMFA", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *IdentityServiceClient) ListMySessions(ctx context.Context) (r *ListSessionsResponseDTO, err error) {
+	var _args IdentityServiceListMySessionsArgs
+	var _result IdentityServiceListMySessionsResult
+	if err = p.Client_().Call(ctx, "listMySessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *IdentityServiceClient) RevokeMySession(ctx context.Context, req *RevokeMySessionRequestDTO) (r *http_base.OperationStatusResponseDTO, err error) {
+	var _args IdentityServiceRevokeMySessionArgs
+	_args.Req = req
+	var _result IdentityServiceRevokeMySessionResult
+	if err = p.Client_().Call(ctx, "revokeMySession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *IdentityServiceClient) RevokeMyOtherSessions(ctx context.Context) (r *http_base.OperationStatusResponseDTO, err error) {
+	var _args IdentityServiceRevokeMyOtherSessionsArgs
+	var _result IdentityServiceRevokeMyOtherSessionsResult
+	if err = p.Client_().Call(ctx, "revokeMyOtherSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *IdentityServiceClient) CreateUser(ctx context.Context, req *CreateUserRequestDTO) (r *UserProfileResponseDTO, err error) {
 	var _args IdentityServiceCreateUserArgs
 	_args.Req = req
@@ -381,6 +519,33 @@ func (p *IdentityServiceClient) UnlockUser(ctx context.Context, req *UnlockUserR
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *IdentityServiceClient) ListUserSessions(ctx context.Context, req *ListUserSessionsRequestDTO) (r *ListSessionsResponseDTO, err error) {
+	var _args IdentityServiceListUserSessionsArgs
+	_args.Req = req
+	var _result IdentityServiceListUserSessionsResult
+	if err = p.Client_().Call(ctx, "listUserSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *IdentityServiceClient) RevokeUserSession(ctx context.Context, req *RevokeUserSessionRequestDTO) (r *http_base.OperationStatusResponseDTO, err error) {
+	var _args IdentityServiceRevokeUserSessionArgs
+	_args.Req = req
+	var _result IdentityServiceRevokeUserSessionResult
+	if err = p.Client_().Call(ctx, "revokeUserSession", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *IdentityServiceClient) RevokeUserSessions(ctx context.Context, req *RevokeUserSessionsRequestDTO) (r *http_base.OperationStatusResponseDTO, err error) {
+	var _args IdentityServiceRevokeUserSessionsArgs
+	_args.Req = req
+	var _result IdentityServiceRevokeUserSessionsResult
+	if err = p.Client_().Call(ctx, "revokeUserSessions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *IdentityServiceClient) GetUserMemberships(ctx context.Context, req *GetUserMembershipsRequestDTO) (r *GetUserMembershipsResponseDTO, err error) {
 	var _args IdentityServiceGetUserMembershipsArgs
 	_args.Req = req
@@ -561,6 +726,15 @@ func NewIdentityServiceProcessor(handler IdentityService) *IdentityServiceProces
 	self.AddToProcessorMap("resetPassword", &identityServiceProcessorResetPassword{handler: handler})
 	self.AddToProcessorMap("forcePasswordChange", &identityServiceProcessorForcePasswordChange{handler: handler})
 	self.AddToProcessorMap("refreshToken", &identityServiceProcessorRefreshToken{handler: handler})
+	self.AddToProcessorMap("verifyMFA", &identityServiceProcessorVerifyMFA{handler: handler})
+	self.AddToProcessorMap("switchOrganization", &identityServiceProcessorSwitchOrganization{handler: handler})
+	self.AddToProcessorMap("enrollMFA", &identityServiceProcessorEnrollMFA{handler: handler})
+	self.AddToProcessorMap("confirmMFAEnrollment", &identityServiceProcessorConfirmMFAEnrollment{handler: handler})
+	self.AddToProcessorMap("regenerateMFARecoveryCodes", &identityServiceProcessorRegenerateMFARecoveryCodes{handler: handler})
+	self.AddToProcessorMap("disableMFA", &identityServiceProcessorDisableMFA{handler: handler})
+	self.AddToProcessorMap("listMySessions", &identityServiceProcessorListMySessions{handler: handler})
+	self.AddToProcessorMap("revokeMySession", &identityServiceProcessorRevokeMySession{handler: handler})
+	self.AddToProcessorMap("revokeMyOtherSessions", &identityServiceProcessorRevokeMyOtherSessions{handler: handler})
 	self.AddToProcessorMap("createUser", &identityServiceProcessorCreateUser{handler: handler})
 	self.AddToProcessorMap("getUser", &identityServiceProcessorGetUser{handler: handler})
 	self.AddToProcessorMap("getMe", &identityServiceProcessorGetMe{handler: handler})
@@ -571,6 +745,9 @@ func NewIdentityServiceProcessor(handler IdentityService) *IdentityServiceProces
 	self.AddToProcessorMap("searchUsers", &identityServiceProcessorSearchUsers{handler: handler})
 	self.AddToProcessorMap("changeUserStatus", &identityServiceProcessorChangeUserStatus{handler: handler})
 	self.AddToProcessorMap("unlockUser", &identityServiceProcessorUnlockUser{handler: handler})
+	self.AddToProcessorMap("listUserSessions", &identityServiceProcessorListUserSessions{handler: handler})
+	self.AddToProcessorMap("revokeUserSession", &identityServiceProcessorRevokeUserSession{handler: handler})
+	self.AddToProcessorMap("revokeUserSessions", &identityServiceProcessorRevokeUserSessions{handler: handler})
 	self.AddToProcessorMap("getUserMemberships", &identityServiceProcessorGetUserMemberships{handler: handler})
 	self.AddToProcessorMap("getPrimaryMembership", &identityServiceProcessorGetPrimaryMembership{handler: handler})
 	self.AddToProcessorMap("checkMembership", &identityServiceProcessorCheckMembership{handler: handler})
@@ -896,16 +1073,16 @@ func (p *identityServiceProcessorRefreshToken) Process(ctx context.Context, seqI
 	return true, err
 }
 
-type identityServiceProcessorCreateUser struct {
+type identityServiceProcessorVerifyMFA struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorCreateUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceCreateUserArgs{}
+func (p *identityServiceProcessorVerifyMFA) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceVerifyMFAArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("createUser", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("verifyMFA", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -914,11 +1091,11 @@ func (p *identityServiceProcessorCreateUser) Process(ctx context.Context, seqId
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceCreateUserResult{}
-	var retval *UserProfileResponseDTO
-	if retval, err2 = p.handler.CreateUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing createUser: "+err2.Error())
-		oprot.WriteMessageBegin("createUser", thrift.EXCEPTION, seqId)
+	result := IdentityServiceVerifyMFAResult{}
+	var retval *LoginResponseDTO
+	if retval, err2 = p.handler.VerifyMFA(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing verifyMFA: "+err2.Error())
+		oprot.WriteMessageBegin("verifyMFA", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -926,7 +1103,7 @@ func (p *identityServiceProcessorCreateUser) Process(ctx context.Context, seqId
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("createUser", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("verifyMFA", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -944,16 +1121,16 @@ func (p *identityServiceProcessorCreateUser) Process(ctx context.Context, seqId
 	return true, err
 }
 
-type identityServiceProcessorGetUser struct {
+type identityServiceProcessorSwitchOrganization struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorGetUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceGetUserArgs{}
+func (p *identityServiceProcessorSwitchOrganization) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceSwitchOrganizationArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getUser", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("switchOrganization", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -962,11 +1139,11 @@ func (p *identityServiceProcessorGetUser) Process(ctx context.Context, seqId int
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceGetUserResult{}
-	var retval *UserProfileResponseDTO
-	if retval, err2 = p.handler.GetUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getUser: "+err2.Error())
-		oprot.WriteMessageBegin("getUser", thrift.EXCEPTION, seqId)
+	result := IdentityServiceSwitchOrganizationResult{}
+	var retval *LoginResponseDTO
+	if retval, err2 = p.handler.SwitchOrganization(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing switchOrganization: "+err2.Error())
+		oprot.WriteMessageBegin("switchOrganization", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -974,7 +1151,7 @@ func (p *identityServiceProcessorGetUser) Process(ctx context.Context, seqId int
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("getUser", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("switchOrganization", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -992,16 +1169,16 @@ func (p *identityServiceProcessorGetUser) Process(ctx context.Context, seqId int
 	return true, err
 }
 
-type identityServiceProcessorGetMe struct {
+type identityServiceProcessorEnrollMFA struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorGetMe) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceGetMeArgs{}
+func (p *identityServiceProcessorEnrollMFA) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceEnrollMFAArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getMe", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("enrollMFA", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1010,11 +1187,11 @@ func (p *identityServiceProcessorGetMe) Process(ctx context.Context, seqId int32
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceGetMeResult{}
-	var retval *UserProfileResponseDTO
-	if retval, err2 = p.handler.GetMe(ctx); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getMe: "+err2.Error())
-		oprot.WriteMessageBegin("getMe", thrift.EXCEPTION, seqId)
+	result := IdentityServiceEnrollMFAResult{}
+	var retval *MFAEnrollResponseDTO
+	if retval, err2 = p.handler.EnrollMFA(ctx); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing enrollMFA: "+err2.Error())
+		oprot.WriteMessageBegin("enrollMFA", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1022,7 +1199,7 @@ func (p *identityServiceProcessorGetMe) Process(ctx context.Context, seqId int32
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("getMe", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("enrollMFA", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1040,16 +1217,16 @@ func (p *identityServiceProcessorGetMe) Process(ctx context.Context, seqId int32
 	return true, err
 }
 
-type identityServiceProcessorUpdateUser struct {
+type identityServiceProcessorConfirmMFAEnrollment struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorUpdateUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceUpdateUserArgs{}
+func (p *identityServiceProcessorConfirmMFAEnrollment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceConfirmMFAEnrollmentArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("updateUser", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("confirmMFAEnrollment", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1058,11 +1235,11 @@ func (p *identityServiceProcessorUpdateUser) Process(ctx context.Context, seqId
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceUpdateUserResult{}
-	var retval *UserProfileResponseDTO
-	if retval, err2 = p.handler.UpdateUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updateUser: "+err2.Error())
-		oprot.WriteMessageBegin("updateUser", thrift.EXCEPTION, seqId)
+	result := IdentityServiceConfirmMFAEnrollmentResult{}
+	var retval *MFARecoveryCodesResponseDTO
+	if retval, err2 = p.handler.ConfirmMFAEnrollment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing confirmMFAEnrollment: "+err2.Error())
+		oprot.WriteMessageBegin("confirmMFAEnrollment", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1070,7 +1247,7 @@ func (p *identityServiceProcessorUpdateUser) Process(ctx context.Context, seqId
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("updateUser", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("confirmMFAEnrollment", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1088,16 +1265,16 @@ func (p *identityServiceProcessorUpdateUser) Process(ctx context.Context, seqId
 	return true, err
 }
 
-type identityServiceProcessorUpdateMe struct {
+type identityServiceProcessorRegenerateMFARecoveryCodes struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorUpdateMe) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceUpdateMeArgs{}
+func (p *identityServiceProcessorRegenerateMFARecoveryCodes) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceRegenerateMFARecoveryCodesArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("updateMe", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("regenerateMFARecoveryCodes", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1106,11 +1283,11 @@ func (p *identityServiceProcessorUpdateMe) Process(ctx context.Context, seqId in
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceUpdateMeResult{}
-	var retval *UserProfileResponseDTO
-	if retval, err2 = p.handler.UpdateMe(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updateMe: "+err2.Error())
-		oprot.WriteMessageBegin("updateMe", thrift.EXCEPTION, seqId)
+	result := IdentityServiceRegenerateMFARecoveryCodesResult{}
+	var retval *MFARecoveryCodesResponseDTO
+	if retval, err2 = p.handler.RegenerateMFARecoveryCodes(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing regenerateMFARecoveryCodes: "+err2.Error())
+		oprot.WriteMessageBegin("regenerateMFARecoveryCodes", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1118,7 +1295,7 @@ func (p *identityServiceProcessorUpdateMe) Process(ctx context.Context, seqId in
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("updateMe", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("regenerateMFARecoveryCodes", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1136,16 +1313,16 @@ func (p *identityServiceProcessorUpdateMe) Process(ctx context.Context, seqId in
 	return true, err
 }
 
-type identityServiceProcessorDeleteUser struct {
+type identityServiceProcessorDisableMFA struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorDeleteUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceDeleteUserArgs{}
+func (p *identityServiceProcessorDisableMFA) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceDisableMFAArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("deleteUser", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("disableMFA", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1154,11 +1331,11 @@ func (p *identityServiceProcessorDeleteUser) Process(ctx context.Context, seqId
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceDeleteUserResult{}
+	result := IdentityServiceDisableMFAResult{}
 	var retval *http_base.OperationStatusResponseDTO
-	if retval, err2 = p.handler.DeleteUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteUser: "+err2.Error())
-		oprot.WriteMessageBegin("deleteUser", thrift.EXCEPTION, seqId)
+	if retval, err2 = p.handler.DisableMFA(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing disableMFA: "+err2.Error())
+		oprot.WriteMessageBegin("disableMFA", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1166,7 +1343,7 @@ func (p *identityServiceProcessorDeleteUser) Process(ctx context.Context, seqId
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("deleteUser", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("disableMFA", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1184,16 +1361,16 @@ func (p *identityServiceProcessorDeleteUser) Process(ctx context.Context, seqId
 	return true, err
 }
 
-type identityServiceProcessorListUsers struct {
+type identityServiceProcessorListMySessions struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorListUsers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceListUsersArgs{}
+func (p *identityServiceProcessorListMySessions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceListMySessionsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("listUsers", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("listMySessions", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1202,11 +1379,11 @@ func (p *identityServiceProcessorListUsers) Process(ctx context.Context, seqId i
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceListUsersResult{}
-	var retval *ListUsersResponseDTO
-	if retval, err2 = p.handler.ListUsers(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing listUsers: "+err2.Error())
-		oprot.WriteMessageBegin("listUsers", thrift.EXCEPTION, seqId)
+	result := IdentityServiceListMySessionsResult{}
+	var retval *ListSessionsResponseDTO
+	if retval, err2 = p.handler.ListMySessions(ctx); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing listMySessions: "+err2.Error())
+		oprot.WriteMessageBegin("listMySessions", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1214,7 +1391,7 @@ func (p *identityServiceProcessorListUsers) Process(ctx context.Context, seqId i
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("listUsers", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("listMySessions", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1232,16 +1409,16 @@ func (p *identityServiceProcessorListUsers) Process(ctx context.Context, seqId i
 	return true, err
 }
 
-type identityServiceProcessorSearchUsers struct {
+type identityServiceProcessorRevokeMySession struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorSearchUsers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceSearchUsersArgs{}
+func (p *identityServiceProcessorRevokeMySession) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceRevokeMySessionArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("searchUsers", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("revokeMySession", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1250,11 +1427,11 @@ func (p *identityServiceProcessorSearchUsers) Process(ctx context.Context, seqId
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceSearchUsersResult{}
-	var retval *SearchUsersResponseDTO
-	if retval, err2 = p.handler.SearchUsers(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing searchUsers: "+err2.Error())
-		oprot.WriteMessageBegin("searchUsers", thrift.EXCEPTION, seqId)
+	result := IdentityServiceRevokeMySessionResult{}
+	var retval *http_base.OperationStatusResponseDTO
+	if retval, err2 = p.handler.RevokeMySession(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing revokeMySession: "+err2.Error())
+		oprot.WriteMessageBegin("revokeMySession", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1262,7 +1439,7 @@ func (p *identityServiceProcessorSearchUsers) Process(ctx context.Context, seqId
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("searchUsers", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("revokeMySession", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1280,16 +1457,16 @@ func (p *identityServiceProcessorSearchUsers) Process(ctx context.Context, seqId
 	return true, err
 }
 
-type identityServiceProcessorChangeUserStatus struct {
+type identityServiceProcessorRevokeMyOtherSessions struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorChangeUserStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceChangeUserStatusArgs{}
+func (p *identityServiceProcessorRevokeMyOtherSessions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceRevokeMyOtherSessionsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("changeUserStatus", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("revokeMyOtherSessions", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1298,11 +1475,11 @@ func (p *identityServiceProcessorChangeUserStatus) Process(ctx context.Context,
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceChangeUserStatusResult{}
+	result := IdentityServiceRevokeMyOtherSessionsResult{}
 	var retval *http_base.OperationStatusResponseDTO
-	if retval, err2 = p.handler.ChangeUserStatus(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing changeUserStatus: "+err2.Error())
-		oprot.WriteMessageBegin("changeUserStatus", thrift.EXCEPTION, seqId)
+	if retval, err2 = p.handler.RevokeMyOtherSessions(ctx); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing revokeMyOtherSessions: "+err2.Error())
+		oprot.WriteMessageBegin("revokeMyOtherSessions", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1310,7 +1487,7 @@ func (p *identityServiceProcessorChangeUserStatus) Process(ctx context.Context,
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("changeUserStatus", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("revokeMyOtherSessions", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1328,16 +1505,16 @@ func (p *identityServiceProcessorChangeUserStatus) Process(ctx context.Context,
 	return true, err
 }
 
-type identityServiceProcessorUnlockUser struct {
+type identityServiceProcessorCreateUser struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorUnlockUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceUnlockUserArgs{}
+func (p *identityServiceProcessorCreateUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceCreateUserArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("unlockUser", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("createUser", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1346,11 +1523,11 @@ func (p *identityServiceProcessorUnlockUser) Process(ctx context.Context, seqId
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceUnlockUserResult{}
-	var retval *http_base.OperationStatusResponseDTO
-	if retval, err2 = p.handler.UnlockUser(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing unlockUser: "+err2.Error())
-		oprot.WriteMessageBegin("unlockUser", thrift.EXCEPTION, seqId)
+	result := IdentityServiceCreateUserResult{}
+	var retval *UserProfileResponseDTO
+	if retval, err2 = p.handler.CreateUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing createUser: "+err2.Error())
+		oprot.WriteMessageBegin("createUser", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1358,7 +1535,7 @@ func (p *identityServiceProcessorUnlockUser) Process(ctx context.Context, seqId
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("unlockUser", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("createUser", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1376,16 +1553,16 @@ func (p *identityServiceProcessorUnlockUser) Process(ctx context.Context, seqId
 	return true, err
 }
 
-type identityServiceProcessorGetUserMemberships struct {
+type identityServiceProcessorGetUser struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorGetUserMemberships) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceGetUserMembershipsArgs{}
+func (p *identityServiceProcessorGetUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceGetUserArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getUserMemberships", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("getUser", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1394,11 +1571,11 @@ func (p *identityServiceProcessorGetUserMemberships) Process(ctx context.Context
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceGetUserMembershipsResult{}
-	var retval *GetUserMembershipsResponseDTO
-	if retval, err2 = p.handler.GetUserMemberships(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getUserMemberships: "+err2.Error())
-		oprot.WriteMessageBegin("getUserMemberships", thrift.EXCEPTION, seqId)
+	result := IdentityServiceGetUserResult{}
+	var retval *UserProfileResponseDTO
+	if retval, err2 = p.handler.GetUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getUser: "+err2.Error())
+		oprot.WriteMessageBegin("getUser", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1406,7 +1583,7 @@ func (p *identityServiceProcessorGetUserMemberships) Process(ctx context.Context
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("getUserMemberships", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("getUser", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1424,16 +1601,16 @@ func (p *identityServiceProcessorGetUserMemberships) Process(ctx context.Context
 	return true, err
 }
 
-type identityServiceProcessorGetPrimaryMembership struct {
+type identityServiceProcessorGetMe struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorGetPrimaryMembership) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceGetPrimaryMembershipArgs{}
+func (p *identityServiceProcessorGetMe) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceGetMeArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getPrimaryMembership", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("getMe", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1442,11 +1619,11 @@ func (p *identityServiceProcessorGetPrimaryMembership) Process(ctx context.Conte
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceGetPrimaryMembershipResult{}
-	var retval *UserMembershipResponseDTO
-	if retval, err2 = p.handler.GetPrimaryMembership(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getPrimaryMembership: "+err2.Error())
-		oprot.WriteMessageBegin("getPrimaryMembership", thrift.EXCEPTION, seqId)
+	result := IdentityServiceGetMeResult{}
+	var retval *UserProfileResponseDTO
+	if retval, err2 = p.handler.GetMe(ctx); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getMe: "+err2.Error())
+		oprot.WriteMessageBegin("getMe", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1454,7 +1631,7 @@ func (p *identityServiceProcessorGetPrimaryMembership) Process(ctx context.Conte
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("getPrimaryMembership", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("getMe", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1472,16 +1649,16 @@ func (p *identityServiceProcessorGetPrimaryMembership) Process(ctx context.Conte
 	return true, err
 }
 
-type identityServiceProcessorCheckMembership struct {
+type identityServiceProcessorUpdateUser struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorCheckMembership) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceCheckMembershipArgs{}
+func (p *identityServiceProcessorUpdateUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceUpdateUserArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("checkMembership", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("updateUser", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1490,11 +1667,11 @@ func (p *identityServiceProcessorCheckMembership) Process(ctx context.Context, s
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceCheckMembershipResult{}
-	var retval *http_base.OperationStatusResponseDTO
-	if retval, err2 = p.handler.CheckMembership(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing checkMembership: "+err2.Error())
-		oprot.WriteMessageBegin("checkMembership", thrift.EXCEPTION, seqId)
+	result := IdentityServiceUpdateUserResult{}
+	var retval *UserProfileResponseDTO
+	if retval, err2 = p.handler.UpdateUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updateUser: "+err2.Error())
+		oprot.WriteMessageBegin("updateUser", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1502,7 +1679,7 @@ func (p *identityServiceProcessorCheckMembership) Process(ctx context.Context, s
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("checkMembership", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("updateUser", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1520,16 +1697,16 @@ func (p *identityServiceProcessorCheckMembership) Process(ctx context.Context, s
 	return true, err
 }
 
-type identityServiceProcessorCreateOrganization struct {
+type identityServiceProcessorUpdateMe struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorCreateOrganization) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceCreateOrganizationArgs{}
+func (p *identityServiceProcessorUpdateMe) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceUpdateMeArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("createOrganization", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("updateMe", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1538,11 +1715,11 @@ func (p *identityServiceProcessorCreateOrganization) Process(ctx context.Context
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceCreateOrganizationResult{}
-	var retval *OrganizationResponseDTO
-	if retval, err2 = p.handler.CreateOrganization(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing createOrganization: "+err2.Error())
-		oprot.WriteMessageBegin("createOrganization", thrift.EXCEPTION, seqId)
+	result := IdentityServiceUpdateMeResult{}
+	var retval *UserProfileResponseDTO
+	if retval, err2 = p.handler.UpdateMe(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updateMe: "+err2.Error())
+		oprot.WriteMessageBegin("updateMe", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1550,7 +1727,7 @@ func (p *identityServiceProcessorCreateOrganization) Process(ctx context.Context
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("createOrganization", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("updateMe", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1568,16 +1745,16 @@ func (p *identityServiceProcessorCreateOrganization) Process(ctx context.Context
 	return true, err
 }
 
-type identityServiceProcessorGetOrganization struct {
+type identityServiceProcessorDeleteUser struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorGetOrganization) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceGetOrganizationArgs{}
+func (p *identityServiceProcessorDeleteUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceDeleteUserArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getOrganization", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("deleteUser", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1586,11 +1763,11 @@ func (p *identityServiceProcessorGetOrganization) Process(ctx context.Context, s
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceGetOrganizationResult{}
-	var retval *OrganizationResponseDTO
-	if retval, err2 = p.handler.GetOrganization(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getOrganization: "+err2.Error())
-		oprot.WriteMessageBegin("getOrganization", thrift.EXCEPTION, seqId)
+	result := IdentityServiceDeleteUserResult{}
+	var retval *http_base.OperationStatusResponseDTO
+	if retval, err2 = p.handler.DeleteUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteUser: "+err2.Error())
+		oprot.WriteMessageBegin("deleteUser", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1598,7 +1775,7 @@ func (p *identityServiceProcessorGetOrganization) Process(ctx context.Context, s
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("getOrganization", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("deleteUser", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1616,16 +1793,16 @@ func (p *identityServiceProcessorGetOrganization) Process(ctx context.Context, s
 	return true, err
 }
 
-type identityServiceProcessorUpdateOrganization struct {
+type identityServiceProcessorListUsers struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorUpdateOrganization) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceUpdateOrganizationArgs{}
+func (p *identityServiceProcessorListUsers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceListUsersArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("updateOrganization", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("listUsers", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1634,11 +1811,11 @@ func (p *identityServiceProcessorUpdateOrganization) Process(ctx context.Context
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceUpdateOrganizationResult{}
-	var retval *OrganizationResponseDTO
-	if retval, err2 = p.handler.UpdateOrganization(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updateOrganization: "+err2.Error())
-		oprot.WriteMessageBegin("updateOrganization", thrift.EXCEPTION, seqId)
+	result := IdentityServiceListUsersResult{}
+	var retval *ListUsersResponseDTO
+	if retval, err2 = p.handler.ListUsers(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing listUsers: "+err2.Error())
+		oprot.WriteMessageBegin("listUsers", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1646,7 +1823,7 @@ func (p *identityServiceProcessorUpdateOrganization) Process(ctx context.Context
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("updateOrganization", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("listUsers", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1664,16 +1841,16 @@ func (p *identityServiceProcessorUpdateOrganization) Process(ctx context.Context
 	return true, err
 }
 
-type identityServiceProcessorDeleteOrganization struct {
+type identityServiceProcessorSearchUsers struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorDeleteOrganization) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceDeleteOrganizationArgs{}
+func (p *identityServiceProcessorSearchUsers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceSearchUsersArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("deleteOrganization", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("searchUsers", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1682,11 +1859,11 @@ func (p *identityServiceProcessorDeleteOrganization) Process(ctx context.Context
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceDeleteOrganizationResult{}
-	var retval *http_base.OperationStatusResponseDTO
-	if retval, err2 = p.handler.DeleteOrganization(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteOrganization: "+err2.Error())
-		oprot.WriteMessageBegin("deleteOrganization", thrift.EXCEPTION, seqId)
+	result := IdentityServiceSearchUsersResult{}
+	var retval *SearchUsersResponseDTO
+	if retval, err2 = p.handler.SearchUsers(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing searchUsers: "+err2.Error())
+		oprot.WriteMessageBegin("searchUsers", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1694,7 +1871,7 @@ func (p *identityServiceProcessorDeleteOrganization) Process(ctx context.Context
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("deleteOrganization", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("searchUsers", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1712,16 +1889,16 @@ func (p *identityServiceProcessorDeleteOrganization) Process(ctx context.Context
 	return true, err
 }
 
-type identityServiceProcessorListOrganizations struct {
+type identityServiceProcessorChangeUserStatus struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorListOrganizations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceListOrganizationsArgs{}
+func (p *identityServiceProcessorChangeUserStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceChangeUserStatusArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("listOrganizations", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("changeUserStatus", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1730,11 +1907,11 @@ func (p *identityServiceProcessorListOrganizations) Process(ctx context.Context,
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceListOrganizationsResult{}
-	var retval *ListOrganizationsResponseDTO
-	if retval, err2 = p.handler.ListOrganizations(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing listOrganizations: "+err2.Error())
-		oprot.WriteMessageBegin("listOrganizations", thrift.EXCEPTION, seqId)
+	result := IdentityServiceChangeUserStatusResult{}
+	var retval *http_base.OperationStatusResponseDTO
+	if retval, err2 = p.handler.ChangeUserStatus(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing changeUserStatus: "+err2.Error())
+		oprot.WriteMessageBegin("changeUserStatus", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1742,7 +1919,7 @@ func (p *identityServiceProcessorListOrganizations) Process(ctx context.Context,
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("listOrganizations", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("changeUserStatus", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1760,16 +1937,16 @@ func (p *identityServiceProcessorListOrganizations) Process(ctx context.Context,
 	return true, err
 }
 
-type identityServiceProcessorCreateDepartment struct {
+type identityServiceProcessorUnlockUser struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorCreateDepartment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceCreateDepartmentArgs{}
+func (p *identityServiceProcessorUnlockUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceUnlockUserArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("createDepartment", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("unlockUser", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1778,11 +1955,11 @@ func (p *identityServiceProcessorCreateDepartment) Process(ctx context.Context,
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceCreateDepartmentResult{}
-	var retval *DepartmentResponseDTO
-	if retval, err2 = p.handler.CreateDepartment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing createDepartment: "+err2.Error())
-		oprot.WriteMessageBegin("createDepartment", thrift.EXCEPTION, seqId)
+	result := IdentityServiceUnlockUserResult{}
+	var retval *http_base.OperationStatusResponseDTO
+	if retval, err2 = p.handler.UnlockUser(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing unlockUser: "+err2.Error())
+		oprot.WriteMessageBegin("unlockUser", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1790,7 +1967,7 @@ func (p *identityServiceProcessorCreateDepartment) Process(ctx context.Context,
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("createDepartment", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("unlockUser", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1808,16 +1985,16 @@ func (p *identityServiceProcessorCreateDepartment) Process(ctx context.Context,
 	return true, err
 }
 
-type identityServiceProcessorGetDepartment struct {
+type identityServiceProcessorListUserSessions struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorGetDepartment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceGetDepartmentArgs{}
+func (p *identityServiceProcessorListUserSessions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceListUserSessionsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getDepartment", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("listUserSessions", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1826,11 +2003,11 @@ func (p *identityServiceProcessorGetDepartment) Process(ctx context.Context, seq
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceGetDepartmentResult{}
-	var retval *DepartmentResponseDTO
-	if retval, err2 = p.handler.GetDepartment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getDepartment: "+err2.Error())
-		oprot.WriteMessageBegin("getDepartment", thrift.EXCEPTION, seqId)
+	result := IdentityServiceListUserSessionsResult{}
+	var retval *ListSessionsResponseDTO
+	if retval, err2 = p.handler.ListUserSessions(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing listUserSessions: "+err2.Error())
+		oprot.WriteMessageBegin("listUserSessions", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1838,7 +2015,7 @@ func (p *identityServiceProcessorGetDepartment) Process(ctx context.Context, seq
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("getDepartment", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("listUserSessions", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1856,16 +2033,16 @@ func (p *identityServiceProcessorGetDepartment) Process(ctx context.Context, seq
 	return true, err
 }
 
-type identityServiceProcessorUpdateDepartment struct {
+type identityServiceProcessorRevokeUserSession struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorUpdateDepartment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceUpdateDepartmentArgs{}
+func (p *identityServiceProcessorRevokeUserSession) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceRevokeUserSessionArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("updateDepartment", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("revokeUserSession", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1874,11 +2051,11 @@ func (p *identityServiceProcessorUpdateDepartment) Process(ctx context.Context,
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceUpdateDepartmentResult{}
-	var retval *DepartmentResponseDTO
-	if retval, err2 = p.handler.UpdateDepartment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updateDepartment: "+err2.Error())
-		oprot.WriteMessageBegin("updateDepartment", thrift.EXCEPTION, seqId)
+	result := IdentityServiceRevokeUserSessionResult{}
+	var retval *http_base.OperationStatusResponseDTO
+	if retval, err2 = p.handler.RevokeUserSession(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing revokeUserSession: "+err2.Error())
+		oprot.WriteMessageBegin("revokeUserSession", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1886,7 +2063,7 @@ func (p *identityServiceProcessorUpdateDepartment) Process(ctx context.Context,
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("updateDepartment", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("revokeUserSession", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1904,16 +2081,16 @@ func (p *identityServiceProcessorUpdateDepartment) Process(ctx context.Context,
 	return true, err
 }
 
-type identityServiceProcessorDeleteDepartment struct {
+type identityServiceProcessorRevokeUserSessions struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorDeleteDepartment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceDeleteDepartmentArgs{}
+func (p *identityServiceProcessorRevokeUserSessions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceRevokeUserSessionsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("deleteDepartment", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("revokeUserSessions", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1922,11 +2099,11 @@ func (p *identityServiceProcessorDeleteDepartment) Process(ctx context.Context,
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceDeleteDepartmentResult{}
+	result := IdentityServiceRevokeUserSessionsResult{}
 	var retval *http_base.OperationStatusResponseDTO
-	if retval, err2 = p.handler.DeleteDepartment(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteDepartment: "+err2.Error())
-		oprot.WriteMessageBegin("deleteDepartment", thrift.EXCEPTION, seqId)
+	if retval, err2 = p.handler.RevokeUserSessions(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing revokeUserSessions: "+err2.Error())
+		oprot.WriteMessageBegin("revokeUserSessions", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1934,7 +2111,7 @@ func (p *identityServiceProcessorDeleteDepartment) Process(ctx context.Context,
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("deleteDepartment", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("revokeUserSessions", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1952,16 +2129,16 @@ func (p *identityServiceProcessorDeleteDepartment) Process(ctx context.Context,
 	return true, err
 }
 
-type identityServiceProcessorGetOrganizationDepartments struct {
+type identityServiceProcessorGetUserMemberships struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorGetOrganizationDepartments) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceGetOrganizationDepartmentsArgs{}
+func (p *identityServiceProcessorGetUserMemberships) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceGetUserMembershipsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getOrganizationDepartments", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("getUserMemberships", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1970,11 +2147,11 @@ func (p *identityServiceProcessorGetOrganizationDepartments) Process(ctx context
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceGetOrganizationDepartmentsResult{}
-	var retval *GetOrganizationDepartmentsResponseDTO
-	if retval, err2 = p.handler.GetOrganizationDepartments(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getOrganizationDepartments: "+err2.Error())
-		oprot.WriteMessageBegin("getOrganizationDepartments", thrift.EXCEPTION, seqId)
+	result := IdentityServiceGetUserMembershipsResult{}
+	var retval *GetUserMembershipsResponseDTO
+	if retval, err2 = p.handler.GetUserMemberships(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getUserMemberships: "+err2.Error())
+		oprot.WriteMessageBegin("getUserMemberships", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -1982,7 +2159,7 @@ func (p *identityServiceProcessorGetOrganizationDepartments) Process(ctx context
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("getOrganizationDepartments", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("getUserMemberships", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2000,16 +2177,16 @@ func (p *identityServiceProcessorGetOrganizationDepartments) Process(ctx context
 	return true, err
 }
 
-type identityServiceProcessorUploadTemporaryLogo struct {
+type identityServiceProcessorGetPrimaryMembership struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorUploadTemporaryLogo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceUploadTemporaryLogoArgs{}
+func (p *identityServiceProcessorGetPrimaryMembership) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceGetPrimaryMembershipArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("uploadTemporaryLogo", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("getPrimaryMembership", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -2018,11 +2195,11 @@ func (p *identityServiceProcessorUploadTemporaryLogo) Process(ctx context.Contex
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceUploadTemporaryLogoResult{}
-	var retval *OrganizationLogoResponseDTO
-	if retval, err2 = p.handler.UploadTemporaryLogo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing uploadTemporaryLogo: "+err2.Error())
-		oprot.WriteMessageBegin("uploadTemporaryLogo", thrift.EXCEPTION, seqId)
+	result := IdentityServiceGetPrimaryMembershipResult{}
+	var retval *UserMembershipResponseDTO
+	if retval, err2 = p.handler.GetPrimaryMembership(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getPrimaryMembership: "+err2.Error())
+		oprot.WriteMessageBegin("getPrimaryMembership", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -2030,7 +2207,7 @@ func (p *identityServiceProcessorUploadTemporaryLogo) Process(ctx context.Contex
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("uploadTemporaryLogo", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("getPrimaryMembership", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2048,16 +2225,16 @@ func (p *identityServiceProcessorUploadTemporaryLogo) Process(ctx context.Contex
 	return true, err
 }
 
-type identityServiceProcessorGetOrganizationLogo struct {
+type identityServiceProcessorCheckMembership struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorGetOrganizationLogo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceGetOrganizationLogoArgs{}
+func (p *identityServiceProcessorCheckMembership) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceCheckMembershipArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("getOrganizationLogo", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("checkMembership", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -2066,11 +2243,11 @@ func (p *identityServiceProcessorGetOrganizationLogo) Process(ctx context.Contex
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceGetOrganizationLogoResult{}
-	var retval *OrganizationLogoResponseDTO
-	if retval, err2 = p.handler.GetOrganizationLogo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getOrganizationLogo: "+err2.Error())
-		oprot.WriteMessageBegin("getOrganizationLogo", thrift.EXCEPTION, seqId)
+	result := IdentityServiceCheckMembershipResult{}
+	var retval *http_base.OperationStatusResponseDTO
+	if retval, err2 = p.handler.CheckMembership(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing checkMembership: "+err2.Error())
+		oprot.WriteMessageBegin("checkMembership", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -2078,7 +2255,7 @@ func (p *identityServiceProcessorGetOrganizationLogo) Process(ctx context.Contex
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("getOrganizationLogo", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("checkMembership", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2096,16 +2273,16 @@ func (p *identityServiceProcessorGetOrganizationLogo) Process(ctx context.Contex
 	return true, err
 }
 
-type identityServiceProcessorDeleteOrganizationLogo struct {
+type identityServiceProcessorCreateOrganization struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorDeleteOrganizationLogo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceDeleteOrganizationLogoArgs{}
+func (p *identityServiceProcessorCreateOrganization) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceCreateOrganizationArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("deleteOrganizationLogo", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("createOrganization", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -2114,11 +2291,11 @@ func (p *identityServiceProcessorDeleteOrganizationLogo) Process(ctx context.Con
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceDeleteOrganizationLogoResult{}
-	var retval *http_base.OperationStatusResponseDTO
-	if retval, err2 = p.handler.DeleteOrganizationLogo(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteOrganizationLogo: "+err2.Error())
-		oprot.WriteMessageBegin("deleteOrganizationLogo", thrift.EXCEPTION, seqId)
+	result := IdentityServiceCreateOrganizationResult{}
+	var retval *OrganizationResponseDTO
+	if retval, err2 = p.handler.CreateOrganization(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing createOrganization: "+err2.Error())
+		oprot.WriteMessageBegin("createOrganization", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -2126,7 +2303,7 @@ func (p *identityServiceProcessorDeleteOrganizationLogo) Process(ctx context.Con
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("deleteOrganizationLogo", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("createOrganization", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2144,16 +2321,16 @@ func (p *identityServiceProcessorDeleteOrganizationLogo) Process(ctx context.Con
 	return true, err
 }
 
-type identityServiceProcessorBindLogoToOrganization struct {
+type identityServiceProcessorGetOrganization struct {
 	handler IdentityService
 }
 
-func (p *identityServiceProcessorBindLogoToOrganization) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := IdentityServiceBindLogoToOrganizationArgs{}
+func (p *identityServiceProcessorGetOrganization) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceGetOrganizationArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("bindLogoToOrganization", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("getOrganization", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -2162,11 +2339,11 @@ func (p *identityServiceProcessorBindLogoToOrganization) Process(ctx context.Con
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := IdentityServiceBindLogoToOrganizationResult{}
+	result := IdentityServiceGetOrganizationResult{}
 	var retval *OrganizationResponseDTO
-	if retval, err2 = p.handler.BindLogoToOrganization(ctx, args.Req); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing bindLogoToOrganization: "+err2.Error())
-		oprot.WriteMessageBegin("bindLogoToOrganization", thrift.EXCEPTION, seqId)
+	if retval, err2 = p.handler.GetOrganization(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getOrganization: "+err2.Error())
+		oprot.WriteMessageBegin("getOrganization", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -2174,7 +2351,7 @@ func (p *identityServiceProcessorBindLogoToOrganization) Process(ctx context.Con
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("bindLogoToOrganization", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("getOrganization", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2192,35 +2369,3928 @@ func (p *identityServiceProcessorBindLogoToOrganization) Process(ctx context.Con
 	return true, err
 }
 
-type IdentityServiceLoginArgs struct {
-	Req *LoginRequestDTO `thrift:"req,1"`
-}
-
-func NewIdentityServiceLoginArgs() *IdentityServiceLoginArgs {
-	return &IdentityServiceLoginArgs{}
-}
-
-func (p *IdentityServiceLoginArgs) InitDefault() {
+type identityServiceProcessorUpdateOrganization struct {
+	handler IdentityService
 }
 
-var IdentityServiceLoginArgs_Req_DEFAULT *LoginRequestDTO
+func (p *identityServiceProcessorUpdateOrganization) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceUpdateOrganizationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("updateOrganization", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := IdentityServiceUpdateOrganizationResult{}
+	var retval *OrganizationResponseDTO
+	if retval, err2 = p.handler.UpdateOrganization(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updateOrganization: "+err2.Error())
+		oprot.WriteMessageBegin("updateOrganization", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("updateOrganization", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type identityServiceProcessorDeleteOrganization struct {
+	handler IdentityService
+}
+
+func (p *identityServiceProcessorDeleteOrganization) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceDeleteOrganizationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("deleteOrganization", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := IdentityServiceDeleteOrganizationResult{}
+	var retval *http_base.OperationStatusResponseDTO
+	if retval, err2 = p.handler.DeleteOrganization(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteOrganization: "+err2.Error())
+		oprot.WriteMessageBegin("deleteOrganization", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("deleteOrganization", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type identityServiceProcessorListOrganizations struct {
+	handler IdentityService
+}
+
+func (p *identityServiceProcessorListOrganizations) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceListOrganizationsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("listOrganizations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := IdentityServiceListOrganizationsResult{}
+	var retval *ListOrganizationsResponseDTO
+	if retval, err2 = p.handler.ListOrganizations(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing listOrganizations: "+err2.Error())
+		oprot.WriteMessageBegin("listOrganizations", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("listOrganizations", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type identityServiceProcessorCreateDepartment struct {
+	handler IdentityService
+}
+
+func (p *identityServiceProcessorCreateDepartment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceCreateDepartmentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("createDepartment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := IdentityServiceCreateDepartmentResult{}
+	var retval *DepartmentResponseDTO
+	if retval, err2 = p.handler.CreateDepartment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing createDepartment: "+err2.Error())
+		oprot.WriteMessageBegin("createDepartment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("createDepartment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type identityServiceProcessorGetDepartment struct {
+	handler IdentityService
+}
+
+func (p *identityServiceProcessorGetDepartment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceGetDepartmentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getDepartment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := IdentityServiceGetDepartmentResult{}
+	var retval *DepartmentResponseDTO
+	if retval, err2 = p.handler.GetDepartment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getDepartment: "+err2.Error())
+		oprot.WriteMessageBegin("getDepartment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("getDepartment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type identityServiceProcessorUpdateDepartment struct {
+	handler IdentityService
+}
+
+func (p *identityServiceProcessorUpdateDepartment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceUpdateDepartmentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("updateDepartment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := IdentityServiceUpdateDepartmentResult{}
+	var retval *DepartmentResponseDTO
+	if retval, err2 = p.handler.UpdateDepartment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing updateDepartment: "+err2.Error())
+		oprot.WriteMessageBegin("updateDepartment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("updateDepartment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type identityServiceProcessorDeleteDepartment struct {
+	handler IdentityService
+}
+
+func (p *identityServiceProcessorDeleteDepartment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceDeleteDepartmentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("deleteDepartment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := IdentityServiceDeleteDepartmentResult{}
+	var retval *http_base.OperationStatusResponseDTO
+	if retval, err2 = p.handler.DeleteDepartment(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteDepartment: "+err2.Error())
+		oprot.WriteMessageBegin("deleteDepartment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("deleteDepartment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type identityServiceProcessorGetOrganizationDepartments struct {
+	handler IdentityService
+}
+
+func (p *identityServiceProcessorGetOrganizationDepartments) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceGetOrganizationDepartmentsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getOrganizationDepartments", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := IdentityServiceGetOrganizationDepartmentsResult{}
+	var retval *GetOrganizationDepartmentsResponseDTO
+	if retval, err2 = p.handler.GetOrganizationDepartments(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getOrganizationDepartments: "+err2.Error())
+		oprot.WriteMessageBegin("getOrganizationDepartments", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("getOrganizationDepartments", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type identityServiceProcessorUploadTemporaryLogo struct {
+	handler IdentityService
+}
+
+func (p *identityServiceProcessorUploadTemporaryLogo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceUploadTemporaryLogoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("uploadTemporaryLogo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := IdentityServiceUploadTemporaryLogoResult{}
+	var retval *OrganizationLogoResponseDTO
+	if retval, err2 = p.handler.UploadTemporaryLogo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing uploadTemporaryLogo: "+err2.Error())
+		oprot.WriteMessageBegin("uploadTemporaryLogo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("uploadTemporaryLogo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type identityServiceProcessorGetOrganizationLogo struct {
+	handler IdentityService
+}
+
+func (p *identityServiceProcessorGetOrganizationLogo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceGetOrganizationLogoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("getOrganizationLogo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := IdentityServiceGetOrganizationLogoResult{}
+	var retval *OrganizationLogoResponseDTO
+	if retval, err2 = p.handler.GetOrganizationLogo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getOrganizationLogo: "+err2.Error())
+		oprot.WriteMessageBegin("getOrganizationLogo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("getOrganizationLogo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type identityServiceProcessorDeleteOrganizationLogo struct {
+	handler IdentityService
+}
+
+func (p *identityServiceProcessorDeleteOrganizationLogo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceDeleteOrganizationLogoArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("deleteOrganizationLogo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := IdentityServiceDeleteOrganizationLogoResult{}
+	var retval *http_base.OperationStatusResponseDTO
+	if retval, err2 = p.handler.DeleteOrganizationLogo(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing deleteOrganizationLogo: "+err2.Error())
+		oprot.WriteMessageBegin("deleteOrganizationLogo", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("deleteOrganizationLogo", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type identityServiceProcessorBindLogoToOrganization struct {
+	handler IdentityService
+}
+
+func (p *identityServiceProcessorBindLogoToOrganization) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := IdentityServiceBindLogoToOrganizationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("bindLogoToOrganization", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := IdentityServiceBindLogoToOrganizationResult{}
+	var retval *OrganizationResponseDTO
+	if retval, err2 = p.handler.BindLogoToOrganization(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing bindLogoToOrganization: "+err2.Error())
+		oprot.WriteMessageBegin("bindLogoToOrganization", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("bindLogoToOrganization", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type IdentityServiceLoginArgs struct {
+	Req *LoginRequestDTO `thrift:"req,1"`
+}
+
+func NewIdentityServiceLoginArgs() *IdentityServiceLoginArgs {
+	return &IdentityServiceLoginArgs{}
+}
+
+func (p *IdentityServiceLoginArgs) InitDefault() {
+}
+
+var IdentityServiceLoginArgs_Req_DEFAULT *LoginRequestDTO
+
+func (p *IdentityServiceLoginArgs) GetReq() (v *LoginRequestDTO) {
+	if !p.IsSetReq() {
+		return IdentityServiceLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_IdentityServiceLoginArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *IdentityServiceLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceLoginArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceLoginArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceLoginArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewLoginRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *IdentityServiceLoginArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("login_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceLoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *IdentityServiceLoginArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceLoginArgs(%+v)", *p)
+
+}
+
+type IdentityServiceLoginResult struct {
+	Success *LoginResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewIdentityServiceLoginResult() *IdentityServiceLoginResult {
+	return &IdentityServiceLoginResult{}
+}
+
+func (p *IdentityServiceLoginResult) InitDefault() {
+}
+
+var IdentityServiceLoginResult_Success_DEFAULT *LoginResponseDTO
+
+func (p *IdentityServiceLoginResult) GetSuccess() (v *LoginResponseDTO) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_IdentityServiceLoginResult = map[int16]string{
+	0: "success",
+}
+
+func (p *IdentityServiceLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceLoginResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceLoginResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceLoginResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewLoginResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *IdentityServiceLoginResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("login_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceLoginResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *IdentityServiceLoginResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceLoginResult(%+v)", *p)
+
+}
+
+type IdentityServiceLogoutArgs struct {
+	Req *LogoutRequestDTO `thrift:"req,1"`
+}
+
+func NewIdentityServiceLogoutArgs() *IdentityServiceLogoutArgs {
+	return &IdentityServiceLogoutArgs{}
+}
+
+func (p *IdentityServiceLogoutArgs) InitDefault() {
+}
+
+var IdentityServiceLogoutArgs_Req_DEFAULT *LogoutRequestDTO
+
+func (p *IdentityServiceLogoutArgs) GetReq() (v *LogoutRequestDTO) {
+	if !p.IsSetReq() {
+		return IdentityServiceLogoutArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_IdentityServiceLogoutArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *IdentityServiceLogoutArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceLogoutArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceLogoutArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceLogoutArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewLogoutRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *IdentityServiceLogoutArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("logout_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceLogoutArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *IdentityServiceLogoutArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceLogoutArgs(%+v)", *p)
+
+}
+
+type IdentityServiceLogoutResult struct {
+	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewIdentityServiceLogoutResult() *IdentityServiceLogoutResult {
+	return &IdentityServiceLogoutResult{}
+}
+
+func (p *IdentityServiceLogoutResult) InitDefault() {
+}
+
+var IdentityServiceLogoutResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
+
+func (p *IdentityServiceLogoutResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceLogoutResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_IdentityServiceLogoutResult = map[int16]string{
+	0: "success",
+}
+
+func (p *IdentityServiceLogoutResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceLogoutResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceLogoutResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceLogoutResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := http_base.NewOperationStatusResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *IdentityServiceLogoutResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("logout_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceLogoutResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *IdentityServiceLogoutResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceLogoutResult(%+v)", *p)
+
+}
+
+type IdentityServiceChangePasswordArgs struct {
+	Req *ChangePasswordRequestDTO `thrift:"req,1"`
+}
+
+func NewIdentityServiceChangePasswordArgs() *IdentityServiceChangePasswordArgs {
+	return &IdentityServiceChangePasswordArgs{}
+}
+
+func (p *IdentityServiceChangePasswordArgs) InitDefault() {
+}
+
+var IdentityServiceChangePasswordArgs_Req_DEFAULT *ChangePasswordRequestDTO
+
+func (p *IdentityServiceChangePasswordArgs) GetReq() (v *ChangePasswordRequestDTO) {
+	if !p.IsSetReq() {
+		return IdentityServiceChangePasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_IdentityServiceChangePasswordArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *IdentityServiceChangePasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceChangePasswordArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceChangePasswordArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceChangePasswordArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewChangePasswordRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *IdentityServiceChangePasswordArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("changePassword_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceChangePasswordArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *IdentityServiceChangePasswordArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceChangePasswordArgs(%+v)", *p)
+
+}
+
+type IdentityServiceChangePasswordResult struct {
+	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewIdentityServiceChangePasswordResult() *IdentityServiceChangePasswordResult {
+	return &IdentityServiceChangePasswordResult{}
+}
+
+func (p *IdentityServiceChangePasswordResult) InitDefault() {
+}
+
+var IdentityServiceChangePasswordResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
+
+func (p *IdentityServiceChangePasswordResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceChangePasswordResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_IdentityServiceChangePasswordResult = map[int16]string{
+	0: "success",
+}
+
+func (p *IdentityServiceChangePasswordResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceChangePasswordResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceChangePasswordResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceChangePasswordResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := http_base.NewOperationStatusResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *IdentityServiceChangePasswordResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("changePassword_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceChangePasswordResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *IdentityServiceChangePasswordResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceChangePasswordResult(%+v)", *p)
+
+}
+
+type IdentityServiceResetPasswordArgs struct {
+	Req *ResetPasswordRequestDTO `thrift:"req,1"`
+}
+
+func NewIdentityServiceResetPasswordArgs() *IdentityServiceResetPasswordArgs {
+	return &IdentityServiceResetPasswordArgs{}
+}
+
+func (p *IdentityServiceResetPasswordArgs) InitDefault() {
+}
+
+var IdentityServiceResetPasswordArgs_Req_DEFAULT *ResetPasswordRequestDTO
+
+func (p *IdentityServiceResetPasswordArgs) GetReq() (v *ResetPasswordRequestDTO) {
+	if !p.IsSetReq() {
+		return IdentityServiceResetPasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_IdentityServiceResetPasswordArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *IdentityServiceResetPasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceResetPasswordArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceResetPasswordArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceResetPasswordArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewResetPasswordRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *IdentityServiceResetPasswordArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("resetPassword_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceResetPasswordArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *IdentityServiceResetPasswordArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceResetPasswordArgs(%+v)", *p)
+
+}
+
+type IdentityServiceResetPasswordResult struct {
+	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewIdentityServiceResetPasswordResult() *IdentityServiceResetPasswordResult {
+	return &IdentityServiceResetPasswordResult{}
+}
+
+func (p *IdentityServiceResetPasswordResult) InitDefault() {
+}
+
+var IdentityServiceResetPasswordResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
+
+func (p *IdentityServiceResetPasswordResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceResetPasswordResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_IdentityServiceResetPasswordResult = map[int16]string{
+	0: "success",
+}
+
+func (p *IdentityServiceResetPasswordResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceResetPasswordResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceResetPasswordResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceResetPasswordResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := http_base.NewOperationStatusResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *IdentityServiceResetPasswordResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("resetPassword_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceResetPasswordResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *IdentityServiceResetPasswordResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceResetPasswordResult(%+v)", *p)
+
+}
+
+type IdentityServiceForcePasswordChangeArgs struct {
+	Req *ForcePasswordChangeRequestDTO `thrift:"req,1"`
+}
+
+func NewIdentityServiceForcePasswordChangeArgs() *IdentityServiceForcePasswordChangeArgs {
+	return &IdentityServiceForcePasswordChangeArgs{}
+}
+
+func (p *IdentityServiceForcePasswordChangeArgs) InitDefault() {
+}
+
+var IdentityServiceForcePasswordChangeArgs_Req_DEFAULT *ForcePasswordChangeRequestDTO
+
+func (p *IdentityServiceForcePasswordChangeArgs) GetReq() (v *ForcePasswordChangeRequestDTO) {
+	if !p.IsSetReq() {
+		return IdentityServiceForcePasswordChangeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_IdentityServiceForcePasswordChangeArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *IdentityServiceForcePasswordChangeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceForcePasswordChangeArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceForcePasswordChangeArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceForcePasswordChangeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewForcePasswordChangeRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *IdentityServiceForcePasswordChangeArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("forcePasswordChange_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceForcePasswordChangeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *IdentityServiceForcePasswordChangeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceForcePasswordChangeArgs(%+v)", *p)
+
+}
+
+type IdentityServiceForcePasswordChangeResult struct {
+	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewIdentityServiceForcePasswordChangeResult() *IdentityServiceForcePasswordChangeResult {
+	return &IdentityServiceForcePasswordChangeResult{}
+}
+
+func (p *IdentityServiceForcePasswordChangeResult) InitDefault() {
+}
+
+var IdentityServiceForcePasswordChangeResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
+
+func (p *IdentityServiceForcePasswordChangeResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceForcePasswordChangeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_IdentityServiceForcePasswordChangeResult = map[int16]string{
+	0: "success",
+}
+
+func (p *IdentityServiceForcePasswordChangeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceForcePasswordChangeResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceForcePasswordChangeResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceForcePasswordChangeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := http_base.NewOperationStatusResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *IdentityServiceForcePasswordChangeResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("forcePasswordChange_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceForcePasswordChangeResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *IdentityServiceForcePasswordChangeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceForcePasswordChangeResult(%+v)", *p)
+
+}
+
+type IdentityServiceRefreshTokenArgs struct {
+	Req *RefreshTokenRequestDTO `thrift:"req,1"`
+}
+
+func NewIdentityServiceRefreshTokenArgs() *IdentityServiceRefreshTokenArgs {
+	return &IdentityServiceRefreshTokenArgs{}
+}
+
+func (p *IdentityServiceRefreshTokenArgs) InitDefault() {
+}
+
+var IdentityServiceRefreshTokenArgs_Req_DEFAULT *RefreshTokenRequestDTO
+
+func (p *IdentityServiceRefreshTokenArgs) GetReq() (v *RefreshTokenRequestDTO) {
+	if !p.IsSetReq() {
+		return IdentityServiceRefreshTokenArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_IdentityServiceRefreshTokenArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *IdentityServiceRefreshTokenArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceRefreshTokenArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceRefreshTokenArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceRefreshTokenArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRefreshTokenRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *IdentityServiceRefreshTokenArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("refreshToken_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceRefreshTokenArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *IdentityServiceRefreshTokenArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceRefreshTokenArgs(%+v)", *p)
+
+}
+
+type IdentityServiceRefreshTokenResult struct {
+	Success *RefreshTokenResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewIdentityServiceRefreshTokenResult() *IdentityServiceRefreshTokenResult {
+	return &IdentityServiceRefreshTokenResult{}
+}
+
+func (p *IdentityServiceRefreshTokenResult) InitDefault() {
+}
+
+var IdentityServiceRefreshTokenResult_Success_DEFAULT *RefreshTokenResponseDTO
+
+func (p *IdentityServiceRefreshTokenResult) GetSuccess() (v *RefreshTokenResponseDTO) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceRefreshTokenResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_IdentityServiceRefreshTokenResult = map[int16]string{
+	0: "success",
+}
+
+func (p *IdentityServiceRefreshTokenResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceRefreshTokenResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceRefreshTokenResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceRefreshTokenResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewRefreshTokenResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *IdentityServiceRefreshTokenResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("refreshToken_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceRefreshTokenResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *IdentityServiceRefreshTokenResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceRefreshTokenResult(%+v)", *p)
+
+}
+
+type IdentityServiceVerifyMFAArgs struct {
+	Req *MFAVerifyRequestDTO `thrift:"req,1"`
+}
+
+func NewIdentityServiceVerifyMFAArgs() *IdentityServiceVerifyMFAArgs {
+	return &IdentityServiceVerifyMFAArgs{}
+}
+
+func (p *IdentityServiceVerifyMFAArgs) InitDefault() {
+}
+
+var IdentityServiceVerifyMFAArgs_Req_DEFAULT *MFAVerifyRequestDTO
+
+func (p *IdentityServiceVerifyMFAArgs) GetReq() (v *MFAVerifyRequestDTO) {
+	if !p.IsSetReq() {
+		return IdentityServiceVerifyMFAArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_IdentityServiceVerifyMFAArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *IdentityServiceVerifyMFAArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceVerifyMFAArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceVerifyMFAArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceVerifyMFAArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewMFAVerifyRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *IdentityServiceVerifyMFAArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("verifyMFA_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceVerifyMFAArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *IdentityServiceVerifyMFAArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceVerifyMFAArgs(%+v)", *p)
+
+}
+
+type IdentityServiceVerifyMFAResult struct {
+	Success *LoginResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewIdentityServiceVerifyMFAResult() *IdentityServiceVerifyMFAResult {
+	return &IdentityServiceVerifyMFAResult{}
+}
+
+func (p *IdentityServiceVerifyMFAResult) InitDefault() {
+}
+
+var IdentityServiceVerifyMFAResult_Success_DEFAULT *LoginResponseDTO
+
+func (p *IdentityServiceVerifyMFAResult) GetSuccess() (v *LoginResponseDTO) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceVerifyMFAResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_IdentityServiceVerifyMFAResult = map[int16]string{
+	0: "success",
+}
+
+func (p *IdentityServiceVerifyMFAResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceVerifyMFAResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceVerifyMFAResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceVerifyMFAResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewLoginResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *IdentityServiceVerifyMFAResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("verifyMFA_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceVerifyMFAResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *IdentityServiceVerifyMFAResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceVerifyMFAResult(%+v)", *p)
+
+}
+
+type IdentityServiceSwitchOrganizationArgs struct {
+	Req *SwitchOrganizationRequestDTO `thrift:"req,1"`
+}
+
+func NewIdentityServiceSwitchOrganizationArgs() *IdentityServiceSwitchOrganizationArgs {
+	return &IdentityServiceSwitchOrganizationArgs{}
+}
+
+func (p *IdentityServiceSwitchOrganizationArgs) InitDefault() {
+}
+
+var IdentityServiceSwitchOrganizationArgs_Req_DEFAULT *SwitchOrganizationRequestDTO
+
+func (p *IdentityServiceSwitchOrganizationArgs) GetReq() (v *SwitchOrganizationRequestDTO) {
+	if !p.IsSetReq() {
+		return IdentityServiceSwitchOrganizationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_IdentityServiceSwitchOrganizationArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *IdentityServiceSwitchOrganizationArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceSwitchOrganizationArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceSwitchOrganizationArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceSwitchOrganizationArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSwitchOrganizationRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *IdentityServiceSwitchOrganizationArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("switchOrganization_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceSwitchOrganizationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *IdentityServiceSwitchOrganizationArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceSwitchOrganizationArgs(%+v)", *p)
+
+}
+
+type IdentityServiceSwitchOrganizationResult struct {
+	Success *LoginResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewIdentityServiceSwitchOrganizationResult() *IdentityServiceSwitchOrganizationResult {
+	return &IdentityServiceSwitchOrganizationResult{}
+}
+
+func (p *IdentityServiceSwitchOrganizationResult) InitDefault() {
+}
+
+var IdentityServiceSwitchOrganizationResult_Success_DEFAULT *LoginResponseDTO
+
+func (p *IdentityServiceSwitchOrganizationResult) GetSuccess() (v *LoginResponseDTO) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceSwitchOrganizationResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_IdentityServiceSwitchOrganizationResult = map[int16]string{
+	0: "success",
+}
+
+func (p *IdentityServiceSwitchOrganizationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceSwitchOrganizationResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceSwitchOrganizationResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceSwitchOrganizationResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewLoginResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *IdentityServiceSwitchOrganizationResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("switchOrganization_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceSwitchOrganizationResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *IdentityServiceSwitchOrganizationResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceSwitchOrganizationResult(%+v)", *p)
+
+}
+
+type IdentityServiceEnrollMFAArgs struct {
+}
+
+func NewIdentityServiceEnrollMFAArgs() *IdentityServiceEnrollMFAArgs {
+	return &IdentityServiceEnrollMFAArgs{}
+}
+
+func (p *IdentityServiceEnrollMFAArgs) InitDefault() {
+}
+
+var fieldIDToName_IdentityServiceEnrollMFAArgs = map[int16]string{}
+
+func (p *IdentityServiceEnrollMFAArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceEnrollMFAArgs) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("enrollMFA_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceEnrollMFAArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceEnrollMFAArgs(%+v)", *p)
+
+}
+
+type IdentityServiceEnrollMFAResult struct {
+	Success *MFAEnrollResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewIdentityServiceEnrollMFAResult() *IdentityServiceEnrollMFAResult {
+	return &IdentityServiceEnrollMFAResult{}
+}
+
+func (p *IdentityServiceEnrollMFAResult) InitDefault() {
+}
+
+var IdentityServiceEnrollMFAResult_Success_DEFAULT *MFAEnrollResponseDTO
+
+func (p *IdentityServiceEnrollMFAResult) GetSuccess() (v *MFAEnrollResponseDTO) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceEnrollMFAResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_IdentityServiceEnrollMFAResult = map[int16]string{
+	0: "success",
+}
+
+func (p *IdentityServiceEnrollMFAResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceEnrollMFAResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceEnrollMFAResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceEnrollMFAResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewMFAEnrollResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *IdentityServiceEnrollMFAResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("enrollMFA_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceEnrollMFAResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *IdentityServiceEnrollMFAResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceEnrollMFAResult(%+v)", *p)
+
+}
+
+type IdentityServiceConfirmMFAEnrollmentArgs struct {
+	Req *MFACodeRequestDTO `thrift:"req,1"`
+}
+
+func NewIdentityServiceConfirmMFAEnrollmentArgs() *IdentityServiceConfirmMFAEnrollmentArgs {
+	return &IdentityServiceConfirmMFAEnrollmentArgs{}
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentArgs) InitDefault() {
+}
+
+var IdentityServiceConfirmMFAEnrollmentArgs_Req_DEFAULT *MFACodeRequestDTO
+
+func (p *IdentityServiceConfirmMFAEnrollmentArgs) GetReq() (v *MFACodeRequestDTO) {
+	if !p.IsSetReq() {
+		return IdentityServiceConfirmMFAEnrollmentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_IdentityServiceConfirmMFAEnrollmentArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceConfirmMFAEnrollmentArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewMFACodeRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("confirmMFAEnrollment_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceConfirmMFAEnrollmentArgs(%+v)", *p)
+
+}
+
+type IdentityServiceConfirmMFAEnrollmentResult struct {
+	Success *MFARecoveryCodesResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewIdentityServiceConfirmMFAEnrollmentResult() *IdentityServiceConfirmMFAEnrollmentResult {
+	return &IdentityServiceConfirmMFAEnrollmentResult{}
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentResult) InitDefault() {
+}
+
+var IdentityServiceConfirmMFAEnrollmentResult_Success_DEFAULT *MFARecoveryCodesResponseDTO
+
+func (p *IdentityServiceConfirmMFAEnrollmentResult) GetSuccess() (v *MFARecoveryCodesResponseDTO) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceConfirmMFAEnrollmentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_IdentityServiceConfirmMFAEnrollmentResult = map[int16]string{
+	0: "success",
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceConfirmMFAEnrollmentResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewMFARecoveryCodesResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("confirmMFAEnrollment_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *IdentityServiceConfirmMFAEnrollmentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceConfirmMFAEnrollmentResult(%+v)", *p)
+
+}
+
+type IdentityServiceRegenerateMFARecoveryCodesArgs struct {
+	Req *MFACodeRequestDTO `thrift:"req,1"`
+}
+
+func NewIdentityServiceRegenerateMFARecoveryCodesArgs() *IdentityServiceRegenerateMFARecoveryCodesArgs {
+	return &IdentityServiceRegenerateMFARecoveryCodesArgs{}
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesArgs) InitDefault() {
+}
+
+var IdentityServiceRegenerateMFARecoveryCodesArgs_Req_DEFAULT *MFACodeRequestDTO
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesArgs) GetReq() (v *MFACodeRequestDTO) {
+	if !p.IsSetReq() {
+		return IdentityServiceRegenerateMFARecoveryCodesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_IdentityServiceRegenerateMFARecoveryCodesArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceRegenerateMFARecoveryCodesArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewMFACodeRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("regenerateMFARecoveryCodes_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceRegenerateMFARecoveryCodesArgs(%+v)", *p)
+
+}
+
+type IdentityServiceRegenerateMFARecoveryCodesResult struct {
+	Success *MFARecoveryCodesResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewIdentityServiceRegenerateMFARecoveryCodesResult() *IdentityServiceRegenerateMFARecoveryCodesResult {
+	return &IdentityServiceRegenerateMFARecoveryCodesResult{}
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesResult) InitDefault() {
+}
+
+var IdentityServiceRegenerateMFARecoveryCodesResult_Success_DEFAULT *MFARecoveryCodesResponseDTO
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesResult) GetSuccess() (v *MFARecoveryCodesResponseDTO) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceRegenerateMFARecoveryCodesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_IdentityServiceRegenerateMFARecoveryCodesResult = map[int16]string{
+	0: "success",
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceRegenerateMFARecoveryCodesResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewMFARecoveryCodesResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("regenerateMFARecoveryCodes_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceRegenerateMFARecoveryCodesResult(%+v)", *p)
+
+}
+
+type IdentityServiceDisableMFAArgs struct {
+	Req *MFACodeRequestDTO `thrift:"req,1"`
+}
+
+func NewIdentityServiceDisableMFAArgs() *IdentityServiceDisableMFAArgs {
+	return &IdentityServiceDisableMFAArgs{}
+}
+
+func (p *IdentityServiceDisableMFAArgs) InitDefault() {
+}
+
+var IdentityServiceDisableMFAArgs_Req_DEFAULT *MFACodeRequestDTO
+
+func (p *IdentityServiceDisableMFAArgs) GetReq() (v *MFACodeRequestDTO) {
+	if !p.IsSetReq() {
+		return IdentityServiceDisableMFAArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_IdentityServiceDisableMFAArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *IdentityServiceDisableMFAArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceDisableMFAArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceDisableMFAArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceDisableMFAArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewMFACodeRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *IdentityServiceDisableMFAArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("disableMFA_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
 
-func (p *IdentityServiceLoginArgs) GetReq() (v *LoginRequestDTO) {
-	if !p.IsSetReq() {
-		return IdentityServiceLoginArgs_Req_DEFAULT
+func (p *IdentityServiceDisableMFAArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	return p.Req
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-var fieldIDToName_IdentityServiceLoginArgs = map[int16]string{
-	1: "req",
+func (p *IdentityServiceDisableMFAArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceDisableMFAArgs(%+v)", *p)
+
 }
 
-func (p *IdentityServiceLoginArgs) IsSetReq() bool {
-	return p.Req != nil
+type IdentityServiceDisableMFAResult struct {
+	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
 }
 
-func (p *IdentityServiceLoginArgs) Read(iprot thrift.TProtocol) (err error) {
+func NewIdentityServiceDisableMFAResult() *IdentityServiceDisableMFAResult {
+	return &IdentityServiceDisableMFAResult{}
+}
+
+func (p *IdentityServiceDisableMFAResult) InitDefault() {
+}
+
+var IdentityServiceDisableMFAResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
+
+func (p *IdentityServiceDisableMFAResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceDisableMFAResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_IdentityServiceDisableMFAResult = map[int16]string{
+	0: "success",
+}
+
+func (p *IdentityServiceDisableMFAResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceDisableMFAResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2239,9 +6309,9 @@ func (p *IdentityServiceLoginArgs) Read(iprot thrift.TProtocol) (err error) {
 		}
 
 		switch fieldId {
-		case 1:
+		case 0:
 			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField1(iprot); err != nil {
+				if err = p.ReadField0(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -2266,7 +6336,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceLoginArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceDisableMFAResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2276,23 +6346,23 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceLoginArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewLoginRequestDTO()
+func (p *IdentityServiceDisableMFAResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := http_base.NewOperationStatusResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	p.Req = _field
+	p.Success = _field
 	return nil
 }
 
-func (p *IdentityServiceLoginArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceDisableMFAResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("login_args"); err != nil {
+	if err = oprot.WriteStructBegin("disableMFA_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
 			goto WriteFieldError
 		}
 	}
@@ -2313,60 +6383,145 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceLoginArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.Req.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+func (p *IdentityServiceDisableMFAResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceLoginArgs) String() string {
+func (p *IdentityServiceDisableMFAResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceLoginArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceDisableMFAResult(%+v)", *p)
 
 }
 
-type IdentityServiceLoginResult struct {
-	Success *LoginResponseDTO `thrift:"success,0,optional"`
+type IdentityServiceListMySessionsArgs struct {
 }
 
-func NewIdentityServiceLoginResult() *IdentityServiceLoginResult {
-	return &IdentityServiceLoginResult{}
+func NewIdentityServiceListMySessionsArgs() *IdentityServiceListMySessionsArgs {
+	return &IdentityServiceListMySessionsArgs{}
 }
 
-func (p *IdentityServiceLoginResult) InitDefault() {
+func (p *IdentityServiceListMySessionsArgs) InitDefault() {
 }
 
-var IdentityServiceLoginResult_Success_DEFAULT *LoginResponseDTO
+var fieldIDToName_IdentityServiceListMySessionsArgs = map[int16]string{}
 
-func (p *IdentityServiceLoginResult) GetSuccess() (v *LoginResponseDTO) {
+func (p *IdentityServiceListMySessionsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *IdentityServiceListMySessionsArgs) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("listMySessions_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *IdentityServiceListMySessionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceListMySessionsArgs(%+v)", *p)
+
+}
+
+type IdentityServiceListMySessionsResult struct {
+	Success *ListSessionsResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewIdentityServiceListMySessionsResult() *IdentityServiceListMySessionsResult {
+	return &IdentityServiceListMySessionsResult{}
+}
+
+func (p *IdentityServiceListMySessionsResult) InitDefault() {
+}
+
+var IdentityServiceListMySessionsResult_Success_DEFAULT *ListSessionsResponseDTO
+
+func (p *IdentityServiceListMySessionsResult) GetSuccess() (v *ListSessionsResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceLoginResult_Success_DEFAULT
+		return IdentityServiceListMySessionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceLoginResult = map[int16]string{
+var fieldIDToName_IdentityServiceListMySessionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceLoginResult) IsSetSuccess() bool {
+func (p *IdentityServiceListMySessionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceLoginResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListMySessionsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2412,7 +6567,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceLoginResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceListMySessionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2422,8 +6577,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceLoginResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewLoginResponseDTO()
+func (p *IdentityServiceListMySessionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListSessionsResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2431,9 +6586,9 @@ func (p *IdentityServiceLoginResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *IdentityServiceLoginResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListMySessionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("login_result"); err != nil {
+	if err = oprot.WriteStructBegin("listMySessions_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2459,7 +6614,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceLoginResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListMySessionsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2478,43 +6633,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceLoginResult) String() string {
+func (p *IdentityServiceListMySessionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceLoginResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceListMySessionsResult(%+v)", *p)
 
 }
 
-type IdentityServiceLogoutArgs struct {
-	Req *LogoutRequestDTO `thrift:"req,1"`
+type IdentityServiceRevokeMySessionArgs struct {
+	Req *RevokeMySessionRequestDTO `thrift:"req,1"`
 }
 
-func NewIdentityServiceLogoutArgs() *IdentityServiceLogoutArgs {
-	return &IdentityServiceLogoutArgs{}
+func NewIdentityServiceRevokeMySessionArgs() *IdentityServiceRevokeMySessionArgs {
+	return &IdentityServiceRevokeMySessionArgs{}
 }
 
-func (p *IdentityServiceLogoutArgs) InitDefault() {
+func (p *IdentityServiceRevokeMySessionArgs) InitDefault() {
 }
 
-var IdentityServiceLogoutArgs_Req_DEFAULT *LogoutRequestDTO
+var IdentityServiceRevokeMySessionArgs_Req_DEFAULT *RevokeMySessionRequestDTO
 
-func (p *IdentityServiceLogoutArgs) GetReq() (v *LogoutRequestDTO) {
+func (p *IdentityServiceRevokeMySessionArgs) GetReq() (v *RevokeMySessionRequestDTO) {
 	if !p.IsSetReq() {
-		return IdentityServiceLogoutArgs_Req_DEFAULT
+		return IdentityServiceRevokeMySessionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_IdentityServiceLogoutArgs = map[int16]string{
+var fieldIDToName_IdentityServiceRevokeMySessionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *IdentityServiceLogoutArgs) IsSetReq() bool {
+func (p *IdentityServiceRevokeMySessionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceLogoutArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeMySessionArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2560,7 +6715,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceLogoutArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceRevokeMySessionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2570,8 +6725,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceLogoutArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewLogoutRequestDTO()
+func (p *IdentityServiceRevokeMySessionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRevokeMySessionRequestDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2579,9 +6734,9 @@ func (p *IdentityServiceLogoutArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *IdentityServiceLogoutArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeMySessionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("logout_args"); err != nil {
+	if err = oprot.WriteStructBegin("revokeMySession_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2607,7 +6762,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceLogoutArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeMySessionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2624,43 +6779,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *IdentityServiceLogoutArgs) String() string {
+func (p *IdentityServiceRevokeMySessionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceLogoutArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceRevokeMySessionArgs(%+v)", *p)
 
 }
 
-type IdentityServiceLogoutResult struct {
+type IdentityServiceRevokeMySessionResult struct {
 	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceLogoutResult() *IdentityServiceLogoutResult {
-	return &IdentityServiceLogoutResult{}
+func NewIdentityServiceRevokeMySessionResult() *IdentityServiceRevokeMySessionResult {
+	return &IdentityServiceRevokeMySessionResult{}
 }
 
-func (p *IdentityServiceLogoutResult) InitDefault() {
+func (p *IdentityServiceRevokeMySessionResult) InitDefault() {
 }
 
-var IdentityServiceLogoutResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
+var IdentityServiceRevokeMySessionResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
 
-func (p *IdentityServiceLogoutResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
+func (p *IdentityServiceRevokeMySessionResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceLogoutResult_Success_DEFAULT
+		return IdentityServiceRevokeMySessionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceLogoutResult = map[int16]string{
+var fieldIDToName_IdentityServiceRevokeMySessionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceLogoutResult) IsSetSuccess() bool {
+func (p *IdentityServiceRevokeMySessionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceLogoutResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeMySessionResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2706,7 +6861,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceLogoutResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceRevokeMySessionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2716,7 +6871,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceLogoutResult) ReadField0(iprot thrift.TProtocol) error {
+func (p *IdentityServiceRevokeMySessionResult) ReadField0(iprot thrift.TProtocol) error {
 	_field := http_base.NewOperationStatusResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -2725,9 +6880,9 @@ func (p *IdentityServiceLogoutResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *IdentityServiceLogoutResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeMySessionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("logout_result"); err != nil {
+	if err = oprot.WriteStructBegin("revokeMySession_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2753,7 +6908,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceLogoutResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeMySessionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -2772,43 +6927,27 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceLogoutResult) String() string {
+func (p *IdentityServiceRevokeMySessionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceLogoutResult(%+v)", *p)
-
-}
-
-type IdentityServiceChangePasswordArgs struct {
-	Req *ChangePasswordRequestDTO `thrift:"req,1"`
-}
+	return fmt.Sprintf("IdentityServiceRevokeMySessionResult(%+v)", *p)
 
-func NewIdentityServiceChangePasswordArgs() *IdentityServiceChangePasswordArgs {
-	return &IdentityServiceChangePasswordArgs{}
 }
 
-func (p *IdentityServiceChangePasswordArgs) InitDefault() {
+type IdentityServiceRevokeMyOtherSessionsArgs struct {
 }
 
-var IdentityServiceChangePasswordArgs_Req_DEFAULT *ChangePasswordRequestDTO
-
-func (p *IdentityServiceChangePasswordArgs) GetReq() (v *ChangePasswordRequestDTO) {
-	if !p.IsSetReq() {
-		return IdentityServiceChangePasswordArgs_Req_DEFAULT
-	}
-	return p.Req
+func NewIdentityServiceRevokeMyOtherSessionsArgs() *IdentityServiceRevokeMyOtherSessionsArgs {
+	return &IdentityServiceRevokeMyOtherSessionsArgs{}
 }
 
-var fieldIDToName_IdentityServiceChangePasswordArgs = map[int16]string{
-	1: "req",
+func (p *IdentityServiceRevokeMyOtherSessionsArgs) InitDefault() {
 }
 
-func (p *IdentityServiceChangePasswordArgs) IsSetReq() bool {
-	return p.Req != nil
-}
+var fieldIDToName_IdentityServiceRevokeMyOtherSessionsArgs = map[int16]string{}
 
-func (p *IdentityServiceChangePasswordArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeMyOtherSessionsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2825,20 +6964,8 @@ func (p *IdentityServiceChangePasswordArgs) Read(iprot thrift.TProtocol) (err er
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -2853,10 +6980,8 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceChangePasswordArgs[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -2864,25 +6989,11 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceChangePasswordArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewChangePasswordRequestDTO()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Req = _field
-	return nil
-}
-
-func (p *IdentityServiceChangePasswordArgs) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("changePassword_args"); err != nil {
+func (p *IdentityServiceRevokeMyOtherSessionsArgs) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("revokeMyOtherSessions_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2893,68 +7004,49 @@ func (p *IdentityServiceChangePasswordArgs) Write(oprot thrift.TProtocol) (err e
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceChangePasswordArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.Req.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *IdentityServiceChangePasswordArgs) String() string {
+func (p *IdentityServiceRevokeMyOtherSessionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceChangePasswordArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceRevokeMyOtherSessionsArgs(%+v)", *p)
 
 }
 
-type IdentityServiceChangePasswordResult struct {
+type IdentityServiceRevokeMyOtherSessionsResult struct {
 	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceChangePasswordResult() *IdentityServiceChangePasswordResult {
-	return &IdentityServiceChangePasswordResult{}
+func NewIdentityServiceRevokeMyOtherSessionsResult() *IdentityServiceRevokeMyOtherSessionsResult {
+	return &IdentityServiceRevokeMyOtherSessionsResult{}
 }
 
-func (p *IdentityServiceChangePasswordResult) InitDefault() {
+func (p *IdentityServiceRevokeMyOtherSessionsResult) InitDefault() {
 }
 
-var IdentityServiceChangePasswordResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
+var IdentityServiceRevokeMyOtherSessionsResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
 
-func (p *IdentityServiceChangePasswordResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
+func (p *IdentityServiceRevokeMyOtherSessionsResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceChangePasswordResult_Success_DEFAULT
+		return IdentityServiceRevokeMyOtherSessionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceChangePasswordResult = map[int16]string{
+var fieldIDToName_IdentityServiceRevokeMyOtherSessionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceChangePasswordResult) IsSetSuccess() bool {
+func (p *IdentityServiceRevokeMyOtherSessionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceChangePasswordResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeMyOtherSessionsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3000,7 +7092,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceChangePasswordResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceRevokeMyOtherSessionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3010,7 +7102,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceChangePasswordResult) ReadField0(iprot thrift.TProtocol) error {
+func (p *IdentityServiceRevokeMyOtherSessionsResult) ReadField0(iprot thrift.TProtocol) error {
 	_field := http_base.NewOperationStatusResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -3019,9 +7111,9 @@ func (p *IdentityServiceChangePasswordResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *IdentityServiceChangePasswordResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeMyOtherSessionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("changePassword_result"); err != nil {
+	if err = oprot.WriteStructBegin("revokeMyOtherSessions_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3047,7 +7139,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceChangePasswordResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeMyOtherSessionsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3066,43 +7158,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceChangePasswordResult) String() string {
+func (p *IdentityServiceRevokeMyOtherSessionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceChangePasswordResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceRevokeMyOtherSessionsResult(%+v)", *p)
 
 }
 
-type IdentityServiceResetPasswordArgs struct {
-	Req *ResetPasswordRequestDTO `thrift:"req,1"`
+type IdentityServiceCreateUserArgs struct {
+	Req *CreateUserRequestDTO `thrift:"req,1"`
 }
 
-func NewIdentityServiceResetPasswordArgs() *IdentityServiceResetPasswordArgs {
-	return &IdentityServiceResetPasswordArgs{}
+func NewIdentityServiceCreateUserArgs() *IdentityServiceCreateUserArgs {
+	return &IdentityServiceCreateUserArgs{}
 }
 
-func (p *IdentityServiceResetPasswordArgs) InitDefault() {
+func (p *IdentityServiceCreateUserArgs) InitDefault() {
 }
 
-var IdentityServiceResetPasswordArgs_Req_DEFAULT *ResetPasswordRequestDTO
+var IdentityServiceCreateUserArgs_Req_DEFAULT *CreateUserRequestDTO
 
-func (p *IdentityServiceResetPasswordArgs) GetReq() (v *ResetPasswordRequestDTO) {
+func (p *IdentityServiceCreateUserArgs) GetReq() (v *CreateUserRequestDTO) {
 	if !p.IsSetReq() {
-		return IdentityServiceResetPasswordArgs_Req_DEFAULT
+		return IdentityServiceCreateUserArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_IdentityServiceResetPasswordArgs = map[int16]string{
+var fieldIDToName_IdentityServiceCreateUserArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *IdentityServiceResetPasswordArgs) IsSetReq() bool {
+func (p *IdentityServiceCreateUserArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceResetPasswordArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceCreateUserArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3148,7 +7240,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceResetPasswordArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceCreateUserArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3158,8 +7250,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceResetPasswordArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewResetPasswordRequestDTO()
+func (p *IdentityServiceCreateUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewCreateUserRequestDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3167,9 +7259,9 @@ func (p *IdentityServiceResetPasswordArgs) ReadField1(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *IdentityServiceResetPasswordArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceCreateUserArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("resetPassword_args"); err != nil {
+	if err = oprot.WriteStructBegin("createUser_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3195,7 +7287,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceResetPasswordArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceCreateUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3212,43 +7304,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *IdentityServiceResetPasswordArgs) String() string {
+func (p *IdentityServiceCreateUserArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceResetPasswordArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceCreateUserArgs(%+v)", *p)
 
 }
 
-type IdentityServiceResetPasswordResult struct {
-	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
+type IdentityServiceCreateUserResult struct {
+	Success *UserProfileResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceResetPasswordResult() *IdentityServiceResetPasswordResult {
-	return &IdentityServiceResetPasswordResult{}
+func NewIdentityServiceCreateUserResult() *IdentityServiceCreateUserResult {
+	return &IdentityServiceCreateUserResult{}
 }
 
-func (p *IdentityServiceResetPasswordResult) InitDefault() {
+func (p *IdentityServiceCreateUserResult) InitDefault() {
 }
 
-var IdentityServiceResetPasswordResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
+var IdentityServiceCreateUserResult_Success_DEFAULT *UserProfileResponseDTO
 
-func (p *IdentityServiceResetPasswordResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
+func (p *IdentityServiceCreateUserResult) GetSuccess() (v *UserProfileResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceResetPasswordResult_Success_DEFAULT
+		return IdentityServiceCreateUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceResetPasswordResult = map[int16]string{
+var fieldIDToName_IdentityServiceCreateUserResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceResetPasswordResult) IsSetSuccess() bool {
+func (p *IdentityServiceCreateUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceResetPasswordResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceCreateUserResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3294,7 +7386,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceResetPasswordResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceCreateUserResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3304,8 +7396,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceResetPasswordResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := http_base.NewOperationStatusResponseDTO()
+func (p *IdentityServiceCreateUserResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUserProfileResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3313,9 +7405,9 @@ func (p *IdentityServiceResetPasswordResult) ReadField0(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *IdentityServiceResetPasswordResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceCreateUserResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("resetPassword_result"); err != nil {
+	if err = oprot.WriteStructBegin("createUser_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3341,7 +7433,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceResetPasswordResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceCreateUserResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3360,43 +7452,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceResetPasswordResult) String() string {
+func (p *IdentityServiceCreateUserResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceResetPasswordResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceCreateUserResult(%+v)", *p)
 
 }
 
-type IdentityServiceForcePasswordChangeArgs struct {
-	Req *ForcePasswordChangeRequestDTO `thrift:"req,1"`
+type IdentityServiceGetUserArgs struct {
+	Req *GetUserRequestDTO `thrift:"req,1"`
 }
 
-func NewIdentityServiceForcePasswordChangeArgs() *IdentityServiceForcePasswordChangeArgs {
-	return &IdentityServiceForcePasswordChangeArgs{}
+func NewIdentityServiceGetUserArgs() *IdentityServiceGetUserArgs {
+	return &IdentityServiceGetUserArgs{}
 }
 
-func (p *IdentityServiceForcePasswordChangeArgs) InitDefault() {
+func (p *IdentityServiceGetUserArgs) InitDefault() {
 }
 
-var IdentityServiceForcePasswordChangeArgs_Req_DEFAULT *ForcePasswordChangeRequestDTO
+var IdentityServiceGetUserArgs_Req_DEFAULT *GetUserRequestDTO
 
-func (p *IdentityServiceForcePasswordChangeArgs) GetReq() (v *ForcePasswordChangeRequestDTO) {
+func (p *IdentityServiceGetUserArgs) GetReq() (v *GetUserRequestDTO) {
 	if !p.IsSetReq() {
-		return IdentityServiceForcePasswordChangeArgs_Req_DEFAULT
+		return IdentityServiceGetUserArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_IdentityServiceForcePasswordChangeArgs = map[int16]string{
+var fieldIDToName_IdentityServiceGetUserArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *IdentityServiceForcePasswordChangeArgs) IsSetReq() bool {
+func (p *IdentityServiceGetUserArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceForcePasswordChangeArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceGetUserArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3442,7 +7534,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceForcePasswordChangeArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceGetUserArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3452,8 +7544,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceForcePasswordChangeArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewForcePasswordChangeRequestDTO()
+func (p *IdentityServiceGetUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewGetUserRequestDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3461,9 +7553,9 @@ func (p *IdentityServiceForcePasswordChangeArgs) ReadField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *IdentityServiceForcePasswordChangeArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceGetUserArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("forcePasswordChange_args"); err != nil {
+	if err = oprot.WriteStructBegin("getUser_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3489,7 +7581,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceForcePasswordChangeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceGetUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3506,43 +7598,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *IdentityServiceForcePasswordChangeArgs) String() string {
+func (p *IdentityServiceGetUserArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceForcePasswordChangeArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceGetUserArgs(%+v)", *p)
 
 }
 
-type IdentityServiceForcePasswordChangeResult struct {
-	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
+type IdentityServiceGetUserResult struct {
+	Success *UserProfileResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceForcePasswordChangeResult() *IdentityServiceForcePasswordChangeResult {
-	return &IdentityServiceForcePasswordChangeResult{}
+func NewIdentityServiceGetUserResult() *IdentityServiceGetUserResult {
+	return &IdentityServiceGetUserResult{}
 }
 
-func (p *IdentityServiceForcePasswordChangeResult) InitDefault() {
+func (p *IdentityServiceGetUserResult) InitDefault() {
 }
 
-var IdentityServiceForcePasswordChangeResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
+var IdentityServiceGetUserResult_Success_DEFAULT *UserProfileResponseDTO
 
-func (p *IdentityServiceForcePasswordChangeResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
+func (p *IdentityServiceGetUserResult) GetSuccess() (v *UserProfileResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceForcePasswordChangeResult_Success_DEFAULT
+		return IdentityServiceGetUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceForcePasswordChangeResult = map[int16]string{
+var fieldIDToName_IdentityServiceGetUserResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceForcePasswordChangeResult) IsSetSuccess() bool {
+func (p *IdentityServiceGetUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceForcePasswordChangeResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceGetUserResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3588,7 +7680,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceForcePasswordChangeResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceGetUserResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3598,8 +7690,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceForcePasswordChangeResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := http_base.NewOperationStatusResponseDTO()
+func (p *IdentityServiceGetUserResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUserProfileResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3607,9 +7699,9 @@ func (p *IdentityServiceForcePasswordChangeResult) ReadField0(iprot thrift.TProt
 	return nil
 }
 
-func (p *IdentityServiceForcePasswordChangeResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceGetUserResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("forcePasswordChange_result"); err != nil {
+	if err = oprot.WriteStructBegin("getUser_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3635,7 +7727,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceForcePasswordChangeResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceGetUserResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3654,43 +7746,27 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceForcePasswordChangeResult) String() string {
+func (p *IdentityServiceGetUserResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceForcePasswordChangeResult(%+v)", *p)
-
-}
-
-type IdentityServiceRefreshTokenArgs struct {
-	Req *RefreshTokenRequestDTO `thrift:"req,1"`
-}
+	return fmt.Sprintf("IdentityServiceGetUserResult(%+v)", *p)
 
-func NewIdentityServiceRefreshTokenArgs() *IdentityServiceRefreshTokenArgs {
-	return &IdentityServiceRefreshTokenArgs{}
 }
 
-func (p *IdentityServiceRefreshTokenArgs) InitDefault() {
+type IdentityServiceGetMeArgs struct {
 }
 
-var IdentityServiceRefreshTokenArgs_Req_DEFAULT *RefreshTokenRequestDTO
-
-func (p *IdentityServiceRefreshTokenArgs) GetReq() (v *RefreshTokenRequestDTO) {
-	if !p.IsSetReq() {
-		return IdentityServiceRefreshTokenArgs_Req_DEFAULT
-	}
-	return p.Req
+func NewIdentityServiceGetMeArgs() *IdentityServiceGetMeArgs {
+	return &IdentityServiceGetMeArgs{}
 }
 
-var fieldIDToName_IdentityServiceRefreshTokenArgs = map[int16]string{
-	1: "req",
+func (p *IdentityServiceGetMeArgs) InitDefault() {
 }
 
-func (p *IdentityServiceRefreshTokenArgs) IsSetReq() bool {
-	return p.Req != nil
-}
+var fieldIDToName_IdentityServiceGetMeArgs = map[int16]string{}
 
-func (p *IdentityServiceRefreshTokenArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceGetMeArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3707,20 +7783,8 @@ func (p *IdentityServiceRefreshTokenArgs) Read(iprot thrift.TProtocol) (err erro
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -3735,10 +7799,8 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceRefreshTokenArgs[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -3746,25 +7808,11 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceRefreshTokenArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewRefreshTokenRequestDTO()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Req = _field
-	return nil
-}
-
-func (p *IdentityServiceRefreshTokenArgs) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("refreshToken_args"); err != nil {
+func (p *IdentityServiceGetMeArgs) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("getMe_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3775,68 +7823,49 @@ func (p *IdentityServiceRefreshTokenArgs) Write(oprot thrift.TProtocol) (err err
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceRefreshTokenArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
-		goto WriteFieldBeginError
-	}
-	if err := p.Req.Write(oprot); err != nil {
-		return err
-	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
-}
-
-func (p *IdentityServiceRefreshTokenArgs) String() string {
+func (p *IdentityServiceGetMeArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceRefreshTokenArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceGetMeArgs(%+v)", *p)
 
 }
 
-type IdentityServiceRefreshTokenResult struct {
-	Success *RefreshTokenResponseDTO `thrift:"success,0,optional"`
+type IdentityServiceGetMeResult struct {
+	Success *UserProfileResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceRefreshTokenResult() *IdentityServiceRefreshTokenResult {
-	return &IdentityServiceRefreshTokenResult{}
+func NewIdentityServiceGetMeResult() *IdentityServiceGetMeResult {
+	return &IdentityServiceGetMeResult{}
 }
 
-func (p *IdentityServiceRefreshTokenResult) InitDefault() {
+func (p *IdentityServiceGetMeResult) InitDefault() {
 }
 
-var IdentityServiceRefreshTokenResult_Success_DEFAULT *RefreshTokenResponseDTO
+var IdentityServiceGetMeResult_Success_DEFAULT *UserProfileResponseDTO
 
-func (p *IdentityServiceRefreshTokenResult) GetSuccess() (v *RefreshTokenResponseDTO) {
+func (p *IdentityServiceGetMeResult) GetSuccess() (v *UserProfileResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceRefreshTokenResult_Success_DEFAULT
+		return IdentityServiceGetMeResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceRefreshTokenResult = map[int16]string{
+var fieldIDToName_IdentityServiceGetMeResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceRefreshTokenResult) IsSetSuccess() bool {
+func (p *IdentityServiceGetMeResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceRefreshTokenResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceGetMeResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3882,7 +7911,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceRefreshTokenResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceGetMeResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3892,8 +7921,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceRefreshTokenResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewRefreshTokenResponseDTO()
+func (p *IdentityServiceGetMeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewUserProfileResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3901,9 +7930,9 @@ func (p *IdentityServiceRefreshTokenResult) ReadField0(iprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *IdentityServiceRefreshTokenResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceGetMeResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("refreshToken_result"); err != nil {
+	if err = oprot.WriteStructBegin("getMe_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3929,7 +7958,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceRefreshTokenResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceGetMeResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3948,43 +7977,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceRefreshTokenResult) String() string {
+func (p *IdentityServiceGetMeResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceRefreshTokenResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceGetMeResult(%+v)", *p)
 
 }
 
-type IdentityServiceCreateUserArgs struct {
-	Req *CreateUserRequestDTO `thrift:"req,1"`
+type IdentityServiceUpdateUserArgs struct {
+	Req *UpdateUserRequestDTO `thrift:"req,1"`
 }
 
-func NewIdentityServiceCreateUserArgs() *IdentityServiceCreateUserArgs {
-	return &IdentityServiceCreateUserArgs{}
+func NewIdentityServiceUpdateUserArgs() *IdentityServiceUpdateUserArgs {
+	return &IdentityServiceUpdateUserArgs{}
 }
 
-func (p *IdentityServiceCreateUserArgs) InitDefault() {
+func (p *IdentityServiceUpdateUserArgs) InitDefault() {
 }
 
-var IdentityServiceCreateUserArgs_Req_DEFAULT *CreateUserRequestDTO
+var IdentityServiceUpdateUserArgs_Req_DEFAULT *UpdateUserRequestDTO
 
-func (p *IdentityServiceCreateUserArgs) GetReq() (v *CreateUserRequestDTO) {
+func (p *IdentityServiceUpdateUserArgs) GetReq() (v *UpdateUserRequestDTO) {
 	if !p.IsSetReq() {
-		return IdentityServiceCreateUserArgs_Req_DEFAULT
+		return IdentityServiceUpdateUserArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_IdentityServiceCreateUserArgs = map[int16]string{
+var fieldIDToName_IdentityServiceUpdateUserArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *IdentityServiceCreateUserArgs) IsSetReq() bool {
+func (p *IdentityServiceUpdateUserArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceCreateUserArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUpdateUserArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4030,7 +8059,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceCreateUserArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceUpdateUserArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4040,8 +8069,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceCreateUserArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewCreateUserRequestDTO()
+func (p *IdentityServiceUpdateUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateUserRequestDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4049,9 +8078,9 @@ func (p *IdentityServiceCreateUserArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *IdentityServiceCreateUserArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUpdateUserArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("createUser_args"); err != nil {
+	if err = oprot.WriteStructBegin("updateUser_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4077,7 +8106,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceCreateUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUpdateUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4094,43 +8123,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *IdentityServiceCreateUserArgs) String() string {
+func (p *IdentityServiceUpdateUserArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceCreateUserArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceUpdateUserArgs(%+v)", *p)
 
 }
 
-type IdentityServiceCreateUserResult struct {
+type IdentityServiceUpdateUserResult struct {
 	Success *UserProfileResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceCreateUserResult() *IdentityServiceCreateUserResult {
-	return &IdentityServiceCreateUserResult{}
+func NewIdentityServiceUpdateUserResult() *IdentityServiceUpdateUserResult {
+	return &IdentityServiceUpdateUserResult{}
 }
 
-func (p *IdentityServiceCreateUserResult) InitDefault() {
+func (p *IdentityServiceUpdateUserResult) InitDefault() {
 }
 
-var IdentityServiceCreateUserResult_Success_DEFAULT *UserProfileResponseDTO
+var IdentityServiceUpdateUserResult_Success_DEFAULT *UserProfileResponseDTO
 
-func (p *IdentityServiceCreateUserResult) GetSuccess() (v *UserProfileResponseDTO) {
+func (p *IdentityServiceUpdateUserResult) GetSuccess() (v *UserProfileResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceCreateUserResult_Success_DEFAULT
+		return IdentityServiceUpdateUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceCreateUserResult = map[int16]string{
+var fieldIDToName_IdentityServiceUpdateUserResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceCreateUserResult) IsSetSuccess() bool {
+func (p *IdentityServiceUpdateUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceCreateUserResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUpdateUserResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4176,7 +8205,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceCreateUserResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceUpdateUserResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4186,7 +8215,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceCreateUserResult) ReadField0(iprot thrift.TProtocol) error {
+func (p *IdentityServiceUpdateUserResult) ReadField0(iprot thrift.TProtocol) error {
 	_field := NewUserProfileResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -4195,9 +8224,9 @@ func (p *IdentityServiceCreateUserResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *IdentityServiceCreateUserResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUpdateUserResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("createUser_result"); err != nil {
+	if err = oprot.WriteStructBegin("updateUser_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4223,7 +8252,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceCreateUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUpdateUserResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4242,43 +8271,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceCreateUserResult) String() string {
+func (p *IdentityServiceUpdateUserResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceCreateUserResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceUpdateUserResult(%+v)", *p)
 
 }
 
-type IdentityServiceGetUserArgs struct {
-	Req *GetUserRequestDTO `thrift:"req,1"`
+type IdentityServiceUpdateMeArgs struct {
+	Req *UpdateMeRequestDTO `thrift:"req,1"`
 }
 
-func NewIdentityServiceGetUserArgs() *IdentityServiceGetUserArgs {
-	return &IdentityServiceGetUserArgs{}
+func NewIdentityServiceUpdateMeArgs() *IdentityServiceUpdateMeArgs {
+	return &IdentityServiceUpdateMeArgs{}
 }
 
-func (p *IdentityServiceGetUserArgs) InitDefault() {
+func (p *IdentityServiceUpdateMeArgs) InitDefault() {
 }
 
-var IdentityServiceGetUserArgs_Req_DEFAULT *GetUserRequestDTO
+var IdentityServiceUpdateMeArgs_Req_DEFAULT *UpdateMeRequestDTO
 
-func (p *IdentityServiceGetUserArgs) GetReq() (v *GetUserRequestDTO) {
+func (p *IdentityServiceUpdateMeArgs) GetReq() (v *UpdateMeRequestDTO) {
 	if !p.IsSetReq() {
-		return IdentityServiceGetUserArgs_Req_DEFAULT
+		return IdentityServiceUpdateMeArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_IdentityServiceGetUserArgs = map[int16]string{
+var fieldIDToName_IdentityServiceUpdateMeArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *IdentityServiceGetUserArgs) IsSetReq() bool {
+func (p *IdentityServiceUpdateMeArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceGetUserArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUpdateMeArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4324,7 +8353,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceGetUserArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceUpdateMeArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4334,8 +8363,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceGetUserArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewGetUserRequestDTO()
+func (p *IdentityServiceUpdateMeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUpdateMeRequestDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4343,9 +8372,9 @@ func (p *IdentityServiceGetUserArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *IdentityServiceGetUserArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUpdateMeArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getUser_args"); err != nil {
+	if err = oprot.WriteStructBegin("updateMe_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4371,7 +8400,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceGetUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUpdateMeArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4388,43 +8417,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *IdentityServiceGetUserArgs) String() string {
+func (p *IdentityServiceUpdateMeArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceGetUserArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceUpdateMeArgs(%+v)", *p)
 
 }
 
-type IdentityServiceGetUserResult struct {
+type IdentityServiceUpdateMeResult struct {
 	Success *UserProfileResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceGetUserResult() *IdentityServiceGetUserResult {
-	return &IdentityServiceGetUserResult{}
+func NewIdentityServiceUpdateMeResult() *IdentityServiceUpdateMeResult {
+	return &IdentityServiceUpdateMeResult{}
 }
 
-func (p *IdentityServiceGetUserResult) InitDefault() {
+func (p *IdentityServiceUpdateMeResult) InitDefault() {
 }
 
-var IdentityServiceGetUserResult_Success_DEFAULT *UserProfileResponseDTO
+var IdentityServiceUpdateMeResult_Success_DEFAULT *UserProfileResponseDTO
 
-func (p *IdentityServiceGetUserResult) GetSuccess() (v *UserProfileResponseDTO) {
+func (p *IdentityServiceUpdateMeResult) GetSuccess() (v *UserProfileResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceGetUserResult_Success_DEFAULT
+		return IdentityServiceUpdateMeResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceGetUserResult = map[int16]string{
+var fieldIDToName_IdentityServiceUpdateMeResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceGetUserResult) IsSetSuccess() bool {
+func (p *IdentityServiceUpdateMeResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceGetUserResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUpdateMeResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4470,7 +8499,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceGetUserResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceUpdateMeResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4480,7 +8509,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceGetUserResult) ReadField0(iprot thrift.TProtocol) error {
+func (p *IdentityServiceUpdateMeResult) ReadField0(iprot thrift.TProtocol) error {
 	_field := NewUserProfileResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -4489,9 +8518,9 @@ func (p *IdentityServiceGetUserResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *IdentityServiceGetUserResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUpdateMeResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getUser_result"); err != nil {
+	if err = oprot.WriteStructBegin("updateMe_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4517,7 +8546,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceGetUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUpdateMeResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4536,27 +8565,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceGetUserResult) String() string {
+func (p *IdentityServiceUpdateMeResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceGetUserResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceUpdateMeResult(%+v)", *p)
 
 }
 
-type IdentityServiceGetMeArgs struct {
+type IdentityServiceDeleteUserArgs struct {
+	Req *DeleteUserRequestDTO `thrift:"req,1"`
 }
 
-func NewIdentityServiceGetMeArgs() *IdentityServiceGetMeArgs {
-	return &IdentityServiceGetMeArgs{}
+func NewIdentityServiceDeleteUserArgs() *IdentityServiceDeleteUserArgs {
+	return &IdentityServiceDeleteUserArgs{}
 }
 
-func (p *IdentityServiceGetMeArgs) InitDefault() {
+func (p *IdentityServiceDeleteUserArgs) InitDefault() {
 }
 
-var fieldIDToName_IdentityServiceGetMeArgs = map[int16]string{}
+var IdentityServiceDeleteUserArgs_Req_DEFAULT *DeleteUserRequestDTO
 
-func (p *IdentityServiceGetMeArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceDeleteUserArgs) GetReq() (v *DeleteUserRequestDTO) {
+	if !p.IsSetReq() {
+		return IdentityServiceDeleteUserArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_IdentityServiceDeleteUserArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *IdentityServiceDeleteUserArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceDeleteUserArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4573,8 +8618,20 @@ func (p *IdentityServiceGetMeArgs) Read(iprot thrift.TProtocol) (err error) {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		if err = iprot.Skip(fieldTypeId); err != nil {
-			goto SkipFieldTypeError
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		}
 		if err = iprot.ReadFieldEnd(); err != nil {
 			goto ReadFieldEndError
@@ -4589,8 +8646,10 @@ ReadStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-SkipFieldTypeError:
-	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceDeleteUserArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
 ReadFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
@@ -4598,11 +8657,25 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceGetMeArgs) Write(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteStructBegin("getMe_args"); err != nil {
+func (p *IdentityServiceDeleteUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDeleteUserRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *IdentityServiceDeleteUserArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("deleteUser_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4613,49 +8686,68 @@ func (p *IdentityServiceGetMeArgs) Write(oprot thrift.TProtocol) (err error) {
 	return nil
 WriteStructBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
 WriteFieldStopError:
 	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
 WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceGetMeArgs) String() string {
+func (p *IdentityServiceDeleteUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *IdentityServiceDeleteUserArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceGetMeArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceDeleteUserArgs(%+v)", *p)
 
 }
 
-type IdentityServiceGetMeResult struct {
-	Success *UserProfileResponseDTO `thrift:"success,0,optional"`
+type IdentityServiceDeleteUserResult struct {
+	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceGetMeResult() *IdentityServiceGetMeResult {
-	return &IdentityServiceGetMeResult{}
+func NewIdentityServiceDeleteUserResult() *IdentityServiceDeleteUserResult {
+	return &IdentityServiceDeleteUserResult{}
 }
 
-func (p *IdentityServiceGetMeResult) InitDefault() {
+func (p *IdentityServiceDeleteUserResult) InitDefault() {
 }
 
-var IdentityServiceGetMeResult_Success_DEFAULT *UserProfileResponseDTO
+var IdentityServiceDeleteUserResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
 
-func (p *IdentityServiceGetMeResult) GetSuccess() (v *UserProfileResponseDTO) {
+func (p *IdentityServiceDeleteUserResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceGetMeResult_Success_DEFAULT
+		return IdentityServiceDeleteUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceGetMeResult = map[int16]string{
+var fieldIDToName_IdentityServiceDeleteUserResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceGetMeResult) IsSetSuccess() bool {
+func (p *IdentityServiceDeleteUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceGetMeResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceDeleteUserResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4701,7 +8793,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceGetMeResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceDeleteUserResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4711,8 +8803,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceGetMeResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUserProfileResponseDTO()
+func (p *IdentityServiceDeleteUserResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := http_base.NewOperationStatusResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4720,9 +8812,9 @@ func (p *IdentityServiceGetMeResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *IdentityServiceGetMeResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceDeleteUserResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("getMe_result"); err != nil {
+	if err = oprot.WriteStructBegin("deleteUser_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4748,7 +8840,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceGetMeResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceDeleteUserResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4767,43 +8859,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceGetMeResult) String() string {
+func (p *IdentityServiceDeleteUserResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceGetMeResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceDeleteUserResult(%+v)", *p)
 
 }
 
-type IdentityServiceUpdateUserArgs struct {
-	Req *UpdateUserRequestDTO `thrift:"req,1"`
+type IdentityServiceListUsersArgs struct {
+	Req *ListUsersRequestDTO `thrift:"req,1"`
 }
 
-func NewIdentityServiceUpdateUserArgs() *IdentityServiceUpdateUserArgs {
-	return &IdentityServiceUpdateUserArgs{}
+func NewIdentityServiceListUsersArgs() *IdentityServiceListUsersArgs {
+	return &IdentityServiceListUsersArgs{}
 }
 
-func (p *IdentityServiceUpdateUserArgs) InitDefault() {
+func (p *IdentityServiceListUsersArgs) InitDefault() {
 }
 
-var IdentityServiceUpdateUserArgs_Req_DEFAULT *UpdateUserRequestDTO
+var IdentityServiceListUsersArgs_Req_DEFAULT *ListUsersRequestDTO
 
-func (p *IdentityServiceUpdateUserArgs) GetReq() (v *UpdateUserRequestDTO) {
+func (p *IdentityServiceListUsersArgs) GetReq() (v *ListUsersRequestDTO) {
 	if !p.IsSetReq() {
-		return IdentityServiceUpdateUserArgs_Req_DEFAULT
+		return IdentityServiceListUsersArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_IdentityServiceUpdateUserArgs = map[int16]string{
+var fieldIDToName_IdentityServiceListUsersArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *IdentityServiceUpdateUserArgs) IsSetReq() bool {
+func (p *IdentityServiceListUsersArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceUpdateUserArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListUsersArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4849,7 +8941,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceUpdateUserArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceListUsersArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4859,8 +8951,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceUpdateUserArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateUserRequestDTO()
+func (p *IdentityServiceListUsersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListUsersRequestDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4868,9 +8960,9 @@ func (p *IdentityServiceUpdateUserArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *IdentityServiceUpdateUserArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListUsersArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("updateUser_args"); err != nil {
+	if err = oprot.WriteStructBegin("listUsers_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4896,7 +8988,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceUpdateUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListUsersArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4913,43 +9005,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *IdentityServiceUpdateUserArgs) String() string {
+func (p *IdentityServiceListUsersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceUpdateUserArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceListUsersArgs(%+v)", *p)
 
 }
 
-type IdentityServiceUpdateUserResult struct {
-	Success *UserProfileResponseDTO `thrift:"success,0,optional"`
+type IdentityServiceListUsersResult struct {
+	Success *ListUsersResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceUpdateUserResult() *IdentityServiceUpdateUserResult {
-	return &IdentityServiceUpdateUserResult{}
+func NewIdentityServiceListUsersResult() *IdentityServiceListUsersResult {
+	return &IdentityServiceListUsersResult{}
 }
 
-func (p *IdentityServiceUpdateUserResult) InitDefault() {
+func (p *IdentityServiceListUsersResult) InitDefault() {
 }
 
-var IdentityServiceUpdateUserResult_Success_DEFAULT *UserProfileResponseDTO
+var IdentityServiceListUsersResult_Success_DEFAULT *ListUsersResponseDTO
 
-func (p *IdentityServiceUpdateUserResult) GetSuccess() (v *UserProfileResponseDTO) {
+func (p *IdentityServiceListUsersResult) GetSuccess() (v *ListUsersResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceUpdateUserResult_Success_DEFAULT
+		return IdentityServiceListUsersResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceUpdateUserResult = map[int16]string{
+var fieldIDToName_IdentityServiceListUsersResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceUpdateUserResult) IsSetSuccess() bool {
+func (p *IdentityServiceListUsersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceUpdateUserResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListUsersResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4995,7 +9087,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceUpdateUserResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceListUsersResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5005,8 +9097,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceUpdateUserResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUserProfileResponseDTO()
+func (p *IdentityServiceListUsersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListUsersResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5014,9 +9106,9 @@ func (p *IdentityServiceUpdateUserResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *IdentityServiceUpdateUserResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListUsersResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("updateUser_result"); err != nil {
+	if err = oprot.WriteStructBegin("listUsers_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5042,7 +9134,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceUpdateUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListUsersResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5061,43 +9153,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceUpdateUserResult) String() string {
+func (p *IdentityServiceListUsersResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceUpdateUserResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceListUsersResult(%+v)", *p)
 
 }
 
-type IdentityServiceUpdateMeArgs struct {
-	Req *UpdateMeRequestDTO `thrift:"req,1"`
+type IdentityServiceSearchUsersArgs struct {
+	Req *SearchUsersRequestDTO `thrift:"req,1"`
 }
 
-func NewIdentityServiceUpdateMeArgs() *IdentityServiceUpdateMeArgs {
-	return &IdentityServiceUpdateMeArgs{}
+func NewIdentityServiceSearchUsersArgs() *IdentityServiceSearchUsersArgs {
+	return &IdentityServiceSearchUsersArgs{}
 }
 
-func (p *IdentityServiceUpdateMeArgs) InitDefault() {
+func (p *IdentityServiceSearchUsersArgs) InitDefault() {
 }
 
-var IdentityServiceUpdateMeArgs_Req_DEFAULT *UpdateMeRequestDTO
+var IdentityServiceSearchUsersArgs_Req_DEFAULT *SearchUsersRequestDTO
 
-func (p *IdentityServiceUpdateMeArgs) GetReq() (v *UpdateMeRequestDTO) {
+func (p *IdentityServiceSearchUsersArgs) GetReq() (v *SearchUsersRequestDTO) {
 	if !p.IsSetReq() {
-		return IdentityServiceUpdateMeArgs_Req_DEFAULT
+		return IdentityServiceSearchUsersArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_IdentityServiceUpdateMeArgs = map[int16]string{
+var fieldIDToName_IdentityServiceSearchUsersArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *IdentityServiceUpdateMeArgs) IsSetReq() bool {
+func (p *IdentityServiceSearchUsersArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceUpdateMeArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceSearchUsersArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5143,7 +9235,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceUpdateMeArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceSearchUsersArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5153,8 +9245,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceUpdateMeArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUpdateMeRequestDTO()
+func (p *IdentityServiceSearchUsersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewSearchUsersRequestDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5162,9 +9254,9 @@ func (p *IdentityServiceUpdateMeArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *IdentityServiceUpdateMeArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceSearchUsersArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("updateMe_args"); err != nil {
+	if err = oprot.WriteStructBegin("searchUsers_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5190,7 +9282,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceUpdateMeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceSearchUsersArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5207,43 +9299,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *IdentityServiceUpdateMeArgs) String() string {
+func (p *IdentityServiceSearchUsersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceUpdateMeArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceSearchUsersArgs(%+v)", *p)
 
 }
 
-type IdentityServiceUpdateMeResult struct {
-	Success *UserProfileResponseDTO `thrift:"success,0,optional"`
+type IdentityServiceSearchUsersResult struct {
+	Success *SearchUsersResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceUpdateMeResult() *IdentityServiceUpdateMeResult {
-	return &IdentityServiceUpdateMeResult{}
+func NewIdentityServiceSearchUsersResult() *IdentityServiceSearchUsersResult {
+	return &IdentityServiceSearchUsersResult{}
 }
 
-func (p *IdentityServiceUpdateMeResult) InitDefault() {
+func (p *IdentityServiceSearchUsersResult) InitDefault() {
 }
 
-var IdentityServiceUpdateMeResult_Success_DEFAULT *UserProfileResponseDTO
+var IdentityServiceSearchUsersResult_Success_DEFAULT *SearchUsersResponseDTO
 
-func (p *IdentityServiceUpdateMeResult) GetSuccess() (v *UserProfileResponseDTO) {
+func (p *IdentityServiceSearchUsersResult) GetSuccess() (v *SearchUsersResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceUpdateMeResult_Success_DEFAULT
+		return IdentityServiceSearchUsersResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceUpdateMeResult = map[int16]string{
+var fieldIDToName_IdentityServiceSearchUsersResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceUpdateMeResult) IsSetSuccess() bool {
+func (p *IdentityServiceSearchUsersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceUpdateMeResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceSearchUsersResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5289,7 +9381,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceUpdateMeResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceSearchUsersResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5299,8 +9391,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceUpdateMeResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewUserProfileResponseDTO()
+func (p *IdentityServiceSearchUsersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewSearchUsersResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5308,9 +9400,9 @@ func (p *IdentityServiceUpdateMeResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *IdentityServiceUpdateMeResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceSearchUsersResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("updateMe_result"); err != nil {
+	if err = oprot.WriteStructBegin("searchUsers_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5336,7 +9428,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceUpdateMeResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceSearchUsersResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5355,43 +9447,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceUpdateMeResult) String() string {
+func (p *IdentityServiceSearchUsersResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceUpdateMeResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceSearchUsersResult(%+v)", *p)
 
 }
 
-type IdentityServiceDeleteUserArgs struct {
-	Req *DeleteUserRequestDTO `thrift:"req,1"`
+type IdentityServiceChangeUserStatusArgs struct {
+	Req *ChangeUserStatusRequestDTO `thrift:"req,1"`
 }
 
-func NewIdentityServiceDeleteUserArgs() *IdentityServiceDeleteUserArgs {
-	return &IdentityServiceDeleteUserArgs{}
+func NewIdentityServiceChangeUserStatusArgs() *IdentityServiceChangeUserStatusArgs {
+	return &IdentityServiceChangeUserStatusArgs{}
 }
 
-func (p *IdentityServiceDeleteUserArgs) InitDefault() {
+func (p *IdentityServiceChangeUserStatusArgs) InitDefault() {
 }
 
-var IdentityServiceDeleteUserArgs_Req_DEFAULT *DeleteUserRequestDTO
+var IdentityServiceChangeUserStatusArgs_Req_DEFAULT *ChangeUserStatusRequestDTO
 
-func (p *IdentityServiceDeleteUserArgs) GetReq() (v *DeleteUserRequestDTO) {
+func (p *IdentityServiceChangeUserStatusArgs) GetReq() (v *ChangeUserStatusRequestDTO) {
 	if !p.IsSetReq() {
-		return IdentityServiceDeleteUserArgs_Req_DEFAULT
+		return IdentityServiceChangeUserStatusArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_IdentityServiceDeleteUserArgs = map[int16]string{
+var fieldIDToName_IdentityServiceChangeUserStatusArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *IdentityServiceDeleteUserArgs) IsSetReq() bool {
+func (p *IdentityServiceChangeUserStatusArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceDeleteUserArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceChangeUserStatusArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5437,7 +9529,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceDeleteUserArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceChangeUserStatusArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5447,8 +9539,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceDeleteUserArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewDeleteUserRequestDTO()
+func (p *IdentityServiceChangeUserStatusArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewChangeUserStatusRequestDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5456,9 +9548,9 @@ func (p *IdentityServiceDeleteUserArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *IdentityServiceDeleteUserArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceChangeUserStatusArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("deleteUser_args"); err != nil {
+	if err = oprot.WriteStructBegin("changeUserStatus_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5484,7 +9576,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceDeleteUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceChangeUserStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5501,43 +9593,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *IdentityServiceDeleteUserArgs) String() string {
+func (p *IdentityServiceChangeUserStatusArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceDeleteUserArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceChangeUserStatusArgs(%+v)", *p)
 
 }
 
-type IdentityServiceDeleteUserResult struct {
+type IdentityServiceChangeUserStatusResult struct {
 	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceDeleteUserResult() *IdentityServiceDeleteUserResult {
-	return &IdentityServiceDeleteUserResult{}
+func NewIdentityServiceChangeUserStatusResult() *IdentityServiceChangeUserStatusResult {
+	return &IdentityServiceChangeUserStatusResult{}
 }
 
-func (p *IdentityServiceDeleteUserResult) InitDefault() {
+func (p *IdentityServiceChangeUserStatusResult) InitDefault() {
 }
 
-var IdentityServiceDeleteUserResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
+var IdentityServiceChangeUserStatusResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
 
-func (p *IdentityServiceDeleteUserResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
+func (p *IdentityServiceChangeUserStatusResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceDeleteUserResult_Success_DEFAULT
+		return IdentityServiceChangeUserStatusResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceDeleteUserResult = map[int16]string{
+var fieldIDToName_IdentityServiceChangeUserStatusResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceDeleteUserResult) IsSetSuccess() bool {
+func (p *IdentityServiceChangeUserStatusResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceDeleteUserResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceChangeUserStatusResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5583,7 +9675,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceDeleteUserResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceChangeUserStatusResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5593,7 +9685,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceDeleteUserResult) ReadField0(iprot thrift.TProtocol) error {
+func (p *IdentityServiceChangeUserStatusResult) ReadField0(iprot thrift.TProtocol) error {
 	_field := http_base.NewOperationStatusResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -5602,9 +9694,9 @@ func (p *IdentityServiceDeleteUserResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *IdentityServiceDeleteUserResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceChangeUserStatusResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("deleteUser_result"); err != nil {
+	if err = oprot.WriteStructBegin("changeUserStatus_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5630,7 +9722,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceDeleteUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceChangeUserStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5649,43 +9741,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceDeleteUserResult) String() string {
+func (p *IdentityServiceChangeUserStatusResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceDeleteUserResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceChangeUserStatusResult(%+v)", *p)
 
 }
 
-type IdentityServiceListUsersArgs struct {
-	Req *ListUsersRequestDTO `thrift:"req,1"`
+type IdentityServiceUnlockUserArgs struct {
+	Req *UnlockUserRequestDTO `thrift:"req,1"`
 }
 
-func NewIdentityServiceListUsersArgs() *IdentityServiceListUsersArgs {
-	return &IdentityServiceListUsersArgs{}
+func NewIdentityServiceUnlockUserArgs() *IdentityServiceUnlockUserArgs {
+	return &IdentityServiceUnlockUserArgs{}
 }
 
-func (p *IdentityServiceListUsersArgs) InitDefault() {
+func (p *IdentityServiceUnlockUserArgs) InitDefault() {
 }
 
-var IdentityServiceListUsersArgs_Req_DEFAULT *ListUsersRequestDTO
+var IdentityServiceUnlockUserArgs_Req_DEFAULT *UnlockUserRequestDTO
 
-func (p *IdentityServiceListUsersArgs) GetReq() (v *ListUsersRequestDTO) {
+func (p *IdentityServiceUnlockUserArgs) GetReq() (v *UnlockUserRequestDTO) {
 	if !p.IsSetReq() {
-		return IdentityServiceListUsersArgs_Req_DEFAULT
+		return IdentityServiceUnlockUserArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_IdentityServiceListUsersArgs = map[int16]string{
+var fieldIDToName_IdentityServiceUnlockUserArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *IdentityServiceListUsersArgs) IsSetReq() bool {
+func (p *IdentityServiceUnlockUserArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceListUsersArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUnlockUserArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5731,7 +9823,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceListUsersArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceUnlockUserArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5741,8 +9833,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceListUsersArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewListUsersRequestDTO()
+func (p *IdentityServiceUnlockUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewUnlockUserRequestDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5750,9 +9842,9 @@ func (p *IdentityServiceListUsersArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *IdentityServiceListUsersArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUnlockUserArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("listUsers_args"); err != nil {
+	if err = oprot.WriteStructBegin("unlockUser_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5778,7 +9870,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceListUsersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUnlockUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5795,43 +9887,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *IdentityServiceListUsersArgs) String() string {
+func (p *IdentityServiceUnlockUserArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceListUsersArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceUnlockUserArgs(%+v)", *p)
 
 }
 
-type IdentityServiceListUsersResult struct {
-	Success *ListUsersResponseDTO `thrift:"success,0,optional"`
+type IdentityServiceUnlockUserResult struct {
+	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceListUsersResult() *IdentityServiceListUsersResult {
-	return &IdentityServiceListUsersResult{}
+func NewIdentityServiceUnlockUserResult() *IdentityServiceUnlockUserResult {
+	return &IdentityServiceUnlockUserResult{}
 }
 
-func (p *IdentityServiceListUsersResult) InitDefault() {
+func (p *IdentityServiceUnlockUserResult) InitDefault() {
 }
 
-var IdentityServiceListUsersResult_Success_DEFAULT *ListUsersResponseDTO
+var IdentityServiceUnlockUserResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
 
-func (p *IdentityServiceListUsersResult) GetSuccess() (v *ListUsersResponseDTO) {
+func (p *IdentityServiceUnlockUserResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceListUsersResult_Success_DEFAULT
+		return IdentityServiceUnlockUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceListUsersResult = map[int16]string{
+var fieldIDToName_IdentityServiceUnlockUserResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceListUsersResult) IsSetSuccess() bool {
+func (p *IdentityServiceUnlockUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceListUsersResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUnlockUserResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5877,7 +9969,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceListUsersResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceUnlockUserResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5887,8 +9979,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceListUsersResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewListUsersResponseDTO()
+func (p *IdentityServiceUnlockUserResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := http_base.NewOperationStatusResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5896,9 +9988,9 @@ func (p *IdentityServiceListUsersResult) ReadField0(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *IdentityServiceListUsersResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUnlockUserResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("listUsers_result"); err != nil {
+	if err = oprot.WriteStructBegin("unlockUser_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5924,7 +10016,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceListUsersResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceUnlockUserResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5943,43 +10035,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceListUsersResult) String() string {
+func (p *IdentityServiceUnlockUserResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceListUsersResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceUnlockUserResult(%+v)", *p)
 
 }
 
-type IdentityServiceSearchUsersArgs struct {
-	Req *SearchUsersRequestDTO `thrift:"req,1"`
+type IdentityServiceListUserSessionsArgs struct {
+	Req *ListUserSessionsRequestDTO `thrift:"req,1"`
 }
 
-func NewIdentityServiceSearchUsersArgs() *IdentityServiceSearchUsersArgs {
-	return &IdentityServiceSearchUsersArgs{}
+func NewIdentityServiceListUserSessionsArgs() *IdentityServiceListUserSessionsArgs {
+	return &IdentityServiceListUserSessionsArgs{}
 }
 
-func (p *IdentityServiceSearchUsersArgs) InitDefault() {
+func (p *IdentityServiceListUserSessionsArgs) InitDefault() {
 }
 
-var IdentityServiceSearchUsersArgs_Req_DEFAULT *SearchUsersRequestDTO
+var IdentityServiceListUserSessionsArgs_Req_DEFAULT *ListUserSessionsRequestDTO
 
-func (p *IdentityServiceSearchUsersArgs) GetReq() (v *SearchUsersRequestDTO) {
+func (p *IdentityServiceListUserSessionsArgs) GetReq() (v *ListUserSessionsRequestDTO) {
 	if !p.IsSetReq() {
-		return IdentityServiceSearchUsersArgs_Req_DEFAULT
+		return IdentityServiceListUserSessionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_IdentityServiceSearchUsersArgs = map[int16]string{
+var fieldIDToName_IdentityServiceListUserSessionsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *IdentityServiceSearchUsersArgs) IsSetReq() bool {
+func (p *IdentityServiceListUserSessionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceSearchUsersArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListUserSessionsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6025,7 +10117,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceSearchUsersArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceListUserSessionsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6035,8 +10127,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceSearchUsersArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewSearchUsersRequestDTO()
+func (p *IdentityServiceListUserSessionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewListUserSessionsRequestDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6044,9 +10136,9 @@ func (p *IdentityServiceSearchUsersArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *IdentityServiceSearchUsersArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListUserSessionsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("searchUsers_args"); err != nil {
+	if err = oprot.WriteStructBegin("listUserSessions_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6072,7 +10164,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceSearchUsersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListUserSessionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6089,43 +10181,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *IdentityServiceSearchUsersArgs) String() string {
+func (p *IdentityServiceListUserSessionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceSearchUsersArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceListUserSessionsArgs(%+v)", *p)
 
 }
 
-type IdentityServiceSearchUsersResult struct {
-	Success *SearchUsersResponseDTO `thrift:"success,0,optional"`
+type IdentityServiceListUserSessionsResult struct {
+	Success *ListSessionsResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceSearchUsersResult() *IdentityServiceSearchUsersResult {
-	return &IdentityServiceSearchUsersResult{}
+func NewIdentityServiceListUserSessionsResult() *IdentityServiceListUserSessionsResult {
+	return &IdentityServiceListUserSessionsResult{}
 }
 
-func (p *IdentityServiceSearchUsersResult) InitDefault() {
+func (p *IdentityServiceListUserSessionsResult) InitDefault() {
 }
 
-var IdentityServiceSearchUsersResult_Success_DEFAULT *SearchUsersResponseDTO
+var IdentityServiceListUserSessionsResult_Success_DEFAULT *ListSessionsResponseDTO
 
-func (p *IdentityServiceSearchUsersResult) GetSuccess() (v *SearchUsersResponseDTO) {
+func (p *IdentityServiceListUserSessionsResult) GetSuccess() (v *ListSessionsResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceSearchUsersResult_Success_DEFAULT
+		return IdentityServiceListUserSessionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceSearchUsersResult = map[int16]string{
+var fieldIDToName_IdentityServiceListUserSessionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceSearchUsersResult) IsSetSuccess() bool {
+func (p *IdentityServiceListUserSessionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceSearchUsersResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListUserSessionsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6171,7 +10263,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceSearchUsersResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceListUserSessionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6181,8 +10273,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceSearchUsersResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := NewSearchUsersResponseDTO()
+func (p *IdentityServiceListUserSessionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListSessionsResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6190,9 +10282,9 @@ func (p *IdentityServiceSearchUsersResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *IdentityServiceSearchUsersResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListUserSessionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("searchUsers_result"); err != nil {
+	if err = oprot.WriteStructBegin("listUserSessions_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6218,7 +10310,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceSearchUsersResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceListUserSessionsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6237,43 +10329,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceSearchUsersResult) String() string {
+func (p *IdentityServiceListUserSessionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceSearchUsersResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceListUserSessionsResult(%+v)", *p)
 
 }
 
-type IdentityServiceChangeUserStatusArgs struct {
-	Req *ChangeUserStatusRequestDTO `thrift:"req,1"`
+type IdentityServiceRevokeUserSessionArgs struct {
+	Req *RevokeUserSessionRequestDTO `thrift:"req,1"`
 }
 
-func NewIdentityServiceChangeUserStatusArgs() *IdentityServiceChangeUserStatusArgs {
-	return &IdentityServiceChangeUserStatusArgs{}
+func NewIdentityServiceRevokeUserSessionArgs() *IdentityServiceRevokeUserSessionArgs {
+	return &IdentityServiceRevokeUserSessionArgs{}
 }
 
-func (p *IdentityServiceChangeUserStatusArgs) InitDefault() {
+func (p *IdentityServiceRevokeUserSessionArgs) InitDefault() {
 }
 
-var IdentityServiceChangeUserStatusArgs_Req_DEFAULT *ChangeUserStatusRequestDTO
+var IdentityServiceRevokeUserSessionArgs_Req_DEFAULT *RevokeUserSessionRequestDTO
 
-func (p *IdentityServiceChangeUserStatusArgs) GetReq() (v *ChangeUserStatusRequestDTO) {
+func (p *IdentityServiceRevokeUserSessionArgs) GetReq() (v *RevokeUserSessionRequestDTO) {
 	if !p.IsSetReq() {
-		return IdentityServiceChangeUserStatusArgs_Req_DEFAULT
+		return IdentityServiceRevokeUserSessionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_IdentityServiceChangeUserStatusArgs = map[int16]string{
+var fieldIDToName_IdentityServiceRevokeUserSessionArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *IdentityServiceChangeUserStatusArgs) IsSetReq() bool {
+func (p *IdentityServiceRevokeUserSessionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceChangeUserStatusArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeUserSessionArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6319,7 +10411,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceChangeUserStatusArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceRevokeUserSessionArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6329,8 +10421,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceChangeUserStatusArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewChangeUserStatusRequestDTO()
+func (p *IdentityServiceRevokeUserSessionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRevokeUserSessionRequestDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6338,9 +10430,9 @@ func (p *IdentityServiceChangeUserStatusArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *IdentityServiceChangeUserStatusArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeUserSessionArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("changeUserStatus_args"); err != nil {
+	if err = oprot.WriteStructBegin("revokeUserSession_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6366,7 +10458,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceChangeUserStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeUserSessionArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6383,43 +10475,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *IdentityServiceChangeUserStatusArgs) String() string {
+func (p *IdentityServiceRevokeUserSessionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceChangeUserStatusArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceRevokeUserSessionArgs(%+v)", *p)
 
 }
 
-type IdentityServiceChangeUserStatusResult struct {
+type IdentityServiceRevokeUserSessionResult struct {
 	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceChangeUserStatusResult() *IdentityServiceChangeUserStatusResult {
-	return &IdentityServiceChangeUserStatusResult{}
+func NewIdentityServiceRevokeUserSessionResult() *IdentityServiceRevokeUserSessionResult {
+	return &IdentityServiceRevokeUserSessionResult{}
 }
 
-func (p *IdentityServiceChangeUserStatusResult) InitDefault() {
+func (p *IdentityServiceRevokeUserSessionResult) InitDefault() {
 }
 
-var IdentityServiceChangeUserStatusResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
+var IdentityServiceRevokeUserSessionResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
 
-func (p *IdentityServiceChangeUserStatusResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
+func (p *IdentityServiceRevokeUserSessionResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceChangeUserStatusResult_Success_DEFAULT
+		return IdentityServiceRevokeUserSessionResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceChangeUserStatusResult = map[int16]string{
+var fieldIDToName_IdentityServiceRevokeUserSessionResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceChangeUserStatusResult) IsSetSuccess() bool {
+func (p *IdentityServiceRevokeUserSessionResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceChangeUserStatusResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeUserSessionResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6465,7 +10557,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceChangeUserStatusResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceRevokeUserSessionResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6475,7 +10567,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceChangeUserStatusResult) ReadField0(iprot thrift.TProtocol) error {
+func (p *IdentityServiceRevokeUserSessionResult) ReadField0(iprot thrift.TProtocol) error {
 	_field := http_base.NewOperationStatusResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -6484,9 +10576,9 @@ func (p *IdentityServiceChangeUserStatusResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *IdentityServiceChangeUserStatusResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeUserSessionResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("changeUserStatus_result"); err != nil {
+	if err = oprot.WriteStructBegin("revokeUserSession_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6512,7 +10604,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceChangeUserStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeUserSessionResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6531,43 +10623,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceChangeUserStatusResult) String() string {
+func (p *IdentityServiceRevokeUserSessionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceChangeUserStatusResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceRevokeUserSessionResult(%+v)", *p)
 
 }
 
-type IdentityServiceUnlockUserArgs struct {
-	Req *UnlockUserRequestDTO `thrift:"req,1"`
+type IdentityServiceRevokeUserSessionsArgs struct {
+	Req *RevokeUserSessionsRequestDTO `thrift:"req,1"`
 }
 
-func NewIdentityServiceUnlockUserArgs() *IdentityServiceUnlockUserArgs {
-	return &IdentityServiceUnlockUserArgs{}
+func NewIdentityServiceRevokeUserSessionsArgs() *IdentityServiceRevokeUserSessionsArgs {
+	return &IdentityServiceRevokeUserSessionsArgs{}
 }
 
-func (p *IdentityServiceUnlockUserArgs) InitDefault() {
+func (p *IdentityServiceRevokeUserSessionsArgs) InitDefault() {
 }
 
-var IdentityServiceUnlockUserArgs_Req_DEFAULT *UnlockUserRequestDTO
+var IdentityServiceRevokeUserSessionsArgs_Req_DEFAULT *RevokeUserSessionsRequestDTO
 
-func (p *IdentityServiceUnlockUserArgs) GetReq() (v *UnlockUserRequestDTO) {
+func (p *IdentityServiceRevokeUserSessionsArgs) GetReq() (v *RevokeUserSessionsRequestDTO) {
 	if !p.IsSetReq() {
-		return IdentityServiceUnlockUserArgs_Req_DEFAULT
+		return IdentityServiceRevokeUserSessionsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-var fieldIDToName_IdentityServiceUnlockUserArgs = map[int16]string{
+var fieldIDToName_IdentityServiceRevokeUserSessionsArgs = map[int16]string{
 	1: "req",
 }
 
-func (p *IdentityServiceUnlockUserArgs) IsSetReq() bool {
+func (p *IdentityServiceRevokeUserSessionsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceUnlockUserArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeUserSessionsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6613,7 +10705,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceUnlockUserArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceRevokeUserSessionsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6623,8 +10715,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceUnlockUserArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := NewUnlockUserRequestDTO()
+func (p *IdentityServiceRevokeUserSessionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewRevokeUserSessionsRequestDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6632,9 +10724,9 @@ func (p *IdentityServiceUnlockUserArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *IdentityServiceUnlockUserArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeUserSessionsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("unlockUser_args"); err != nil {
+	if err = oprot.WriteStructBegin("revokeUserSessions_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6660,7 +10752,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceUnlockUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeUserSessionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6677,43 +10769,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *IdentityServiceUnlockUserArgs) String() string {
+func (p *IdentityServiceRevokeUserSessionsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceUnlockUserArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceRevokeUserSessionsArgs(%+v)", *p)
 
 }
 
-type IdentityServiceUnlockUserResult struct {
+type IdentityServiceRevokeUserSessionsResult struct {
 	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
 }
 
-func NewIdentityServiceUnlockUserResult() *IdentityServiceUnlockUserResult {
-	return &IdentityServiceUnlockUserResult{}
+func NewIdentityServiceRevokeUserSessionsResult() *IdentityServiceRevokeUserSessionsResult {
+	return &IdentityServiceRevokeUserSessionsResult{}
 }
 
-func (p *IdentityServiceUnlockUserResult) InitDefault() {
+func (p *IdentityServiceRevokeUserSessionsResult) InitDefault() {
 }
 
-var IdentityServiceUnlockUserResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
+var IdentityServiceRevokeUserSessionsResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
 
-func (p *IdentityServiceUnlockUserResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
+func (p *IdentityServiceRevokeUserSessionsResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceUnlockUserResult_Success_DEFAULT
+		return IdentityServiceRevokeUserSessionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_IdentityServiceUnlockUserResult = map[int16]string{
+var fieldIDToName_IdentityServiceRevokeUserSessionsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *IdentityServiceUnlockUserResult) IsSetSuccess() bool {
+func (p *IdentityServiceRevokeUserSessionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceUnlockUserResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeUserSessionsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6759,7 +10851,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceUnlockUserResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_IdentityServiceRevokeUserSessionsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6769,7 +10861,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *IdentityServiceUnlockUserResult) ReadField0(iprot thrift.TProtocol) error {
+func (p *IdentityServiceRevokeUserSessionsResult) ReadField0(iprot thrift.TProtocol) error {
 	_field := http_base.NewOperationStatusResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -6778,9 +10870,9 @@ func (p *IdentityServiceUnlockUserResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *IdentityServiceUnlockUserResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeUserSessionsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("unlockUser_result"); err != nil {
+	if err = oprot.WriteStructBegin("revokeUserSessions_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6806,7 +10898,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *IdentityServiceUnlockUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *IdentityServiceRevokeUserSessionsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6825,11 +10917,11 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *IdentityServiceUnlockUserResult) String() string {
+func (p *IdentityServiceRevokeUserSessionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceUnlockUserResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceRevokeUserSessionsResult(%+v)", *p)
 
 }
 
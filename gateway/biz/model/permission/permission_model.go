@@ -305,6 +305,12 @@ type RoleDefinitionDTO struct {
 	UpdatedAt *core.TimestampMS `thrift:"updatedAt,10,optional" json:"updated_at" form:"updatedAt" query:"updatedAt"`
 	/** 当前角色绑定的用户数量（非持久化字段，查询时动态计算） */
 	UserCount *int64 `thrift:"userCount,11,optional" json:"user_count,omitempty" form:"userCount" query:"userCount"`
+	/** 拥有该角色的用户是否必须启用多因素认证 */
+	MfaRequired *bool `thrift:"mfaRequired,12,optional" json:"mfa_required" form:"mfaRequired" query:"mfaRequired"`
+	/** 父角色ID，当前角色继承父角色的全部权限 */
+	ParentRoleID *string `thrift:"parentRoleID,13,optional" json:"parent_role_id,omitempty" form:"parentRoleID" query:"parentRoleID"`
+	/** 生效权限列表：自身权限与继承权限合并去重（仅详情查询返回） */
+	EffectivePermissions []*PermissionDTO `thrift:"effectivePermissions,14,optional,list<PermissionDTO>" json:"effective_permissions,omitempty" form:"effectivePermissions" query:"effectivePermissions"`
 }
 
 func NewRoleDefinitionDTO() *RoleDefinitionDTO {
@@ -413,6 +419,33 @@ func (p *RoleDefinitionDTO) GetUserCount() (v int64) {
 	return *p.UserCount
 }
 
+var RoleDefinitionDTO_MfaRequired_DEFAULT bool
+
+func (p *RoleDefinitionDTO) GetMfaRequired() (v bool) {
+	if !p.IsSetMfaRequired() {
+		return RoleDefinitionDTO_MfaRequired_DEFAULT
+	}
+	return *p.MfaRequired
+}
+
+var RoleDefinitionDTO_ParentRoleID_DEFAULT string
+
+func (p *RoleDefinitionDTO) GetParentRoleID() (v string) {
+	if !p.IsSetParentRoleID() {
+		return RoleDefinitionDTO_ParentRoleID_DEFAULT
+	}
+	return *p.ParentRoleID
+}
+
+var RoleDefinitionDTO_EffectivePermissions_DEFAULT []*PermissionDTO
+
+func (p *RoleDefinitionDTO) GetEffectivePermissions() (v []*PermissionDTO) {
+	if !p.IsSetEffectivePermissions() {
+		return RoleDefinitionDTO_EffectivePermissions_DEFAULT
+	}
+	return p.EffectivePermissions
+}
+
 var fieldIDToName_RoleDefinitionDTO = map[int16]string{
 	1:  "id",
 	2:  "name",
@@ -425,6 +458,9 @@ var fieldIDToName_RoleDefinitionDTO = map[int16]string{
 	9:  "createdAt",
 	10: "updatedAt",
 	11: "userCount",
+	12: "mfaRequired",
+	13: "parentRoleID",
+	14: "effectivePermissions",
 }
 
 func (p *RoleDefinitionDTO) IsSetID() bool {
@@ -471,6 +507,18 @@ func (p *RoleDefinitionDTO) IsSetUserCount() bool {
 	return p.UserCount != nil
 }
 
+func (p *RoleDefinitionDTO) IsSetMfaRequired() bool {
+	return p.MfaRequired != nil
+}
+
+func (p *RoleDefinitionDTO) IsSetParentRoleID() bool {
+	return p.ParentRoleID != nil
+}
+
+func (p *RoleDefinitionDTO) IsSetEffectivePermissions() bool {
+	return p.EffectivePermissions != nil
+}
+
 func (p *RoleDefinitionDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -578,6 +626,30 @@ func (p *RoleDefinitionDTO) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 12:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField12(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 13:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField13(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 14:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField14(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -740,6 +812,51 @@ func (p *RoleDefinitionDTO) ReadField11(iprot thrift.TProtocol) error {
 	p.UserCount = _field
 	return nil
 }
+func (p *RoleDefinitionDTO) ReadField12(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MfaRequired = _field
+	return nil
+}
+func (p *RoleDefinitionDTO) ReadField13(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ParentRoleID = _field
+	return nil
+}
+func (p *RoleDefinitionDTO) ReadField14(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*PermissionDTO, 0, size)
+	values := make([]PermissionDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.EffectivePermissions = _field
+	return nil
+}
 
 func (p *RoleDefinitionDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -791,6 +908,18 @@ func (p *RoleDefinitionDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 11
 			goto WriteFieldError
 		}
+		if err = p.writeField12(oprot); err != nil {
+			fieldId = 12
+			goto WriteFieldError
+		}
+		if err = p.writeField13(oprot); err != nil {
+			fieldId = 13
+			goto WriteFieldError
+		}
+		if err = p.writeField14(oprot); err != nil {
+			fieldId = 14
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1026,6 +1155,71 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
 }
 
+func (p *RoleDefinitionDTO) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMfaRequired() {
+		if err = oprot.WriteFieldBegin("mfaRequired", thrift.BOOL, 12); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.MfaRequired); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 12 end error: ", p), err)
+}
+
+func (p *RoleDefinitionDTO) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetParentRoleID() {
+		if err = oprot.WriteFieldBegin("parentRoleID", thrift.STRING, 13); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ParentRoleID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 13 end error: ", p), err)
+}
+
+func (p *RoleDefinitionDTO) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEffectivePermissions() {
+		if err = oprot.WriteFieldBegin("effectivePermissions", thrift.LIST, 14); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EffectivePermissions)); err != nil {
+			return err
+		}
+		for _, v := range p.EffectivePermissions {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 14 end error: ", p), err)
+}
+
 func (p *RoleDefinitionDTO) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1050,6 +1244,14 @@ type UserRoleAssignmentDTO struct {
 	CreatedAt *core.TimestampMS `thrift:"createdAt,6,optional" json:"created_at" form:"createdAt" query:"createdAt"`
 	/** 最后更新时间 */
 	UpdatedAt *core.TimestampMS `thrift:"updatedAt,7,optional" json:"updated_at" form:"updatedAt" query:"updatedAt"`
+	/** 生效时间，为空表示分配后立即生效 */
+	EffectiveFrom *core.TimestampMS `thrift:"effectiveFrom,8,optional" json:"effective_from,omitempty" form:"effectiveFrom" query:"effectiveFrom"`
+	/** 过期时间，为空表示永久有效 */
+	ExpiresAt *core.TimestampMS `thrift:"expiresAt,9,optional" json:"expires_at,omitempty" form:"expiresAt" query:"expiresAt"`
+	/** 当前是否处于有效期内 */
+	Active *bool `thrift:"active,10,optional" json:"active" form:"active" query:"active"`
+	/** 授权所属组织ID，为空表示全局授权 */
+	OrganizationID *string `thrift:"organizationID,11,optional" json:"organization_id,omitempty" form:"organizationID" query:"organizationID"`
 }
 
 func NewUserRoleAssignmentDTO() *UserRoleAssignmentDTO {
@@ -1122,14 +1324,54 @@ func (p *UserRoleAssignmentDTO) GetUpdatedAt() (v core.TimestampMS) {
 	return *p.UpdatedAt
 }
 
+var UserRoleAssignmentDTO_EffectiveFrom_DEFAULT core.TimestampMS
+
+func (p *UserRoleAssignmentDTO) GetEffectiveFrom() (v core.TimestampMS) {
+	if !p.IsSetEffectiveFrom() {
+		return UserRoleAssignmentDTO_EffectiveFrom_DEFAULT
+	}
+	return *p.EffectiveFrom
+}
+
+var UserRoleAssignmentDTO_ExpiresAt_DEFAULT core.TimestampMS
+
+func (p *UserRoleAssignmentDTO) GetExpiresAt() (v core.TimestampMS) {
+	if !p.IsSetExpiresAt() {
+		return UserRoleAssignmentDTO_ExpiresAt_DEFAULT
+	}
+	return *p.ExpiresAt
+}
+
+var UserRoleAssignmentDTO_Active_DEFAULT bool
+
+func (p *UserRoleAssignmentDTO) GetActive() (v bool) {
+	if !p.IsSetActive() {
+		return UserRoleAssignmentDTO_Active_DEFAULT
+	}
+	return *p.Active
+}
+
+var UserRoleAssignmentDTO_OrganizationID_DEFAULT string
+
+func (p *UserRoleAssignmentDTO) GetOrganizationID() (v string) {
+	if !p.IsSetOrganizationID() {
+		return UserRoleAssignmentDTO_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
+
 var fieldIDToName_UserRoleAssignmentDTO = map[int16]string{
-	1: "id",
-	2: "userID",
-	3: "roleID",
-	4: "createdBy",
-	5: "updatedBy",
-	6: "createdAt",
-	7: "updatedAt",
+	1:  "id",
+	2:  "userID",
+	3:  "roleID",
+	4:  "createdBy",
+	5:  "updatedBy",
+	6:  "createdAt",
+	7:  "updatedAt",
+	8:  "effectiveFrom",
+	9:  "expiresAt",
+	10: "active",
+	11: "organizationID",
 }
 
 func (p *UserRoleAssignmentDTO) IsSetID() bool {
@@ -1160,6 +1402,22 @@ func (p *UserRoleAssignmentDTO) IsSetUpdatedAt() bool {
 	return p.UpdatedAt != nil
 }
 
+func (p *UserRoleAssignmentDTO) IsSetEffectiveFrom() bool {
+	return p.EffectiveFrom != nil
+}
+
+func (p *UserRoleAssignmentDTO) IsSetExpiresAt() bool {
+	return p.ExpiresAt != nil
+}
+
+func (p *UserRoleAssignmentDTO) IsSetActive() bool {
+	return p.Active != nil
+}
+
+func (p *UserRoleAssignmentDTO) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
 func (p *UserRoleAssignmentDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -1235,6 +1493,38 @@ func (p *UserRoleAssignmentDTO) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 8:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 9:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField9(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 10:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField10(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 11:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField11(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1341,6 +1631,50 @@ func (p *UserRoleAssignmentDTO) ReadField7(iprot thrift.TProtocol) error {
 	p.UpdatedAt = _field
 	return nil
 }
+func (p *UserRoleAssignmentDTO) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *core.TimestampMS
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EffectiveFrom = _field
+	return nil
+}
+func (p *UserRoleAssignmentDTO) ReadField9(iprot thrift.TProtocol) error {
+
+	var _field *core.TimestampMS
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExpiresAt = _field
+	return nil
+}
+func (p *UserRoleAssignmentDTO) ReadField10(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Active = _field
+	return nil
+}
+func (p *UserRoleAssignmentDTO) ReadField11(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.OrganizationID = _field
+	return nil
+}
 
 func (p *UserRoleAssignmentDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1376,6 +1710,22 @@ func (p *UserRoleAssignmentDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 7
 			goto WriteFieldError
 		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+		if err = p.writeField9(oprot); err != nil {
+			fieldId = 9
+			goto WriteFieldError
+		}
+		if err = p.writeField10(oprot); err != nil {
+			fieldId = 10
+			goto WriteFieldError
+		}
+		if err = p.writeField11(oprot); err != nil {
+			fieldId = 11
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1527,16 +1877,92 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *UserRoleAssignmentDTO) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *UserRoleAssignmentDTO) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEffectiveFrom() {
+		if err = oprot.WriteFieldBegin("effectiveFrom", thrift.I64, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EffectiveFrom); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("UserRoleAssignmentDTO(%+v)", *p)
-
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-// 角色定义请求/响应 DTO
-/** 角色定义创建请求DTO */
+func (p *UserRoleAssignmentDTO) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExpiresAt() {
+		if err = oprot.WriteFieldBegin("expiresAt", thrift.I64, 9); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExpiresAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 9 end error: ", p), err)
+}
+
+func (p *UserRoleAssignmentDTO) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActive() {
+		if err = oprot.WriteFieldBegin("active", thrift.BOOL, 10); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Active); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 10 end error: ", p), err)
+}
+
+func (p *UserRoleAssignmentDTO) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrganizationID() {
+		if err = oprot.WriteFieldBegin("organizationID", thrift.STRING, 11); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.OrganizationID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 11 end error: ", p), err)
+}
+
+func (p *UserRoleAssignmentDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserRoleAssignmentDTO(%+v)", *p)
+
+}
+
+// 角色定义请求/响应 DTO
+/** 角色定义创建请求DTO */
 type RoleDefinitionCreateRequestDTO struct {
 	/** 角色唯一名称 (英文，用于程序识别) */
 	Name *string `thrift:"name,1,optional" json:"name" form:"name" vd:"@:len($) > 0; msg:'角色名称不能为空'"`
@@ -1546,6 +1972,10 @@ type RoleDefinitionCreateRequestDTO struct {
 	Permissions []*PermissionDTO `thrift:"permissions,3,optional,list<PermissionDTO>" json:"permissions" form:"permissions" `
 	/** 是否为系统内置角色 */
 	IsSystemRole *bool `thrift:"isSystemRole,4,optional" json:"is_system_role,omitempty" form:"is_system_role" `
+	/** 拥有该角色的用户是否必须启用多因素认证 */
+	MfaRequired *bool `thrift:"mfaRequired,5,optional" json:"mfa_required,omitempty" form:"mfa_required" `
+	/** 父角色ID，当前角色继承父角色的全部权限 */
+	ParentRoleID *string `thrift:"parentRoleID,6,optional" json:"parent_role_id,omitempty" form:"parent_role_id" `
 }
 
 func NewRoleDefinitionCreateRequestDTO() *RoleDefinitionCreateRequestDTO {
@@ -1591,11 +2021,31 @@ func (p *RoleDefinitionCreateRequestDTO) GetIsSystemRole() (v bool) {
 	return *p.IsSystemRole
 }
 
+var RoleDefinitionCreateRequestDTO_MfaRequired_DEFAULT bool
+
+func (p *RoleDefinitionCreateRequestDTO) GetMfaRequired() (v bool) {
+	if !p.IsSetMfaRequired() {
+		return RoleDefinitionCreateRequestDTO_MfaRequired_DEFAULT
+	}
+	return *p.MfaRequired
+}
+
+var RoleDefinitionCreateRequestDTO_ParentRoleID_DEFAULT string
+
+func (p *RoleDefinitionCreateRequestDTO) GetParentRoleID() (v string) {
+	if !p.IsSetParentRoleID() {
+		return RoleDefinitionCreateRequestDTO_ParentRoleID_DEFAULT
+	}
+	return *p.ParentRoleID
+}
+
 var fieldIDToName_RoleDefinitionCreateRequestDTO = map[int16]string{
 	1: "name",
 	2: "description",
 	3: "permissions",
 	4: "isSystemRole",
+	5: "mfaRequired",
+	6: "parentRoleID",
 }
 
 func (p *RoleDefinitionCreateRequestDTO) IsSetName() bool {
@@ -1614,6 +2064,14 @@ func (p *RoleDefinitionCreateRequestDTO) IsSetIsSystemRole() bool {
 	return p.IsSystemRole != nil
 }
 
+func (p *RoleDefinitionCreateRequestDTO) IsSetMfaRequired() bool {
+	return p.MfaRequired != nil
+}
+
+func (p *RoleDefinitionCreateRequestDTO) IsSetParentRoleID() bool {
+	return p.ParentRoleID != nil
+}
+
 func (p *RoleDefinitionCreateRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -1665,6 +2123,22 @@ func (p *RoleDefinitionCreateRequestDTO) Read(iprot thrift.TProtocol) (err error
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -1750,6 +2224,28 @@ func (p *RoleDefinitionCreateRequestDTO) ReadField4(iprot thrift.TProtocol) erro
 	p.IsSystemRole = _field
 	return nil
 }
+func (p *RoleDefinitionCreateRequestDTO) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MfaRequired = _field
+	return nil
+}
+func (p *RoleDefinitionCreateRequestDTO) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ParentRoleID = _field
+	return nil
+}
 
 func (p *RoleDefinitionCreateRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -1773,6 +2269,14 @@ func (p *RoleDefinitionCreateRequestDTO) Write(oprot thrift.TProtocol) (err erro
 			fieldId = 4
 			goto WriteFieldError
 		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -1875,6 +2379,44 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
+func (p *RoleDefinitionCreateRequestDTO) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMfaRequired() {
+		if err = oprot.WriteFieldBegin("mfaRequired", thrift.BOOL, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.MfaRequired); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *RoleDefinitionCreateRequestDTO) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetParentRoleID() {
+		if err = oprot.WriteFieldBegin("parentRoleID", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ParentRoleID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
 func (p *RoleDefinitionCreateRequestDTO) String() string {
 	if p == nil {
 		return "<nil>"
@@ -2100,6 +2642,10 @@ type RoleDefinitionUpdateRequestDTO struct {
 	Permissions []*PermissionDTO `thrift:"permissions,4,optional,list<PermissionDTO>" json:"permissions,omitempty" form:"permissions" `
 	/** 角色名称 */
 	Name *string `thrift:"name,5,optional" json:"name,omitempty" form:"name" `
+	/** 拥有该角色的用户是否必须启用多因素认证 */
+	MfaRequired *bool `thrift:"mfaRequired,6,optional" json:"mfa_required,omitempty" form:"mfa_required" `
+	/** 父角色ID，传空字符串表示取消继承 */
+	ParentRoleID *string `thrift:"parentRoleID,7,optional" json:"parent_role_id,omitempty" form:"parent_role_id" `
 }
 
 func NewRoleDefinitionUpdateRequestDTO() *RoleDefinitionUpdateRequestDTO {
@@ -2154,12 +2700,32 @@ func (p *RoleDefinitionUpdateRequestDTO) GetName() (v string) {
 	return *p.Name
 }
 
+var RoleDefinitionUpdateRequestDTO_MfaRequired_DEFAULT bool
+
+func (p *RoleDefinitionUpdateRequestDTO) GetMfaRequired() (v bool) {
+	if !p.IsSetMfaRequired() {
+		return RoleDefinitionUpdateRequestDTO_MfaRequired_DEFAULT
+	}
+	return *p.MfaRequired
+}
+
+var RoleDefinitionUpdateRequestDTO_ParentRoleID_DEFAULT string
+
+func (p *RoleDefinitionUpdateRequestDTO) GetParentRoleID() (v string) {
+	if !p.IsSetParentRoleID() {
+		return RoleDefinitionUpdateRequestDTO_ParentRoleID_DEFAULT
+	}
+	return *p.ParentRoleID
+}
+
 var fieldIDToName_RoleDefinitionUpdateRequestDTO = map[int16]string{
 	1: "roleDefinitionID",
 	2: "description",
 	3: "status",
 	4: "permissions",
 	5: "name",
+	6: "mfaRequired",
+	7: "parentRoleID",
 }
 
 func (p *RoleDefinitionUpdateRequestDTO) IsSetRoleDefinitionID() bool {
@@ -2182,6 +2748,14 @@ func (p *RoleDefinitionUpdateRequestDTO) IsSetName() bool {
 	return p.Name != nil
 }
 
+func (p *RoleDefinitionUpdateRequestDTO) IsSetMfaRequired() bool {
+	return p.MfaRequired != nil
+}
+
+func (p *RoleDefinitionUpdateRequestDTO) IsSetParentRoleID() bool {
+	return p.ParentRoleID != nil
+}
+
 func (p *RoleDefinitionUpdateRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
@@ -2241,6 +2815,22 @@ func (p *RoleDefinitionUpdateRequestDTO) Read(iprot thrift.TProtocol) (err error
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 6:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -2337,6 +2927,28 @@ func (p *RoleDefinitionUpdateRequestDTO) ReadField5(iprot thrift.TProtocol) erro
 	p.Name = _field
 	return nil
 }
+func (p *RoleDefinitionUpdateRequestDTO) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MfaRequired = _field
+	return nil
+}
+func (p *RoleDefinitionUpdateRequestDTO) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ParentRoleID = _field
+	return nil
+}
 
 func (p *RoleDefinitionUpdateRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
@@ -2364,6 +2976,14 @@ func (p *RoleDefinitionUpdateRequestDTO) Write(oprot thrift.TProtocol) (err erro
 			fieldId = 5
 			goto WriteFieldError
 		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -2485,6 +3105,44 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
+func (p *RoleDefinitionUpdateRequestDTO) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMfaRequired() {
+		if err = oprot.WriteFieldBegin("mfaRequired", thrift.BOOL, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.MfaRequired); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *RoleDefinitionUpdateRequestDTO) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetParentRoleID() {
+		if err = oprot.WriteFieldBegin("parentRoleID", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ParentRoleID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
 func (p *RoleDefinitionUpdateRequestDTO) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3816,53 +4474,85 @@ func (p *RoleDefinitionListResponseDTO) String() string {
 
 }
 
-/** 用户角色分配响应DTO */
-type AssignRoleToUserResponseDTO struct {
-	/** 响应状态码 */
-	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
-	/** 创建的分配记录ID */
-	AssignmentID *string `thrift:"assignmentID,2,optional" json:"assignment_id" form:"assignmentID" query:"assignmentID"`
+/** 权限目录项DTO */
+type PermissionCatalogItemDTO struct {
+	/** 权限标识，格式为 resource:action */
+	Permission *string `thrift:"permission,1,optional" json:"permission" form:"permission" query:"permission"`
+	/** 权限作用的资源 */
+	Resource *string `thrift:"resource,2,optional" json:"resource" form:"resource" query:"resource"`
+	/** 对资源执行的操作 */
+	Action *string `thrift:"action,3,optional" json:"action" form:"action" query:"action"`
+	/** 权限描述 */
+	Description *string `thrift:"description,4,optional" json:"description" form:"description" query:"description"`
 }
 
-func NewAssignRoleToUserResponseDTO() *AssignRoleToUserResponseDTO {
-	return &AssignRoleToUserResponseDTO{}
+func NewPermissionCatalogItemDTO() *PermissionCatalogItemDTO {
+	return &PermissionCatalogItemDTO{}
 }
 
-func (p *AssignRoleToUserResponseDTO) InitDefault() {
+func (p *PermissionCatalogItemDTO) InitDefault() {
 }
 
-var AssignRoleToUserResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+var PermissionCatalogItemDTO_Permission_DEFAULT string
 
-func (p *AssignRoleToUserResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
-	if !p.IsSetBaseResp() {
-		return AssignRoleToUserResponseDTO_BaseResp_DEFAULT
+func (p *PermissionCatalogItemDTO) GetPermission() (v string) {
+	if !p.IsSetPermission() {
+		return PermissionCatalogItemDTO_Permission_DEFAULT
 	}
-	return p.BaseResp
+	return *p.Permission
 }
 
-var AssignRoleToUserResponseDTO_AssignmentID_DEFAULT string
+var PermissionCatalogItemDTO_Resource_DEFAULT string
 
-func (p *AssignRoleToUserResponseDTO) GetAssignmentID() (v string) {
-	if !p.IsSetAssignmentID() {
-		return AssignRoleToUserResponseDTO_AssignmentID_DEFAULT
+func (p *PermissionCatalogItemDTO) GetResource() (v string) {
+	if !p.IsSetResource() {
+		return PermissionCatalogItemDTO_Resource_DEFAULT
 	}
-	return *p.AssignmentID
+	return *p.Resource
 }
 
-var fieldIDToName_AssignRoleToUserResponseDTO = map[int16]string{
-	1: "baseResp",
-	2: "assignmentID",
+var PermissionCatalogItemDTO_Action_DEFAULT string
+
+func (p *PermissionCatalogItemDTO) GetAction() (v string) {
+	if !p.IsSetAction() {
+		return PermissionCatalogItemDTO_Action_DEFAULT
+	}
+	return *p.Action
 }
 
-func (p *AssignRoleToUserResponseDTO) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+var PermissionCatalogItemDTO_Description_DEFAULT string
+
+func (p *PermissionCatalogItemDTO) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return PermissionCatalogItemDTO_Description_DEFAULT
+	}
+	return *p.Description
 }
 
-func (p *AssignRoleToUserResponseDTO) IsSetAssignmentID() bool {
-	return p.AssignmentID != nil
+var fieldIDToName_PermissionCatalogItemDTO = map[int16]string{
+	1: "permission",
+	2: "resource",
+	3: "action",
+	4: "description",
 }
 
-func (p *AssignRoleToUserResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *PermissionCatalogItemDTO) IsSetPermission() bool {
+	return p.Permission != nil
+}
+
+func (p *PermissionCatalogItemDTO) IsSetResource() bool {
+	return p.Resource != nil
+}
+
+func (p *PermissionCatalogItemDTO) IsSetAction() bool {
+	return p.Action != nil
+}
+
+func (p *PermissionCatalogItemDTO) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *PermissionCatalogItemDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3882,7 +4572,7 @@ func (p *AssignRoleToUserResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -3897,6 +4587,22 @@ func (p *AssignRoleToUserResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -3916,7 +4622,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AssignRoleToUserResponseDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PermissionCatalogItemDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3926,15 +4632,29 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *AssignRoleToUserResponseDTO) ReadField1(iprot thrift.TProtocol) error {
-	_field := http_base.NewBaseResponseDTO()
-	if err := _field.Read(iprot); err != nil {
+func (p *PermissionCatalogItemDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Permission = _field
+	return nil
+}
+func (p *PermissionCatalogItemDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.BaseResp = _field
+	p.Resource = _field
 	return nil
 }
-func (p *AssignRoleToUserResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+func (p *PermissionCatalogItemDTO) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -3942,13 +4662,24 @@ func (p *AssignRoleToUserResponseDTO) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.AssignmentID = _field
+	p.Action = _field
 	return nil
 }
+func (p *PermissionCatalogItemDTO) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *AssignRoleToUserResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Description = _field
+	return nil
+}
+
+func (p *PermissionCatalogItemDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("AssignRoleToUserResponseDTO"); err != nil {
+	if err = oprot.WriteStructBegin("PermissionCatalogItemDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3960,6 +4691,14 @@ func (p *AssignRoleToUserResponseDTO) Write(oprot thrift.TProtocol) (err error)
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -3978,12 +4717,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *AssignRoleToUserResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseResp() {
-		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+func (p *PermissionCatalogItemDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermission() {
+		if err = oprot.WriteFieldBegin("permission", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.BaseResp.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.Permission); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -3997,12 +4736,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *AssignRoleToUserResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAssignmentID() {
-		if err = oprot.WriteFieldBegin("assignmentID", thrift.STRING, 2); err != nil {
+func (p *PermissionCatalogItemDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResource() {
+		if err = oprot.WriteFieldBegin("resource", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.AssignmentID); err != nil {
+		if err := oprot.WriteString(*p.Resource); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4016,45 +4755,99 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *AssignRoleToUserResponseDTO) String() string {
+func (p *PermissionCatalogItemDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAction() {
+		if err = oprot.WriteFieldBegin("action", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Action); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *PermissionCatalogItemDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDescription() {
+		if err = oprot.WriteFieldBegin("description", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Description); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *PermissionCatalogItemDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("AssignRoleToUserResponseDTO(%+v)", *p)
+	return fmt.Sprintf("PermissionCatalogItemDTO(%+v)", *p)
 
 }
 
-/** 用户最后角色分配请求DTO */
-type GetLastUserRoleAssignmentRequestDTO struct {
-	/** 用户ID */
-	UserID *string `thrift:"userID,1,optional" json:"user_id" path:"userID" vd:"@:len($) > 0; msg:'用户ID不能为空'"`
+/** 权限目录分组DTO */
+type PermissionCatalogGroupDTO struct {
+	/** 分组名称 */
+	Name *string `thrift:"name,1,optional" json:"name" form:"name" query:"name"`
+	/** 分组内的权限列表 */
+	Permissions []*PermissionCatalogItemDTO `thrift:"permissions,2,optional,list<PermissionCatalogItemDTO>" json:"permissions" form:"permissions" query:"permissions"`
 }
 
-func NewGetLastUserRoleAssignmentRequestDTO() *GetLastUserRoleAssignmentRequestDTO {
-	return &GetLastUserRoleAssignmentRequestDTO{}
+func NewPermissionCatalogGroupDTO() *PermissionCatalogGroupDTO {
+	return &PermissionCatalogGroupDTO{}
 }
 
-func (p *GetLastUserRoleAssignmentRequestDTO) InitDefault() {
+func (p *PermissionCatalogGroupDTO) InitDefault() {
 }
 
-var GetLastUserRoleAssignmentRequestDTO_UserID_DEFAULT string
+var PermissionCatalogGroupDTO_Name_DEFAULT string
 
-func (p *GetLastUserRoleAssignmentRequestDTO) GetUserID() (v string) {
-	if !p.IsSetUserID() {
-		return GetLastUserRoleAssignmentRequestDTO_UserID_DEFAULT
+func (p *PermissionCatalogGroupDTO) GetName() (v string) {
+	if !p.IsSetName() {
+		return PermissionCatalogGroupDTO_Name_DEFAULT
 	}
-	return *p.UserID
+	return *p.Name
 }
 
-var fieldIDToName_GetLastUserRoleAssignmentRequestDTO = map[int16]string{
-	1: "userID",
+var PermissionCatalogGroupDTO_Permissions_DEFAULT []*PermissionCatalogItemDTO
+
+func (p *PermissionCatalogGroupDTO) GetPermissions() (v []*PermissionCatalogItemDTO) {
+	if !p.IsSetPermissions() {
+		return PermissionCatalogGroupDTO_Permissions_DEFAULT
+	}
+	return p.Permissions
 }
 
-func (p *GetLastUserRoleAssignmentRequestDTO) IsSetUserID() bool {
-	return p.UserID != nil
+var fieldIDToName_PermissionCatalogGroupDTO = map[int16]string{
+	1: "name",
+	2: "permissions",
 }
 
-func (p *GetLastUserRoleAssignmentRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *PermissionCatalogGroupDTO) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *PermissionCatalogGroupDTO) IsSetPermissions() bool {
+	return p.Permissions != nil
+}
+
+func (p *PermissionCatalogGroupDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4081,6 +4874,14 @@ func (p *GetLastUserRoleAssignmentRequestDTO) Read(iprot thrift.TProtocol) (err
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4100,7 +4901,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetLastUserRoleAssignmentRequestDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PermissionCatalogGroupDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4110,7 +4911,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetLastUserRoleAssignmentRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+func (p *PermissionCatalogGroupDTO) ReadField1(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4118,13 +4919,36 @@ func (p *GetLastUserRoleAssignmentRequestDTO) ReadField1(iprot thrift.TProtocol)
 	} else {
 		_field = &v
 	}
-	p.UserID = _field
+	p.Name = _field
 	return nil
 }
+func (p *PermissionCatalogGroupDTO) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*PermissionCatalogItemDTO, 0, size)
+	values := make([]PermissionCatalogItemDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-func (p *GetLastUserRoleAssignmentRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Permissions = _field
+	return nil
+}
+
+func (p *PermissionCatalogGroupDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLastUserRoleAssignmentRequestDTO"); err != nil {
+	if err = oprot.WriteStructBegin("PermissionCatalogGroupDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4132,6 +4956,10 @@ func (p *GetLastUserRoleAssignmentRequestDTO) Write(oprot thrift.TProtocol) (err
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4150,12 +4978,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetLastUserRoleAssignmentRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserID() {
-		if err = oprot.WriteFieldBegin("userID", thrift.STRING, 1); err != nil {
+func (p *PermissionCatalogGroupDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.UserID); err != nil {
+		if err := oprot.WriteString(*p.Name); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4169,61 +4997,88 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetLastUserRoleAssignmentRequestDTO) String() string {
+func (p *PermissionCatalogGroupDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissions() {
+		if err = oprot.WriteFieldBegin("permissions", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Permissions)); err != nil {
+			return err
+		}
+		for _, v := range p.Permissions {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *PermissionCatalogGroupDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetLastUserRoleAssignmentRequestDTO(%+v)", *p)
+	return fmt.Sprintf("PermissionCatalogGroupDTO(%+v)", *p)
 
 }
 
-/** 用户最后角色分配响应DTO */
-type GetLastUserRoleAssignmentResponseDTO struct {
+/** 获取权限目录响应DTO */
+type GetPermissionCatalogResponseDTO struct {
 	/** 响应状态码 */
 	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
-	/** 用户角色分配信息 */
-	Assignment *UserRoleAssignmentDTO `thrift:"assignment,2,optional" json:"assignment" form:"assignment" query:"assignment"`
+	/** 按分组排列的权限目录 */
+	Groups []*PermissionCatalogGroupDTO `thrift:"groups,2,optional,list<PermissionCatalogGroupDTO>" json:"groups" form:"groups" query:"groups"`
 }
 
-func NewGetLastUserRoleAssignmentResponseDTO() *GetLastUserRoleAssignmentResponseDTO {
-	return &GetLastUserRoleAssignmentResponseDTO{}
+func NewGetPermissionCatalogResponseDTO() *GetPermissionCatalogResponseDTO {
+	return &GetPermissionCatalogResponseDTO{}
 }
 
-func (p *GetLastUserRoleAssignmentResponseDTO) InitDefault() {
+func (p *GetPermissionCatalogResponseDTO) InitDefault() {
 }
 
-var GetLastUserRoleAssignmentResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+var GetPermissionCatalogResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
 
-func (p *GetLastUserRoleAssignmentResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+func (p *GetPermissionCatalogResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
 	if !p.IsSetBaseResp() {
-		return GetLastUserRoleAssignmentResponseDTO_BaseResp_DEFAULT
+		return GetPermissionCatalogResponseDTO_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var GetLastUserRoleAssignmentResponseDTO_Assignment_DEFAULT *UserRoleAssignmentDTO
+var GetPermissionCatalogResponseDTO_Groups_DEFAULT []*PermissionCatalogGroupDTO
 
-func (p *GetLastUserRoleAssignmentResponseDTO) GetAssignment() (v *UserRoleAssignmentDTO) {
-	if !p.IsSetAssignment() {
-		return GetLastUserRoleAssignmentResponseDTO_Assignment_DEFAULT
+func (p *GetPermissionCatalogResponseDTO) GetGroups() (v []*PermissionCatalogGroupDTO) {
+	if !p.IsSetGroups() {
+		return GetPermissionCatalogResponseDTO_Groups_DEFAULT
 	}
-	return p.Assignment
+	return p.Groups
 }
 
-var fieldIDToName_GetLastUserRoleAssignmentResponseDTO = map[int16]string{
+var fieldIDToName_GetPermissionCatalogResponseDTO = map[int16]string{
 	1: "baseResp",
-	2: "assignment",
+	2: "groups",
 }
 
-func (p *GetLastUserRoleAssignmentResponseDTO) IsSetBaseResp() bool {
+func (p *GetPermissionCatalogResponseDTO) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *GetLastUserRoleAssignmentResponseDTO) IsSetAssignment() bool {
-	return p.Assignment != nil
+func (p *GetPermissionCatalogResponseDTO) IsSetGroups() bool {
+	return p.Groups != nil
 }
 
-func (p *GetLastUserRoleAssignmentResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetPermissionCatalogResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4251,7 +5106,7 @@ func (p *GetLastUserRoleAssignmentResponseDTO) Read(iprot thrift.TProtocol) (err
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4277,7 +5132,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetLastUserRoleAssignmentResponseDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetPermissionCatalogResponseDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4287,7 +5142,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetLastUserRoleAssignmentResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetPermissionCatalogResponseDTO) ReadField1(iprot thrift.TProtocol) error {
 	_field := http_base.NewBaseResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -4295,18 +5150,33 @@ func (p *GetLastUserRoleAssignmentResponseDTO) ReadField1(iprot thrift.TProtocol
 	p.BaseResp = _field
 	return nil
 }
-func (p *GetLastUserRoleAssignmentResponseDTO) ReadField2(iprot thrift.TProtocol) error {
-	_field := NewUserRoleAssignmentDTO()
-	if err := _field.Read(iprot); err != nil {
+func (p *GetPermissionCatalogResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
 		return err
 	}
-	p.Assignment = _field
+	_field := make([]*PermissionCatalogGroupDTO, 0, size)
+	values := make([]PermissionCatalogGroupDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Groups = _field
 	return nil
 }
 
-func (p *GetLastUserRoleAssignmentResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetPermissionCatalogResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetLastUserRoleAssignmentResponseDTO"); err != nil {
+	if err = oprot.WriteStructBegin("GetPermissionCatalogResponseDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4336,7 +5206,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetLastUserRoleAssignmentResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetPermissionCatalogResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBaseResp() {
 		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
@@ -4355,12 +5225,20 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetLastUserRoleAssignmentResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAssignment() {
-		if err = oprot.WriteFieldBegin("assignment", thrift.STRUCT, 2); err != nil {
+func (p *GetPermissionCatalogResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGroups() {
+		if err = oprot.WriteFieldBegin("groups", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.Assignment.Write(oprot); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Groups)); err != nil {
+			return err
+		}
+		for _, v := range p.Groups {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4374,77 +5252,61 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetLastUserRoleAssignmentResponseDTO) String() string {
+func (p *GetPermissionCatalogResponseDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetLastUserRoleAssignmentResponseDTO(%+v)", *p)
+	return fmt.Sprintf("GetPermissionCatalogResponseDTO(%+v)", *p)
 
 }
 
-/** 用户角色查询请求DTO */
-type UserRoleQueryRequestDTO struct {
-	/** 用户ID */
-	UserID *string `thrift:"userID,1,optional" json:"user_id,omitempty" query:"userID" `
-	/** 角色ID */
-	RoleID *string `thrift:"roleID,2,optional" json:"role_id,omitempty" query:"roleID" `
-	/** 分页请求参数 */
-	Page *http_base.PageRequestDTO `thrift:"page,3,optional" json:"page,omitempty" form:"-" query:"-"`
-}
-
-func NewUserRoleQueryRequestDTO() *UserRoleQueryRequestDTO {
-	return &UserRoleQueryRequestDTO{}
+/** 用户角色分配响应DTO */
+type AssignRoleToUserResponseDTO struct {
+	/** 响应状态码 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 创建的分配记录ID */
+	AssignmentID *string `thrift:"assignmentID,2,optional" json:"assignment_id" form:"assignmentID" query:"assignmentID"`
 }
 
-func (p *UserRoleQueryRequestDTO) InitDefault() {
+func NewAssignRoleToUserResponseDTO() *AssignRoleToUserResponseDTO {
+	return &AssignRoleToUserResponseDTO{}
 }
 
-var UserRoleQueryRequestDTO_UserID_DEFAULT string
-
-func (p *UserRoleQueryRequestDTO) GetUserID() (v string) {
-	if !p.IsSetUserID() {
-		return UserRoleQueryRequestDTO_UserID_DEFAULT
-	}
-	return *p.UserID
+func (p *AssignRoleToUserResponseDTO) InitDefault() {
 }
 
-var UserRoleQueryRequestDTO_RoleID_DEFAULT string
+var AssignRoleToUserResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
 
-func (p *UserRoleQueryRequestDTO) GetRoleID() (v string) {
-	if !p.IsSetRoleID() {
-		return UserRoleQueryRequestDTO_RoleID_DEFAULT
+func (p *AssignRoleToUserResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return AssignRoleToUserResponseDTO_BaseResp_DEFAULT
 	}
-	return *p.RoleID
+	return p.BaseResp
 }
 
-var UserRoleQueryRequestDTO_Page_DEFAULT *http_base.PageRequestDTO
+var AssignRoleToUserResponseDTO_AssignmentID_DEFAULT string
 
-func (p *UserRoleQueryRequestDTO) GetPage() (v *http_base.PageRequestDTO) {
-	if !p.IsSetPage() {
-		return UserRoleQueryRequestDTO_Page_DEFAULT
+func (p *AssignRoleToUserResponseDTO) GetAssignmentID() (v string) {
+	if !p.IsSetAssignmentID() {
+		return AssignRoleToUserResponseDTO_AssignmentID_DEFAULT
 	}
-	return p.Page
-}
-
-var fieldIDToName_UserRoleQueryRequestDTO = map[int16]string{
-	1: "userID",
-	2: "roleID",
-	3: "page",
+	return *p.AssignmentID
 }
 
-func (p *UserRoleQueryRequestDTO) IsSetUserID() bool {
-	return p.UserID != nil
+var fieldIDToName_AssignRoleToUserResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "assignmentID",
 }
 
-func (p *UserRoleQueryRequestDTO) IsSetRoleID() bool {
-	return p.RoleID != nil
+func (p *AssignRoleToUserResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *UserRoleQueryRequestDTO) IsSetPage() bool {
-	return p.Page != nil
+func (p *AssignRoleToUserResponseDTO) IsSetAssignmentID() bool {
+	return p.AssignmentID != nil
 }
 
-func (p *UserRoleQueryRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *AssignRoleToUserResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4464,7 +5326,7 @@ func (p *UserRoleQueryRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -4479,14 +5341,6 @@ func (p *UserRoleQueryRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 3:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4506,7 +5360,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserRoleQueryRequestDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_AssignRoleToUserResponseDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4516,18 +5370,15 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserRoleQueryRequestDTO) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *AssignRoleToUserResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.UserID = _field
+	p.BaseResp = _field
 	return nil
 }
-func (p *UserRoleQueryRequestDTO) ReadField2(iprot thrift.TProtocol) error {
+func (p *AssignRoleToUserResponseDTO) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -4535,21 +5386,13 @@ func (p *UserRoleQueryRequestDTO) ReadField2(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.RoleID = _field
-	return nil
-}
-func (p *UserRoleQueryRequestDTO) ReadField3(iprot thrift.TProtocol) error {
-	_field := http_base.NewPageRequestDTO()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Page = _field
+	p.AssignmentID = _field
 	return nil
 }
 
-func (p *UserRoleQueryRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *AssignRoleToUserResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserRoleQueryRequestDTO"); err != nil {
+	if err = oprot.WriteStructBegin("AssignRoleToUserResponseDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4561,10 +5404,6 @@ func (p *UserRoleQueryRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4583,12 +5422,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserRoleQueryRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserID() {
-		if err = oprot.WriteFieldBegin("userID", thrift.STRING, 1); err != nil {
+func (p *AssignRoleToUserResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.UserID); err != nil {
+		if err := p.BaseResp.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4602,12 +5441,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserRoleQueryRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRoleID() {
-		if err = oprot.WriteFieldBegin("roleID", thrift.STRING, 2); err != nil {
+func (p *AssignRoleToUserResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAssignmentID() {
+		if err = oprot.WriteFieldBegin("assignmentID", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.RoleID); err != nil {
+		if err := oprot.WriteString(*p.AssignmentID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4621,103 +5460,52 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *UserRoleQueryRequestDTO) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPage() {
-		if err = oprot.WriteFieldBegin("page", thrift.STRUCT, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.Page.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *UserRoleQueryRequestDTO) String() string {
+func (p *AssignRoleToUserResponseDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserRoleQueryRequestDTO(%+v)", *p)
+	return fmt.Sprintf("AssignRoleToUserResponseDTO(%+v)", *p)
 
 }
 
-/** 用户角色列表响应DTO */
-type UserRoleListResponseDTO struct {
-	/** 响应状态码 */
-	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
-	/** 用户角色分配列表 */
-	Assignments []*UserRoleAssignmentDTO `thrift:"assignments,2,optional,list<UserRoleAssignmentDTO>" json:"assignments" form:"assignments" query:"assignments"`
-	/** 分页响应参数 */
-	Page *http_base.PageResponseDTO `thrift:"page,3,optional" json:"page" form:"page" query:"page"`
+/** 用户最后角色分配请求DTO */
+type GetLastUserRoleAssignmentRequestDTO struct {
+	/** 用户ID */
+	UserID *string `thrift:"userID,1,optional" json:"user_id" path:"userID" vd:"@:len($) > 0; msg:'用户ID不能为空'"`
 }
 
-func NewUserRoleListResponseDTO() *UserRoleListResponseDTO {
-	return &UserRoleListResponseDTO{}
+func NewGetLastUserRoleAssignmentRequestDTO() *GetLastUserRoleAssignmentRequestDTO {
+	return &GetLastUserRoleAssignmentRequestDTO{}
 }
 
-func (p *UserRoleListResponseDTO) InitDefault() {
+func (p *GetLastUserRoleAssignmentRequestDTO) InitDefault() {
 }
 
-var UserRoleListResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+var GetLastUserRoleAssignmentRequestDTO_UserID_DEFAULT string
 
-func (p *UserRoleListResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
-	if !p.IsSetBaseResp() {
-		return UserRoleListResponseDTO_BaseResp_DEFAULT
+func (p *GetLastUserRoleAssignmentRequestDTO) GetUserID() (v string) {
+	if !p.IsSetUserID() {
+		return GetLastUserRoleAssignmentRequestDTO_UserID_DEFAULT
 	}
-	return p.BaseResp
+	return *p.UserID
 }
 
-var UserRoleListResponseDTO_Assignments_DEFAULT []*UserRoleAssignmentDTO
-
-func (p *UserRoleListResponseDTO) GetAssignments() (v []*UserRoleAssignmentDTO) {
-	if !p.IsSetAssignments() {
-		return UserRoleListResponseDTO_Assignments_DEFAULT
-	}
-	return p.Assignments
+var fieldIDToName_GetLastUserRoleAssignmentRequestDTO = map[int16]string{
+	1: "userID",
 }
 
-var UserRoleListResponseDTO_Page_DEFAULT *http_base.PageResponseDTO
-
-func (p *UserRoleListResponseDTO) GetPage() (v *http_base.PageResponseDTO) {
-	if !p.IsSetPage() {
-		return UserRoleListResponseDTO_Page_DEFAULT
-	}
-	return p.Page
+func (p *GetLastUserRoleAssignmentRequestDTO) IsSetUserID() bool {
+	return p.UserID != nil
 }
 
-var fieldIDToName_UserRoleListResponseDTO = map[int16]string{
-	1: "baseResp",
-	2: "assignments",
-	3: "page",
-}
+func (p *GetLastUserRoleAssignmentRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
-func (p *UserRoleListResponseDTO) IsSetBaseResp() bool {
-	return p.BaseResp != nil
-}
+	var fieldTypeId thrift.TType
+	var fieldId int16
 
-func (p *UserRoleListResponseDTO) IsSetAssignments() bool {
-	return p.Assignments != nil
-}
-
-func (p *UserRoleListResponseDTO) IsSetPage() bool {
-	return p.Page != nil
-}
-
-func (p *UserRoleListResponseDTO) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
 
 	for {
 		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
@@ -4730,29 +5518,13 @@ func (p *UserRoleListResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
-		case 2:
-			if fieldTypeId == thrift.LIST {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -4772,7 +5544,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserRoleListResponseDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetLastUserRoleAssignmentRequestDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4782,49 +5554,21 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserRoleListResponseDTO) ReadField1(iprot thrift.TProtocol) error {
-	_field := http_base.NewBaseResponseDTO()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.BaseResp = _field
-	return nil
-}
-func (p *UserRoleListResponseDTO) ReadField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return err
-	}
-	_field := make([]*UserRoleAssignmentDTO, 0, size)
-	values := make([]UserRoleAssignmentDTO, size)
-	for i := 0; i < size; i++ {
-		_elem := &values[i]
-		_elem.InitDefault()
-
-		if err := _elem.Read(iprot); err != nil {
-			return err
-		}
+func (p *GetLastUserRoleAssignmentRequestDTO) ReadField1(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return err
-	}
-	p.Assignments = _field
-	return nil
-}
-func (p *UserRoleListResponseDTO) ReadField3(iprot thrift.TProtocol) error {
-	_field := http_base.NewPageResponseDTO()
-	if err := _field.Read(iprot); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.Page = _field
+	p.UserID = _field
 	return nil
 }
 
-func (p *UserRoleListResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetLastUserRoleAssignmentRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UserRoleListResponseDTO"); err != nil {
+	if err = oprot.WriteStructBegin("GetLastUserRoleAssignmentRequestDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4832,14 +5576,6 @@ func (p *UserRoleListResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -4858,12 +5594,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserRoleListResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseResp() {
-		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+func (p *GetLastUserRoleAssignmentRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserID() {
+		if err = oprot.WriteFieldBegin("userID", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.BaseResp.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.UserID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -4877,91 +5613,61 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserRoleListResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetAssignments() {
-		if err = oprot.WriteFieldBegin("assignments", thrift.LIST, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Assignments)); err != nil {
-			return err
-		}
-		for _, v := range p.Assignments {
-			if err := v.Write(oprot); err != nil {
-				return err
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
-
-func (p *UserRoleListResponseDTO) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPage() {
-		if err = oprot.WriteFieldBegin("page", thrift.STRUCT, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.Page.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
-}
-
-func (p *UserRoleListResponseDTO) String() string {
+func (p *GetLastUserRoleAssignmentRequestDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserRoleListResponseDTO(%+v)", *p)
+	return fmt.Sprintf("GetLastUserRoleAssignmentRequestDTO(%+v)", *p)
 
 }
 
-/** 根据角色ID获取用户请求DTO */
-type GetUsersByRoleRequestDTO struct {
-	/** 角色ID */
-	RoleID *string `thrift:"roleID,1,optional" json:"-" path:"roleID" vd:"@:len($) > 0; msg:'角色ID不能为空'"`
+/** 用户最后角色分配响应DTO */
+type GetLastUserRoleAssignmentResponseDTO struct {
+	/** 响应状态码 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 用户角色分配信息 */
+	Assignment *UserRoleAssignmentDTO `thrift:"assignment,2,optional" json:"assignment" form:"assignment" query:"assignment"`
 }
 
-func NewGetUsersByRoleRequestDTO() *GetUsersByRoleRequestDTO {
-	return &GetUsersByRoleRequestDTO{}
+func NewGetLastUserRoleAssignmentResponseDTO() *GetLastUserRoleAssignmentResponseDTO {
+	return &GetLastUserRoleAssignmentResponseDTO{}
 }
 
-func (p *GetUsersByRoleRequestDTO) InitDefault() {
+func (p *GetLastUserRoleAssignmentResponseDTO) InitDefault() {
 }
 
-var GetUsersByRoleRequestDTO_RoleID_DEFAULT string
+var GetLastUserRoleAssignmentResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
 
-func (p *GetUsersByRoleRequestDTO) GetRoleID() (v string) {
-	if !p.IsSetRoleID() {
-		return GetUsersByRoleRequestDTO_RoleID_DEFAULT
+func (p *GetLastUserRoleAssignmentResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return GetLastUserRoleAssignmentResponseDTO_BaseResp_DEFAULT
 	}
-	return *p.RoleID
+	return p.BaseResp
 }
 
-var fieldIDToName_GetUsersByRoleRequestDTO = map[int16]string{
-	1: "roleID",
+var GetLastUserRoleAssignmentResponseDTO_Assignment_DEFAULT *UserRoleAssignmentDTO
+
+func (p *GetLastUserRoleAssignmentResponseDTO) GetAssignment() (v *UserRoleAssignmentDTO) {
+	if !p.IsSetAssignment() {
+		return GetLastUserRoleAssignmentResponseDTO_Assignment_DEFAULT
+	}
+	return p.Assignment
 }
 
-func (p *GetUsersByRoleRequestDTO) IsSetRoleID() bool {
-	return p.RoleID != nil
+var fieldIDToName_GetLastUserRoleAssignmentResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "assignment",
 }
 
-func (p *GetUsersByRoleRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetLastUserRoleAssignmentResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetLastUserRoleAssignmentResponseDTO) IsSetAssignment() bool {
+	return p.Assignment != nil
+}
+
+func (p *GetLastUserRoleAssignmentResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4981,13 +5687,21 @@ func (p *GetUsersByRoleRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5007,7 +5721,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUsersByRoleRequestDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetLastUserRoleAssignmentResponseDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5017,21 +5731,26 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetUsersByRoleRequestDTO) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *GetLastUserRoleAssignmentResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.RoleID = _field
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetLastUserRoleAssignmentResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewUserRoleAssignmentDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Assignment = _field
 	return nil
 }
 
-func (p *GetUsersByRoleRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetLastUserRoleAssignmentResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUsersByRoleRequestDTO"); err != nil {
+	if err = oprot.WriteStructBegin("GetLastUserRoleAssignmentResponseDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5039,6 +5758,10 @@ func (p *GetUsersByRoleRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5057,12 +5780,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetUsersByRoleRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRoleID() {
-		if err = oprot.WriteFieldBegin("roleID", thrift.STRING, 1); err != nil {
+func (p *GetLastUserRoleAssignmentResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.RoleID); err != nil {
+		if err := p.BaseResp.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5076,77 +5799,128 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetUsersByRoleRequestDTO) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *GetLastUserRoleAssignmentResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAssignment() {
+		if err = oprot.WriteFieldBegin("assignment", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Assignment.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("GetUsersByRoleRequestDTO(%+v)", *p)
-
-}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
 
-/** 根据角色ID获取用户响应DTO */
-type GetUsersByRoleResponseDTO struct {
-	/** 响应状态码 */
-	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+func (p *GetLastUserRoleAssignmentResponseDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetLastUserRoleAssignmentResponseDTO(%+v)", *p)
+
+}
+
+/** 用户角色查询请求DTO */
+type UserRoleQueryRequestDTO struct {
+	/** 用户ID */
+	UserID *string `thrift:"userID,1,optional" json:"user_id,omitempty" query:"userID" `
 	/** 角色ID */
-	RoleID *string `thrift:"roleID,2,optional" json:"role_id" form:"roleID" query:"roleID"`
-	/** 该角色下所有用户的ID列表 */
-	UserIDs []string `thrift:"userIDs,3,optional,list<string>" json:"user_ids" form:"userIDs" query:"userIDs"`
+	RoleID *string `thrift:"roleID,2,optional" json:"role_id,omitempty" query:"roleID" `
+	/** 分页请求参数 */
+	Page *http_base.PageRequestDTO `thrift:"page,3,optional" json:"page,omitempty" form:"-" query:"-"`
+	/** 仅返回在该时间之前过期的分配 (毫秒时间戳) */
+	ExpiringBefore *core.TimestampMS `thrift:"expiringBefore,4,optional" json:"expiring_before,omitempty" query:"expiringBefore" `
+	/** 仅返回该组织内的授权 */
+	OrganizationID *string `thrift:"organizationID,5,optional" json:"organization_id,omitempty" query:"organizationID" vd:"@:len($)==0 || len($)==36; msg:'组织ID格式不正确'"`
 }
 
-func NewGetUsersByRoleResponseDTO() *GetUsersByRoleResponseDTO {
-	return &GetUsersByRoleResponseDTO{}
+func NewUserRoleQueryRequestDTO() *UserRoleQueryRequestDTO {
+	return &UserRoleQueryRequestDTO{}
 }
 
-func (p *GetUsersByRoleResponseDTO) InitDefault() {
+func (p *UserRoleQueryRequestDTO) InitDefault() {
 }
 
-var GetUsersByRoleResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+var UserRoleQueryRequestDTO_UserID_DEFAULT string
 
-func (p *GetUsersByRoleResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
-	if !p.IsSetBaseResp() {
-		return GetUsersByRoleResponseDTO_BaseResp_DEFAULT
+func (p *UserRoleQueryRequestDTO) GetUserID() (v string) {
+	if !p.IsSetUserID() {
+		return UserRoleQueryRequestDTO_UserID_DEFAULT
 	}
-	return p.BaseResp
+	return *p.UserID
 }
 
-var GetUsersByRoleResponseDTO_RoleID_DEFAULT string
+var UserRoleQueryRequestDTO_RoleID_DEFAULT string
 
-func (p *GetUsersByRoleResponseDTO) GetRoleID() (v string) {
+func (p *UserRoleQueryRequestDTO) GetRoleID() (v string) {
 	if !p.IsSetRoleID() {
-		return GetUsersByRoleResponseDTO_RoleID_DEFAULT
+		return UserRoleQueryRequestDTO_RoleID_DEFAULT
 	}
 	return *p.RoleID
 }
 
-var GetUsersByRoleResponseDTO_UserIDs_DEFAULT []string
+var UserRoleQueryRequestDTO_Page_DEFAULT *http_base.PageRequestDTO
 
-func (p *GetUsersByRoleResponseDTO) GetUserIDs() (v []string) {
-	if !p.IsSetUserIDs() {
-		return GetUsersByRoleResponseDTO_UserIDs_DEFAULT
+func (p *UserRoleQueryRequestDTO) GetPage() (v *http_base.PageRequestDTO) {
+	if !p.IsSetPage() {
+		return UserRoleQueryRequestDTO_Page_DEFAULT
 	}
-	return p.UserIDs
+	return p.Page
 }
 
-var fieldIDToName_GetUsersByRoleResponseDTO = map[int16]string{
-	1: "baseResp",
+var UserRoleQueryRequestDTO_ExpiringBefore_DEFAULT core.TimestampMS
+
+func (p *UserRoleQueryRequestDTO) GetExpiringBefore() (v core.TimestampMS) {
+	if !p.IsSetExpiringBefore() {
+		return UserRoleQueryRequestDTO_ExpiringBefore_DEFAULT
+	}
+	return *p.ExpiringBefore
+}
+
+var UserRoleQueryRequestDTO_OrganizationID_DEFAULT string
+
+func (p *UserRoleQueryRequestDTO) GetOrganizationID() (v string) {
+	if !p.IsSetOrganizationID() {
+		return UserRoleQueryRequestDTO_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
+
+var fieldIDToName_UserRoleQueryRequestDTO = map[int16]string{
+	1: "userID",
 	2: "roleID",
-	3: "userIDs",
+	3: "page",
+	4: "expiringBefore",
+	5: "organizationID",
 }
 
-func (p *GetUsersByRoleResponseDTO) IsSetBaseResp() bool {
-	return p.BaseResp != nil
+func (p *UserRoleQueryRequestDTO) IsSetUserID() bool {
+	return p.UserID != nil
 }
 
-func (p *GetUsersByRoleResponseDTO) IsSetRoleID() bool {
+func (p *UserRoleQueryRequestDTO) IsSetRoleID() bool {
 	return p.RoleID != nil
 }
 
-func (p *GetUsersByRoleResponseDTO) IsSetUserIDs() bool {
-	return p.UserIDs != nil
+func (p *UserRoleQueryRequestDTO) IsSetPage() bool {
+	return p.Page != nil
 }
 
-func (p *GetUsersByRoleResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserRoleQueryRequestDTO) IsSetExpiringBefore() bool {
+	return p.ExpiringBefore != nil
+}
+
+func (p *UserRoleQueryRequestDTO) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
+func (p *UserRoleQueryRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5166,7 +5940,7 @@ func (p *GetUsersByRoleResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5182,13 +5956,29 @@ func (p *GetUsersByRoleResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5208,7 +5998,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUsersByRoleResponseDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserRoleQueryRequestDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5218,15 +6008,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetUsersByRoleResponseDTO) ReadField1(iprot thrift.TProtocol) error {
-	_field := http_base.NewBaseResponseDTO()
-	if err := _field.Read(iprot); err != nil {
+func (p *UserRoleQueryRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.BaseResp = _field
+	p.UserID = _field
 	return nil
 }
-func (p *GetUsersByRoleResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+func (p *UserRoleQueryRequestDTO) ReadField2(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5237,33 +6030,40 @@ func (p *GetUsersByRoleResponseDTO) ReadField2(iprot thrift.TProtocol) error {
 	p.RoleID = _field
 	return nil
 }
-func (p *GetUsersByRoleResponseDTO) ReadField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
+func (p *UserRoleQueryRequestDTO) ReadField3(iprot thrift.TProtocol) error {
+	_field := http_base.NewPageRequestDTO()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
-	_field := make([]string, 0, size)
-	for i := 0; i < size; i++ {
-
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
-			return err
-		} else {
-			_elem = v
-		}
+	p.Page = _field
+	return nil
+}
+func (p *UserRoleQueryRequestDTO) ReadField4(iprot thrift.TProtocol) error {
 
-		_field = append(_field, _elem)
+	var _field *core.TimestampMS
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
 	}
-	if err := iprot.ReadListEnd(); err != nil {
+	p.ExpiringBefore = _field
+	return nil
+}
+func (p *UserRoleQueryRequestDTO) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
+	} else {
+		_field = &v
 	}
-	p.UserIDs = _field
+	p.OrganizationID = _field
 	return nil
 }
 
-func (p *GetUsersByRoleResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserRoleQueryRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUsersByRoleResponseDTO"); err != nil {
+	if err = oprot.WriteStructBegin("UserRoleQueryRequestDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5279,6 +6079,14 @@ func (p *GetUsersByRoleResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 3
 			goto WriteFieldError
 		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5297,12 +6105,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetUsersByRoleResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseResp() {
-		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+func (p *UserRoleQueryRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserID() {
+		if err = oprot.WriteFieldBegin("userID", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.BaseResp.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.UserID); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5316,7 +6124,7 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetUsersByRoleResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *UserRoleQueryRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetRoleID() {
 		if err = oprot.WriteFieldBegin("roleID", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
@@ -5335,20 +6143,31 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *GetUsersByRoleResponseDTO) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserIDs() {
-		if err = oprot.WriteFieldBegin("userIDs", thrift.LIST, 3); err != nil {
+func (p *UserRoleQueryRequestDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.STRUCT, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRING, len(p.UserIDs)); err != nil {
+		if err := p.Page.Write(oprot); err != nil {
 			return err
 		}
-		for _, v := range p.UserIDs {
-			if err := oprot.WriteString(v); err != nil {
-				return err
-			}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
 		}
-		if err := oprot.WriteListEnd(); err != nil {
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UserRoleQueryRequestDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExpiringBefore() {
+		if err = oprot.WriteFieldBegin("expiringBefore", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExpiringBefore); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5357,66 +6176,101 @@ func (p *GetUsersByRoleResponseDTO) writeField3(oprot thrift.TProtocol) (err err
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *GetUsersByRoleResponseDTO) String() string {
+func (p *UserRoleQueryRequestDTO) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrganizationID() {
+		if err = oprot.WriteFieldBegin("organizationID", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.OrganizationID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *UserRoleQueryRequestDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUsersByRoleResponseDTO(%+v)", *p)
+	return fmt.Sprintf("UserRoleQueryRequestDTO(%+v)", *p)
 
 }
 
-/** 批量绑定用户到角色请求DTO */
-type BatchBindUsersToRoleRequestDTO struct {
-	/** 角色ID */
-	RoleID *string `thrift:"roleID,1,optional" json:"-" path:"roleID" vd:"@:len($) > 0; msg:'角色ID不能为空'"`
-	/** 用户ID列表 */
-	UserIDs []string `thrift:"userIDs,2,optional,list<string>" json:"user_ids" form:"userIDs" vd:"@:len($) > 0; msg:'用户ID列表不能为空'"`
+/** 用户角色列表响应DTO */
+type UserRoleListResponseDTO struct {
+	/** 响应状态码 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 用户角色分配列表 */
+	Assignments []*UserRoleAssignmentDTO `thrift:"assignments,2,optional,list<UserRoleAssignmentDTO>" json:"assignments" form:"assignments" query:"assignments"`
+	/** 分页响应参数 */
+	Page *http_base.PageResponseDTO `thrift:"page,3,optional" json:"page" form:"page" query:"page"`
 }
 
-func NewBatchBindUsersToRoleRequestDTO() *BatchBindUsersToRoleRequestDTO {
-	return &BatchBindUsersToRoleRequestDTO{}
+func NewUserRoleListResponseDTO() *UserRoleListResponseDTO {
+	return &UserRoleListResponseDTO{}
 }
 
-func (p *BatchBindUsersToRoleRequestDTO) InitDefault() {
+func (p *UserRoleListResponseDTO) InitDefault() {
 }
 
-var BatchBindUsersToRoleRequestDTO_RoleID_DEFAULT string
+var UserRoleListResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
 
-func (p *BatchBindUsersToRoleRequestDTO) GetRoleID() (v string) {
-	if !p.IsSetRoleID() {
-		return BatchBindUsersToRoleRequestDTO_RoleID_DEFAULT
+func (p *UserRoleListResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return UserRoleListResponseDTO_BaseResp_DEFAULT
 	}
-	return *p.RoleID
+	return p.BaseResp
 }
 
-var BatchBindUsersToRoleRequestDTO_UserIDs_DEFAULT []string
+var UserRoleListResponseDTO_Assignments_DEFAULT []*UserRoleAssignmentDTO
 
-func (p *BatchBindUsersToRoleRequestDTO) GetUserIDs() (v []string) {
-	if !p.IsSetUserIDs() {
-		return BatchBindUsersToRoleRequestDTO_UserIDs_DEFAULT
+func (p *UserRoleListResponseDTO) GetAssignments() (v []*UserRoleAssignmentDTO) {
+	if !p.IsSetAssignments() {
+		return UserRoleListResponseDTO_Assignments_DEFAULT
 	}
-	return p.UserIDs
+	return p.Assignments
 }
 
-var fieldIDToName_BatchBindUsersToRoleRequestDTO = map[int16]string{
-	1: "roleID",
-	2: "userIDs",
+var UserRoleListResponseDTO_Page_DEFAULT *http_base.PageResponseDTO
+
+func (p *UserRoleListResponseDTO) GetPage() (v *http_base.PageResponseDTO) {
+	if !p.IsSetPage() {
+		return UserRoleListResponseDTO_Page_DEFAULT
+	}
+	return p.Page
 }
 
-func (p *BatchBindUsersToRoleRequestDTO) IsSetRoleID() bool {
-	return p.RoleID != nil
+var fieldIDToName_UserRoleListResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "assignments",
+	3: "page",
 }
 
-func (p *BatchBindUsersToRoleRequestDTO) IsSetUserIDs() bool {
-	return p.UserIDs != nil
+func (p *UserRoleListResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *BatchBindUsersToRoleRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserRoleListResponseDTO) IsSetAssignments() bool {
+	return p.Assignments != nil
+}
+
+func (p *UserRoleListResponseDTO) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *UserRoleListResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5436,7 +6290,7 @@ func (p *BatchBindUsersToRoleRequestDTO) Read(iprot thrift.TProtocol) (err error
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -5451,6 +6305,14 @@ func (p *BatchBindUsersToRoleRequestDTO) Read(iprot thrift.TProtocol) (err error
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -5470,7 +6332,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchBindUsersToRoleRequestDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserRoleListResponseDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5480,30 +6342,27 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *BatchBindUsersToRoleRequestDTO) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *UserRoleListResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.RoleID = _field
+	p.BaseResp = _field
 	return nil
 }
-func (p *BatchBindUsersToRoleRequestDTO) ReadField2(iprot thrift.TProtocol) error {
+func (p *UserRoleListResponseDTO) ReadField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]string, 0, size)
+	_field := make([]*UserRoleAssignmentDTO, 0, size)
+	values := make([]UserRoleAssignmentDTO, size)
 	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-		var _elem string
-		if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
 			return err
-		} else {
-			_elem = v
 		}
 
 		_field = append(_field, _elem)
@@ -5511,13 +6370,21 @@ func (p *BatchBindUsersToRoleRequestDTO) ReadField2(iprot thrift.TProtocol) erro
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.UserIDs = _field
+	p.Assignments = _field
+	return nil
+}
+func (p *UserRoleListResponseDTO) ReadField3(iprot thrift.TProtocol) error {
+	_field := http_base.NewPageResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Page = _field
 	return nil
 }
 
-func (p *BatchBindUsersToRoleRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserRoleListResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchBindUsersToRoleRequestDTO"); err != nil {
+	if err = oprot.WriteStructBegin("UserRoleListResponseDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5529,6 +6396,10 @@ func (p *BatchBindUsersToRoleRequestDTO) Write(oprot thrift.TProtocol) (err erro
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5547,12 +6418,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BatchBindUsersToRoleRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRoleID() {
-		if err = oprot.WriteFieldBegin("roleID", thrift.STRING, 1); err != nil {
+func (p *UserRoleListResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.RoleID); err != nil {
+		if err := p.BaseResp.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5566,16 +6437,16 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *BatchBindUsersToRoleRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetUserIDs() {
-		if err = oprot.WriteFieldBegin("userIDs", thrift.LIST, 2); err != nil {
+func (p *UserRoleListResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAssignments() {
+		if err = oprot.WriteFieldBegin("assignments", thrift.LIST, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRING, len(p.UserIDs)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Assignments)); err != nil {
 			return err
 		}
-		for _, v := range p.UserIDs {
-			if err := oprot.WriteString(v); err != nil {
+		for _, v := range p.Assignments {
+			if err := v.Write(oprot); err != nil {
 				return err
 			}
 		}
@@ -5593,93 +6464,64 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *BatchBindUsersToRoleRequestDTO) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *UserRoleListResponseDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPage() {
+		if err = oprot.WriteFieldBegin("page", thrift.STRUCT, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Page.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	return fmt.Sprintf("BatchBindUsersToRoleRequestDTO(%+v)", *p)
-
-}
-
-/** 批量绑定用户到角色响应DTO */
-type BatchBindUsersToRoleResponseDTO struct {
-	/** 响应状态码 */
-	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
-	/** 操作是否成功 */
-	Success *bool `thrift:"success,2,optional" json:"success" form:"success" query:"success"`
-	/** 成功绑定的用户数量 */
-	SuccessCount *int32 `thrift:"successCount,3,optional" json:"success_count" form:"successCount" query:"successCount"`
-	/** 响应消息 */
-	Message *string `thrift:"message,4,optional" json:"message,omitempty" form:"message" query:"message"`
-}
-
-func NewBatchBindUsersToRoleResponseDTO() *BatchBindUsersToRoleResponseDTO {
-	return &BatchBindUsersToRoleResponseDTO{}
-}
-
-func (p *BatchBindUsersToRoleResponseDTO) InitDefault() {
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-var BatchBindUsersToRoleResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
-
-func (p *BatchBindUsersToRoleResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
-	if !p.IsSetBaseResp() {
-		return BatchBindUsersToRoleResponseDTO_BaseResp_DEFAULT
+func (p *UserRoleListResponseDTO) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return p.BaseResp
-}
-
-var BatchBindUsersToRoleResponseDTO_Success_DEFAULT bool
+	return fmt.Sprintf("UserRoleListResponseDTO(%+v)", *p)
 
-func (p *BatchBindUsersToRoleResponseDTO) GetSuccess() (v bool) {
-	if !p.IsSetSuccess() {
-		return BatchBindUsersToRoleResponseDTO_Success_DEFAULT
-	}
-	return *p.Success
 }
 
-var BatchBindUsersToRoleResponseDTO_SuccessCount_DEFAULT int32
-
-func (p *BatchBindUsersToRoleResponseDTO) GetSuccessCount() (v int32) {
-	if !p.IsSetSuccessCount() {
-		return BatchBindUsersToRoleResponseDTO_SuccessCount_DEFAULT
-	}
-	return *p.SuccessCount
+/** 根据角色ID获取用户请求DTO */
+type GetUsersByRoleRequestDTO struct {
+	/** 角色ID */
+	RoleID *string `thrift:"roleID,1,optional" json:"-" path:"roleID" vd:"@:len($) > 0; msg:'角色ID不能为空'"`
 }
 
-var BatchBindUsersToRoleResponseDTO_Message_DEFAULT string
-
-func (p *BatchBindUsersToRoleResponseDTO) GetMessage() (v string) {
-	if !p.IsSetMessage() {
-		return BatchBindUsersToRoleResponseDTO_Message_DEFAULT
-	}
-	return *p.Message
+func NewGetUsersByRoleRequestDTO() *GetUsersByRoleRequestDTO {
+	return &GetUsersByRoleRequestDTO{}
 }
 
-var fieldIDToName_BatchBindUsersToRoleResponseDTO = map[int16]string{
-	1: "baseResp",
-	2: "success",
-	3: "successCount",
-	4: "message",
+func (p *GetUsersByRoleRequestDTO) InitDefault() {
 }
 
-func (p *BatchBindUsersToRoleResponseDTO) IsSetBaseResp() bool {
-	return p.BaseResp != nil
-}
+var GetUsersByRoleRequestDTO_RoleID_DEFAULT string
 
-func (p *BatchBindUsersToRoleResponseDTO) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *GetUsersByRoleRequestDTO) GetRoleID() (v string) {
+	if !p.IsSetRoleID() {
+		return GetUsersByRoleRequestDTO_RoleID_DEFAULT
+	}
+	return *p.RoleID
 }
 
-func (p *BatchBindUsersToRoleResponseDTO) IsSetSuccessCount() bool {
-	return p.SuccessCount != nil
+var fieldIDToName_GetUsersByRoleRequestDTO = map[int16]string{
+	1: "roleID",
 }
 
-func (p *BatchBindUsersToRoleResponseDTO) IsSetMessage() bool {
-	return p.Message != nil
+func (p *GetUsersByRoleRequestDTO) IsSetRoleID() bool {
+	return p.RoleID != nil
 }
 
-func (p *BatchBindUsersToRoleResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetUsersByRoleRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5699,32 +6541,8 @@ func (p *BatchBindUsersToRoleResponseDTO) Read(iprot thrift.TProtocol) (err erro
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 2:
-			if fieldTypeId == thrift.BOOL {
-				if err = p.ReadField2(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 3:
-			if fieldTypeId == thrift.I32 {
-				if err = p.ReadField3(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		case 4:
 			if fieldTypeId == thrift.STRING {
-				if err = p.ReadField4(iprot); err != nil {
+				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
@@ -5749,7 +6567,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchBindUsersToRoleResponseDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUsersByRoleRequestDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5759,37 +6577,4694 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *BatchBindUsersToRoleResponseDTO) ReadField1(iprot thrift.TProtocol) error {
-	_field := http_base.NewBaseResponseDTO()
-	if err := _field.Read(iprot); err != nil {
+func (p *GetUsersByRoleRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
-	}
+	} else {
+		_field = &v
+	}
+	p.RoleID = _field
+	return nil
+}
+
+func (p *GetUsersByRoleRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUsersByRoleRequestDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetUsersByRoleRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRoleID() {
+		if err = oprot.WriteFieldBegin("roleID", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RoleID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetUsersByRoleRequestDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUsersByRoleRequestDTO(%+v)", *p)
+
+}
+
+/** 根据角色ID获取用户响应DTO */
+type GetUsersByRoleResponseDTO struct {
+	/** 响应状态码 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 角色ID */
+	RoleID *string `thrift:"roleID,2,optional" json:"role_id" form:"roleID" query:"roleID"`
+	/** 该角色下所有用户的ID列表 */
+	UserIDs []string `thrift:"userIDs,3,optional,list<string>" json:"user_ids" form:"userIDs" query:"userIDs"`
+}
+
+func NewGetUsersByRoleResponseDTO() *GetUsersByRoleResponseDTO {
+	return &GetUsersByRoleResponseDTO{}
+}
+
+func (p *GetUsersByRoleResponseDTO) InitDefault() {
+}
+
+var GetUsersByRoleResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+
+func (p *GetUsersByRoleResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return GetUsersByRoleResponseDTO_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var GetUsersByRoleResponseDTO_RoleID_DEFAULT string
+
+func (p *GetUsersByRoleResponseDTO) GetRoleID() (v string) {
+	if !p.IsSetRoleID() {
+		return GetUsersByRoleResponseDTO_RoleID_DEFAULT
+	}
+	return *p.RoleID
+}
+
+var GetUsersByRoleResponseDTO_UserIDs_DEFAULT []string
+
+func (p *GetUsersByRoleResponseDTO) GetUserIDs() (v []string) {
+	if !p.IsSetUserIDs() {
+		return GetUsersByRoleResponseDTO_UserIDs_DEFAULT
+	}
+	return p.UserIDs
+}
+
+var fieldIDToName_GetUsersByRoleResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "roleID",
+	3: "userIDs",
+}
+
+func (p *GetUsersByRoleResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetUsersByRoleResponseDTO) IsSetRoleID() bool {
+	return p.RoleID != nil
+}
+
+func (p *GetUsersByRoleResponseDTO) IsSetUserIDs() bool {
+	return p.UserIDs != nil
+}
+
+func (p *GetUsersByRoleResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetUsersByRoleResponseDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetUsersByRoleResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
 	p.BaseResp = _field
 	return nil
 }
-func (p *BatchBindUsersToRoleResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+func (p *GetUsersByRoleResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RoleID = _field
+	return nil
+}
+func (p *GetUsersByRoleResponseDTO) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.UserIDs = _field
+	return nil
+}
+
+func (p *GetUsersByRoleResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetUsersByRoleResponseDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetUsersByRoleResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetUsersByRoleResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRoleID() {
+		if err = oprot.WriteFieldBegin("roleID", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RoleID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetUsersByRoleResponseDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserIDs() {
+		if err = oprot.WriteFieldBegin("userIDs", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.UserIDs)); err != nil {
+			return err
+		}
+		for _, v := range p.UserIDs {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *GetUsersByRoleResponseDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUsersByRoleResponseDTO(%+v)", *p)
+
+}
+
+/** 批量绑定用户到角色请求DTO */
+type BatchBindUsersToRoleRequestDTO struct {
+	/** 角色ID */
+	RoleID *string `thrift:"roleID,1,optional" json:"-" path:"roleID" vd:"@:len($) > 0; msg:'角色ID不能为空'"`
+	/** 用户ID列表 */
+	UserIDs []string `thrift:"userIDs,2,optional,list<string>" json:"user_ids" form:"userIDs" vd:"@:len($) > 0; msg:'用户ID列表不能为空'"`
+	/** 生效时间 (毫秒时间戳)，为空表示立即生效 */
+	EffectiveFrom *core.TimestampMS `thrift:"effectiveFrom,3,optional" json:"effective_from,omitempty" form:"effective_from" `
+	/** 过期时间 (毫秒时间戳)，为空表示永久有效 */
+	ExpiresAt *core.TimestampMS `thrift:"expiresAt,4,optional" json:"expires_at,omitempty" form:"expires_at" `
+	/** 授权所属组织ID，为空表示全局授权；只替换该范围内的绑定，用户必须是该组织的有效成员 */
+	OrganizationID *string `thrift:"organizationID,5,optional" json:"organization_id,omitempty" form:"organization_id" vd:"@:len($)==0 || len($)==36; msg:'组织ID格式不正确'"`
+}
+
+func NewBatchBindUsersToRoleRequestDTO() *BatchBindUsersToRoleRequestDTO {
+	return &BatchBindUsersToRoleRequestDTO{}
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) InitDefault() {
+}
+
+var BatchBindUsersToRoleRequestDTO_RoleID_DEFAULT string
+
+func (p *BatchBindUsersToRoleRequestDTO) GetRoleID() (v string) {
+	if !p.IsSetRoleID() {
+		return BatchBindUsersToRoleRequestDTO_RoleID_DEFAULT
+	}
+	return *p.RoleID
+}
+
+var BatchBindUsersToRoleRequestDTO_UserIDs_DEFAULT []string
+
+func (p *BatchBindUsersToRoleRequestDTO) GetUserIDs() (v []string) {
+	if !p.IsSetUserIDs() {
+		return BatchBindUsersToRoleRequestDTO_UserIDs_DEFAULT
+	}
+	return p.UserIDs
+}
+
+var BatchBindUsersToRoleRequestDTO_EffectiveFrom_DEFAULT core.TimestampMS
+
+func (p *BatchBindUsersToRoleRequestDTO) GetEffectiveFrom() (v core.TimestampMS) {
+	if !p.IsSetEffectiveFrom() {
+		return BatchBindUsersToRoleRequestDTO_EffectiveFrom_DEFAULT
+	}
+	return *p.EffectiveFrom
+}
+
+var BatchBindUsersToRoleRequestDTO_ExpiresAt_DEFAULT core.TimestampMS
+
+func (p *BatchBindUsersToRoleRequestDTO) GetExpiresAt() (v core.TimestampMS) {
+	if !p.IsSetExpiresAt() {
+		return BatchBindUsersToRoleRequestDTO_ExpiresAt_DEFAULT
+	}
+	return *p.ExpiresAt
+}
+
+var BatchBindUsersToRoleRequestDTO_OrganizationID_DEFAULT string
+
+func (p *BatchBindUsersToRoleRequestDTO) GetOrganizationID() (v string) {
+	if !p.IsSetOrganizationID() {
+		return BatchBindUsersToRoleRequestDTO_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
+
+var fieldIDToName_BatchBindUsersToRoleRequestDTO = map[int16]string{
+	1: "roleID",
+	2: "userIDs",
+	3: "effectiveFrom",
+	4: "expiresAt",
+	5: "organizationID",
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) IsSetRoleID() bool {
+	return p.RoleID != nil
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) IsSetUserIDs() bool {
+	return p.UserIDs != nil
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) IsSetEffectiveFrom() bool {
+	return p.EffectiveFrom != nil
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) IsSetExpiresAt() bool {
+	return p.ExpiresAt != nil
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchBindUsersToRoleRequestDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.RoleID = _field
+	return nil
+}
+func (p *BatchBindUsersToRoleRequestDTO) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]string, 0, size)
+	for i := 0; i < size; i++ {
+
+		var _elem string
+		if v, err := iprot.ReadString(); err != nil {
+			return err
+		} else {
+			_elem = v
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.UserIDs = _field
+	return nil
+}
+func (p *BatchBindUsersToRoleRequestDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *core.TimestampMS
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.EffectiveFrom = _field
+	return nil
+}
+func (p *BatchBindUsersToRoleRequestDTO) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *core.TimestampMS
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ExpiresAt = _field
+	return nil
+}
+func (p *BatchBindUsersToRoleRequestDTO) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.OrganizationID = _field
+	return nil
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchBindUsersToRoleRequestDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRoleID() {
+		if err = oprot.WriteFieldBegin("roleID", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RoleID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUserIDs() {
+		if err = oprot.WriteFieldBegin("userIDs", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.UserIDs)); err != nil {
+			return err
+		}
+		for _, v := range p.UserIDs {
+			if err := oprot.WriteString(v); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEffectiveFrom() {
+		if err = oprot.WriteFieldBegin("effectiveFrom", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.EffectiveFrom); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExpiresAt() {
+		if err = oprot.WriteFieldBegin("expiresAt", thrift.I64, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ExpiresAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrganizationID() {
+		if err = oprot.WriteFieldBegin("organizationID", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.OrganizationID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *BatchBindUsersToRoleRequestDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchBindUsersToRoleRequestDTO(%+v)", *p)
+
+}
+
+/** 批量绑定用户到角色响应DTO */
+type BatchBindUsersToRoleResponseDTO struct {
+	/** 响应状态码 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 操作是否成功 */
+	Success *bool `thrift:"success,2,optional" json:"success" form:"success" query:"success"`
+	/** 成功绑定的用户数量 */
+	SuccessCount *int32 `thrift:"successCount,3,optional" json:"success_count" form:"successCount" query:"successCount"`
+	/** 响应消息 */
+	Message *string `thrift:"message,4,optional" json:"message,omitempty" form:"message" query:"message"`
+}
+
+func NewBatchBindUsersToRoleResponseDTO() *BatchBindUsersToRoleResponseDTO {
+	return &BatchBindUsersToRoleResponseDTO{}
+}
+
+func (p *BatchBindUsersToRoleResponseDTO) InitDefault() {
+}
+
+var BatchBindUsersToRoleResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+
+func (p *BatchBindUsersToRoleResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return BatchBindUsersToRoleResponseDTO_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var BatchBindUsersToRoleResponseDTO_Success_DEFAULT bool
+
+func (p *BatchBindUsersToRoleResponseDTO) GetSuccess() (v bool) {
+	if !p.IsSetSuccess() {
+		return BatchBindUsersToRoleResponseDTO_Success_DEFAULT
+	}
+	return *p.Success
+}
+
+var BatchBindUsersToRoleResponseDTO_SuccessCount_DEFAULT int32
+
+func (p *BatchBindUsersToRoleResponseDTO) GetSuccessCount() (v int32) {
+	if !p.IsSetSuccessCount() {
+		return BatchBindUsersToRoleResponseDTO_SuccessCount_DEFAULT
+	}
+	return *p.SuccessCount
+}
+
+var BatchBindUsersToRoleResponseDTO_Message_DEFAULT string
+
+func (p *BatchBindUsersToRoleResponseDTO) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return BatchBindUsersToRoleResponseDTO_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var fieldIDToName_BatchBindUsersToRoleResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "success",
+	3: "successCount",
+	4: "message",
+}
+
+func (p *BatchBindUsersToRoleResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *BatchBindUsersToRoleResponseDTO) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *BatchBindUsersToRoleResponseDTO) IsSetSuccessCount() bool {
+	return p.SuccessCount != nil
+}
+
+func (p *BatchBindUsersToRoleResponseDTO) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *BatchBindUsersToRoleResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_BatchBindUsersToRoleResponseDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *BatchBindUsersToRoleResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *BatchBindUsersToRoleResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Success = _field
+	return nil
+}
+func (p *BatchBindUsersToRoleResponseDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SuccessCount = _field
+	return nil
+}
+func (p *BatchBindUsersToRoleResponseDTO) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Message = _field
+	return nil
+}
+
+func (p *BatchBindUsersToRoleResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchBindUsersToRoleResponseDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *BatchBindUsersToRoleResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *BatchBindUsersToRoleResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.BOOL, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Success); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *BatchBindUsersToRoleResponseDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccessCount() {
+		if err = oprot.WriteFieldBegin("successCount", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.SuccessCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *BatchBindUsersToRoleResponseDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessage() {
+		if err = oprot.WriteFieldBegin("message", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Message); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *BatchBindUsersToRoleResponseDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BatchBindUsersToRoleResponseDTO(%+v)", *p)
+
+}
+
+// =================================================================
+// 2. 菜单管理模块 DTO (Menu Management)
+// =================================================================
+/** 菜单上传请求DTO */
+type UploadMenuRequestDTO struct {
+	/** YAML 格式的菜单配置文件 */
+	MenuFile []byte `thrift:"menuFile,1,optional" form:"menu_file" json:"menuFile,omitempty" `
+	/** 仅预检查：校验菜单配置并报告影响，不保存任何数据 */
+	DryRun *bool `thrift:"dryRun,2,optional" form:"dry_run" json:"dryRun,omitempty" `
+	/** 强制上传：即使会导致角色失去菜单权限也继续上传 */
+	Force *bool `thrift:"force,3,optional" form:"force" json:"force,omitempty" `
+}
+
+func NewUploadMenuRequestDTO() *UploadMenuRequestDTO {
+	return &UploadMenuRequestDTO{}
+}
+
+func (p *UploadMenuRequestDTO) InitDefault() {
+}
+
+var UploadMenuRequestDTO_MenuFile_DEFAULT []byte
+
+func (p *UploadMenuRequestDTO) GetMenuFile() (v []byte) {
+	if !p.IsSetMenuFile() {
+		return UploadMenuRequestDTO_MenuFile_DEFAULT
+	}
+	return p.MenuFile
+}
+
+var UploadMenuRequestDTO_DryRun_DEFAULT bool
+
+func (p *UploadMenuRequestDTO) GetDryRun() (v bool) {
+	if !p.IsSetDryRun() {
+		return UploadMenuRequestDTO_DryRun_DEFAULT
+	}
+	return *p.DryRun
+}
+
+var UploadMenuRequestDTO_Force_DEFAULT bool
+
+func (p *UploadMenuRequestDTO) GetForce() (v bool) {
+	if !p.IsSetForce() {
+		return UploadMenuRequestDTO_Force_DEFAULT
+	}
+	return *p.Force
+}
+
+var fieldIDToName_UploadMenuRequestDTO = map[int16]string{
+	1: "menuFile",
+	2: "dryRun",
+	3: "force",
+}
+
+func (p *UploadMenuRequestDTO) IsSetMenuFile() bool {
+	return p.MenuFile != nil
+}
+
+func (p *UploadMenuRequestDTO) IsSetDryRun() bool {
+	return p.DryRun != nil
+}
+
+func (p *UploadMenuRequestDTO) IsSetForce() bool {
+	return p.Force != nil
+}
+
+func (p *UploadMenuRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadMenuRequestDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *UploadMenuRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field []byte
+	if v, err := iprot.ReadBinary(); err != nil {
+		return err
+	} else {
+		_field = []byte(v)
+	}
+	p.MenuFile = _field
+	return nil
+}
+func (p *UploadMenuRequestDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.DryRun = _field
+	return nil
+}
+func (p *UploadMenuRequestDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Force = _field
+	return nil
+}
+
+func (p *UploadMenuRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UploadMenuRequestDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *UploadMenuRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMenuFile() {
+		if err = oprot.WriteFieldBegin("menuFile", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBinary([]byte(p.MenuFile)); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *UploadMenuRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDryRun() {
+		if err = oprot.WriteFieldBegin("dryRun", thrift.BOOL, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.DryRun); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *UploadMenuRequestDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetForce() {
+		if err = oprot.WriteFieldBegin("force", thrift.BOOL, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.Force); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *UploadMenuRequestDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadMenuRequestDTO(%+v)", *p)
+
+}
+
+/** 菜单配置校验问题DTO */
+type MenuValidationIssueDTO struct {
+	/** 问题所在菜单的语义ID */
+	SemanticID *string `thrift:"semanticID,1,optional" json:"semantic_id,omitempty" form:"semanticID" query:"semanticID"`
+	/** 问题字段 */
+	Field *string `thrift:"field,2,optional" json:"field" form:"field" query:"field"`
+	/** 问题在 YAML 中的行号 */
+	Line *int32 `thrift:"line,3,optional" json:"line" form:"line" query:"line"`
+	/** 问题描述 */
+	Message *string `thrift:"message,4,optional" json:"message" form:"message" query:"message"`
+}
+
+func NewMenuValidationIssueDTO() *MenuValidationIssueDTO {
+	return &MenuValidationIssueDTO{}
+}
+
+func (p *MenuValidationIssueDTO) InitDefault() {
+}
+
+var MenuValidationIssueDTO_SemanticID_DEFAULT string
+
+func (p *MenuValidationIssueDTO) GetSemanticID() (v string) {
+	if !p.IsSetSemanticID() {
+		return MenuValidationIssueDTO_SemanticID_DEFAULT
+	}
+	return *p.SemanticID
+}
+
+var MenuValidationIssueDTO_Field_DEFAULT string
+
+func (p *MenuValidationIssueDTO) GetField() (v string) {
+	if !p.IsSetField() {
+		return MenuValidationIssueDTO_Field_DEFAULT
+	}
+	return *p.Field
+}
+
+var MenuValidationIssueDTO_Line_DEFAULT int32
+
+func (p *MenuValidationIssueDTO) GetLine() (v int32) {
+	if !p.IsSetLine() {
+		return MenuValidationIssueDTO_Line_DEFAULT
+	}
+	return *p.Line
+}
+
+var MenuValidationIssueDTO_Message_DEFAULT string
+
+func (p *MenuValidationIssueDTO) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return MenuValidationIssueDTO_Message_DEFAULT
+	}
+	return *p.Message
+}
+
+var fieldIDToName_MenuValidationIssueDTO = map[int16]string{
+	1: "semanticID",
+	2: "field",
+	3: "line",
+	4: "message",
+}
+
+func (p *MenuValidationIssueDTO) IsSetSemanticID() bool {
+	return p.SemanticID != nil
+}
+
+func (p *MenuValidationIssueDTO) IsSetField() bool {
+	return p.Field != nil
+}
+
+func (p *MenuValidationIssueDTO) IsSetLine() bool {
+	return p.Line != nil
+}
+
+func (p *MenuValidationIssueDTO) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *MenuValidationIssueDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I32 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MenuValidationIssueDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MenuValidationIssueDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SemanticID = _field
+	return nil
+}
+func (p *MenuValidationIssueDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Field = _field
+	return nil
+}
+func (p *MenuValidationIssueDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *int32
+	if v, err := iprot.ReadI32(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Line = _field
+	return nil
+}
+func (p *MenuValidationIssueDTO) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Message = _field
+	return nil
+}
+
+func (p *MenuValidationIssueDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MenuValidationIssueDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MenuValidationIssueDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSemanticID() {
+		if err = oprot.WriteFieldBegin("semanticID", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SemanticID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MenuValidationIssueDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetField() {
+		if err = oprot.WriteFieldBegin("field", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Field); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *MenuValidationIssueDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLine() {
+		if err = oprot.WriteFieldBegin("line", thrift.I32, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI32(*p.Line); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *MenuValidationIssueDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMessage() {
+		if err = oprot.WriteFieldBegin("message", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Message); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *MenuValidationIssueDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MenuValidationIssueDTO(%+v)", *p)
+
+}
+
+/** 菜单节点DTO */
+type MenuNodeDTO struct {
+	/** 菜单名称 (用于显示) */
+	Name *string `thrift:"name,1,optional" json:"name" form:"name" query:"name"`
+	/** 菜单唯一标识符 */
+	ID *string `thrift:"id,2,optional" json:"id" form:"id" query:"id"`
+	/** 路由路径 */
+	Path *string `thrift:"path,3,optional" json:"path" form:"path" query:"path"`
+	/** 菜单图标 (可选) */
+	Icon *string `thrift:"icon,4,optional" json:"icon,omitempty" form:"icon" query:"icon"`
+	/** 前端组件路径 (可选) */
+	Component *string `thrift:"component,5,optional" json:"component,omitempty" form:"component" query:"component"`
+	/** 子菜单列表 (可选) */
+	Children []*MenuNodeDTO `thrift:"children,6,optional,list<MenuNodeDTO>" json:"children,omitempty" form:"children" query:"children"`
+	/** 是否有权限访问此菜单 (可选, 用于权限标记) */
+	HasPermission *bool `thrift:"hasPermission,7,optional" json:"has_permission,omitempty" form:"hasPermission" query:"hasPermission"`
+	/** 权限级别 (可选): read, write, full, none */
+	PermissionLevel *string `thrift:"permissionLevel,8,optional" json:"permission_level,omitempty" form:"permissionLevel" query:"permissionLevel"`
+}
+
+func NewMenuNodeDTO() *MenuNodeDTO {
+	return &MenuNodeDTO{}
+}
+
+func (p *MenuNodeDTO) InitDefault() {
+}
+
+var MenuNodeDTO_Name_DEFAULT string
+
+func (p *MenuNodeDTO) GetName() (v string) {
+	if !p.IsSetName() {
+		return MenuNodeDTO_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var MenuNodeDTO_ID_DEFAULT string
+
+func (p *MenuNodeDTO) GetID() (v string) {
+	if !p.IsSetID() {
+		return MenuNodeDTO_ID_DEFAULT
+	}
+	return *p.ID
+}
+
+var MenuNodeDTO_Path_DEFAULT string
+
+func (p *MenuNodeDTO) GetPath() (v string) {
+	if !p.IsSetPath() {
+		return MenuNodeDTO_Path_DEFAULT
+	}
+	return *p.Path
+}
+
+var MenuNodeDTO_Icon_DEFAULT string
+
+func (p *MenuNodeDTO) GetIcon() (v string) {
+	if !p.IsSetIcon() {
+		return MenuNodeDTO_Icon_DEFAULT
+	}
+	return *p.Icon
+}
+
+var MenuNodeDTO_Component_DEFAULT string
+
+func (p *MenuNodeDTO) GetComponent() (v string) {
+	if !p.IsSetComponent() {
+		return MenuNodeDTO_Component_DEFAULT
+	}
+	return *p.Component
+}
+
+var MenuNodeDTO_Children_DEFAULT []*MenuNodeDTO
+
+func (p *MenuNodeDTO) GetChildren() (v []*MenuNodeDTO) {
+	if !p.IsSetChildren() {
+		return MenuNodeDTO_Children_DEFAULT
+	}
+	return p.Children
+}
+
+var MenuNodeDTO_HasPermission_DEFAULT bool
+
+func (p *MenuNodeDTO) GetHasPermission() (v bool) {
+	if !p.IsSetHasPermission() {
+		return MenuNodeDTO_HasPermission_DEFAULT
+	}
+	return *p.HasPermission
+}
+
+var MenuNodeDTO_PermissionLevel_DEFAULT string
+
+func (p *MenuNodeDTO) GetPermissionLevel() (v string) {
+	if !p.IsSetPermissionLevel() {
+		return MenuNodeDTO_PermissionLevel_DEFAULT
+	}
+	return *p.PermissionLevel
+}
+
+var fieldIDToName_MenuNodeDTO = map[int16]string{
+	1: "name",
+	2: "id",
+	3: "path",
+	4: "icon",
+	5: "component",
+	6: "children",
+	7: "hasPermission",
+	8: "permissionLevel",
+}
+
+func (p *MenuNodeDTO) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *MenuNodeDTO) IsSetID() bool {
+	return p.ID != nil
+}
+
+func (p *MenuNodeDTO) IsSetPath() bool {
+	return p.Path != nil
+}
+
+func (p *MenuNodeDTO) IsSetIcon() bool {
+	return p.Icon != nil
+}
+
+func (p *MenuNodeDTO) IsSetComponent() bool {
+	return p.Component != nil
+}
+
+func (p *MenuNodeDTO) IsSetChildren() bool {
+	return p.Children != nil
+}
+
+func (p *MenuNodeDTO) IsSetHasPermission() bool {
+	return p.HasPermission != nil
+}
+
+func (p *MenuNodeDTO) IsSetPermissionLevel() bool {
+	return p.PermissionLevel != nil
+}
+
+func (p *MenuNodeDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 8:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField8(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MenuNodeDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MenuNodeDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *MenuNodeDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ID = _field
+	return nil
+}
+func (p *MenuNodeDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Path = _field
+	return nil
+}
+func (p *MenuNodeDTO) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Icon = _field
+	return nil
+}
+func (p *MenuNodeDTO) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Component = _field
+	return nil
+}
+func (p *MenuNodeDTO) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*MenuNodeDTO, 0, size)
+	values := make([]MenuNodeDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Children = _field
+	return nil
+}
+func (p *MenuNodeDTO) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.HasPermission = _field
+	return nil
+}
+func (p *MenuNodeDTO) ReadField8(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PermissionLevel = _field
+	return nil
+}
+
+func (p *MenuNodeDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MenuNodeDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+		if err = p.writeField8(oprot); err != nil {
+			fieldId = 8
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MenuNodeDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MenuNodeDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetID() {
+		if err = oprot.WriteFieldBegin("id", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *MenuNodeDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPath() {
+		if err = oprot.WriteFieldBegin("path", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Path); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *MenuNodeDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIcon() {
+		if err = oprot.WriteFieldBegin("icon", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Icon); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *MenuNodeDTO) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetComponent() {
+		if err = oprot.WriteFieldBegin("component", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Component); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *MenuNodeDTO) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChildren() {
+		if err = oprot.WriteFieldBegin("children", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Children)); err != nil {
+			return err
+		}
+		for _, v := range p.Children {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *MenuNodeDTO) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetHasPermission() {
+		if err = oprot.WriteFieldBegin("hasPermission", thrift.BOOL, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.HasPermission); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *MenuNodeDTO) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermissionLevel() {
+		if err = oprot.WriteFieldBegin("permissionLevel", thrift.STRING, 8); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PermissionLevel); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
+}
+
+func (p *MenuNodeDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MenuNodeDTO(%+v)", *p)
+
+}
+
+/** 菜单树获取响应DTO */
+type GetMenuTreeResponseDTO struct {
+	/** 响应状态码 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 完整的菜单树结构 */
+	MenuTree []*MenuNodeDTO `thrift:"menuTree,2,optional,list<MenuNodeDTO>" json:"menu_tree" form:"menuTree" query:"menuTree"`
+}
+
+func NewGetMenuTreeResponseDTO() *GetMenuTreeResponseDTO {
+	return &GetMenuTreeResponseDTO{}
+}
+
+func (p *GetMenuTreeResponseDTO) InitDefault() {
+}
+
+var GetMenuTreeResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+
+func (p *GetMenuTreeResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return GetMenuTreeResponseDTO_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var GetMenuTreeResponseDTO_MenuTree_DEFAULT []*MenuNodeDTO
+
+func (p *GetMenuTreeResponseDTO) GetMenuTree() (v []*MenuNodeDTO) {
+	if !p.IsSetMenuTree() {
+		return GetMenuTreeResponseDTO_MenuTree_DEFAULT
+	}
+	return p.MenuTree
+}
+
+var fieldIDToName_GetMenuTreeResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "menuTree",
+}
+
+func (p *GetMenuTreeResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetMenuTreeResponseDTO) IsSetMenuTree() bool {
+	return p.MenuTree != nil
+}
+
+func (p *GetMenuTreeResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetMenuTreeResponseDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *GetMenuTreeResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *GetMenuTreeResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*MenuNodeDTO, 0, size)
+	values := make([]MenuNodeDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.MenuTree = _field
+	return nil
+}
+
+func (p *GetMenuTreeResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetMenuTreeResponseDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *GetMenuTreeResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *GetMenuTreeResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMenuTree() {
+		if err = oprot.WriteFieldBegin("menuTree", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.MenuTree)); err != nil {
+			return err
+		}
+		for _, v := range p.MenuTree {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetMenuTreeResponseDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetMenuTreeResponseDTO(%+v)", *p)
+
+}
+
+/** 菜单版本DTO */
+type MenuVersionDTO struct {
+	/** 版本标识 */
+	Version *string `thrift:"version,1,optional" json:"version" form:"version" query:"version"`
+	/** 菜单节点数量 */
+	MenuCount *int64 `thrift:"menuCount,2,optional" json:"menu_count" form:"menuCount" query:"menuCount"`
+	/** 上传时间 */
+	UploadedAt *core.TimestampMS `thrift:"uploadedAt,3,optional" json:"uploaded_at" form:"uploadedAt" query:"uploadedAt"`
+	/** 上传者用户ID */
+	UploadedBy *string `thrift:"uploadedBy,4,optional" json:"uploaded_by,omitempty" form:"uploadedBy" query:"uploadedBy"`
+	/** 是否为当前生效版本 */
+	IsActive *bool `thrift:"isActive,5,optional" json:"is_active" form:"isActive" query:"isActive"`
+	/** 最近一次激活时间 */
+	ActivatedAt *core.TimestampMS `thrift:"activatedAt,6,optional" json:"activated_at,omitempty" form:"activatedAt" query:"activatedAt"`
+	/** 最近一次激活的操作者用户ID */
+	ActivatedBy *string `thrift:"activatedBy,7,optional" json:"activated_by,omitempty" form:"activatedBy" query:"activatedBy"`
+}
+
+func NewMenuVersionDTO() *MenuVersionDTO {
+	return &MenuVersionDTO{}
+}
+
+func (p *MenuVersionDTO) InitDefault() {
+}
+
+var MenuVersionDTO_Version_DEFAULT string
+
+func (p *MenuVersionDTO) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return MenuVersionDTO_Version_DEFAULT
+	}
+	return *p.Version
+}
+
+var MenuVersionDTO_MenuCount_DEFAULT int64
+
+func (p *MenuVersionDTO) GetMenuCount() (v int64) {
+	if !p.IsSetMenuCount() {
+		return MenuVersionDTO_MenuCount_DEFAULT
+	}
+	return *p.MenuCount
+}
+
+var MenuVersionDTO_UploadedAt_DEFAULT core.TimestampMS
+
+func (p *MenuVersionDTO) GetUploadedAt() (v core.TimestampMS) {
+	if !p.IsSetUploadedAt() {
+		return MenuVersionDTO_UploadedAt_DEFAULT
+	}
+	return *p.UploadedAt
+}
+
+var MenuVersionDTO_UploadedBy_DEFAULT string
+
+func (p *MenuVersionDTO) GetUploadedBy() (v string) {
+	if !p.IsSetUploadedBy() {
+		return MenuVersionDTO_UploadedBy_DEFAULT
+	}
+	return *p.UploadedBy
+}
+
+var MenuVersionDTO_IsActive_DEFAULT bool
+
+func (p *MenuVersionDTO) GetIsActive() (v bool) {
+	if !p.IsSetIsActive() {
+		return MenuVersionDTO_IsActive_DEFAULT
+	}
+	return *p.IsActive
+}
+
+var MenuVersionDTO_ActivatedAt_DEFAULT core.TimestampMS
+
+func (p *MenuVersionDTO) GetActivatedAt() (v core.TimestampMS) {
+	if !p.IsSetActivatedAt() {
+		return MenuVersionDTO_ActivatedAt_DEFAULT
+	}
+	return *p.ActivatedAt
+}
+
+var MenuVersionDTO_ActivatedBy_DEFAULT string
+
+func (p *MenuVersionDTO) GetActivatedBy() (v string) {
+	if !p.IsSetActivatedBy() {
+		return MenuVersionDTO_ActivatedBy_DEFAULT
+	}
+	return *p.ActivatedBy
+}
+
+var fieldIDToName_MenuVersionDTO = map[int16]string{
+	1: "version",
+	2: "menuCount",
+	3: "uploadedAt",
+	4: "uploadedBy",
+	5: "isActive",
+	6: "activatedAt",
+	7: "activatedBy",
+}
+
+func (p *MenuVersionDTO) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *MenuVersionDTO) IsSetMenuCount() bool {
+	return p.MenuCount != nil
+}
+
+func (p *MenuVersionDTO) IsSetUploadedAt() bool {
+	return p.UploadedAt != nil
+}
+
+func (p *MenuVersionDTO) IsSetUploadedBy() bool {
+	return p.UploadedBy != nil
+}
+
+func (p *MenuVersionDTO) IsSetIsActive() bool {
+	return p.IsActive != nil
+}
+
+func (p *MenuVersionDTO) IsSetActivatedAt() bool {
+	return p.ActivatedAt != nil
+}
+
+func (p *MenuVersionDTO) IsSetActivatedBy() bool {
+	return p.ActivatedBy != nil
+}
+
+func (p *MenuVersionDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.BOOL {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.I64 {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MenuVersionDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MenuVersionDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Version = _field
+	return nil
+}
+func (p *MenuVersionDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *int64
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.MenuCount = _field
+	return nil
+}
+func (p *MenuVersionDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *core.TimestampMS
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UploadedAt = _field
+	return nil
+}
+func (p *MenuVersionDTO) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.UploadedBy = _field
+	return nil
+}
+func (p *MenuVersionDTO) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.IsActive = _field
+	return nil
+}
+func (p *MenuVersionDTO) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *core.TimestampMS
+	if v, err := iprot.ReadI64(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ActivatedAt = _field
+	return nil
+}
+func (p *MenuVersionDTO) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ActivatedBy = _field
+	return nil
+}
+
+func (p *MenuVersionDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MenuVersionDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MenuVersionDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err = oprot.WriteFieldBegin("version", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Version); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MenuVersionDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMenuCount() {
+		if err = oprot.WriteFieldBegin("menuCount", thrift.I64, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.MenuCount); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *MenuVersionDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUploadedAt() {
+		if err = oprot.WriteFieldBegin("uploadedAt", thrift.I64, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.UploadedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *MenuVersionDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUploadedBy() {
+		if err = oprot.WriteFieldBegin("uploadedBy", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.UploadedBy); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *MenuVersionDTO) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsActive() {
+		if err = oprot.WriteFieldBegin("isActive", thrift.BOOL, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteBool(*p.IsActive); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *MenuVersionDTO) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActivatedAt() {
+		if err = oprot.WriteFieldBegin("activatedAt", thrift.I64, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteI64(*p.ActivatedAt); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *MenuVersionDTO) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetActivatedBy() {
+		if err = oprot.WriteFieldBegin("activatedBy", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ActivatedBy); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *MenuVersionDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MenuVersionDTO(%+v)", *p)
+
+}
+
+/** 菜单版本列表响应DTO */
+type ListMenuVersionsResponseDTO struct {
+	/** 响应状态码 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 按上传时间倒序排列的菜单版本 */
+	Versions []*MenuVersionDTO `thrift:"versions,2,optional,list<MenuVersionDTO>" json:"versions" form:"versions" query:"versions"`
+}
+
+func NewListMenuVersionsResponseDTO() *ListMenuVersionsResponseDTO {
+	return &ListMenuVersionsResponseDTO{}
+}
+
+func (p *ListMenuVersionsResponseDTO) InitDefault() {
+}
+
+var ListMenuVersionsResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+
+func (p *ListMenuVersionsResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return ListMenuVersionsResponseDTO_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var ListMenuVersionsResponseDTO_Versions_DEFAULT []*MenuVersionDTO
+
+func (p *ListMenuVersionsResponseDTO) GetVersions() (v []*MenuVersionDTO) {
+	if !p.IsSetVersions() {
+		return ListMenuVersionsResponseDTO_Versions_DEFAULT
+	}
+	return p.Versions
+}
+
+var fieldIDToName_ListMenuVersionsResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "versions",
+}
+
+func (p *ListMenuVersionsResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *ListMenuVersionsResponseDTO) IsSetVersions() bool {
+	return p.Versions != nil
+}
+
+func (p *ListMenuVersionsResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ListMenuVersionsResponseDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ListMenuVersionsResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ListMenuVersionsResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*MenuVersionDTO, 0, size)
+	values := make([]MenuVersionDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Versions = _field
+	return nil
+}
+
+func (p *ListMenuVersionsResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListMenuVersionsResponseDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *ListMenuVersionsResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *ListMenuVersionsResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersions() {
+		if err = oprot.WriteFieldBegin("versions", thrift.LIST, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Versions)); err != nil {
+			return err
+		}
+		for _, v := range p.Versions {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ListMenuVersionsResponseDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListMenuVersionsResponseDTO(%+v)", *p)
+
+}
+
+/** 菜单版本差异请求DTO */
+type DiffMenuVersionsRequestDTO struct {
+	/** 基准版本 */
+	FromVersion *string `thrift:"fromVersion,1,optional" json:"from,omitempty" query:"from" vd:"@:len($) > 0; msg:'基准版本不能为空'"`
+	/** 目标版本，为空时与生效版本比较 */
+	ToVersion *string `thrift:"toVersion,2,optional" json:"to,omitempty" query:"to" `
+}
+
+func NewDiffMenuVersionsRequestDTO() *DiffMenuVersionsRequestDTO {
+	return &DiffMenuVersionsRequestDTO{}
+}
+
+func (p *DiffMenuVersionsRequestDTO) InitDefault() {
+}
+
+var DiffMenuVersionsRequestDTO_FromVersion_DEFAULT string
+
+func (p *DiffMenuVersionsRequestDTO) GetFromVersion() (v string) {
+	if !p.IsSetFromVersion() {
+		return DiffMenuVersionsRequestDTO_FromVersion_DEFAULT
+	}
+	return *p.FromVersion
+}
+
+var DiffMenuVersionsRequestDTO_ToVersion_DEFAULT string
+
+func (p *DiffMenuVersionsRequestDTO) GetToVersion() (v string) {
+	if !p.IsSetToVersion() {
+		return DiffMenuVersionsRequestDTO_ToVersion_DEFAULT
+	}
+	return *p.ToVersion
+}
+
+var fieldIDToName_DiffMenuVersionsRequestDTO = map[int16]string{
+	1: "fromVersion",
+	2: "toVersion",
+}
+
+func (p *DiffMenuVersionsRequestDTO) IsSetFromVersion() bool {
+	return p.FromVersion != nil
+}
+
+func (p *DiffMenuVersionsRequestDTO) IsSetToVersion() bool {
+	return p.ToVersion != nil
+}
+
+func (p *DiffMenuVersionsRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DiffMenuVersionsRequestDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DiffMenuVersionsRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FromVersion = _field
+	return nil
+}
+func (p *DiffMenuVersionsRequestDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ToVersion = _field
+	return nil
+}
+
+func (p *DiffMenuVersionsRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DiffMenuVersionsRequestDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DiffMenuVersionsRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFromVersion() {
+		if err = oprot.WriteFieldBegin("fromVersion", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.FromVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DiffMenuVersionsRequestDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetToVersion() {
+		if err = oprot.WriteFieldBegin("toVersion", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ToVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DiffMenuVersionsRequestDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DiffMenuVersionsRequestDTO(%+v)", *p)
+
+}
+
+/** 菜单版本间的单个菜单变更DTO */
+type MenuVersionChangeDTO struct {
+	/** 菜单语义ID */
+	SemanticID *string `thrift:"semanticID,1,optional" json:"semantic_id" form:"semanticID" query:"semanticID"`
+	/** 目标版本中的菜单名称（删除时为基准版本中的名称） */
+	Name *string `thrift:"name,2,optional" json:"name,omitempty" form:"name" query:"name"`
+	/** 基准版本中的菜单名称 */
+	PreviousName *string `thrift:"previousName,3,optional" json:"previous_name,omitempty" form:"previousName" query:"previousName"`
+	/** 目标版本中的路由路径 */
+	Path *string `thrift:"path,4,optional" json:"path,omitempty" form:"path" query:"path"`
+	/** 基准版本中的路由路径 */
+	PreviousPath *string `thrift:"previousPath,5,optional" json:"previous_path,omitempty" form:"previousPath" query:"previousPath"`
+	/** 目标版本中父菜单的语义ID */
+	ParentSemanticID *string `thrift:"parentSemanticID,6,optional" json:"parent_semantic_id,omitempty" form:"parentSemanticID" query:"parentSemanticID"`
+	/** 基准版本中父菜单的语义ID */
+	PreviousParentSemanticID *string `thrift:"previousParentSemanticID,7,optional" json:"previous_parent_semantic_id,omitempty" form:"previousParentSemanticID" query:"previousParentSemanticID"`
+}
+
+func NewMenuVersionChangeDTO() *MenuVersionChangeDTO {
+	return &MenuVersionChangeDTO{}
+}
+
+func (p *MenuVersionChangeDTO) InitDefault() {
+}
+
+var MenuVersionChangeDTO_SemanticID_DEFAULT string
+
+func (p *MenuVersionChangeDTO) GetSemanticID() (v string) {
+	if !p.IsSetSemanticID() {
+		return MenuVersionChangeDTO_SemanticID_DEFAULT
+	}
+	return *p.SemanticID
+}
+
+var MenuVersionChangeDTO_Name_DEFAULT string
+
+func (p *MenuVersionChangeDTO) GetName() (v string) {
+	if !p.IsSetName() {
+		return MenuVersionChangeDTO_Name_DEFAULT
+	}
+	return *p.Name
+}
+
+var MenuVersionChangeDTO_PreviousName_DEFAULT string
+
+func (p *MenuVersionChangeDTO) GetPreviousName() (v string) {
+	if !p.IsSetPreviousName() {
+		return MenuVersionChangeDTO_PreviousName_DEFAULT
+	}
+	return *p.PreviousName
+}
+
+var MenuVersionChangeDTO_Path_DEFAULT string
+
+func (p *MenuVersionChangeDTO) GetPath() (v string) {
+	if !p.IsSetPath() {
+		return MenuVersionChangeDTO_Path_DEFAULT
+	}
+	return *p.Path
+}
+
+var MenuVersionChangeDTO_PreviousPath_DEFAULT string
+
+func (p *MenuVersionChangeDTO) GetPreviousPath() (v string) {
+	if !p.IsSetPreviousPath() {
+		return MenuVersionChangeDTO_PreviousPath_DEFAULT
+	}
+	return *p.PreviousPath
+}
+
+var MenuVersionChangeDTO_ParentSemanticID_DEFAULT string
+
+func (p *MenuVersionChangeDTO) GetParentSemanticID() (v string) {
+	if !p.IsSetParentSemanticID() {
+		return MenuVersionChangeDTO_ParentSemanticID_DEFAULT
+	}
+	return *p.ParentSemanticID
+}
+
+var MenuVersionChangeDTO_PreviousParentSemanticID_DEFAULT string
+
+func (p *MenuVersionChangeDTO) GetPreviousParentSemanticID() (v string) {
+	if !p.IsSetPreviousParentSemanticID() {
+		return MenuVersionChangeDTO_PreviousParentSemanticID_DEFAULT
+	}
+	return *p.PreviousParentSemanticID
+}
+
+var fieldIDToName_MenuVersionChangeDTO = map[int16]string{
+	1: "semanticID",
+	2: "name",
+	3: "previousName",
+	4: "path",
+	5: "previousPath",
+	6: "parentSemanticID",
+	7: "previousParentSemanticID",
+}
+
+func (p *MenuVersionChangeDTO) IsSetSemanticID() bool {
+	return p.SemanticID != nil
+}
+
+func (p *MenuVersionChangeDTO) IsSetName() bool {
+	return p.Name != nil
+}
+
+func (p *MenuVersionChangeDTO) IsSetPreviousName() bool {
+	return p.PreviousName != nil
+}
+
+func (p *MenuVersionChangeDTO) IsSetPath() bool {
+	return p.Path != nil
+}
+
+func (p *MenuVersionChangeDTO) IsSetPreviousPath() bool {
+	return p.PreviousPath != nil
+}
+
+func (p *MenuVersionChangeDTO) IsSetParentSemanticID() bool {
+	return p.ParentSemanticID != nil
+}
+
+func (p *MenuVersionChangeDTO) IsSetPreviousParentSemanticID() bool {
+	return p.PreviousParentSemanticID != nil
+}
+
+func (p *MenuVersionChangeDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MenuVersionChangeDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *MenuVersionChangeDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.SemanticID = _field
+	return nil
+}
+func (p *MenuVersionChangeDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Name = _field
+	return nil
+}
+func (p *MenuVersionChangeDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PreviousName = _field
+	return nil
+}
+func (p *MenuVersionChangeDTO) ReadField4(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Path = _field
+	return nil
+}
+func (p *MenuVersionChangeDTO) ReadField5(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PreviousPath = _field
+	return nil
+}
+func (p *MenuVersionChangeDTO) ReadField6(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ParentSemanticID = _field
+	return nil
+}
+func (p *MenuVersionChangeDTO) ReadField7(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.PreviousParentSemanticID = _field
+	return nil
+}
+
+func (p *MenuVersionChangeDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("MenuVersionChangeDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *MenuVersionChangeDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSemanticID() {
+		if err = oprot.WriteFieldBegin("semanticID", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.SemanticID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *MenuVersionChangeDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetName() {
+		if err = oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Name); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *MenuVersionChangeDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPreviousName() {
+		if err = oprot.WriteFieldBegin("previousName", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PreviousName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *MenuVersionChangeDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPath() {
+		if err = oprot.WriteFieldBegin("path", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Path); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *MenuVersionChangeDTO) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPreviousPath() {
+		if err = oprot.WriteFieldBegin("previousPath", thrift.STRING, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PreviousPath); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *MenuVersionChangeDTO) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetParentSemanticID() {
+		if err = oprot.WriteFieldBegin("parentSemanticID", thrift.STRING, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ParentSemanticID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *MenuVersionChangeDTO) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPreviousParentSemanticID() {
+		if err = oprot.WriteFieldBegin("previousParentSemanticID", thrift.STRING, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.PreviousParentSemanticID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *MenuVersionChangeDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MenuVersionChangeDTO(%+v)", *p)
+
+}
+
+/** 菜单版本差异响应DTO */
+type DiffMenuVersionsResponseDTO struct {
+	/** 响应状态码 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 基准版本 */
+	FromVersion *string `thrift:"fromVersion,2,optional" json:"from_version" form:"fromVersion" query:"fromVersion"`
+	/** 目标版本 */
+	ToVersion *string `thrift:"toVersion,3,optional" json:"to_version" form:"toVersion" query:"toVersion"`
+	/** 新增的菜单 */
+	Added []*MenuVersionChangeDTO `thrift:"added,4,optional,list<MenuVersionChangeDTO>" json:"added" form:"added" query:"added"`
+	/** 删除的菜单 */
+	Removed []*MenuVersionChangeDTO `thrift:"removed,5,optional,list<MenuVersionChangeDTO>" json:"removed" form:"removed" query:"removed"`
+	/** 父菜单或路由路径发生变化的菜单 */
+	Moved []*MenuVersionChangeDTO `thrift:"moved,6,optional,list<MenuVersionChangeDTO>" json:"moved" form:"moved" query:"moved"`
+	/** 名称发生变化的菜单 */
+	Renamed []*MenuVersionChangeDTO `thrift:"renamed,7,optional,list<MenuVersionChangeDTO>" json:"renamed" form:"renamed" query:"renamed"`
+}
+
+func NewDiffMenuVersionsResponseDTO() *DiffMenuVersionsResponseDTO {
+	return &DiffMenuVersionsResponseDTO{}
+}
+
+func (p *DiffMenuVersionsResponseDTO) InitDefault() {
+}
+
+var DiffMenuVersionsResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+
+func (p *DiffMenuVersionsResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return DiffMenuVersionsResponseDTO_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var DiffMenuVersionsResponseDTO_FromVersion_DEFAULT string
+
+func (p *DiffMenuVersionsResponseDTO) GetFromVersion() (v string) {
+	if !p.IsSetFromVersion() {
+		return DiffMenuVersionsResponseDTO_FromVersion_DEFAULT
+	}
+	return *p.FromVersion
+}
+
+var DiffMenuVersionsResponseDTO_ToVersion_DEFAULT string
+
+func (p *DiffMenuVersionsResponseDTO) GetToVersion() (v string) {
+	if !p.IsSetToVersion() {
+		return DiffMenuVersionsResponseDTO_ToVersion_DEFAULT
+	}
+	return *p.ToVersion
+}
+
+var DiffMenuVersionsResponseDTO_Added_DEFAULT []*MenuVersionChangeDTO
+
+func (p *DiffMenuVersionsResponseDTO) GetAdded() (v []*MenuVersionChangeDTO) {
+	if !p.IsSetAdded() {
+		return DiffMenuVersionsResponseDTO_Added_DEFAULT
+	}
+	return p.Added
+}
+
+var DiffMenuVersionsResponseDTO_Removed_DEFAULT []*MenuVersionChangeDTO
+
+func (p *DiffMenuVersionsResponseDTO) GetRemoved() (v []*MenuVersionChangeDTO) {
+	if !p.IsSetRemoved() {
+		return DiffMenuVersionsResponseDTO_Removed_DEFAULT
+	}
+	return p.Removed
+}
+
+var DiffMenuVersionsResponseDTO_Moved_DEFAULT []*MenuVersionChangeDTO
+
+func (p *DiffMenuVersionsResponseDTO) GetMoved() (v []*MenuVersionChangeDTO) {
+	if !p.IsSetMoved() {
+		return DiffMenuVersionsResponseDTO_Moved_DEFAULT
+	}
+	return p.Moved
+}
+
+var DiffMenuVersionsResponseDTO_Renamed_DEFAULT []*MenuVersionChangeDTO
+
+func (p *DiffMenuVersionsResponseDTO) GetRenamed() (v []*MenuVersionChangeDTO) {
+	if !p.IsSetRenamed() {
+		return DiffMenuVersionsResponseDTO_Renamed_DEFAULT
+	}
+	return p.Renamed
+}
+
+var fieldIDToName_DiffMenuVersionsResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "fromVersion",
+	3: "toVersion",
+	4: "added",
+	5: "removed",
+	6: "moved",
+	7: "renamed",
+}
+
+func (p *DiffMenuVersionsResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *DiffMenuVersionsResponseDTO) IsSetFromVersion() bool {
+	return p.FromVersion != nil
+}
+
+func (p *DiffMenuVersionsResponseDTO) IsSetToVersion() bool {
+	return p.ToVersion != nil
+}
+
+func (p *DiffMenuVersionsResponseDTO) IsSetAdded() bool {
+	return p.Added != nil
+}
+
+func (p *DiffMenuVersionsResponseDTO) IsSetRemoved() bool {
+	return p.Removed != nil
+}
+
+func (p *DiffMenuVersionsResponseDTO) IsSetMoved() bool {
+	return p.Moved != nil
+}
+
+func (p *DiffMenuVersionsResponseDTO) IsSetRenamed() bool {
+	return p.Renamed != nil
+}
+
+func (p *DiffMenuVersionsResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 5:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField5(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 6:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField6(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 7:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField7(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_DiffMenuVersionsResponseDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *DiffMenuVersionsResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *DiffMenuVersionsResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.FromVersion = _field
+	return nil
+}
+func (p *DiffMenuVersionsResponseDTO) ReadField3(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.ToVersion = _field
+	return nil
+}
+func (p *DiffMenuVersionsResponseDTO) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*MenuVersionChangeDTO, 0, size)
+	values := make([]MenuVersionChangeDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Added = _field
+	return nil
+}
+func (p *DiffMenuVersionsResponseDTO) ReadField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*MenuVersionChangeDTO, 0, size)
+	values := make([]MenuVersionChangeDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Removed = _field
+	return nil
+}
+func (p *DiffMenuVersionsResponseDTO) ReadField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*MenuVersionChangeDTO, 0, size)
+	values := make([]MenuVersionChangeDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Moved = _field
+	return nil
+}
+func (p *DiffMenuVersionsResponseDTO) ReadField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*MenuVersionChangeDTO, 0, size)
+	values := make([]MenuVersionChangeDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
+
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return err
+	}
+	p.Renamed = _field
+	return nil
+}
+
+func (p *DiffMenuVersionsResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DiffMenuVersionsResponseDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+		if err = p.writeField5(oprot); err != nil {
+			fieldId = 5
+			goto WriteFieldError
+		}
+		if err = p.writeField6(oprot); err != nil {
+			fieldId = 6
+			goto WriteFieldError
+		}
+		if err = p.writeField7(oprot); err != nil {
+			fieldId = 7
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *DiffMenuVersionsResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.BaseResp.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *DiffMenuVersionsResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFromVersion() {
+		if err = oprot.WriteFieldBegin("fromVersion", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.FromVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *DiffMenuVersionsResponseDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetToVersion() {
+		if err = oprot.WriteFieldBegin("toVersion", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.ToVersion); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *DiffMenuVersionsResponseDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAdded() {
+		if err = oprot.WriteFieldBegin("added", thrift.LIST, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Added)); err != nil {
+			return err
+		}
+		for _, v := range p.Added {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *DiffMenuVersionsResponseDTO) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRemoved() {
+		if err = oprot.WriteFieldBegin("removed", thrift.LIST, 5); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Removed)); err != nil {
+			return err
+		}
+		for _, v := range p.Removed {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
+}
+
+func (p *DiffMenuVersionsResponseDTO) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMoved() {
+		if err = oprot.WriteFieldBegin("moved", thrift.LIST, 6); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Moved)); err != nil {
+			return err
+		}
+		for _, v := range p.Moved {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
+}
+
+func (p *DiffMenuVersionsResponseDTO) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRenamed() {
+		if err = oprot.WriteFieldBegin("renamed", thrift.LIST, 7); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Renamed)); err != nil {
+			return err
+		}
+		for _, v := range p.Renamed {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
+}
+
+func (p *DiffMenuVersionsResponseDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DiffMenuVersionsResponseDTO(%+v)", *p)
+
+}
+
+/** 孤立的角色菜单映射DTO */
+type OrphanRoleMenuMappingDTO struct {
+	/** 角色ID */
+	RoleID *string `thrift:"roleID,1,optional" json:"role_id" form:"roleID" query:"roleID"`
+	/** 角色名称 */
+	RoleName *string `thrift:"roleName,2,optional" json:"role_name,omitempty" form:"roleName" query:"roleName"`
+	/** 菜单语义ID */
+	MenuID *string `thrift:"menuID,3,optional" json:"menu_id" form:"menuID" query:"menuID"`
+	/** 菜单权限 */
+	Permission *string `thrift:"permission,4,optional" json:"permission" form:"permission" query:"permission"`
+}
+
+func NewOrphanRoleMenuMappingDTO() *OrphanRoleMenuMappingDTO {
+	return &OrphanRoleMenuMappingDTO{}
+}
+
+func (p *OrphanRoleMenuMappingDTO) InitDefault() {
+}
+
+var OrphanRoleMenuMappingDTO_RoleID_DEFAULT string
+
+func (p *OrphanRoleMenuMappingDTO) GetRoleID() (v string) {
+	if !p.IsSetRoleID() {
+		return OrphanRoleMenuMappingDTO_RoleID_DEFAULT
+	}
+	return *p.RoleID
+}
+
+var OrphanRoleMenuMappingDTO_RoleName_DEFAULT string
+
+func (p *OrphanRoleMenuMappingDTO) GetRoleName() (v string) {
+	if !p.IsSetRoleName() {
+		return OrphanRoleMenuMappingDTO_RoleName_DEFAULT
+	}
+	return *p.RoleName
+}
+
+var OrphanRoleMenuMappingDTO_MenuID_DEFAULT string
+
+func (p *OrphanRoleMenuMappingDTO) GetMenuID() (v string) {
+	if !p.IsSetMenuID() {
+		return OrphanRoleMenuMappingDTO_MenuID_DEFAULT
+	}
+	return *p.MenuID
+}
+
+var OrphanRoleMenuMappingDTO_Permission_DEFAULT string
+
+func (p *OrphanRoleMenuMappingDTO) GetPermission() (v string) {
+	if !p.IsSetPermission() {
+		return OrphanRoleMenuMappingDTO_Permission_DEFAULT
+	}
+	return *p.Permission
+}
+
+var fieldIDToName_OrphanRoleMenuMappingDTO = map[int16]string{
+	1: "roleID",
+	2: "roleName",
+	3: "menuID",
+	4: "permission",
+}
+
+func (p *OrphanRoleMenuMappingDTO) IsSetRoleID() bool {
+	return p.RoleID != nil
+}
+
+func (p *OrphanRoleMenuMappingDTO) IsSetRoleName() bool {
+	return p.RoleName != nil
+}
+
+func (p *OrphanRoleMenuMappingDTO) IsSetMenuID() bool {
+	return p.MenuID != nil
+}
+
+func (p *OrphanRoleMenuMappingDTO) IsSetPermission() bool {
+	return p.Permission != nil
+}
+
+func (p *OrphanRoleMenuMappingDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 2:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 4:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField4(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_OrphanRoleMenuMappingDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
 
-	var _field *bool
-	if v, err := iprot.ReadBool(); err != nil {
+func (p *OrphanRoleMenuMappingDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Success = _field
+	p.RoleID = _field
 	return nil
 }
-func (p *BatchBindUsersToRoleResponseDTO) ReadField3(iprot thrift.TProtocol) error {
+func (p *OrphanRoleMenuMappingDTO) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *int32
-	if v, err := iprot.ReadI32(); err != nil {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.SuccessCount = _field
+	p.RoleName = _field
 	return nil
 }
-func (p *BatchBindUsersToRoleResponseDTO) ReadField4(iprot thrift.TProtocol) error {
+func (p *OrphanRoleMenuMappingDTO) ReadField3(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -5797,13 +11272,246 @@ func (p *BatchBindUsersToRoleResponseDTO) ReadField4(iprot thrift.TProtocol) err
 	} else {
 		_field = &v
 	}
-	p.Message = _field
+	p.MenuID = _field
 	return nil
 }
+func (p *OrphanRoleMenuMappingDTO) ReadField4(iprot thrift.TProtocol) error {
 
-func (p *BatchBindUsersToRoleResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Permission = _field
+	return nil
+}
+
+func (p *OrphanRoleMenuMappingDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("BatchBindUsersToRoleResponseDTO"); err != nil {
+	if err = oprot.WriteStructBegin("OrphanRoleMenuMappingDTO"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
+		if err = p.writeField4(oprot); err != nil {
+			fieldId = 4
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *OrphanRoleMenuMappingDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRoleID() {
+		if err = oprot.WriteFieldBegin("roleID", thrift.STRING, 1); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RoleID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *OrphanRoleMenuMappingDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRoleName() {
+		if err = oprot.WriteFieldBegin("roleName", thrift.STRING, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.RoleName); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *OrphanRoleMenuMappingDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMenuID() {
+		if err = oprot.WriteFieldBegin("menuID", thrift.STRING, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.MenuID); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *OrphanRoleMenuMappingDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPermission() {
+		if err = oprot.WriteFieldBegin("permission", thrift.STRING, 4); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteString(*p.Permission); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+}
+
+func (p *OrphanRoleMenuMappingDTO) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OrphanRoleMenuMappingDTO(%+v)", *p)
+
+}
+
+/** 激活菜单版本请求DTO */
+type ActivateMenuVersionRequestDTO struct {
+	/** 要激活的版本标识 */
+	Version *string `thrift:"version,1,optional" json:"-" path:"version" vd:"@:len($) > 0; msg:'版本标识不能为空'"`
+}
+
+func NewActivateMenuVersionRequestDTO() *ActivateMenuVersionRequestDTO {
+	return &ActivateMenuVersionRequestDTO{}
+}
+
+func (p *ActivateMenuVersionRequestDTO) InitDefault() {
+}
+
+var ActivateMenuVersionRequestDTO_Version_DEFAULT string
+
+func (p *ActivateMenuVersionRequestDTO) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return ActivateMenuVersionRequestDTO_Version_DEFAULT
+	}
+	return *p.Version
+}
+
+var fieldIDToName_ActivateMenuVersionRequestDTO = map[int16]string{
+	1: "version",
+}
+
+func (p *ActivateMenuVersionRequestDTO) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *ActivateMenuVersionRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRING {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ActivateMenuVersionRequestDTO[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *ActivateMenuVersionRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Version = _field
+	return nil
+}
+
+func (p *ActivateMenuVersionRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ActivateMenuVersionRequestDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5811,18 +11519,6 @@ func (p *BatchBindUsersToRoleResponseDTO) Write(oprot thrift.TProtocol) (err err
 			fieldId = 1
 			goto WriteFieldError
 		}
-		if err = p.writeField2(oprot); err != nil {
-			fieldId = 2
-			goto WriteFieldError
-		}
-		if err = p.writeField3(oprot); err != nil {
-			fieldId = 3
-			goto WriteFieldError
-		}
-		if err = p.writeField4(oprot); err != nil {
-			fieldId = 4
-			goto WriteFieldError
-		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -5841,12 +11537,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *BatchBindUsersToRoleResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBaseResp() {
-		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
+func (p *ActivateMenuVersionRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err = oprot.WriteFieldBegin("version", thrift.STRING, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := p.BaseResp.Write(oprot); err != nil {
+		if err := oprot.WriteString(*p.Version); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -5860,105 +11556,77 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *BatchBindUsersToRoleResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.BOOL, 2); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteBool(*p.Success); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
+func (p *ActivateMenuVersionRequestDTO) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
-}
+	return fmt.Sprintf("ActivateMenuVersionRequestDTO(%+v)", *p)
 
-func (p *BatchBindUsersToRoleResponseDTO) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccessCount() {
-		if err = oprot.WriteFieldBegin("successCount", thrift.I32, 3); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteI32(*p.SuccessCount); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *BatchBindUsersToRoleResponseDTO) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMessage() {
-		if err = oprot.WriteFieldBegin("message", thrift.STRING, 4); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := oprot.WriteString(*p.Message); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
+/** 激活菜单版本响应DTO */
+type ActivateMenuVersionResponseDTO struct {
+	/** 响应状态码 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 激活后的生效版本 */
+	Version *MenuVersionDTO `thrift:"version,2,optional" json:"version" form:"version" query:"version"`
+	/** 激活后指向不存在菜单的角色菜单映射 */
+	OrphanMappings []*OrphanRoleMenuMappingDTO `thrift:"orphanMappings,3,optional,list<OrphanRoleMenuMappingDTO>" json:"orphan_mappings" form:"orphanMappings" query:"orphanMappings"`
 }
 
-func (p *BatchBindUsersToRoleResponseDTO) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("BatchBindUsersToRoleResponseDTO(%+v)", *p)
-
+func NewActivateMenuVersionResponseDTO() *ActivateMenuVersionResponseDTO {
+	return &ActivateMenuVersionResponseDTO{}
 }
 
-// =================================================================
-// 2. 菜单管理模块 DTO (Menu Management)
-// =================================================================
-/** 菜单上传请求DTO */
-type UploadMenuRequestDTO struct {
-	/** YAML 格式的菜单配置文件 */
-	MenuFile []byte `thrift:"menuFile,1,optional" form:"menu_file" json:"menuFile,omitempty" `
+func (p *ActivateMenuVersionResponseDTO) InitDefault() {
 }
 
-func NewUploadMenuRequestDTO() *UploadMenuRequestDTO {
-	return &UploadMenuRequestDTO{}
+var ActivateMenuVersionResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+
+func (p *ActivateMenuVersionResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return ActivateMenuVersionResponseDTO_BaseResp_DEFAULT
+	}
+	return p.BaseResp
 }
 
-func (p *UploadMenuRequestDTO) InitDefault() {
+var ActivateMenuVersionResponseDTO_Version_DEFAULT *MenuVersionDTO
+
+func (p *ActivateMenuVersionResponseDTO) GetVersion() (v *MenuVersionDTO) {
+	if !p.IsSetVersion() {
+		return ActivateMenuVersionResponseDTO_Version_DEFAULT
+	}
+	return p.Version
 }
 
-var UploadMenuRequestDTO_MenuFile_DEFAULT []byte
+var ActivateMenuVersionResponseDTO_OrphanMappings_DEFAULT []*OrphanRoleMenuMappingDTO
 
-func (p *UploadMenuRequestDTO) GetMenuFile() (v []byte) {
-	if !p.IsSetMenuFile() {
-		return UploadMenuRequestDTO_MenuFile_DEFAULT
+func (p *ActivateMenuVersionResponseDTO) GetOrphanMappings() (v []*OrphanRoleMenuMappingDTO) {
+	if !p.IsSetOrphanMappings() {
+		return ActivateMenuVersionResponseDTO_OrphanMappings_DEFAULT
 	}
-	return p.MenuFile
+	return p.OrphanMappings
 }
 
-var fieldIDToName_UploadMenuRequestDTO = map[int16]string{
-	1: "menuFile",
+var fieldIDToName_ActivateMenuVersionResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "version",
+	3: "orphanMappings",
 }
 
-func (p *UploadMenuRequestDTO) IsSetMenuFile() bool {
-	return p.MenuFile != nil
+func (p *ActivateMenuVersionResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *UploadMenuRequestDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *ActivateMenuVersionResponseDTO) IsSetVersion() bool {
+	return p.Version != nil
+}
+
+func (p *ActivateMenuVersionResponseDTO) IsSetOrphanMappings() bool {
+	return p.OrphanMappings != nil
+}
+
+func (p *ActivateMenuVersionResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5978,13 +11646,29 @@ func (p *UploadMenuRequestDTO) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 2:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField2(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6004,7 +11688,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadMenuRequestDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_ActivateMenuVersionResponseDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6014,21 +11698,49 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UploadMenuRequestDTO) ReadField1(iprot thrift.TProtocol) error {
+func (p *ActivateMenuVersionResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.BaseResp = _field
+	return nil
+}
+func (p *ActivateMenuVersionResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+	_field := NewMenuVersionDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Version = _field
+	return nil
+}
+func (p *ActivateMenuVersionResponseDTO) ReadField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*OrphanRoleMenuMappingDTO, 0, size)
+	values := make([]OrphanRoleMenuMappingDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field []byte
-	if v, err := iprot.ReadBinary(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = []byte(v)
 	}
-	p.MenuFile = _field
+	p.OrphanMappings = _field
 	return nil
 }
 
-func (p *UploadMenuRequestDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *ActivateMenuVersionResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UploadMenuRequestDTO"); err != nil {
+	if err = oprot.WriteStructBegin("ActivateMenuVersionResponseDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6036,6 +11748,14 @@ func (p *UploadMenuRequestDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 1
 			goto WriteFieldError
 		}
+		if err = p.writeField2(oprot); err != nil {
+			fieldId = 2
+			goto WriteFieldError
+		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6054,12 +11774,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UploadMenuRequestDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMenuFile() {
-		if err = oprot.WriteFieldBegin("menuFile", thrift.STRING, 1); err != nil {
+func (p *ActivateMenuVersionResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBinary([]byte(p.MenuFile)); err != nil {
+		if err := p.BaseResp.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6073,157 +11793,203 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UploadMenuRequestDTO) String() string {
+func (p *ActivateMenuVersionResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err = oprot.WriteFieldBegin("version", thrift.STRUCT, 2); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Version.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *ActivateMenuVersionResponseDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOrphanMappings() {
+		if err = oprot.WriteFieldBegin("orphanMappings", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.OrphanMappings)); err != nil {
+			return err
+		}
+		for _, v := range p.OrphanMappings {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
+}
+
+func (p *ActivateMenuVersionResponseDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UploadMenuRequestDTO(%+v)", *p)
+	return fmt.Sprintf("ActivateMenuVersionResponseDTO(%+v)", *p)
 
 }
 
-/** 菜单节点DTO */
-type MenuNodeDTO struct {
-	/** 菜单名称 (用于显示) */
-	Name *string `thrift:"name,1,optional" json:"name" form:"name" query:"name"`
-	/** 菜单唯一标识符 */
-	ID *string `thrift:"id,2,optional" json:"id" form:"id" query:"id"`
-	/** 路由路径 */
-	Path *string `thrift:"path,3,optional" json:"path" form:"path" query:"path"`
-	/** 菜单图标 (可选) */
-	Icon *string `thrift:"icon,4,optional" json:"icon,omitempty" form:"icon" query:"icon"`
-	/** 前端组件路径 (可选) */
-	Component *string `thrift:"component,5,optional" json:"component,omitempty" form:"component" query:"component"`
-	/** 子菜单列表 (可选) */
-	Children []*MenuNodeDTO `thrift:"children,6,optional,list<MenuNodeDTO>" json:"children,omitempty" form:"children" query:"children"`
-	/** 是否有权限访问此菜单 (可选, 用于权限标记) */
-	HasPermission *bool `thrift:"hasPermission,7,optional" json:"has_permission,omitempty" form:"hasPermission" query:"hasPermission"`
-	/** 权限级别 (可选): read, write, full, none */
-	PermissionLevel *string `thrift:"permissionLevel,8,optional" json:"permission_level,omitempty" form:"permissionLevel" query:"permissionLevel"`
+/** 菜单上传响应DTO */
+type UploadMenuResponseDTO struct {
+	/** 响应状态码 */
+	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
+	/** 是否为仅预检查 */
+	DryRun *bool `thrift:"dryRun,2,optional" json:"dry_run" form:"dryRun" query:"dryRun"`
+	/** 菜单配置是否通过校验 */
+	Valid *bool `thrift:"valid,3,optional" json:"valid" form:"valid" query:"valid"`
+	/** 校验发现的问题 */
+	Issues []*MenuValidationIssueDTO `thrift:"issues,4,optional,list<MenuValidationIssueDTO>" json:"issues" form:"issues" query:"issues"`
+	/** 与当前生效版本相比的菜单变更 */
+	Diff *DiffMenuVersionsResponseDTO `thrift:"diff,5,optional" json:"diff,omitempty" form:"diff" query:"diff"`
+	/** 上传后角色将失去访问权限的菜单映射 */
+	AccessLoss []*OrphanRoleMenuMappingDTO `thrift:"accessLoss,6,optional,list<OrphanRoleMenuMappingDTO>" json:"access_loss" form:"accessLoss" query:"accessLoss"`
+	/** 是否已保存并激活新版本 */
+	Applied *bool `thrift:"applied,7,optional" json:"applied" form:"applied" query:"applied"`
+	/** 新版本标识，仅在实际上传后返回 */
+	Version *string `thrift:"version,8,optional" json:"version,omitempty" form:"version" query:"version"`
 }
 
-func NewMenuNodeDTO() *MenuNodeDTO {
-	return &MenuNodeDTO{}
+func NewUploadMenuResponseDTO() *UploadMenuResponseDTO {
+	return &UploadMenuResponseDTO{}
 }
 
-func (p *MenuNodeDTO) InitDefault() {
+func (p *UploadMenuResponseDTO) InitDefault() {
 }
 
-var MenuNodeDTO_Name_DEFAULT string
+var UploadMenuResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
 
-func (p *MenuNodeDTO) GetName() (v string) {
-	if !p.IsSetName() {
-		return MenuNodeDTO_Name_DEFAULT
+func (p *UploadMenuResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+	if !p.IsSetBaseResp() {
+		return UploadMenuResponseDTO_BaseResp_DEFAULT
 	}
-	return *p.Name
+	return p.BaseResp
 }
 
-var MenuNodeDTO_ID_DEFAULT string
+var UploadMenuResponseDTO_DryRun_DEFAULT bool
 
-func (p *MenuNodeDTO) GetID() (v string) {
-	if !p.IsSetID() {
-		return MenuNodeDTO_ID_DEFAULT
+func (p *UploadMenuResponseDTO) GetDryRun() (v bool) {
+	if !p.IsSetDryRun() {
+		return UploadMenuResponseDTO_DryRun_DEFAULT
 	}
-	return *p.ID
+	return *p.DryRun
 }
 
-var MenuNodeDTO_Path_DEFAULT string
-
-func (p *MenuNodeDTO) GetPath() (v string) {
-	if !p.IsSetPath() {
-		return MenuNodeDTO_Path_DEFAULT
+var UploadMenuResponseDTO_Valid_DEFAULT bool
+
+func (p *UploadMenuResponseDTO) GetValid() (v bool) {
+	if !p.IsSetValid() {
+		return UploadMenuResponseDTO_Valid_DEFAULT
 	}
-	return *p.Path
+	return *p.Valid
 }
 
-var MenuNodeDTO_Icon_DEFAULT string
+var UploadMenuResponseDTO_Issues_DEFAULT []*MenuValidationIssueDTO
 
-func (p *MenuNodeDTO) GetIcon() (v string) {
-	if !p.IsSetIcon() {
-		return MenuNodeDTO_Icon_DEFAULT
+func (p *UploadMenuResponseDTO) GetIssues() (v []*MenuValidationIssueDTO) {
+	if !p.IsSetIssues() {
+		return UploadMenuResponseDTO_Issues_DEFAULT
 	}
-	return *p.Icon
+	return p.Issues
 }
 
-var MenuNodeDTO_Component_DEFAULT string
+var UploadMenuResponseDTO_Diff_DEFAULT *DiffMenuVersionsResponseDTO
 
-func (p *MenuNodeDTO) GetComponent() (v string) {
-	if !p.IsSetComponent() {
-		return MenuNodeDTO_Component_DEFAULT
+func (p *UploadMenuResponseDTO) GetDiff() (v *DiffMenuVersionsResponseDTO) {
+	if !p.IsSetDiff() {
+		return UploadMenuResponseDTO_Diff_DEFAULT
 	}
-	return *p.Component
+	return p.Diff
 }
 
-var MenuNodeDTO_Children_DEFAULT []*MenuNodeDTO
+var UploadMenuResponseDTO_AccessLoss_DEFAULT []*OrphanRoleMenuMappingDTO
 
-func (p *MenuNodeDTO) GetChildren() (v []*MenuNodeDTO) {
-	if !p.IsSetChildren() {
-		return MenuNodeDTO_Children_DEFAULT
+func (p *UploadMenuResponseDTO) GetAccessLoss() (v []*OrphanRoleMenuMappingDTO) {
+	if !p.IsSetAccessLoss() {
+		return UploadMenuResponseDTO_AccessLoss_DEFAULT
 	}
-	return p.Children
+	return p.AccessLoss
 }
 
-var MenuNodeDTO_HasPermission_DEFAULT bool
+var UploadMenuResponseDTO_Applied_DEFAULT bool
 
-func (p *MenuNodeDTO) GetHasPermission() (v bool) {
-	if !p.IsSetHasPermission() {
-		return MenuNodeDTO_HasPermission_DEFAULT
+func (p *UploadMenuResponseDTO) GetApplied() (v bool) {
+	if !p.IsSetApplied() {
+		return UploadMenuResponseDTO_Applied_DEFAULT
 	}
-	return *p.HasPermission
+	return *p.Applied
 }
 
-var MenuNodeDTO_PermissionLevel_DEFAULT string
+var UploadMenuResponseDTO_Version_DEFAULT string
 
-func (p *MenuNodeDTO) GetPermissionLevel() (v string) {
-	if !p.IsSetPermissionLevel() {
-		return MenuNodeDTO_PermissionLevel_DEFAULT
+func (p *UploadMenuResponseDTO) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return UploadMenuResponseDTO_Version_DEFAULT
 	}
-	return *p.PermissionLevel
+	return *p.Version
 }
 
-var fieldIDToName_MenuNodeDTO = map[int16]string{
-	1: "name",
-	2: "id",
-	3: "path",
-	4: "icon",
-	5: "component",
-	6: "children",
-	7: "hasPermission",
-	8: "permissionLevel",
+var fieldIDToName_UploadMenuResponseDTO = map[int16]string{
+	1: "baseResp",
+	2: "dryRun",
+	3: "valid",
+	4: "issues",
+	5: "diff",
+	6: "accessLoss",
+	7: "applied",
+	8: "version",
 }
 
-func (p *MenuNodeDTO) IsSetName() bool {
-	return p.Name != nil
+func (p *UploadMenuResponseDTO) IsSetBaseResp() bool {
+	return p.BaseResp != nil
 }
 
-func (p *MenuNodeDTO) IsSetID() bool {
-	return p.ID != nil
+func (p *UploadMenuResponseDTO) IsSetDryRun() bool {
+	return p.DryRun != nil
 }
 
-func (p *MenuNodeDTO) IsSetPath() bool {
-	return p.Path != nil
+func (p *UploadMenuResponseDTO) IsSetValid() bool {
+	return p.Valid != nil
 }
 
-func (p *MenuNodeDTO) IsSetIcon() bool {
-	return p.Icon != nil
+func (p *UploadMenuResponseDTO) IsSetIssues() bool {
+	return p.Issues != nil
 }
 
-func (p *MenuNodeDTO) IsSetComponent() bool {
-	return p.Component != nil
+func (p *UploadMenuResponseDTO) IsSetDiff() bool {
+	return p.Diff != nil
 }
 
-func (p *MenuNodeDTO) IsSetChildren() bool {
-	return p.Children != nil
+func (p *UploadMenuResponseDTO) IsSetAccessLoss() bool {
+	return p.AccessLoss != nil
 }
 
-func (p *MenuNodeDTO) IsSetHasPermission() bool {
-	return p.HasPermission != nil
+func (p *UploadMenuResponseDTO) IsSetApplied() bool {
+	return p.Applied != nil
 }
 
-func (p *MenuNodeDTO) IsSetPermissionLevel() bool {
-	return p.PermissionLevel != nil
+func (p *UploadMenuResponseDTO) IsSetVersion() bool {
+	return p.Version != nil
 }
 
-func (p *MenuNodeDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *UploadMenuResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6243,7 +12009,7 @@ func (p *MenuNodeDTO) Read(iprot thrift.TProtocol) (err error) {
 
 		switch fieldId {
 		case 1:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField1(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6251,7 +12017,7 @@ func (p *MenuNodeDTO) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6259,7 +12025,7 @@ func (p *MenuNodeDTO) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 3:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.BOOL {
 				if err = p.ReadField3(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6267,7 +12033,7 @@ func (p *MenuNodeDTO) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 4:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.LIST {
 				if err = p.ReadField4(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6275,7 +12041,7 @@ func (p *MenuNodeDTO) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 5:
-			if fieldTypeId == thrift.STRING {
+			if fieldTypeId == thrift.STRUCT {
 				if err = p.ReadField5(iprot); err != nil {
 					goto ReadFieldError
 				}
@@ -6325,7 +12091,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_MenuNodeDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UploadMenuResponseDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6335,68 +12101,74 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *MenuNodeDTO) ReadField1(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *UploadMenuResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+	_field := http_base.NewBaseResponseDTO()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Name = _field
+	p.BaseResp = _field
 	return nil
 }
-func (p *MenuNodeDTO) ReadField2(iprot thrift.TProtocol) error {
+func (p *UploadMenuResponseDTO) ReadField2(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.ID = _field
+	p.DryRun = _field
 	return nil
 }
-func (p *MenuNodeDTO) ReadField3(iprot thrift.TProtocol) error {
+func (p *UploadMenuResponseDTO) ReadField3(iprot thrift.TProtocol) error {
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+	var _field *bool
+	if v, err := iprot.ReadBool(); err != nil {
 		return err
 	} else {
 		_field = &v
 	}
-	p.Path = _field
+	p.Valid = _field
 	return nil
 }
-func (p *MenuNodeDTO) ReadField4(iprot thrift.TProtocol) error {
+func (p *UploadMenuResponseDTO) ReadField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return err
+	}
+	_field := make([]*MenuValidationIssueDTO, 0, size)
+	values := make([]MenuValidationIssueDTO, size)
+	for i := 0; i < size; i++ {
+		_elem := &values[i]
+		_elem.InitDefault()
 
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+		if err := _elem.Read(iprot); err != nil {
+			return err
+		}
+
+		_field = append(_field, _elem)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Icon = _field
+	p.Issues = _field
 	return nil
 }
-func (p *MenuNodeDTO) ReadField5(iprot thrift.TProtocol) error {
-
-	var _field *string
-	if v, err := iprot.ReadString(); err != nil {
+func (p *UploadMenuResponseDTO) ReadField5(iprot thrift.TProtocol) error {
+	_field := NewDiffMenuVersionsResponseDTO()
+	if err := _field.Read(iprot); err != nil {
 		return err
-	} else {
-		_field = &v
 	}
-	p.Component = _field
+	p.Diff = _field
 	return nil
 }
-func (p *MenuNodeDTO) ReadField6(iprot thrift.TProtocol) error {
+func (p *UploadMenuResponseDTO) ReadField6(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*MenuNodeDTO, 0, size)
-	values := make([]MenuNodeDTO, size)
+	_field := make([]*OrphanRoleMenuMappingDTO, 0, size)
+	values := make([]OrphanRoleMenuMappingDTO, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -6410,10 +12182,10 @@ func (p *MenuNodeDTO) ReadField6(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.Children = _field
+	p.AccessLoss = _field
 	return nil
 }
-func (p *MenuNodeDTO) ReadField7(iprot thrift.TProtocol) error {
+func (p *UploadMenuResponseDTO) ReadField7(iprot thrift.TProtocol) error {
 
 	var _field *bool
 	if v, err := iprot.ReadBool(); err != nil {
@@ -6421,10 +12193,10 @@ func (p *MenuNodeDTO) ReadField7(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.HasPermission = _field
+	p.Applied = _field
 	return nil
 }
-func (p *MenuNodeDTO) ReadField8(iprot thrift.TProtocol) error {
+func (p *UploadMenuResponseDTO) ReadField8(iprot thrift.TProtocol) error {
 
 	var _field *string
 	if v, err := iprot.ReadString(); err != nil {
@@ -6432,13 +12204,13 @@ func (p *MenuNodeDTO) ReadField8(iprot thrift.TProtocol) error {
 	} else {
 		_field = &v
 	}
-	p.PermissionLevel = _field
+	p.Version = _field
 	return nil
 }
 
-func (p *MenuNodeDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *UploadMenuResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("MenuNodeDTO"); err != nil {
+	if err = oprot.WriteStructBegin("UploadMenuResponseDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6492,12 +12264,12 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *MenuNodeDTO) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetName() {
-		if err = oprot.WriteFieldBegin("name", thrift.STRING, 1); err != nil {
+func (p *UploadMenuResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBaseResp() {
+		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Name); err != nil {
+		if err := p.BaseResp.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6511,12 +12283,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *MenuNodeDTO) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetID() {
-		if err = oprot.WriteFieldBegin("id", thrift.STRING, 2); err != nil {
+func (p *UploadMenuResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDryRun() {
+		if err = oprot.WriteFieldBegin("dryRun", thrift.BOOL, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.ID); err != nil {
+		if err := oprot.WriteBool(*p.DryRun); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6530,12 +12302,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
 }
 
-func (p *MenuNodeDTO) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPath() {
-		if err = oprot.WriteFieldBegin("path", thrift.STRING, 3); err != nil {
+func (p *UploadMenuResponseDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetValid() {
+		if err = oprot.WriteFieldBegin("valid", thrift.BOOL, 3); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Path); err != nil {
+		if err := oprot.WriteBool(*p.Valid); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6549,12 +12321,20 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *MenuNodeDTO) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIcon() {
-		if err = oprot.WriteFieldBegin("icon", thrift.STRING, 4); err != nil {
+func (p *UploadMenuResponseDTO) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIssues() {
+		if err = oprot.WriteFieldBegin("issues", thrift.LIST, 4); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Icon); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Issues)); err != nil {
+			return err
+		}
+		for _, v := range p.Issues {
+			if err := v.Write(oprot); err != nil {
+				return err
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6568,12 +12348,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 4 end error: ", p), err)
 }
 
-func (p *MenuNodeDTO) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetComponent() {
-		if err = oprot.WriteFieldBegin("component", thrift.STRING, 5); err != nil {
+func (p *UploadMenuResponseDTO) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiff() {
+		if err = oprot.WriteFieldBegin("diff", thrift.STRUCT, 5); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.Component); err != nil {
+		if err := p.Diff.Write(oprot); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6587,15 +12367,15 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 5 end error: ", p), err)
 }
 
-func (p *MenuNodeDTO) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetChildren() {
-		if err = oprot.WriteFieldBegin("children", thrift.LIST, 6); err != nil {
+func (p *UploadMenuResponseDTO) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAccessLoss() {
+		if err = oprot.WriteFieldBegin("accessLoss", thrift.LIST, 6); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Children)); err != nil {
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.AccessLoss)); err != nil {
 			return err
 		}
-		for _, v := range p.Children {
+		for _, v := range p.AccessLoss {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -6614,12 +12394,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 6 end error: ", p), err)
 }
 
-func (p *MenuNodeDTO) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetHasPermission() {
-		if err = oprot.WriteFieldBegin("hasPermission", thrift.BOOL, 7); err != nil {
+func (p *UploadMenuResponseDTO) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetApplied() {
+		if err = oprot.WriteFieldBegin("applied", thrift.BOOL, 7); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteBool(*p.HasPermission); err != nil {
+		if err := oprot.WriteBool(*p.Applied); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6633,12 +12413,12 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 7 end error: ", p), err)
 }
 
-func (p *MenuNodeDTO) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPermissionLevel() {
-		if err = oprot.WriteFieldBegin("permissionLevel", thrift.STRING, 8); err != nil {
+func (p *UploadMenuResponseDTO) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err = oprot.WriteFieldBegin("version", thrift.STRING, 8); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteString(*p.PermissionLevel); err != nil {
+		if err := oprot.WriteString(*p.Version); err != nil {
 			return err
 		}
 		if err = oprot.WriteFieldEnd(); err != nil {
@@ -6652,61 +12432,77 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 8 end error: ", p), err)
 }
 
-func (p *MenuNodeDTO) String() string {
+func (p *UploadMenuResponseDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("MenuNodeDTO(%+v)", *p)
+	return fmt.Sprintf("UploadMenuResponseDTO(%+v)", *p)
 
 }
 
-/** 菜单树获取响应DTO */
-type GetMenuTreeResponseDTO struct {
+/** 孤立角色菜单映射查询响应DTO */
+type GetOrphanRoleMenuMappingsResponseDTO struct {
 	/** 响应状态码 */
 	BaseResp *http_base.BaseResponseDTO `thrift:"baseResp,1,optional" json:"base_resp" form:"baseResp" query:"baseResp"`
-	/** 完整的菜单树结构 */
-	MenuTree []*MenuNodeDTO `thrift:"menuTree,2,optional,list<MenuNodeDTO>" json:"menu_tree" form:"menuTree" query:"menuTree"`
+	/** 当前生效版本 */
+	Version *string `thrift:"version,2,optional" json:"version" form:"version" query:"version"`
+	/** 孤立的角色菜单映射 */
+	Mappings []*OrphanRoleMenuMappingDTO `thrift:"mappings,3,optional,list<OrphanRoleMenuMappingDTO>" json:"mappings" form:"mappings" query:"mappings"`
 }
 
-func NewGetMenuTreeResponseDTO() *GetMenuTreeResponseDTO {
-	return &GetMenuTreeResponseDTO{}
+func NewGetOrphanRoleMenuMappingsResponseDTO() *GetOrphanRoleMenuMappingsResponseDTO {
+	return &GetOrphanRoleMenuMappingsResponseDTO{}
 }
 
-func (p *GetMenuTreeResponseDTO) InitDefault() {
+func (p *GetOrphanRoleMenuMappingsResponseDTO) InitDefault() {
 }
 
-var GetMenuTreeResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
+var GetOrphanRoleMenuMappingsResponseDTO_BaseResp_DEFAULT *http_base.BaseResponseDTO
 
-func (p *GetMenuTreeResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
+func (p *GetOrphanRoleMenuMappingsResponseDTO) GetBaseResp() (v *http_base.BaseResponseDTO) {
 	if !p.IsSetBaseResp() {
-		return GetMenuTreeResponseDTO_BaseResp_DEFAULT
+		return GetOrphanRoleMenuMappingsResponseDTO_BaseResp_DEFAULT
 	}
 	return p.BaseResp
 }
 
-var GetMenuTreeResponseDTO_MenuTree_DEFAULT []*MenuNodeDTO
+var GetOrphanRoleMenuMappingsResponseDTO_Version_DEFAULT string
 
-func (p *GetMenuTreeResponseDTO) GetMenuTree() (v []*MenuNodeDTO) {
-	if !p.IsSetMenuTree() {
-		return GetMenuTreeResponseDTO_MenuTree_DEFAULT
+func (p *GetOrphanRoleMenuMappingsResponseDTO) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return GetOrphanRoleMenuMappingsResponseDTO_Version_DEFAULT
 	}
-	return p.MenuTree
+	return *p.Version
 }
 
-var fieldIDToName_GetMenuTreeResponseDTO = map[int16]string{
+var GetOrphanRoleMenuMappingsResponseDTO_Mappings_DEFAULT []*OrphanRoleMenuMappingDTO
+
+func (p *GetOrphanRoleMenuMappingsResponseDTO) GetMappings() (v []*OrphanRoleMenuMappingDTO) {
+	if !p.IsSetMappings() {
+		return GetOrphanRoleMenuMappingsResponseDTO_Mappings_DEFAULT
+	}
+	return p.Mappings
+}
+
+var fieldIDToName_GetOrphanRoleMenuMappingsResponseDTO = map[int16]string{
 	1: "baseResp",
-	2: "menuTree",
+	2: "version",
+	3: "mappings",
 }
 
-func (p *GetMenuTreeResponseDTO) IsSetBaseResp() bool {
+func (p *GetOrphanRoleMenuMappingsResponseDTO) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
-func (p *GetMenuTreeResponseDTO) IsSetMenuTree() bool {
-	return p.MenuTree != nil
+func (p *GetOrphanRoleMenuMappingsResponseDTO) IsSetVersion() bool {
+	return p.Version != nil
 }
 
-func (p *GetMenuTreeResponseDTO) Read(iprot thrift.TProtocol) (err error) {
+func (p *GetOrphanRoleMenuMappingsResponseDTO) IsSetMappings() bool {
+	return p.Mappings != nil
+}
+
+func (p *GetOrphanRoleMenuMappingsResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6734,13 +12530,21 @@ func (p *GetMenuTreeResponseDTO) Read(iprot thrift.TProtocol) (err error) {
 				goto SkipFieldError
 			}
 		case 2:
-			if fieldTypeId == thrift.LIST {
+			if fieldTypeId == thrift.STRING {
 				if err = p.ReadField2(iprot); err != nil {
 					goto ReadFieldError
 				}
 			} else if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
 			}
+		case 3:
+			if fieldTypeId == thrift.LIST {
+				if err = p.ReadField3(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
 		default:
 			if err = iprot.Skip(fieldTypeId); err != nil {
 				goto SkipFieldError
@@ -6760,7 +12564,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetMenuTreeResponseDTO[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_GetOrphanRoleMenuMappingsResponseDTO[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6770,7 +12574,7 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *GetMenuTreeResponseDTO) ReadField1(iprot thrift.TProtocol) error {
+func (p *GetOrphanRoleMenuMappingsResponseDTO) ReadField1(iprot thrift.TProtocol) error {
 	_field := http_base.NewBaseResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
@@ -6778,13 +12582,24 @@ func (p *GetMenuTreeResponseDTO) ReadField1(iprot thrift.TProtocol) error {
 	p.BaseResp = _field
 	return nil
 }
-func (p *GetMenuTreeResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+func (p *GetOrphanRoleMenuMappingsResponseDTO) ReadField2(iprot thrift.TProtocol) error {
+
+	var _field *string
+	if v, err := iprot.ReadString(); err != nil {
+		return err
+	} else {
+		_field = &v
+	}
+	p.Version = _field
+	return nil
+}
+func (p *GetOrphanRoleMenuMappingsResponseDTO) ReadField3(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return err
 	}
-	_field := make([]*MenuNodeDTO, 0, size)
-	values := make([]MenuNodeDTO, size)
+	_field := make([]*OrphanRoleMenuMappingDTO, 0, size)
+	values := make([]OrphanRoleMenuMappingDTO, size)
 	for i := 0; i < size; i++ {
 		_elem := &values[i]
 		_elem.InitDefault()
@@ -6798,13 +12613,13 @@ func (p *GetMenuTreeResponseDTO) ReadField2(iprot thrift.TProtocol) error {
 	if err := iprot.ReadListEnd(); err != nil {
 		return err
 	}
-	p.MenuTree = _field
+	p.Mappings = _field
 	return nil
 }
 
-func (p *GetMenuTreeResponseDTO) Write(oprot thrift.TProtocol) (err error) {
+func (p *GetOrphanRoleMenuMappingsResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetMenuTreeResponseDTO"); err != nil {
+	if err = oprot.WriteStructBegin("GetOrphanRoleMenuMappingsResponseDTO"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6816,6 +12631,10 @@ func (p *GetMenuTreeResponseDTO) Write(oprot thrift.TProtocol) (err error) {
 			fieldId = 2
 			goto WriteFieldError
 		}
+		if err = p.writeField3(oprot); err != nil {
+			fieldId = 3
+			goto WriteFieldError
+		}
 	}
 	if err = oprot.WriteFieldStop(); err != nil {
 		goto WriteFieldStopError
@@ -6834,7 +12653,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *GetMenuTreeResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *GetOrphanRoleMenuMappingsResponseDTO) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetBaseResp() {
 		if err = oprot.WriteFieldBegin("baseResp", thrift.STRUCT, 1); err != nil {
 			goto WriteFieldBeginError
@@ -6853,15 +12672,34 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *GetMenuTreeResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMenuTree() {
-		if err = oprot.WriteFieldBegin("menuTree", thrift.LIST, 2); err != nil {
+func (p *GetOrphanRoleMenuMappingsResponseDTO) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVersion() {
+		if err = oprot.WriteFieldBegin("version", thrift.STRING, 2); err != nil {
 			goto WriteFieldBeginError
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.MenuTree)); err != nil {
+		if err := oprot.WriteString(*p.Version); err != nil {
 			return err
 		}
-		for _, v := range p.MenuTree {
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+}
+
+func (p *GetOrphanRoleMenuMappingsResponseDTO) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMappings() {
+		if err = oprot.WriteFieldBegin("mappings", thrift.LIST, 3); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Mappings)); err != nil {
+			return err
+		}
+		for _, v := range p.Mappings {
 			if err := v.Write(oprot); err != nil {
 				return err
 			}
@@ -6875,16 +12713,16 @@ func (p *GetMenuTreeResponseDTO) writeField2(oprot thrift.TProtocol) (err error)
 	}
 	return nil
 WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 begin error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 begin error: ", p), err)
 WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 2 end error: ", p), err)
+	return thrift.PrependError(fmt.Sprintf("%T write field 3 end error: ", p), err)
 }
 
-func (p *GetMenuTreeResponseDTO) String() string {
+func (p *GetOrphanRoleMenuMappingsResponseDTO) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetMenuTreeResponseDTO(%+v)", *p)
+	return fmt.Sprintf("GetOrphanRoleMenuMappingsResponseDTO(%+v)", *p)
 
 }
 
@@ -63,6 +63,15 @@ type PermissionService interface {
 	 * @return resp (RoleDefinitionListResponseDTO) - 角色定义列表及分页信息
 	 */
 	ListRoleDefinitions(ctx context.Context, req *RoleDefinitionQueryRequestDTO) (r *RoleDefinitionListResponseDTO, err error)
+	/**
+	 * GetPermissionCatalog：获取权限目录
+	 *
+	 * 返回网关路由声明的全部接口权限（resource:action）及其分组与描述，
+	 * 角色定义中的权限必须来自该目录。
+	 *
+	 * @return resp (GetPermissionCatalogResponseDTO) - 按分组排列的权限目录
+	 */
+	GetPermissionCatalog(ctx context.Context) (r *GetPermissionCatalogResponseDTO, err error)
 	/**
 	 * GetLastUserRoleAssignment：获取用户最后一次角色分配
 	 *
@@ -106,11 +115,13 @@ type PermissionService interface {
 	 * UploadMenu：上传菜单
 	 *
 	 * 上传菜单配置文件，用于定义系统的菜单结构和权限。
+	 * 上传前校验菜单配置；dry_run 时只返回校验结果与角色权限影响，
+	 * 会导致角色失去菜单权限的上传需指定 force。
 	 *
 	 * @param req (UploadMenuRequestDTO) - 上传菜单请求参数
-	 * @return err (core.Error) - 错误信息
+	 * @return resp (UploadMenuResponseDTO) - 校验结果、版本变更与角色权限影响
 	 */
-	UploadMenu(ctx context.Context, req *UploadMenuRequestDTO) (r *http_base.OperationStatusResponseDTO, err error)
+	UploadMenu(ctx context.Context, req *UploadMenuRequestDTO) (r *UploadMenuResponseDTO, err error)
 	/**
 	 * GetMenuTree：获取菜单树
 	 *
@@ -120,6 +131,40 @@ type PermissionService interface {
 	 * @return err (core.Error) - 错误信息
 	 */
 	GetMenuTree(ctx context.Context) (r *GetMenuTreeResponseDTO, err error)
+	/**
+	 * ListMenuVersions：列出菜单版本
+	 *
+	 * 列出全部菜单版本及其上传者、上传时间和生效状态。
+	 *
+	 * @return resp (ListMenuVersionsResponseDTO) - 菜单版本列表
+	 */
+	ListMenuVersions(ctx context.Context) (r *ListMenuVersionsResponseDTO, err error)
+	/**
+	 * DiffMenuVersions：比较菜单版本
+	 *
+	 * 按语义ID比较两个菜单版本，给出新增、删除、移动和重命名的菜单。
+	 *
+	 * @param req (DiffMenuVersionsRequestDTO) - 基准版本与目标版本
+	 * @return resp (DiffMenuVersionsResponseDTO) - 版本差异
+	 */
+	DiffMenuVersions(ctx context.Context, req *DiffMenuVersionsRequestDTO) (r *DiffMenuVersionsResponseDTO, err error)
+	/**
+	 * ActivateMenuVersion：激活菜单版本
+	 *
+	 * 将指定的历史版本设为生效版本，用于回滚错误的菜单上传。
+	 *
+	 * @param req (ActivateMenuVersionRequestDTO) - 要激活的版本
+	 * @return resp (ActivateMenuVersionResponseDTO) - 生效版本及孤立的角色菜单映射
+	 */
+	ActivateMenuVersion(ctx context.Context, req *ActivateMenuVersionRequestDTO) (r *ActivateMenuVersionResponseDTO, err error)
+	/**
+	 * GetOrphanRoleMenuMappings：查询孤立的角色菜单映射
+	 *
+	 * 列出指向生效版本中不存在菜单的角色菜单映射。
+	 *
+	 * @return resp (GetOrphanRoleMenuMappingsResponseDTO) - 孤立的角色菜单映射
+	 */
+	GetOrphanRoleMenuMappings(ctx context.Context) (r *GetOrphanRoleMenuMappingsResponseDTO, err error)
 	// -----------------------------------------------------------------
 	// 菜单权限管理模块 (Menu Permission Management)
 	// -----------------------------------------------------------------
@@ -241,6 +286,14 @@ func (p *PermissionServiceClient) ListRoleDefinitions(ctx context.Context, req *
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *PermissionServiceClient) GetPermissionCatalog(ctx context.Context) (r *GetPermissionCatalogResponseDTO, err error) {
+	var _args PermissionServiceGetPermissionCatalogArgs
+	var _result PermissionServiceGetPermissionCatalogResult
+	if err = p.Client_().Call(ctx, "GetPermissionCatalog", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *PermissionServiceClient) GetLastUserRoleAssignment(ctx context.Context, req *GetLastUserRoleAssignmentRequestDTO) (r *GetLastUserRoleAssignmentResponseDTO, err error) {
 	var _args PermissionServiceGetLastUserRoleAssignmentArgs
 	_args.Req = req
@@ -277,7 +330,7 @@ func (p *PermissionServiceClient) BatchBindUsersToRole(ctx context.Context, req
 	}
 	return _result.GetSuccess(), nil
 }
-func (p *PermissionServiceClient) UploadMenu(ctx context.Context, req *UploadMenuRequestDTO) (r *http_base.OperationStatusResponseDTO, err error) {
+func (p *PermissionServiceClient) UploadMenu(ctx context.Context, req *UploadMenuRequestDTO) (r *UploadMenuResponseDTO, err error) {
 	var _args PermissionServiceUploadMenuArgs
 	_args.Req = req
 	var _result PermissionServiceUploadMenuResult
@@ -294,6 +347,40 @@ func (p *PermissionServiceClient) GetMenuTree(ctx context.Context) (r *GetMenuTr
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *PermissionServiceClient) ListMenuVersions(ctx context.Context) (r *ListMenuVersionsResponseDTO, err error) {
+	var _args PermissionServiceListMenuVersionsArgs
+	var _result PermissionServiceListMenuVersionsResult
+	if err = p.Client_().Call(ctx, "ListMenuVersions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PermissionServiceClient) DiffMenuVersions(ctx context.Context, req *DiffMenuVersionsRequestDTO) (r *DiffMenuVersionsResponseDTO, err error) {
+	var _args PermissionServiceDiffMenuVersionsArgs
+	_args.Req = req
+	var _result PermissionServiceDiffMenuVersionsResult
+	if err = p.Client_().Call(ctx, "DiffMenuVersions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PermissionServiceClient) ActivateMenuVersion(ctx context.Context, req *ActivateMenuVersionRequestDTO) (r *ActivateMenuVersionResponseDTO, err error) {
+	var _args PermissionServiceActivateMenuVersionArgs
+	_args.Req = req
+	var _result PermissionServiceActivateMenuVersionResult
+	if err = p.Client_().Call(ctx, "ActivateMenuVersion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PermissionServiceClient) GetOrphanRoleMenuMappings(ctx context.Context) (r *GetOrphanRoleMenuMappingsResponseDTO, err error) {
+	var _args PermissionServiceGetOrphanRoleMenuMappingsArgs
+	var _result PermissionServiceGetOrphanRoleMenuMappingsResult
+	if err = p.Client_().Call(ctx, "GetOrphanRoleMenuMappings", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *PermissionServiceClient) ConfigureRoleMenus(ctx context.Context, req *ConfigureRoleMenusRequestDTO) (r *ConfigureRoleMenusResponseDTO, err error) {
 	var _args PermissionServiceConfigureRoleMenusArgs
 	_args.Req = req
@@ -365,12 +452,17 @@ func NewPermissionServiceProcessor(handler PermissionService) *PermissionService
 	self.AddToProcessorMap("DeleteRoleDefinition", &permissionServiceProcessorDeleteRoleDefinition{handler: handler})
 	self.AddToProcessorMap("GetRoleDefinition", &permissionServiceProcessorGetRoleDefinition{handler: handler})
 	self.AddToProcessorMap("ListRoleDefinitions", &permissionServiceProcessorListRoleDefinitions{handler: handler})
+	self.AddToProcessorMap("GetPermissionCatalog", &permissionServiceProcessorGetPermissionCatalog{handler: handler})
 	self.AddToProcessorMap("GetLastUserRoleAssignment", &permissionServiceProcessorGetLastUserRoleAssignment{handler: handler})
 	self.AddToProcessorMap("ListUserRoleAssignments", &permissionServiceProcessorListUserRoleAssignments{handler: handler})
 	self.AddToProcessorMap("GetUsersByRole", &permissionServiceProcessorGetUsersByRole{handler: handler})
 	self.AddToProcessorMap("BatchBindUsersToRole", &permissionServiceProcessorBatchBindUsersToRole{handler: handler})
 	self.AddToProcessorMap("UploadMenu", &permissionServiceProcessorUploadMenu{handler: handler})
 	self.AddToProcessorMap("GetMenuTree", &permissionServiceProcessorGetMenuTree{handler: handler})
+	self.AddToProcessorMap("ListMenuVersions", &permissionServiceProcessorListMenuVersions{handler: handler})
+	self.AddToProcessorMap("DiffMenuVersions", &permissionServiceProcessorDiffMenuVersions{handler: handler})
+	self.AddToProcessorMap("ActivateMenuVersion", &permissionServiceProcessorActivateMenuVersion{handler: handler})
+	self.AddToProcessorMap("GetOrphanRoleMenuMappings", &permissionServiceProcessorGetOrphanRoleMenuMappings{handler: handler})
 	self.AddToProcessorMap("ConfigureRoleMenus", &permissionServiceProcessorConfigureRoleMenus{handler: handler})
 	self.AddToProcessorMap("GetRoleMenuTree", &permissionServiceProcessorGetRoleMenuTree{handler: handler})
 	self.AddToProcessorMap("GetUserMenuTree", &permissionServiceProcessorGetUserMenuTree{handler: handler})
@@ -636,6 +728,54 @@ func (p *permissionServiceProcessorListRoleDefinitions) Process(ctx context.Cont
 	return true, err
 }
 
+type permissionServiceProcessorGetPermissionCatalog struct {
+	handler PermissionService
+}
+
+func (p *permissionServiceProcessorGetPermissionCatalog) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PermissionServiceGetPermissionCatalogArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetPermissionCatalog", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PermissionServiceGetPermissionCatalogResult{}
+	var retval *GetPermissionCatalogResponseDTO
+	if retval, err2 = p.handler.GetPermissionCatalog(ctx); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPermissionCatalog: "+err2.Error())
+		oprot.WriteMessageBegin("GetPermissionCatalog", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetPermissionCatalog", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
 type permissionServiceProcessorGetLastUserRoleAssignment struct {
 	handler PermissionService
 }
@@ -847,7 +987,7 @@ func (p *permissionServiceProcessorUploadMenu) Process(ctx context.Context, seqI
 	iprot.ReadMessageEnd()
 	var err2 error
 	result := PermissionServiceUploadMenuResult{}
-	var retval *http_base.OperationStatusResponseDTO
+	var retval *UploadMenuResponseDTO
 	if retval, err2 = p.handler.UploadMenu(ctx, args.Req); err2 != nil {
 		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UploadMenu: "+err2.Error())
 		oprot.WriteMessageBegin("UploadMenu", thrift.EXCEPTION, seqId)
@@ -924,6 +1064,198 @@ func (p *permissionServiceProcessorGetMenuTree) Process(ctx context.Context, seq
 	return true, err
 }
 
+type permissionServiceProcessorListMenuVersions struct {
+	handler PermissionService
+}
+
+func (p *permissionServiceProcessorListMenuVersions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PermissionServiceListMenuVersionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ListMenuVersions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PermissionServiceListMenuVersionsResult{}
+	var retval *ListMenuVersionsResponseDTO
+	if retval, err2 = p.handler.ListMenuVersions(ctx); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListMenuVersions: "+err2.Error())
+		oprot.WriteMessageBegin("ListMenuVersions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ListMenuVersions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type permissionServiceProcessorDiffMenuVersions struct {
+	handler PermissionService
+}
+
+func (p *permissionServiceProcessorDiffMenuVersions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PermissionServiceDiffMenuVersionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DiffMenuVersions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PermissionServiceDiffMenuVersionsResult{}
+	var retval *DiffMenuVersionsResponseDTO
+	if retval, err2 = p.handler.DiffMenuVersions(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DiffMenuVersions: "+err2.Error())
+		oprot.WriteMessageBegin("DiffMenuVersions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DiffMenuVersions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type permissionServiceProcessorActivateMenuVersion struct {
+	handler PermissionService
+}
+
+func (p *permissionServiceProcessorActivateMenuVersion) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PermissionServiceActivateMenuVersionArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ActivateMenuVersion", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PermissionServiceActivateMenuVersionResult{}
+	var retval *ActivateMenuVersionResponseDTO
+	if retval, err2 = p.handler.ActivateMenuVersion(ctx, args.Req); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ActivateMenuVersion: "+err2.Error())
+		oprot.WriteMessageBegin("ActivateMenuVersion", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ActivateMenuVersion", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type permissionServiceProcessorGetOrphanRoleMenuMappings struct {
+	handler PermissionService
+}
+
+func (p *permissionServiceProcessorGetOrphanRoleMenuMappings) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PermissionServiceGetOrphanRoleMenuMappingsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetOrphanRoleMenuMappings", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PermissionServiceGetOrphanRoleMenuMappingsResult{}
+	var retval *GetOrphanRoleMenuMappingsResponseDTO
+	if retval, err2 = p.handler.GetOrphanRoleMenuMappings(ctx); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetOrphanRoleMenuMappings: "+err2.Error())
+		oprot.WriteMessageBegin("GetOrphanRoleMenuMappings", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetOrphanRoleMenuMappings", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
 type permissionServiceProcessorConfigureRoleMenus struct {
 	handler PermissionService
 }
@@ -2634,35 +2966,266 @@ func (p *PermissionServiceListRoleDefinitionsResult) String() string {
 
 }
 
-type PermissionServiceGetLastUserRoleAssignmentArgs struct {
-	Req *GetLastUserRoleAssignmentRequestDTO `thrift:"req,1"`
-}
-
-func NewPermissionServiceGetLastUserRoleAssignmentArgs() *PermissionServiceGetLastUserRoleAssignmentArgs {
-	return &PermissionServiceGetLastUserRoleAssignmentArgs{}
-}
-
-func (p *PermissionServiceGetLastUserRoleAssignmentArgs) InitDefault() {
+type PermissionServiceGetPermissionCatalogArgs struct {
 }
 
-var PermissionServiceGetLastUserRoleAssignmentArgs_Req_DEFAULT *GetLastUserRoleAssignmentRequestDTO
-
-func (p *PermissionServiceGetLastUserRoleAssignmentArgs) GetReq() (v *GetLastUserRoleAssignmentRequestDTO) {
-	if !p.IsSetReq() {
-		return PermissionServiceGetLastUserRoleAssignmentArgs_Req_DEFAULT
-	}
-	return p.Req
+func NewPermissionServiceGetPermissionCatalogArgs() *PermissionServiceGetPermissionCatalogArgs {
+	return &PermissionServiceGetPermissionCatalogArgs{}
 }
 
-var fieldIDToName_PermissionServiceGetLastUserRoleAssignmentArgs = map[int16]string{
-	1: "req",
+func (p *PermissionServiceGetPermissionCatalogArgs) InitDefault() {
 }
 
-func (p *PermissionServiceGetLastUserRoleAssignmentArgs) IsSetReq() bool {
-	return p.Req != nil
-}
+var fieldIDToName_PermissionServiceGetPermissionCatalogArgs = map[int16]string{}
 
-func (p *PermissionServiceGetLastUserRoleAssignmentArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PermissionServiceGetPermissionCatalogArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PermissionServiceGetPermissionCatalogArgs) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("GetPermissionCatalog_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PermissionServiceGetPermissionCatalogArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PermissionServiceGetPermissionCatalogArgs(%+v)", *p)
+
+}
+
+type PermissionServiceGetPermissionCatalogResult struct {
+	Success *GetPermissionCatalogResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewPermissionServiceGetPermissionCatalogResult() *PermissionServiceGetPermissionCatalogResult {
+	return &PermissionServiceGetPermissionCatalogResult{}
+}
+
+func (p *PermissionServiceGetPermissionCatalogResult) InitDefault() {
+}
+
+var PermissionServiceGetPermissionCatalogResult_Success_DEFAULT *GetPermissionCatalogResponseDTO
+
+func (p *PermissionServiceGetPermissionCatalogResult) GetSuccess() (v *GetPermissionCatalogResponseDTO) {
+	if !p.IsSetSuccess() {
+		return PermissionServiceGetPermissionCatalogResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PermissionServiceGetPermissionCatalogResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PermissionServiceGetPermissionCatalogResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PermissionServiceGetPermissionCatalogResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PermissionServiceGetPermissionCatalogResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PermissionServiceGetPermissionCatalogResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetPermissionCatalogResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PermissionServiceGetPermissionCatalogResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPermissionCatalog_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PermissionServiceGetPermissionCatalogResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PermissionServiceGetPermissionCatalogResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PermissionServiceGetPermissionCatalogResult(%+v)", *p)
+
+}
+
+type PermissionServiceGetLastUserRoleAssignmentArgs struct {
+	Req *GetLastUserRoleAssignmentRequestDTO `thrift:"req,1"`
+}
+
+func NewPermissionServiceGetLastUserRoleAssignmentArgs() *PermissionServiceGetLastUserRoleAssignmentArgs {
+	return &PermissionServiceGetLastUserRoleAssignmentArgs{}
+}
+
+func (p *PermissionServiceGetLastUserRoleAssignmentArgs) InitDefault() {
+}
+
+var PermissionServiceGetLastUserRoleAssignmentArgs_Req_DEFAULT *GetLastUserRoleAssignmentRequestDTO
+
+func (p *PermissionServiceGetLastUserRoleAssignmentArgs) GetReq() (v *GetLastUserRoleAssignmentRequestDTO) {
+	if !p.IsSetReq() {
+		return PermissionServiceGetLastUserRoleAssignmentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PermissionServiceGetLastUserRoleAssignmentArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PermissionServiceGetLastUserRoleAssignmentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PermissionServiceGetLastUserRoleAssignmentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3957,7 +4520,7 @@ func (p *PermissionServiceUploadMenuArgs) String() string {
 }
 
 type PermissionServiceUploadMenuResult struct {
-	Success *http_base.OperationStatusResponseDTO `thrift:"success,0,optional"`
+	Success *UploadMenuResponseDTO `thrift:"success,0,optional"`
 }
 
 func NewPermissionServiceUploadMenuResult() *PermissionServiceUploadMenuResult {
@@ -3967,9 +4530,9 @@ func NewPermissionServiceUploadMenuResult() *PermissionServiceUploadMenuResult {
 func (p *PermissionServiceUploadMenuResult) InitDefault() {
 }
 
-var PermissionServiceUploadMenuResult_Success_DEFAULT *http_base.OperationStatusResponseDTO
+var PermissionServiceUploadMenuResult_Success_DEFAULT *UploadMenuResponseDTO
 
-func (p *PermissionServiceUploadMenuResult) GetSuccess() (v *http_base.OperationStatusResponseDTO) {
+func (p *PermissionServiceUploadMenuResult) GetSuccess() (v *UploadMenuResponseDTO) {
 	if !p.IsSetSuccess() {
 		return PermissionServiceUploadMenuResult_Success_DEFAULT
 	}
@@ -4041,7 +4604,7 @@ ReadStructEndError:
 }
 
 func (p *PermissionServiceUploadMenuResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := http_base.NewOperationStatusResponseDTO()
+	_field := NewUploadMenuResponseDTO()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4335,6 +4898,1056 @@ func (p *PermissionServiceGetMenuTreeResult) String() string {
 
 }
 
+type PermissionServiceListMenuVersionsArgs struct {
+}
+
+func NewPermissionServiceListMenuVersionsArgs() *PermissionServiceListMenuVersionsArgs {
+	return &PermissionServiceListMenuVersionsArgs{}
+}
+
+func (p *PermissionServiceListMenuVersionsArgs) InitDefault() {
+}
+
+var fieldIDToName_PermissionServiceListMenuVersionsArgs = map[int16]string{}
+
+func (p *PermissionServiceListMenuVersionsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PermissionServiceListMenuVersionsArgs) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("ListMenuVersions_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PermissionServiceListMenuVersionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PermissionServiceListMenuVersionsArgs(%+v)", *p)
+
+}
+
+type PermissionServiceListMenuVersionsResult struct {
+	Success *ListMenuVersionsResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewPermissionServiceListMenuVersionsResult() *PermissionServiceListMenuVersionsResult {
+	return &PermissionServiceListMenuVersionsResult{}
+}
+
+func (p *PermissionServiceListMenuVersionsResult) InitDefault() {
+}
+
+var PermissionServiceListMenuVersionsResult_Success_DEFAULT *ListMenuVersionsResponseDTO
+
+func (p *PermissionServiceListMenuVersionsResult) GetSuccess() (v *ListMenuVersionsResponseDTO) {
+	if !p.IsSetSuccess() {
+		return PermissionServiceListMenuVersionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PermissionServiceListMenuVersionsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PermissionServiceListMenuVersionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PermissionServiceListMenuVersionsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PermissionServiceListMenuVersionsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PermissionServiceListMenuVersionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewListMenuVersionsResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PermissionServiceListMenuVersionsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ListMenuVersions_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PermissionServiceListMenuVersionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PermissionServiceListMenuVersionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PermissionServiceListMenuVersionsResult(%+v)", *p)
+
+}
+
+type PermissionServiceDiffMenuVersionsArgs struct {
+	Req *DiffMenuVersionsRequestDTO `thrift:"req,1"`
+}
+
+func NewPermissionServiceDiffMenuVersionsArgs() *PermissionServiceDiffMenuVersionsArgs {
+	return &PermissionServiceDiffMenuVersionsArgs{}
+}
+
+func (p *PermissionServiceDiffMenuVersionsArgs) InitDefault() {
+}
+
+var PermissionServiceDiffMenuVersionsArgs_Req_DEFAULT *DiffMenuVersionsRequestDTO
+
+func (p *PermissionServiceDiffMenuVersionsArgs) GetReq() (v *DiffMenuVersionsRequestDTO) {
+	if !p.IsSetReq() {
+		return PermissionServiceDiffMenuVersionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PermissionServiceDiffMenuVersionsArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PermissionServiceDiffMenuVersionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PermissionServiceDiffMenuVersionsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PermissionServiceDiffMenuVersionsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PermissionServiceDiffMenuVersionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewDiffMenuVersionsRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PermissionServiceDiffMenuVersionsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DiffMenuVersions_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PermissionServiceDiffMenuVersionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PermissionServiceDiffMenuVersionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PermissionServiceDiffMenuVersionsArgs(%+v)", *p)
+
+}
+
+type PermissionServiceDiffMenuVersionsResult struct {
+	Success *DiffMenuVersionsResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewPermissionServiceDiffMenuVersionsResult() *PermissionServiceDiffMenuVersionsResult {
+	return &PermissionServiceDiffMenuVersionsResult{}
+}
+
+func (p *PermissionServiceDiffMenuVersionsResult) InitDefault() {
+}
+
+var PermissionServiceDiffMenuVersionsResult_Success_DEFAULT *DiffMenuVersionsResponseDTO
+
+func (p *PermissionServiceDiffMenuVersionsResult) GetSuccess() (v *DiffMenuVersionsResponseDTO) {
+	if !p.IsSetSuccess() {
+		return PermissionServiceDiffMenuVersionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PermissionServiceDiffMenuVersionsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PermissionServiceDiffMenuVersionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PermissionServiceDiffMenuVersionsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PermissionServiceDiffMenuVersionsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PermissionServiceDiffMenuVersionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewDiffMenuVersionsResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PermissionServiceDiffMenuVersionsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DiffMenuVersions_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PermissionServiceDiffMenuVersionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PermissionServiceDiffMenuVersionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PermissionServiceDiffMenuVersionsResult(%+v)", *p)
+
+}
+
+type PermissionServiceActivateMenuVersionArgs struct {
+	Req *ActivateMenuVersionRequestDTO `thrift:"req,1"`
+}
+
+func NewPermissionServiceActivateMenuVersionArgs() *PermissionServiceActivateMenuVersionArgs {
+	return &PermissionServiceActivateMenuVersionArgs{}
+}
+
+func (p *PermissionServiceActivateMenuVersionArgs) InitDefault() {
+}
+
+var PermissionServiceActivateMenuVersionArgs_Req_DEFAULT *ActivateMenuVersionRequestDTO
+
+func (p *PermissionServiceActivateMenuVersionArgs) GetReq() (v *ActivateMenuVersionRequestDTO) {
+	if !p.IsSetReq() {
+		return PermissionServiceActivateMenuVersionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+var fieldIDToName_PermissionServiceActivateMenuVersionArgs = map[int16]string{
+	1: "req",
+}
+
+func (p *PermissionServiceActivateMenuVersionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *PermissionServiceActivateMenuVersionArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PermissionServiceActivateMenuVersionArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PermissionServiceActivateMenuVersionArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := NewActivateMenuVersionRequestDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Req = _field
+	return nil
+}
+
+func (p *PermissionServiceActivateMenuVersionArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ActivateMenuVersion_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PermissionServiceActivateMenuVersionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PermissionServiceActivateMenuVersionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PermissionServiceActivateMenuVersionArgs(%+v)", *p)
+
+}
+
+type PermissionServiceActivateMenuVersionResult struct {
+	Success *ActivateMenuVersionResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewPermissionServiceActivateMenuVersionResult() *PermissionServiceActivateMenuVersionResult {
+	return &PermissionServiceActivateMenuVersionResult{}
+}
+
+func (p *PermissionServiceActivateMenuVersionResult) InitDefault() {
+}
+
+var PermissionServiceActivateMenuVersionResult_Success_DEFAULT *ActivateMenuVersionResponseDTO
+
+func (p *PermissionServiceActivateMenuVersionResult) GetSuccess() (v *ActivateMenuVersionResponseDTO) {
+	if !p.IsSetSuccess() {
+		return PermissionServiceActivateMenuVersionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PermissionServiceActivateMenuVersionResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PermissionServiceActivateMenuVersionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PermissionServiceActivateMenuVersionResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PermissionServiceActivateMenuVersionResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PermissionServiceActivateMenuVersionResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewActivateMenuVersionResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PermissionServiceActivateMenuVersionResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ActivateMenuVersion_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PermissionServiceActivateMenuVersionResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PermissionServiceActivateMenuVersionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PermissionServiceActivateMenuVersionResult(%+v)", *p)
+
+}
+
+type PermissionServiceGetOrphanRoleMenuMappingsArgs struct {
+}
+
+func NewPermissionServiceGetOrphanRoleMenuMappingsArgs() *PermissionServiceGetOrphanRoleMenuMappingsArgs {
+	return &PermissionServiceGetOrphanRoleMenuMappingsArgs{}
+}
+
+func (p *PermissionServiceGetOrphanRoleMenuMappingsArgs) InitDefault() {
+}
+
+var fieldIDToName_PermissionServiceGetOrphanRoleMenuMappingsArgs = map[int16]string{}
+
+func (p *PermissionServiceGetOrphanRoleMenuMappingsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err = iprot.Skip(fieldTypeId); err != nil {
+			goto SkipFieldTypeError
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+SkipFieldTypeError:
+	return thrift.PrependError(fmt.Sprintf("%T skip field type %d error", p, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PermissionServiceGetOrphanRoleMenuMappingsArgs) Write(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteStructBegin("GetOrphanRoleMenuMappings_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PermissionServiceGetOrphanRoleMenuMappingsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PermissionServiceGetOrphanRoleMenuMappingsArgs(%+v)", *p)
+
+}
+
+type PermissionServiceGetOrphanRoleMenuMappingsResult struct {
+	Success *GetOrphanRoleMenuMappingsResponseDTO `thrift:"success,0,optional"`
+}
+
+func NewPermissionServiceGetOrphanRoleMenuMappingsResult() *PermissionServiceGetOrphanRoleMenuMappingsResult {
+	return &PermissionServiceGetOrphanRoleMenuMappingsResult{}
+}
+
+func (p *PermissionServiceGetOrphanRoleMenuMappingsResult) InitDefault() {
+}
+
+var PermissionServiceGetOrphanRoleMenuMappingsResult_Success_DEFAULT *GetOrphanRoleMenuMappingsResponseDTO
+
+func (p *PermissionServiceGetOrphanRoleMenuMappingsResult) GetSuccess() (v *GetOrphanRoleMenuMappingsResponseDTO) {
+	if !p.IsSetSuccess() {
+		return PermissionServiceGetOrphanRoleMenuMappingsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PermissionServiceGetOrphanRoleMenuMappingsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PermissionServiceGetOrphanRoleMenuMappingsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PermissionServiceGetOrphanRoleMenuMappingsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PermissionServiceGetOrphanRoleMenuMappingsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PermissionServiceGetOrphanRoleMenuMappingsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := NewGetOrphanRoleMenuMappingsResponseDTO()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PermissionServiceGetOrphanRoleMenuMappingsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetOrphanRoleMenuMappings_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PermissionServiceGetOrphanRoleMenuMappingsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PermissionServiceGetOrphanRoleMenuMappingsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PermissionServiceGetOrphanRoleMenuMappingsResult(%+v)", *p)
+
+}
+
 type PermissionServiceConfigureRoleMenusArgs struct {
 	Req *ConfigureRoleMenusRequestDTO `thrift:"req,1"`
 }
@@ -0,0 +1,34 @@
+// Code generated by hertz generator.
+
+package audit
+
+import (
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware"
+	casbinmw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/casbin_middleware"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _apiMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _v1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _auditMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listauditeventsMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermAuditRead)
+}
@@ -47,6 +47,10 @@ func Register(r *server.Hertz) {
 					_userid := _users.Group("/:userID", _useridMw()...)
 					_userid.GET("/memberships", append(_getusermembershipsMw(), identity.GetUserMemberships)...)
 					_userid.GET("/primary-membership", append(_getprimarymembershipMw(), identity.GetPrimaryMembership)...)
+					_userid.DELETE("/sessions", append(_revokeusersessionsMw(), identity.RevokeUserSessions)...)
+					_userid.GET("/sessions", append(_listusersessionsMw(), identity.ListUserSessions)...)
+					_sessions := _userid.Group("/sessions", _sessionsMw()...)
+					_sessions.DELETE("/:sessionID", append(_revokeusersessionMw(), identity.RevokeUserSession)...)
 					_userid.PUT("/status", append(_changeuserstatusMw(), identity.ChangeUserStatus)...)
 					_userid.PUT("/unlock", append(_unlockuserMw(), identity.UnlockUser)...)
 				}
@@ -66,6 +70,20 @@ func Register(r *server.Hertz) {
 					_password.PUT("/force-change", append(_forcepasswordchangeMw(), identity.ForcePasswordChange)...)
 					_password.POST("/reset", append(_resetpasswordMw(), identity.ResetPassword)...)
 					_auth.POST("/refresh", append(_refreshtokenMw(), identity.RefreshToken)...)
+					_auth.POST("/switch-organization", append(_switchorganizationMw(), identity.SwitchOrganization)...)
+					_auth.GET("/sessions", append(_listmysessionsMw(), identity.ListMySessions)...)
+					_sessions0 := _auth.Group("/sessions", _sessions0Mw()...)
+					_sessions0.POST("/revoke-others", append(_revokemyothersessionsMw(), identity.RevokeMyOtherSessions)...)
+					_sessions0.DELETE("/:sessionID", append(_revokemysessionMw(), identity.RevokeMySession)...)
+					{
+						_mfa := _auth.Group("/mfa", _mfaMw()...)
+						_mfa.POST("/disable", append(_disablemfaMw(), identity.DisableMFA)...)
+						_mfa.POST("/enroll", append(_enrollmfaMw(), identity.EnrollMFA)...)
+						_enroll := _mfa.Group("/enroll", _enrollMw()...)
+						_enroll.POST("/confirm", append(_confirmmfaenrollmentMw(), identity.ConfirmMFAEnrollment)...)
+						_mfa.POST("/recovery-codes", append(_regeneratemfarecoverycodesMw(), identity.RegenerateMFARecoveryCodes)...)
+						_mfa.POST("/verify", append(_verifymfaMw(), identity.VerifyMFA)...)
+					}
 				}
 				{
 					_organization_logos := _identity.Group("/organization-logos", _organization_logosMw()...)
@@ -4,6 +4,9 @@ package identity
 
 import (
 	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware"
+	casbinmw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/casbin_middleware"
 )
 
 func rootMw() []app.HandlerFunc {
@@ -32,23 +35,19 @@ func _departmentsMw() []app.HandlerFunc {
 }
 
 func _createdepartmentMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermDepartmentCreate)
 }
 
 func _deletedepartmentMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermDepartmentDelete)
 }
 
 func _getdepartmentMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermDepartmentRead)
 }
 
 func _updatedepartmentMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermDepartmentUpdate)
 }
 
 func _organizationsMw() []app.HandlerFunc {
@@ -57,8 +56,7 @@ func _organizationsMw() []app.HandlerFunc {
 }
 
 func _listorganizationsMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermOrganizationRead)
 }
 
 func _organizationidMw() []app.HandlerFunc {
@@ -67,13 +65,11 @@ func _organizationidMw() []app.HandlerFunc {
 }
 
 func _getorganizationdepartmentsMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermDepartmentRead)
 }
 
 func _bindlogotoorganizationMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermOrganizationUpdate)
 }
 
 func _organizations0Mw() []app.HandlerFunc {
@@ -82,23 +78,19 @@ func _organizations0Mw() []app.HandlerFunc {
 }
 
 func _createorganizationMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermOrganizationCreate)
 }
 
 func _deleteorganizationMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermOrganizationDelete)
 }
 
 func _getorganizationMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermOrganizationRead)
 }
 
 func _updateorganizationMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermOrganizationUpdate)
 }
 
 func _usersMw() []app.HandlerFunc {
@@ -107,13 +99,11 @@ func _usersMw() []app.HandlerFunc {
 }
 
 func _listusersMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermUserRead)
 }
 
 func _searchusersMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermUserRead)
 }
 
 func _useridMw() []app.HandlerFunc {
@@ -122,23 +112,19 @@ func _useridMw() []app.HandlerFunc {
 }
 
 func _getusermembershipsMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermMembershipRead)
 }
 
 func _getprimarymembershipMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermMembershipRead)
 }
 
 func _changeuserstatusMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermUserUpdateStatus)
 }
 
 func _unlockuserMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermUserUnlock)
 }
 
 func _users0Mw() []app.HandlerFunc {
@@ -147,8 +133,7 @@ func _users0Mw() []app.HandlerFunc {
 }
 
 func _createuserMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermUserCreate)
 }
 
 func _getmeMw() []app.HandlerFunc {
@@ -162,18 +147,15 @@ func _updatemeMw() []app.HandlerFunc {
 }
 
 func _deleteuserMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermUserDelete)
 }
 
 func _getuserMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermUserRead)
 }
 
 func _updateuserMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermUserUpdate)
 }
 
 func _authMw() []app.HandlerFunc {
@@ -202,13 +184,11 @@ func _changepasswordMw() []app.HandlerFunc {
 }
 
 func _forcepasswordchangeMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermUserForcePwd)
 }
 
 func _resetpasswordMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermUserResetPwd)
 }
 
 func _refreshtokenMw() []app.HandlerFunc {
@@ -222,16 +202,90 @@ func _organization_logosMw() []app.HandlerFunc {
 }
 
 func _deleteorganizationlogoMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermLogoDelete)
+}
+
+func _getorganizationlogoMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermLogoRead)
+}
+
+func _uploadtemporarylogoMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermLogoUpload)
+}
+
+func _revokeusersessionsMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermSessionRevoke)
+}
+
+func _sessionsMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
 
-func _getorganizationlogoMw() []app.HandlerFunc {
+func _listusersessionsMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermSessionRead)
+}
+
+func _revokeusersessionMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermSessionRevoke)
+}
+
+func _switchorganizationMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
 
-func _uploadtemporarylogoMw() []app.HandlerFunc {
+func _sessions0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listmysessionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokemyothersessionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokemysessionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _mfaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _disablemfaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _enrollMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _enrollmfaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _confirmmfaenrollmentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _regeneratemfarecoverycodesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _verifymfaMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
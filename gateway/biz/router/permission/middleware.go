@@ -4,6 +4,9 @@ package permission
 
 import (
 	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware"
+	casbinmw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/casbin_middleware"
 )
 
 func rootMw() []app.HandlerFunc {
@@ -26,14 +29,17 @@ func _permissionMw() []app.HandlerFunc {
 	return nil
 }
 
+func _getpermissioncatalogMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermRoleRead)
+}
+
 func _rolesMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
 
 func _listroledefinitionsMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermRoleRead)
 }
 
 func _roleidMw() []app.HandlerFunc {
@@ -42,23 +48,19 @@ func _roleidMw() []app.HandlerFunc {
 }
 
 func _hasmenupermissionMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermRoleRead)
 }
 
 func _getrolemenupermissionsMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermRoleRead)
 }
 
 func _getrolemenutreeMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermRoleRead)
 }
 
 func _configurerolemenusMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermRoleConfigureMenu)
 }
 
 func _usersMw() []app.HandlerFunc {
@@ -67,13 +69,11 @@ func _usersMw() []app.HandlerFunc {
 }
 
 func _getusersbyroleMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermRoleAssignmentRead)
 }
 
 func _batchbinduserstoroleMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermRoleAssignmentBind)
 }
 
 func _roles0Mw() []app.HandlerFunc {
@@ -82,28 +82,23 @@ func _roles0Mw() []app.HandlerFunc {
 }
 
 func _createroledefinitionMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermRoleCreate)
 }
 
 func _updateroledefinitionMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermRoleUpdate)
 }
 
 func _deleteroledefinitionMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermRoleDelete)
 }
 
 func _getroledefinitionMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermRoleRead)
 }
 
 func _listuserroleassignmentsMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermRoleAssignmentRead)
 }
 
 func _menuMw() []app.HandlerFunc {
@@ -112,15 +107,39 @@ func _menuMw() []app.HandlerFunc {
 }
 
 func _getmenutreeMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermMenuRead)
+}
+
+func _uploadmenuMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermMenuUpload)
+}
+
+func _getorphanrolemenumappingsMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermMenuRead)
+}
+
+func _listmenuversionsMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermMenuRead)
+}
+
+func _versionsMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
 
-func _uploadmenuMw() []app.HandlerFunc {
+func _diffmenuversionsMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermMenuRead)
+}
+
+func _versionMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
 
+func _activatemenuversionMw() []app.HandlerFunc {
+	return middleware.RequiresPermissions(casbinmw.PermMenuUpload)
+}
+
 func _users0Mw() []app.HandlerFunc {
 	// your code...
 	return nil
@@ -132,8 +151,7 @@ func _useridMw() []app.HandlerFunc {
 }
 
 func _getusermenutreeMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermMenuRead)
 }
 
 func _roles1Mw() []app.HandlerFunc {
@@ -142,6 +160,5 @@ func _roles1Mw() []app.HandlerFunc {
 }
 
 func _getlastuserroleassignmentMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return middleware.RequiresPermissions(casbinmw.PermRoleAssignmentRead)
 }
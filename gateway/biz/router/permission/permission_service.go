@@ -23,6 +23,7 @@ func Register(r *server.Hertz) {
 			_v1 := _api.Group("/v1", _v1Mw()...)
 			{
 				_permission := _v1.Group("/permission", _permissionMw()...)
+				_permission.GET("/catalog", append(_getpermissioncatalogMw(), permission.GetPermissionCatalog)...)
 				_permission.GET("/roles", append(_listroledefinitionsMw(), permission.ListRoleDefinitions)...)
 				_roles := _permission.Group("/roles", _rolesMw()...)
 				{
@@ -43,8 +44,16 @@ func Register(r *server.Hertz) {
 				_permission.GET("/user-roles", append(_listuserroleassignmentsMw(), permission.ListUserRoleAssignments)...)
 				{
 					_menu := _permission.Group("/menu", _menuMw()...)
+					_menu.GET("/orphan-mappings", append(_getorphanrolemenumappingsMw(), permission.GetOrphanRoleMenuMappings)...)
 					_menu.GET("/tree", append(_getmenutreeMw(), permission.GetMenuTree)...)
 					_menu.POST("/upload", append(_uploadmenuMw(), permission.UploadMenu)...)
+					_menu.GET("/versions", append(_listmenuversionsMw(), permission.ListMenuVersions)...)
+					_versions := _menu.Group("/versions", _versionsMw()...)
+					_versions.GET("/diff", append(_diffmenuversionsMw(), permission.DiffMenuVersions)...)
+					{
+						_version := _versions.Group("/:version", _versionMw()...)
+						_version.POST("/activate", append(_activatemenuversionMw(), permission.ActivateMenuVersion)...)
+					}
 				}
 				{
 					_users0 := _permission.Group("/users", _users0Mw()...)
@@ -4,6 +4,7 @@ package router
 
 import (
 	"github.com/cloudwego/hertz/pkg/app/server"
+	audit "github.com/masonsxu/cloudwego-scaffold/gateway/biz/router/audit"
 	identity "github.com/masonsxu/cloudwego-scaffold/gateway/biz/router/identity"
 	permission "github.com/masonsxu/cloudwego-scaffold/gateway/biz/router/permission"
 )
@@ -11,6 +12,8 @@ import (
 // GeneratedRegister registers routers generated by IDL.
 func GeneratedRegister(r *server.Hertz) {
 	//INSERT_POINT: DO NOT DELETE THIS LINE!
+	audit.Register(r)
+
 	permission.Register(r)
 
 	identity.Register(r)
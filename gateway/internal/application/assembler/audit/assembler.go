@@ -0,0 +1,89 @@
+// Package audit 审计日志协议转换
+package audit
+
+import (
+	auditModel "github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/audit"
+	identityassembler "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/identity"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// Assembler 审计日志组装器接口 - 统一暴露给Service层
+type Assembler interface {
+	ToRPCListAuditEventsRequest(*auditModel.ListAuditEventsRequestDTO) *identity_srv.ListAuditEventsRequest
+	ToHTTPListAuditEventsResponse(*identity_srv.ListAuditEventsResponse) *auditModel.ListAuditEventsResponseDTO
+	ToHTTPAuditEvent(*identity_srv.AuditEvent) *auditModel.AuditEventDTO
+}
+
+// auditAssembler 审计日志组装器实现
+type auditAssembler struct{}
+
+// NewAuditAssembler 创建审计日志组装器
+func NewAuditAssembler() Assembler {
+	return &auditAssembler{}
+}
+
+// ToRPCListAuditEventsRequest 将审计事件查询请求转换为 RPC 请求
+func (a *auditAssembler) ToRPCListAuditEventsRequest(
+	dto *auditModel.ListAuditEventsRequestDTO,
+) *identity_srv.ListAuditEventsRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.ListAuditEventsRequest{
+		ActorID:    dto.ActorID,
+		Action:     dto.Action,
+		TargetType: dto.TargetType,
+		TargetID:   dto.TargetID,
+		Result_:    dto.Result,
+		RequestID:  dto.RequestID,
+		StartTime:  dto.StartTime,
+		EndTime:    dto.EndTime,
+		Page:       identityassembler.ToRPCPageRequest(dto.Page),
+	}
+}
+
+// ToHTTPListAuditEventsResponse 将审计事件查询结果转换为 HTTP 响应
+func (a *auditAssembler) ToHTTPListAuditEventsResponse(
+	rpc *identity_srv.ListAuditEventsResponse,
+) *auditModel.ListAuditEventsResponseDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	events := make([]*auditModel.AuditEventDTO, 0, len(rpc.Events))
+	for _, event := range rpc.Events {
+		events = append(events, a.ToHTTPAuditEvent(event))
+	}
+
+	return &auditModel.ListAuditEventsResponseDTO{
+		Events: events,
+		Page:   identityassembler.ToHTTPPageResponse(rpc.Page),
+	}
+}
+
+// ToHTTPAuditEvent 将审计事件转换为 HTTP DTO
+func (a *auditAssembler) ToHTTPAuditEvent(rpc *identity_srv.AuditEvent) *auditModel.AuditEventDTO {
+	if rpc == nil {
+		return nil
+	}
+
+	return &auditModel.AuditEventDTO{
+		ID:                  rpc.Id,
+		ActorID:             rpc.ActorID,
+		ActorOrganizationID: rpc.ActorOrganizationID,
+		Action:              rpc.Action,
+		TargetType:          rpc.TargetType,
+		TargetID:            rpc.TargetID,
+		Before:              rpc.Before,
+		After:               rpc.After,
+		ChangedFields:       rpc.ChangedFields,
+		RequestID:           rpc.RequestID,
+		TraceID:             rpc.TraceID,
+		IpAddress:           rpc.IpAddress,
+		Result:              rpc.Result_,
+		ErrorCode:           rpc.ErrorCode,
+		ErrorMessage:        rpc.ErrorMessage,
+		CreatedAt:           rpc.CreatedAt,
+	}
+}
@@ -22,8 +22,9 @@ func (a *authAssembler) ToRPCLoginRequest(
 	}
 
 	return &identity_srv.LoginRequest{
-		Username: dto.Username,
-		Password: dto.Password,
+		Username:       dto.Username,
+		Password:       dto.Password,
+		OrganizationID: dto.OrganizationID,
 	}
 }
 
@@ -36,7 +37,10 @@ func (a *authAssembler) ToHTTPLoginResponse(
 	}
 
 	return &identity.LoginResponseDTO{
-		UserProfile: NewUserAssembler().ToHTTPUserProfile(rpc.UserProfile),
+		UserProfile:           NewUserAssembler().ToHTTPUserProfile(rpc.UserProfile),
+		MfaRequired:           rpc.MfaRequired,
+		MfaEnrollmentRequired: rpc.MfaEnrollmentRequired,
+		ActiveOrganizationID:  rpc.ActiveOrganizationID,
 		// PermissionInfo 将由上层服务设置
 		// TokenInfo 将由上层服务设置
 	}
@@ -44,6 +44,7 @@ func (a *userAssembler) ToHTTPUserProfile(
 		MustChangePassword: &rpc.MustChangePassword,
 		AccountExpiry:      common.CopyInt64Ptr(rpc.AccountExpiry),
 		LoginAttempts:      common.CopyInt32Ptr(&rpc.LoginAttempts),
+		MfaEnabled:         &rpc.MfaEnabled,
 
 		// 审计字段
 		CreatedAt:     common.CopyInt64Ptr(rpc.CreatedAt),
@@ -233,6 +234,8 @@ func (a *userAssembler) ToRPCListUsersRequest(
 		Page:           ToRPCPageRequest(dto.Page),
 		OrganizationID: dto.OrganizationID,
 		Status:         common.ConvertIdentityUserStatusPtrToRPCPtr(dto.Status),
+
+		AccountExpiresBefore: dto.AccountExpiresBefore,
 	}
 }
 
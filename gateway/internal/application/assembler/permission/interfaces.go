@@ -29,7 +29,13 @@ type IMenuAssembler interface {
 	ToRPCMenuNode(*permissionModel.MenuNodeDTO) *identity_srv.MenuNode
 	ToRPCMenuNodes([]*permissionModel.MenuNodeDTO) []*identity_srv.MenuNode
 
-	ToRPCUploadMenuRequest(*permissionModel.UploadMenuRequestDTO) *identity_srv.UploadMenuRequest
+	ToRPCUploadMenuRequest(
+		operatorID string,
+		dto *permissionModel.UploadMenuRequestDTO,
+	) *identity_srv.UploadMenuRequest
+	ToHTTPUploadMenuResponse(
+		*identity_srv.UploadMenuResponse,
+	) *permissionModel.UploadMenuResponseDTO
 
 	ToHTTPGetMenuTreeResponse(
 		*identity_srv.GetMenuTreeResponse,
@@ -86,6 +92,28 @@ type IMenuAssembler interface {
 	ToHTTPHasMenuPermissionResponse(
 		*identity_srv.HasMenuPermissionResponse,
 	) *permissionModel.HasMenuPermissionResponseDTO
+
+	// 菜单版本管理转换
+	ToHTTPMenuVersion(*identity_srv.MenuVersionInfo) *permissionModel.MenuVersionDTO
+	ToHTTPListMenuVersionsResponse(
+		*identity_srv.ListMenuVersionsResponse,
+	) *permissionModel.ListMenuVersionsResponseDTO
+	ToRPCDiffMenuVersionsRequest(
+		req *permissionModel.DiffMenuVersionsRequestDTO,
+	) *identity_srv.DiffMenuVersionsRequest
+	ToHTTPDiffMenuVersionsResponse(
+		*identity_srv.DiffMenuVersionsResponse,
+	) *permissionModel.DiffMenuVersionsResponseDTO
+	ToRPCActivateMenuVersionRequest(
+		operatorID string,
+		req *permissionModel.ActivateMenuVersionRequestDTO,
+	) *identity_srv.ActivateMenuVersionRequest
+	ToHTTPActivateMenuVersionResponse(
+		*identity_srv.ActivateMenuVersionResponse,
+	) *permissionModel.ActivateMenuVersionResponseDTO
+	ToHTTPGetOrphanRoleMenuMappingsResponse(
+		*identity_srv.GetOrphanRoleMenuMappingsResponse,
+	) *permissionModel.GetOrphanRoleMenuMappingsResponseDTO
 }
 
 // IPermissionAssembler 权限相关组装器接口
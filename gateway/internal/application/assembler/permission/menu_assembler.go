@@ -107,6 +107,7 @@ func (a *menuAssembler) ToRPCMenuNodes(
 
 // ToRPCUploadMenuRequest converts UploadMenuRequestDTO to RPC UploadMenuRequest.
 func (a *menuAssembler) ToRPCUploadMenuRequest(
+	operatorID string,
 	dto *permissionModel.UploadMenuRequestDTO,
 ) *identity_srv.UploadMenuRequest {
 	if dto == nil {
@@ -119,9 +120,52 @@ func (a *menuAssembler) ToRPCUploadMenuRequest(
 		yamlContent = string(dto.MenuFile)
 	}
 
-	return &identity_srv.UploadMenuRequest{
+	rpcReq := &identity_srv.UploadMenuRequest{
 		YamlContent: &yamlContent,
 	}
+
+	if operatorID != "" {
+		rpcReq.OperatorID = &operatorID
+	}
+
+	return rpcReq
+}
+
+// ToHTTPUploadMenuResponse converts RPC UploadMenuResponse to HTTP UploadMenuResponseDTO.
+func (a *menuAssembler) ToHTTPUploadMenuResponse(
+	rpcResp *identity_srv.UploadMenuResponse,
+) *permissionModel.UploadMenuResponseDTO {
+	if rpcResp == nil {
+		return nil
+	}
+
+	httpResp := &permissionModel.UploadMenuResponseDTO{
+		DryRun:     common.CopyBoolPtr(rpcResp.DryRun),
+		Valid:      common.CopyBoolPtr(rpcResp.Valid),
+		Issues:     make([]*permissionModel.MenuValidationIssueDTO, 0, len(rpcResp.Issues)),
+		AccessLoss: a.toHTTPOrphanRoleMenuMappings(rpcResp.AccessLoss),
+		Applied:    common.CopyBoolPtr(rpcResp.Applied),
+		Version:    common.CopyStringPtr(rpcResp.Version),
+	}
+
+	if rpcResp.Diff != nil {
+		httpResp.Diff = a.ToHTTPDiffMenuVersionsResponse(rpcResp.Diff)
+	}
+
+	for _, issue := range rpcResp.Issues {
+		if issue == nil {
+			continue
+		}
+
+		httpResp.Issues = append(httpResp.Issues, &permissionModel.MenuValidationIssueDTO{
+			SemanticID: common.CopyStringPtr(issue.SemanticID),
+			Field:      common.CopyStringPtr(issue.Field),
+			Line:       common.CopyInt32Ptr(issue.Line),
+			Message:    common.CopyStringPtr(issue.Message),
+		})
+	}
+
+	return httpResp
 }
 
 // ToHTTPGetMenuTreeResponse converts RPC GetMenuTreeResponse to HTTP GetMenuTreeResponseDTO.
@@ -391,3 +435,174 @@ func (a *menuAssembler) ToHTTPHasMenuPermissionResponse(
 
 	return httpResp
 }
+
+// =================================================================
+// 菜单版本管理相关转换方法
+// =================================================================
+
+// ToHTTPMenuVersion converts RPC MenuVersionInfo to HTTP MenuVersionDTO.
+func (a *menuAssembler) ToHTTPMenuVersion(
+	rpcVersion *identity_srv.MenuVersionInfo,
+) *permissionModel.MenuVersionDTO {
+	if rpcVersion == nil {
+		return nil
+	}
+
+	return &permissionModel.MenuVersionDTO{
+		Version:     common.CopyStringPtr(rpcVersion.Version),
+		MenuCount:   common.CopyInt64Ptr(rpcVersion.MenuCount),
+		UploadedAt:  common.CopyInt64Ptr(rpcVersion.UploadedAt),
+		UploadedBy:  common.CopyStringPtr(rpcVersion.UploadedBy),
+		IsActive:    common.CopyBoolPtr(rpcVersion.IsActive),
+		ActivatedAt: common.CopyInt64Ptr(rpcVersion.ActivatedAt),
+		ActivatedBy: common.CopyStringPtr(rpcVersion.ActivatedBy),
+	}
+}
+
+// ToHTTPListMenuVersionsResponse converts RPC ListMenuVersionsResponse to HTTP response.
+func (a *menuAssembler) ToHTTPListMenuVersionsResponse(
+	rpcResp *identity_srv.ListMenuVersionsResponse,
+) *permissionModel.ListMenuVersionsResponseDTO {
+	if rpcResp == nil {
+		return nil
+	}
+
+	httpResp := &permissionModel.ListMenuVersionsResponseDTO{
+		Versions: make([]*permissionModel.MenuVersionDTO, 0, len(rpcResp.Versions)),
+	}
+
+	for _, rpcVersion := range rpcResp.Versions {
+		if httpVersion := a.ToHTTPMenuVersion(rpcVersion); httpVersion != nil {
+			httpResp.Versions = append(httpResp.Versions, httpVersion)
+		}
+	}
+
+	return httpResp
+}
+
+// ToRPCDiffMenuVersionsRequest converts HTTP request to RPC DiffMenuVersionsRequest.
+func (a *menuAssembler) ToRPCDiffMenuVersionsRequest(
+	dto *permissionModel.DiffMenuVersionsRequestDTO,
+) *identity_srv.DiffMenuVersionsRequest {
+	if dto == nil {
+		return nil
+	}
+
+	return &identity_srv.DiffMenuVersionsRequest{
+		FromVersion: common.CopyStringPtr(dto.FromVersion),
+		ToVersion:   common.CopyStringPtr(dto.ToVersion),
+	}
+}
+
+// ToHTTPDiffMenuVersionsResponse converts RPC DiffMenuVersionsResponse to HTTP response.
+func (a *menuAssembler) ToHTTPDiffMenuVersionsResponse(
+	rpcResp *identity_srv.DiffMenuVersionsResponse,
+) *permissionModel.DiffMenuVersionsResponseDTO {
+	if rpcResp == nil {
+		return nil
+	}
+
+	return &permissionModel.DiffMenuVersionsResponseDTO{
+		FromVersion: common.CopyStringPtr(rpcResp.FromVersion),
+		ToVersion:   common.CopyStringPtr(rpcResp.ToVersion),
+		Added:       a.toHTTPMenuVersionChanges(rpcResp.Added),
+		Removed:     a.toHTTPMenuVersionChanges(rpcResp.Removed),
+		Moved:       a.toHTTPMenuVersionChanges(rpcResp.Moved),
+		Renamed:     a.toHTTPMenuVersionChanges(rpcResp.Renamed),
+	}
+}
+
+// toHTTPMenuVersionChanges converts RPC MenuVersionChange list to HTTP DTO list.
+func (a *menuAssembler) toHTTPMenuVersionChanges(
+	rpcChanges []*identity_srv.MenuVersionChange,
+) []*permissionModel.MenuVersionChangeDTO {
+	result := make([]*permissionModel.MenuVersionChangeDTO, 0, len(rpcChanges))
+
+	for _, change := range rpcChanges {
+		if change == nil {
+			continue
+		}
+
+		result = append(result, &permissionModel.MenuVersionChangeDTO{
+			SemanticID:               common.CopyStringPtr(change.SemanticID),
+			Name:                     common.CopyStringPtr(change.Name),
+			PreviousName:             common.CopyStringPtr(change.PreviousName),
+			Path:                     common.CopyStringPtr(change.Path),
+			PreviousPath:             common.CopyStringPtr(change.PreviousPath),
+			ParentSemanticID:         common.CopyStringPtr(change.ParentSemanticID),
+			PreviousParentSemanticID: common.CopyStringPtr(change.PreviousParentSemanticID),
+		})
+	}
+
+	return result
+}
+
+// ToRPCActivateMenuVersionRequest converts HTTP request to RPC ActivateMenuVersionRequest.
+func (a *menuAssembler) ToRPCActivateMenuVersionRequest(
+	operatorID string,
+	dto *permissionModel.ActivateMenuVersionRequestDTO,
+) *identity_srv.ActivateMenuVersionRequest {
+	if dto == nil {
+		return nil
+	}
+
+	rpcReq := &identity_srv.ActivateMenuVersionRequest{
+		Version: common.CopyStringPtr(dto.Version),
+	}
+
+	if operatorID != "" {
+		rpcReq.OperatorID = &operatorID
+	}
+
+	return rpcReq
+}
+
+// ToHTTPActivateMenuVersionResponse converts RPC ActivateMenuVersionResponse to HTTP response.
+func (a *menuAssembler) ToHTTPActivateMenuVersionResponse(
+	rpcResp *identity_srv.ActivateMenuVersionResponse,
+) *permissionModel.ActivateMenuVersionResponseDTO {
+	if rpcResp == nil {
+		return nil
+	}
+
+	return &permissionModel.ActivateMenuVersionResponseDTO{
+		Version:        a.ToHTTPMenuVersion(rpcResp.Version),
+		OrphanMappings: a.toHTTPOrphanRoleMenuMappings(rpcResp.OrphanMappings),
+	}
+}
+
+// ToHTTPGetOrphanRoleMenuMappingsResponse converts RPC GetOrphanRoleMenuMappingsResponse to HTTP response.
+func (a *menuAssembler) ToHTTPGetOrphanRoleMenuMappingsResponse(
+	rpcResp *identity_srv.GetOrphanRoleMenuMappingsResponse,
+) *permissionModel.GetOrphanRoleMenuMappingsResponseDTO {
+	if rpcResp == nil {
+		return nil
+	}
+
+	return &permissionModel.GetOrphanRoleMenuMappingsResponseDTO{
+		Version:  common.CopyStringPtr(rpcResp.Version),
+		Mappings: a.toHTTPOrphanRoleMenuMappings(rpcResp.Mappings),
+	}
+}
+
+// toHTTPOrphanRoleMenuMappings converts RPC OrphanRoleMenuMapping list to HTTP DTO list.
+func (a *menuAssembler) toHTTPOrphanRoleMenuMappings(
+	rpcMappings []*identity_srv.OrphanRoleMenuMapping,
+) []*permissionModel.OrphanRoleMenuMappingDTO {
+	result := make([]*permissionModel.OrphanRoleMenuMappingDTO, 0, len(rpcMappings))
+
+	for _, mapping := range rpcMappings {
+		if mapping == nil {
+			continue
+		}
+
+		result = append(result, &permissionModel.OrphanRoleMenuMappingDTO{
+			RoleID:     common.CopyStringPtr(mapping.RoleID),
+			RoleName:   common.CopyStringPtr(mapping.RoleName),
+			MenuID:     common.CopyStringPtr(mapping.MenuID),
+			Permission: common.CopyStringPtr(mapping.Permission),
+		})
+	}
+
+	return result
+}
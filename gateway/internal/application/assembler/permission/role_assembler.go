@@ -33,11 +33,15 @@ func (a *roleAssembler) ToHTTPRoleDefinition(
 		Status:       common.ConvertRoleStatusPtrToHTTPPtr(rpc.Status),
 		Permissions:  a.permissionAssembler.ToHTTPPermissions(rpc.Permissions),
 		IsSystemRole: common.CopyBoolPtr(&rpc.IsSystemRole),
+		MfaRequired:  common.CopyBoolPtr(&rpc.MfaRequired),
 		CreatedBy:    common.CopyStringPtr(rpc.CreatedBy),
 		UpdatedBy:    common.CopyStringPtr(rpc.UpdatedBy),
 		CreatedAt:    common.CopyInt64Ptr(rpc.CreatedAt),
 		UpdatedAt:    common.CopyInt64Ptr(rpc.UpdatedAt),
 		UserCount:    common.CopyInt64Ptr(rpc.UserCount), // 新增：用户数量
+		ParentRoleID: common.CopyStringPtr(rpc.ParentRoleID),
+
+		EffectivePermissions: a.permissionAssembler.ToHTTPPermissions(rpc.EffectivePermissions),
 	}
 }
 
@@ -75,6 +79,7 @@ func (a *roleAssembler) ToRPCRoleDefinition(
 		UpdatedBy:    http.UpdatedBy,
 		CreatedAt:    http.CreatedAt,
 		UpdatedAt:    http.UpdatedAt,
+		ParentRoleID: http.ParentRoleID,
 	}
 }
 
@@ -91,6 +96,7 @@ func (a *roleAssembler) ToRPCRoleDefinitionCreateRequest(
 		Description:  http.Description,
 		Permissions:  a.permissionAssembler.ToRPCPermissions(http.Permissions),
 		IsSystemRole: false, // 默认值
+		ParentRoleID: http.ParentRoleID,
 	}
 
 	// 使用 ApplyIfSet 处理可选的 IsSystemRole 字段
@@ -98,6 +104,10 @@ func (a *roleAssembler) ToRPCRoleDefinitionCreateRequest(
 		req.IsSystemRole = *v
 	})
 
+	common.ApplyIfSet(http.IsSetMfaRequired, http.MfaRequired, func(v *bool) {
+		req.MfaRequired = *v
+	})
+
 	return req
 }
 
@@ -124,6 +134,8 @@ func (a *roleAssembler) ToRPCRoleDefinitionUpdateRequest(
 		Status:           common.ConvertRoleStatusPtrToRPCPtr(http.Status),
 		Permissions:      a.permissionAssembler.ToRPCPermissions(http.Permissions),
 		Name:             http.Name, // 支持更新角色名称
+		MfaRequired:      http.MfaRequired,
+		ParentRoleID:     http.ParentRoleID,
 	}
 }
 
@@ -30,6 +30,11 @@ func (a *userRoleAssembler) ToHTTPUserRoleAssignment(
 		UpdatedBy: common.CopyStringPtr(rpc.UpdatedBy),
 		CreatedAt: common.CopyInt64Ptr(rpc.CreatedAt),
 		UpdatedAt: common.CopyInt64Ptr(rpc.UpdatedAt),
+
+		EffectiveFrom:  common.CopyInt64Ptr(rpc.EffectiveFrom),
+		ExpiresAt:      common.CopyInt64Ptr(rpc.ExpiresAt),
+		Active:         rpc.Active,
+		OrganizationID: common.CopyStringPtr(rpc.OrganizationID),
 	}
 }
 
@@ -65,6 +70,11 @@ func (a *userRoleAssembler) ToRPCUserRoleAssignment(
 		UpdatedBy: http.UpdatedBy,
 		CreatedAt: http.CreatedAt,
 		UpdatedAt: http.UpdatedAt,
+
+		EffectiveFrom:  http.EffectiveFrom,
+		ExpiresAt:      http.ExpiresAt,
+		Active:         http.Active,
+		OrganizationID: http.OrganizationID,
 	}
 }
 
@@ -99,9 +109,11 @@ func (a *userRoleAssembler) ToRPCUserRoleQueryRequest(
 	}
 
 	return &identity_srv.UserRoleQueryRequest{
-		UserID: http.UserID,
-		RoleID: http.RoleID,
-		Page:   ToRPCPageRequest(http.Page),
+		UserID:         http.UserID,
+		RoleID:         http.RoleID,
+		Page:           ToRPCPageRequest(http.Page),
+		ExpiringBefore: http.ExpiringBefore,
+		OrganizationID: http.OrganizationID,
 	}
 }
 
@@ -156,9 +168,12 @@ func (a *userRoleAssembler) ToRPCBatchBindUsersToRoleRequest(
 	}
 
 	return &identity_srv.BatchBindUsersToRoleRequest{
-		RoleID:     http.RoleID,
-		UserIDs:    http.UserIDs,
-		OperatorID: &operatorID,
+		RoleID:         http.RoleID,
+		UserIDs:        http.UserIDs,
+		OperatorID:     &operatorID,
+		EffectiveFrom:  http.EffectiveFrom,
+		ExpiresAt:      http.ExpiresAt,
+		OrganizationID: http.OrganizationID,
 	}
 }
 
@@ -119,6 +119,15 @@ func (ac *AuthContext) GetPermission() (string, bool) {
 	return *ac.claims.Permission, true
 }
 
+// GetSessionID 获取登录会话ID
+func (ac *AuthContext) GetSessionID() (string, bool) {
+	if ac == nil || ac.claims == nil || ac.claims.SessionID == nil {
+		return "", false
+	}
+
+	return *ac.claims.SessionID, true
+}
+
 // 便利函数：直接从 RequestContext 获取认证信息
 
 // GetCurrentUserProfileID 直接从请求上下文获取当前用户ID
@@ -174,3 +183,12 @@ func GetCurrentPermission(c *app.RequestContext) (string, bool) {
 
 	return "", false
 }
+
+// GetCurrentSessionID 直接从请求上下文获取当前登录会话ID
+func GetCurrentSessionID(c *app.RequestContext) (string, bool) {
+	if authCtx, exists := GetAuthContext(c); exists {
+		return authCtx.GetSessionID()
+	}
+
+	return "", false
+}
@@ -0,0 +1,32 @@
+package auth_context
+
+import (
+	"context"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+)
+
+// RPC 元信息中的调用方键名，需与下游服务保持一致
+const (
+	// CallerIDMetaKey 调用方用户ID
+	CallerIDMetaKey = "user_id"
+
+	// CallerOrganizationIDMetaKey 调用方当前代表的组织ID
+	CallerOrganizationIDMetaKey = "organization_id"
+)
+
+// InjectCallerToContext 将当前用户ID及其代表的组织ID写入 RPC 元信息
+// 使用 metainfo.WithPersistentValue 通过 TTHeader 传递到 RPC 服务，供其解析数据范围；组织ID为空时不写入
+func InjectCallerToContext(ctx context.Context, userID, organizationID string) context.Context {
+	if userID == "" {
+		return ctx
+	}
+
+	ctx = metainfo.WithPersistentValue(ctx, CallerIDMetaKey, userID)
+
+	if organizationID != "" {
+		ctx = metainfo.WithPersistentValue(ctx, CallerOrganizationIDMetaKey, organizationID)
+	}
+
+	return ctx
+}
@@ -0,0 +1,129 @@
+package casbin_middleware
+
+import (
+	"sync"
+	"time"
+
+	permissionService "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/permission"
+)
+
+// decisionEntry 权限判定缓存条目
+type decisionEntry struct {
+	userID    string
+	allowed   bool
+	expiresAt time.Time
+}
+
+// decisionCache 权限判定结果的本地 TTL 缓存
+// 避免同一用户的每个请求都调用 identity_srv；角色或策略变更时由失效通知按用户清除，
+// 未收到通知的副本最迟在 TTL 后生效
+type decisionCache struct {
+	ttl     time.Duration
+	entries sync.Map
+}
+
+// newDecisionCache 创建权限判定缓存
+func newDecisionCache(ttl time.Duration) *decisionCache {
+	return &decisionCache{ttl: ttl}
+}
+
+// get 获取未过期的判定结果
+func (dc *decisionCache) get(key string) (bool, bool) {
+	value, ok := dc.entries.Load(key)
+	if !ok {
+		return false, false
+	}
+
+	entry := value.(decisionEntry)
+	if time.Now().After(entry.expiresAt) {
+		dc.entries.Delete(key)
+		return false, false
+	}
+
+	return entry.allowed, true
+}
+
+// set 写入判定结果
+func (dc *decisionCache) set(key, userID string, allowed bool) {
+	dc.entries.Store(key, decisionEntry{
+		userID:    userID,
+		allowed:   allowed,
+		expiresAt: time.Now().Add(dc.ttl),
+	})
+}
+
+// deleteUser 清除指定用户的全部判定结果
+func (dc *decisionCache) deleteUser(userID string) {
+	dc.entries.Range(func(key, value any) bool {
+		if value.(decisionEntry).userID == userID {
+			dc.entries.Delete(key)
+		}
+
+		return true
+	})
+}
+
+// clear 清除全部判定结果
+func (dc *decisionCache) clear() {
+	dc.entries.Clear()
+}
+
+// permissionSetEntry 用户生效权限集合缓存条目
+type permissionSetEntry struct {
+	userID      string
+	permissions *permissionService.EffectivePermissions
+	expiresAt   time.Time
+}
+
+// permissionSetCache 用户生效权限集合的本地 TTL 缓存
+// 每个主体只需一次批量查询即可在本地完成其所有接口权限判定
+type permissionSetCache struct {
+	ttl     time.Duration
+	entries sync.Map
+}
+
+// newPermissionSetCache 创建用户生效权限集合缓存
+func newPermissionSetCache(ttl time.Duration) *permissionSetCache {
+	return &permissionSetCache{ttl: ttl}
+}
+
+// get 获取未过期的权限集合
+func (pc *permissionSetCache) get(key string) (*permissionService.EffectivePermissions, bool) {
+	value, ok := pc.entries.Load(key)
+	if !ok {
+		return nil, false
+	}
+
+	entry := value.(permissionSetEntry)
+	if time.Now().After(entry.expiresAt) {
+		pc.entries.Delete(key)
+		return nil, false
+	}
+
+	return entry.permissions, true
+}
+
+// set 写入权限集合
+func (pc *permissionSetCache) set(key, userID string, permissions *permissionService.EffectivePermissions) {
+	pc.entries.Store(key, permissionSetEntry{
+		userID:      userID,
+		permissions: permissions,
+		expiresAt:   time.Now().Add(pc.ttl),
+	})
+}
+
+// deleteUser 清除指定用户在各个域下的权限集合
+func (pc *permissionSetCache) deleteUser(userID string) {
+	pc.entries.Range(func(key, value any) bool {
+		if value.(permissionSetEntry).userID == userID {
+			pc.entries.Delete(key)
+		}
+
+		return true
+	})
+}
+
+// clear 清除全部权限集合
+func (pc *permissionSetCache) clear() {
+	pc.entries.Clear()
+}
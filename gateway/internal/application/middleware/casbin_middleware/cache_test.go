@@ -0,0 +1,118 @@
+package casbin_middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	permissionService "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingAuthzService 按用户返回预置权限集合并记录 identity_srv 调用次数，err 非空时模拟服务不可用
+type countingAuthzService struct {
+	permissions map[string]map[string]struct{}
+	calls       map[string]int
+	err         error
+}
+
+func (s *countingAuthzService) CheckPermission(context.Context, string, string, string, string) (bool, error) {
+	return false, nil
+}
+
+func (s *countingAuthzService) CheckRole(_ context.Context, userID, _ string, _ []string) (bool, error) {
+	s.calls[userID]++
+	return true, nil
+}
+
+func (s *countingAuthzService) GetUserEffectivePermissions(
+	_ context.Context,
+	userID, _ string,
+) (*permissionService.EffectivePermissions, error) {
+	s.calls[userID]++
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return &permissionService.EffectivePermissions{Permissions: s.permissions[userID]}, nil
+}
+
+func newCachedMiddleware(t *testing.T) (*casbinMiddleware, *countingAuthzService) {
+	t.Helper()
+
+	authz := &countingAuthzService{
+		permissions: map[string]map[string]struct{}{
+			"alice": {"user:read": {}},
+			"bob":   {"user:read": {}},
+		},
+		calls: make(map[string]int),
+	}
+
+	config := DefaultPermissionConfig()
+	config.CacheTimeout = time.Minute
+
+	m, err := NewCasbinMiddleware(authz, hertzZerolog.New(), config)
+	require.NoError(t, err)
+
+	return m.(*casbinMiddleware), authz
+}
+
+func TestInvalidateUserCache(t *testing.T) {
+	ctx := context.Background()
+	m, authz := newCachedMiddleware(t)
+
+	for _, userID := range []string{"alice", "bob"} {
+		allowed, err := m.HasPermission(ctx, userID, WildcardDomain, "user", "read")
+		require.NoError(t, err)
+		assert.True(t, allowed)
+
+		_, err = m.HasRole(ctx, userID, "org-1", "admin")
+		require.NoError(t, err)
+	}
+
+	// 权限回收后，失效通知前仍命中缓存
+	authz.permissions["alice"] = map[string]struct{}{}
+
+	allowed, err := m.HasPermission(ctx, "alice", WildcardDomain, "user", "read")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	m.InvalidateUserCache("alice")
+
+	allowed, err = m.HasPermission(ctx, "alice", WildcardDomain, "user", "read")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	_, err = m.HasRole(ctx, "alice", "org-1", "admin")
+	require.NoError(t, err)
+
+	_, err = m.HasPermission(ctx, "bob", WildcardDomain, "user", "read")
+	require.NoError(t, err)
+
+	_, err = m.HasRole(ctx, "bob", "org-1", "admin")
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, authz.calls["alice"])
+	assert.Equal(t, 2, authz.calls["bob"])
+}
+
+func TestClearCache(t *testing.T) {
+	ctx := context.Background()
+	m, authz := newCachedMiddleware(t)
+
+	for _, userID := range []string{"alice", "bob"} {
+		_, err := m.HasPermission(ctx, userID, WildcardDomain, "user", "read")
+		require.NoError(t, err)
+	}
+
+	m.ClearCache()
+
+	for _, userID := range []string{"alice", "bob"} {
+		_, err := m.HasPermission(ctx, userID, WildcardDomain, "user", "read")
+		require.NoError(t, err)
+		assert.Equal(t, 2, authz.calls[userID])
+	}
+}
@@ -1,446 +1,337 @@
 package casbin_middleware
 
-// import (
-// 	"context"
-// 	"fmt"
-// 	"log/slog"
-// 	"strings"
-// 	"time"
-
-// 	"github.com/cloudwego/hertz/pkg/app"
-
-// 	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/http_base"
-// 	casbinManager "github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/casbin"
-// 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
-// )
-
-// // casbinMiddleware Casbin权限中间件实现
-// type casbinMiddleware struct {
-// 	casbinManager    *casbinManager.CasbinManager
-// 	subjectExtractor *SubjectExtractor
-// 	config           *PermissionConfig
-// 	logger           *slog.Logger
-// }
-
-// // NewCasbinMiddleware 创建权限中间件实例
-// func NewCasbinMiddleware(
-// 	casbinMgr *casbinManager.CasbinManager,
-// 	logger *slog.Logger,
-// 	config *PermissionConfig,
-// ) (CasbinMiddleware, error) {
-// 	if casbinMgr == nil {
-// 		return nil, fmt.Errorf("casbinManager不能为空")
-// 	}
-// 	if logger == nil {
-// 		return nil, fmt.Errorf("logger不能为空")
-// 	}
-
-// 	if config == nil {
-// 		config = DefaultPermissionConfig()
-// 	}
-
-// 	// 创建主体提取器
-// 	subjectExtractor := NewSubjectExtractor(logger)
-
-// 	impl := &casbinMiddleware{
-// 		casbinManager:    casbinMgr,
-// 		subjectExtractor: subjectExtractor,
-// 		config:           config,
-// 		logger:           logger,
-// 	}
-
-// 	// 配置自定义错误处理器
-// 	impl.setupErrorHandlers()
-
-// 	return impl, nil
-// }
-
-// // RequiresPermissions 实现CasbinMiddleware接口
-// func (m *casbinMiddleware) RequiresPermissions(permission string) []app.HandlerFunc {
-// 	return []app.HandlerFunc{
-// 		m.createPermissionHandler(permission),
-// 	}
-// }
-
-// // RequiresRoles 实现CasbinMiddleware接口
-// func (m *casbinMiddleware) RequiresRoles(roles string) []app.HandlerFunc {
-// 	return []app.HandlerFunc{
-// 		m.createRoleHandler(roles),
-// 	}
-// }
-
-// // RequiresAnyPermissions 实现CasbinMiddleware接口
-// func (m *casbinMiddleware) RequiresAnyPermissions(permissions ...string) []app.HandlerFunc {
-// 	return []app.HandlerFunc{
-// 		m.createAnyPermissionsHandler(permissions),
-// 	}
-// }
-
-// // RequiresAllPermissions 实现CasbinMiddleware接口
-// func (m *casbinMiddleware) RequiresAllPermissions(permissions ...string) []app.HandlerFunc {
-// 	return []app.HandlerFunc{
-// 		m.createAllPermissionsHandler(permissions),
-// 	}
-// }
-
-// // HasPermission 实现CasbinMiddleware接口
-// func (m *casbinMiddleware) HasPermission(ctx context.Context, userID, domain, resource, action string) (bool, error) {
-// 	return m.casbinManager.HasPermission(userID, domain, resource, action)
-// }
-
-// // HasRole 实现CasbinMiddleware接口
-// func (m *casbinMiddleware) HasRole(ctx context.Context, userID, domain, role string) (bool, error) {
-// 	subject := FormatSubject(userID, domain)
-// 	hasRole, err := m.casbinManager.Enforcer.HasRoleForUser(subject, role)
-// 	if err != nil {
-// 		m.logger.Error("检查用户角色失败",
-// 			slog.String("userID", userID),
-// 			slog.String("domain", domain),
-// 			slog.String("role", role),
-// 			slog.String("error", err.Error()))
-// 		return false, err
-// 	}
-// 	return hasRole, nil
-// }
-
-// // GetUserPermissions 实现CasbinMiddleware接口
-// func (m *casbinMiddleware) GetUserPermissions(ctx context.Context, userID, domain string) ([]string, error) {
-// 	permissions, err := m.casbinManager.GetUserPermissions(userID)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	result := make([]string, len(permissions))
-// 	for i, perm := range permissions {
-// 		// permissions是[][]string类型，每个perm是[resource, action]格式
-// 		if len(perm) >= 2 {
-// 			result[i] = fmt.Sprintf("%s:%s", perm[0], perm[1])
-// 		}
-// 	}
-// 	return result, nil
-// }
-
-// // GetUserRoles 实现CasbinMiddleware接口
-// func (m *casbinMiddleware) GetUserRoles(ctx context.Context, userID, domain string) ([]string, error) {
-// 	return m.casbinManager.GetUserRoles(userID)
-// }
-
-// // createPermissionHandler 创建权限检查处理器
-// func (m *casbinMiddleware) createPermissionHandler(permission string) app.HandlerFunc {
-// 	return func(ctx context.Context, c *app.RequestContext) {
-// 		// 跳过路径检查
-// 		if m.shouldSkipPath(string(c.Request.URI().Path())) {
-// 			c.Next(ctx)
-// 			return
-// 		}
-
-// 		// 提取主体和域
-// 		subject := m.subjectExtractor.Extract(ctx, c)
-// 		if subject == "" {
-// 			m.handleUnauthorized(ctx, c, "未找到有效的用户身份")
-// 			return
-// 		}
-
-// 		domain := m.subjectExtractor.ExtractDomain(ctx, c)
-
-// 		// 解析权限字符串
-// 		parts := strings.Split(permission, ":")
-// 		if len(parts) != 2 {
-// 			m.logger.Error("无效的权限字符串格式",
-// 				slog.String("permission", permission))
-// 			m.handleForbidden(ctx, c, "权限配置错误")
-// 			return
-// 		}
-// 		resource, action := parts[0], parts[1]
-
-// 		// 执行权限检查
-// 		allowed, err := m.casbinManager.HasPermission(subject, domain, resource, action)
-// 		if err != nil {
-// 			m.logger.Error("权限检查失败",
-// 				slog.String("subject", subject),
-// 				slog.String("domain", domain),
-// 				slog.String("resource", resource),
-// 				slog.String("action", action),
-// 				slog.String("error", err.Error()))
-// 			m.handleForbidden(ctx, c, "权限检查失败")
-// 			return
-// 		}
-
-// 		if !allowed {
-// 			m.logger.Warn("权限检查失败：用户权限不足",
-// 				slog.String("subject", subject),
-// 				slog.String("domain", domain),
-// 				slog.String("permission", permission),
-// 				slog.String("path", string(c.Request.URI().Path())))
-// 			m.handleForbidden(ctx, c, "权限不足")
-// 			return
-// 		}
-
-// 		// 权限检查通过，继续处理请求
-// 		m.logger.Debug("权限检查通过",
-// 			slog.String("subject", subject),
-// 			slog.String("domain", domain),
-// 			slog.String("permission", permission))
-
-// 		c.Next(ctx)
-// 	}
-// }
-
-// // createRoleHandler 创建角色检查处理器
-// func (m *casbinMiddleware) createRoleHandler(roles string) app.HandlerFunc {
-// 	roleList := strings.Split(roles, ",")
-// 	for i := range roleList {
-// 		roleList[i] = strings.TrimSpace(roleList[i])
-// 	}
-
-// 	return func(ctx context.Context, c *app.RequestContext) {
-// 		// 跳过路径检查
-// 		if m.shouldSkipPath(string(c.Request.URI().Path())) {
-// 			c.Next(ctx)
-// 			return
-// 		}
-
-// 		// 提取主体和域
-// 		subject := m.subjectExtractor.Extract(ctx, c)
-// 		if subject == "" {
-// 			m.handleUnauthorized(ctx, c, "未找到有效的用户身份")
-// 			return
-// 		}
-
-// 		domain := m.subjectExtractor.ExtractDomain(ctx, c)
-
-// 		// 检查用户是否拥有任一指定角色
-// 		for _, role := range roleList {
-// 			hasRole, err := m.HasRole(ctx, subject, domain, role)
-// 			if err != nil {
-// 				m.logger.Error("角色检查失败",
-// 					slog.String("subject", subject),
-// 					slog.String("role", role),
-// 					slog.String("error", err.Error()))
-// 				continue
-// 			}
-
-// 			if hasRole {
-// 				m.logger.Debug("角色检查通过",
-// 					slog.String("subject", subject),
-// 					slog.String("role", role))
-// 				c.Next(ctx)
-// 				return
-// 			}
-// 		}
-
-// 		m.logger.Warn("角色检查失败：用户无所需角色",
-// 			slog.String("subject", subject),
-// 			slog.String("required_roles", roles))
-// 		m.handleForbidden(ctx, c, "角色权限不足")
-// 	}
-// }
-
-// // createAnyPermissionsHandler 创建任一权限检查处理器
-// func (m *casbinMiddleware) createAnyPermissionsHandler(permissions []string) app.HandlerFunc {
-// 	return func(ctx context.Context, c *app.RequestContext) {
-// 		// 跳过路径检查
-// 		if m.shouldSkipPath(string(c.Request.URI().Path())) {
-// 			c.Next(ctx)
-// 			return
-// 		}
-
-// 		// 提取主体和域
-// 		subject := m.subjectExtractor.Extract(ctx, c)
-// 		if subject == "" {
-// 			m.handleUnauthorized(ctx, c, "未找到有效的用户身份")
-// 			return
-// 		}
-
-// 		// domain := m.subjectExtractor.ExtractDomain(ctx, c)
-
-// 		// 检查用户是否拥有任一指定权限
-// 		// for _, perm := range permissions {
-// 		// 	resource, action, err := permission.ParsePermissionString(perm)
-// 		// 	if err != nil {
-// 		// 		m.logger.Error("无效的权限字符串格式",
-// 		// 			slog.String("permission", perm),
-// 		// 			slog.String("error", err.Error()))
-// 		// 		continue
-// 		// 	}
-
-// 		// 	allowed, err := m.casbinManager.HasPermission(subject, domain, resource, action)
-// 		// 	if err != nil {
-// 		// 		m.logger.Error("权限检查失败",
-// 		// 			slog.String("subject", subject),
-// 		// 			slog.String("permission", perm),
-// 		// 			slog.String("error", err.Error()))
-// 		// 		continue
-// 		// 	}
-
-// 		// 	if allowed {
-// 		// 		m.logger.Debug("任一权限检查通过",
-// 		// 			slog.String("subject", subject),
-// 		// 			slog.String("permission", perm))
-// 		// 		c.Next(ctx)
-// 		// 		return
-// 		// 	}
-// 		// }
-
-// 		m.logger.Warn("任一权限检查失败：用户无所需权限",
-// 			slog.String("subject", subject),
-// 			slog.Any("required_permissions", permissions))
-// 		m.handleForbidden(ctx, c, "权限不足")
-// 	}
-// }
-
-// // createAllPermissionsHandler 创建所有权限检查处理器
-// func (m *casbinMiddleware) createAllPermissionsHandler(permissions []string) app.HandlerFunc {
-// 	return func(ctx context.Context, c *app.RequestContext) {
-// 		// 跳过路径检查
-// 		if m.shouldSkipPath(string(c.Request.URI().Path())) {
-// 			c.Next(ctx)
-// 			return
-// 		}
-
-// 		// 提取主体和域
-// 		subject := m.subjectExtractor.Extract(ctx, c)
-// 		if subject == "" {
-// 			m.handleUnauthorized(ctx, c, "未找到有效的用户身份")
-// 			return
-// 		}
-
-// 		// domain := m.subjectExtractor.ExtractDomain(ctx, c)
-
-// 		// // 检查用户是否拥有所有指定权限
-// 		// for _, perm := range permissions {
-// 		// 	resource, action, err := permission.ParsePermissionString(perm)
-// 		// 	if err != nil {
-// 		// 		m.logger.Error("无效的权限字符串格式",
-// 		// 			slog.String("permission", perm),
-// 		// 			slog.String("error", err.Error()))
-// 		// 		m.handleForbidden(ctx, c, "权限配置错误")
-// 		// 		return
-// 		// 	}
-
-// 		// 	allowed, err := m.casbinManager.HasPermission(subject, domain, resource, action)
-// 		// 	if err != nil {
-// 		// 		m.logger.Error("权限检查失败",
-// 		// 			slog.String("subject", subject),
-// 		// 			slog.String("permission", perm),
-// 		// 			slog.String("error", err.Error()))
-// 		// 		m.handleForbidden(ctx, c, "权限检查失败")
-// 		// 		return
-// 		// 	}
-
-// 		// 	if !allowed {
-// 		// 		m.logger.Warn("所有权限检查失败：缺少必需权限",
-// 		// 			slog.String("subject", subject),
-// 		// 			slog.String("missing_permission", perm))
-// 		// 		m.handleForbidden(ctx, c, "权限不足")
-// 		// 		return
-// 		// 	}
-// 		// }
-
-// 		m.logger.Debug("所有权限检查通过",
-// 			slog.String("subject", subject),
-// 			slog.Any("permissions", permissions))
-// 		c.Next(ctx)
-// 	}
-// }
-
-// // shouldSkipPath 检查是否应该跳过权限检查
-// func (m *casbinMiddleware) shouldSkipPath(path string) bool {
-// 	for _, skipPath := range m.config.SkipPaths {
-// 		if path == skipPath {
-// 			return true
-// 		}
-// 	}
-// 	return false
-// }
-
-// // setupErrorHandlers 设置错误处理器
-// func (m *casbinMiddleware) setupErrorHandlers() {
-// 	if m.config.UnauthorizedHandler == nil {
-// 		m.config.UnauthorizedHandler = m.defaultUnauthorizedHandler
-// 	}
-// 	if m.config.ForbiddenHandler == nil {
-// 		m.config.ForbiddenHandler = m.defaultForbiddenHandler
-// 	}
-// }
-
-// // handleUnauthorized 处理未认证错误
-// func (m *casbinMiddleware) handleUnauthorized(ctx context.Context, c *app.RequestContext, message string) {
-// 	m.logger.Warn("用户未认证",
-// 		slog.String("path", string(c.Request.URI().Path())),
-// 		slog.String("method", string(c.Request.Header.Method())),
-// 		slog.String("message", message))
-
-// 	if m.config.UnauthorizedHandler != nil {
-// 		m.config.UnauthorizedHandler(ctx, c)
-// 		return
-// 	}
-
-// 	m.defaultUnauthorizedHandler(ctx, c)
-// }
-
-// // handleForbidden 处理权限不足错误
-// func (m *casbinMiddleware) handleForbidden(ctx context.Context, c *app.RequestContext, message string) {
-// 	m.logger.Warn("权限不足",
-// 		slog.String("path", string(c.Request.URI().Path())),
-// 		slog.String("method", string(c.Request.Header.Method())),
-// 		slog.String("message", message))
-
-// 	if m.config.ForbiddenHandler != nil {
-// 		m.config.ForbiddenHandler(ctx, c)
-// 		return
-// 	}
-
-// 	m.defaultForbiddenHandler(ctx, c)
-// }
-
-// // defaultUnauthorizedHandler 默认未认证处理器
-// func (m *casbinMiddleware) defaultUnauthorizedHandler(ctx context.Context, c *app.RequestContext) {
-// 	// 生成标准化的错误响应
-// 	// 权限不足异常
-// 	apiError := errors.ErrUnauthorized
-
-// 	httpStatus := errors.GetHTTPStatus(apiError.Code())
-// 	requestID := errors.GenerateRequestID(c)
-// 	traceID := errors.GenerateTraceID(c)
-// 	timestamp := time.Now().UnixMilli()
-
-// 	response := &http_base.OperationStatusResponseDTO{
-// 		BaseResp: &http_base.BaseResponseDTO{
-// 			Code:      apiError.Code(),
-// 			Message:   apiError.Message(),
-// 			RequestID: &requestID,
-// 			TraceID:   &traceID,
-// 			Timestamp: &timestamp,
-// 		},
-// 	}
-
-// 	// 直接写入响应
-// 	c.JSON(httpStatus, response)
-// 	c.Abort()
-// }
-
-// // defaultForbiddenHandler 默认权限不足处理器
-// func (m *casbinMiddleware) defaultForbiddenHandler(ctx context.Context, c *app.RequestContext) {
-// 	// 生成标准化的错误响应
-// 	// 权限不足异常
-// 	apiError := errors.ErrForbidden
-
-// 	httpStatus := errors.GetHTTPStatus(apiError.Code())
-// 	requestID := errors.GenerateRequestID(c)
-// 	traceID := errors.GenerateTraceID(c)
-// 	timestamp := time.Now().UnixMilli()
-
-// 	response := &http_base.OperationStatusResponseDTO{
-// 		BaseResp: &http_base.BaseResponseDTO{
-// 			Code:      apiError.Code(),
-// 			Message:   apiError.Message(),
-// 			RequestID: &requestID,
-// 			TraceID:   &traceID,
-// 			Timestamp: &timestamp,
-// 		},
-// 	}
-
-// 	// 直接写入响应
-// 	c.JSON(httpStatus, response)
-// 	c.Abort()
-// }
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/observability"
+	permissionService "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/permission"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+)
+
+// checkFunc 权限判定函数，返回是否放行
+type checkFunc func(ctx context.Context, subject, domain string) (bool, error)
+
+// casbinMiddleware Casbin权限中间件实现
+type casbinMiddleware struct {
+	authzService     permissionService.AuthorizationService
+	subjectExtractor *SubjectExtractor
+	config           *PermissionConfig
+	cache            *decisionCache
+	permissionCache  *permissionSetCache
+	logger           *hertzZerolog.Logger
+}
+
+// NewCasbinMiddleware 创建权限中间件实例
+func NewCasbinMiddleware(
+	authzService permissionService.AuthorizationService,
+	logger *hertzZerolog.Logger,
+	config *PermissionConfig,
+) (CasbinMiddleware, error) {
+	if authzService == nil {
+		return nil, fmt.Errorf("authzService不能为空")
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger不能为空")
+	}
+
+	if config == nil {
+		config = DefaultPermissionConfig()
+	}
+
+	impl := &casbinMiddleware{
+		authzService:     authzService,
+		subjectExtractor: NewSubjectExtractor(logger),
+		config:           config,
+		logger:           logger,
+	}
+
+	if config.EnableCache && config.CacheTimeout > 0 {
+		impl.cache = newDecisionCache(config.CacheTimeout)
+		impl.permissionCache = newPermissionSetCache(config.CacheTimeout)
+	}
+
+	// 配置自定义错误处理器
+	impl.setupErrorHandlers()
+
+	return impl, nil
+}
+
+// RequiresPermissions 实现CasbinMiddleware接口
+func (m *casbinMiddleware) RequiresPermissions(permission string) []app.HandlerFunc {
+	resource, action, err := parseCataloguedPermission(permission)
+	if err != nil {
+		// 路由权限声明错误属于编码错误，启动阶段直接暴露
+		panic(err)
+	}
+
+	return []app.HandlerFunc{
+		m.createHandler(permission, func(ctx context.Context, subject, domain string) (bool, error) {
+			return m.HasPermission(ctx, subject, domain, resource, action)
+		}),
+	}
+}
+
+// RequiresRoles 实现CasbinMiddleware接口
+func (m *casbinMiddleware) RequiresRoles(roles string) []app.HandlerFunc {
+	roleList := make([]string, 0)
+
+	for _, role := range strings.Split(roles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			roleList = append(roleList, role)
+		}
+	}
+
+	if len(roleList) == 0 {
+		panic(fmt.Errorf("角色列表不能为空"))
+	}
+
+	return []app.HandlerFunc{
+		m.createHandler("roles:"+roles, func(ctx context.Context, subject, domain string) (bool, error) {
+			return m.cachedCheck(subject, "role|"+subject+"|"+domain+"|"+strings.Join(roleList, ","),
+				func() (bool, error) {
+					return m.authzService.CheckRole(ctx, subject, organizationOf(domain), roleList)
+				})
+		}),
+	}
+}
+
+// RequiresAnyPermissions 实现CasbinMiddleware接口
+func (m *casbinMiddleware) RequiresAnyPermissions(permissions ...string) []app.HandlerFunc {
+	parsed := m.mustParsePermissions(permissions)
+
+	return []app.HandlerFunc{
+		m.createHandler(strings.Join(permissions, "|"),
+			func(ctx context.Context, subject, domain string) (bool, error) {
+				for _, perm := range parsed {
+					allowed, err := m.HasPermission(ctx, subject, domain, perm[0], perm[1])
+					if err != nil {
+						return false, err
+					}
+
+					if allowed {
+						return true, nil
+					}
+				}
+
+				return false, nil
+			}),
+	}
+}
+
+// RequiresAllPermissions 实现CasbinMiddleware接口
+func (m *casbinMiddleware) RequiresAllPermissions(permissions ...string) []app.HandlerFunc {
+	parsed := m.mustParsePermissions(permissions)
+
+	return []app.HandlerFunc{
+		m.createHandler(strings.Join(permissions, "&"),
+			func(ctx context.Context, subject, domain string) (bool, error) {
+				for _, perm := range parsed {
+					allowed, err := m.HasPermission(ctx, subject, domain, perm[0], perm[1])
+					if err != nil || !allowed {
+						return false, err
+					}
+				}
+
+				return true, nil
+			}),
+	}
+}
+
+// HasPermission 实现CasbinMiddleware接口
+// 启用缓存时批量获取并缓存用户的生效权限集合在本地判定，否则逐次调用 identity_srv 校验
+func (m *casbinMiddleware) HasPermission(
+	ctx context.Context,
+	userID, domain, resource, action string,
+) (bool, error) {
+	if m.permissionCache == nil {
+		return m.authzService.CheckPermission(ctx, userID, organizationOf(domain), resource, action)
+	}
+
+	key := FormatSubject(userID, domain)
+
+	permissions, ok := m.permissionCache.get(key)
+	if !ok {
+		var err error
+
+		permissions, err = m.authzService.GetUserEffectivePermissions(ctx, userID, organizationOf(domain))
+		if err != nil {
+			return false, err
+		}
+
+		m.permissionCache.set(key, userID, permissions)
+	}
+
+	return permissions.Allows(resource, action), nil
+}
+
+// HasRole 实现CasbinMiddleware接口
+func (m *casbinMiddleware) HasRole(ctx context.Context, userID, domain, role string) (bool, error) {
+	key := "role|" + FormatSubject(userID, domain) + "|" + role
+
+	return m.cachedCheck(userID, key, func() (bool, error) {
+		return m.authzService.CheckRole(ctx, userID, organizationOf(domain), []string{role})
+	})
+}
+
+// InvalidateUserCache 实现CasbinMiddleware接口
+func (m *casbinMiddleware) InvalidateUserCache(userID string) {
+	if m.cache != nil {
+		m.cache.deleteUser(userID)
+	}
+
+	if m.permissionCache != nil {
+		m.permissionCache.deleteUser(userID)
+	}
+}
+
+// ClearCache 实现CasbinMiddleware接口
+func (m *casbinMiddleware) ClearCache() {
+	if m.cache != nil {
+		m.cache.clear()
+	}
+
+	if m.permissionCache != nil {
+		m.permissionCache.clear()
+	}
+}
+
+// cachedCheck 带缓存的权限判定，仅缓存成功的判定结果
+func (m *casbinMiddleware) cachedCheck(userID, key string, check func() (bool, error)) (bool, error) {
+	if m.cache != nil {
+		if allowed, ok := m.cache.get(key); ok {
+			return allowed, nil
+		}
+	}
+
+	allowed, err := check()
+	if err != nil {
+		return false, err
+	}
+
+	if m.cache != nil {
+		m.cache.set(key, userID, allowed)
+	}
+
+	return allowed, nil
+}
+
+// createHandler 创建权限检查处理器
+func (m *casbinMiddleware) createHandler(requirement string, check checkFunc) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		// 未启用或跳过路径，直接放行
+		if !m.config.Enabled || m.shouldSkipPath(string(c.Request.URI().Path())) {
+			c.Next(ctx)
+			return
+		}
+
+		// 提取主体和域
+		subject := m.subjectExtractor.Extract(ctx, c)
+		if subject == "" {
+			m.handleUnauthorized(ctx, c, "未找到有效的用户身份")
+			return
+		}
+
+		domain := m.subjectExtractor.ExtractDomain(ctx, c)
+
+		allowed, err := check(ctx, subject, domain)
+		if err != nil {
+			m.logger.Errorf("权限检查失败: subject=%s, domain=%s, requirement=%s, error=%v",
+				subject, domain, requirement, err)
+			errors.AbortWithErrorMessage(c, errors.ErrServiceDown, "权限校验服务暂不可用")
+
+			return
+		}
+
+		if !allowed {
+			m.logger.Warnf("权限检查失败：用户权限不足: subject=%s, domain=%s, requirement=%s, path=%s",
+				subject, domain, requirement, string(c.Request.URI().Path()))
+			observability.RecordPermissionDenied(requirement)
+			m.handleForbidden(ctx, c, "权限不足")
+
+			return
+		}
+
+		c.Next(ctx)
+	}
+}
+
+// mustParsePermissions 解析权限字符串列表，格式错误时在注册阶段 panic
+func (m *casbinMiddleware) mustParsePermissions(permissions []string) [][2]string {
+	if len(permissions) == 0 {
+		panic(fmt.Errorf("权限列表不能为空"))
+	}
+
+	parsed := make([][2]string, 0, len(permissions))
+
+	for _, perm := range permissions {
+		resource, action, err := parseCataloguedPermission(perm)
+		if err != nil {
+			panic(err)
+		}
+
+		parsed = append(parsed, [2]string{resource, action})
+	}
+
+	return parsed
+}
+
+// shouldSkipPath 检查是否应该跳过权限检查
+func (m *casbinMiddleware) shouldSkipPath(path string) bool {
+	for _, skipPath := range m.config.SkipPaths {
+		if path == skipPath {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setupErrorHandlers 设置错误处理器
+func (m *casbinMiddleware) setupErrorHandlers() {
+	if m.config.UnauthorizedHandler == nil {
+		m.config.UnauthorizedHandler = m.defaultUnauthorizedHandler
+	}
+
+	if m.config.ForbiddenHandler == nil {
+		m.config.ForbiddenHandler = m.defaultForbiddenHandler
+	}
+}
+
+// handleUnauthorized 处理未认证错误
+func (m *casbinMiddleware) handleUnauthorized(
+	ctx context.Context,
+	c *app.RequestContext,
+	message string,
+) {
+	m.logger.Warnf("用户未认证: method=%s, path=%s, message=%s",
+		string(c.Request.Header.Method()), string(c.Request.URI().Path()), message)
+
+	m.config.UnauthorizedHandler(ctx, c)
+}
+
+// handleForbidden 处理权限不足错误
+func (m *casbinMiddleware) handleForbidden(
+	ctx context.Context,
+	c *app.RequestContext,
+	message string,
+) {
+	m.logger.Debugf("权限不足: method=%s, path=%s, message=%s",
+		string(c.Request.Header.Method()), string(c.Request.URI().Path()), message)
+
+	m.config.ForbiddenHandler(ctx, c)
+}
+
+// defaultUnauthorizedHandler 默认未认证处理器
+func (m *casbinMiddleware) defaultUnauthorizedHandler(ctx context.Context, c *app.RequestContext) {
+	errors.AbortWithError(c, errors.ErrUnauthorized)
+}
+
+// defaultForbiddenHandler 默认权限不足处理器
+func (m *casbinMiddleware) defaultForbiddenHandler(ctx context.Context, c *app.RequestContext) {
+	errors.AbortWithError(c, errors.ErrForbidden)
+}
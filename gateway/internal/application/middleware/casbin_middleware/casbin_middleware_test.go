@@ -0,0 +1,172 @@
+package casbin_middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/http_base"
+	authctx "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/context/auth_context"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveRequest 以指定用户身份执行权限中间件，返回请求上下文及是否放行到业务处理器
+// userID 为空时不设置认证上下文
+func serveRequest(handlers []app.HandlerFunc, path, userID, organizationID string) (*app.RequestContext, bool) {
+	c := app.NewContext(0)
+	c.Request.SetRequestURI(path)
+
+	if userID != "" {
+		claims := &http_base.JWTClaimsDTO{UserProfileID: &userID}
+		if organizationID != "" {
+			claims.OrganizationID = &organizationID
+		}
+
+		authctx.SetAuthContext(c, authctx.NewAuthContext(claims))
+	}
+
+	reached := false
+
+	c.SetHandlers(append(handlers, func(context.Context, *app.RequestContext) {
+		reached = true
+	}))
+	c.Next(context.Background())
+
+	return c, reached
+}
+
+// assertRejected 断言请求被拦截并返回指定错误对应的响应
+func assertRejected(t *testing.T, c *app.RequestContext, reached bool, expected errors.APIError) {
+	t.Helper()
+
+	assert.False(t, reached)
+	assert.True(t, c.IsAborted())
+	assert.Equal(t, errors.GetHTTPStatus(expected.Code()), c.Response.StatusCode())
+
+	code, ok := errors.GetErrorCode(c)
+	require.True(t, ok)
+	assert.Equal(t, expected.Code(), code)
+}
+
+func TestRequiresPermissions_AllowAndDeny(t *testing.T) {
+	m, authz := newCachedMiddleware(t)
+	authz.permissions["bob"] = map[string]struct{}{"user:create": {}}
+	handlers := m.RequiresPermissions(PermUserRead)
+
+	c, reached := serveRequest(handlers, "/api/v1/users", "alice", "")
+	assert.True(t, reached)
+	assert.Equal(t, http.StatusOK, c.Response.StatusCode())
+
+	c, reached = serveRequest(handlers, "/api/v1/users", "bob", "")
+	assertRejected(t, c, reached, errors.ErrForbidden)
+}
+
+func TestRequiresPermissions_Unauthenticated(t *testing.T) {
+	m, authz := newCachedMiddleware(t)
+
+	c, reached := serveRequest(m.RequiresPermissions(PermUserRead), "/api/v1/users", "", "")
+	assertRejected(t, c, reached, errors.ErrUnauthorized)
+	assert.Empty(t, authz.calls)
+}
+
+func TestRequiresPermissions_SkipPathAndDisabled(t *testing.T) {
+	m, authz := newCachedMiddleware(t)
+
+	_, reached := serveRequest(m.RequiresPermissions(PermUserRead), "/health", "", "")
+	assert.True(t, reached)
+
+	m.config.Enabled = false
+
+	_, reached = serveRequest(m.RequiresPermissions(PermUserDelete), "/api/v1/users", "bob", "")
+	assert.True(t, reached)
+	assert.Empty(t, authz.calls)
+}
+
+func TestRequiresAnyAndAllPermissions(t *testing.T) {
+	m, _ := newCachedMiddleware(t)
+
+	_, reached := serveRequest(m.RequiresAnyPermissions(PermUserDelete, PermUserRead), "/", "alice", "")
+	assert.True(t, reached)
+
+	c, reached := serveRequest(m.RequiresAnyPermissions(PermUserDelete, PermUserCreate), "/", "alice", "")
+	assertRejected(t, c, reached, errors.ErrForbidden)
+
+	c, reached = serveRequest(m.RequiresAllPermissions(PermUserRead, PermUserDelete), "/", "alice", "")
+	assertRejected(t, c, reached, errors.ErrForbidden)
+}
+
+func TestRequiresPermissions_CachesPerUserAndOrganization(t *testing.T) {
+	m, authz := newCachedMiddleware(t)
+	handlers := m.RequiresPermissions(PermUserRead)
+
+	for range 3 {
+		_, reached := serveRequest(handlers, "/api/v1/users", "alice", "")
+		assert.True(t, reached)
+	}
+
+	assert.Equal(t, 1, authz.calls["alice"])
+
+	// 不同组织下的生效权限分别获取与缓存
+	_, reached := serveRequest(handlers, "/api/v1/users", "alice", "org-1")
+	assert.True(t, reached)
+	assert.Equal(t, 2, authz.calls["alice"])
+}
+
+func TestRequiresPermissions_WithoutCache(t *testing.T) {
+	authz := &countingAuthzService{calls: make(map[string]int)}
+
+	config := DefaultPermissionConfig()
+	config.EnableCache = false
+
+	m, err := NewCasbinMiddleware(authz, hertzZerolog.New(), config)
+	require.NoError(t, err)
+
+	// 未启用缓存时逐次调用 CheckPermission，桩实现一律拒绝
+	c, reached := serveRequest(m.RequiresPermissions(PermUserRead), "/api/v1/users", "alice", "")
+	assertRejected(t, c, reached, errors.ErrForbidden)
+	assert.Empty(t, authz.calls)
+}
+
+func TestRequiresPermissions_ServiceErrorNotCached(t *testing.T) {
+	m, authz := newCachedMiddleware(t)
+	handlers := m.RequiresPermissions(PermUserRead)
+
+	authz.err = fmt.Errorf("identity_srv unavailable")
+
+	c, reached := serveRequest(handlers, "/api/v1/users", "alice", "")
+	assertRejected(t, c, reached, errors.ErrServiceDown)
+
+	// 服务恢复后重新获取权限，失败结果未被缓存
+	authz.err = nil
+
+	_, reached = serveRequest(handlers, "/api/v1/users", "alice", "")
+	assert.True(t, reached)
+	assert.Equal(t, 2, authz.calls["alice"])
+}
+
+func TestRequiresRoles(t *testing.T) {
+	m, authz := newCachedMiddleware(t)
+	handlers := m.RequiresRoles("admin, auditor")
+
+	for range 2 {
+		_, reached := serveRequest(handlers, "/api/v1/roles", "alice", "org-1")
+		assert.True(t, reached)
+	}
+
+	assert.Equal(t, 1, authz.calls["alice"])
+
+	assert.Panics(t, func() { m.RequiresRoles(" , ") })
+}
+
+func TestRequiresPermissions_PanicsOnUncataloguedPermission(t *testing.T) {
+	m, _ := newCachedMiddleware(t)
+
+	assert.Panics(t, func() { m.RequiresPermissions("report:export") })
+	assert.Panics(t, func() { m.RequiresAnyPermissions() })
+	assert.Panics(t, func() { m.RequiresAllPermissions(PermUserRead, "user") })
+}
@@ -2,20 +2,22 @@ package casbin_middleware
 
 import (
 	"context"
+	"time"
 
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
 // CasbinMiddleware Casbin权限控制中间件接口
-// 基于hertz-contrib/casbin的权限中间件，提供类型安全的权限控制
+// 权限判定委托给 identity_srv 的 Casbin 策略（p = role, resource, action），
+// 网关侧仅负责提取主体、缓存判定结果并在路由级别拦截请求
 type CasbinMiddleware interface {
 	// RequiresPermissions 要求特定权限的中间件
-	// 权限格式: resource:action (如: "users:read", "departments:create")
+	// 权限格式: resource:action (如: "user:read", "department:create")
 	// 域(domain)通过认证上下文自动提取，支持多租户权限隔离
 	RequiresPermissions(permission string) []app.HandlerFunc
 
 	// RequiresRoles 要求特定角色的中间件
-	// 支持单个角色或多个角色（逗号分隔）
+	// 支持单个角色或多个角色（逗号分隔），拥有任一角色即可通过
 	RequiresRoles(roles string) []app.HandlerFunc
 
 	// RequiresAnyPermissions 要求任一权限的中间件
@@ -33,26 +35,26 @@ type CasbinMiddleware interface {
 	// HasRole 检查用户是否拥有特定角色（工具方法）
 	HasRole(ctx context.Context, userID, domain, role string) (bool, error)
 
-	// GetUserPermissions 获取用户所有权限（工具方法）
-	GetUserPermissions(ctx context.Context, userID, domain string) ([]string, error)
+	// InvalidateUserCache 清除指定用户在各个域下的权限判定缓存（用户角色或权限变更时调用）
+	InvalidateUserCache(userID string)
 
-	// GetUserRoles 获取用户所有角色（工具方法）
-	GetUserRoles(ctx context.Context, userID, domain string) ([]string, error)
+	// ClearCache 清除全部用户的权限判定缓存（角色定义的权限变更时调用）
+	ClearCache()
 }
 
 // PermissionConfig 权限中间件配置
 type PermissionConfig struct {
+	// Enabled 是否启用权限校验，关闭时所有路由级权限中间件直接放行
+	Enabled bool
+
 	// SkipPaths 跳过权限检查的路径列表
 	SkipPaths []string
 
-	// EnableCache 是否启用权限缓存
+	// EnableCache 是否启用权限判定缓存
 	EnableCache bool
 
-	// CacheTimeout 权限缓存超时时间（秒）
-	CacheTimeout int
-
-	// LogLevel 日志级别 (DEBUG, INFO, WARN, ERROR)
-	LogLevel string
+	// CacheTimeout 权限判定缓存超时时间
+	CacheTimeout time.Duration
 
 	// UnauthorizedHandler 未认证处理器
 	UnauthorizedHandler app.HandlerFunc
@@ -64,13 +66,13 @@ type PermissionConfig struct {
 // DefaultPermissionConfig 默认权限配置
 func DefaultPermissionConfig() *PermissionConfig {
 	return &PermissionConfig{
+		Enabled: true,
 		SkipPaths: []string{
 			"/health",
 			"/metrics",
 			"/favicon.ico",
 		},
 		EnableCache:  true,
-		CacheTimeout: 300, // 5分钟
-		LogLevel:     "INFO",
+		CacheTimeout: 30 * time.Second,
 	}
 }
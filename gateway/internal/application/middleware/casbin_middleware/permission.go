@@ -0,0 +1,92 @@
+package casbin_middleware
+
+import (
+	"fmt"
+	"strings"
+
+	permissionService "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/permission"
+)
+
+// 权限字符串分隔符，格式为 resource:action
+const permissionSeparator = ":"
+
+// 路由权限定义（resource:action）
+// 与 identity_srv 中角色的 p 策略 (role, resource, action) 一一对应
+const (
+	// 用户管理
+	PermUserRead         = "user:read"
+	PermUserCreate       = "user:create"
+	PermUserUpdate       = "user:update"
+	PermUserDelete       = "user:delete"
+	PermUserUpdateStatus = "user:update_status"
+	PermUserUnlock       = "user:unlock"
+	PermUserResetPwd     = "user:reset_password"
+	PermUserForcePwd     = "user:force_password_change"
+
+	// 登录会话管理（管理员管理其他用户的会话）
+	PermSessionRead   = "session:read"
+	PermSessionRevoke = "session:revoke"
+
+	// 成员关系
+	PermMembershipRead = "membership:read"
+
+	// 组织管理
+	PermOrganizationRead   = "organization:read"
+	PermOrganizationCreate = "organization:create"
+	PermOrganizationUpdate = "organization:update"
+	PermOrganizationDelete = "organization:delete"
+
+	// 部门管理
+	PermDepartmentRead   = "department:read"
+	PermDepartmentCreate = "department:create"
+	PermDepartmentUpdate = "department:update"
+	PermDepartmentDelete = "department:delete"
+
+	// 组织Logo
+	PermLogoRead   = "logo:read"
+	PermLogoUpload = "logo:upload"
+	PermLogoDelete = "logo:delete"
+
+	// 角色定义
+	PermRoleRead          = "role:read"
+	PermRoleCreate        = "role:create"
+	PermRoleUpdate        = "role:update"
+	PermRoleDelete        = "role:delete"
+	PermRoleConfigureMenu = "role:configure_menus"
+
+	// 用户角色分配
+	PermRoleAssignmentRead = "role_assignment:read"
+	PermRoleAssignmentBind = "role_assignment:bind"
+
+	// 菜单管理
+	PermMenuRead   = "menu:read"
+	PermMenuUpload = "menu:upload"
+
+	// 审计日志
+	PermAuditRead = "audit:read"
+)
+
+// ParsePermission 解析 resource:action 格式的权限字符串
+func ParsePermission(permission string) (resource, action string, err error) {
+	parts := strings.SplitN(permission, permissionSeparator, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("无效的权限字符串格式: %q，期望 resource:action", permission)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// parseCataloguedPermission 解析路由声明的权限，并要求其已登记在权限目录中
+// 保证权限目录覆盖全部路由权限，角色配置的权限才能与路由一一对应
+func parseCataloguedPermission(permission string) (resource, action string, err error) {
+	resource, action, err = ParsePermission(permission)
+	if err != nil {
+		return "", "", err
+	}
+
+	if !permissionService.IsCatalogued(resource, action) {
+		return "", "", fmt.Errorf("路由权限 %q 未在权限目录中登记", permission)
+	}
+
+	return resource, action, nil
+}
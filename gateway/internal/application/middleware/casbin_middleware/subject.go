@@ -3,138 +3,107 @@ package casbin_middleware
 import (
 	"context"
 	"fmt"
-	"log/slog"
 	"strings"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
 
 	authctx "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/context/auth_context"
 )
 
+// WildcardDomain 通配符域，表示不区分租户，只按全局授权的角色判定
+const WildcardDomain = "*"
+
 // SubjectExtractor 主体提取器
 // 从Hertz请求上下文中提取Casbin主体标识符
 type SubjectExtractor struct {
-	logger *slog.Logger
+	logger *hertzZerolog.Logger
 }
 
 // NewSubjectExtractor 创建主体提取器
-func NewSubjectExtractor(logger *slog.Logger) *SubjectExtractor {
+func NewSubjectExtractor(logger *hertzZerolog.Logger) *SubjectExtractor {
 	return &SubjectExtractor{
 		logger: logger,
 	}
 }
 
-// Extract 实现casbin.LookupHandler接口
-// 从认证上下文中提取用户名作为Casbin主体
+// Extract 从认证上下文中提取用户档案ID作为Casbin主体
+// identity_srv 的 g 策略以用户ID关联角色，因此主体使用用户ID而非用户名
 func (e *SubjectExtractor) Extract(ctx context.Context, c *app.RequestContext) string {
 	// 获取认证上下文
 	authContext, exists := authctx.GetAuthContext(c)
 	if !exists {
-		e.logger.Debug("未找到认证上下文，返回空主体",
-			slog.String("path", string(c.Request.URI().Path())),
-			slog.String("method", string(c.Request.Header.Method())))
-		return ""
-	}
+		e.logger.Debugf("未找到认证上下文，返回空主体: method=%s, path=%s",
+			string(c.Request.Header.Method()), string(c.Request.URI().Path()))
 
-	// 提取用户名
-	username, hasUsername := authContext.GetUsername()
-	if !hasUsername || username == "" {
-		e.logger.Debug("认证上下文中未找到用户名，返回空主体",
-			slog.String("path", string(c.Request.URI().Path())),
-			slog.String("method", string(c.Request.Header.Method())))
 		return ""
 	}
 
-	// 验证用户名格式
-	if !e.isValidUsername(username) {
-		e.logger.Warn("无效的用户名格式",
-			slog.String("username", username),
-			slog.String("path", string(c.Request.URI().Path())))
+	// 提取用户ID
+	userID, hasUserID := authContext.GetUserProfileID()
+	if !hasUserID || !e.isValidSubject(userID) {
+		e.logger.Debugf("认证上下文中未找到有效的用户ID，返回空主体: method=%s, path=%s",
+			string(c.Request.Header.Method()), string(c.Request.URI().Path()))
+
 		return ""
 	}
 
-	// 记录主体提取成功
-	e.logger.Debug("成功提取Casbin主体",
-		slog.String("username", username),
-		slog.String("path", string(c.Request.URI().Path())),
-		slog.String("method", string(c.Request.Header.Method())))
-
-	return username
+	return userID
 }
 
 // ExtractDomain 从认证上下文中提取域信息
-// 用于多租户权限控制
+// 域为访问令牌中用户当前代表的组织ID，角色按该组织内的授权与全局授权合并判定；
+// 令牌未携带组织时返回通配符域
 func (e *SubjectExtractor) ExtractDomain(ctx context.Context, c *app.RequestContext) string {
-	// 获取认证上下文
-	// authContext, exists := authctx.GetAuthContext(c)
-	// if !exists {
-	// 	return permission.WildcardDomain // 默认使用通配符域
-	// }
-
-	// // 提取组织ID
-	// orgID, hasOrgID := authContext.GetOrganizationID()
-	// if !hasOrgID || orgID == "" {
-	// 	return permission.WildcardDomain
-	// }
-
-	// // 检查是否为超级管理员
-	// username, hasUsername := authContext.GetUsername()
-	// if hasUsername && username == permission.SuperAdminUsername {
-	// 	return permission.WildcardDomain // 超级管理员使用通配符域
-	// }
-
-	return "*" // 默认返回通配符域
-}
-
-// isValidUsername 验证用户名格式
-func (e *SubjectExtractor) isValidUsername(username string) bool {
-	if username == "" {
-		return false
+	organizationID, ok := authctx.GetCurrentOrganizationID(c)
+	if !ok || organizationID == "" {
+		return WildcardDomain
 	}
 
-	// // 超级管理员特殊处理
-	// if username == permission.SuperAdminUsername {
-	// 	return true
-	// }
+	return organizationID
+}
 
-	// 一般用户名格式验证：不能包含特殊字符
-	if strings.ContainsAny(username, " \t\n\r\v\f") {
+// isValidSubject 验证主体格式
+func (e *SubjectExtractor) isValidSubject(subject string) bool {
+	if subject == "" {
 		return false
 	}
 
-	// 用户名长度检查
-	if len(username) < 1 || len(username) > 100 {
+	// 不能包含空白字符
+	if strings.ContainsAny(subject, " \t\n\r\v\f") {
 		return false
 	}
 
-	return true
+	// 长度检查
+	return len(subject) <= 100
 }
 
 // FormatSubject 格式化主体标识符
-// 为多租户场景提供统一的主体格式化
-func FormatSubject(username, domain string) string {
-	// if username == permission.SuperAdminUsername {
-	// 	return username // 超级管理员不需要域后缀
-	// }
+// 为多租户场景提供统一的主体格式化，通配符域下直接返回用户标识
+func FormatSubject(userID, domain string) string {
+	if domain == "" || domain == WildcardDomain {
+		return userID
+	}
+
+	return fmt.Sprintf("%s@%s", userID, domain)
+}
 
-	// if domain == "" || domain == permission.WildcardDomain {
-	// 	return username
-	// }
+// organizationOf 将域转换为 identity_srv 的组织ID，通配符域对应空组织ID
+func organizationOf(domain string) string {
+	if domain == WildcardDomain {
+		return ""
+	}
 
-	return fmt.Sprintf("%s@%s", username, domain)
+	return domain
 }
 
 // ParseSubject 解析主体标识符
-// 从格式化的主体标识符中解析出用户名和域
-func ParseSubject(subject string) (username, domain string) {
-	// if subject == permission.SuperAdminUsername {
-	// 	return subject, permission.WildcardDomain
-	// }
-
+// 从格式化的主体标识符中解析出用户标识和域
+func ParseSubject(subject string) (userID, domain string) {
 	parts := strings.SplitN(subject, "@", 2)
-	// if len(parts) == 1 {
-	// 	return parts[0], permission.WildcardDomain
-	// }
+	if len(parts) == 1 {
+		return parts[0], WildcardDomain
+	}
 
 	return parts[0], parts[1]
 }
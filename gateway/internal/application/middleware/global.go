@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"github.com/cloudwego/hertz/pkg/app"
+
 	casbinmw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/casbin_middleware"
 )
 
@@ -20,3 +22,40 @@ func SetGlobalCasbinMiddleware(casbin casbinmw.CasbinMiddleware) {
 func GetGlobalCasbinMiddleware() casbinmw.CasbinMiddleware {
 	return globalCasbinMiddleware
 }
+
+// RequiresPermissions 声明路由所需权限，格式为 "resource:action"
+// 全局中间件未设置时返回 nil，路由不做权限校验
+func RequiresPermissions(permission string) []app.HandlerFunc {
+	if globalCasbinMiddleware == nil {
+		return nil
+	}
+
+	return globalCasbinMiddleware.RequiresPermissions(permission)
+}
+
+// RequiresAnyPermissions 声明路由需要满足任一权限
+func RequiresAnyPermissions(permissions ...string) []app.HandlerFunc {
+	if globalCasbinMiddleware == nil {
+		return nil
+	}
+
+	return globalCasbinMiddleware.RequiresAnyPermissions(permissions...)
+}
+
+// RequiresAllPermissions 声明路由需要同时满足所有权限
+func RequiresAllPermissions(permissions ...string) []app.HandlerFunc {
+	if globalCasbinMiddleware == nil {
+		return nil
+	}
+
+	return globalCasbinMiddleware.RequiresAllPermissions(permissions...)
+}
+
+// RequiresRoles 声明路由需要具备的角色（逗号分隔，满足任一即可）
+func RequiresRoles(roles string) []app.HandlerFunc {
+	if globalCasbinMiddleware == nil {
+		return nil
+	}
+
+	return globalCasbinMiddleware.RequiresRoles(roles)
+}
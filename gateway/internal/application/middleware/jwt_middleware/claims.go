@@ -39,6 +39,14 @@ func createPayloadFromLoginData(data map[string]interface{}) jwt.MapClaims {
 		claims[CorePermission] = permission
 	}
 
+	if familyID, exists := data[TokenFamilyID]; exists && familyID != nil {
+		claims[TokenFamilyID] = familyID
+	}
+
+	if enrollment, exists := data[MFAEnrollmentRequired]; exists && enrollment != nil {
+		claims[MFAEnrollmentRequired] = enrollment
+	}
+
 	return claims
 }
 
@@ -182,46 +190,66 @@ func extractBasicUserInfo(
 }
 
 // extractMembershipInfo 提取成员关系信息（组织ID、部门ID）
-// 该函数负责从成员关系列表中查找主要成员关系，并提取组织ID和部门ID。
-// 函数会遍历所有成员关系，寻找标记为主要成员关系的记录，并从中提取组织和部门信息。
-// 如果没有找到主要成员关系，则不会向用户数据映射中添加任何组织或部门信息。
+// 该函数负责从成员关系列表中查找当前生效组织对应的成员关系，并提取组织ID和部门ID。
+// 指定了生效组织时使用该组织的成员关系，否则使用标记为主要成员关系的记录。
+// 如果没有找到匹配的成员关系，则不会向用户数据映射中添加任何组织或部门信息。
 //
 // 参数:
 //   - memberships: 成员关系列表，可能为nil或空切片
+//   - activeOrganizationID: 当前生效的组织ID，为空时使用主要成员关系
 //   - userData: 目标数据映射，用于存储提取的成员关系信息
 //
 // 示例:
 //
 //	userData := make(map[string]interface{})
-//	extractMembershipInfo(loginResp.Memberships, userData)
-//	// userData 现在包含组织ID和部门ID（如果找到主要成员关系且这些字段非空）
+//	extractMembershipInfo(loginResp.Memberships, loginResp.GetActiveOrganizationID(), userData)
+//	// userData 现在包含组织ID和部门ID（如果找到匹配的成员关系且这些字段非空）
 func extractMembershipInfo(
 	memberships []*identity.UserMembershipDTO,
+	activeOrganizationID string,
 	userData map[string]interface{},
 ) {
-	// 检查成员关系列表是否为nil，如果为nil则直接返回
-	if memberships == nil {
-		return
-	}
+	var matched *identity.UserMembershipDTO
 
-	// 遍历所有成员关系，寻找主要成员关系
+	// 遍历所有成员关系，优先匹配生效组织，否则使用主要成员关系
 	for _, membership := range memberships {
-		// 检查成员关系对象是否为nil，以及是否标记为主要成员关系
-		if membership != nil && membership.IsPrimary != nil && *membership.IsPrimary {
-			// 提取组织ID，确保非空才添加到映射中
-			if membership.OrganizationID != nil {
-				userData[OrganizationID] = *membership.OrganizationID
-			}
+		if membership == nil {
+			continue
+		}
 
-			// 提取部门ID，确保非空才添加到映射中
-			if membership.DepartmentID != nil {
-				userData[DepartmentID] = *membership.DepartmentID
+		if activeOrganizationID != "" {
+			if membership.GetOrganizationID() == activeOrganizationID {
+				matched = membership
+				break
 			}
 
-			// 找到主要成员关系后跳出循环，避免重复处理
+			continue
+		}
+
+		if membership.GetIsPrimary() {
+			matched = membership
 			break
 		}
 	}
+
+	if matched == nil {
+		// 生效组织可能没有对应的成员关系记录（如全局授权），仍以其作为令牌的组织
+		if activeOrganizationID != "" {
+			userData[OrganizationID] = activeOrganizationID
+		}
+
+		return
+	}
+
+	// 提取组织ID，确保非空才添加到映射中
+	if matched.OrganizationID != nil {
+		userData[OrganizationID] = *matched.OrganizationID
+	}
+
+	// 提取部门ID，确保非空才添加到映射中
+	if matched.DepartmentID != nil {
+		userData[DepartmentID] = *matched.DepartmentID
+	}
 }
 
 // extractRoleInfo 提取角色信息（角色ID）
@@ -296,10 +324,15 @@ func buildUserDataMap(
 	extractBasicUserInfo(user, userData, permission)
 
 	// 使用helper函数提取成员关系信息
-	extractMembershipInfo(loginResp.Memberships, userData)
+	extractMembershipInfo(loginResp.Memberships, loginResp.GetActiveOrganizationID(), userData)
 
 	// 使用helper函数提取角色信息
 	extractRoleInfo(loginResp.RoleIDs, userData)
 
+	// 所属角色要求多因素认证但尚未绑定时，令牌仅可用于完成绑定
+	if loginResp.GetMfaEnrollmentRequired() {
+		userData[MFAEnrollmentRequired] = true
+	}
+
 	return userData
 }
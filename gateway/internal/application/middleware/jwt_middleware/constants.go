@@ -2,6 +2,8 @@
 // 基于 github.com/hertz-contrib/jwt 实现高性能JWT认证
 package middleware
 
+import "time"
+
 // JWT claims 中的键名定义
 const (
 	// IdentityKey 表示用户ID (改为使用新的字段名)
@@ -24,10 +26,39 @@ const (
 
 	// CorePermission 表示核心权限
 	CorePermission = "corePermission"
+
+	// TokenFamilyID 表示令牌族ID（一次登录会话），用于会话吊销与刷新令牌轮换
+	TokenFamilyID = "tokenFamilyID"
+
+	// MFAEnrollmentRequired 表示所属角色要求多因素认证但用户尚未绑定，此时仅允许访问绑定相关接口
+	MFAEnrollmentRequired = "mfaEnrollmentRequired"
 )
 
 // Context中存储登录用户信息的键名
 const (
 	// LoginUserContextKey 在 Context 中存储登录用户信息的键名
 	LoginUserContextKey = "login_user_info"
+
+	// LoginDataContextKey 在 Context 中存储访问令牌载荷数据的键名，供签发刷新令牌使用
+	LoginDataContextKey = "login_token_data"
+
+	// authErrorContextKey 在 Context 中存储授权失败具体原因的键名
+	authErrorContextKey = "jwt_auth_error"
 )
+
+// 多因素认证挑战配置
+const (
+	// mfaChallengeTTL 挑战令牌有效期，超时需重新输入密码
+	mfaChallengeTTL = 5 * time.Minute
+
+	// mfaChallengeMaxAttempts 同一挑战允许的最大失败次数，超过后挑战作废
+	mfaChallengeMaxAttempts = 5
+)
+
+// mfaEnrollmentAllowedPaths 待绑定多因素认证的用户可访问的路径
+var mfaEnrollmentAllowedPaths = map[string]struct{}{
+	"/api/v1/identity/auth/mfa/enroll":         {},
+	"/api/v1/identity/auth/mfa/enroll/confirm": {},
+	"/api/v1/identity/auth/logout":             {},
+	"/api/v1/identity/users/me":                {},
+}
@@ -28,6 +28,53 @@ func createTokenInfo(token string, expire time.Time) *http_base.TokenInfoDTO {
 	}
 }
 
+// withRefreshToken 在Token信息中附加刷新令牌
+func withRefreshToken(
+	tokenInfo *http_base.TokenInfoDTO,
+	refreshToken string,
+	refreshExpire time.Time,
+) *http_base.TokenInfoDTO {
+	refreshExpiresIn := int64(time.Until(refreshExpire).Seconds())
+
+	tokenInfo.RefreshToken = &refreshToken
+	tokenInfo.RefreshExpiresIn = &refreshExpiresIn
+
+	return tokenInfo
+}
+
+// newLoginResponseHandler 创建登录响应处理函数
+// 访问令牌签发后为本次登录创建令牌族（登录会话），记录设备、IP等会话元数据并签发刷新令牌
+func newLoginResponseHandler(
+	refreshTokens *refreshTokenManager,
+	logger *hertzZerolog.Logger,
+) func(ctx context.Context, c *app.RequestContext, code int, token string, expire time.Time) {
+	return func(ctx context.Context, c *app.RequestContext, code int, token string, expire time.Time) {
+		loginData, _ := c.Get(LoginDataContextKey)
+
+		userData, ok := loginData.(map[string]interface{})
+		if !ok {
+			logger.Errorf("Login token data not found in context")
+			errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+			return
+		}
+
+		refreshToken, refreshExpire, err := refreshTokens.startFamily(
+			ctx,
+			userData,
+			sessionMetadataFromRequest(c),
+		)
+		if err != nil {
+			logger.Errorf("Failed to issue refresh token: %v", err)
+			errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+			return
+		}
+
+		loginResponseHandler(ctx, c, code, token, expire, refreshToken, refreshExpire)
+	}
+}
+
 // loginResponseHandler 登录响应处理函数
 func loginResponseHandler(
 	_ context.Context,
@@ -35,9 +82,11 @@ func loginResponseHandler(
 	_ int,
 	token string,
 	expire time.Time,
+	refreshToken string,
+	refreshExpire time.Time,
 ) {
 	// 构造Token信息
-	tokenInfo := createTokenInfo(token, expire)
+	tokenInfo := withRefreshToken(createTokenInfo(token, expire), refreshToken, refreshExpire)
 
 	// 从context中获取登录响应
 	if userVal, exists := c.Get(LoginUserContextKey); exists {
@@ -80,9 +129,11 @@ func refreshResponseHandler(
 	_ int,
 	token string,
 	expire time.Time,
+	refreshToken string,
+	refreshExpire time.Time,
 ) {
 	// 构造新的Token信息
-	tokenInfo := createTokenInfo(token, expire)
+	tokenInfo := withRefreshToken(createTokenInfo(token, expire), refreshToken, refreshExpire)
 
 	// 构造刷新Token响应
 	response := &identity.RefreshTokenResponseDTO{
@@ -144,6 +195,17 @@ func customHTTPStatusMessageFunc(
 		apiError = errors.ErrJWTCreationFail
 
 		logger.Warnf("Token creation failed: error=%v", e)
+	case jwt.ErrForbidden:
+		// 授权失败：优先使用授权函数记录的具体原因（如会话已吊销）
+		apiError = errors.ErrJWTValidationFail
+
+		if authErr, exists := c.Get(authErrorContextKey); exists {
+			if bizErr, ok := authErr.(errors.APIError); ok {
+				apiError = bizErr
+			}
+		}
+
+		logger.Debugf("Authorization failed: error=%v", apiError)
 	default:
 		// 检查是否是项目内部的业务错误
 		if bizErr, ok := e.(errors.APIError); ok {
@@ -168,6 +230,7 @@ func customHTTPStatusMessageFunc(
 	}
 
 	// 直接写入响应
+	errors.SetErrorCode(c, apiError.Code())
 	c.JSON(httpStatus, response)
 	c.Abort()
 
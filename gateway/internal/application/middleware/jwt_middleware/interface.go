@@ -12,10 +12,15 @@ type JWTMiddlewareService interface {
 	// 认证中间件
 	MiddlewareFunc() app.HandlerFunc
 
+	// 身份传递中间件，需注册在认证中间件之后
+	IdentityPropagationFunc() app.HandlerFunc
+
 	// 处理器方法
 	LoginHandler(ctx context.Context, c *app.RequestContext)
 	LogoutHandler(ctx context.Context, c *app.RequestContext)
 	RefreshHandler(ctx context.Context, c *app.RequestContext)
+	MFAVerifyHandler(ctx context.Context, c *app.RequestContext)
+	SwitchOrganizationHandler(ctx context.Context, c *app.RequestContext)
 }
 
 // TokenCacheService Token缓存服务接口（直接使用redis包的接口）
@@ -0,0 +1,193 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/identity"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/redis"
+)
+
+// mfaChallengeTokenBytes 挑战令牌随机字节数
+const mfaChallengeTokenBytes = 32
+
+// generateMFAChallengeToken 生成不透明挑战令牌
+func generateMFAChallengeToken() (string, error) {
+	buf := make([]byte, mfaChallengeTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("生成挑战令牌失败: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// startMFAChallenge 密码校验通过但需要多因素认证时，暂存登录结果并返回挑战令牌
+// 此时不签发任何访问令牌或刷新令牌
+func (m *JWTMiddlewareImpl) startMFAChallenge(
+	ctx context.Context,
+	c *app.RequestContext,
+	loginResp *identity.LoginResponseDTO,
+	userData map[string]interface{},
+) {
+	userID, _ := userData[IdentityKey].(string)
+
+	loginData, err := json.Marshal(userData)
+	if err != nil {
+		m.logger.Errorf("Failed to encode login data for MFA challenge: %v", err)
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	loginResponse, err := json.Marshal(loginResp)
+	if err != nil {
+		m.logger.Errorf("Failed to encode login response for MFA challenge: %v", err)
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	challengeToken, err := generateMFAChallengeToken()
+	if err != nil {
+		m.logger.Errorf("Failed to generate MFA challenge token: %v", err)
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	challenge := &redis.MFAChallenge{
+		UserID:        userID,
+		LoginData:     string(loginData),
+		LoginResponse: string(loginResponse),
+	}
+	if err := m.tokenCache.SaveMFAChallenge(ctx, challengeToken, challenge, mfaChallengeTTL); err != nil {
+		errors.AbortWithError(c, errors.ErrInternal)
+		return
+	}
+
+	mfaRequired := true
+	expiresIn := int64(mfaChallengeTTL.Seconds())
+
+	c.JSON(http.StatusOK, &identity.LoginResponseDTO{
+		BaseResp:    loginResp.BaseResp,
+		MfaRequired: &mfaRequired,
+		MfaChallenge: &identity.MFAChallengeDTO{
+			ChallengeToken: &challengeToken,
+			ExpiresIn:      &expiresIn,
+		},
+	})
+}
+
+// MFAVerifyHandler 处理登录第二步的多因素认证
+// 校验挑战令牌与验证码，通过后按登录时的结果签发访问令牌与刷新令牌；
+// 同一挑战失败次数过多或账户被锁定时挑战作废，需重新输入密码
+func (m *JWTMiddlewareImpl) MFAVerifyHandler(ctx context.Context, c *app.RequestContext) {
+	var req identity.MFAVerifyRequestDTO
+	if err := c.BindAndValidate(&req); err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	challengeToken := req.GetChallengeToken()
+
+	challenge, err := m.tokenCache.GetMFAChallenge(ctx, challengeToken)
+	if err != nil {
+		m.logger.Errorf("Failed to load MFA challenge: %v", err)
+		errors.AbortWithError(c, errors.ErrInternal)
+
+		return
+	}
+
+	if challenge == nil {
+		errors.AbortWithError(c, errors.ErrMFAChallengeInvalid)
+		return
+	}
+
+	if err := m.mfaService.VerifyMFA(ctx, challenge.UserID, req.GetCode()); err != nil {
+		m.handleMFAVerifyFailure(ctx, challengeToken, challenge.UserID, err)
+		errors.HandleServiceError(c, err, "多因素认证失败")
+
+		return
+	}
+
+	// 以删除结果作为兑换凭据，同一挑战只能签发一次令牌
+	consumed, err := m.tokenCache.DeleteMFAChallenge(ctx, challengeToken)
+	if err != nil {
+		m.logger.Errorf("Failed to consume MFA challenge: %v", err)
+		errors.AbortWithError(c, errors.ErrInternal)
+
+		return
+	}
+
+	if !consumed {
+		errors.AbortWithError(c, errors.ErrMFAChallengeInvalid)
+		return
+	}
+
+	var (
+		userData  map[string]interface{}
+		loginResp identity.LoginResponseDTO
+	)
+
+	if err := json.Unmarshal([]byte(challenge.LoginData), &userData); err != nil {
+		m.logger.Errorf("Failed to decode MFA challenge login data: error=%v, userID=%s", err, challenge.UserID)
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	if err := json.Unmarshal([]byte(challenge.LoginResponse), &loginResp); err != nil {
+		m.logger.Errorf("Failed to decode MFA challenge login response: error=%v, userID=%s", err, challenge.UserID)
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	loginResp.MfaRequired = nil
+
+	c.Set(LoginUserContextKey, &loginResp)
+	c.Set(LoginDataContextKey, userData)
+
+	m.issueLoginTokens(ctx, c, userData)
+}
+
+// handleMFAVerifyFailure 记录挑战的失败次数，超过上限或账户被锁定时作废挑战
+func (m *JWTMiddlewareImpl) handleMFAVerifyFailure(
+	ctx context.Context,
+	challengeToken string,
+	userID string,
+	verifyErr error,
+) {
+	apiErr, ok := verifyErr.(errors.APIError)
+	if !ok {
+		return
+	}
+
+	switch apiErr.Code() {
+	case errors.CodeRPCInvalidMFACode:
+		attempts, err := m.tokenCache.IncrMFAChallengeAttempts(ctx, challengeToken)
+		if err != nil {
+			m.logger.Warnf("Failed to record MFA challenge attempt: error=%v, userID=%s", err, userID)
+			return
+		}
+
+		if attempts < mfaChallengeMaxAttempts {
+			return
+		}
+
+		m.logger.Warnf("MFA challenge discarded after too many attempts: userID=%s", userID)
+	case errors.CodeRPCUserLocked:
+	default:
+		return
+	}
+
+	if _, err := m.tokenCache.DeleteMFAChallenge(ctx, challengeToken); err != nil {
+		m.logger.Warnf("Failed to discard MFA challenge: error=%v, userID=%s", err, userID)
+	}
+}
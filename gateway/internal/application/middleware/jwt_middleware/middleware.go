@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"time"
 
@@ -9,7 +10,11 @@ import (
 	"github.com/hertz-contrib/jwt"
 	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/http_base"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/identity"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/context/auth_context"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/common"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/observability"
+	authservice "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/identity"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/config"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
 )
@@ -20,6 +25,9 @@ type JWTMiddlewareImpl struct {
 	mw             *jwt.HertzJWTMiddleware
 	tokenCache     TokenCacheService
 	tokenExtractor TokenExtractor
+	refreshTokens  *refreshTokenManager
+	mfaService     authservice.MFAService
+	authService    authservice.AuthService
 	logger         *hertzZerolog.Logger
 }
 
@@ -55,9 +63,79 @@ func (m *JWTMiddlewareImpl) MiddlewareFunc() app.HandlerFunc {
 	}
 }
 
+// IdentityPropagationFunc 返回身份传递中间件
+// 将认证通过的用户ID及其当前代表的组织写入 RPC 元信息，下游服务据此解析调用方的数据范围；未认证的请求不写入
+func (m *JWTMiddlewareImpl) IdentityPropagationFunc() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if userID, ok := auth_context.GetCurrentUserProfileID(c); ok {
+			organizationID, _ := auth_context.GetCurrentOrganizationID(c)
+			ctx = auth_context.InjectCallerToContext(ctx, userID, organizationID)
+		}
+
+		c.Next(ctx)
+	}
+}
+
 // LoginHandler 处理登录请求
+// 已启用多因素认证的用户在密码校验通过后仅获得挑战令牌，通过 MFAVerifyHandler 完成校验后才签发令牌
 func (m *JWTMiddlewareImpl) LoginHandler(ctx context.Context, c *app.RequestContext) {
-	m.mw.LoginHandler(ctx, c)
+	data, err := m.mw.Authenticator(ctx, c)
+	if err != nil {
+		m.unauthorized(ctx, c, err)
+		return
+	}
+
+	userData, ok := data.(map[string]interface{})
+	if !ok {
+		m.logger.Errorf("Login token data not found in authenticator result")
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	if userVal, exists := c.Get(LoginUserContextKey); exists {
+		if loginResp, ok := userVal.(*identity.LoginResponseDTO); ok && loginResp.GetMfaRequired() {
+			m.startMFAChallenge(ctx, c, loginResp, userData)
+			return
+		}
+	}
+
+	m.issueLoginTokens(ctx, c, userData)
+}
+
+// issueLoginTokens 签发访问令牌并写入登录响应（同时开启登录会话、签发刷新令牌）
+func (m *JWTMiddlewareImpl) issueLoginTokens(
+	ctx context.Context,
+	c *app.RequestContext,
+	userData map[string]interface{},
+) {
+	token, expire, err := m.mw.TokenGenerator(userData)
+	if err != nil {
+		m.unauthorized(ctx, c, jwt.ErrFailedTokenCreation)
+		return
+	}
+
+	if m.mw.SendCookie {
+		c.SetCookie(
+			m.mw.CookieName,
+			token,
+			int(m.mw.CookieMaxAge.Seconds()),
+			"/",
+			m.mw.CookieDomain,
+			m.mw.CookieSameSite,
+			m.mw.SecureCookie,
+			m.mw.CookieHTTPOnly,
+		)
+	}
+
+	m.mw.LoginResponse(ctx, c, http.StatusOK, token, expire)
+}
+
+// unauthorized 与 hertz-contrib/jwt 保持一致的认证失败处理
+func (m *JWTMiddlewareImpl) unauthorized(ctx context.Context, c *app.RequestContext, err error) {
+	c.Header("WWW-Authenticate", "JWT realm="+m.mw.Realm)
+	c.Abort()
+	m.mw.Unauthorized(ctx, c, http.StatusUnauthorized, m.mw.HTTPStatusMessageFunc(err, ctx, c))
 }
 
 // LogoutHandler 处理登出请求
@@ -83,6 +161,16 @@ func (m *JWTMiddlewareImpl) LogoutHandler(ctx context.Context, c *app.RequestCon
 
 	m.logger.Debugf("JWT claims extracted: claims=%v", claims)
 
+	// 结束当前登录会话，使该会话的刷新令牌失效
+	familyID, hasFamily := extractStringClaim(claims, TokenFamilyID)
+	userID, hasUser := extractStringClaim(claims, IdentityKey)
+
+	if hasFamily && hasUser {
+		if err := m.refreshTokens.revokeFamily(ctx, familyID, userID); err != nil {
+			m.logger.Errorf("Failed to revoke token family during logout: %v", err)
+		}
+	}
+
 	// 获取token过期时间
 	expClaim, exists := claims["exp"]
 	if !exists {
@@ -128,6 +216,7 @@ func (m *JWTMiddlewareImpl) LogoutHandler(ctx context.Context, c *app.RequestCon
 		m.logger.Errorf("Failed to revoke token during logout: %v", err)
 		// 即使吊销失败，也继续返回登出成功
 	} else {
+		observability.RecordTokenRevocation(observability.RevokeReasonLogout, 1)
 		m.logger.Infof("Token successfully revoked during logout")
 	}
 
@@ -136,6 +225,51 @@ func (m *JWTMiddlewareImpl) LogoutHandler(ctx context.Context, c *app.RequestCon
 }
 
 // RefreshHandler 处理刷新Token请求
+// 使用不透明刷新令牌换取新的访问令牌，刷新令牌随之轮换；
+// 已轮换的刷新令牌再次出现时，吊销该用户的全部会话
 func (m *JWTMiddlewareImpl) RefreshHandler(ctx context.Context, c *app.RequestContext) {
-	m.mw.RefreshHandler(ctx, c)
+	var req identity.RefreshTokenRequestDTO
+	if err := c.BindAndValidate(&req); err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	family, refreshToken, refreshExpire, err := m.refreshTokens.rotate(ctx, req.GetRefreshToken())
+	if err != nil {
+		errors.HandleServiceError(c, err, "刷新令牌失败")
+		return
+	}
+
+	// 使用登录时的载荷快照重新签发访问令牌，令牌族ID保持不变
+	var loginData map[string]interface{}
+	if err := json.Unmarshal([]byte(family.Claims), &loginData); err != nil {
+		m.logger.Errorf("Failed to decode token family claims: error=%v, userID=%s", err, family.UserID)
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	token, expire, err := m.mw.TokenGenerator(loginData)
+	if err != nil {
+		m.logger.Errorf("Failed to generate access token on refresh: %v", err)
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	// 与登录保持一致：启用Cookie时同步写入新的访问令牌
+	if m.mw.SendCookie {
+		c.SetCookie(
+			m.mw.CookieName,
+			token,
+			int(m.mw.CookieMaxAge.Seconds()),
+			"/",
+			m.mw.CookieDomain,
+			m.mw.CookieSameSite,
+			m.mw.SecureCookie,
+			m.mw.CookieHTTPOnly,
+		)
+	}
+
+	refreshResponseHandler(ctx, c, http.StatusOK, token, expire, refreshToken, refreshExpire)
 }
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/hertz-contrib/jwt"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/identity"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+)
+
+// SwitchOrganizationHandler 处理切换组织请求
+// 按用户在目标组织的角色重新计算菜单与权限，签发代表该组织的新访问令牌；
+// 登录会话（令牌族）保持不变，载荷快照随之更新，后续刷新签发的访问令牌同样代表新组织，
+// 切换前的访问令牌立即吊销
+func (m *JWTMiddlewareImpl) SwitchOrganizationHandler(ctx context.Context, c *app.RequestContext) {
+	var req identity.SwitchOrganizationRequestDTO
+	if err := c.BindAndValidate(&req); err != nil {
+		errors.AbortWithError(c, errors.ErrInvalidParams.WithMessage(err.Error()))
+		return
+	}
+
+	claims := jwt.ExtractClaims(ctx, c)
+	userID, hasUser := extractStringClaim(claims, IdentityKey)
+	familyID, hasFamily := extractStringClaim(claims, TokenFamilyID)
+
+	if !hasUser || !hasFamily {
+		errors.AbortWithError(c, errors.ErrUnauthorized)
+		return
+	}
+
+	resp, permission, err := m.authService.SwitchOrganization(ctx, userID, req.GetOrganizationID())
+	if err != nil {
+		errors.HandleServiceError(c, err, "切换组织失败")
+		return
+	}
+
+	userData := buildUserDataMap(resp, string(permission))
+	userData[TokenFamilyID] = familyID
+
+	loginData, err := json.Marshal(userData)
+	if err != nil {
+		m.logger.Errorf("Failed to encode login data on organization switch: %v", err)
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	updated, err := m.tokenCache.UpdateTokenFamilyClaims(ctx, familyID, string(loginData))
+	if err != nil {
+		m.logger.Errorf("Failed to update token family claims: error=%v, userID=%s", err, userID)
+		errors.AbortWithError(c, errors.ErrInternal)
+
+		return
+	}
+
+	// 令牌族已被吊销（并发登出或管理员踢出），不再签发新令牌
+	if !updated {
+		errors.AbortWithError(c, errors.ErrJWTTokenRevoked)
+		return
+	}
+
+	token, expire, err := m.mw.TokenGenerator(userData)
+	if err != nil {
+		m.logger.Errorf("Failed to generate access token on organization switch: %v", err)
+		errors.AbortWithError(c, errors.ErrJWTCreationFail)
+
+		return
+	}
+
+	m.revokeCurrentToken(ctx, c, claims)
+
+	if m.mw.SendCookie {
+		c.SetCookie(
+			m.mw.CookieName,
+			token,
+			int(m.mw.CookieMaxAge.Seconds()),
+			"/",
+			m.mw.CookieDomain,
+			m.mw.CookieSameSite,
+			m.mw.SecureCookie,
+			m.mw.CookieHTTPOnly,
+		)
+	}
+
+	// 刷新令牌不随切换轮换，响应中只包含新的访问令牌
+	resp.TokenInfo = createTokenInfo(token, expire)
+	c.JSON(http.StatusOK, resp)
+}
+
+// revokeCurrentToken 吊销本次请求携带的访问令牌，失败时仅记录日志
+func (m *JWTMiddlewareImpl) revokeCurrentToken(
+	ctx context.Context,
+	c *app.RequestContext,
+	claims jwt.MapClaims,
+) {
+	tokenString := m.tokenExtractor.ExtractToken(c)
+	exp, ok := extractInt64Claim(claims, "exp")
+
+	if tokenString == "" || !ok {
+		return
+	}
+
+	remaining := time.Until(time.Unix(exp, 0))
+	if remaining <= 0 {
+		return
+	}
+
+	if err := m.tokenCache.RevokeToken(ctx, tokenString, remaining); err != nil {
+		m.logger.Warnf("Failed to revoke previous access token on organization switch: %v", err)
+	}
+}
@@ -80,7 +80,7 @@ func parseSameSite(sameSite string) int {
 // JWTMiddlewareProvider 创建JWT中间件实例
 // 这是依赖注入的入口函数，负责创建和配置JWT中间件
 func JWTMiddlewareProvider(
-	authService authservice.AuthService,
+	identityService authservice.Service,
 	jwtConfig *config.JWTConfig,
 	tokenCache TokenCacheService,
 	logger *hertzZerolog.Logger,
@@ -99,6 +99,9 @@ func JWTMiddlewareProvider(
 	// 创建 Token 提取器
 	tokenExtractor := NewDefaultTokenExtractor(jwtConfig)
 
+	// 创建刷新令牌管理器，令牌族有效期即 MaxRefresh
+	refreshTokens := newRefreshTokenManager(tokenCache, jwtConfig.MaxRefresh, logger)
+
 	// 创建 HTTP 状态消息处理函数（适配 hertz-contrib/jwt 的接口）
 	// hertz-contrib/jwt 的 HTTPStatusMessageFunc 签名是：
 	// func(e error, ctx context.Context, c *app.RequestContext) string
@@ -139,20 +142,19 @@ func JWTMiddlewareProvider(
 		// 核心处理函数
 		PayloadFunc:     payloadFunc,
 		IdentityHandler: identityHandler,
-		Authenticator:   authenticatorWithoutAbort(authService),
-		Authorizator:    authorizator,
+		Authenticator:   authenticatorWithoutAbort(identityService),
+		Authorizator:    newSessionAuthorizator(refreshTokens, logger),
 
 		// 关键：使用自定义的HTTP状态消息函数
 		HTTPStatusMessageFunc: httpStatusMessageFunc,
 
 		// 未认证处理
 		Unauthorized: unauthorizedHandler,
-		// 登录响应处理
-		LoginResponse: loginResponseHandler,
+		// 登录响应处理（同时签发刷新令牌）
+		LoginResponse: newLoginResponseHandler(refreshTokens, logger),
 		// 登出响应处理
 		LogoutResponse: logoutResponseHandler,
-		// 刷新Token响应处理
-		RefreshResponse: refreshResponseHandler,
+		// 刷新Token由 RefreshHandler 基于轮换刷新令牌自行处理
 	})
 	if err != nil {
 		return nil, fmt.Errorf("创建JWT中间件失败: %w", err)
@@ -169,6 +171,9 @@ func JWTMiddlewareProvider(
 		mw:             mw,
 		tokenCache:     tokenCache,
 		tokenExtractor: tokenExtractor,
+		refreshTokens:  refreshTokens,
+		mfaService:     identityService,
+		authService:    identityService,
 		logger:         logger,
 	}, nil
 }
@@ -0,0 +1,196 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/observability"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/redis"
+)
+
+// refreshTokenBytes 刷新令牌随机字节数
+const refreshTokenBytes = 32
+
+// refreshTokenManager 刷新令牌管理器
+// 刷新令牌为不透明随机串，仅以哈希形式存储在 Redis 中；每次刷新都会轮换，
+// 已轮换的令牌再次出现时视为泄露，吊销该用户的全部会话
+type refreshTokenManager struct {
+	tokenCache TokenCacheService
+	lifetime   time.Duration // 令牌族的绝对有效期，到期后必须重新登录
+	logger     *hertzZerolog.Logger
+}
+
+// newRefreshTokenManager 创建刷新令牌管理器
+func newRefreshTokenManager(
+	tokenCache TokenCacheService,
+	lifetime time.Duration,
+	logger *hertzZerolog.Logger,
+) *refreshTokenManager {
+	return &refreshTokenManager{
+		tokenCache: tokenCache,
+		lifetime:   lifetime,
+		logger:     logger,
+	}
+}
+
+// generateRefreshToken 生成不透明刷新令牌
+func generateRefreshToken() (string, error) {
+	buf := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("生成刷新令牌失败: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// startFamily 登录成功后创建令牌族（登录会话）并签发第一个刷新令牌
+// loginData 为签发访问令牌的载荷数据，刷新时据此重新签发访问令牌
+func (rm *refreshTokenManager) startFamily(
+	ctx context.Context,
+	loginData map[string]interface{},
+	metadata sessionMetadata,
+) (string, time.Time, error) {
+	familyID, _ := loginData[TokenFamilyID].(string)
+	userID, _ := loginData[IdentityKey].(string)
+
+	if familyID == "" || userID == "" {
+		return "", time.Time{}, fmt.Errorf("登录载荷缺少令牌族ID或用户ID")
+	}
+
+	claims, err := json.Marshal(loginData)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("序列化登录载荷失败: %w", err)
+	}
+
+	now := time.Now()
+	expireAt := now.Add(rm.lifetime)
+	family := &redis.TokenFamily{
+		FamilyID:   familyID,
+		UserID:     userID,
+		Claims:     string(claims),
+		Device:     metadata.device,
+		IPAddress:  metadata.ipAddress,
+		UserAgent:  metadata.userAgent,
+		CreatedAt:  now.Unix(),
+		LastSeenAt: now.Unix(),
+		ExpiresAt:  expireAt.Unix(),
+	}
+
+	if err := rm.tokenCache.SaveTokenFamily(ctx, family, rm.lifetime); err != nil {
+		return "", time.Time{}, err
+	}
+
+	refreshToken, err := rm.issue(ctx, family, rm.lifetime)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return refreshToken, expireAt, nil
+}
+
+// rotate 消费刷新令牌并签发新的刷新令牌
+// 返回令牌族（用于重新签发访问令牌）、新的刷新令牌及其过期时间；返回的错误均为 APIError
+func (rm *refreshTokenManager) rotate(
+	ctx context.Context,
+	refreshToken string,
+) (*redis.TokenFamily, string, time.Time, error) {
+	record, err := rm.tokenCache.ConsumeRefreshToken(ctx, refreshToken, rm.lifetime)
+
+	switch err {
+	case nil:
+	case redis.ErrRefreshTokenReused:
+		rm.handleReuse(ctx, record)
+		return nil, "", time.Time{}, errors.ErrRefreshTokenReused
+	case redis.ErrRefreshTokenNotFound:
+		return nil, "", time.Time{}, errors.ErrRefreshTokenInvalid
+	default:
+		rm.logger.Errorf("Failed to consume refresh token: %v", err)
+		return nil, "", time.Time{}, errors.ErrInternal
+	}
+
+	family, err := rm.tokenCache.GetTokenFamily(ctx, record.FamilyID)
+	if err != nil {
+		rm.logger.Errorf("Failed to load token family: error=%v, userID=%s", err, record.UserID)
+		return nil, "", time.Time{}, errors.ErrInternal
+	}
+
+	// 令牌族已被吊销或过期（登出、管理员踢出、重放检测等）
+	if family == nil {
+		return nil, "", time.Time{}, errors.ErrRefreshTokenInvalid
+	}
+
+	// 新令牌与令牌族同时过期，刷新不会延长会话的绝对有效期
+	expireAt := time.Unix(family.CreatedAt, 0).Add(rm.lifetime)
+
+	remaining := time.Until(expireAt)
+	if remaining <= 0 {
+		return nil, "", time.Time{}, errors.ErrRefreshTokenInvalid
+	}
+
+	newToken, err := rm.issue(ctx, family, remaining)
+	if err != nil {
+		rm.logger.Errorf("Failed to issue refresh token: error=%v, userID=%s", err, record.UserID)
+		return nil, "", time.Time{}, errors.ErrJWTCreationFail
+	}
+
+	return family, newToken, expireAt, nil
+}
+
+// issue 为令牌族签发新的刷新令牌
+func (rm *refreshTokenManager) issue(
+	ctx context.Context,
+	family *redis.TokenFamily,
+	expiration time.Duration,
+) (string, error) {
+	refreshToken, err := generateRefreshToken()
+	if err != nil {
+		return "", err
+	}
+
+	record := &redis.RefreshTokenRecord{
+		FamilyID: family.FamilyID,
+		UserID:   family.UserID,
+	}
+
+	if err := rm.tokenCache.StoreRefreshToken(ctx, refreshToken, record, expiration); err != nil {
+		return "", err
+	}
+
+	return refreshToken, nil
+}
+
+// handleReuse 处理刷新令牌重放：吊销令牌族及该用户的所有会话
+func (rm *refreshTokenManager) handleReuse(ctx context.Context, record *redis.RefreshTokenRecord) {
+	rm.logger.Warnf(
+		"Refresh token reuse detected, revoking all sessions: userID=%s, familyID=%s",
+		record.UserID,
+		record.FamilyID,
+	)
+
+	if err := rm.tokenCache.RevokeTokenFamily(ctx, record.FamilyID, record.UserID); err != nil {
+		rm.logger.Errorf("Failed to revoke token family on reuse: %v", err)
+	}
+
+	if err := rm.tokenCache.RemoveUserTokens(ctx, record.UserID); err != nil {
+		rm.logger.Errorf("Failed to remove user tokens on reuse: %v", err)
+		return
+	}
+
+	observability.RecordTokenRevocation(observability.RevokeReasonRefreshReuse, 1)
+}
+
+// revokeFamily 吊销访问令牌所属的令牌族（登出时调用）
+func (rm *refreshTokenManager) revokeFamily(ctx context.Context, familyID, userID string) error {
+	return rm.tokenCache.RevokeTokenFamily(ctx, familyID, userID)
+}
+
+// touchFamily 检查访问令牌所属的令牌族是否有效，并记录会话最近活跃时间
+func (rm *refreshTokenManager) touchFamily(ctx context.Context, familyID string) (bool, error) {
+	return rm.tokenCache.TouchTokenFamily(ctx, familyID)
+}
@@ -0,0 +1,186 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryTokenCache 按 TokenCacheService 约定在内存中保存令牌族与刷新令牌，未实现的方法调用时 panic
+type memoryTokenCache struct {
+	TokenCacheService
+
+	families map[string]*redis.TokenFamily
+	refresh  map[string]*redis.RefreshTokenRecord
+	used     map[string]*redis.RefreshTokenRecord
+}
+
+func newMemoryTokenCache() *memoryTokenCache {
+	return &memoryTokenCache{
+		families: make(map[string]*redis.TokenFamily),
+		refresh:  make(map[string]*redis.RefreshTokenRecord),
+		used:     make(map[string]*redis.RefreshTokenRecord),
+	}
+}
+
+func (c *memoryTokenCache) SaveTokenFamily(_ context.Context, family *redis.TokenFamily, _ time.Duration) error {
+	saved := *family
+	c.families[family.FamilyID] = &saved
+
+	return nil
+}
+
+func (c *memoryTokenCache) GetTokenFamily(_ context.Context, familyID string) (*redis.TokenFamily, error) {
+	family, ok := c.families[familyID]
+	if !ok {
+		return nil, nil
+	}
+
+	loaded := *family
+
+	return &loaded, nil
+}
+
+func (c *memoryTokenCache) RevokeTokenFamily(_ context.Context, familyID, _ string) error {
+	delete(c.families, familyID)
+	return nil
+}
+
+// RemoveUserTokens 删除用户全部令牌族及其未消费的刷新令牌
+func (c *memoryTokenCache) RemoveUserTokens(_ context.Context, userID string) error {
+	for familyID, family := range c.families {
+		if family.UserID == userID {
+			delete(c.families, familyID)
+		}
+	}
+
+	for token, record := range c.refresh {
+		if record.UserID == userID {
+			delete(c.refresh, token)
+		}
+	}
+
+	return nil
+}
+
+func (c *memoryTokenCache) StoreRefreshToken(
+	_ context.Context,
+	refreshToken string,
+	record *redis.RefreshTokenRecord,
+	_ time.Duration,
+) error {
+	c.refresh[refreshToken] = record
+	return nil
+}
+
+func (c *memoryTokenCache) ConsumeRefreshToken(
+	_ context.Context,
+	refreshToken string,
+	_ time.Duration,
+) (*redis.RefreshTokenRecord, error) {
+	if record, ok := c.refresh[refreshToken]; ok {
+		delete(c.refresh, refreshToken)
+		c.used[refreshToken] = record
+
+		return record, nil
+	}
+
+	if record, ok := c.used[refreshToken]; ok {
+		return record, redis.ErrRefreshTokenReused
+	}
+
+	return nil, redis.ErrRefreshTokenNotFound
+}
+
+func startTestFamily(t *testing.T, rm *refreshTokenManager) string {
+	t.Helper()
+
+	return startUserFamily(t, rm, "user-1", "family-1")
+}
+
+// startUserFamily 为指定用户登录创建令牌族，返回首个刷新令牌
+func startUserFamily(t *testing.T, rm *refreshTokenManager, userID, familyID string) string {
+	t.Helper()
+
+	refreshToken, _, err := rm.startFamily(context.Background(), map[string]interface{}{
+		IdentityKey:   userID,
+		TokenFamilyID: familyID,
+	}, sessionMetadata{})
+	require.NoError(t, err)
+
+	return refreshToken
+}
+
+func TestRefreshTokenRotate(t *testing.T) {
+	rm := newRefreshTokenManager(newMemoryTokenCache(), time.Hour, hertzZerolog.New())
+	refreshToken := startTestFamily(t, rm)
+
+	family, newToken, expireAt, err := rm.rotate(context.Background(), refreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, "family-1", family.FamilyID)
+	assert.NotEmpty(t, newToken)
+	assert.NotEqual(t, refreshToken, newToken)
+	assert.True(t, expireAt.After(time.Now()))
+}
+
+func TestRefreshTokenRotate_AfterRevokeFamily(t *testing.T) {
+	ctx := context.Background()
+	rm := newRefreshTokenManager(newMemoryTokenCache(), time.Hour, hertzZerolog.New())
+	refreshToken := startTestFamily(t, rm)
+
+	require.NoError(t, rm.revokeFamily(ctx, "family-1", "user-1"))
+
+	family, newToken, _, err := rm.rotate(ctx, refreshToken)
+	assert.Equal(t, errors.ErrRefreshTokenInvalid, err)
+	assert.Nil(t, family)
+	assert.Empty(t, newToken)
+}
+
+func TestRefreshTokenRotate_ReuseRevokesAllSessions(t *testing.T) {
+	ctx := context.Background()
+	cache := newMemoryTokenCache()
+	rm := newRefreshTokenManager(cache, time.Hour, hertzZerolog.New())
+
+	stolen := startUserFamily(t, rm, "user-1", "family-1")
+	otherDevice := startUserFamily(t, rm, "user-1", "family-2")
+	otherUser := startUserFamily(t, rm, "user-2", "family-3")
+
+	// 合法客户端先完成轮换，随后攻击者提交已轮换的旧令牌
+	_, rotated, _, err := rm.rotate(ctx, stolen)
+	require.NoError(t, err)
+
+	family, newToken, _, err := rm.rotate(ctx, stolen)
+	assert.Equal(t, errors.ErrRefreshTokenReused, err)
+	assert.Nil(t, family)
+	assert.Empty(t, newToken)
+
+	// 该用户全部会话被吊销，包括轮换后的令牌与其他设备的令牌族
+	assert.NotContains(t, cache.families, "family-1")
+	assert.NotContains(t, cache.families, "family-2")
+
+	for _, token := range []string{rotated, otherDevice} {
+		_, _, _, err = rm.rotate(ctx, token)
+		assert.Equal(t, errors.ErrRefreshTokenInvalid, err)
+	}
+
+	// 其他用户的会话不受影响
+	family, _, _, err = rm.rotate(ctx, otherUser)
+	require.NoError(t, err)
+	assert.Equal(t, "family-3", family.FamilyID)
+}
+
+func TestRefreshTokenRotate_UnknownToken(t *testing.T) {
+	rm := newRefreshTokenManager(newMemoryTokenCache(), time.Hour, hertzZerolog.New())
+	startTestFamily(t, rm)
+
+	family, newToken, _, err := rm.rotate(context.Background(), "unknown")
+	assert.Equal(t, errors.ErrRefreshTokenInvalid, err)
+	assert.Nil(t, family)
+	assert.Empty(t, newToken)
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// DeviceNameHeader 客户端上报设备名称的请求头，未上报时根据 User-Agent 推断
+const DeviceNameHeader = "X-Device-Name"
+
+// 会话元数据长度上限，避免客户端写入超长内容
+const (
+	maxDeviceNameLength = 64
+	maxUserAgentLength  = 512
+)
+
+// sessionMetadata 登录会话元数据，在登录时采集
+type sessionMetadata struct {
+	device    string
+	ipAddress string
+	userAgent string
+}
+
+// sessionMetadataFromRequest 从登录请求中采集会话元数据
+func sessionMetadataFromRequest(c *app.RequestContext) sessionMetadata {
+	userAgent := truncate(string(c.UserAgent()), maxUserAgentLength)
+
+	device := strings.TrimSpace(string(c.GetHeader(DeviceNameHeader)))
+	if device == "" {
+		device = detectDevice(userAgent)
+	}
+
+	return sessionMetadata{
+		device:    truncate(device, maxDeviceNameLength),
+		ipAddress: c.ClientIP(),
+		userAgent: userAgent,
+	}
+}
+
+// detectDevice 根据 User-Agent 粗略推断设备平台
+func detectDevice(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+
+	switch {
+	case ua == "":
+		return "Unknown"
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"):
+		return "iOS"
+	case strings.Contains(ua, "android"):
+		return "Android"
+	case strings.Contains(ua, "windows"):
+		return "Windows"
+	case strings.Contains(ua, "mac os"), strings.Contains(ua, "macintosh"):
+		return "macOS"
+	case strings.Contains(ua, "linux"):
+		return "Linux"
+	default:
+		return "Other"
+	}
+}
+
+// truncate 按字符截断字符串
+func truncate(value string, limit int) string {
+	runes := []rune(value)
+	if len(runes) <= limit {
+		return value
+	}
+
+	return string(runes[:limit])
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/google/uuid"
 	"github.com/hertz-contrib/jwt"
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/core"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/http_base"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/identity"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/context/auth_context"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/observability"
 	authservice "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/identity"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
 )
@@ -54,6 +57,10 @@ func identityHandler(ctx context.Context, c *app.RequestContext) interface{} {
 		jwtClaims.Permission = &permissionStr
 	}
 
+	if sessionID, ok := extractStringClaim(claims, TokenFamilyID); ok {
+		jwtClaims.SessionID = &sessionID
+	}
+
 	// 设置JWT标准时间戳声明
 	if expTime, ok := extractInt64Claim(claims, "exp"); ok {
 		jwtClaims.Exp = &expTime
@@ -82,6 +89,18 @@ func checkUserStatusFromClaims(ctx context.Context, c *app.RequestContext) bool
 	return false
 }
 
+// checkMFAEnrollmentFromClaims 检查待绑定多因素认证的令牌是否访问了允许的路径
+func checkMFAEnrollmentFromClaims(ctx context.Context, c *app.RequestContext) bool {
+	claims := jwt.ExtractClaims(ctx, c)
+	if required, ok := claims[MFAEnrollmentRequired].(bool); !ok || !required {
+		return true
+	}
+
+	_, allowed := mfaEnrollmentAllowedPaths[string(c.Path())]
+
+	return allowed
+}
+
 // authorizator 授权函数
 // 检查用户状态是否为激活状态，只有激活用户才能通过授权
 func authorizator(data interface{}, ctx context.Context, c *app.RequestContext) bool {
@@ -90,13 +109,49 @@ func authorizator(data interface{}, ctx context.Context, c *app.RequestContext)
 		return false
 	}
 
-	// 检查Token是否被吊销（需要TokenCacheService，这里通过中间件实例访问）
-	// 注意：由于authorizator是独立函数，无法直接访问tokenCache
-	// 吊销检查将在MiddlewareFunc中处理
+	// 单个Token的吊销检查在MiddlewareFunc中处理
 
 	return true
 }
 
+// newSessionAuthorizator 创建带会话校验的授权函数
+// 在用户状态检查的基础上，校验访问令牌所属的令牌族（登录会话）是否已被吊销
+func newSessionAuthorizator(
+	refreshTokens *refreshTokenManager,
+	logger *hertzZerolog.Logger,
+) func(data interface{}, ctx context.Context, c *app.RequestContext) bool {
+	return func(data interface{}, ctx context.Context, c *app.RequestContext) bool {
+		if !authorizator(data, ctx, c) {
+			return false
+		}
+
+		if !checkMFAEnrollmentFromClaims(ctx, c) {
+			c.Set(authErrorContextKey, errors.ErrMFAEnrollmentNeeded)
+			return false
+		}
+
+		// 未携带令牌族ID的历史令牌仅依赖过期时间
+		familyID, ok := extractStringClaim(jwt.ExtractClaims(ctx, c), TokenFamilyID)
+		if !ok {
+			return true
+		}
+
+		active, err := refreshTokens.touchFamily(ctx, familyID)
+		if err != nil {
+			// 与Token吊销检查保持一致：缓存不可用时放行，避免Redis故障导致全站不可用
+			logger.Warnf("Failed to check token family, skip session check: %v", err)
+			return true
+		}
+
+		if !active {
+			c.Set(authErrorContextKey, errors.ErrJWTTokenRevoked)
+			return false
+		}
+
+		return true
+	}
+}
+
 // authenticatorWithoutAbort 认证函数（不调用AbortWithError）
 // 让JWT中间件通过HTTPStatusMessageFunc统一处理错误响应
 func authenticatorWithoutAbort(
@@ -117,15 +172,20 @@ func authenticatorWithoutAbort(
 		// 调用业务服务层进行身份验证
 		resp, permission, err := authService.Login(ctx, &req)
 		if err != nil {
+			observability.RecordLogin(false)
 			// 直接返回业务错误，不调用HandleServiceError
 			return nil, err
 		}
 
-		// 构造用户信息map
+		observability.RecordLogin(true)
+
+		// 构造用户信息map，每次登录开启一个新的令牌族
 		userData := buildUserDataMap(resp, string(permission))
+		userData[TokenFamilyID] = uuid.NewString()
 
 		// 存储用户信息供LoginResponseHandler使用
 		c.Set(LoginUserContextKey, resp)
+		c.Set(LoginDataContextKey, userData)
 
 		return userData, nil
 	}
@@ -9,6 +9,7 @@ import (
 	corsmw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/cors_middleware"
 	errormw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/error_middleware"
 	jwtmw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/jwt_middleware"
+	ratelimitmw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/ratelimit_middleware"
 	responsemw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/response_middleware"
 	tracemdw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/trace_middleware"
 )
@@ -22,6 +23,7 @@ func DefaultMiddleware(
 	errorMiddleware errormw.ErrorHandlerMiddlewareService,
 	jwtMiddleware jwtmw.JWTMiddlewareService,
 	responseHeaderMiddleware responsemw.ResponseHeaderMiddlewareService,
+	rateLimitMiddleware ratelimitmw.RateLimitMiddlewareService,
 ) {
 	h.Use(
 		requestid.New(),                             // RequestID：生成和传递请求ID
@@ -31,12 +33,13 @@ func DefaultMiddleware(
 		corsMiddleware.MiddlewareFunc(),             // 跨域：处理预检，避免被后续中间件拦截
 		errorMiddleware.MiddlewareFunc(),            // 错误处理：后续所有错误均由其捕获
 		jwtMiddleware.MiddlewareFunc(),              // 认证：解析用户身份，存入上下文
+		jwtMiddleware.IdentityPropagationFunc(),     // 身份传递：将用户ID写入 RPC 元信息
+		rateLimitMiddleware.MiddlewareFunc(),        // 限流：按 IP/用户/路由维度限制请求速率
 		etag.New(),                                  // ETag：计算和验证 ETag
 		// 注意：Casbin 权限校验不在全局注册
 		// 应在需要权限的路由组或路由上使用：
-		// - casbinMiddleware.RequiresPermissions("users:read")  // 推荐
-		// - casbinMiddleware.RequiresRoles("admin")            // 推荐
-		// - casbinMiddleware.MiddlewareFunc()                  // 仅限路由组
+		// - middleware.RequiresPermissions(casbinmw.PermUserRead)  // 推荐
+		// - middleware.RequiresRoles("admin")
 	)
 }
 
@@ -0,0 +1,10 @@
+package middleware
+
+import "github.com/cloudwego/hertz/pkg/app"
+
+// RateLimitMiddlewareService 限流中间件接口
+// 基于 Redis 令牌桶按客户端 IP、已认证用户以及路由维度限制请求速率
+type RateLimitMiddlewareService interface {
+	// MiddlewareFunc 返回中间件函数
+	MiddlewareFunc() app.HandlerFunc
+}
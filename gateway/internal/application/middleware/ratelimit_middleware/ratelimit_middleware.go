@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	authctx "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/context/auth_context"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/config"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/redis"
+)
+
+// 限流响应头
+const (
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderRateLimitReset     = "X-RateLimit-Reset"
+	HeaderRetryAfter         = "Retry-After"
+)
+
+// bucket 单次请求需要消耗令牌的令牌桶
+type bucket struct {
+	key   string
+	rate  float64
+	burst int
+}
+
+// RateLimitMiddleware 限流中间件实现
+type RateLimitMiddleware struct {
+	config  *config.RateLimitConfig
+	limiter redis.RateLimiterService
+	logger  *hertzZerolog.Logger
+}
+
+// NewRateLimitMiddleware 创建限流中间件实例
+func NewRateLimitMiddleware(
+	config *config.RateLimitConfig,
+	limiter redis.RateLimiterService,
+	logger *hertzZerolog.Logger,
+) RateLimitMiddlewareService {
+	return &RateLimitMiddleware{
+		config:  config,
+		limiter: limiter,
+		logger:  logger,
+	}
+}
+
+// MiddlewareFunc 返回中间件处理函数
+// 需注册在 JWT 中间件之后，以便从认证上下文中获取用户身份
+func (m *RateLimitMiddleware) MiddlewareFunc() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		path := string(c.Path())
+
+		if !m.config.Enabled || m.shouldSkipPath(path) {
+			c.Next(ctx)
+			return
+		}
+
+		var result *redis.RateLimitResult
+
+		for _, b := range m.resolveBuckets(c, path) {
+			res, err := m.limiter.Allow(ctx, b.key, b.rate, b.burst)
+			if err != nil {
+				m.logger.Errorf("限流检查失败: key=%s, error=%v", b.key, err)
+
+				if m.config.FailOpen {
+					continue
+				}
+
+				errors.AbortWithErrorMessage(c, errors.ErrServiceDown, "限流服务暂不可用")
+
+				return
+			}
+
+			// 响应头以最严格的令牌桶为准
+			if result == nil || !res.Allowed || res.Remaining < result.Remaining {
+				result = res
+			}
+
+			if !res.Allowed {
+				break
+			}
+		}
+
+		if result == nil {
+			c.Next(ctx)
+			return
+		}
+
+		m.setHeaders(c, result)
+
+		if !result.Allowed {
+			c.Header(HeaderRetryAfter, strconv.Itoa(ceilSeconds(result.RetryAfter)))
+			m.logger.Warnf("请求被限流: ip=%s, method=%s, path=%s",
+				c.ClientIP(), string(c.Method()), path)
+			errors.AbortWithError(c, errors.ErrRateLimited)
+
+			return
+		}
+
+		c.Next(ctx)
+	}
+}
+
+// resolveBuckets 计算本次请求需要检查的令牌桶
+// 登录接口仅按客户端 IP 使用严格限流；其余接口按 IP 限流，已认证请求额外按用户限流
+func (m *RateLimitMiddleware) resolveBuckets(c *app.RequestContext, path string) []bucket {
+	ip := c.ClientIP()
+
+	if m.isLoginPath(path) {
+		login := m.config.Login
+		if login.RequestsPerMinute <= 0 || login.Burst <= 0 {
+			return nil
+		}
+
+		return []bucket{{
+			key:   "login:ip:" + ip + ":" + path,
+			rate:  float64(login.RequestsPerMinute) / 60,
+			burst: login.Burst,
+		}}
+	}
+
+	routeSuffix := ""
+	if m.config.PerRoute {
+		route := c.FullPath()
+		if route == "" {
+			route = path
+		}
+
+		routeSuffix = ":" + string(c.Method()) + ":" + route
+	}
+
+	buckets := make([]bucket, 0, 2)
+
+	if m.config.RequestsPerSecond > 0 && m.config.Burst > 0 {
+		buckets = append(buckets, bucket{
+			key:   "ip:" + ip + routeSuffix,
+			rate:  float64(m.config.RequestsPerSecond),
+			burst: m.config.Burst,
+		})
+	}
+
+	if m.config.UserRequestsPerSecond > 0 && m.config.UserBurst > 0 {
+		if authCtx, ok := authctx.GetAuthContext(c); ok {
+			if userID, ok := authCtx.GetUserProfileID(); ok && userID != "" {
+				buckets = append(buckets, bucket{
+					key:   "user:" + userID + routeSuffix,
+					rate:  float64(m.config.UserRequestsPerSecond),
+					burst: m.config.UserBurst,
+				})
+			}
+		}
+	}
+
+	return buckets
+}
+
+// setHeaders 写入标准限流响应头
+func (m *RateLimitMiddleware) setHeaders(c *app.RequestContext, result *redis.RateLimitResult) {
+	c.Header(HeaderRateLimitLimit, strconv.Itoa(result.Limit))
+	c.Header(HeaderRateLimitRemaining, strconv.Itoa(result.Remaining))
+	c.Header(HeaderRateLimitReset, strconv.Itoa(ceilSeconds(result.ResetAfter)))
+}
+
+// shouldSkipPath 检查是否跳过限流
+func (m *RateLimitMiddleware) shouldSkipPath(path string) bool {
+	for _, skipPath := range m.config.SkipPaths {
+		if path == skipPath {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isLoginPath 检查是否为登录接口
+func (m *RateLimitMiddleware) isLoginPath(path string) bool {
+	for _, loginPath := range m.config.Login.Paths {
+		if path == loginPath {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ceilSeconds 将时长向上取整为秒
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
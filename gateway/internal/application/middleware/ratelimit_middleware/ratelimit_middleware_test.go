@@ -0,0 +1,209 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/http_base"
+	authctx "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/context/auth_context"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/config"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingLimiter 按 Key 在内存中计数的令牌桶，不补充令牌；err 非空时模拟 Redis 不可用
+type countingLimiter struct {
+	used  map[string]int
+	calls []string
+	err   error
+}
+
+func newCountingLimiter() *countingLimiter {
+	return &countingLimiter{used: make(map[string]int)}
+}
+
+func (l *countingLimiter) Allow(_ context.Context, key string, rate float64, burst int) (*redis.RateLimitResult, error) {
+	l.calls = append(l.calls, key)
+
+	if l.err != nil {
+		return nil, l.err
+	}
+
+	refill := time.Duration(float64(time.Second) / rate)
+
+	if l.used[key] >= burst {
+		return &redis.RateLimitResult{
+			Limit:      burst,
+			RetryAfter: refill,
+			ResetAfter: time.Duration(burst) * refill,
+		}, nil
+	}
+
+	l.used[key]++
+
+	return &redis.RateLimitResult{
+		Allowed:    true,
+		Limit:      burst,
+		Remaining:  burst - l.used[key],
+		ResetAfter: time.Duration(l.used[key]) * refill,
+	}, nil
+}
+
+func newTestRateLimitConfig() *config.RateLimitConfig {
+	return &config.RateLimitConfig{
+		Enabled:               true,
+		RequestsPerSecond:     10,
+		Burst:                 3,
+		UserRequestsPerSecond: 5,
+		UserBurst:             2,
+		SkipPaths:             []string{"/health"},
+		Login: config.LoginRateLimitConfig{
+			Paths:             []string{"/api/v1/identity/auth/login"},
+			RequestsPerMinute: 6,
+			Burst:             1,
+		},
+	}
+}
+
+// serveRequest 执行限流中间件，返回请求上下文及是否放行到业务处理器
+// userID 为空时不设置认证上下文
+func serveRequest(handler app.HandlerFunc, path, userID string) (*app.RequestContext, bool) {
+	c := app.NewContext(0)
+	c.Request.SetRequestURI(path)
+	c.Request.Header.SetMethod(http.MethodGet)
+
+	if userID != "" {
+		authctx.SetAuthContext(c, authctx.NewAuthContext(&http_base.JWTClaimsDTO{UserProfileID: &userID}))
+	}
+
+	reached := false
+
+	c.SetHandlers(app.HandlersChain{handler, func(context.Context, *app.RequestContext) {
+		reached = true
+	}})
+	c.Next(context.Background())
+
+	return c, reached
+}
+
+func newTestRateLimitMiddleware(cfg *config.RateLimitConfig) (app.HandlerFunc, *countingLimiter) {
+	limiter := newCountingLimiter()
+
+	return NewRateLimitMiddleware(cfg, limiter, hertzZerolog.New()).MiddlewareFunc(), limiter
+}
+
+func TestRateLimit_RejectsAfterBurst(t *testing.T) {
+	handler, _ := newTestRateLimitMiddleware(newTestRateLimitConfig())
+
+	for i := 0; i < 3; i++ {
+		c, reached := serveRequest(handler, "/api/v1/users", "")
+		require.True(t, reached)
+		assert.Equal(t, "3", string(c.Response.Header.Peek(HeaderRateLimitLimit)))
+		assert.Equal(t, fmt.Sprint(2-i), string(c.Response.Header.Peek(HeaderRateLimitRemaining)))
+	}
+
+	c, reached := serveRequest(handler, "/api/v1/users", "")
+	assert.False(t, reached)
+	assert.Equal(t, errors.GetHTTPStatus(errors.ErrRateLimited.Code()), c.Response.StatusCode())
+	assert.Equal(t, "0", string(c.Response.Header.Peek(HeaderRateLimitRemaining)))
+	assert.Equal(t, "1", string(c.Response.Header.Peek(HeaderRetryAfter)))
+}
+
+func TestRateLimit_UserBucketIsStricter(t *testing.T) {
+	handler, limiter := newTestRateLimitMiddleware(newTestRateLimitConfig())
+
+	for range 2 {
+		c, reached := serveRequest(handler, "/api/v1/users", "user-1")
+		require.True(t, reached)
+		assert.Equal(t, "2", string(c.Response.Header.Peek(HeaderRateLimitLimit)))
+	}
+
+	// 用户令牌桶耗尽后即使 IP 令牌桶仍有余量也拒绝
+	c, reached := serveRequest(handler, "/api/v1/users", "user-1")
+	assert.False(t, reached)
+	assert.Equal(t, "2", string(c.Response.Header.Peek(HeaderRateLimitLimit)))
+	assert.Equal(t, 3, limiter.used[limiter.calls[0]])
+
+	// IP 令牌桶由同一 IP 的所有用户共享，已被上一个用户耗尽
+	_, reached = serveRequest(handler, "/api/v1/users", "user-2")
+	assert.False(t, reached)
+}
+
+func TestRateLimit_LoginUsesDedicatedBucket(t *testing.T) {
+	handler, limiter := newTestRateLimitMiddleware(newTestRateLimitConfig())
+
+	_, reached := serveRequest(handler, "/api/v1/identity/auth/login", "")
+	require.True(t, reached)
+
+	c, reached := serveRequest(handler, "/api/v1/identity/auth/login", "")
+	assert.False(t, reached)
+	assert.Equal(t, "10", string(c.Response.Header.Peek(HeaderRetryAfter)))
+
+	// 登录限流不消耗普通接口的令牌
+	_, reached = serveRequest(handler, "/api/v1/users", "")
+	assert.True(t, reached)
+
+	require.Len(t, limiter.calls, 3)
+	assert.Contains(t, limiter.calls[0], "login:ip:")
+	assert.NotEqual(t, limiter.calls[0], limiter.calls[2])
+}
+
+func TestRateLimit_PerRouteBuckets(t *testing.T) {
+	cfg := newTestRateLimitConfig()
+	cfg.Burst = 1
+	cfg.PerRoute = true
+	handler, _ := newTestRateLimitMiddleware(cfg)
+
+	_, reached := serveRequest(handler, "/api/v1/users", "")
+	require.True(t, reached)
+
+	_, reached = serveRequest(handler, "/api/v1/roles", "")
+	assert.True(t, reached)
+
+	_, reached = serveRequest(handler, "/api/v1/users", "")
+	assert.False(t, reached)
+}
+
+func TestRateLimit_SkipPathAndDisabled(t *testing.T) {
+	cfg := newTestRateLimitConfig()
+	handler, limiter := newTestRateLimitMiddleware(cfg)
+
+	_, reached := serveRequest(handler, "/health", "")
+	assert.True(t, reached)
+
+	cfg.Enabled = false
+
+	_, reached = serveRequest(handler, "/api/v1/users", "")
+	assert.True(t, reached)
+	assert.Empty(t, limiter.calls)
+}
+
+func TestRateLimit_LimiterFailure(t *testing.T) {
+	t.Run("fail closed", func(t *testing.T) {
+		handler, limiter := newTestRateLimitMiddleware(newTestRateLimitConfig())
+		limiter.err = fmt.Errorf("redis unavailable")
+
+		c, reached := serveRequest(handler, "/api/v1/users", "")
+		assert.False(t, reached)
+		assert.Equal(t, errors.GetHTTPStatus(errors.ErrServiceDown.Code()), c.Response.StatusCode())
+	})
+
+	t.Run("fail open", func(t *testing.T) {
+		cfg := newTestRateLimitConfig()
+		cfg.FailOpen = true
+		handler, limiter := newTestRateLimitMiddleware(cfg)
+		limiter.err = fmt.Errorf("redis unavailable")
+
+		c, reached := serveRequest(handler, "/api/v1/users", "user-1")
+		assert.True(t, reached)
+		assert.Len(t, limiter.calls, 2)
+		assert.Empty(t, c.Response.Header.Peek(HeaderRateLimitLimit))
+	})
+}
@@ -39,6 +39,9 @@ func (m *TraceMiddlewareImpl) MiddlewareFunc() app.HandlerFunc {
 		// 将 RequestID 注入到 Go context (metainfo) 供 RPC 调用传播
 		ctx = errors.InjectRequestIDToContext(ctx, requestID)
 
+		// 将客户端IP注入到 metainfo 供 RPC 服务记录审计来源
+		ctx = errors.InjectClientIPToContext(ctx, c.ClientIP())
+
 		// 继续处理请求
 		c.Next(ctx)
 	}
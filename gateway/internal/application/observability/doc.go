@@ -1,3 +1,5 @@
 // Package observability 聚合日志、指标、追踪等可观测性能力。
-// 迭代0仅占位，后续按指标与Trace接入方案补充。
+// 指标基于 Prometheus：HTTP 请求指标由 HTTPMetricsMiddleware 采集，
+// 业务计数器（登录、Token 吊销、权限拒绝）由各业务环节调用 Record* 函数上报，
+// 并通过 StartMetricsServer 在独立端口以 Prometheus 文本格式暴露。
 package observability
@@ -0,0 +1,52 @@
+package observability
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+)
+
+// unmatchedRoute 未匹配到任何路由时使用的路由标签，避免原始路径导致标签基数膨胀
+const unmatchedRoute = "unmatched"
+
+// HTTPMetricsMiddleware 返回 HTTP 请求指标采集中间件
+// 需最先注册，以便统计包括认证失败、限流在内的所有请求
+func HTTPMetricsMiddleware() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		start := time.Now()
+
+		c.Next(ctx)
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		method := string(c.Method())
+		status := c.Response.StatusCode()
+
+		httpRequestsTotal.WithLabelValues(
+			method,
+			route,
+			strconv.Itoa(status),
+			responseCode(c, status),
+		).Inc()
+		httpRequestDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+	}
+}
+
+// responseCode 获取响应的业务错误码标签
+func responseCode(c *app.RequestContext, status int) string {
+	if code, ok := errors.GetErrorCode(c); ok {
+		return strconv.Itoa(int(code))
+	}
+
+	if status < 400 {
+		return strconv.Itoa(int(errors.CodeSuccess))
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,83 @@
+package observability
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// requestHeaderCarrier 基于 Hertz 请求头的 TextMapCarrier，用于提取上游传入的 traceparent
+type requestHeaderCarrier struct {
+	header *protocol.RequestHeader
+}
+
+// Get 实现 propagation.TextMapCarrier
+func (hc *requestHeaderCarrier) Get(key string) string {
+	return string(hc.header.Peek(key))
+}
+
+// Set 实现 propagation.TextMapCarrier
+func (hc *requestHeaderCarrier) Set(key, value string) {
+	hc.header.Set(key, value)
+}
+
+// Keys 实现 propagation.TextMapCarrier
+func (hc *requestHeaderCarrier) Keys() []string {
+	keys := make([]string, 0)
+	hc.header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+
+	return keys
+}
+
+// HTTPTracingMiddleware 返回 HTTP 链路追踪中间件
+// 为每个请求创建服务端 Span，并将 Span 写入 context 供下游 RPC 客户端继续传播
+func HTTPTracingMiddleware() app.HandlerFunc {
+	tracer := otel.Tracer(TracerName)
+
+	return func(ctx context.Context, c *app.RequestContext) {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, &requestHeaderCarrier{header: &c.Request.Header})
+
+		method := string(c.Method())
+
+		ctx, span := tracer.Start(
+			ctx,
+			"HTTP "+method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(method),
+				semconv.URLPath(string(c.Path())),
+				semconv.ClientAddress(c.ClientIP()),
+			),
+		)
+		defer span.End()
+
+		c.Next(ctx)
+
+		// 路由在匹配后才可获取，使用路由模板命名 Span 以控制基数
+		if route := c.FullPath(); route != "" {
+			span.SetName(method + " " + route)
+			span.SetAttributes(semconv.HTTPRoute(route))
+		}
+
+		status := c.Response.StatusCode()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+
+		if code, ok := errors.GetErrorCode(c); ok {
+			span.SetAttributes(attribute.Int("biz.code", int(code)))
+		}
+
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
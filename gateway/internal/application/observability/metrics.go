@@ -0,0 +1,180 @@
+package observability
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const metricsNamespace = "gateway"
+
+// Registry 网关指标注册表
+// 使用独立注册表而非全局默认注册表，避免第三方库注册的指标混入
+var Registry = prometheus.NewRegistry()
+
+var (
+	// httpRequestsTotal HTTP 请求总数，按方法、路由、HTTP 状态码与业务错误码区分
+	httpRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "HTTP 请求总数",
+		},
+		[]string{"method", "route", "status", "code"},
+	)
+
+	// httpRequestDuration HTTP 请求耗时分布
+	httpRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTP 请求耗时（秒）",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method", "route"},
+	)
+
+	// loginAttemptsTotal 登录尝试次数，按结果区分
+	loginAttemptsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "auth",
+			Name:      "login_attempts_total",
+			Help:      "登录尝试次数",
+		},
+		[]string{"result"},
+	)
+
+	// tokenRevocationsTotal Token 吊销次数，按吊销原因区分
+	tokenRevocationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "auth",
+			Name:      "token_revocations_total",
+			Help:      "Token 吊销次数",
+		},
+		[]string{"reason"},
+	)
+
+	// sessionCleanupRunsTotal 会话索引清理执行次数，按结果区分
+	sessionCleanupRunsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "auth",
+			Name:      "session_cleanup_runs_total",
+			Help:      "会话索引清理执行次数",
+		},
+		[]string{"result"},
+	)
+
+	// sessionIndexPrunedTotal 会话索引中被清理的失效成员数量
+	sessionIndexPrunedTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "auth",
+			Name:      "session_index_pruned_total",
+			Help:      "会话索引中被清理的失效令牌族与令牌数量",
+		},
+	)
+
+	// permissionDenialsTotal Casbin 权限拒绝次数，按路由权限声明区分
+	permissionDenialsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "casbin",
+			Name:      "denials_total",
+			Help:      "Casbin 权限校验拒绝次数",
+		},
+		[]string{"requirement"},
+	)
+
+	// rpcCircuitBreakerState RPC 客户端熔断器状态，0 关闭、1 半开、2 打开
+	rpcCircuitBreakerState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "rpc",
+			Name:      "circuit_breaker_state",
+			Help:      "RPC 客户端熔断器状态（0 关闭，1 半开，2 打开）",
+		},
+		[]string{"service", "level", "key"},
+	)
+)
+
+// 登录结果标签值
+const (
+	LoginResultSuccess = "success"
+	LoginResultFailure = "failure"
+)
+
+// CircuitBreakerState 熔断器状态指标值
+type CircuitBreakerState int
+
+// 熔断器状态指标值
+const (
+	CircuitBreakerClosed   CircuitBreakerState = 0
+	CircuitBreakerHalfOpen CircuitBreakerState = 1
+	CircuitBreakerOpen     CircuitBreakerState = 2
+)
+
+// Token 吊销原因标签值
+const (
+	RevokeReasonLogout         = "logout"
+	RevokeReasonRefreshReuse   = "refresh_reuse"
+	RevokeReasonSessionRevoked = "session_revoked"
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestsTotal,
+		httpRequestDuration,
+		loginAttemptsTotal,
+		tokenRevocationsTotal,
+		sessionCleanupRunsTotal,
+		sessionIndexPrunedTotal,
+		permissionDenialsTotal,
+		rpcCircuitBreakerState,
+	)
+}
+
+// RecordLogin 记录一次登录尝试
+func RecordLogin(success bool) {
+	result := LoginResultFailure
+	if success {
+		result = LoginResultSuccess
+	}
+
+	loginAttemptsTotal.WithLabelValues(result).Inc()
+}
+
+// RecordTokenRevocation 记录 Token 吊销
+func RecordTokenRevocation(reason string, count int) {
+	if count <= 0 {
+		return
+	}
+
+	tokenRevocationsTotal.WithLabelValues(reason).Add(float64(count))
+}
+
+// RecordSessionCleanup 记录一次会话索引清理
+func RecordSessionCleanup(success bool, pruned int64) {
+	result := "failure"
+	if success {
+		result = "success"
+	}
+
+	sessionCleanupRunsTotal.WithLabelValues(result).Inc()
+	sessionIndexPrunedTotal.Add(float64(pruned))
+}
+
+// RecordPermissionDenied 记录一次 Casbin 权限拒绝
+func RecordPermissionDenied(requirement string) {
+	permissionDenialsTotal.WithLabelValues(requirement).Inc()
+}
+
+// RecordCircuitBreakerState 记录熔断器状态变化，level 为 service（方法级）或 instance（实例级）
+func RecordCircuitBreakerState(service, level, key string, state CircuitBreakerState) {
+	rpcCircuitBreakerState.WithLabelValues(service, level, key).Set(float64(state))
+}
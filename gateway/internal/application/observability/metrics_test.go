@@ -0,0 +1,147 @@
+package observability
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveWithMetrics 经 HTTP 指标中间件执行业务处理器，route 为空时模拟未匹配路由
+func serveWithMetrics(method, route string, handler app.HandlerFunc) {
+	c := app.NewContext(0)
+	c.Request.Header.SetMethod(method)
+	c.SetFullPath(route)
+	c.SetHandlers(app.HandlersChain{HTTPMetricsMiddleware(), handler})
+	c.Next(context.Background())
+}
+
+// durationSampleCount 从指标注册表读取指定方法与路由的请求耗时样本数
+func durationSampleCount(t *testing.T, method, route string) uint64 {
+	t.Helper()
+
+	families, err := Registry.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != "gateway_http_request_duration_seconds" {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			if labels["method"] == method && labels["route"] == route {
+				return metric.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+
+	return 0
+}
+
+func TestHTTPMetricsMiddleware(t *testing.T) {
+	forbidden := strconv.Itoa(int(errors.ErrForbidden.Code()))
+
+	tests := []struct {
+		name    string
+		route   string
+		handler app.HandlerFunc
+		labels  []string
+	}{
+		{
+			name:  "success",
+			route: "/api/v1/metrics-test/:id",
+			handler: func(_ context.Context, c *app.RequestContext) {
+				c.Status(http.StatusOK)
+			},
+			labels: []string{
+				http.MethodGet, "/api/v1/metrics-test/:id", "200",
+				strconv.Itoa(int(errors.CodeSuccess)),
+			},
+		},
+		{
+			name:  "business error",
+			route: "/api/v1/metrics-test/forbidden",
+			handler: func(_ context.Context, c *app.RequestContext) {
+				errors.AbortWithError(c, errors.ErrForbidden)
+			},
+			labels: []string{
+				http.MethodGet, "/api/v1/metrics-test/forbidden",
+				strconv.Itoa(errors.GetHTTPStatus(errors.ErrForbidden.Code())), forbidden,
+			},
+		},
+		{
+			name: "unmatched route",
+			handler: func(_ context.Context, c *app.RequestContext) {
+				c.Status(http.StatusNotFound)
+			},
+			labels: []string{http.MethodGet, unmatchedRoute, "404", "unknown"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := httpRequestsTotal.WithLabelValues(tt.labels...)
+			before := testutil.ToFloat64(counter)
+			samples := durationSampleCount(t, tt.labels[0], tt.labels[1])
+
+			serveWithMetrics(http.MethodGet, tt.route, tt.handler)
+
+			assert.Equal(t, before+1, testutil.ToFloat64(counter))
+			assert.Equal(t, samples+1, durationSampleCount(t, tt.labels[0], tt.labels[1]))
+		})
+	}
+}
+
+func TestRecordLogin(t *testing.T) {
+	success := testutil.ToFloat64(loginAttemptsTotal.WithLabelValues(LoginResultSuccess))
+	failure := testutil.ToFloat64(loginAttemptsTotal.WithLabelValues(LoginResultFailure))
+
+	RecordLogin(true)
+	RecordLogin(false)
+	RecordLogin(false)
+
+	assert.Equal(t, success+1, testutil.ToFloat64(loginAttemptsTotal.WithLabelValues(LoginResultSuccess)))
+	assert.Equal(t, failure+2, testutil.ToFloat64(loginAttemptsTotal.WithLabelValues(LoginResultFailure)))
+}
+
+func TestRecordTokenRevocation(t *testing.T) {
+	const reason = "test_record_token_revocation"
+
+	RecordTokenRevocation(reason, 3)
+	RecordTokenRevocation(reason, 0)
+	RecordTokenRevocation(reason, 2)
+
+	assert.Equal(t, float64(5), testutil.ToFloat64(tokenRevocationsTotal.WithLabelValues(reason)))
+}
+
+func TestRecordSessionCleanup(t *testing.T) {
+	pruned := testutil.ToFloat64(sessionIndexPrunedTotal)
+	failures := testutil.ToFloat64(sessionCleanupRunsTotal.WithLabelValues("failure"))
+
+	RecordSessionCleanup(true, 4)
+	RecordSessionCleanup(false, 0)
+
+	assert.Equal(t, pruned+4, testutil.ToFloat64(sessionIndexPrunedTotal))
+	assert.Equal(t, failures+1, testutil.ToFloat64(sessionCleanupRunsTotal.WithLabelValues("failure")))
+}
+
+func TestRecordCircuitBreakerState(t *testing.T) {
+	gauge := rpcCircuitBreakerState.WithLabelValues("identity_srv", "service", "test-key")
+
+	RecordCircuitBreakerState("identity_srv", "service", "test-key", CircuitBreakerOpen)
+	assert.Equal(t, float64(CircuitBreakerOpen), testutil.ToFloat64(gauge))
+
+	RecordCircuitBreakerState("identity_srv", "service", "test-key", CircuitBreakerHalfOpen)
+	assert.Equal(t, float64(CircuitBreakerHalfOpen), testutil.ToFloat64(gauge))
+}
@@ -0,0 +1,33 @@
+package observability
+
+import (
+	"fmt"
+	"net/http"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/config"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// StartMetricsServer 在独立端口启动 Prometheus 指标服务（阻塞调用）
+// 指标端口与业务端口分离，避免指标接口暴露到公网或经过业务中间件
+func StartMetricsServer(cfg *config.MetricsConfig, logger *hertzZerolog.Logger) {
+	path := cfg.Path
+	if path == "" {
+		path = "/metrics"
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(path, promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry}))
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", cfg.Port),
+		Handler: mux,
+	}
+
+	logger.Infof("Metrics server starting on port %d, path=%s", cfg.Port, path)
+
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		logger.Errorf("Metrics server stopped with error: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// TracerName 网关使用的 Tracer 名称
+const TracerName = "github.com/masonsxu/cloudwego-scaffold/gateway"
+
+// 支持的导出器类型
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// ShutdownFunc 关闭 TracerProvider，刷新尚未导出的 Span
+type ShutdownFunc func(ctx context.Context) error
+
+// InitTracing 初始化全局 TracerProvider 与 W3C TraceContext 传播器
+// exporter 为 nil 时按配置创建导出器；测试中可传入 tracetest.NewInMemoryExporter() 替换
+func InitTracing(
+	ctx context.Context,
+	cfg *config.TracingConfig,
+	exporter sdktrace.SpanExporter,
+) (ShutdownFunc, error) {
+	if exporter == nil {
+		var err error
+
+		exporter, err = NewSpanExporter(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("创建链路追踪资源失败: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(
+			sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SamplerRatio)),
+		),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return provider.Shutdown, nil
+}
+
+// NewSpanExporter 按配置创建 Span 导出器
+func NewSpanExporter(ctx context.Context, cfg *config.TracingConfig) (sdktrace.SpanExporter, error) {
+	switch strings.ToLower(cfg.Exporter) {
+	case "", ExporterOTLP:
+		exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+		if err != nil {
+			return nil, fmt.Errorf("创建OTLP导出器失败: %w", err)
+		}
+
+		return exporter, nil
+	case ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("创建stdout导出器失败: %w", err)
+		}
+
+		return exporter, nil
+	default:
+		return nil, fmt.Errorf("不支持的链路追踪导出器: %s", cfg.Exporter)
+	}
+}
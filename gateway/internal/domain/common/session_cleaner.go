@@ -0,0 +1,95 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/observability"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/redis"
+)
+
+// sessionCleanupLockKey 会话索引清理的互斥锁 Key，所有网关副本共用
+const sessionCleanupLockKey = "radius:jobs:session_cleanup:lock"
+
+// Locker 跨副本互斥锁
+type Locker interface {
+	// TryLock 尝试获取在 ttl 后自动释放的互斥锁，锁已被持有时返回 false
+	TryLock(ctx context.Context, key string, ttl time.Duration) (bool, error)
+}
+
+// SessionCleaner 过期会话清理任务
+// 令牌族与令牌本身依靠 Redis TTL 过期，用户会话索引集合则随每次登录整体续期，
+// 需定期移除其中已失效的成员，避免持续活跃用户的索引无限增长
+type SessionCleaner interface {
+	// Run 按固定间隔执行清理，直到 ctx 取消后返回；间隔为 0 时直接返回
+	Run(ctx context.Context)
+}
+
+// sessionCleaner 基于 Redis 锁选主的过期会话清理实现
+type sessionCleaner struct {
+	tokenCache redis.TokenCacheService
+	locker     Locker
+	interval   time.Duration
+	logger     *hertzZerolog.Logger
+}
+
+// NewSessionCleaner 创建过期会话清理任务
+func NewSessionCleaner(
+	tokenCache redis.TokenCacheService,
+	locker Locker,
+	interval time.Duration,
+	logger *hertzZerolog.Logger,
+) SessionCleaner {
+	return &sessionCleaner{
+		tokenCache: tokenCache,
+		locker:     locker,
+		interval:   interval,
+		logger:     logger,
+	}
+}
+
+// Run 按固定间隔执行清理，直到 ctx 取消后返回
+func (c *sessionCleaner) Run(ctx context.Context) {
+	if c.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.runOnce(ctx)
+		}
+	}
+}
+
+// runOnce 取得清理锁后执行一次清理，其他副本已在本间隔内执行时跳过
+// 锁的有效期与执行间隔相同且不主动释放，保证每个间隔内只有一个副本执行
+func (c *sessionCleaner) runOnce(ctx context.Context) {
+	locked, err := c.locker.TryLock(ctx, sessionCleanupLockKey, c.interval)
+	if err != nil {
+		c.logger.Errorf("Failed to acquire session cleanup lock: error=%v", err)
+		return
+	}
+
+	if !locked {
+		return
+	}
+
+	startedAt := time.Now()
+	pruned, err := c.tokenCache.PruneSessionIndexes(ctx)
+
+	observability.RecordSessionCleanup(err == nil, pruned)
+
+	if err != nil {
+		c.logger.Errorf("Session cleanup failed: pruned=%d, error=%v", pruned, err)
+		return
+	}
+
+	c.logger.Infof("Session cleanup finished: pruned=%d, duration=%v", pruned, time.Since(startedAt))
+}
@@ -0,0 +1,64 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryLocker 在内存中模拟跨副本共享的互斥锁，锁不会自动过期
+type memoryLocker struct {
+	held map[string]time.Duration
+}
+
+func (l *memoryLocker) TryLock(_ context.Context, key string, ttl time.Duration) (bool, error) {
+	if _, ok := l.held[key]; ok {
+		return false, nil
+	}
+
+	l.held[key] = ttl
+
+	return true, nil
+}
+
+// countingTokenCache 记录会话索引清理次数，未实现的方法调用时 panic
+type countingTokenCache struct {
+	redis.TokenCacheService
+
+	prunes int
+}
+
+func (c *countingTokenCache) PruneSessionIndexes(context.Context) (int64, error) {
+	c.prunes++
+	return 2, nil
+}
+
+func TestSessionCleaner_RunOnceTakesLock(t *testing.T) {
+	ctx := context.Background()
+	locker := &memoryLocker{held: make(map[string]time.Duration)}
+	logger := hertzZerolog.New()
+
+	// 两个副本共用同一把锁，同一间隔内只有先取得锁的副本执行清理
+	first := &countingTokenCache{}
+	second := &countingTokenCache{}
+
+	NewSessionCleaner(first, locker, time.Hour, logger).(*sessionCleaner).runOnce(ctx)
+	NewSessionCleaner(second, locker, time.Hour, logger).(*sessionCleaner).runOnce(ctx)
+
+	assert.Equal(t, 1, first.prunes)
+	assert.Equal(t, 0, second.prunes)
+	assert.Equal(t, time.Hour, locker.held[sessionCleanupLockKey])
+}
+
+func TestSessionCleaner_RunDisabled(t *testing.T) {
+	tokenCache := &countingTokenCache{}
+	cleaner := NewSessionCleaner(tokenCache, &memoryLocker{}, 0, hertzZerolog.New())
+
+	// 间隔为 0 时立即返回
+	cleaner.Run(context.Background())
+	assert.Equal(t, 0, tokenCache.prunes)
+}
@@ -0,0 +1,76 @@
+package common
+
+import (
+	"context"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/observability"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/redis"
+)
+
+// 令牌吊销原因，同时作为吊销指标的标签值
+const (
+	RevokeReasonUserStatusChanged = "user_status_changed"
+	RevokeReasonUserDeleted       = "user_deleted"
+	RevokeReasonUserRolesChanged  = "user_roles_changed"
+	RevokeReasonRoleMenusChanged  = "role_menus_changed"
+)
+
+// TokenRevoker 用户令牌吊销器
+// JWT 中固化了用户状态、角色等登录时的信息；当这些信息发生变更后，
+// 吊销受影响用户的全部登录会话，使其重新登录以获取反映最新权限的令牌
+type TokenRevoker interface {
+	// RevokeUserTokens 吊销指定用户的全部登录会话，并通知各网关副本清除这些用户的权限判定缓存
+	// 吊销为尽力而为：失败时仅记录日志，不影响已完成的业务变更
+	RevokeUserTokens(ctx context.Context, reason string, userIDs ...string)
+}
+
+// tokenRevoker 基于 Redis 令牌缓存的吊销器实现
+type tokenRevoker struct {
+	tokenCache   redis.TokenCacheService
+	invalidation redis.PermissionInvalidationService
+	logger       *hertzZerolog.Logger
+}
+
+// NewTokenRevoker 创建用户令牌吊销器
+func NewTokenRevoker(
+	tokenCache redis.TokenCacheService,
+	invalidation redis.PermissionInvalidationService,
+	logger *hertzZerolog.Logger,
+) TokenRevoker {
+	return &tokenRevoker{
+		tokenCache:   tokenCache,
+		invalidation: invalidation,
+		logger:       logger,
+	}
+}
+
+// RevokeUserTokens 吊销指定用户的全部登录会话
+func (r *tokenRevoker) RevokeUserTokens(ctx context.Context, reason string, userIDs ...string) {
+	revoked := 0
+
+	for _, userID := range userIDs {
+		if userID == "" {
+			continue
+		}
+
+		if err := r.tokenCache.RemoveUserTokens(ctx, userID); err != nil {
+			r.logger.Errorf("Failed to revoke user tokens: reason=%s, userID=%s, error=%v",
+				reason, userID, err)
+
+			continue
+		}
+
+		revoked++
+	}
+
+	if revoked > 0 {
+		observability.RecordTokenRevocation(reason, revoked)
+		r.logger.Infof("User tokens revoked: reason=%s, userCount=%d", reason, revoked)
+	}
+
+	// 权限判定缓存按用户而非令牌缓存，重新登录不会使其失效，需单独通知清除
+	if err := r.invalidation.Publish(ctx, userIDs...); err != nil {
+		r.logger.Errorf("Failed to publish permission cache invalidation: reason=%s, error=%v", reason, err)
+	}
+}
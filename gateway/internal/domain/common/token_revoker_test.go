@@ -0,0 +1,55 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+// revokingTokenCache 记录被移除令牌的用户，failing 中的用户移除失败；未实现的方法调用时 panic
+type revokingTokenCache struct {
+	redis.TokenCacheService
+
+	failing map[string]bool
+	removed []string
+}
+
+func (c *revokingTokenCache) RemoveUserTokens(_ context.Context, userID string) error {
+	if c.failing[userID] {
+		return fmt.Errorf("redis unavailable")
+	}
+
+	c.removed = append(c.removed, userID)
+
+	return nil
+}
+
+// recordingInvalidation 记录发布的权限缓存失效通知；未实现的方法调用时 panic
+type recordingInvalidation struct {
+	redis.PermissionInvalidationService
+
+	published [][]string
+}
+
+func (i *recordingInvalidation) Publish(_ context.Context, userIDs ...string) error {
+	i.published = append(i.published, userIDs)
+	return nil
+}
+
+func TestRevokeUserTokens(t *testing.T) {
+	tokenCache := &revokingTokenCache{failing: map[string]bool{"user-2": true}}
+	invalidation := &recordingInvalidation{}
+	revoker := NewTokenRevoker(tokenCache, invalidation, hertzZerolog.New())
+
+	revoker.RevokeUserTokens(context.Background(), RevokeReasonUserRolesChanged, "user-1", "", "user-2", "user-3")
+
+	// 单个用户吊销失败不影响其余用户，空用户ID被忽略
+	assert.Equal(t, []string{"user-1", "user-3"}, tokenCache.removed)
+
+	// 权限缓存失效通知覆盖全部用户，包括令牌吊销失败的用户
+	assert.Equal(t, [][]string{{"user-1", "", "user-2", "user-3"}}, invalidation.published)
+}
@@ -0,0 +1,17 @@
+// Package audit 审计日志领域服务
+package audit
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/audit"
+)
+
+// Service 审计日志服务接口 - 统一暴露给Handler层
+type Service interface {
+	// ListAuditEvents 查询审计事件 - 按操作者、操作、操作对象、执行结果与时间范围分页查询
+	ListAuditEvents(
+		ctx context.Context,
+		req *audit.ListAuditEventsRequestDTO,
+	) (*audit.ListAuditEventsResponseDTO, error)
+}
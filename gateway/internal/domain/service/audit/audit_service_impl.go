@@ -0,0 +1,54 @@
+package audit
+
+import (
+	"context"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/audit"
+	auditconv "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/audit"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/common"
+	identitycli "github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/client/identity_cli"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// serviceImpl 审计日志服务实现
+type serviceImpl struct {
+	*common.BaseService
+	identityClient identitycli.IdentityClient
+	assembler      auditconv.Assembler
+}
+
+// NewService 创建审计日志服务
+func NewService(
+	identityClient identitycli.IdentityClient,
+	assembler auditconv.Assembler,
+	logger *hertzZerolog.Logger,
+) Service {
+	return &serviceImpl{
+		BaseService:    common.NewBaseService(logger),
+		identityClient: identityClient,
+		assembler:      assembler,
+	}
+}
+
+func (s *serviceImpl) ListAuditEvents(
+	ctx context.Context,
+	req *audit.ListAuditEventsRequestDTO,
+) (*audit.ListAuditEventsResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "查询审计事件",
+		func(ctx context.Context) (interface{}, error) {
+			rpcReq := s.assembler.ToRPCListAuditEventsRequest(req)
+			return s.identityClient.ListAuditEvents(ctx, rpcReq)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.ListAuditEventsResponse)
+
+	httpResp := s.assembler.ToHTTPListAuditEventsResponse(rpcResp)
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
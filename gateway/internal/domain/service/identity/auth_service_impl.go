@@ -56,8 +56,39 @@ func (s *authServiceImpl) Login(
 		return nil, "", err
 	}
 
+	httpResp, permission := s.buildLoginResponse(result.(*identity_srv.LoginResponse))
+
+	return httpResp, permission, nil
+}
+
+func (s *authServiceImpl) SwitchOrganization(
+	ctx context.Context,
+	userID string,
+	organizationID string,
+) (*identity.LoginResponseDTO, Permission, error) {
+	result, err := s.ProcessRPCCall(ctx, "切换组织",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.SwitchOrganization(ctx, &identity_srv.SwitchOrganizationRequest{
+				UserID:         &userID,
+				OrganizationID: &organizationID,
+			})
+		},
+		"user_id", userID, "organization_id", organizationID,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	httpResp, permission := s.buildLoginResponse(result.(*identity_srv.LoginResponse))
+
+	return httpResp, permission, nil
+}
+
+// buildLoginResponse 将登录或切换组织的RPC响应转换为HTTP响应，并提取核心权限
+func (s *authServiceImpl) buildLoginResponse(
+	rpcResp *identity_srv.LoginResponse,
+) (*identity.LoginResponseDTO, Permission) {
 	// 转换RPC响应到HTTP响应
-	rpcResp := result.(*identity_srv.LoginResponse)
 	httpResp := s.assembler.Auth().ToHTTPLoginResponse(rpcResp)
 
 	// 设置成功的基础响应
@@ -84,12 +115,11 @@ func (s *authServiceImpl) Login(
 		httpResp.RoleIDs = []string{}
 	}
 
-	permission := *rpcResp.Permissions[0].Permission
-	if permission != "" {
-		return httpResp, Permission(permission), nil
+	if len(rpcResp.Permissions) > 0 && rpcResp.Permissions[0].Permission != nil {
+		return httpResp, Permission(*rpcResp.Permissions[0].Permission)
 	}
 
-	return httpResp, "", nil
+	return httpResp, ""
 }
 
 func (s *authServiceImpl) ChangePassword(
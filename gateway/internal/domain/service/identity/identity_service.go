@@ -17,6 +17,8 @@ type Service interface {
 	OrganizationService
 	DepartmentService
 	LogoService
+	SessionService
+	MFAService
 }
 
 // =================================================================
@@ -31,6 +33,13 @@ type AuthService interface {
 		req *identity.LoginRequestDTO,
 	) (*identity.LoginResponseDTO, Permission, error)
 
+	// SwitchOrganization 切换组织 - 按用户在目标组织的角色重新计算菜单与权限
+	SwitchOrganization(
+		ctx context.Context,
+		userID string,
+		organizationID string,
+	) (*identity.LoginResponseDTO, Permission, error)
+
 	// ChangePassword 修改密码 - 用户修改自己的密码（需要提供旧密码）
 	ChangePassword(
 		ctx context.Context,
@@ -51,6 +60,73 @@ type AuthService interface {
 	) (*http_base.OperationStatusResponseDTO, error)
 }
 
+// SessionService 登录会话管理服务接口
+type SessionService interface {
+	// ListSessions 获取登录会话 - 列出用户所有有效会话，currentSessionID 对应的会话标记为当前会话
+	ListSessions(
+		ctx context.Context,
+		userID string,
+		currentSessionID string,
+	) (*identity.ListSessionsResponseDTO, error)
+
+	// RevokeSession 吊销会话 - 使用户的指定会话立即失效
+	RevokeSession(
+		ctx context.Context,
+		userID string,
+		sessionID string,
+	) (*http_base.OperationStatusResponseDTO, error)
+
+	// RevokeOtherSessions 吊销其他会话 - 保留当前会话，使用户的其他会话立即失效
+	RevokeOtherSessions(
+		ctx context.Context,
+		userID string,
+		currentSessionID string,
+	) (*http_base.OperationStatusResponseDTO, error)
+
+	// RevokeAllSessions 吊销全部会话 - 使用户的所有会话立即失效
+	RevokeAllSessions(
+		ctx context.Context,
+		userID string,
+	) (*http_base.OperationStatusResponseDTO, error)
+}
+
+// MFAService 多因素认证服务接口
+type MFAService interface {
+	// VerifyMFA 校验多因素验证码 - 登录第二步，校验 TOTP 验证码或恢复码
+	VerifyMFA(
+		ctx context.Context,
+		userID string,
+		code string,
+	) error
+
+	// EnrollMFA 开始绑定多因素认证 - 为用户生成待确认的 TOTP 密钥
+	EnrollMFA(
+		ctx context.Context,
+		userID string,
+	) (*identity.MFAEnrollResponseDTO, error)
+
+	// ConfirmMFAEnrollment 确认绑定多因素认证 - 校验验证码后启用并返回恢复码
+	ConfirmMFAEnrollment(
+		ctx context.Context,
+		req *identity.MFACodeRequestDTO,
+		userID string,
+	) (*identity.MFARecoveryCodesResponseDTO, error)
+
+	// RegenerateMFARecoveryCodes 重新生成恢复码 - 原有恢复码全部失效
+	RegenerateMFARecoveryCodes(
+		ctx context.Context,
+		req *identity.MFACodeRequestDTO,
+		userID string,
+	) (*identity.MFARecoveryCodesResponseDTO, error)
+
+	// DisableMFA 关闭多因素认证 - 需提供 TOTP 验证码或恢复码
+	DisableMFA(
+		ctx context.Context,
+		req *identity.MFACodeRequestDTO,
+		userID string,
+	) (*http_base.OperationStatusResponseDTO, error)
+}
+
 // UserService 用户管理服务接口
 type UserService interface {
 	// CreateUser 创建用户 - 管理员创建新用户账户
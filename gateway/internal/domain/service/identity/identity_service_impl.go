@@ -16,6 +16,8 @@ type identityServiceImpl struct {
 	orgService        OrganizationService
 	deptService       DepartmentService
 	logoService       LogoService
+	sessionService    SessionService
+	mfaService        MFAService
 }
 
 // NewService 创建身份管理聚合服务
@@ -26,6 +28,8 @@ func NewService(
 	orgService OrganizationService,
 	deptService DepartmentService,
 	logoService LogoService,
+	sessionService SessionService,
+	mfaService MFAService,
 ) Service {
 	return &identityServiceImpl{
 		authService:       authService,
@@ -34,6 +38,8 @@ func NewService(
 		orgService:        orgService,
 		deptService:       deptService,
 		logoService:       logoService,
+		sessionService:    sessionService,
+		mfaService:        mfaService,
 	}
 }
 
@@ -48,6 +54,14 @@ func (s *identityServiceImpl) Login(
 	return s.authService.Login(ctx, req)
 }
 
+func (s *identityServiceImpl) SwitchOrganization(
+	ctx context.Context,
+	userID string,
+	organizationID string,
+) (*identity.LoginResponseDTO, Permission, error) {
+	return s.authService.SwitchOrganization(ctx, userID, organizationID)
+}
+
 func (s *identityServiceImpl) ChangePassword(
 	ctx context.Context,
 	req *identity.ChangePasswordRequestDTO,
@@ -276,3 +290,81 @@ func (s *identityServiceImpl) BindLogoToOrganization(
 ) (*identity.OrganizationResponseDTO, error) {
 	return s.logoService.BindLogoToOrganization(ctx, req)
 }
+
+// =================================================================
+// SessionService 接口实现 - 委托给 sessionService
+// =================================================================
+
+func (s *identityServiceImpl) ListSessions(
+	ctx context.Context,
+	userID string,
+	currentSessionID string,
+) (*identity.ListSessionsResponseDTO, error) {
+	return s.sessionService.ListSessions(ctx, userID, currentSessionID)
+}
+
+func (s *identityServiceImpl) RevokeSession(
+	ctx context.Context,
+	userID string,
+	sessionID string,
+) (*http_base.OperationStatusResponseDTO, error) {
+	return s.sessionService.RevokeSession(ctx, userID, sessionID)
+}
+
+func (s *identityServiceImpl) RevokeOtherSessions(
+	ctx context.Context,
+	userID string,
+	currentSessionID string,
+) (*http_base.OperationStatusResponseDTO, error) {
+	return s.sessionService.RevokeOtherSessions(ctx, userID, currentSessionID)
+}
+
+func (s *identityServiceImpl) RevokeAllSessions(
+	ctx context.Context,
+	userID string,
+) (*http_base.OperationStatusResponseDTO, error) {
+	return s.sessionService.RevokeAllSessions(ctx, userID)
+}
+
+// =================================================================
+// MFAService 接口实现 - 委托给 mfaService
+// =================================================================
+
+func (s *identityServiceImpl) VerifyMFA(
+	ctx context.Context,
+	userID string,
+	code string,
+) error {
+	return s.mfaService.VerifyMFA(ctx, userID, code)
+}
+
+func (s *identityServiceImpl) EnrollMFA(
+	ctx context.Context,
+	userID string,
+) (*identity.MFAEnrollResponseDTO, error) {
+	return s.mfaService.EnrollMFA(ctx, userID)
+}
+
+func (s *identityServiceImpl) ConfirmMFAEnrollment(
+	ctx context.Context,
+	req *identity.MFACodeRequestDTO,
+	userID string,
+) (*identity.MFARecoveryCodesResponseDTO, error) {
+	return s.mfaService.ConfirmMFAEnrollment(ctx, req, userID)
+}
+
+func (s *identityServiceImpl) RegenerateMFARecoveryCodes(
+	ctx context.Context,
+	req *identity.MFACodeRequestDTO,
+	userID string,
+) (*identity.MFARecoveryCodesResponseDTO, error) {
+	return s.mfaService.RegenerateMFARecoveryCodes(ctx, req, userID)
+}
+
+func (s *identityServiceImpl) DisableMFA(
+	ctx context.Context,
+	req *identity.MFACodeRequestDTO,
+	userID string,
+) (*http_base.OperationStatusResponseDTO, error) {
+	return s.mfaService.DisableMFA(ctx, req, userID)
+}
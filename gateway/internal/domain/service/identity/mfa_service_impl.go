@@ -0,0 +1,155 @@
+package identity
+
+import (
+	"context"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/http_base"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/identity"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/common"
+	identitycli "github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/client/identity_cli"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// mfaServiceImpl 多因素认证服务实现
+type mfaServiceImpl struct {
+	*common.BaseService
+	identityClient identitycli.IdentityClient
+}
+
+// NewMFAService 创建多因素认证服务实例
+func NewMFAService(
+	identityClient identitycli.IdentityClient,
+	logger *hertzZerolog.Logger,
+) MFAService {
+	return &mfaServiceImpl{
+		BaseService:    common.NewBaseService(logger),
+		identityClient: identityClient,
+	}
+}
+
+func (s *mfaServiceImpl) VerifyMFA(
+	ctx context.Context,
+	userID string,
+	code string,
+) error {
+	result, err := s.ProcessRPCCall(ctx, "校验多因素验证码",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.VerifyMFA(ctx, &identity_srv.MFACodeRequest{
+				UserID: &userID,
+				Code:   &code,
+			})
+		},
+		"user_id", userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	// 使用恢复码登录时提示剩余数量，便于排查恢复码耗尽的用户
+	rpcResp := result.(*identity_srv.VerifyMFAResponse)
+	if rpcResp.GetUsedRecoveryCode() {
+		s.LogInfo(ctx, "用户使用恢复码完成多因素认证",
+			"user_id", userID, "remaining", rpcResp.GetRemainingRecoveryCodes())
+	}
+
+	return nil
+}
+
+func (s *mfaServiceImpl) EnrollMFA(
+	ctx context.Context,
+	userID string,
+) (*identity.MFAEnrollResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "开始绑定多因素认证",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.BeginMFAEnrollment(ctx, &identity_srv.BeginMFAEnrollmentRequest{
+				UserID: &userID,
+			})
+		},
+		"user_id", userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.BeginMFAEnrollmentResponse)
+
+	return &identity.MFAEnrollResponseDTO{
+		BaseResp:        s.ResponseBuilder().BuildSuccessResponse(),
+		Secret:          rpcResp.Secret,
+		ProvisioningURI: rpcResp.ProvisioningURI,
+	}, nil
+}
+
+func (s *mfaServiceImpl) ConfirmMFAEnrollment(
+	ctx context.Context,
+	req *identity.MFACodeRequestDTO,
+	userID string,
+) (*identity.MFARecoveryCodesResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "确认绑定多因素认证",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.ConfirmMFAEnrollment(ctx, &identity_srv.MFACodeRequest{
+				UserID: &userID,
+				Code:   req.Code,
+			})
+		},
+		"user_id", userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.MFARecoveryCodesResponse)
+
+	return &identity.MFARecoveryCodesResponseDTO{
+		BaseResp:      s.ResponseBuilder().BuildSuccessResponse(),
+		RecoveryCodes: rpcResp.RecoveryCodes,
+	}, nil
+}
+
+func (s *mfaServiceImpl) RegenerateMFARecoveryCodes(
+	ctx context.Context,
+	req *identity.MFACodeRequestDTO,
+	userID string,
+) (*identity.MFARecoveryCodesResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "重新生成恢复码",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.RegenerateMFARecoveryCodes(ctx, &identity_srv.MFACodeRequest{
+				UserID: &userID,
+				Code:   req.Code,
+			})
+		},
+		"user_id", userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.MFARecoveryCodesResponse)
+
+	return &identity.MFARecoveryCodesResponseDTO{
+		BaseResp:      s.ResponseBuilder().BuildSuccessResponse(),
+		RecoveryCodes: rpcResp.RecoveryCodes,
+	}, nil
+}
+
+func (s *mfaServiceImpl) DisableMFA(
+	ctx context.Context,
+	req *identity.MFACodeRequestDTO,
+	userID string,
+) (*http_base.OperationStatusResponseDTO, error) {
+	err := s.ProcessRPCVoidCall(ctx, "关闭多因素认证",
+		func(ctx context.Context) error {
+			return s.identityClient.DisableMFA(ctx, &identity_srv.MFACodeRequest{
+				UserID: &userID,
+				Code:   req.Code,
+			})
+		},
+		"user_id", userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
+}
@@ -0,0 +1,158 @@
+package identity
+
+import (
+	"context"
+	"sort"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/http_base"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/identity"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/observability"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/common"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/redis"
+)
+
+// sessionServiceImpl 登录会话管理服务实现
+// 登录会话即 Redis 中的令牌族，吊销会话会使其访问令牌与刷新令牌立即失效
+type sessionServiceImpl struct {
+	*common.BaseService
+	tokenCache redis.TokenCacheService
+}
+
+// NewSessionService 创建登录会话管理服务实例
+func NewSessionService(
+	tokenCache redis.TokenCacheService,
+	logger *hertzZerolog.Logger,
+) SessionService {
+	return &sessionServiceImpl{
+		BaseService: common.NewBaseService(logger),
+		tokenCache:  tokenCache,
+	}
+}
+
+// ListSessions 获取用户所有有效的登录会话
+func (s *sessionServiceImpl) ListSessions(
+	ctx context.Context,
+	userID string,
+	currentSessionID string,
+) (*identity.ListSessionsResponseDTO, error) {
+	families, err := s.tokenCache.ListUserTokenFamilies(ctx, userID)
+	if err != nil {
+		s.LogError(ctx, "获取登录会话列表失败", err, "user_id", userID)
+		return nil, err
+	}
+
+	// 最近活跃的会话排在前面
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].LastSeenAt > families[j].LastSeenAt
+	})
+
+	sessions := make([]*identity.SessionDTO, 0, len(families))
+	for _, family := range families {
+		sessions = append(sessions, toSessionDTO(family, currentSessionID))
+	}
+
+	return &identity.ListSessionsResponseDTO{
+		BaseResp: s.ResponseBuilder().BuildSuccessResponse(),
+		Sessions: sessions,
+	}, nil
+}
+
+// RevokeSession 吊销用户的指定会话，会话不属于该用户时视为不存在
+func (s *sessionServiceImpl) RevokeSession(
+	ctx context.Context,
+	userID string,
+	sessionID string,
+) (*http_base.OperationStatusResponseDTO, error) {
+	family, err := s.tokenCache.GetTokenFamily(ctx, sessionID)
+	if err != nil {
+		s.LogError(ctx, "获取登录会话失败", err, "user_id", userID, "session_id", sessionID)
+		return nil, err
+	}
+
+	if family == nil || family.UserID != userID {
+		return nil, errors.ErrNotFound.WithMessage("会话不存在或已失效")
+	}
+
+	if err := s.tokenCache.RevokeTokenFamily(ctx, sessionID, userID); err != nil {
+		s.LogError(ctx, "吊销登录会话失败", err, "user_id", userID, "session_id", sessionID)
+		return nil, err
+	}
+
+	observability.RecordTokenRevocation(observability.RevokeReasonSessionRevoked, 1)
+	s.LogInfo(ctx, "登录会话已吊销", "user_id", userID, "session_id", sessionID)
+
+	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
+}
+
+// RevokeOtherSessions 吊销用户除当前会话外的所有会话
+func (s *sessionServiceImpl) RevokeOtherSessions(
+	ctx context.Context,
+	userID string,
+	currentSessionID string,
+) (*http_base.OperationStatusResponseDTO, error) {
+	families, err := s.tokenCache.ListUserTokenFamilies(ctx, userID)
+	if err != nil {
+		s.LogError(ctx, "获取登录会话列表失败", err, "user_id", userID)
+		return nil, err
+	}
+
+	revoked := 0
+
+	for _, family := range families {
+		if family.FamilyID == currentSessionID {
+			continue
+		}
+
+		if err := s.tokenCache.RevokeTokenFamily(ctx, family.FamilyID, userID); err != nil {
+			s.LogError(ctx, "吊销登录会话失败", err, "user_id", userID, "session_id", family.FamilyID)
+			return nil, err
+		}
+
+		revoked++
+	}
+
+	observability.RecordTokenRevocation(observability.RevokeReasonSessionRevoked, revoked)
+	s.LogInfo(ctx, "其他登录会话已吊销", "user_id", userID, "count", revoked)
+
+	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
+}
+
+// RevokeAllSessions 吊销用户的全部会话
+func (s *sessionServiceImpl) RevokeAllSessions(
+	ctx context.Context,
+	userID string,
+) (*http_base.OperationStatusResponseDTO, error) {
+	families, err := s.tokenCache.ListUserTokenFamilies(ctx, userID)
+	if err != nil {
+		s.LogError(ctx, "获取登录会话列表失败", err, "user_id", userID)
+		return nil, err
+	}
+
+	if err := s.tokenCache.RemoveUserTokens(ctx, userID); err != nil {
+		s.LogError(ctx, "吊销用户全部会话失败", err, "user_id", userID)
+		return nil, err
+	}
+
+	observability.RecordTokenRevocation(observability.RevokeReasonSessionRevoked, len(families))
+	s.LogInfo(ctx, "用户全部登录会话已吊销", "user_id", userID, "count", len(families))
+
+	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
+}
+
+// toSessionDTO 将令牌族转换为会话DTO
+func toSessionDTO(family *redis.TokenFamily, currentSessionID string) *identity.SessionDTO {
+	current := currentSessionID != "" && family.FamilyID == currentSessionID
+
+	return &identity.SessionDTO{
+		SessionID:  &family.FamilyID,
+		Device:     &family.Device,
+		IpAddress:  &family.IPAddress,
+		UserAgent:  &family.UserAgent,
+		IssuedAt:   &family.CreatedAt,
+		LastSeenAt: &family.LastSeenAt,
+		ExpiresAt:  &family.ExpiresAt,
+		Current:    &current,
+	}
+}
@@ -20,18 +20,21 @@ type userManagementServiceImpl struct {
 	*common.BaseService
 	identityClient identitycli.IdentityClient
 	assembler      identityConv.Assembler
+	tokenRevoker   common.TokenRevoker
 }
 
 // NewUserManagementService 创建新的用户管理服务实例
 func NewUserManagementService(
 	identityClient identitycli.IdentityClient,
 	assembler identityConv.Assembler,
+	tokenRevoker common.TokenRevoker,
 	logger *hertzZerolog.Logger,
 ) UserService {
 	return &userManagementServiceImpl{
 		BaseService:    common.NewBaseService(logger),
 		identityClient: identityClient,
 		assembler:      assembler,
+		tokenRevoker:   tokenRevoker,
 	}
 }
 
@@ -236,6 +239,9 @@ func (s *userManagementServiceImpl) DeleteUser(
 		return nil, err
 	}
 
+	// 已删除用户的登录会话立即失效
+	s.tokenRevoker.RevokeUserTokens(ctx, common.RevokeReasonUserDeleted, req.GetUserID())
+
 	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
 }
 
@@ -307,6 +313,9 @@ func (s *userManagementServiceImpl) ChangeUserStatus(
 		return nil, err
 	}
 
+	// 令牌中固化了用户状态，状态变更后使旧令牌立即失效
+	s.tokenRevoker.RevokeUserTokens(ctx, common.RevokeReasonUserStatusChanged, req.GetUserID())
+
 	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
 }
 
@@ -359,11 +368,11 @@ func (s *userManagementServiceImpl) enrichUserProfileWithRoles(
 		return
 	}
 
-	// 提取角色ID列表
+	// 提取全局角色ID列表，与 updateUserRoles 维护的范围一致
 	if roleResp != nil && roleResp.Assignments != nil {
 		roleIDs := make([]string, 0, len(roleResp.Assignments))
 		for _, assignment := range roleResp.Assignments {
-			if assignment.RoleID != nil {
+			if assignment.RoleID != nil && assignment.OrganizationID == nil {
 				roleIDs = append(roleIDs, *assignment.RoleID)
 			}
 		}
@@ -574,11 +583,12 @@ func (s *userManagementServiceImpl) updateUserRoles(
 	}
 
 	// 2. 构建当前角色ID集合
+	// 用户管理只维护全局角色，组织内授予的角色通过用户角色分配接口单独管理
 	currentRoleIDs := make(map[string]bool)
 
 	if roleResp != nil && roleResp.Assignments != nil {
 		for _, assignment := range roleResp.Assignments {
-			if assignment.RoleID != nil {
+			if assignment.RoleID != nil && assignment.OrganizationID == nil {
 				currentRoleIDs[*assignment.RoleID] = true
 			}
 		}
@@ -660,6 +670,11 @@ func (s *userManagementServiceImpl) updateUserRoles(
 		s.assignRolesToUser(ctx, userID, rolesToAdd, operatorID)
 	}
 
+	// 8. 令牌中固化了角色信息，角色变更后使旧令牌立即失效
+	if len(rolesToAdd) > 0 || len(rolesToRemove) > 0 {
+		s.tokenRevoker.RevokeUserTokens(ctx, common.RevokeReasonUserRolesChanged, *userID)
+	}
+
 	s.Logger().Info("更新用户角色完成",
 		"userID", *userID,
 		"currentCount", len(currentRoleIDs),
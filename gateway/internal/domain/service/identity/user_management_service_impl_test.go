@@ -0,0 +1,145 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cloudwego/kitex/client/callopt"
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/identity"
+	identityConv "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/identity"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/common"
+	identitycli "github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/client/identity_cli"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// userRolesClient 在内存中维护用户的全局角色，err 非空时写操作失败；未实现的方法调用时 panic
+type userRolesClient struct {
+	identitycli.IdentityClient
+
+	roles map[string]bool
+	err   error
+}
+
+func (c *userRolesClient) DeleteUser(context.Context, *identity_srv.DeleteUserRequest, ...callopt.Option) error {
+	return c.err
+}
+
+func (c *userRolesClient) ChangeUserStatus(
+	context.Context,
+	*identity_srv.ChangeUserStatusRequest,
+	...callopt.Option,
+) error {
+	return c.err
+}
+
+func (c *userRolesClient) ListUserRoleAssignments(
+	context.Context,
+	*identity_srv.UserRoleQueryRequest,
+	...callopt.Option,
+) (*identity_srv.UserRoleListResponse, error) {
+	assignments := make([]*identity_srv.UserRoleAssignment, 0, len(c.roles))
+
+	for roleID := range c.roles {
+		assignments = append(assignments, &identity_srv.UserRoleAssignment{RoleID: &roleID})
+	}
+
+	return &identity_srv.UserRoleListResponse{Assignments: assignments}, nil
+}
+
+func (c *userRolesClient) AssignRoleToUser(
+	_ context.Context,
+	req *identity_srv.AssignRoleToUserRequest,
+	_ ...callopt.Option,
+) (*identity_srv.UserRoleAssignmentResponse, error) {
+	c.roles[req.GetRoleID()] = true
+	return &identity_srv.UserRoleAssignmentResponse{}, nil
+}
+
+func (c *userRolesClient) RevokeRoleFromUser(
+	_ context.Context,
+	req *identity_srv.RevokeRoleFromUserRequest,
+	_ ...callopt.Option,
+) error {
+	delete(c.roles, req.GetRoleID())
+	return nil
+}
+
+// recordingRevoker 记录令牌吊销请求
+type recordingRevoker struct {
+	reasons []string
+	userIDs []string
+}
+
+func (r *recordingRevoker) RevokeUserTokens(_ context.Context, reason string, userIDs ...string) {
+	r.reasons = append(r.reasons, reason)
+	r.userIDs = append(r.userIDs, userIDs...)
+}
+
+func newTestUserService(client *userRolesClient) (*userManagementServiceImpl, *recordingRevoker) {
+	assembler := identityConv.NewIdentityAggregateAssembler(
+		identityConv.NewAuthAssembler(),
+		identityConv.NewUserAssembler(),
+		identityConv.NewOrgAssembler(),
+		identityConv.NewDepartmentAssembler(),
+		identityConv.NewMembershipAssembler(),
+		identityConv.NewLogoAssembler(),
+	)
+	revoker := &recordingRevoker{}
+
+	svc := NewUserManagementService(client, assembler, revoker, hertzZerolog.New())
+
+	return svc.(*userManagementServiceImpl), revoker
+}
+
+func TestChangeUserStatus_RevokesSessions(t *testing.T) {
+	userID := "user-1"
+	status := int32(2)
+	req := &identity.ChangeUserStatusRequestDTO{UserID: &userID, NewStatus: &status}
+
+	t.Run("success", func(t *testing.T) {
+		svc, revoker := newTestUserService(&userRolesClient{})
+
+		_, err := svc.ChangeUserStatus(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, []string{common.RevokeReasonUserStatusChanged}, revoker.reasons)
+		assert.Equal(t, []string{userID}, revoker.userIDs)
+	})
+
+	t.Run("rpc failure", func(t *testing.T) {
+		svc, revoker := newTestUserService(&userRolesClient{err: fmt.Errorf("identity_srv unavailable")})
+
+		_, err := svc.ChangeUserStatus(context.Background(), req)
+		assert.Error(t, err)
+		assert.Empty(t, revoker.reasons)
+	})
+}
+
+func TestDeleteUser_RevokesSessions(t *testing.T) {
+	userID := "user-1"
+	svc, revoker := newTestUserService(&userRolesClient{})
+
+	_, err := svc.DeleteUser(context.Background(), &identity.DeleteUserRequestDTO{UserID: &userID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{common.RevokeReasonUserDeleted}, revoker.reasons)
+	assert.Equal(t, []string{userID}, revoker.userIDs)
+}
+
+func TestUpdateUserRoles_RevokesOnlyWhenRolesChange(t *testing.T) {
+	ctx := context.Background()
+	userID := "user-1"
+	client := &userRolesClient{roles: map[string]bool{"role-a": true}}
+	svc, revoker := newTestUserService(client)
+
+	// 角色未变化时不吊销
+	require.NoError(t, svc.updateUserRoles(ctx, &userID, []string{"role-a"}, "operator-1"))
+	assert.Empty(t, revoker.reasons)
+
+	require.NoError(t, svc.updateUserRoles(ctx, &userID, []string{"role-b"}, "operator-1"))
+	assert.Equal(t, map[string]bool{"role-b": true}, client.roles)
+	assert.Equal(t, []string{common.RevokeReasonUserRolesChanged}, revoker.reasons)
+	assert.Equal(t, []string{userID}, revoker.userIDs)
+}
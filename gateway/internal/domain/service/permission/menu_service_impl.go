@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
-	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/http_base"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/permission"
 	permissionConv "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/permission"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/common"
@@ -18,46 +17,63 @@ type menuServiceImpl struct {
 	*common.BaseService
 	identityClient identitycli.IdentityClient
 	assembler      permissionConv.Assembler
+	tokenRevoker   common.TokenRevoker
 }
 
 // NewMenuService creates a new menu service instance.
 func NewMenuService(
 	identityClient identitycli.IdentityClient,
 	assembler permissionConv.Assembler,
+	tokenRevoker common.TokenRevoker,
 	logger *hertzZerolog.Logger,
 ) MenuService {
 	return &menuServiceImpl{
 		BaseService:    common.NewBaseService(logger),
 		identityClient: identityClient,
 		assembler:      assembler,
+		tokenRevoker:   tokenRevoker,
 	}
 }
 
-// UploadMenu 上传菜单配置到权限服务
+// UploadMenu 上传菜单配置到权限服务，dryRun 时只返回校验结果与影响报告
 func (s *menuServiceImpl) UploadMenu(
 	ctx context.Context,
+	operatorID string,
 	req *permission.UploadMenuRequestDTO,
-) (*http_base.OperationStatusResponseDTO, error) {
+) (*permission.UploadMenuResponseDTO, error) {
 	// 使用BaseService模板处理RPC调用
-	err := s.ProcessRPCVoidCall(ctx, "上传菜单配置",
-		func(ctx context.Context) error {
+	result, err := s.ProcessRPCCall(ctx, "上传菜单配置",
+		func(ctx context.Context) (interface{}, error) {
 			// 转换为RPC请求
-			rpcReq := s.assembler.Menu().ToRPCUploadMenuRequest(req)
+			rpcReq := s.assembler.Menu().ToRPCUploadMenuRequest(operatorID, req)
 			if rpcReq == nil {
-				return fmt.Errorf("转换上传请求失败")
+				return nil, fmt.Errorf("转换上传请求失败")
 			}
 
 			// 调用RPC服务
 			return s.identityClient.UploadMenu(ctx, rpcReq)
 		},
 		"yaml_size", len(req.MenuFile),
+		"dry_run", req.GetDryRun(),
+		"force", req.GetForce(),
+		"operator_id", operatorID,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// 使用ResponseBuilder构建响应
-	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
+	// 转换RPC响应为HTTP响应
+	rpcResp := result.(*identity_srv.UploadMenuResponse)
+
+	httpResp := s.assembler.Menu().ToHTTPUploadMenuResponse(rpcResp)
+	if httpResp == nil {
+		return nil, fmt.Errorf("转换菜单上传响应失败")
+	}
+
+	// 设置成功的基础响应
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
 }
 
 // GetMenuTree 获取菜单树结构
@@ -89,6 +105,123 @@ func (s *menuServiceImpl) GetMenuTree(
 	return httpResp, nil
 }
 
+// ListMenuVersions 列出菜单版本
+func (s *menuServiceImpl) ListMenuVersions(
+	ctx context.Context,
+) (*permission.ListMenuVersionsResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "列出菜单版本",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.ListMenuVersions(ctx)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.ListMenuVersionsResponse)
+
+	httpResp := s.assembler.Menu().ToHTTPListMenuVersionsResponse(rpcResp)
+	if httpResp == nil {
+		return nil, fmt.Errorf("转换菜单版本列表响应失败")
+	}
+
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
+
+// DiffMenuVersions 比较两个菜单版本
+func (s *menuServiceImpl) DiffMenuVersions(
+	ctx context.Context,
+	req *permission.DiffMenuVersionsRequestDTO,
+) (*permission.DiffMenuVersionsResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "比较菜单版本",
+		func(ctx context.Context) (interface{}, error) {
+			rpcReq := s.assembler.Menu().ToRPCDiffMenuVersionsRequest(req)
+			if rpcReq == nil {
+				return nil, fmt.Errorf("转换菜单版本比较请求失败")
+			}
+
+			return s.identityClient.DiffMenuVersions(ctx, rpcReq)
+		},
+		"from_version", req.GetFromVersion(),
+		"to_version", req.GetToVersion(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.DiffMenuVersionsResponse)
+
+	httpResp := s.assembler.Menu().ToHTTPDiffMenuVersionsResponse(rpcResp)
+	if httpResp == nil {
+		return nil, fmt.Errorf("转换菜单版本比较响应失败")
+	}
+
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
+
+// ActivateMenuVersion 激活指定的菜单版本
+func (s *menuServiceImpl) ActivateMenuVersion(
+	ctx context.Context,
+	operatorID string,
+	req *permission.ActivateMenuVersionRequestDTO,
+) (*permission.ActivateMenuVersionResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "激活菜单版本",
+		func(ctx context.Context) (interface{}, error) {
+			rpcReq := s.assembler.Menu().ToRPCActivateMenuVersionRequest(operatorID, req)
+			if rpcReq == nil {
+				return nil, fmt.Errorf("转换菜单版本激活请求失败")
+			}
+
+			return s.identityClient.ActivateMenuVersion(ctx, rpcReq)
+		},
+		"version", req.GetVersion(),
+		"operator_id", operatorID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.ActivateMenuVersionResponse)
+
+	httpResp := s.assembler.Menu().ToHTTPActivateMenuVersionResponse(rpcResp)
+	if httpResp == nil {
+		return nil, fmt.Errorf("转换菜单版本激活响应失败")
+	}
+
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
+
+// GetOrphanRoleMenuMappings 查询指向生效版本中不存在菜单的角色菜单映射
+func (s *menuServiceImpl) GetOrphanRoleMenuMappings(
+	ctx context.Context,
+) (*permission.GetOrphanRoleMenuMappingsResponseDTO, error) {
+	result, err := s.ProcessRPCCall(ctx, "查询孤立的角色菜单映射",
+		func(ctx context.Context) (interface{}, error) {
+			return s.identityClient.GetOrphanRoleMenuMappings(ctx)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp := result.(*identity_srv.GetOrphanRoleMenuMappingsResponse)
+
+	httpResp := s.assembler.Menu().ToHTTPGetOrphanRoleMenuMappingsResponse(rpcResp)
+	if httpResp == nil {
+		return nil, fmt.Errorf("转换孤立角色菜单映射响应失败")
+	}
+
+	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
+
+	return httpResp, nil
+}
+
 // =================================================================
 // 菜单权限管理服务方法实现
 // =================================================================
@@ -118,6 +251,15 @@ func (s *menuServiceImpl) ConfigureRoleMenus(
 		return nil, err
 	}
 
+	// 角色菜单权限变更后，使持有该角色的用户重新登录以获取最新权限
+	userIDs, err := listRoleUserIDs(ctx, s.identityClient, *req.RoleID)
+	if err != nil {
+		s.Logger().Warnf("Failed to load role users for token revocation: roleID=%s, error=%v",
+			*req.RoleID, err)
+	} else {
+		s.tokenRevoker.RevokeUserTokens(ctx, common.RevokeReasonRoleMenusChanged, userIDs...)
+	}
+
 	// 转换RPC响应为HTTP响应
 	rpcResp := result.(*identity_srv.ConfigureRoleMenusResponse)
 
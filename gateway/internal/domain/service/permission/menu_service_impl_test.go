@@ -0,0 +1,43 @@
+package permission
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/permission"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigureRoleMenus_RevokesRoleUsers(t *testing.T) {
+	revoker := &recordingRevoker{}
+	svc := NewMenuService(newRoleUsersClient(), newTestPermissionAssembler(), revoker, hertzZerolog.New())
+
+	roleID := "role-1"
+	_, err := svc.ConfigureRoleMenus(context.Background(), "operator-1", &permission.ConfigureRoleMenusRequestDTO{
+		RoleID: &roleID,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []revocation{{
+		reason:  common.RevokeReasonRoleMenusChanged,
+		userIDs: []string{"user-1", "user-2"},
+	}}, revoker.revocations)
+}
+
+func TestConfigureRoleMenus_FailureRevokesNothing(t *testing.T) {
+	client := newRoleUsersClient()
+	client.err = fmt.Errorf("identity_srv unavailable")
+	revoker := &recordingRevoker{}
+	svc := NewMenuService(client, newTestPermissionAssembler(), revoker, hertzZerolog.New())
+
+	roleID := "role-1"
+	_, err := svc.ConfigureRoleMenus(context.Background(), "operator-1", &permission.ConfigureRoleMenusRequestDTO{
+		RoleID: &roleID,
+	})
+	assert.Error(t, err)
+	assert.Empty(t, revoker.revocations)
+}
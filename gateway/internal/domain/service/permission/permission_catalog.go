@@ -0,0 +1,136 @@
+package permission
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/permission"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+)
+
+// CatalogEntry 权限目录项，对应路由声明的一个 resource:action 权限
+type CatalogEntry struct {
+	Group       string
+	Resource    string
+	Action      string
+	Description string
+}
+
+// Permission 返回 resource:action 格式的权限标识
+func (e CatalogEntry) Permission() string {
+	return e.Resource + ":" + e.Action
+}
+
+// permissionCatalog 网关路由可声明的全部接口权限，按分组排列
+// 路由注册时权限中间件会校验所声明的权限已登记在此，新增路由权限需同步补充；
+// 单元测试会扫描 biz/router 中的权限声明，校验目录与路由使用的权限完全一致（不多不少）
+var permissionCatalog = []CatalogEntry{
+	{Group: "用户管理", Resource: "user", Action: "read", Description: "查看用户"},
+	{Group: "用户管理", Resource: "user", Action: "create", Description: "创建用户"},
+	{Group: "用户管理", Resource: "user", Action: "update", Description: "更新用户"},
+	{Group: "用户管理", Resource: "user", Action: "delete", Description: "删除用户"},
+	{Group: "用户管理", Resource: "user", Action: "update_status", Description: "变更用户状态"},
+	{Group: "用户管理", Resource: "user", Action: "unlock", Description: "解锁用户"},
+	{Group: "用户管理", Resource: "user", Action: "reset_password", Description: "重置用户密码"},
+	{Group: "用户管理", Resource: "user", Action: "force_password_change", Description: "强制用户修改密码"},
+
+	{Group: "登录会话", Resource: "session", Action: "read", Description: "查看用户登录会话"},
+	{Group: "登录会话", Resource: "session", Action: "revoke", Description: "撤销用户登录会话"},
+
+	{Group: "成员关系", Resource: "membership", Action: "read", Description: "查看成员关系"},
+
+	{Group: "组织管理", Resource: "organization", Action: "read", Description: "查看组织"},
+	{Group: "组织管理", Resource: "organization", Action: "create", Description: "创建组织"},
+	{Group: "组织管理", Resource: "organization", Action: "update", Description: "更新组织"},
+	{Group: "组织管理", Resource: "organization", Action: "delete", Description: "删除组织"},
+
+	{Group: "部门管理", Resource: "department", Action: "read", Description: "查看部门"},
+	{Group: "部门管理", Resource: "department", Action: "create", Description: "创建部门"},
+	{Group: "部门管理", Resource: "department", Action: "update", Description: "更新部门"},
+	{Group: "部门管理", Resource: "department", Action: "delete", Description: "删除部门"},
+
+	{Group: "组织Logo", Resource: "logo", Action: "read", Description: "查看组织Logo"},
+	{Group: "组织Logo", Resource: "logo", Action: "upload", Description: "上传组织Logo"},
+	{Group: "组织Logo", Resource: "logo", Action: "delete", Description: "删除组织Logo"},
+
+	{Group: "角色定义", Resource: "role", Action: "read", Description: "查看角色定义与权限目录"},
+	{Group: "角色定义", Resource: "role", Action: "create", Description: "创建角色定义"},
+	{Group: "角色定义", Resource: "role", Action: "update", Description: "更新角色定义"},
+	{Group: "角色定义", Resource: "role", Action: "delete", Description: "删除角色定义"},
+	{Group: "角色定义", Resource: "role", Action: "configure_menus", Description: "配置角色菜单权限"},
+
+	{Group: "用户角色分配", Resource: "role_assignment", Action: "read", Description: "查看用户角色分配"},
+	{Group: "用户角色分配", Resource: "role_assignment", Action: "bind", Description: "为用户分配角色"},
+
+	{Group: "菜单管理", Resource: "menu", Action: "read", Description: "查看菜单"},
+	{Group: "菜单管理", Resource: "menu", Action: "upload", Description: "上传菜单配置"},
+
+	{Group: "审计日志", Resource: "audit", Action: "read", Description: "查看审计日志"},
+}
+
+// catalogIndex 以 resource:action 为键的权限目录索引
+var catalogIndex = func() map[string]CatalogEntry {
+	index := make(map[string]CatalogEntry, len(permissionCatalog))
+	for _, entry := range permissionCatalog {
+		index[entry.Permission()] = entry
+	}
+
+	return index
+}()
+
+// IsCatalogued 判断权限是否已登记在权限目录中
+func IsCatalogued(resource, action string) bool {
+	_, ok := catalogIndex[resource+":"+action]
+	return ok
+}
+
+// ValidateCatalogPermissions 校验角色权限均来自权限目录，返回包含全部未知权限的参数错误
+func ValidateCatalogPermissions(permissions []*permission.PermissionDTO) error {
+	var unknown []string
+
+	for _, p := range permissions {
+		if p == nil {
+			continue
+		}
+
+		if !IsCatalogued(p.GetResource(), p.GetAction()) {
+			unknown = append(unknown, p.GetResource()+":"+p.GetAction())
+		}
+	}
+
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	return errors.ErrInvalidParams.WithMessage(
+		fmt.Sprintf("权限未在权限目录中登记: %s", strings.Join(unknown, ", ")),
+	)
+}
+
+// buildCatalogGroups 将权限目录按分组转换为响应 DTO，保持目录中的分组与权限顺序
+func buildCatalogGroups() []*permission.PermissionCatalogGroupDTO {
+	groups := make([]*permission.PermissionCatalogGroupDTO, 0)
+	groupIndex := make(map[string]*permission.PermissionCatalogGroupDTO)
+
+	for _, entry := range permissionCatalog {
+		group, ok := groupIndex[entry.Group]
+		if !ok {
+			name := entry.Group
+			group = &permission.PermissionCatalogGroupDTO{Name: &name}
+			groupIndex[entry.Group] = group
+			groups = append(groups, group)
+		}
+
+		item := entry
+		perm := item.Permission()
+
+		group.Permissions = append(group.Permissions, &permission.PermissionCatalogItemDTO{
+			Permission:  &perm,
+			Resource:    &item.Resource,
+			Action:      &item.Action,
+			Description: &item.Description,
+		})
+	}
+
+	return groups
+}
@@ -0,0 +1,205 @@
+package permission
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/permission"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// routerDir 路由注册代码目录（相对于本包）
+	routerDir = "../../../../biz/router"
+	// routePermissionFile 路由权限常量定义文件（相对于本包）
+	routePermissionFile = "../../../application/middleware/casbin_middleware/permission.go"
+)
+
+// permissionMiddlewareFuncs 声明路由权限的中间件方法
+var permissionMiddlewareFuncs = map[string]struct{}{
+	"RequiresPermissions":    {},
+	"RequiresAnyPermissions": {},
+	"RequiresAllPermissions": {},
+}
+
+// routePermissionConstants 解析权限中间件包中的权限常量，返回常量名到权限字符串的映射
+func routePermissionConstants(t *testing.T) map[string]string {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), routePermissionFile, nil, 0)
+	require.NoError(t, err)
+
+	constants := make(map[string]string)
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+
+			for i, name := range valueSpec.Names {
+				if i >= len(valueSpec.Values) {
+					continue
+				}
+
+				lit, ok := valueSpec.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+
+				value, err := strconv.Unquote(lit.Value)
+				require.NoError(t, err)
+
+				constants[name.Name] = value
+			}
+		}
+	}
+
+	return constants
+}
+
+// routerPermissions 扫描路由注册代码，收集所有通过权限中间件声明的权限
+func routerPermissions(t *testing.T) map[string]struct{} {
+	t.Helper()
+
+	constants := routePermissionConstants(t)
+	permissions := make(map[string]struct{})
+	fset := token.NewFileSet()
+
+	err := filepath.WalkDir(routerDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") {
+			return err
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			fun, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+
+			if _, ok := permissionMiddlewareFuncs[fun.Sel.Name]; !ok {
+				return true
+			}
+
+			for _, arg := range call.Args {
+				switch arg := arg.(type) {
+				case *ast.BasicLit:
+					value, err := strconv.Unquote(arg.Value)
+					require.NoError(t, err)
+
+					permissions[value] = struct{}{}
+				case *ast.SelectorExpr:
+					value, ok := constants[arg.Sel.Name]
+					require.True(t, ok, "%s: 未知的权限常量 %s", fset.Position(arg.Pos()), arg.Sel.Name)
+
+					permissions[value] = struct{}{}
+				default:
+					t.Fatalf("%s: 权限参数需为字符串字面量或权限常量", fset.Position(arg.Pos()))
+				}
+			}
+
+			return true
+		})
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	return permissions
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func TestPermissionCatalog_MatchesRouterPermissions(t *testing.T) {
+	routed := routerPermissions(t)
+	require.NotEmpty(t, routed)
+
+	catalogued := make(map[string]struct{}, len(permissionCatalog))
+	for _, entry := range permissionCatalog {
+		_, duplicated := catalogued[entry.Permission()]
+		assert.False(t, duplicated, "权限目录重复登记: %s", entry.Permission())
+
+		catalogued[entry.Permission()] = struct{}{}
+	}
+
+	assert.Equal(t, sortedKeys(routed), sortedKeys(catalogued),
+		"权限目录需与路由声明的权限完全一致")
+}
+
+func TestPermissionCatalog_EntriesComplete(t *testing.T) {
+	for _, entry := range permissionCatalog {
+		assert.NotEmpty(t, entry.Group, entry.Permission())
+		assert.NotEmpty(t, entry.Description, entry.Permission())
+		assert.NotContains(t, entry.Resource, ":", entry.Permission())
+	}
+}
+
+func newPermissionDTO(resource, action string) *permission.PermissionDTO {
+	return &permission.PermissionDTO{Resource: &resource, Action: &action}
+}
+
+func TestValidateCatalogPermissions(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.NoError(t, ValidateCatalogPermissions(nil))
+	})
+
+	t.Run("catalogued", func(t *testing.T) {
+		err := ValidateCatalogPermissions([]*permission.PermissionDTO{
+			newPermissionDTO("user", "read"),
+			nil,
+			newPermissionDTO("role", "configure_menus"),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		err := ValidateCatalogPermissions([]*permission.PermissionDTO{
+			newPermissionDTO("user", "read"),
+			newPermissionDTO("user", "*"),
+			newPermissionDTO("report", "export"),
+		})
+		require.Error(t, err)
+
+		apiErr, ok := err.(errors.APIError)
+		require.True(t, ok)
+		assert.Equal(t, errors.ErrInvalidParams.Code(), apiErr.Code())
+		assert.Equal(t, "权限未在权限目录中登记: user:*, report:export", apiErr.Message())
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		err := ValidateCatalogPermissions([]*permission.PermissionDTO{{}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "权限未在权限目录中登记")
+	})
+}
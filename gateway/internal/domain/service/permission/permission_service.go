@@ -50,6 +50,9 @@ type RoleDefinitionService interface {
 		ctx context.Context,
 		req *permission.RoleDefinitionQueryRequestDTO,
 	) (*permission.RoleDefinitionListResponseDTO, error)
+
+	// GetPermissionCatalog 获取权限目录 - 返回路由可声明的全部接口权限及其分组与描述
+	GetPermissionCatalog(ctx context.Context) (*permission.GetPermissionCatalogResponseDTO, error)
 }
 
 // UserRoleAssignmentService 用户角色分配管理服务接口
@@ -84,14 +87,38 @@ type MenuService interface {
 	// UploadMenu 上传菜单 - 上传菜单到权限引擎
 	UploadMenu(
 		ctx context.Context,
+		operatorID string,
 		req *permission.UploadMenuRequestDTO,
-	) (*http_base.OperationStatusResponseDTO, error)
+	) (*permission.UploadMenuResponseDTO, error)
 
 	// GetMenuTree 获取菜单树 - 获取权限引擎中的菜单树结构
 	GetMenuTree(
 		ctx context.Context,
 	) (*permission.GetMenuTreeResponseDTO, error)
 
+	// ListMenuVersions 列出菜单版本 - 含上传者、上传时间和生效状态
+	ListMenuVersions(
+		ctx context.Context,
+	) (*permission.ListMenuVersionsResponseDTO, error)
+
+	// DiffMenuVersions 比较菜单版本 - 按语义ID给出新增、删除、移动和重命名的菜单
+	DiffMenuVersions(
+		ctx context.Context,
+		req *permission.DiffMenuVersionsRequestDTO,
+	) (*permission.DiffMenuVersionsResponseDTO, error)
+
+	// ActivateMenuVersion 激活菜单版本 - 将历史版本设为生效版本
+	ActivateMenuVersion(
+		ctx context.Context,
+		operatorID string,
+		req *permission.ActivateMenuVersionRequestDTO,
+	) (*permission.ActivateMenuVersionResponseDTO, error)
+
+	// GetOrphanRoleMenuMappings 查询孤立的角色菜单映射 - 指向生效版本中不存在菜单的映射
+	GetOrphanRoleMenuMappings(
+		ctx context.Context,
+	) (*permission.GetOrphanRoleMenuMappingsResponseDTO, error)
+
 	// ConfigureRoleMenus 配置角色的菜单权限 - 为指定角色配置菜单权限
 	ConfigureRoleMenus(
 		ctx context.Context,
@@ -123,3 +150,37 @@ type MenuService interface {
 		req *permission.HasMenuPermissionRequestDTO,
 	) (*permission.HasMenuPermissionResponseDTO, error)
 }
+
+// AuthorizationService 接口权限校验服务接口
+// 供 Casbin 权限中间件使用，权限判定委托给 identity_srv 的 Casbin 策略
+// organizationID 为用户当前代表的组织，为空时只按全局授权的角色判定
+type AuthorizationService interface {
+	// CheckPermission 检查用户在当前组织内是否具有指定资源的操作权限
+	CheckPermission(ctx context.Context, userID, organizationID, resource, action string) (bool, error)
+
+	// CheckRole 检查用户在当前组织内是否拥有任一指定角色（角色名称或角色ID）
+	CheckRole(ctx context.Context, userID, organizationID string, roles []string) (bool, error)
+
+	// GetUserEffectivePermissions 批量获取用户在当前组织内的全部生效接口权限，供中间件缓存后本地判定
+	GetUserEffectivePermissions(ctx context.Context, userID, organizationID string) (*EffectivePermissions, error)
+}
+
+// EffectivePermissions 用户的生效接口权限集合（含继承角色的权限）
+type EffectivePermissions struct {
+	// IsSuperAdmin 是否为超级管理员，超级管理员拥有全部权限
+	IsSuperAdmin bool
+
+	// Permissions 以 resource:action 为键的权限集合
+	Permissions map[string]struct{}
+}
+
+// Allows 判断权限集合是否包含指定资源的操作权限
+func (p *EffectivePermissions) Allows(resource, action string) bool {
+	if p.IsSuperAdmin {
+		return true
+	}
+
+	_, ok := p.Permissions[resource+":"+action]
+
+	return ok
+}
@@ -70,6 +70,12 @@ func (s *permissionServiceImpl) ListRoleDefinitions(
 	return s.roleDefinitionService.ListRoleDefinitions(ctx, req)
 }
 
+func (s *permissionServiceImpl) GetPermissionCatalog(
+	ctx context.Context,
+) (*permission.GetPermissionCatalogResponseDTO, error) {
+	return s.roleDefinitionService.GetPermissionCatalog(ctx)
+}
+
 // =================================================================
 // UserRoleAssignmentService 接口实现 - 委托给 userRoleAssignmentService
 // =================================================================
@@ -139,9 +145,10 @@ func (s *permissionServiceImpl) BatchBindUsersToRole(
 
 func (s *permissionServiceImpl) UploadMenu(
 	ctx context.Context,
+	operatorID string,
 	req *permission.UploadMenuRequestDTO,
-) (*http_base.OperationStatusResponseDTO, error) {
-	return s.menuService.UploadMenu(ctx, req)
+) (*permission.UploadMenuResponseDTO, error) {
+	return s.menuService.UploadMenu(ctx, operatorID, req)
 }
 
 func (s *permissionServiceImpl) GetMenuTree(
@@ -150,6 +157,33 @@ func (s *permissionServiceImpl) GetMenuTree(
 	return s.menuService.GetMenuTree(ctx)
 }
 
+func (s *permissionServiceImpl) ListMenuVersions(
+	ctx context.Context,
+) (*permission.ListMenuVersionsResponseDTO, error) {
+	return s.menuService.ListMenuVersions(ctx)
+}
+
+func (s *permissionServiceImpl) DiffMenuVersions(
+	ctx context.Context,
+	req *permission.DiffMenuVersionsRequestDTO,
+) (*permission.DiffMenuVersionsResponseDTO, error) {
+	return s.menuService.DiffMenuVersions(ctx, req)
+}
+
+func (s *permissionServiceImpl) ActivateMenuVersion(
+	ctx context.Context,
+	operatorID string,
+	req *permission.ActivateMenuVersionRequestDTO,
+) (*permission.ActivateMenuVersionResponseDTO, error) {
+	return s.menuService.ActivateMenuVersion(ctx, operatorID, req)
+}
+
+func (s *permissionServiceImpl) GetOrphanRoleMenuMappings(
+	ctx context.Context,
+) (*permission.GetOrphanRoleMenuMappingsResponseDTO, error) {
+	return s.menuService.GetOrphanRoleMenuMappings(ctx)
+}
+
 func (s *permissionServiceImpl) ConfigureRoleMenus(
 	ctx context.Context,
 	operatorID string,
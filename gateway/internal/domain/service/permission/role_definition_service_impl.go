@@ -9,6 +9,7 @@ import (
 	permissionconv "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/permission"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/common"
 	identitycli "github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/client/identity_cli"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/redis"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
 )
 
@@ -17,18 +18,21 @@ type roleDefinitionServiceImpl struct {
 	*common.BaseService
 	identityClient identitycli.IdentityClient
 	assembler      permissionconv.Assembler
+	invalidation   redis.PermissionInvalidationService
 }
 
 // NewRoleDefinitionService
 func NewRoleDefinitionService(
 	identityClient identitycli.IdentityClient,
 	assembler permissionconv.Assembler,
+	invalidation redis.PermissionInvalidationService,
 	logger *hertzZerolog.Logger,
 ) RoleDefinitionService {
 	return &roleDefinitionServiceImpl{
 		BaseService:    common.NewBaseService(logger),
 		identityClient: identityClient,
 		assembler:      assembler,
+		invalidation:   invalidation,
 	}
 }
 
@@ -36,6 +40,10 @@ func (s *roleDefinitionServiceImpl) CreateRoleDefinition(
 	ctx context.Context,
 	req *permission.RoleDefinitionCreateRequestDTO,
 ) (*permission.RoleDefinitionCreateResponseDTO, error) {
+	if err := ValidateCatalogPermissions(req.Permissions); err != nil {
+		return nil, err
+	}
+
 	result, err := s.ProcessRPCCall(ctx, "创建角色定义",
 		func(ctx context.Context) (interface{}, error) {
 			rpcReq := s.assembler.Role().ToRPCRoleDefinitionCreateRequest(req)
@@ -62,6 +70,10 @@ func (s *roleDefinitionServiceImpl) UpdateRoleDefinition(
 	ctx context.Context,
 	req *permission.RoleDefinitionUpdateRequestDTO,
 ) (*permission.RoleDefinitionUpdateResponseDTO, error) {
+	if err := ValidateCatalogPermissions(req.Permissions); err != nil {
+		return nil, err
+	}
+
 	result, err := s.ProcessRPCCall(ctx, "更新角色定义",
 		func(ctx context.Context) (interface{}, error) {
 			rpcReq := s.assembler.Role().ToRPCRoleDefinitionUpdateRequest(req)
@@ -72,6 +84,9 @@ func (s *roleDefinitionServiceImpl) UpdateRoleDefinition(
 		return nil, err
 	}
 
+	// 角色的权限或状态变更影响持有该角色的所有用户，清除全部权限判定缓存
+	s.invalidatePermissionCache(ctx)
+
 	rpcResp := result.(*identity_srv.RoleDefinition)
 
 	httpRoleDefinition := s.assembler.Role().ToHTTPRoleDefinition(rpcResp)
@@ -98,9 +113,19 @@ func (s *roleDefinitionServiceImpl) DeleteRoleDefinition(
 		return nil, err
 	}
 
+	s.invalidatePermissionCache(ctx)
+
 	return s.ResponseBuilder().BuildOperationStatusResponse(), nil
 }
 
+// invalidatePermissionCache 通知各网关副本清除全部用户的权限判定缓存
+// 通知为尽力而为：失败时仅记录日志，缓存最迟在 TTL 后过期
+func (s *roleDefinitionServiceImpl) invalidatePermissionCache(ctx context.Context) {
+	if err := s.invalidation.Publish(ctx, redis.PermissionInvalidateAll); err != nil {
+		s.Logger().Warnf("Failed to publish permission cache invalidation: %v", err)
+	}
+}
+
 func (s *roleDefinitionServiceImpl) GetRoleDefinition(
 	ctx context.Context,
 	req *permission.RoleDefinitionGetRequestDTO,
@@ -153,3 +178,13 @@ func (s *roleDefinitionServiceImpl) ListRoleDefinitions(
 
 	return httpResp, nil
 }
+
+// GetPermissionCatalog 获取权限目录，目录由网关维护，无需调用 identity_srv
+func (s *roleDefinitionServiceImpl) GetPermissionCatalog(
+	ctx context.Context,
+) (*permission.GetPermissionCatalogResponseDTO, error) {
+	return &permission.GetPermissionCatalogResponseDTO{
+		BaseResp: s.ResponseBuilder().BuildSuccessResponse(),
+		Groups:   buildCatalogGroups(),
+	}, nil
+}
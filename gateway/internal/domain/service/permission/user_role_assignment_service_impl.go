@@ -16,18 +16,21 @@ type userRoleAssignmentServiceImpl struct {
 	*common.BaseService
 	identityClient identitycli.IdentityClient
 	assembler      permissionconv.Assembler
+	tokenRevoker   common.TokenRevoker
 }
 
 // NewUserRoleAssignmentService
 func NewUserRoleAssignmentService(
 	identityClient identitycli.IdentityClient,
 	assembler permissionconv.Assembler,
+	tokenRevoker common.TokenRevoker,
 	logger *hertzZerolog.Logger,
 ) UserRoleAssignmentService {
 	return &userRoleAssignmentServiceImpl{
 		BaseService:    common.NewBaseService(logger),
 		identityClient: identityClient,
 		assembler:      assembler,
+		tokenRevoker:   tokenRevoker,
 	}
 }
 
@@ -100,6 +103,13 @@ func (s *userRoleAssignmentServiceImpl) BatchBindUsersToRole(
 	operatorID string,
 	req *permission.BatchBindUsersToRoleRequestDTO,
 ) (*permission.BatchBindUsersToRoleResponseDTO, error) {
+	// 批量绑定会替换角色下的全部用户，先记录原有用户以便计算受影响的用户
+	previousUserIDs, err := listRoleUserIDs(ctx, s.identityClient, req.GetRoleID())
+	if err != nil {
+		s.Logger().Warnf("Failed to load role users before batch bind: roleID=%s, error=%v",
+			req.GetRoleID(), err)
+	}
+
 	result, err := s.ProcessRPCCall(ctx, "批量绑定用户到角色",
 		func(ctx context.Context) (interface{}, error) {
 			rpcReq := s.assembler.UserRole().ToRPCBatchBindUsersToRoleRequest(operatorID, req)
@@ -110,9 +120,61 @@ func (s *userRoleAssignmentServiceImpl) BatchBindUsersToRole(
 		return nil, err
 	}
 
+	// 被移出或新加入角色的用户，令牌中的角色信息已过期
+	s.tokenRevoker.RevokeUserTokens(
+		ctx,
+		common.RevokeReasonUserRolesChanged,
+		symmetricDifference(previousUserIDs, req.GetUserIDs())...,
+	)
+
 	rpcResp := result.(*identity_srv.BatchBindUsersToRoleResponse)
 	httpResp := s.assembler.UserRole().ToHTTPBatchBindUsersToRoleResponse(rpcResp)
 	httpResp.BaseResp = s.ResponseBuilder().BuildSuccessResponse()
 
 	return httpResp, nil
 }
+
+// listRoleUserIDs 获取角色下所有用户的ID
+func listRoleUserIDs(
+	ctx context.Context,
+	identityClient identitycli.IdentityClient,
+	roleID string,
+) ([]string, error) {
+	resp, err := identityClient.GetUsersByRole(ctx, &identity_srv.GetUsersByRoleRequest{
+		RoleID: &roleID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetUserIDs(), nil
+}
+
+// symmetricDifference 返回只在其中一个列表中出现的元素
+func symmetricDifference(a, b []string) []string {
+	inA := make(map[string]bool, len(a))
+	for _, id := range a {
+		inA[id] = true
+	}
+
+	inB := make(map[string]bool, len(b))
+	for _, id := range b {
+		inB[id] = true
+	}
+
+	diff := make([]string, 0)
+
+	for id := range inA {
+		if !inB[id] {
+			diff = append(diff, id)
+		}
+	}
+
+	for id := range inB {
+		if !inA[id] {
+			diff = append(diff, id)
+		}
+	}
+
+	return diff
+}
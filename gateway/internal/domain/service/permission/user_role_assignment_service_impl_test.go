@@ -0,0 +1,134 @@
+package permission
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/cloudwego/kitex/client/callopt"
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/biz/model/permission"
+	permissionconv "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/permission"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/common"
+	identitycli "github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/client/identity_cli"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roleUsersClient 在内存中维护角色下的用户，err 非空时写操作失败；未实现的方法调用时 panic
+type roleUsersClient struct {
+	identitycli.IdentityClient
+
+	roleUsers map[string][]string
+	err       error
+}
+
+func (c *roleUsersClient) GetUsersByRole(
+	_ context.Context,
+	req *identity_srv.GetUsersByRoleRequest,
+	_ ...callopt.Option,
+) (*identity_srv.GetUsersByRoleResponse, error) {
+	return &identity_srv.GetUsersByRoleResponse{UserIDs: c.roleUsers[req.GetRoleID()]}, nil
+}
+
+func (c *roleUsersClient) BatchBindUsersToRole(
+	_ context.Context,
+	req *identity_srv.BatchBindUsersToRoleRequest,
+	_ ...callopt.Option,
+) (*identity_srv.BatchBindUsersToRoleResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	c.roleUsers[req.GetRoleID()] = req.GetUserIDs()
+
+	return &identity_srv.BatchBindUsersToRoleResponse{}, nil
+}
+
+func (c *roleUsersClient) ConfigureRoleMenus(
+	context.Context,
+	*identity_srv.ConfigureRoleMenusRequest,
+	...callopt.Option,
+) (*identity_srv.ConfigureRoleMenusResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &identity_srv.ConfigureRoleMenusResponse{}, nil
+}
+
+// revocation 一次令牌吊销请求
+type revocation struct {
+	reason  string
+	userIDs []string
+}
+
+// recordingRevoker 记录令牌吊销请求，用户ID排序后保存
+type recordingRevoker struct {
+	revocations []revocation
+}
+
+func (r *recordingRevoker) RevokeUserTokens(_ context.Context, reason string, userIDs ...string) {
+	sorted := append([]string(nil), userIDs...)
+	sort.Strings(sorted)
+
+	r.revocations = append(r.revocations, revocation{reason: reason, userIDs: sorted})
+}
+
+func newTestPermissionAssembler() permissionconv.Assembler {
+	return permissionconv.NewPermissionAggregateAssembler(
+		permissionconv.NewRoleAssembler(permissionconv.NewPermissionAssembler()),
+		permissionconv.NewPermissionAssembler(),
+		permissionconv.NewUserRoleAssembler(),
+		permissionconv.NewMenuAssembler(),
+	)
+}
+
+func newRoleUsersClient() *roleUsersClient {
+	return &roleUsersClient{roleUsers: map[string][]string{"role-1": {"user-1", "user-2"}}}
+}
+
+func TestBatchBindUsersToRole_RevokesChangedUsers(t *testing.T) {
+	client := newRoleUsersClient()
+	revoker := &recordingRevoker{}
+	svc := NewUserRoleAssignmentService(client, newTestPermissionAssembler(), revoker, hertzZerolog.New())
+
+	roleID := "role-1"
+	_, err := svc.BatchBindUsersToRole(context.Background(), "operator-1", &permission.BatchBindUsersToRoleRequestDTO{
+		RoleID:  &roleID,
+		UserIDs: []string{"user-2", "user-3"},
+	})
+	require.NoError(t, err)
+
+	// user-1 被移出、user-3 新加入，保留在角色中的 user-2 不受影响
+	assert.Equal(t, []revocation{{
+		reason:  common.RevokeReasonUserRolesChanged,
+		userIDs: []string{"user-1", "user-3"},
+	}}, revoker.revocations)
+}
+
+func TestBatchBindUsersToRole_FailureRevokesNothing(t *testing.T) {
+	client := newRoleUsersClient()
+	client.err = fmt.Errorf("identity_srv unavailable")
+	revoker := &recordingRevoker{}
+	svc := NewUserRoleAssignmentService(client, newTestPermissionAssembler(), revoker, hertzZerolog.New())
+
+	roleID := "role-1"
+	_, err := svc.BatchBindUsersToRole(context.Background(), "operator-1", &permission.BatchBindUsersToRoleRequestDTO{
+		RoleID:  &roleID,
+		UserIDs: []string{"user-3"},
+	})
+	assert.Error(t, err)
+	assert.Empty(t, revoker.revocations)
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	diff := symmetricDifference([]string{"a", "b", "b", "c"}, []string{"c", "d"})
+	sort.Strings(diff)
+
+	assert.Equal(t, []string{"a", "b", "d"}, diff)
+	assert.Empty(t, symmetricDifference([]string{"a"}, []string{"a"}))
+	assert.Empty(t, symmetricDifference(nil, nil))
+}
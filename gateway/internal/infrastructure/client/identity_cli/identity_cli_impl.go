@@ -10,6 +10,7 @@ import (
 	"github.com/cloudwego/kitex/pkg/transmeta"
 	"github.com/cloudwego/kitex/transport"
 	etcd "github.com/kitex-contrib/registry-etcd"
+	clientmw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/client/middleware"
 	conf "github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/config"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv/identityservice"
 )
@@ -72,12 +73,6 @@ func NewIdentityClient() (IdentityClient, error) {
 		client.WithMetaHandler(transmeta.ClientTTHeaderHandler),
 		// 配置长连接池:cite[4]
 		client.WithLongConnection(idleConfig),
-		// 配置熔断器中间件:cite[6]
-		// client.WithMiddleware(cbSuite.ServiceCBMW()),
-		// 配置实例级别的熔断器中间件（支持自动重试）:cite[6]
-		// client.WithInstanceMW(cbSuite.InstanceCBMW()),
-		// 可选：配置熔断器中间件（使用 kitex-contrib/cbreaker）
-		// client.WithMiddleware(cbreaker.NewCircuitBreakerMiddleware()),
 		// 配置负载均衡策略:cite[2]
 		// client.WithLoadBalance(loadbalance.NewWeightedRoundBalancer()),
 	}
@@ -85,6 +80,21 @@ func NewIdentityClient() (IdentityClient, error) {
 	// 配置超时
 	opts = configureTimeouts(opts)
 
+	// 配置方法级/实例级熔断，下游变慢时快速失败，避免请求堆积到超时
+	opts = configureCircuitBreaker(opts, conf.Config.Client.CircuitBreaker, identityServiceName)
+
+	// 仅为幂等读方法配置重试
+	opts, err = configureRetry(opts, conf.Config.Client.Retry)
+	if err != nil {
+		slog.Error("Invalid identity client retry config", "error", err)
+		return nil, err
+	}
+
+	// 启用链路追踪时为每次调用创建客户端 Span，并通过 TTHeader 传播 traceparent
+	if conf.Config.Tracing.Enabled {
+		opts = append(opts, client.WithMiddleware(clientmw.TraceClientMiddleware()))
+	}
+
 	cli, err := identityservice.NewClient(
 		identityServiceName,
 		opts...,
@@ -0,0 +1,230 @@
+package identitycli
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/circuitbreak"
+	"github.com/cloudwego/kitex/pkg/event"
+	"github.com/cloudwego/kitex/pkg/retry"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/observability"
+	conf "github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/config"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv/identityservice"
+)
+
+// 重试模式
+const (
+	retryModeFailure = "failure" // 超时后重试
+	retryModeBackup  = "backup"  // 首次调用超过延迟未返回时发起备份请求
+)
+
+// Kitex 重试策略的取值上限，超出时 Kitex 会直接 panic，因此在构建前先校验
+const (
+	maxFailureRetryTimes   = 5
+	maxBackupRetryTimes    = 2
+	maxRetryBreakerErrRate = 0.3
+)
+
+// 熔断器状态名称到指标值的映射（与 gopkg circuitbreaker.State 的字符串形式一致）
+var cbStateValues = map[string]observability.CircuitBreakerState{
+	"CLOSED":   observability.CircuitBreakerClosed,
+	"HALFOPEN": observability.CircuitBreakerHalfOpen,
+	"OPEN":     observability.CircuitBreakerOpen,
+}
+
+// configureCircuitBreaker 配置方法级与实例级熔断
+// 方法级熔断以 IDL 方法名为键，每个方法独立统计；实例级熔断仅统计连接失败
+func configureCircuitBreaker(
+	opts []client.Option,
+	cfg conf.CircuitBreakerConfig,
+	serviceName string,
+) []client.Option {
+	if !cfg.Enabled && !cfg.InstanceEnabled {
+		return opts
+	}
+
+	cbSuite := circuitbreak.NewCBSuite(func(ri rpcinfo.RPCInfo) string {
+		return ri.To().Method()
+	})
+
+	// CBSuite 通过事件队列上报状态变化，替换为带日志与指标的队列
+	cbSuite.SetEventBusAndQueue(nil, &cbStateQueue{
+		Queue:   event.NewQueue(event.MaxEventNum),
+		service: serviceName,
+	})
+
+	if cfg.Enabled {
+		// 未显式配置的方法会使用 Kitex 内置默认值，因此为全部方法写入配置
+		for method := range identityservice.NewServiceInfoForClient().Methods {
+			rule := circuitbreak.CBConfig{Enable: true, ErrRate: cfg.ErrRate, MinSample: cfg.MinSample}
+			if override, ok := cfg.Methods[method]; ok {
+				rule.ErrRate = override.ErrRate
+				rule.MinSample = override.MinSample
+			}
+
+			cbSuite.UpdateServiceCBConfig(method, rule)
+		}
+
+		opts = append(opts, client.WithMiddleware(cbSuite.ServiceCBMW()))
+		slog.Info("Enabled service circuit breaker",
+			"service", serviceName,
+			"err_rate", cfg.ErrRate,
+			"min_sample", cfg.MinSample,
+			"method_overrides", len(cfg.Methods),
+		)
+	}
+
+	if cfg.InstanceEnabled {
+		cbSuite.UpdateInstanceCBConfig(circuitbreak.CBConfig{
+			Enable:    true,
+			ErrRate:   cfg.ErrRate,
+			MinSample: cfg.MinSample,
+		})
+
+		opts = append(opts, client.WithInstanceMW(cbSuite.InstanceCBMW()))
+		slog.Info("Enabled instance circuit breaker", "service", serviceName)
+	}
+
+	return opts
+}
+
+// configureRetry 为幂等读方法配置重试策略，未列出的方法不重试
+func configureRetry(opts []client.Option, cfg conf.RetryConfig) ([]client.Option, error) {
+	if !cfg.Enabled || len(cfg.Methods) == 0 {
+		return opts, nil
+	}
+
+	policy, err := buildRetryPolicy(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	methods := identityservice.NewServiceInfoForClient().Methods
+	policies := make(map[string]retry.Policy, len(cfg.Methods))
+
+	for _, method := range cfg.Methods {
+		if _, ok := methods[method]; !ok {
+			slog.Warn("Ignore retry policy for unknown method", "method", method)
+			continue
+		}
+
+		policies[method] = policy
+	}
+
+	if len(policies) == 0 {
+		return opts, nil
+	}
+
+	slog.Info("Enabled retry policy", "mode", cfg.Mode, "methods", cfg.Methods, "max_times", cfg.MaxTimes)
+
+	return append(opts, client.WithRetryMethodPolicies(policies)), nil
+}
+
+// buildRetryPolicy 根据配置构建重试策略
+func buildRetryPolicy(cfg conf.RetryConfig) (retry.Policy, error) {
+	if cfg.BreakerErrRate <= 0 || cfg.BreakerErrRate > maxRetryBreakerErrRate {
+		return retry.Policy{}, fmt.Errorf(
+			"invalid retry breaker err rate %.2f, must be in (0, %.1f]",
+			cfg.BreakerErrRate,
+			maxRetryBreakerErrRate,
+		)
+	}
+
+	switch cfg.Mode {
+	case retryModeBackup:
+		if cfg.MaxTimes < 0 || cfg.MaxTimes > maxBackupRetryTimes {
+			return retry.Policy{}, fmt.Errorf("invalid backup retry times %d, max is %d", cfg.MaxTimes, maxBackupRetryTimes)
+		}
+
+		delayMS := cfg.BackupDelay.Milliseconds()
+		if delayMS <= 0 {
+			return retry.Policy{}, fmt.Errorf("invalid backup delay %s", cfg.BackupDelay)
+		}
+
+		bp := retry.NewBackupPolicy(uint32(delayMS))
+		bp.WithMaxRetryTimes(cfg.MaxTimes)
+		bp.WithRetryBreaker(cfg.BreakerErrRate)
+
+		return retry.BuildBackupRequest(bp), nil
+	case retryModeFailure, "":
+		if cfg.MaxTimes < 0 || cfg.MaxTimes > maxFailureRetryTimes {
+			return retry.Policy{}, fmt.Errorf(
+				"invalid failure retry times %d, max is %d",
+				cfg.MaxTimes,
+				maxFailureRetryTimes,
+			)
+		}
+
+		// 默认仅对超时重试，业务错误不会触发重试
+		fp := retry.NewFailurePolicy()
+		fp.WithMaxRetryTimes(cfg.MaxTimes)
+		fp.WithRetryBreaker(cfg.BreakerErrRate)
+
+		if cfg.MaxDuration > 0 {
+			fp.WithMaxDurationMS(uint32(cfg.MaxDuration.Milliseconds()))
+		}
+
+		return retry.BuildFailurePolicy(fp), nil
+	default:
+		return retry.Policy{}, fmt.Errorf("unsupported retry mode %q", cfg.Mode)
+	}
+}
+
+// cbStateQueue 熔断器状态变化事件队列
+// 保留原有事件环形队列的同时，将状态变化记录到日志与指标
+type cbStateQueue struct {
+	event.Queue
+	service string
+}
+
+// Push 记录熔断器状态变化事件
+func (q *cbStateQueue) Push(e *event.Event) {
+	q.Queue.Push(e)
+
+	level := strings.TrimSuffix(e.Name, "_cb")
+
+	key, from, to, ok := parseCBStateChange(e.Detail)
+	if !ok {
+		slog.Warn("Circuit breaker state changed", "service", q.service, "level", level, "detail", e.Detail)
+		return
+	}
+
+	logFn := slog.Info
+	if to == "OPEN" {
+		logFn = slog.Warn
+	}
+
+	logFn("Circuit breaker state changed",
+		"service", q.service,
+		"level", level,
+		"key", key,
+		"from", from,
+		"to", to,
+		"detail", e.Detail,
+	)
+
+	if state, exists := cbStateValues[to]; exists {
+		observability.RecordCircuitBreakerState(q.service, level, key, state)
+	}
+}
+
+// parseCBStateChange 解析 CBSuite 状态变化事件详情
+// 格式："<key>: <old> -> <new>, (succ: x, err: x, timeout: x, rate: x)"
+func parseCBStateChange(detail string) (key, from, to string, ok bool) {
+	key, rest, found := strings.Cut(detail, ": ")
+	if !found {
+		return "", "", "", false
+	}
+
+	transition, _, _ := strings.Cut(rest, ", ")
+
+	from, to, found = strings.Cut(transition, " -> ")
+	if !found {
+		return "", "", "", false
+	}
+
+	return key, from, to, true
+}
@@ -0,0 +1,314 @@
+package identitycli
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/retry"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/transport"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/observability"
+	conf "github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/config"
+	apierrors "github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/errors"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv/identityservice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// silentServer 接受连接但从不响应，用于模拟变慢的 identity_srv；记录收到的连接数（短连接下即调用次数）
+type silentServer struct {
+	listener net.Listener
+	accepted atomic.Int32
+}
+
+func newSilentServer(t *testing.T) *silentServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &silentServer{listener: listener}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			s.accepted.Add(1)
+
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(io.Discard, conn)
+			}()
+		}
+	}()
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	return s
+}
+
+// closedAddress 返回没有服务监听的本地地址，调用时立即连接失败
+func closedAddress(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	return addr
+}
+
+// newTestClient 创建直连 addr 的 identity 客户端，opts 为待测的熔断与重试选项
+func newTestClient(t *testing.T, addr string, timeout time.Duration, opts ...client.Option) identityservice.Client {
+	t.Helper()
+
+	opts = append(opts,
+		client.WithHostPorts(addr),
+		client.WithRPCTimeout(timeout),
+		client.WithTransportProtocol(transport.TTHeader),
+		client.WithMetaHandler(transmeta.ClientTTHeaderHandler),
+	)
+
+	cli, err := identityservice.NewClient(defaultIdentityServiceName, opts...)
+	require.NoError(t, err)
+
+	return cli
+}
+
+// circuitBreakerState 从指标注册表读取熔断器状态，未记录时返回 -1
+func circuitBreakerState(t *testing.T, service, level, key string) float64 {
+	t.Helper()
+
+	families, err := observability.Registry.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != "gateway_rpc_circuit_breaker_state" {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			if labels["service"] == service && labels["level"] == level && labels["key"] == key {
+				return metric.GetGauge().GetValue()
+			}
+		}
+	}
+
+	return -1
+}
+
+func newRetryConfig(mode string, methods ...string) conf.RetryConfig {
+	return conf.RetryConfig{
+		Enabled:        true,
+		Mode:           mode,
+		Methods:        methods,
+		MaxTimes:       2,
+		BackupDelay:    20 * time.Millisecond,
+		BreakerErrRate: 0.1,
+	}
+}
+
+func TestBuildRetryPolicy(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
+		cfg := newRetryConfig(retryModeFailure)
+		cfg.MaxDuration = time.Second
+
+		policy, err := buildRetryPolicy(cfg)
+		require.NoError(t, err)
+		assert.True(t, policy.Enable)
+		assert.Equal(t, retry.FailureType, policy.Type)
+		require.NotNil(t, policy.FailurePolicy)
+		assert.Equal(t, 2, policy.FailurePolicy.StopPolicy.MaxRetryTimes)
+		assert.EqualValues(t, 1000, policy.FailurePolicy.StopPolicy.MaxDurationMS)
+	})
+
+	t.Run("default mode is failure", func(t *testing.T) {
+		policy, err := buildRetryPolicy(newRetryConfig(""))
+		require.NoError(t, err)
+		assert.Equal(t, retry.FailureType, policy.Type)
+	})
+
+	t.Run("backup", func(t *testing.T) {
+		cfg := newRetryConfig(retryModeBackup)
+		cfg.MaxTimes = 1
+
+		policy, err := buildRetryPolicy(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, retry.BackupType, policy.Type)
+		require.NotNil(t, policy.BackupPolicy)
+		assert.EqualValues(t, 20, policy.BackupPolicy.RetryDelayMS)
+		assert.Equal(t, 1, policy.BackupPolicy.StopPolicy.MaxRetryTimes)
+	})
+
+	invalid := map[string]func(cfg *conf.RetryConfig){
+		"breaker err rate zero":  func(cfg *conf.RetryConfig) { cfg.BreakerErrRate = 0 },
+		"breaker err rate large": func(cfg *conf.RetryConfig) { cfg.BreakerErrRate = 0.5 },
+		"failure times": func(cfg *conf.RetryConfig) {
+			cfg.MaxTimes = maxFailureRetryTimes + 1
+		},
+		"backup times": func(cfg *conf.RetryConfig) {
+			cfg.Mode = retryModeBackup
+			cfg.MaxTimes = maxBackupRetryTimes + 1
+		},
+		"backup delay": func(cfg *conf.RetryConfig) {
+			cfg.Mode = retryModeBackup
+			cfg.BackupDelay = 0
+		},
+		"unknown mode": func(cfg *conf.RetryConfig) { cfg.Mode = "hedge" },
+	}
+
+	for name, mutate := range invalid {
+		t.Run(name, func(t *testing.T) {
+			cfg := newRetryConfig(retryModeFailure)
+			mutate(&cfg)
+
+			_, err := buildRetryPolicy(cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestConfigureRetry(t *testing.T) {
+	opts, err := configureRetry(nil, conf.RetryConfig{Methods: []string{"GetUser"}})
+	require.NoError(t, err)
+	assert.Empty(t, opts, "未启用重试时不添加选项")
+
+	opts, err = configureRetry(nil, newRetryConfig(retryModeFailure, "NoSuchMethod"))
+	require.NoError(t, err)
+	assert.Empty(t, opts, "未知方法被忽略")
+
+	opts, err = configureRetry(nil, newRetryConfig(retryModeFailure, "GetUser", "NoSuchMethod"))
+	require.NoError(t, err)
+	assert.Len(t, opts, 1)
+
+	cfg := newRetryConfig(retryModeFailure, "GetUser")
+	cfg.BreakerErrRate = 1
+
+	_, err = configureRetry(nil, cfg)
+	assert.Error(t, err)
+}
+
+func TestFailureRetry_OnlyConfiguredMethods(t *testing.T) {
+	server := newSilentServer(t)
+
+	opts, err := configureRetry(nil, newRetryConfig(retryModeFailure, "GetUser"))
+	require.NoError(t, err)
+
+	cli := newTestClient(t, server.listener.Addr().String(), 50*time.Millisecond, opts...)
+
+	// 幂等读方法超时后重试，共发起 1+2 次调用
+	userID := "user-1"
+	_, err = cli.GetUser(context.Background(), &identity_srv.GetUserRequest{UserID: &userID})
+	require.Error(t, err)
+	assert.True(t, kerrors.IsTimeoutError(err), err)
+	assert.Eventually(t, func() bool { return server.accepted.Load() == 3 }, time.Second, 10*time.Millisecond)
+
+	// 写方法不重试
+	_, err = cli.UpdateUser(context.Background(), &identity_srv.UpdateUserRequest{UserID: &userID})
+	require.Error(t, err)
+	assert.Eventually(t, func() bool { return server.accepted.Load() == 4 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, apierrors.ErrGatewayTimeout, apierrors.ProcessRPCError(err, "更新用户失败"))
+}
+
+func TestBackupRequest(t *testing.T) {
+	server := newSilentServer(t)
+
+	cfg := newRetryConfig(retryModeBackup, "GetUser")
+	cfg.MaxTimes = 1
+
+	opts, err := configureRetry(nil, cfg)
+	require.NoError(t, err)
+
+	cli := newTestClient(t, server.listener.Addr().String(), 200*time.Millisecond, opts...)
+
+	// 首次调用超过备份延迟未返回时发起一次备份请求
+	userID := "user-1"
+	_, err = cli.GetUser(context.Background(), &identity_srv.GetUserRequest{UserID: &userID})
+	require.Error(t, err)
+	assert.Eventually(t, func() bool { return server.accepted.Load() == 2 }, time.Second, 10*time.Millisecond)
+}
+
+func TestServiceCircuitBreaker_FailsFast(t *testing.T) {
+	const service = "identity-service-cb-test"
+
+	opts := configureCircuitBreaker(nil, conf.CircuitBreakerConfig{
+		Enabled:   true,
+		ErrRate:   0.5,
+		MinSample: 5,
+	}, service)
+	require.Len(t, opts, 1)
+
+	cli := newTestClient(t, closedAddress(t), time.Second, opts...)
+
+	var breakErr error
+
+	userID := "user-1"
+	for range 20 {
+		_, err := cli.GetUser(context.Background(), &identity_srv.GetUserRequest{UserID: &userID})
+		require.Error(t, err)
+
+		if errors.Is(err, kerrors.ErrCircuitBreak) {
+			breakErr = err
+			break
+		}
+	}
+
+	require.Error(t, breakErr, "连续失败后熔断器应打开")
+
+	// 熔断期间快速失败，网关降级为服务暂不可用
+	assert.Equal(t, apierrors.ErrServiceDown, apierrors.ProcessRPCError(breakErr, "获取用户失败"))
+
+	// 熔断以方法为键，其他方法不受影响
+	_, err := cli.ListUsers(context.Background(), &identity_srv.ListUsersRequest{})
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, kerrors.ErrCircuitBreak), err)
+
+	// 状态变化经事件队列记录到指标
+	assert.Eventually(t, func() bool {
+		return circuitBreakerState(t, service, "service", "GetUser") == float64(observability.CircuitBreakerOpen)
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestConfigureCircuitBreaker_Disabled(t *testing.T) {
+	assert.Empty(t, configureCircuitBreaker(nil, conf.CircuitBreakerConfig{ErrRate: 0.5}, "identity-service"))
+
+	opts := configureCircuitBreaker(nil, conf.CircuitBreakerConfig{
+		Enabled:         true,
+		InstanceEnabled: true,
+		ErrRate:         0.5,
+		MinSample:       5,
+	}, "identity-service")
+	assert.Len(t, opts, 2)
+}
+
+func TestParseCBStateChange(t *testing.T) {
+	key, from, to, ok := parseCBStateChange("GetUser: CLOSED -> OPEN, (succ: 0, err: 10, timeout: 0, rate: 1.00)")
+	require.True(t, ok)
+	assert.Equal(t, "GetUser", key)
+	assert.Equal(t, "CLOSED", from)
+	assert.Equal(t, "OPEN", to)
+
+	_, _, _, ok = parseCBStateChange("unexpected detail")
+	assert.False(t, ok)
+
+	_, _, _, ok = parseCBStateChange("GetUser: OPEN")
+	assert.False(t, ok)
+}
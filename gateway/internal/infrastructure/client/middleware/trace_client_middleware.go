@@ -5,12 +5,51 @@ package middleware
 import (
 	"context"
 
+	"github.com/bytedance/gopkg/cloud/metainfo"
 	"github.com/cloudwego/kitex/pkg/endpoint"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
-// TraceClientMiddleware Kitex 客户端追踪中间件
-// 此中间件会自动从 context 的 metainfo 中读取追踪信息
-// 并通过 TTHeader 传递到 RPC 服务端
+// tracerName RPC 客户端使用的 Tracer 名称
+const tracerName = "github.com/masonsxu/cloudwego-scaffold/gateway/identity_cli"
+
+// metainfoCarrier 基于 Kitex metainfo 的 TextMapCarrier
+// traceparent/tracestate 作为瞬态值写入 metainfo，由 TTHeader 传递到 RPC 服务端
+type metainfoCarrier struct {
+	ctx context.Context
+}
+
+// Get 实现 propagation.TextMapCarrier
+func (mc *metainfoCarrier) Get(key string) string {
+	value, _ := metainfo.GetValue(mc.ctx, key)
+	return value
+}
+
+// Set 实现 propagation.TextMapCarrier
+func (mc *metainfoCarrier) Set(key, value string) {
+	mc.ctx = metainfo.WithValue(mc.ctx, key, value)
+}
+
+// Keys 实现 propagation.TextMapCarrier
+func (mc *metainfoCarrier) Keys() []string {
+	values := metainfo.GetAllValues(mc.ctx)
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// TraceClientMiddleware Kitex 客户端链路追踪中间件
+// 为每次 RPC 调用创建客户端 Span，并将 W3C traceparent 注入 metainfo 通过 TTHeader 传播
 //
 // 使用方式:
 //
@@ -22,9 +61,48 @@ import (
 func TraceClientMiddleware() endpoint.Middleware {
 	return func(next endpoint.Endpoint) endpoint.Endpoint {
 		return func(ctx context.Context, req, resp interface{}) error {
-			// metainfo 中的追踪信息会自动通过 TTHeader 传递到 RPC 服务
-			// 无需手动处理，Kitex 框架会自动传播 PersistentValue
-			return next(ctx, req, resp)
+			service, method := "unknown", "unknown"
+			ri := rpcinfo.GetRPCInfo(ctx)
+
+			if ri != nil {
+				service = ri.To().ServiceName()
+				method = ri.To().Method()
+			}
+
+			ctx, span := otel.Tracer(tracerName).Start(
+				ctx,
+				service+"/"+method,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					semconv.RPCSystemKey.String("kitex"),
+					semconv.RPCService(service),
+					semconv.RPCMethod(method),
+				),
+			)
+			defer span.End()
+
+			carrier := &metainfoCarrier{ctx: ctx}
+			otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+			err := next(carrier.ctx, req, resp)
+
+			// 业务错误记录为属性而非 Span 错误状态
+			if ri != nil {
+				if bizErr := ri.Invocation().BizStatusErr(); bizErr != nil {
+					span.SetAttributes(attribute.Int("rpc.biz_status_code", int(bizErr.BizStatusCode())))
+				}
+			}
+
+			if err != nil {
+				if bizErr, ok := kerrors.FromBizStatusError(err); ok {
+					span.SetAttributes(attribute.Int("rpc.biz_status_code", int(bizErr.BizStatusCode())))
+				} else {
+					span.RecordError(err)
+					span.SetStatus(codes.Error, err.Error())
+				}
+			}
+
+			return err
 		}
 	}
 }
@@ -33,6 +33,23 @@ func setDefaults(v *viper.Viper) {
 	// 默认服务配置
 	v.SetDefault("client.services.identity.name", "identity-service")
 
+	// 熔断默认值
+	v.SetDefault("client.circuit_breaker.enabled", true)
+	v.SetDefault("client.circuit_breaker.err_rate", 0.5)
+	v.SetDefault("client.circuit_breaker.min_sample", 200)
+	v.SetDefault("client.circuit_breaker.instance_enabled", true)
+
+	// 重试默认值（仅幂等读方法）
+	v.SetDefault("client.retry.enabled", true)
+	v.SetDefault("client.retry.mode", "failure")
+	v.SetDefault("client.retry.methods", []string{
+		"GetUser", "ListUsers", "GetUserMenuTree", "GetUserEffectivePermissions",
+	})
+	v.SetDefault("client.retry.max_times", 2)
+	v.SetDefault("client.retry.max_duration", 0)
+	v.SetDefault("client.retry.backup_delay", 100*time.Millisecond)
+	v.SetDefault("client.retry.breaker_err_rate", 0.1)
+
 	// 中间件默认值
 	v.SetDefault("middleware.cors.enabled", true)
 	// CORS 允许的来源、方法和头部
@@ -45,10 +62,24 @@ func setDefaults(v *viper.Viper) {
 		"Content-Type",
 		"Authorization",
 		"X-Requested-With",
+		"X-Device-Name",
 	})
 	v.SetDefault("middleware.cors.allow_credentials", false)
 
 	v.SetDefault("middleware.rate_limit.enabled", false)
+	v.SetDefault("middleware.rate_limit.requests_per_second", 1000)
+	v.SetDefault("middleware.rate_limit.burst", 2000)
+	v.SetDefault("middleware.rate_limit.user_requests_per_second", 100)
+	v.SetDefault("middleware.rate_limit.user_burst", 200)
+	v.SetDefault("middleware.rate_limit.per_route", false)
+	v.SetDefault("middleware.rate_limit.skip_paths", []string{"/health", "/metrics", "/ping"})
+	v.SetDefault("middleware.rate_limit.fail_open", true)
+	v.SetDefault("middleware.rate_limit.login.paths", []string{
+		"/api/v1/identity/auth/login",
+		"/api/v1/identity/auth/mfa/verify",
+	})
+	v.SetDefault("middleware.rate_limit.login.requests_per_minute", 10)
+	v.SetDefault("middleware.rate_limit.login.burst", 5)
 	v.SetDefault("middleware.jwt.enabled", true)
 	v.SetDefault("middleware.jwt.signing_key", "OVdQu4vxUBokCin2Lqazs5FgdnjF3G3D+TTICNOL7yU=")
 	v.SetDefault("middleware.jwt.timeout", 30*time.Minute)
@@ -61,6 +92,7 @@ func setDefaults(v *viper.Viper) {
 	)
 	v.SetDefault("middleware.jwt.token_head_name", "Bearer")
 	v.SetDefault("middleware.jwt.send_authorization", false)
+	v.SetDefault("middleware.jwt.session_cleanup_interval", time.Hour)
 	// JWT 跳过认证的路径列表（默认跳过健康检查、指标、认证相关端点）
 	v.SetDefault("middleware.jwt.skip_paths", []string{
 		"/health",
@@ -68,6 +100,7 @@ func setDefaults(v *viper.Viper) {
 		"/ping",
 		"/api/v1/identity/auth/login",
 		"/api/v1/identity/auth/refresh",
+		"/api/v1/identity/auth/mfa/verify",
 	})
 
 	// Cookie默认值
@@ -96,6 +129,8 @@ func setDefaults(v *viper.Viper) {
 	// 链路追踪默认值
 	v.SetDefault("tracing.enabled", false)
 	v.SetDefault("tracing.service_name", "api-gateway")
+	v.SetDefault("tracing.exporter", "otlp")
+	v.SetDefault("tracing.endpoint", "http://localhost:4318/v1/traces")
 	v.SetDefault("tracing.sampler_ratio", 1.0)
 
 	// ErrorHandler 中间件默认配置
@@ -111,6 +146,9 @@ func setDefaults(v *viper.Viper) {
 	// Casbin 权限控制默认配置
 	v.SetDefault("middleware.casbin.enabled", false)
 	v.SetDefault("middleware.casbin.skip_paths", []string{"/health", "/metrics"})
+	// 用户角色或权限变更时经 Redis 通知各网关副本立即清除缓存；通知丢失时（如订阅连接断开期间）
+	// 变更最迟在 cache_ttl 后生效，即权限回收最多有 30 秒的滞后窗口
+	v.SetDefault("middleware.casbin.cache_ttl", 30*time.Second)
 
 	// Redis 默认值
 	v.SetDefault("redis.address", "localhost:6379")
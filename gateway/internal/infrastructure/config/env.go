@@ -177,6 +177,104 @@ func mapClientEnvVars(v *viper.Viper) {
 
 	// 服务配置映射
 	mapToViper(v, "IDENTITY_SRV_NAME", "client.services.identity.name", nil)
+
+	// 熔断与重试配置映射
+	mapCircuitBreakerEnvVars(v)
+	mapRetryEnvVars(v)
+}
+
+// mapCircuitBreakerEnvVars 映射 RPC 客户端熔断相关环境变量
+func mapCircuitBreakerEnvVars(v *viper.Viper) {
+	mapToViper(v, "CLIENT_CB_ENABLED", "client.circuit_breaker.enabled", func(value string) interface{} {
+		return value == "true"
+	})
+	mapToViper(v, "CLIENT_CB_ERR_RATE", "client.circuit_breaker.err_rate", func(value string) interface{} {
+		if val, err := strconv.ParseFloat(value, 64); err == nil {
+			return val
+		}
+
+		return 0.5
+	})
+	mapToViper(v, "CLIENT_CB_MIN_SAMPLE", "client.circuit_breaker.min_sample", func(value string) interface{} {
+		if val, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return val
+		}
+
+		return 200
+	})
+	mapToViper(
+		v,
+		"CLIENT_CB_INSTANCE_ENABLED",
+		"client.circuit_breaker.instance_enabled",
+		func(value string) interface{} {
+			return value == "true"
+		},
+	)
+	// 格式：方法名:错误率:最小采样数，多个规则以逗号分隔
+	// 例如: "GetUserMenuTree:0.3:50,ListUsers:0.4:100"
+	mapToViper(v, "CLIENT_CB_METHOD_RULES", "client.circuit_breaker.methods", func(value string) interface{} {
+		rules := make(map[string]interface{})
+
+		for _, item := range splitAndTrim(value, ",") {
+			parts := strings.Split(item, ":")
+			if len(parts) != 3 {
+				continue
+			}
+
+			errRate, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+			if err != nil {
+				continue
+			}
+
+			minSample, err := strconv.ParseInt(strings.TrimSpace(parts[2]), 10, 64)
+			if err != nil {
+				continue
+			}
+
+			rules[strings.TrimSpace(parts[0])] = map[string]interface{}{
+				"err_rate":   errRate,
+				"min_sample": minSample,
+			}
+		}
+
+		return rules
+	})
+}
+
+// mapRetryEnvVars 映射 RPC 客户端重试相关环境变量
+func mapRetryEnvVars(v *viper.Viper) {
+	mapToViper(v, "CLIENT_RETRY_ENABLED", "client.retry.enabled", func(value string) interface{} {
+		return value == "true"
+	})
+	mapToViper(v, "CLIENT_RETRY_MODE", "client.retry.mode", nil)
+	mapToViper(v, "CLIENT_RETRY_METHODS", "client.retry.methods", func(value string) interface{} {
+		return splitAndTrim(value, ",")
+	})
+	mapToViper(v, "CLIENT_RETRY_MAX_TIMES", "client.retry.max_times", func(value string) interface{} {
+		if val, err := strconv.Atoi(value); err == nil {
+			return val
+		}
+
+		return 2
+	})
+	mapToViper(v, "CLIENT_RETRY_MAX_DURATION", "client.retry.max_duration", func(value string) interface{} {
+		return parseDurationWithDefault(value, 0)
+	})
+	mapToViper(v, "CLIENT_RETRY_BACKUP_DELAY", "client.retry.backup_delay", func(value string) interface{} {
+		return parseDurationWithDefault(value, 100*time.Millisecond)
+	})
+	mapToViper(
+		v,
+		"CLIENT_RETRY_BREAKER_ERR_RATE",
+		"client.retry.breaker_err_rate",
+		func(value string) interface{} {
+			if val, err := strconv.ParseFloat(value, 64); err == nil {
+				return val
+			}
+
+			return 0.1
+		},
+	)
 }
 
 // mapMiddlewareEnvVars 映射中间件相关环境变量
@@ -251,6 +349,86 @@ func mapRateLimitEnvVars(v *viper.Viper) {
 			return 2000
 		},
 	)
+	mapToViper(
+		v,
+		"RATE_LIMIT_USER_RPS",
+		"middleware.rate_limit.user_requests_per_second",
+		func(value string) interface{} {
+			if val, err := strconv.Atoi(value); err == nil {
+				return val
+			}
+
+			return 100
+		},
+	)
+	mapToViper(
+		v,
+		"RATE_LIMIT_USER_BURST",
+		"middleware.rate_limit.user_burst",
+		func(value string) interface{} {
+			if val, err := strconv.Atoi(value); err == nil {
+				return val
+			}
+
+			return 200
+		},
+	)
+	mapToViper(
+		v,
+		"RATE_LIMIT_PER_ROUTE",
+		"middleware.rate_limit.per_route",
+		func(value string) interface{} {
+			return value == "true"
+		},
+	)
+	mapToViper(
+		v,
+		"RATE_LIMIT_SKIP_PATHS",
+		"middleware.rate_limit.skip_paths",
+		func(value string) interface{} {
+			return splitAndTrim(value, ",")
+		},
+	)
+	mapToViper(
+		v,
+		"RATE_LIMIT_FAIL_OPEN",
+		"middleware.rate_limit.fail_open",
+		func(value string) interface{} {
+			return value == "true"
+		},
+	)
+	mapToViper(
+		v,
+		"RATE_LIMIT_LOGIN_PATHS",
+		"middleware.rate_limit.login.paths",
+		func(value string) interface{} {
+			return splitAndTrim(value, ",")
+		},
+	)
+	mapToViper(
+		v,
+		"RATE_LIMIT_LOGIN_RPM",
+		"middleware.rate_limit.login.requests_per_minute",
+		func(value string) interface{} {
+			if val, err := strconv.Atoi(value); err == nil {
+				return val
+			}
+
+			return 10
+		},
+	)
+	mapToViper(
+		v,
+		"RATE_LIMIT_LOGIN_BURST",
+		"middleware.rate_limit.login.burst",
+		func(value string) interface{} {
+			if val, err := strconv.Atoi(value); err == nil {
+				return val
+			}
+
+			return 5
+		},
+	)
 }
 
 // mapJWTEnvVars 映射身份验证相关环境变量
@@ -281,6 +459,14 @@ func mapJWTEnvVars(v *viper.Viper) {
 	mapToViper(v, "JWT_SKIP_PATHS", "middleware.jwt.skip_paths", func(value string) interface{} {
 		return splitAndTrim(value, ",")
 	})
+	mapToViper(
+		v,
+		"JWT_SESSION_CLEANUP_INTERVAL",
+		"middleware.jwt.session_cleanup_interval",
+		func(value string) interface{} {
+			return parseDurationWithDefault(value, time.Hour)
+		},
+	)
 
 	// Cookie配置映射
 	mapCookieEnvVars(v)
@@ -339,6 +525,9 @@ func mapCasbinEnvVars(v *viper.Viper) {
 			return splitAndTrim(value, ",")
 		},
 	)
+	mapToViper(v, "CASBIN_CACHE_TTL", "middleware.casbin.cache_ttl", func(value string) interface{} {
+		return parseDurationWithDefault(value, 30*time.Second)
+	})
 }
 
 // mapLogEnvVars 映射日志相关环境变量
@@ -376,6 +565,7 @@ func mapTracingEnvVars(v *viper.Viper) {
 		return value == "true"
 	})
 	mapToViper(v, "TRACING_SERVICE_NAME", "tracing.service_name", nil)
+	mapToViper(v, "TRACING_EXPORTER", "tracing.exporter", nil)
 	mapToViper(v, "TRACING_ENDPOINT", "tracing.endpoint", nil)
 	mapToViper(v, "TRACING_SAMPLER_RATIO", "tracing.sampler_ratio", func(value string) interface{} {
 		if val, err := strconv.ParseFloat(value, 64); err == nil {
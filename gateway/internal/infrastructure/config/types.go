@@ -43,12 +43,46 @@ type EtcdConfig struct {
 // ClientConfig 客户端配置
 // 相关环境变量：CLIENT_CONNECTION_TIMEOUT, CLIENT_REQUEST_TIMEOUT, CLIENT_POOL_MAX_IDLE_PER_ADDRESS,
 // CLIENT_POOL_MAX_IDLE_GLOBAL, CLIENT_POOL_MAX_IDLE_TIMEOUT
-// 用于配置 RPC 客户端的连接和请求超时、连接池等参数
+// 用于配置 RPC 客户端的连接和请求超时、连接池、熔断与重试等参数
 type ClientConfig struct {
 	ConnectionTimeout time.Duration            `mapstructure:"connection_timeout"`
 	RequestTimeout    time.Duration            `mapstructure:"request_timeout"`
 	Pool              ConnectionPoolConfig     `mapstructure:"pool"`
 	Services          map[string]ServiceConfig `mapstructure:"services"`
+	CircuitBreaker    CircuitBreakerConfig     `mapstructure:"circuit_breaker"`
+	Retry             RetryConfig              `mapstructure:"retry"`
+}
+
+// CircuitBreakerConfig RPC 客户端熔断配置
+// 相关环境变量：CLIENT_CB_ENABLED, CLIENT_CB_ERR_RATE, CLIENT_CB_MIN_SAMPLE,
+// CLIENT_CB_INSTANCE_ENABLED, CLIENT_CB_METHOD_RULES
+// 每个方法独立熔断，Methods 可按方法覆盖默认的错误率阈值与最小采样数
+type CircuitBreakerConfig struct {
+	Enabled         bool                                `mapstructure:"enabled"`          // 是否启用方法级熔断
+	ErrRate         float64                             `mapstructure:"err_rate"`         // 触发熔断的错误率（0-1）
+	MinSample       int64                               `mapstructure:"min_sample"`       // 统计窗口内触发熔断的最小请求数
+	InstanceEnabled bool                                `mapstructure:"instance_enabled"` // 是否启用实例级熔断（仅统计连接失败）
+	Methods         map[string]CircuitBreakerRuleConfig `mapstructure:"methods"`          // 按方法覆盖的熔断规则
+}
+
+// CircuitBreakerRuleConfig 单个方法的熔断规则
+type CircuitBreakerRuleConfig struct {
+	ErrRate   float64 `mapstructure:"err_rate"`
+	MinSample int64   `mapstructure:"min_sample"`
+}
+
+// RetryConfig RPC 客户端重试配置
+// 相关环境变量：CLIENT_RETRY_ENABLED, CLIENT_RETRY_MODE, CLIENT_RETRY_METHODS, CLIENT_RETRY_MAX_TIMES,
+// CLIENT_RETRY_MAX_DURATION, CLIENT_RETRY_BACKUP_DELAY, CLIENT_RETRY_BREAKER_ERR_RATE
+// 重试仅作用于 Methods 中列出的幂等读方法，写操作不重试
+type RetryConfig struct {
+	Enabled        bool          `mapstructure:"enabled"`          // 是否启用重试
+	Mode           string        `mapstructure:"mode"`             // 重试模式：failure（超时重试）或 backup（备份请求）
+	Methods        []string      `mapstructure:"methods"`          // 允许重试的幂等读方法（与 IDL 方法名一致）
+	MaxTimes       int           `mapstructure:"max_times"`        // 最大重试次数（不含首次调用）
+	MaxDuration    time.Duration `mapstructure:"max_duration"`     // failure 模式下含重试的总耗时上限（0 表示不限制）
+	BackupDelay    time.Duration `mapstructure:"backup_delay"`     // backup 模式下首次调用未返回时发起备份请求的延迟
+	BreakerErrRate float64       `mapstructure:"breaker_err_rate"` // 重试熔断错误率，超过后停止重试，避免重试风暴
 }
 
 // ConnectionPoolConfig 连接池配置
@@ -87,17 +121,34 @@ type CORSConfig struct {
 }
 
 // RateLimitConfig 限流配置
-// 相关环境变量：RATE_LIMIT_ENABLED, RATE_LIMIT_RPS, RATE_LIMIT_BURST
-// 用于控制请求速率，防止服务过载
+// 相关环境变量：RATE_LIMIT_ENABLED, RATE_LIMIT_RPS, RATE_LIMIT_BURST, RATE_LIMIT_USER_RPS,
+// RATE_LIMIT_USER_BURST, RATE_LIMIT_PER_ROUTE, RATE_LIMIT_SKIP_PATHS, RATE_LIMIT_FAIL_OPEN,
+// RATE_LIMIT_LOGIN_PATHS, RATE_LIMIT_LOGIN_RPM, RATE_LIMIT_LOGIN_BURST
+// 基于 Redis 令牌桶实现，多个网关副本共享同一组限流计数
 type RateLimitConfig struct {
-	Enabled           bool `mapstructure:"enabled"`
-	RequestsPerSecond int  `mapstructure:"requests_per_second"`
-	Burst             int  `mapstructure:"burst"`
+	Enabled               bool                 `mapstructure:"enabled"`                  // 是否启用限流
+	RequestsPerSecond     int                  `mapstructure:"requests_per_second"`      // 单个客户端 IP 每秒补充的令牌数
+	Burst                 int                  `mapstructure:"burst"`                    // 单个客户端 IP 的令牌桶容量
+	UserRequestsPerSecond int                  `mapstructure:"user_requests_per_second"` // 单个已认证用户每秒补充的令牌数（0 表示不按用户限流）
+	UserBurst             int                  `mapstructure:"user_burst"`               // 单个已认证用户的令牌桶容量
+	PerRoute              bool                 `mapstructure:"per_route"`                // 是否按路由划分独立的令牌桶
+	SkipPaths             []string             `mapstructure:"skip_paths"`               // 跳过限流的路径列表
+	FailOpen              bool                 `mapstructure:"fail_open"`                // Redis 不可用时是否放行请求
+	Login                 LoginRateLimitConfig `mapstructure:"login"`                    // 登录接口限流配置
+}
+
+// LoginRateLimitConfig 登录接口限流配置
+// 登录接口按客户端 IP 单独计数，采用比普通接口更严格的速率，用于抵御暴力破解
+type LoginRateLimitConfig struct {
+	Paths             []string `mapstructure:"paths"`               // 适用登录限流的路径列表
+	RequestsPerMinute int      `mapstructure:"requests_per_minute"` // 每分钟补充的令牌数
+	Burst             int      `mapstructure:"burst"`               // 令牌桶容量
 }
 
 // JWTConfig 身份验证配置
 // 相关环境变量：JWT_ENABLED, JWT_SIGNING_KEY, JWT_TIMEOUT, JWT_MAX_REFRESH, JWT_IDENTITY_KEY,
 // JWT_REALM, JWT_TOKEN_LOOKUP, JWT_TOKEN_HEAD_NAME, JWT_SEND_AUTHORIZATION, JWT_SKIP_PATHS,
+// JWT_SESSION_CLEANUP_INTERVAL,
 // JWT_COOKIE_SEND_COOKIE, JWT_COOKIE_COOKIE_NAME, JWT_COOKIE_COOKIE_DOMAIN, JWT_COOKIE_COOKIE_PATH,
 // JWT_COOKIE_COOKIE_MAX_AGE, JWT_COOKIE_COOKIE_SAME_SITE, JWT_COOKIE_SECURE_COOKIE, JWT_COOKIE_HTTP_ONLY
 // 用于配置 JWT 认证和 Cookie 相关设置
@@ -112,6 +163,10 @@ type JWTConfig struct {
 	TokenHeadName     string        `mapstructure:"token_head_name"`    // token头前缀
 	SendAuthorization bool          `mapstructure:"send_authorization"` // 是否在响应中返回 Authorization header
 
+	// SessionCleanupInterval 清理用户会话索引中已失效令牌族与令牌的执行间隔（0 表示不清理）
+	// 多副本部署时每个间隔内只有取得 Redis 锁的一个副本执行
+	SessionCleanupInterval time.Duration `mapstructure:"session_cleanup_interval"`
+
 	// Cookie配置（前后端分离架构）
 	Cookie CookieConfig `mapstructure:"cookie"` // Cookie配置
 }
@@ -144,10 +199,13 @@ type LogConfig struct {
 }
 
 // TracingConfig 链路追踪配置
+// 相关环境变量：TRACING_ENABLED, TRACING_SERVICE_NAME, TRACING_EXPORTER, TRACING_ENDPOINT, TRACING_SAMPLER_RATIO
 // 当 Enabled=true 时，向 Endpoint 上报链路数据；SamplerRatio 控制采样率[0.0,1.0]
+// Exporter 可选 otlp（OTLP/HTTP，Endpoint 为完整 URL）或 stdout（输出到标准输出，便于本地调试）
 type TracingConfig struct {
 	Enabled      bool    `mapstructure:"enabled"`
 	ServiceName  string  `mapstructure:"service_name"`
+	Exporter     string  `mapstructure:"exporter"`
 	Endpoint     string  `mapstructure:"endpoint"`
 	SamplerRatio float64 `mapstructure:"sampler_ratio"`
 }
@@ -175,10 +233,13 @@ type ErrorHandlerConfig struct {
 }
 
 // CasbinConfig Casbin 权限控制配置
-// 相关环境变量：CASBIN_ENABLED, CASBIN_MODEL_PATH, CASBIN_SKIP_PATHS, CASBIN_ENABLE_LOG
+// 相关环境变量：CASBIN_ENABLED, CASBIN_SKIP_PATHS, CASBIN_CACHE_TTL
+// 权限判定由 identity_srv 的 Casbin 策略完成，网关按 CacheTTL 缓存判定结果以减少 RPC 调用；
+// 角色或权限变更时缓存经 Redis 发布订阅即时清除，CacheTTL 为通知丢失时的最长滞后时间
 type CasbinConfig struct {
-	Enabled   bool     `mapstructure:"enabled"`    // 是否启用Casbin权限校验
-	SkipPaths []string `mapstructure:"skip_paths"` // 跳过权限校验的路径列表
+	Enabled   bool          `mapstructure:"enabled"`    // 是否启用Casbin权限校验
+	SkipPaths []string      `mapstructure:"skip_paths"` // 跳过权限校验的路径列表
+	CacheTTL  time.Duration `mapstructure:"cache_ttl"`  // 权限判定结果缓存时间（0 表示不缓存）
 }
 
 // DataLakeConfig DataLake 配置
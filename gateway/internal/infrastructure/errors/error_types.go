@@ -40,6 +40,26 @@ func (e APIError) Message() string {
 	return e.message
 }
 
+// DetailedAPIError 携带结构化明细的 API 错误
+// 明细透传自下游 BizStatusError 的 Extra，例如密码策略校验时逐条返回违反的规则
+type DetailedAPIError struct {
+	APIError
+	details map[string]string // 明细标识 -> 明细说明
+}
+
+// NewDetailedAPIError 创建携带结构化明细的 API 错误
+func NewDetailedAPIError(code int32, message string, details map[string]string) DetailedAPIError {
+	return DetailedAPIError{
+		APIError: NewAPIError(code, message),
+		details:  details,
+	}
+}
+
+// Details 获取结构化明细
+func (e DetailedAPIError) Details() map[string]string {
+	return e.details
+}
+
 // =================================================================
 //
 //	网关层错误码规范
@@ -78,15 +98,20 @@ const (
 	CodeMethodNotAllowed = 100006 // 请求方法不被允许
 
 	// JWT认证相关错误 (102xxx)
-	CodeJWTTokenMissing    = 102001 // JWT令牌缺失
-	CodeJWTTokenInvalid    = 102002 // JWT令牌格式无效
-	CodeJWTTokenExpired    = 102003 // JWT令牌已过期
-	CodeJWTTokenNotActive  = 102004 // JWT令牌未生效（nbf校验失败）
-	CodeJWTTokenMalformed  = 102005 // JWT令牌结构错误
-	CodeJWTValidationFail  = 102006 // JWT验证失败（通用验证错误）
-	CodeJWTSigningError    = 102007 // JWT签名生成失败
-	CodeJWTCreationFail    = 102008 // JWT令牌创建失败
-	CodeInvalidCredentials = 102009 // 认证凭据无效（用户名密码错误）
+	CodeJWTTokenMissing     = 102001 // JWT令牌缺失
+	CodeJWTTokenInvalid     = 102002 // JWT令牌格式无效
+	CodeJWTTokenExpired     = 102003 // JWT令牌已过期
+	CodeJWTTokenNotActive   = 102004 // JWT令牌未生效（nbf校验失败）
+	CodeJWTTokenMalformed   = 102005 // JWT令牌结构错误
+	CodeJWTValidationFail   = 102006 // JWT验证失败（通用验证错误）
+	CodeJWTSigningError     = 102007 // JWT签名生成失败
+	CodeJWTCreationFail     = 102008 // JWT令牌创建失败
+	CodeInvalidCredentials  = 102009 // 认证凭据无效（用户名密码错误）
+	CodeJWTTokenRevoked     = 102010 // 令牌所属会话已被吊销
+	CodeRefreshTokenInvalid = 102011 // 刷新令牌无效或已过期
+	CodeRefreshTokenReused  = 102012 // 刷新令牌被重复使用
+	CodeMFAChallengeInvalid = 102013 // 多因素认证挑战无效或已过期
+	CodeMFAEnrollmentNeeded = 102014 // 所属角色要求先绑定多因素认证
 
 	// 授权和权限相关错误 (103xxx)
 	CodeUserNoAvailableRoles = 103001 // 用户无可用角色
@@ -118,8 +143,16 @@ const (
 	CodeRPCInvalidCredentials = 201016 // 用户名或密码错误
 	CodeRPCUserSuspended      = 201017 // 用户已停用
 	CodeRPCMustChangePassword = 201018 // 需要修改密码
+	CodeRPCUserLocked         = 201021 // 用户因多次登录失败被锁定
+	CodeRPCInvalidMFACode     = 201024 // 多因素认证验证码错误
+	CodeRPCPasswordPolicy     = 201026 // 密码不符合安全策略
+	CodeRPCPasswordReused     = 201027 // 不能使用近期使用过的密码
+	CodeRPCAccountExpired     = 201028 // 账户已过期
 	// 角色分配相关的 RPC 业务错误 (207xxx - identity_srv)
 	CodeRPCUserNoAvailableRoles = 207016 // 用户没有可用角色
+	// 菜单管理相关的 RPC 业务错误 (207xxx - identity_srv)
+	CodeRPCMenuConfigInvalid     = 207012 // 菜单配置校验未通过
+	CodeRPCMenuUploadDestructive = 207020 // 菜单上传将导致角色失去菜单权限
 	// 数据源配置相关的 RPC 业务错误 (208xxx - cancer_srv)
 	CodeRPCDataSourceInUse = 208001 // 数据源正在被字典配置使用，无法删除
 )
@@ -137,15 +170,20 @@ var (
 	ErrMethodNotAllowed = NewAPIError(CodeMethodNotAllowed, "请求方法不被允许")
 
 	// JWT认证相关错误
-	ErrJWTTokenMissing    = NewAPIError(CodeJWTTokenMissing, "令牌缺失")
-	ErrJWTTokenInvalid    = NewAPIError(CodeJWTTokenInvalid, "令牌格式无效")
-	ErrJWTTokenExpired    = NewAPIError(CodeJWTTokenExpired, "令牌已过期")
-	ErrJWTTokenNotActive  = NewAPIError(CodeJWTTokenNotActive, "令牌未生效")
-	ErrJWTTokenMalformed  = NewAPIError(CodeJWTTokenMalformed, "令牌结构错误")
-	ErrJWTValidationFail  = NewAPIError(CodeJWTValidationFail, "令牌验证失败")
-	ErrJWTSigningError    = NewAPIError(CodeJWTSigningError, "令牌签名生成失败")
-	ErrJWTCreationFail    = NewAPIError(CodeJWTCreationFail, "令牌创建失败")
-	ErrInvalidCredentials = NewAPIError(CodeInvalidCredentials, "用户名或密码错误")
+	ErrJWTTokenMissing     = NewAPIError(CodeJWTTokenMissing, "令牌缺失")
+	ErrJWTTokenInvalid     = NewAPIError(CodeJWTTokenInvalid, "令牌格式无效")
+	ErrJWTTokenExpired     = NewAPIError(CodeJWTTokenExpired, "令牌已过期")
+	ErrJWTTokenNotActive   = NewAPIError(CodeJWTTokenNotActive, "令牌未生效")
+	ErrJWTTokenMalformed   = NewAPIError(CodeJWTTokenMalformed, "令牌结构错误")
+	ErrJWTValidationFail   = NewAPIError(CodeJWTValidationFail, "令牌验证失败")
+	ErrJWTSigningError     = NewAPIError(CodeJWTSigningError, "令牌签名生成失败")
+	ErrJWTCreationFail     = NewAPIError(CodeJWTCreationFail, "令牌创建失败")
+	ErrInvalidCredentials  = NewAPIError(CodeInvalidCredentials, "用户名或密码错误")
+	ErrJWTTokenRevoked     = NewAPIError(CodeJWTTokenRevoked, "登录会话已失效，请重新登录")
+	ErrRefreshTokenInvalid = NewAPIError(CodeRefreshTokenInvalid, "刷新令牌无效或已过期")
+	ErrRefreshTokenReused  = NewAPIError(CodeRefreshTokenReused, "刷新令牌已被使用，请重新登录")
+	ErrMFAChallengeInvalid = NewAPIError(CodeMFAChallengeInvalid, "多因素认证已失效，请重新登录")
+	ErrMFAEnrollmentNeeded = NewAPIError(CodeMFAEnrollmentNeeded, "请先绑定多因素认证")
 
 	// 授权和权限相关错误
 	ErrUserNoAvailableRoles = NewAPIError(CodeUserNoAvailableRoles, "用户无可用角色，无法登录")
@@ -11,6 +11,12 @@ func HandleServiceError(c *app.RequestContext, err error, defaultMessage string)
 		return
 	}
 
+	// 携带结构化明细的错误，明细随响应一并返回
+	if detailedErr, ok := err.(DetailedAPIError); ok {
+		AbortWithDetailedError(c, detailedErr)
+		return
+	}
+
 	// 检查是否为 APIError 类型
 	if apiErr, ok := err.(APIError); ok {
 		// 业务层返回的是 APIError，使用统一错误处理
@@ -4,6 +4,7 @@ package errors
 
 import (
 	"net/http"
+	"sort"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/hertz-contrib/requestid"
@@ -23,15 +24,20 @@ var httpStatusMap = map[int32]int{
 	CodeMethodNotAllowed: http.StatusMethodNotAllowed,
 
 	// JWT认证相关错误
-	CodeJWTTokenMissing:    http.StatusUnauthorized,
-	CodeJWTTokenInvalid:    http.StatusUnauthorized,
-	CodeJWTTokenExpired:    http.StatusUnauthorized,
-	CodeJWTTokenNotActive:  http.StatusUnauthorized,
-	CodeJWTTokenMalformed:  http.StatusBadRequest,
-	CodeJWTValidationFail:  http.StatusUnauthorized,
-	CodeJWTSigningError:    http.StatusInternalServerError,
-	CodeJWTCreationFail:    http.StatusInternalServerError,
-	CodeInvalidCredentials: http.StatusUnauthorized,
+	CodeJWTTokenMissing:     http.StatusUnauthorized,
+	CodeJWTTokenInvalid:     http.StatusUnauthorized,
+	CodeJWTTokenExpired:     http.StatusUnauthorized,
+	CodeJWTTokenNotActive:   http.StatusUnauthorized,
+	CodeJWTTokenMalformed:   http.StatusBadRequest,
+	CodeJWTValidationFail:   http.StatusUnauthorized,
+	CodeJWTSigningError:     http.StatusInternalServerError,
+	CodeJWTCreationFail:     http.StatusInternalServerError,
+	CodeInvalidCredentials:  http.StatusUnauthorized,
+	CodeJWTTokenRevoked:     http.StatusUnauthorized,
+	CodeRefreshTokenInvalid: http.StatusUnauthorized,
+	CodeRefreshTokenReused:  http.StatusUnauthorized,
+	CodeMFAChallengeInvalid: http.StatusUnauthorized,
+	CodeMFAEnrollmentNeeded: http.StatusForbidden,
 
 	// 网关特有错误
 	CodeGatewayTimeout: http.StatusGatewayTimeout,
@@ -45,7 +51,34 @@ var httpStatusMap = map[int32]int{
 	CodeRPCInvalidCredentials:   http.StatusUnauthorized, // 用户名或密码错误
 	CodeRPCUserSuspended:        http.StatusForbidden,    // 用户已停用
 	CodeRPCMustChangePassword:   http.StatusForbidden,    // 需要修改密码
+	CodeRPCUserLocked:           http.StatusLocked,       // 用户已锁定
+	CodeRPCInvalidMFACode:       http.StatusUnauthorized, // 多因素认证验证码错误
+	CodeRPCPasswordPolicy:       http.StatusBadRequest,   // 密码不符合安全策略
+	CodeRPCPasswordReused:       http.StatusBadRequest,   // 不能使用近期使用过的密码
+	CodeRPCAccountExpired:       http.StatusForbidden,    // 账户已过期
 	CodeRPCUserNoAvailableRoles: http.StatusForbidden,    // 用户没有可用角色
+	CodeRPCMenuConfigInvalid:     http.StatusBadRequest, // 菜单配置校验未通过
+	CodeRPCMenuUploadDestructive: http.StatusConflict,   // 菜单上传将导致角色失去菜单权限
+}
+
+// ErrorCodeContextKey 请求上下文中记录业务错误码的键，供指标等中间件读取
+const ErrorCodeContextKey = "biz_error_code"
+
+// SetErrorCode 在请求上下文中记录本次响应的业务错误码
+func SetErrorCode(c *app.RequestContext, code int32) {
+	c.Set(ErrorCodeContextKey, code)
+}
+
+// GetErrorCode 获取本次响应的业务错误码，未记录时返回 false
+func GetErrorCode(c *app.RequestContext) (int32, bool) {
+	value, exists := c.Get(ErrorCodeContextKey)
+	if !exists {
+		return 0, false
+	}
+
+	code, ok := value.(int32)
+
+	return code, ok
 }
 
 // AbortWithError 中断请求并返回错误响应
@@ -63,6 +96,40 @@ func AbortWithError(c *app.RequestContext, err APIError) {
 		},
 	}
 
+	SetErrorCode(c, err.Code())
+	c.JSON(httpStatus, response)
+	c.Abort()
+}
+
+// AbortWithDetailedError 中断请求并返回携带结构化明细的错误响应
+// 明细按标识排序后写入 BaseResp.Details，保证输出稳定，便于前端逐条展示
+func AbortWithDetailedError(c *app.RequestContext, err DetailedAPIError) {
+	httpStatus := GetHTTPStatus(err.Code())
+
+	rules := make([]string, 0, len(err.Details()))
+	for rule := range err.Details() {
+		rules = append(rules, rule)
+	}
+
+	sort.Strings(rules)
+
+	details := make([]*http_base.ErrorDetailDTO, 0, len(rules))
+	for _, rule := range rules {
+		details = append(details, &http_base.ErrorDetailDTO{
+			Rule:    rule,
+			Message: err.Details()[rule],
+		})
+	}
+
+	response := &http_base.OperationStatusResponseDTO{
+		BaseResp: &http_base.BaseResponseDTO{
+			Code:    err.Code(),
+			Message: err.Message(),
+			Details: details,
+		},
+	}
+
+	SetErrorCode(c, err.Code())
 	c.JSON(httpStatus, response)
 	c.Abort()
 }
@@ -82,6 +149,7 @@ func AbortWithErrorMessage(c *app.RequestContext, err APIError, message string)
 		},
 	}
 
+	SetErrorCode(c, err.Code())
 	c.JSON(httpStatus, response)
 	c.Abort()
 }
@@ -3,6 +3,8 @@
 package errors
 
 import (
+	"errors"
+
 	"github.com/cloudwego/kitex/pkg/kerrors"
 )
 
@@ -12,12 +14,15 @@ import (
 //  1. 如果是 RPC BizStatusError（业务错误），直接透传错误码和消息
 //     - RPC 业务错误码范围：200xxx（按业务领域编码）
 //     - 错误信息保持原样，不做转换
-//  2. 如果是 RPC 框架错误（网络超时、连接失败等），返回网关内部错误（100005）
+//     - 携带 Extra 明细时返回 DetailedAPIError，由 HandleServiceError 写入响应的 details
+//  2. 如果被熔断器拒绝，返回服务暂不可用（110002），调用方快速失败而非等待超时
+//  3. 如果是 RPC 调用超时（含重试后仍超时），返回网关超时（110001）
+//  4. 其他 RPC 框架错误（连接失败等），返回网关内部错误（100005）
 //     - 使用 fallbackMessage 作为用户友好的错误提示
 //
 // 返回的 APIError 将通过 GetHTTPStatus 映射为对应的 HTTP 状态码：
 //   - RPC 业务错误（200xxx）-> HTTP 200（错误信息在 response body 中）
-//   - 网关系统错误（100xxx）-> 对应 HTTP 状态码（500）
+//   - 网关系统错误（100xxx/110xxx）-> 对应 HTTP 状态码（500/503/504）
 //
 // 使用示例：
 //
@@ -33,9 +38,22 @@ func ProcessRPCError(err error, fallbackMessage string) error {
 	// 使用 kerrors.FromBizStatusError 提取业务异常
 	if bizErr, isBizErr := kerrors.FromBizStatusError(err); isBizErr {
 		// 直接将 RPC 业务错误转换为 API 错误，保持原始错误码和消息
+		if extra := bizErr.BizExtra(); len(extra) > 0 {
+			return NewDetailedAPIError(bizErr.BizStatusCode(), bizErr.BizMessage(), extra)
+		}
+
 		return NewAPIError(bizErr.BizStatusCode(), bizErr.BizMessage())
 	}
 
+	// 熔断打开时下游已不可用，直接降级为服务不可用
+	if errors.Is(err, kerrors.ErrCircuitBreak) {
+		return ErrServiceDown
+	}
+
+	if kerrors.IsTimeoutError(err) {
+		return ErrGatewayTimeout
+	}
+
 	// 其他类型错误按系统错误处理
 	return ErrInternal.WithMessage(fallbackMessage)
 }
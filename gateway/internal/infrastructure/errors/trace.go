@@ -21,6 +21,16 @@ func InjectRequestIDToContext(ctx context.Context, requestID string) context.Con
 	return ctx
 }
 
+// InjectClientIPToContext 将客户端IP注入到 context 中（用于 RPC 调用）
+// RPC 服务在审计记录中使用该IP标识操作来源
+func InjectClientIPToContext(ctx context.Context, clientIP string) context.Context {
+	if clientIP == "" {
+		return ctx
+	}
+
+	return metainfo.WithPersistentValue(ctx, "client_ip", clientIP)
+}
+
 // GetRequestIDFromContext 从 context 中获取 request_id（辅助函数）
 // 优先从 metainfo 读取，如果没有则从普通 context 读取
 func GetRequestIDFromContext(ctx context.Context) string {
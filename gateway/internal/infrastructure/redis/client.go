@@ -85,6 +85,11 @@ func (c *Client) Exists(ctx context.Context, key string) (bool, error) {
 	return result > 0, nil
 }
 
+// TryLock 尝试获取在 ttl 后自动释放的互斥锁，锁已被持有时返回 false
+func (c *Client) TryLock(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	return c.rdb.SetNX(ctx, key, time.Now().Unix(), ttl).Result()
+}
+
 // Expire 设置键的过期时间
 func (c *Client) Expire(ctx context.Context, key string, expiration time.Duration) error {
 	return c.rdb.Expire(ctx, key, expiration).Err()
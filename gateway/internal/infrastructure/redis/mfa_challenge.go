@@ -0,0 +1,96 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// MFAChallenge 多因素认证挑战
+// 密码校验通过但尚未完成多因素认证的登录，保存签发令牌所需的数据，校验通过后再签发令牌
+type MFAChallenge struct {
+	UserID        string // 用户ID
+	LoginData     string // 访问令牌载荷快照（JSON）
+	LoginResponse string // 登录响应快照（JSON）
+	Attempts      int64  // 已失败的校验次数
+}
+
+// getMFAChallengeKey 获取多因素认证挑战的Redis Key
+func (tc *TokenCache) getMFAChallengeKey(challengeHash string) string {
+	return fmt.Sprintf("radius:mfa:challenge:%s", challengeHash)
+}
+
+// SaveMFAChallenge 保存多因素认证挑战，仅保存挑战令牌的哈希值
+func (tc *TokenCache) SaveMFAChallenge(
+	ctx context.Context,
+	challengeToken string,
+	challenge *MFAChallenge,
+	expiration time.Duration,
+) error {
+	challengeKey := tc.getMFAChallengeKey(tc.hashToken(challengeToken))
+
+	pipe := tc.client.GetClient().Pipeline()
+	pipe.HSet(ctx, challengeKey, map[string]interface{}{
+		"user_id":        challenge.UserID,
+		"login_data":     challenge.LoginData,
+		"login_response": challenge.LoginResponse,
+		"attempts":       challenge.Attempts,
+	})
+	pipe.Expire(ctx, challengeKey, expiration)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		tc.logger.Errorf("Failed to save MFA challenge: error=%v, userID=%s", err, challenge.UserID)
+		return fmt.Errorf("保存多因素认证挑战失败: %w", err)
+	}
+
+	return nil
+}
+
+// GetMFAChallenge 获取多因素认证挑战，不存在或已过期时返回 nil
+func (tc *TokenCache) GetMFAChallenge(ctx context.Context, challengeToken string) (*MFAChallenge, error) {
+	challengeKey := tc.getMFAChallengeKey(tc.hashToken(challengeToken))
+
+	fields, err := tc.client.GetClient().HGetAll(ctx, challengeKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("获取多因素认证挑战失败: %w", err)
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	attempts, _ := strconv.ParseInt(fields["attempts"], 10, 64)
+
+	return &MFAChallenge{
+		UserID:        fields["user_id"],
+		LoginData:     fields["login_data"],
+		LoginResponse: fields["login_response"],
+		Attempts:      attempts,
+	}, nil
+}
+
+// IncrMFAChallengeAttempts 累加多因素认证挑战的失败次数，返回累加后的次数
+func (tc *TokenCache) IncrMFAChallengeAttempts(ctx context.Context, challengeToken string) (int64, error) {
+	challengeKey := tc.getMFAChallengeKey(tc.hashToken(challengeToken))
+
+	attempts, err := tc.client.GetClient().HIncrBy(ctx, challengeKey, "attempts", 1).Result()
+	if err != nil {
+		return 0, fmt.Errorf("更新多因素认证挑战失败次数失败: %w", err)
+	}
+
+	return attempts, nil
+}
+
+// DeleteMFAChallenge 删除多因素认证挑战，返回挑战删除前是否存在
+// 校验通过后以删除结果作为唯一凭据，避免同一挑战被并发兑换为多个会话
+func (tc *TokenCache) DeleteMFAChallenge(ctx context.Context, challengeToken string) (bool, error) {
+	challengeKey := tc.getMFAChallengeKey(tc.hashToken(challengeToken))
+
+	deleted, err := tc.client.GetClient().Del(ctx, challengeKey).Result()
+	if err != nil {
+		return false, fmt.Errorf("删除多因素认证挑战失败: %w", err)
+	}
+
+	return deleted > 0, nil
+}
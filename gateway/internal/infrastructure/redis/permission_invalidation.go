@@ -0,0 +1,89 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+)
+
+// permissionInvalidationChannel 权限缓存失效通知的发布订阅频道
+const permissionInvalidationChannel = "radius:authz:invalidate"
+
+// PermissionInvalidateAll 通知内容为该值时表示清除全部用户的权限缓存（如角色定义的权限变更）
+const PermissionInvalidateAll = "*"
+
+// PermissionInvalidationService 权限缓存失效通知服务接口
+// 各网关副本在本地缓存权限判定结果，用户角色或权限变更后经 Redis 发布订阅通知所有副本清除相应缓存；
+// 发布订阅不保证送达（如订阅连接断开期间的通知会丢失），缓存仍依靠 TTL 兜底过期
+type PermissionInvalidationService interface {
+	// Publish 通知所有网关副本清除指定用户的权限缓存
+	Publish(ctx context.Context, userIDs ...string) error
+
+	// Subscribe 订阅失效通知，对每条通知中的用户ID调用 handler，直到 ctx 取消后返回
+	Subscribe(ctx context.Context, handler func(userID string))
+}
+
+// PermissionInvalidation 基于 Redis 发布订阅的权限缓存失效通知实现
+type PermissionInvalidation struct {
+	client *Client
+	logger *hertzZerolog.Logger
+}
+
+// NewPermissionInvalidation 创建权限缓存失效通知服务
+func NewPermissionInvalidation(client *Client, logger *hertzZerolog.Logger) PermissionInvalidationService {
+	return &PermissionInvalidation{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Publish 通知所有网关副本清除指定用户的权限缓存，每个用户ID发布一条通知
+func (pi *PermissionInvalidation) Publish(ctx context.Context, userIDs ...string) error {
+	pipe := pi.client.GetClient().Pipeline()
+
+	published := 0
+
+	for _, userID := range userIDs {
+		if userID == "" {
+			continue
+		}
+
+		pipe.Publish(ctx, permissionInvalidationChannel, userID)
+
+		published++
+	}
+
+	if published == 0 {
+		return nil
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("发布权限缓存失效通知失败: %w", err)
+	}
+
+	return nil
+}
+
+// Subscribe 订阅失效通知，直到 ctx 取消后返回
+// 订阅连接断开时由 go-redis 自动重连
+func (pi *PermissionInvalidation) Subscribe(ctx context.Context, handler func(userID string)) {
+	pubsub := pi.client.GetClient().Subscribe(ctx, permissionInvalidationChannel)
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				pi.logger.Warnf("Permission invalidation subscription closed")
+				return
+			}
+
+			handler(msg.Payload)
+		}
+	}
+}
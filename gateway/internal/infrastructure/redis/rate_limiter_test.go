@@ -0,0 +1,220 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/config"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// redisTestAddrEnv 指定用于集成测试的 Redis 地址，未设置时跳过需要真实执行 Lua 脚本的用例
+const redisTestAddrEnv = "GATEWAY_REDIS_TEST_ADDR"
+
+// scriptReplyHook 拦截 EVALSHA 命令并返回预置结果，记录脚本调用参数
+type scriptReplyHook struct {
+	reply []interface{}
+	args  []string
+}
+
+func (h *scriptReplyHook) DialHook(redis.DialHook) redis.DialHook {
+	return func(context.Context, string, string) (net.Conn, error) {
+		return nil, fmt.Errorf("scriptReplyHook: dial not supported")
+	}
+}
+
+func (h *scriptReplyHook) ProcessHook(redis.ProcessHook) redis.ProcessHook {
+	return func(_ context.Context, cmd redis.Cmder) error {
+		if cmd.Name() != "evalsha" {
+			return fmt.Errorf("scriptReplyHook: unsupported command %q", cmd.Name())
+		}
+
+		h.args = make([]string, len(cmd.Args()))
+		for i, arg := range cmd.Args() {
+			h.args[i] = fmt.Sprint(arg)
+		}
+
+		cmd.(*redis.Cmd).SetVal(h.reply)
+
+		return nil
+	}
+}
+
+func (h *scriptReplyHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return next
+}
+
+// newScriptReplyClient 创建脚本调用均返回 reply 的客户端
+func newScriptReplyClient(reply ...interface{}) (*Client, *scriptReplyHook) {
+	hook := &scriptReplyHook{reply: reply}
+
+	rdb := redis.NewClient(&redis.Options{Addr: "memory"})
+	rdb.AddHook(hook)
+
+	return &Client{rdb: rdb}, hook
+}
+
+func newScriptReplyLimiter(reply ...interface{}) (*RateLimiter, *scriptReplyHook) {
+	client, hook := newScriptReplyClient(reply...)
+
+	return &RateLimiter{client: client, logger: hertzZerolog.New()}, hook
+}
+
+func TestRateLimiterAllow_InvalidParams(t *testing.T) {
+	rl, _ := newScriptReplyLimiter()
+
+	for _, tc := range []struct {
+		rate  float64
+		burst int
+	}{{0, 10}, {-1, 10}, {5, 0}} {
+		result, err := rl.Allow(context.Background(), "ip:127.0.0.1", tc.rate, tc.burst)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	}
+}
+
+func TestRateLimiterAllow_ParsesScriptReply(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("allowed", func(t *testing.T) {
+		rl, hook := newScriptReplyLimiter(int64(1), int64(4), int64(0), int64(200))
+
+		result, err := rl.Allow(ctx, "ip:127.0.0.1", 5, 5)
+		require.NoError(t, err)
+		assert.Equal(t, &RateLimitResult{
+			Allowed:    true,
+			Limit:      5,
+			Remaining:  4,
+			ResetAfter: 200 * time.Millisecond,
+		}, result)
+
+		// evalsha <sha> <numkeys> <key> <rate> <burst>
+		require.Len(t, hook.args, 6)
+		assert.Equal(t, []string{"1", "radius:ratelimit:ip:127.0.0.1", "5", "5"}, hook.args[2:])
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		rl, _ := newScriptReplyLimiter(int64(0), int64(0), int64(750), int64(5000))
+
+		result, err := rl.Allow(ctx, "user:u-1", 1, 5)
+		require.NoError(t, err)
+		assert.False(t, result.Allowed)
+		assert.Equal(t, 0, result.Remaining)
+		assert.Equal(t, 750*time.Millisecond, result.RetryAfter)
+		assert.Equal(t, 5*time.Second, result.ResetAfter)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		rl, _ := newScriptReplyLimiter(int64(1), int64(4))
+
+		result, err := rl.Allow(ctx, "ip:127.0.0.1", 5, 5)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+// newIntegrationClient 连接 GATEWAY_REDIS_TEST_ADDR 指定的真实 Redis，用于执行 Lua 脚本；未设置时跳过测试
+func newIntegrationClient(t *testing.T) *Client {
+	t.Helper()
+
+	addr := os.Getenv(redisTestAddrEnv)
+	if addr == "" {
+		t.Skipf("%s 未设置，跳过 Redis 脚本集成测试", redisTestAddrEnv)
+	}
+
+	client, err := NewClient(&config.RedisConfig{Address: addr, DialTimeout: 3 * time.Second})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client
+}
+
+// newIntegrationLimiter 创建基于真实 Redis 的限流服务，返回本次测试专用的令牌桶前缀
+func newIntegrationLimiter(t *testing.T) (*RateLimiter, string) {
+	t.Helper()
+
+	client := newIntegrationClient(t)
+	prefix := fmt.Sprintf("test:%s:%d:", strings.ReplaceAll(t.Name(), "/", "_"), time.Now().UnixNano())
+
+	t.Cleanup(func() {
+		ctx := context.Background()
+
+		keys, _ := client.GetClient().Keys(ctx, "radius:ratelimit:"+prefix+"*").Result()
+		if len(keys) > 0 {
+			_ = client.Del(ctx, keys...)
+		}
+	})
+
+	return &RateLimiter{client: client, logger: hertzZerolog.New()}, prefix
+}
+
+func TestTokenBucketScript_ExhaustsBurst(t *testing.T) {
+	ctx := context.Background()
+	rl, prefix := newIntegrationLimiter(t)
+
+	for i := 0; i < 3; i++ {
+		result, err := rl.Allow(ctx, prefix+"ip", 1, 3)
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 2-i, result.Remaining)
+		assert.Zero(t, result.RetryAfter)
+	}
+
+	result, err := rl.Allow(ctx, prefix+"ip", 1, 3)
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+	assert.True(t, result.RetryAfter > 0 && result.RetryAfter <= time.Second, result.RetryAfter)
+	assert.True(t, result.ResetAfter > 2*time.Second && result.ResetAfter <= 3*time.Second, result.ResetAfter)
+
+	// 桶状态按回满时长过期
+	ttl, err := rl.client.GetClient().PTTL(ctx, rl.getBucketKey(prefix+"ip")).Result()
+	require.NoError(t, err)
+	assert.True(t, ttl > 0 && ttl <= 3*time.Second, ttl)
+}
+
+func TestTokenBucketScript_Refills(t *testing.T) {
+	ctx := context.Background()
+	rl, prefix := newIntegrationLimiter(t)
+
+	// 每秒补充 20 个令牌，即每 50ms 补充一个
+	result, err := rl.Allow(ctx, prefix+"ip", 20, 1)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+
+	result, err = rl.Allow(ctx, prefix+"ip", 20, 1)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+
+	time.Sleep(result.RetryAfter + 20*time.Millisecond)
+
+	result, err = rl.Allow(ctx, prefix+"ip", 20, 1)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+}
+
+func TestTokenBucketScript_IsolatesKeys(t *testing.T) {
+	ctx := context.Background()
+	rl, prefix := newIntegrationLimiter(t)
+
+	result, err := rl.Allow(ctx, prefix+"user:a", 1, 1)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+
+	result, err = rl.Allow(ctx, prefix+"user:a", 1, 1)
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+
+	result, err = rl.Allow(ctx, prefix+"user:b", 1, 1)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+}
@@ -0,0 +1,96 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// sessionIndexScanCount 扫描用户会话索引时每批返回的 Key 数量建议值
+const sessionIndexScanCount = 500
+
+// PruneSessionIndexes 清理用户会话索引中已失效的成员，返回清理的成员数量
+// 用户的令牌族集合与访问令牌集合在每次登录时整体续期，用户持续登录时，
+// 集合中已过期或已删除的令牌族ID、令牌哈希不会随集合一起过期而持续堆积
+func (tc *TokenCache) PruneSessionIndexes(ctx context.Context) (int64, error) {
+	pruned, err := tc.pruneIndexes(ctx, tc.getUserFamiliesKey("*"), tc.getTokenFamilyKey)
+	if err != nil {
+		return pruned, err
+	}
+
+	n, err := tc.pruneIndexes(ctx, tc.getUserTokensKey("*"), tc.getTokenKey)
+
+	return pruned + n, err
+}
+
+// pruneIndexes 逐个清理匹配 pattern 的索引集合，memberKey 返回成员对应的数据 Key
+func (tc *TokenCache) pruneIndexes(
+	ctx context.Context,
+	pattern string,
+	memberKey func(member string) string,
+) (int64, error) {
+	var pruned int64
+
+	iter := tc.client.GetClient().Scan(ctx, 0, pattern, sessionIndexScanCount).Iterator()
+	for iter.Next(ctx) {
+		n, err := tc.pruneIndex(ctx, iter.Val(), memberKey)
+		pruned += n
+
+		if err != nil {
+			return pruned, err
+		}
+	}
+
+	if err := iter.Err(); err != nil {
+		return pruned, fmt.Errorf("扫描会话索引失败: %w", err)
+	}
+
+	return pruned, nil
+}
+
+// pruneIndex 从索引集合中移除数据 Key 已不存在的成员
+// 数据 Key 均先于集合成员写入，成员存在而数据 Key 不存在即说明对应令牌已过期或被删除
+func (tc *TokenCache) pruneIndex(
+	ctx context.Context,
+	indexKey string,
+	memberKey func(member string) string,
+) (int64, error) {
+	members, err := tc.client.SMembers(ctx, indexKey)
+	if err != nil {
+		return 0, fmt.Errorf("获取会话索引成员失败: %w", err)
+	}
+
+	if len(members) == 0 {
+		return 0, nil
+	}
+
+	pipe := tc.client.GetClient().Pipeline()
+
+	cmds := make([]*redis.IntCmd, len(members))
+	for i, member := range members {
+		cmds[i] = pipe.Exists(ctx, memberKey(member))
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("检查会话索引成员失败: %w", err)
+	}
+
+	stale := make([]interface{}, 0)
+
+	for i, cmd := range cmds {
+		if cmd.Val() == 0 {
+			stale = append(stale, members[i])
+		}
+	}
+
+	if len(stale) == 0 {
+		return 0, nil
+	}
+
+	if err := tc.client.SRem(ctx, indexKey, stale...); err != nil {
+		return 0, fmt.Errorf("清理会话索引失败: %w", err)
+	}
+
+	return int64(len(stale)), nil
+}
@@ -0,0 +1,54 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneSessionIndexes(t *testing.T) {
+	ctx := context.Background()
+	tc := newMemoryTokenCache()
+
+	for _, familyID := range []string{"family-1", "family-2", "family-3"} {
+		require.NoError(t, tc.SaveTokenFamily(ctx, &TokenFamily{FamilyID: familyID, UserID: "user-1"}, time.Hour))
+	}
+
+	require.NoError(t, tc.SaveTokenFamily(ctx, &TokenFamily{FamilyID: "family-4", UserID: "user-2"}, time.Hour))
+
+	for _, token := range []string{"token-1", "token-2"} {
+		require.NoError(t, tc.CacheToken(ctx, token, "user-1", time.Hour))
+	}
+
+	// 模拟令牌族与令牌因 TTL 到期被 Redis 删除，索引集合中的成员仍然保留
+	rdb := tc.client.GetClient()
+	require.NoError(t, rdb.Del(ctx,
+		tc.getTokenFamilyKey("family-1"),
+		tc.getTokenFamilyKey("family-4"),
+		tc.getTokenKey(tc.hashToken("token-1")),
+	).Err())
+
+	pruned, err := tc.PruneSessionIndexes(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), pruned)
+
+	families, err := tc.client.SMembers(ctx, tc.getUserFamiliesKey("user-1"))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"family-2", "family-3"}, families)
+
+	families, err = tc.client.SMembers(ctx, tc.getUserFamiliesKey("user-2"))
+	require.NoError(t, err)
+	assert.Empty(t, families)
+
+	tokens, err := tc.GetUserTokens(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{tc.hashToken("token-2")}, tokens)
+
+	// 再次清理时没有失效成员
+	pruned, err = tc.PruneSessionIndexes(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, pruned)
+}
@@ -37,6 +37,59 @@ type TokenCacheService interface {
 
 	// RevokeToken 吊销token，expiration为token剩余有效期
 	RevokeToken(ctx context.Context, token string, expiration time.Duration) error
+
+	// SaveTokenFamily 保存令牌族，expiration为令牌族剩余有效期
+	SaveTokenFamily(ctx context.Context, family *TokenFamily, expiration time.Duration) error
+
+	// GetTokenFamily 获取令牌族，不存在时返回 nil
+	GetTokenFamily(ctx context.Context, familyID string) (*TokenFamily, error)
+
+	// ListUserTokenFamilies 获取用户所有有效的令牌族（登录会话）
+	ListUserTokenFamilies(ctx context.Context, userID string) ([]*TokenFamily, error)
+
+	// TouchTokenFamily 检查令牌族是否仍然有效，有效时更新最近活跃时间
+	TouchTokenFamily(ctx context.Context, familyID string) (bool, error)
+
+	// UpdateTokenFamilyClaims 替换令牌族的载荷快照，令牌族不存在时返回 false
+	UpdateTokenFamilyClaims(ctx context.Context, familyID, claims string) (bool, error)
+
+	// RevokeTokenFamily 吊销令牌族，族内访问令牌与刷新令牌随之失效
+	RevokeTokenFamily(ctx context.Context, familyID, userID string) error
+
+	// PruneSessionIndexes 清理用户会话索引中已失效的令牌族ID与令牌哈希，返回清理的数量
+	PruneSessionIndexes(ctx context.Context) (int64, error)
+
+	// StoreRefreshToken 存储刷新令牌
+	StoreRefreshToken(
+		ctx context.Context,
+		refreshToken string,
+		record *RefreshTokenRecord,
+		expiration time.Duration,
+	) error
+
+	// ConsumeRefreshToken 消费刷新令牌，重复使用已轮换的令牌时返回 ErrRefreshTokenReused
+	ConsumeRefreshToken(
+		ctx context.Context,
+		refreshToken string,
+		reuseWindow time.Duration,
+	) (*RefreshTokenRecord, error)
+
+	// SaveMFAChallenge 保存多因素认证挑战，expiration为挑战有效期
+	SaveMFAChallenge(
+		ctx context.Context,
+		challengeToken string,
+		challenge *MFAChallenge,
+		expiration time.Duration,
+	) error
+
+	// GetMFAChallenge 获取多因素认证挑战，不存在时返回 nil
+	GetMFAChallenge(ctx context.Context, challengeToken string) (*MFAChallenge, error)
+
+	// IncrMFAChallengeAttempts 累加多因素认证挑战的失败次数
+	IncrMFAChallengeAttempts(ctx context.Context, challengeToken string) (int64, error)
+
+	// DeleteMFAChallenge 删除多因素认证挑战，返回挑战删除前是否存在
+	DeleteMFAChallenge(ctx context.Context, challengeToken string) (bool, error)
 }
 
 // TokenCache Token缓存服务实现
@@ -184,7 +237,18 @@ func (tc *TokenCache) RefreshTokenExpiration(
 }
 
 // RemoveUserTokens 移除用户所有Token
+// 同时删除用户的全部令牌族，使该用户所有会话的访问令牌与刷新令牌立即失效
 func (tc *TokenCache) RemoveUserTokens(ctx context.Context, userID string) error {
+	familyCount, err := tc.removeUserFamilies(ctx, userID)
+	if err != nil {
+		tc.logger.Errorf("Failed to remove user token families: error=%v, userID=%s", err, userID)
+		return err
+	}
+
+	if familyCount > 0 {
+		tc.logger.Infof("User token families removed: userID=%s, familyCount=%d", userID, familyCount)
+	}
+
 	userTokensKey := tc.getUserTokensKey(userID)
 
 	// 获取用户所有Token
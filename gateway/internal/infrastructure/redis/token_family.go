@@ -0,0 +1,352 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 刷新令牌相关错误
+var (
+	// ErrRefreshTokenNotFound 刷新令牌不存在或已过期
+	ErrRefreshTokenNotFound = errors.New("刷新令牌不存在或已过期")
+
+	// ErrRefreshTokenReused 刷新令牌已被轮换过，再次出现说明令牌可能已泄露
+	ErrRefreshTokenReused = errors.New("刷新令牌已被使用")
+)
+
+// consumeRefreshTokenScript 原子地消费刷新令牌
+// 令牌存在时删除并写入"已轮换"标记（保留至令牌族过期，用于重放检测）；
+// 令牌不存在但存在已轮换标记时，说明该令牌被重复使用。
+// 返回值：{状态(0=不存在, 1=消费成功, 2=重复使用), 令牌记录}
+var consumeRefreshTokenScript = redis.NewScript(`
+local record = redis.call('GET', KEYS[1])
+if record then
+	redis.call('DEL', KEYS[1])
+	redis.call('SET', KEYS[2], record, 'PX', ARGV[1])
+	return {1, record}
+end
+
+local used = redis.call('GET', KEYS[2])
+if used then
+	return {2, used}
+end
+
+return {0, ''}
+`)
+
+// touchTokenFamilyScript 令牌族存在时更新最近活跃时间
+// 返回值：1=令牌族有效, 0=令牌族不存在
+var touchTokenFamilyScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('HSET', KEYS[1], 'last_seen_at', ARGV[1])
+	return 1
+end
+
+return 0
+`)
+
+// updateTokenFamilyClaimsScript 令牌族存在时替换载荷快照
+// 返回值：1=更新成功, 0=令牌族不存在
+var updateTokenFamilyClaimsScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('HSET', KEYS[1], 'claims', ARGV[1])
+	return 1
+end
+
+return 0
+`)
+
+// TokenFamily 令牌族
+// 一次登录产生一个令牌族（即一个登录会话），族内的刷新令牌逐次轮换；
+// 访问令牌通过 claims 中的族ID关联，令牌族被删除后，族内所有访问令牌与刷新令牌立即失效
+type TokenFamily struct {
+	FamilyID   string // 令牌族ID（会话ID）
+	UserID     string // 用户ID
+	Claims     string // 签发访问令牌所需的载荷快照（JSON）
+	Device     string // 设备名称
+	IPAddress  string // 登录IP地址
+	UserAgent  string // 客户端User-Agent
+	CreatedAt  int64  // 创建时间（Unix秒）
+	LastSeenAt int64  // 最近活跃时间（Unix秒）
+	ExpiresAt  int64  // 过期时间（Unix秒）
+}
+
+// RefreshTokenRecord 刷新令牌记录
+type RefreshTokenRecord struct {
+	FamilyID string `json:"familyID"`
+	UserID   string `json:"userID"`
+}
+
+// getTokenFamilyKey 获取令牌族的Redis Key
+func (tc *TokenCache) getTokenFamilyKey(familyID string) string {
+	return fmt.Sprintf("radius:jwt:family:%s", familyID)
+}
+
+// getUserFamiliesKey 获取用户令牌族集合的Redis Key
+func (tc *TokenCache) getUserFamiliesKey(userID string) string {
+	return fmt.Sprintf("radius:user:%s:families", userID)
+}
+
+// getRefreshTokenKey 获取刷新令牌的Redis Key
+func (tc *TokenCache) getRefreshTokenKey(tokenHash string) string {
+	return fmt.Sprintf("radius:jwt:refresh:%s", tokenHash)
+}
+
+// getUsedRefreshTokenKey 获取已轮换刷新令牌标记的Redis Key
+func (tc *TokenCache) getUsedRefreshTokenKey(tokenHash string) string {
+	return fmt.Sprintf("radius:jwt:refresh:used:%s", tokenHash)
+}
+
+// SaveTokenFamily 保存令牌族，expiration为令牌族剩余有效期
+func (tc *TokenCache) SaveTokenFamily(
+	ctx context.Context,
+	family *TokenFamily,
+	expiration time.Duration,
+) error {
+	familyKey := tc.getTokenFamilyKey(family.FamilyID)
+	userFamiliesKey := tc.getUserFamiliesKey(family.UserID)
+
+	pipe := tc.client.GetClient().Pipeline()
+	pipe.HSet(ctx, familyKey, map[string]interface{}{
+		"user_id":      family.UserID,
+		"claims":       family.Claims,
+		"device":       family.Device,
+		"ip_address":   family.IPAddress,
+		"user_agent":   family.UserAgent,
+		"created_at":   family.CreatedAt,
+		"last_seen_at": family.LastSeenAt,
+		"expires_at":   family.ExpiresAt,
+	})
+	pipe.Expire(ctx, familyKey, expiration)
+	pipe.SAdd(ctx, userFamiliesKey, family.FamilyID)
+	pipe.Expire(ctx, userFamiliesKey, expiration)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		tc.logger.Errorf("Failed to save token family: error=%v, userID=%s", err, family.UserID)
+		return fmt.Errorf("保存令牌族失败: %w", err)
+	}
+
+	return nil
+}
+
+// GetTokenFamily 获取令牌族，不存在时返回 nil
+func (tc *TokenCache) GetTokenFamily(ctx context.Context, familyID string) (*TokenFamily, error) {
+	fields, err := tc.client.GetClient().HGetAll(ctx, tc.getTokenFamilyKey(familyID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("获取令牌族失败: %w", err)
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	return parseTokenFamily(familyID, fields), nil
+}
+
+// ListUserTokenFamilies 获取用户所有有效的令牌族，顺带清理集合中已过期的令牌族ID
+func (tc *TokenCache) ListUserTokenFamilies(ctx context.Context, userID string) ([]*TokenFamily, error) {
+	userFamiliesKey := tc.getUserFamiliesKey(userID)
+
+	familyIDs, err := tc.client.SMembers(ctx, userFamiliesKey)
+	if err != nil {
+		tc.logger.Errorf("Failed to get user token families: error=%v, userID=%s", err, userID)
+		return nil, fmt.Errorf("获取用户令牌族列表失败: %w", err)
+	}
+
+	if len(familyIDs) == 0 {
+		return []*TokenFamily{}, nil
+	}
+
+	pipe := tc.client.GetClient().Pipeline()
+
+	cmds := make([]*redis.MapStringStringCmd, len(familyIDs))
+	for i, familyID := range familyIDs {
+		cmds[i] = pipe.HGetAll(ctx, tc.getTokenFamilyKey(familyID))
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("批量获取令牌族失败: %w", err)
+	}
+
+	families := make([]*TokenFamily, 0, len(familyIDs))
+	expired := make([]interface{}, 0)
+
+	for i, cmd := range cmds {
+		fields := cmd.Val()
+		if len(fields) == 0 {
+			expired = append(expired, familyIDs[i])
+			continue
+		}
+
+		families = append(families, parseTokenFamily(familyIDs[i], fields))
+	}
+
+	if len(expired) > 0 {
+		if err := tc.client.SRem(ctx, userFamiliesKey, expired...); err != nil {
+			tc.logger.Warnf("Failed to clean expired token families: error=%v, userID=%s", err, userID)
+		}
+	}
+
+	return families, nil
+}
+
+// TouchTokenFamily 检查令牌族是否仍然有效，有效时更新最近活跃时间
+func (tc *TokenCache) TouchTokenFamily(ctx context.Context, familyID string) (bool, error) {
+	active, err := touchTokenFamilyScript.Run(
+		ctx,
+		tc.client.GetClient(),
+		[]string{tc.getTokenFamilyKey(familyID)},
+		time.Now().Unix(),
+	).Int()
+	if err != nil {
+		return false, fmt.Errorf("检查令牌族状态失败: %w", err)
+	}
+
+	return active == 1, nil
+}
+
+// UpdateTokenFamilyClaims 替换令牌族的载荷快照，此后刷新签发的访问令牌使用新的载荷
+// 令牌族已被吊销或过期时返回 false
+func (tc *TokenCache) UpdateTokenFamilyClaims(ctx context.Context, familyID, claims string) (bool, error) {
+	updated, err := updateTokenFamilyClaimsScript.Run(
+		ctx,
+		tc.client.GetClient(),
+		[]string{tc.getTokenFamilyKey(familyID)},
+		claims,
+	).Int()
+	if err != nil {
+		return false, fmt.Errorf("更新令牌族载荷失败: %w", err)
+	}
+
+	return updated == 1, nil
+}
+
+// parseTokenFamily 从Redis Hash字段解析令牌族
+func parseTokenFamily(familyID string, fields map[string]string) *TokenFamily {
+	parseInt := func(key string) int64 {
+		value, _ := strconv.ParseInt(fields[key], 10, 64)
+		return value
+	}
+
+	return &TokenFamily{
+		FamilyID:   familyID,
+		UserID:     fields["user_id"],
+		Claims:     fields["claims"],
+		Device:     fields["device"],
+		IPAddress:  fields["ip_address"],
+		UserAgent:  fields["user_agent"],
+		CreatedAt:  parseInt("created_at"),
+		LastSeenAt: parseInt("last_seen_at"),
+		ExpiresAt:  parseInt("expires_at"),
+	}
+}
+
+// RevokeTokenFamily 吊销令牌族，族内访问令牌与刷新令牌随之失效
+func (tc *TokenCache) RevokeTokenFamily(ctx context.Context, familyID, userID string) error {
+	pipe := tc.client.GetClient().Pipeline()
+	pipe.Del(ctx, tc.getTokenFamilyKey(familyID))
+	pipe.SRem(ctx, tc.getUserFamiliesKey(userID), familyID)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		tc.logger.Errorf("Failed to revoke token family: error=%v, userID=%s", err, userID)
+		return fmt.Errorf("吊销令牌族失败: %w", err)
+	}
+
+	tc.logger.Infof("Token family revoked: userID=%s", userID)
+
+	return nil
+}
+
+// StoreRefreshToken 存储刷新令牌，仅保存哈希值
+func (tc *TokenCache) StoreRefreshToken(
+	ctx context.Context,
+	refreshToken string,
+	record *RefreshTokenRecord,
+	expiration time.Duration,
+) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("序列化刷新令牌记录失败: %w", err)
+	}
+
+	tokenKey := tc.getRefreshTokenKey(tc.hashToken(refreshToken))
+	if err := tc.client.Set(ctx, tokenKey, string(data), expiration); err != nil {
+		tc.logger.Errorf("Failed to store refresh token: error=%v, userID=%s", err, record.UserID)
+		return fmt.Errorf("存储刷新令牌失败: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeRefreshToken 消费刷新令牌
+// 令牌只能成功消费一次；再次提交已轮换的令牌时返回 ErrRefreshTokenReused 及其所属记录，
+// 调用方应据此吊销整个令牌族。reuseWindow 为已轮换标记的保留时长
+func (tc *TokenCache) ConsumeRefreshToken(
+	ctx context.Context,
+	refreshToken string,
+	reuseWindow time.Duration,
+) (*RefreshTokenRecord, error) {
+	tokenHash := tc.hashToken(refreshToken)
+
+	result, err := consumeRefreshTokenScript.Run(
+		ctx,
+		tc.client.GetClient(),
+		[]string{tc.getRefreshTokenKey(tokenHash), tc.getUsedRefreshTokenKey(tokenHash)},
+		reuseWindow.Milliseconds(),
+	).Slice()
+	if err != nil {
+		tc.logger.Errorf("Failed to consume refresh token: error=%v", err)
+		return nil, fmt.Errorf("消费刷新令牌失败: %w", err)
+	}
+
+	if len(result) != 2 {
+		return nil, fmt.Errorf("刷新令牌脚本返回值异常: %v", result)
+	}
+
+	status, _ := result[0].(int64)
+	if status == 0 {
+		return nil, ErrRefreshTokenNotFound
+	}
+
+	data, _ := result[1].(string)
+
+	var record RefreshTokenRecord
+	if err := json.Unmarshal([]byte(data), &record); err != nil {
+		return nil, fmt.Errorf("解析刷新令牌记录失败: %w", err)
+	}
+
+	if status == 2 {
+		return &record, ErrRefreshTokenReused
+	}
+
+	return &record, nil
+}
+
+// removeUserFamilies 删除用户所有令牌族
+func (tc *TokenCache) removeUserFamilies(ctx context.Context, userID string) (int, error) {
+	userFamiliesKey := tc.getUserFamiliesKey(userID)
+
+	familyIDs, err := tc.client.SMembers(ctx, userFamiliesKey)
+	if err != nil {
+		return 0, fmt.Errorf("获取用户令牌族列表失败: %w", err)
+	}
+
+	keys := make([]string, 0, len(familyIDs)+1)
+	for _, familyID := range familyIDs {
+		keys = append(keys, tc.getTokenFamilyKey(familyID))
+	}
+
+	keys = append(keys, userFamiliesKey)
+
+	if err := tc.client.Del(ctx, keys...); err != nil {
+		return 0, fmt.Errorf("删除用户令牌族失败: %w", err)
+	}
+
+	return len(familyIDs), nil
+}
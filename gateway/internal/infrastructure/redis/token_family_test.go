@@ -0,0 +1,303 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryHook 以内存数据代替 Redis 服务端执行命令，仅支持令牌族与会话索引读写涉及的字符串、哈希与集合命令
+type memoryHook struct {
+	strings map[string]string
+	hashes  map[string]map[string]string
+	sets    map[string]map[string]struct{}
+}
+
+func newMemoryHook() *memoryHook {
+	return &memoryHook{
+		strings: make(map[string]string),
+		hashes:  make(map[string]map[string]string),
+		sets:    make(map[string]map[string]struct{}),
+	}
+}
+
+// exists 判断任意类型的 Key 是否存在
+func (h *memoryHook) exists(key string) bool {
+	_, isString := h.strings[key]
+	_, isHash := h.hashes[key]
+	_, isSet := h.sets[key]
+
+	return isString || isHash || isSet
+}
+
+// keys 返回全部 Key
+func (h *memoryHook) keys() []string {
+	keys := make([]string, 0, len(h.strings)+len(h.hashes)+len(h.sets))
+
+	for key := range h.strings {
+		keys = append(keys, key)
+	}
+
+	for key := range h.hashes {
+		keys = append(keys, key)
+	}
+
+	for key := range h.sets {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+func (h *memoryHook) DialHook(redis.DialHook) redis.DialHook {
+	return func(context.Context, string, string) (net.Conn, error) {
+		return nil, fmt.Errorf("memoryHook: dial not supported")
+	}
+}
+
+func (h *memoryHook) ProcessHook(redis.ProcessHook) redis.ProcessHook {
+	return func(_ context.Context, cmd redis.Cmder) error {
+		return h.process(cmd)
+	}
+}
+
+func (h *memoryHook) ProcessPipelineHook(redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(_ context.Context, cmds []redis.Cmder) error {
+		for _, cmd := range cmds {
+			if err := h.process(cmd); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func (h *memoryHook) process(cmd redis.Cmder) error {
+	args := make([]string, len(cmd.Args()))
+	for i, arg := range cmd.Args() {
+		args[i] = fmt.Sprint(arg)
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "set":
+		h.strings[args[1]] = args[2]
+		cmd.(*redis.StatusCmd).SetVal("OK")
+	case "exists":
+		var count int64
+
+		for _, key := range args[1:] {
+			if h.exists(key) {
+				count++
+			}
+		}
+
+		cmd.(*redis.IntCmd).SetVal(count)
+	case "scan":
+		// 一次返回全部匹配的 Key，游标固定为 0
+		pattern := "*"
+
+		for i := 2; i+1 < len(args); i += 2 {
+			if strings.ToLower(args[i]) == "match" {
+				pattern = args[i+1]
+			}
+		}
+
+		var keys []string
+
+		for _, key := range h.keys() {
+			if matched, _ := path.Match(pattern, key); matched {
+				keys = append(keys, key)
+			}
+		}
+
+		cmd.(*redis.ScanCmd).SetVal(keys, 0)
+	case "smembers":
+		members := make([]string, 0, len(h.sets[args[1]]))
+		for member := range h.sets[args[1]] {
+			members = append(members, member)
+		}
+
+		cmd.(*redis.StringSliceCmd).SetVal(members)
+	case "hset":
+		hash, ok := h.hashes[args[1]]
+		if !ok {
+			hash = make(map[string]string)
+			h.hashes[args[1]] = hash
+		}
+
+		for i := 2; i+1 < len(args); i += 2 {
+			hash[args[i]] = args[i+1]
+		}
+
+		cmd.(*redis.IntCmd).SetVal(int64((len(args) - 2) / 2))
+	case "hgetall":
+		fields := make(map[string]string)
+		for k, v := range h.hashes[args[1]] {
+			fields[k] = v
+		}
+
+		cmd.(*redis.MapStringStringCmd).SetVal(fields)
+	case "sadd":
+		set, ok := h.sets[args[1]]
+		if !ok {
+			set = make(map[string]struct{})
+			h.sets[args[1]] = set
+		}
+
+		for _, member := range args[2:] {
+			set[member] = struct{}{}
+		}
+
+		cmd.(*redis.IntCmd).SetVal(int64(len(args) - 2))
+	case "srem":
+		for _, member := range args[2:] {
+			delete(h.sets[args[1]], member)
+		}
+
+		cmd.(*redis.IntCmd).SetVal(int64(len(args) - 2))
+	case "del":
+		for _, key := range args[1:] {
+			delete(h.strings, key)
+			delete(h.hashes, key)
+			delete(h.sets, key)
+		}
+
+		cmd.(*redis.IntCmd).SetVal(int64(len(args) - 1))
+	case "expire":
+		cmd.(*redis.BoolCmd).SetVal(true)
+	default:
+		return fmt.Errorf("memoryHook: unsupported command %q", args[0])
+	}
+
+	return nil
+}
+
+func newMemoryTokenCache() *TokenCache {
+	rdb := redis.NewClient(&redis.Options{Addr: "memory"})
+	rdb.AddHook(newMemoryHook())
+
+	return &TokenCache{
+		client: &Client{rdb: rdb},
+		logger: hertzZerolog.New(),
+	}
+}
+
+func TestGetTokenFamily_ReturnsNilAfterRevoke(t *testing.T) {
+	ctx := context.Background()
+	tc := newMemoryTokenCache()
+
+	family := &TokenFamily{
+		FamilyID:  "family-1",
+		UserID:    "user-1",
+		Claims:    `{"user_profile_id":"user-1"}`,
+		CreatedAt: time.Now().Unix(),
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	}
+	require.NoError(t, tc.SaveTokenFamily(ctx, family, time.Hour))
+
+	got, err := tc.GetTokenFamily(ctx, family.FamilyID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, family.UserID, got.UserID)
+	assert.Equal(t, family.Claims, got.Claims)
+
+	require.NoError(t, tc.RevokeTokenFamily(ctx, family.FamilyID, family.UserID))
+
+	got, err = tc.GetTokenFamily(ctx, family.FamilyID)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestGetTokenFamily_ReturnsNilWhenMissing(t *testing.T) {
+	got, err := newMemoryTokenCache().GetTokenFamily(context.Background(), "missing")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestRemoveUserTokens_RevokesAllFamilies(t *testing.T) {
+	ctx := context.Background()
+	tc := newMemoryTokenCache()
+
+	for _, family := range []*TokenFamily{
+		{FamilyID: "family-1", UserID: "user-1"},
+		{FamilyID: "family-2", UserID: "user-1"},
+		{FamilyID: "family-3", UserID: "user-2"},
+	} {
+		require.NoError(t, tc.SaveTokenFamily(ctx, family, time.Hour))
+	}
+
+	require.NoError(t, tc.RemoveUserTokens(ctx, "user-1"))
+
+	for familyID, alive := range map[string]bool{"family-1": false, "family-2": false, "family-3": true} {
+		got, err := tc.GetTokenFamily(ctx, familyID)
+		require.NoError(t, err)
+		assert.Equal(t, alive, got != nil, familyID)
+	}
+}
+
+func TestConsumeRefreshToken_ParsesScriptReply(t *testing.T) {
+	ctx := context.Background()
+	record := `{"familyID":"family-1","userID":"user-1"}`
+
+	newTokenCache := func(reply ...interface{}) *TokenCache {
+		client, _ := newScriptReplyClient(reply...)
+		return &TokenCache{client: client, logger: hertzZerolog.New()}
+	}
+
+	got, err := newTokenCache(int64(1), record).ConsumeRefreshToken(ctx, "token", time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, &RefreshTokenRecord{FamilyID: "family-1", UserID: "user-1"}, got)
+
+	// 已轮换的令牌返回所属记录，供调用方吊销令牌族
+	got, err = newTokenCache(int64(2), record).ConsumeRefreshToken(ctx, "token", time.Minute)
+	assert.Equal(t, ErrRefreshTokenReused, err)
+	assert.Equal(t, &RefreshTokenRecord{FamilyID: "family-1", UserID: "user-1"}, got)
+
+	got, err = newTokenCache(int64(0), "").ConsumeRefreshToken(ctx, "token", time.Minute)
+	assert.Equal(t, ErrRefreshTokenNotFound, err)
+	assert.Nil(t, got)
+}
+
+func TestConsumeRefreshTokenScript_DetectsReuse(t *testing.T) {
+	ctx := context.Background()
+	tc := &TokenCache{client: newIntegrationClient(t), logger: hertzZerolog.New()}
+
+	refreshToken := fmt.Sprintf("test-refresh-%d", time.Now().UnixNano())
+	tokenHash := tc.hashToken(refreshToken)
+
+	t.Cleanup(func() {
+		_ = tc.client.Del(context.Background(),
+			tc.getRefreshTokenKey(tokenHash), tc.getUsedRefreshTokenKey(tokenHash))
+	})
+
+	record := &RefreshTokenRecord{FamilyID: "family-1", UserID: "user-1"}
+	require.NoError(t, tc.StoreRefreshToken(ctx, refreshToken, record, time.Minute))
+
+	got, err := tc.ConsumeRefreshToken(ctx, refreshToken, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, record, got)
+
+	exists, err := tc.client.Exists(ctx, tc.getRefreshTokenKey(tokenHash))
+	require.NoError(t, err)
+	assert.False(t, exists, "消费后刷新令牌应被删除")
+
+	for range 2 {
+		got, err = tc.ConsumeRefreshToken(ctx, refreshToken, time.Minute)
+		assert.Equal(t, ErrRefreshTokenReused, err)
+		assert.Equal(t, record, got)
+	}
+
+	got, err = tc.ConsumeRefreshToken(ctx, "unknown-"+refreshToken, time.Minute)
+	assert.Equal(t, ErrRefreshTokenNotFound, err)
+	assert.Nil(t, got)
+}
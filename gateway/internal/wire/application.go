@@ -3,6 +3,7 @@ package wire
 
 import (
 	"github.com/google/wire"
+	auditassembler "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/audit"
 	identityassembler "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/identity"
 	permissionassembler "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/permission"
 )
@@ -24,6 +25,9 @@ var ApplicationSet = wire.NewSet(
 	permissionassembler.NewUserRoleAssembler,
 	permissionassembler.NewMenuAssembler,
 
+	// 审计日志 assembler
+	auditassembler.NewAuditAssembler,
+
 	// 聚合 assembler
 	identityassembler.NewIdentityAggregateAssembler,
 	permissionassembler.NewPermissionAggregateAssembler,
@@ -2,6 +2,8 @@
 package wire
 
 import (
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/common"
+	auditService "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/audit"
 	identityService "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/identity"
 	permissionService "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/permission"
 )
@@ -11,15 +13,23 @@ import (
 type ServiceContainer struct {
 	IdentityService   identityService.Service
 	PermissionService permissionService.Service
+	AuditService      auditService.Service
+
+	// SessionCleaner 过期会话清理后台任务，由 main 启动
+	SessionCleaner common.SessionCleaner
 }
 
 // NewServiceContainer 创建服务容器
 func NewServiceContainer(
 	identityService identityService.Service,
 	permissionService permissionService.Service,
+	auditService auditService.Service,
+	sessionCleaner common.SessionCleaner,
 ) *ServiceContainer {
 	return &ServiceContainer{
 		IdentityService:   identityService,
 		PermissionService: permissionService,
+		AuditService:      auditService,
+		SessionCleaner:    sessionCleaner,
 	}
 }
@@ -4,11 +4,16 @@ package wire
 import (
 	"github.com/google/wire"
 	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	auditassembler "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/audit"
 	identityassembler "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/identity"
 	permissionConv "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/permission"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/common"
+	auditservice "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/audit"
 	identityservice "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/identity"
 	permissionservice "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/permission"
 	identitycli "github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/client/identity_cli"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/config"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/redis"
 )
 
 // DomainServiceSet 领域服务层依赖注入集合
@@ -21,11 +26,19 @@ var DomainServiceSet = wire.NewSet(
 	ProvideOrganizationService,
 	ProvideDepartmentService,
 	ProvideLogoService,
+	ProvideSessionService,
+	ProvideMFAService,
+	ProvideTokenRevoker,
+	ProvideSessionCleaner,
 
 	// 角色与权限管理领域服务
 	ProvideRoleDefinitionService,
 	ProvideUserRoleAssignmentService,
 	ProvideMenuService,
+	ProvideAuthorizationService,
+
+	// 审计日志领域服务
+	ProvideAuditService,
 
 	// 聚合服务
 	ProvideIdentityService,
@@ -49,11 +62,13 @@ func ProvideAuthService(
 func ProvideUserService(
 	identityClient identitycli.IdentityClient,
 	assembler identityassembler.Assembler,
+	tokenRevoker common.TokenRevoker,
 	logger *hertzZerolog.Logger,
 ) identityservice.UserService {
 	return identityservice.NewUserManagementService(
 		identityClient,
 		assembler,
+		tokenRevoker,
 		logger,
 	)
 }
@@ -94,30 +109,91 @@ func ProvideLogoService(
 	return identityservice.NewLogoService(identityClient, assembler, logger)
 }
 
+// ProvideTokenRevoker 提供用户令牌吊销器
+func ProvideTokenRevoker(
+	tokenCache redis.TokenCacheService,
+	invalidation redis.PermissionInvalidationService,
+	logger *hertzZerolog.Logger,
+) common.TokenRevoker {
+	return common.NewTokenRevoker(tokenCache, invalidation, logger)
+}
+
+// ProvideSessionCleaner 提供过期会话清理任务
+// 以 Redis 锁选主，多副本部署时每个清理间隔只由一个副本执行
+func ProvideSessionCleaner(
+	jwtConfig *config.JWTConfig,
+	tokenCache redis.TokenCacheService,
+	client *redis.Client,
+	logger *hertzZerolog.Logger,
+) common.SessionCleaner {
+	return common.NewSessionCleaner(tokenCache, client, jwtConfig.SessionCleanupInterval, logger)
+}
+
+// ProvideSessionService 提供登录会话管理服务
+func ProvideSessionService(
+	tokenCache redis.TokenCacheService,
+	logger *hertzZerolog.Logger,
+) identityservice.SessionService {
+	return identityservice.NewSessionService(tokenCache, logger)
+}
+
+// ProvideMFAService 提供多因素认证服务
+func ProvideMFAService(
+	identityClient identitycli.IdentityClient,
+	logger *hertzZerolog.Logger,
+) identityservice.MFAService {
+	return identityservice.NewMFAService(identityClient, logger)
+}
+
 // ProvideRoleDefinitionService 提供角色定义服务
 func ProvideRoleDefinitionService(
 	identityClient identitycli.IdentityClient,
 	assembler permissionConv.Assembler,
+	invalidation redis.PermissionInvalidationService,
 	logger *hertzZerolog.Logger,
 ) permissionservice.RoleDefinitionService {
-	return permissionservice.NewRoleDefinitionService(identityClient, assembler, logger)
+	return permissionservice.NewRoleDefinitionService(identityClient, assembler, invalidation, logger)
 }
 
 // ProvideUserRoleAssignmentService 提供用户角色分配服务
 func ProvideUserRoleAssignmentService(
 	identityClient identitycli.IdentityClient,
 	assembler permissionConv.Assembler,
+	tokenRevoker common.TokenRevoker,
 	logger *hertzZerolog.Logger,
 ) permissionservice.UserRoleAssignmentService {
-	return permissionservice.NewUserRoleAssignmentService(identityClient, assembler, logger)
+	return permissionservice.NewUserRoleAssignmentService(
+		identityClient,
+		assembler,
+		tokenRevoker,
+		logger,
+	)
 }
 
 func ProvideMenuService(
 	identityClient identitycli.IdentityClient,
 	assembler permissionConv.Assembler,
+	tokenRevoker common.TokenRevoker,
 	logger *hertzZerolog.Logger,
 ) permissionservice.MenuService {
-	return permissionservice.NewMenuService(identityClient, assembler, logger)
+	return permissionservice.NewMenuService(identityClient, assembler, tokenRevoker, logger)
+}
+
+// ProvideAuthorizationService 提供接口权限校验服务
+func ProvideAuthorizationService(
+	identityClient identitycli.IdentityClient,
+	logger *hertzZerolog.Logger,
+) permissionservice.AuthorizationService {
+	return permissionservice.NewAuthorizationService(identityClient, logger)
+}
+
+// ProvideAuditService 提供审计日志服务
+func ProvideAuditService(
+	identityClient identitycli.IdentityClient,
+	assembler auditassembler.Assembler,
+	logger *hertzZerolog.Logger,
+) auditservice.Service {
+	return auditservice.NewService(identityClient, assembler, logger)
 }
 
 // ============================================================================
@@ -133,6 +209,8 @@ func ProvideIdentityService(
 	orgService identityservice.OrganizationService,
 	deptService identityservice.DepartmentService,
 	logoService identityservice.LogoService,
+	sessionService identityservice.SessionService,
+	mfaService identityservice.MFAService,
 ) identityservice.Service {
 	return identityservice.NewService(
 		authService,
@@ -141,6 +219,8 @@ func ProvideIdentityService(
 		orgService,
 		deptService,
 		logoService,
+		sessionService,
+		mfaService,
 	)
 }
 
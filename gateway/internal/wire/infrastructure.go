@@ -20,7 +20,8 @@ var InfrastructureSet = wire.NewSet(
 	ProvideRedisConfig,
 	ProvideRedisClient,
 	ProvideTokenCache,
-	// ProvideCasbinManager,
+	ProvideRateLimiter,
+	ProvidePermissionInvalidation,
 )
 
 // ProvideConfig 提供配置服务
@@ -87,3 +88,18 @@ func ProvideRedisClient(cfg *config.RedisConfig) (*redis.Client, error) {
 func ProvideTokenCache(client *redis.Client, logger *hertzZerolog.Logger) redis.TokenCacheService {
 	return redis.NewTokenCache(client, logger)
 }
+
+// ProvideRateLimiter 提供分布式限流服务
+// 基于 Redis 令牌桶，多个网关副本共享限流计数
+func ProvideRateLimiter(client *redis.Client, logger *hertzZerolog.Logger) redis.RateLimiterService {
+	return redis.NewRateLimiter(client, logger)
+}
+
+// ProvidePermissionInvalidation 提供权限缓存失效通知服务
+// 基于 Redis 发布订阅，角色或权限变更后通知所有网关副本清除本地权限判定缓存
+func ProvidePermissionInvalidation(
+	client *redis.Client,
+	logger *hertzZerolog.Logger,
+) redis.PermissionInvalidationService {
+	return redis.NewPermissionInvalidation(client, logger)
+}
@@ -2,14 +2,19 @@
 package wire
 
 import (
+	"context"
+
 	"github.com/google/wire"
 	hertzZerolog "github.com/hertz-contrib/logger/zerolog"
+	casbinmw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/casbin_middleware"
 	corsmdw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/cors_middleware"
 	errormw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/error_middleware"
 	jwtmdw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/jwt_middleware"
+	ratelimitmw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/ratelimit_middleware"
 	responsemw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/response_middleware"
 	tracemdw "github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware/trace_middleware"
 	identityService "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/identity"
+	permissionService "github.com/masonsxu/cloudwego-scaffold/gateway/internal/domain/service/permission"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/config"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/infrastructure/redis"
 )
@@ -21,7 +26,8 @@ var MiddlewareSet = wire.NewSet(
 	ProvideErrorHandlerMiddleware,
 	ProvideJWTMiddleware,
 	ProvideResponseHeaderMiddleware,
-	// ProvideCasbinMiddleware,
+	ProvideRateLimitMiddleware,
+	ProvideCasbinMiddleware,
 	NewMiddlewareContainer,
 )
 
@@ -33,7 +39,8 @@ type MiddlewareContainer struct {
 	ErrorHandlerMiddleware   errormw.ErrorHandlerMiddlewareService
 	JWTMiddleware            jwtmdw.JWTMiddlewareService
 	ResponseHeaderMiddleware responsemw.ResponseHeaderMiddlewareService
-	// CasbinMiddleware         casbinmw.CasbinMiddleware
+	RateLimitMiddleware      ratelimitmw.RateLimitMiddlewareService
+	CasbinMiddleware         casbinmw.CasbinMiddleware
 }
 
 // NewMiddlewareContainer 创建中间件容器
@@ -43,7 +50,8 @@ func NewMiddlewareContainer(
 	errorHandlerMiddleware errormw.ErrorHandlerMiddlewareService,
 	jwtMiddleware jwtmdw.JWTMiddlewareService,
 	responseHeaderMiddleware responsemw.ResponseHeaderMiddlewareService,
-	// casbinMiddleware casbinmw.CasbinMiddleware,
+	rateLimitMiddleware ratelimitmw.RateLimitMiddlewareService,
+	casbinMiddleware casbinmw.CasbinMiddleware,
 ) *MiddlewareContainer {
 	return &MiddlewareContainer{
 		TraceMiddleware:          traceMiddleware,
@@ -51,7 +59,8 @@ func NewMiddlewareContainer(
 		ErrorHandlerMiddleware:   errorHandlerMiddleware,
 		JWTMiddleware:            jwtMiddleware,
 		ResponseHeaderMiddleware: responseHeaderMiddleware,
-		// CasbinMiddleware:         casbinMiddleware,
+		RateLimitMiddleware:      rateLimitMiddleware,
+		CasbinMiddleware:         casbinMiddleware,
 	}
 }
 
@@ -114,20 +123,54 @@ func ProvideResponseHeaderMiddleware() responsemw.ResponseHeaderMiddlewareServic
 	return responsemw.NewResponseHeaderMiddleware()
 }
 
+// ProvideRateLimitMiddleware 提供限流中间件
+// 按客户端 IP、已认证用户及路由维度限制请求速率
+func ProvideRateLimitMiddleware(
+	cfg *config.Configuration,
+	limiter redis.RateLimiterService,
+	logger *hertzZerolog.Logger,
+) ratelimitmw.RateLimitMiddlewareService {
+	middleware := ratelimitmw.NewRateLimitMiddleware(&cfg.Middleware.RateLimit, limiter, logger)
+	logger.Infof("Rate limit middleware created successfully, enabled=%v", cfg.Middleware.RateLimit.Enabled)
+
+	return middleware
+}
+
 // ProvideCasbinMiddleware 提供Casbin权限中间件
-// 使用 hertz-contrib/casbin 官方中间件，遵循最佳实践
-// func ProvideCasbinMiddleware(
-// 	cfg *config.Configuration,
-// 	logger *slog.Logger,
-// 	manager *casbin.CasbinManager,
-// ) casbinmw.CasbinMiddleware {
-// 	middleware, err := casbinmw.NewCasbinMiddleware(manager, logger, nil)
-// 	if err != nil {
-// 		logger.Error("Failed to create casbin middleware", "error", err)
-// 		panic(err)
-// 	}
-
-// 	logger.Info("Casbin middleware created successfully")
-
-// 	return middleware
-// }
+// 网关不直接访问策略存储，权限判定委托 identity_srv 的 Casbin 执行器完成；
+// 启用判定缓存时订阅权限缓存失效通知，用户角色或权限变更后立即清除本副本的缓存
+func ProvideCasbinMiddleware(
+	cfg *config.Configuration,
+	authzService permissionService.AuthorizationService,
+	invalidation redis.PermissionInvalidationService,
+	logger *hertzZerolog.Logger,
+) casbinmw.CasbinMiddleware {
+	casbinCfg := cfg.Middleware.Casbin
+
+	permissionConfig := casbinmw.DefaultPermissionConfig()
+	permissionConfig.Enabled = casbinCfg.Enabled
+	permissionConfig.SkipPaths = casbinCfg.SkipPaths
+	permissionConfig.EnableCache = casbinCfg.CacheTTL > 0
+	permissionConfig.CacheTimeout = casbinCfg.CacheTTL
+
+	middleware, err := casbinmw.NewCasbinMiddleware(authzService, logger, permissionConfig)
+	if err != nil {
+		logger.Errorf("Failed to create casbin middleware: %v", err)
+		panic(err)
+	}
+
+	if permissionConfig.EnableCache {
+		go invalidation.Subscribe(context.Background(), func(userID string) {
+			if userID == redis.PermissionInvalidateAll {
+				middleware.ClearCache()
+				return
+			}
+
+			middleware.InvalidateUserCache(userID)
+		})
+	}
+
+	logger.Infof("Casbin middleware created successfully, enabled=%v", casbinCfg.Enabled)
+
+	return middleware
+}
@@ -9,6 +9,7 @@ package wire
 import (
 	"github.com/google/wire"
 	"github.com/hertz-contrib/logger/zerolog"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/audit"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/identity"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/assembler/permission"
 )
@@ -29,22 +30,36 @@ func InitializeService() (*ServiceContainer, error) {
 	iLogoAssembler := identity.NewLogoAssembler()
 	assembler := identity.NewIdentityAggregateAssembler(iAuthAssembler, iUserAssembler, iOrgAssembler, iDepartmentAssembler, iMembershipAssembler, iLogoAssembler)
 	authService := ProvideAuthService(identityClient, assembler, logger)
-	userService := ProvideUserService(identityClient, assembler, logger)
+	redisConfig := ProvideRedisConfig(configuration)
+	client, err := ProvideRedisClient(redisConfig)
+	if err != nil {
+		return nil, err
+	}
+	tokenCacheService := ProvideTokenCache(client, logger)
+	permissionInvalidationService := ProvidePermissionInvalidation(client, logger)
+	tokenRevoker := ProvideTokenRevoker(tokenCacheService, permissionInvalidationService, logger)
+	userService := ProvideUserService(identityClient, assembler, tokenRevoker, logger)
 	membershipService := ProvideMembershipService(identityClient, assembler, logger)
 	organizationService := ProvideOrganizationService(identityClient, assembler, logger)
 	departmentService := ProvideDepartmentService(identityClient, assembler, logger)
 	logoService := ProvideLogoService(identityClient, assembler, logger)
-	service := ProvideIdentityService(authService, userService, membershipService, organizationService, departmentService, logoService)
+	sessionService := ProvideSessionService(tokenCacheService, logger)
+	mfaService := ProvideMFAService(identityClient, logger)
+	service := ProvideIdentityService(authService, userService, membershipService, organizationService, departmentService, logoService, sessionService, mfaService)
 	iPermissionAssembler := permission.NewPermissionAssembler()
 	iRoleAssembler := permission.NewRoleAssembler(iPermissionAssembler)
 	iUserRoleAssembler := permission.NewUserRoleAssembler()
 	iMenuAssembler := permission.NewMenuAssembler()
 	permissionAssembler := permission.NewPermissionAggregateAssembler(iRoleAssembler, iPermissionAssembler, iUserRoleAssembler, iMenuAssembler)
-	roleDefinitionService := ProvideRoleDefinitionService(identityClient, permissionAssembler, logger)
-	userRoleAssignmentService := ProvideUserRoleAssignmentService(identityClient, permissionAssembler, logger)
-	menuService := ProvideMenuService(identityClient, permissionAssembler, logger)
+	roleDefinitionService := ProvideRoleDefinitionService(identityClient, permissionAssembler, permissionInvalidationService, logger)
+	userRoleAssignmentService := ProvideUserRoleAssignmentService(identityClient, permissionAssembler, tokenRevoker, logger)
+	menuService := ProvideMenuService(identityClient, permissionAssembler, tokenRevoker, logger)
 	permissionService := ProvidePermissionService(roleDefinitionService, userRoleAssignmentService, menuService)
-	serviceContainer := NewServiceContainer(service, permissionService)
+	auditAssembler := audit.NewAuditAssembler()
+	auditService := ProvideAuditService(identityClient, auditAssembler, logger)
+	jwtConfig := ProvideJWTConfig(configuration)
+	sessionCleaner := ProvideSessionCleaner(jwtConfig, tokenCacheService, client, logger)
+	serviceContainer := NewServiceContainer(service, permissionService, auditService, sessionCleaner)
 	return serviceContainer, nil
 }
 
@@ -65,22 +80,30 @@ func InitializeMiddleware() (*MiddlewareContainer, error) {
 	iLogoAssembler := identity.NewLogoAssembler()
 	assembler := identity.NewIdentityAggregateAssembler(iAuthAssembler, iUserAssembler, iOrgAssembler, iDepartmentAssembler, iMembershipAssembler, iLogoAssembler)
 	authService := ProvideAuthService(identityClient, assembler, logger)
-	userService := ProvideUserService(identityClient, assembler, logger)
-	membershipService := ProvideMembershipService(identityClient, assembler, logger)
-	organizationService := ProvideOrganizationService(identityClient, assembler, logger)
-	departmentService := ProvideDepartmentService(identityClient, assembler, logger)
-	logoService := ProvideLogoService(identityClient, assembler, logger)
-	service := ProvideIdentityService(authService, userService, membershipService, organizationService, departmentService, logoService)
-	jwtConfig := ProvideJWTConfig(configuration)
 	redisConfig := ProvideRedisConfig(configuration)
 	client, err := ProvideRedisClient(redisConfig)
 	if err != nil {
 		return nil, err
 	}
 	tokenCacheService := ProvideTokenCache(client, logger)
+	permissionInvalidationService := ProvidePermissionInvalidation(client, logger)
+	tokenRevoker := ProvideTokenRevoker(tokenCacheService, permissionInvalidationService, logger)
+	userService := ProvideUserService(identityClient, assembler, tokenRevoker, logger)
+	membershipService := ProvideMembershipService(identityClient, assembler, logger)
+	organizationService := ProvideOrganizationService(identityClient, assembler, logger)
+	departmentService := ProvideDepartmentService(identityClient, assembler, logger)
+	logoService := ProvideLogoService(identityClient, assembler, logger)
+	sessionService := ProvideSessionService(tokenCacheService, logger)
+	mfaService := ProvideMFAService(identityClient, logger)
+	service := ProvideIdentityService(authService, userService, membershipService, organizationService, departmentService, logoService, sessionService, mfaService)
+	jwtConfig := ProvideJWTConfig(configuration)
 	jwtMiddlewareService := ProvideJWTMiddleware(service, jwtConfig, tokenCacheService, logger)
 	responseHeaderMiddlewareService := ProvideResponseHeaderMiddleware()
-	middlewareContainer := NewMiddlewareContainer(traceMiddlewareService, corsMiddlewareService, errorHandlerMiddlewareService, jwtMiddlewareService, responseHeaderMiddlewareService)
+	rateLimiterService := ProvideRateLimiter(client, logger)
+	rateLimitMiddlewareService := ProvideRateLimitMiddleware(configuration, rateLimiterService, logger)
+	authorizationService := ProvideAuthorizationService(identityClient, logger)
+	casbinMiddleware := ProvideCasbinMiddleware(configuration, authorizationService, permissionInvalidationService, logger)
+	middlewareContainer := NewMiddlewareContainer(traceMiddlewareService, corsMiddlewareService, errorHandlerMiddlewareService, jwtMiddlewareService, responseHeaderMiddlewareService, rateLimitMiddlewareService, casbinMiddleware)
 	return middlewareContainer, nil
 }
 
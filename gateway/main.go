@@ -3,15 +3,19 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
+	auditHandler "github.com/masonsxu/cloudwego-scaffold/gateway/biz/handler/audit"
 	identityHandler "github.com/masonsxu/cloudwego-scaffold/gateway/biz/handler/identity"
 	permissionHandler "github.com/masonsxu/cloudwego-scaffold/gateway/biz/handler/permission"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/middleware"
+	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/application/observability"
 	"github.com/masonsxu/cloudwego-scaffold/gateway/internal/wire"
 )
 
@@ -42,6 +46,30 @@ func main() {
 	addr := fmt.Sprintf("%s:%d", config.Server.Host, config.Server.Port)
 	h := server.New(server.WithHostPorts(addr), server.WithMaxRequestBodySize(100*1024*1024))
 
+	// 链路追踪：初始化全局 TracerProvider，并最先注册 HTTP 追踪中间件
+	if config.Tracing.Enabled {
+		shutdown, err := observability.InitTracing(context.Background(), &config.Tracing, nil)
+		if err != nil {
+			log.Fatalf("failed to init tracing: %v", err)
+		}
+
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			_ = shutdown(ctx)
+		}()
+
+		h.Use(observability.HTTPTracingMiddleware())
+	}
+
+	// 指标采集：在追踪之后注册以覆盖所有请求，并在独立端口暴露 Prometheus 指标
+	if config.Metrics.Enabled {
+		h.Use(observability.HTTPMetricsMiddleware())
+
+		go observability.StartMetricsServer(&config.Metrics, logger)
+	}
+
 	// 初始化中间件（不包含 Casbin 全局中间件）
 	middleware.DefaultMiddleware(
 		h,
@@ -50,17 +78,20 @@ func main() {
 		middlewares.ErrorHandlerMiddleware,
 		middlewares.JWTMiddleware,
 		middlewares.ResponseHeaderMiddleware,
+		middlewares.RateLimitMiddleware,
 	)
 
 	// 设置全局 Casbin 中间件实例，供路由中间件使用
-	// middleware.SetGlobalCasbinMiddleware(middlewares.CasbinMiddleware)
+	// 必须在 register(h) 之前调用，路由注册时会读取该实例
+	middleware.SetGlobalCasbinMiddleware(middlewares.CasbinMiddleware)
 
 	// 初始化handler层的服务实例
 	identityHandler.SetIdentityService(services.IdentityService, middlewares.JWTMiddleware)
 	permissionHandler.SetPermissionService(services.PermissionService)
+	auditHandler.SetAuditService(services.AuditService)
 
-	// 使用 services 变量确保其被使用
-	_ = services
+	// 定期清理用户会话索引中已失效的令牌族与令牌
+	go services.SessionCleaner.Run(context.Background())
 
 	register(h)
 	h.Spin()
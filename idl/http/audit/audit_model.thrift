@@ -0,0 +1,105 @@
+/**
+ * 审计日志模块 HTTP DTO 定义
+ *
+ * 定义了审计事件查询相关的 HTTP 请求/响应数据传输对象。
+ */
+namespace go audit
+
+include "../../base/core.thrift"
+include "../base/base.thrift"
+
+/** 审计事件DTO */
+struct AuditEventDTO {
+
+    /** 审计事件ID */
+    1: optional string id (go.tag = "json:\"id\""),
+
+    /** 操作者用户ID，未认证的操作（如登录）为空 */
+    2: optional string actorID (go.tag = "json:\"actor_id,omitempty\""),
+
+    /** 操作者当前代表的组织ID */
+    3: optional string actorOrganizationID (go.tag = "json:\"actor_organization_id,omitempty\""),
+
+    /** 操作名称，如 AssignRoleToUser */
+    4: optional string action (go.tag = "json:\"action\""),
+
+    /** 操作对象类型，如 user、role、menu */
+    5: optional string targetType (go.tag = "json:\"target_type\""),
+
+    /** 操作对象ID（登录类操作为用户名） */
+    6: optional string targetID (go.tag = "json:\"target_id,omitempty\""),
+
+    /** 变更前内容（JSON），敏感字段已脱敏 */
+    7: optional string before (go.tag = "json:\"before,omitempty\""),
+
+    /** 变更后内容（JSON），敏感字段已脱敏 */
+    8: optional string after (go.tag = "json:\"after,omitempty\""),
+
+    /** 发生变化的顶层字段 */
+    9: optional list<string> changedFields (go.tag = "json:\"changed_fields,omitempty\""),
+
+    /** 请求ID */
+    10: optional string requestID (go.tag = "json:\"request_id,omitempty\""),
+
+    /** 链路追踪ID */
+    11: optional string traceID (go.tag = "json:\"trace_id,omitempty\""),
+
+    /** 客户端IP地址 */
+    12: optional string ipAddress (go.tag = "json:\"ip_address,omitempty\""),
+
+    /** 执行结果：success 或 failure */
+    13: optional string result (go.tag = "json:\"result\""),
+
+    /** 失败时的业务错误码 */
+    14: optional i32 errorCode (go.tag = "json:\"error_code,omitempty\""),
+
+    /** 失败时的错误信息 */
+    15: optional string errorMessage (go.tag = "json:\"error_message,omitempty\""),
+
+    /** 发生时间 */
+    16: optional core.TimestampMS createdAt (go.tag = "json:\"created_at\""),
+}
+
+/** 审计事件查询请求DTO */
+struct ListAuditEventsRequestDTO {
+
+    /** 操作者用户ID */
+    1: optional string actorID (api.query = "actorID", api.vd = "@:len($)==0 || len($)==36; msg:'操作者ID格式不正确'", go.tag = "json:\"actor_id,omitempty\""),
+
+    /** 操作名称 */
+    2: optional string action (api.query = "action", go.tag = "json:\"action,omitempty\""),
+
+    /** 操作对象类型 */
+    3: optional string targetType (api.query = "targetType", go.tag = "json:\"target_type,omitempty\""),
+
+    /** 操作对象ID */
+    4: optional string targetID (api.query = "targetID", go.tag = "json:\"target_id,omitempty\""),
+
+    /** 执行结果：success 或 failure */
+    5: optional string result (api.query = "result", api.vd = "@:len($)==0 || $=='success' || $=='failure'; msg:'执行结果只能为 success 或 failure'", go.tag = "json:\"result,omitempty\""),
+
+    /** 请求ID */
+    6: optional string requestID (api.query = "requestID", go.tag = "json:\"request_id,omitempty\""),
+
+    /** 起始时间（含，毫秒时间戳） */
+    7: optional core.TimestampMS startTime (api.query = "startTime", go.tag = "json:\"start_time,omitempty\""),
+
+    /** 截止时间（不含，毫秒时间戳） */
+    8: optional core.TimestampMS endTime (api.query = "endTime", go.tag = "json:\"end_time,omitempty\""),
+
+    /** 分页请求参数 */
+    9: optional base.PageRequestDTO page (api.none = "true", go.tag = "json:\"page,omitempty\""),
+}
+
+/** 审计事件查询响应DTO */
+struct ListAuditEventsResponseDTO {
+
+    /** 响应状态码 */
+    1: optional base.BaseResponseDTO baseResp (go.tag = "json:\"base_resp\""),
+
+    /** 审计事件列表 */
+    2: optional list<AuditEventDTO> events (go.tag = "json:\"events\""),
+
+    /** 分页响应参数 */
+    3: optional base.PageResponseDTO page (go.tag = "json:\"page\""),
+}
@@ -0,0 +1,25 @@
+namespace go audit
+
+include "./audit_model.thrift"
+
+// =================================================================
+//                        审计日志服务 (Audit Service)
+// =================================================================
+
+/**
+ * AuditService：审计日志服务
+ *
+ * 提供对管理类与安全类操作审计事件的查询功能。
+ */
+service AuditService {
+
+    /**
+     * ListAuditEvents：查询审计事件
+     *
+     * 按操作者、操作、对象、结果、时间范围等条件分页查询审计事件，按发生时间倒序排列。
+     *
+     * @param req (ListAuditEventsRequestDTO) - 过滤条件及分页参数
+     * @return resp (ListAuditEventsResponseDTO) - 审计事件列表及分页信息
+     */
+    audit_model.ListAuditEventsResponseDTO ListAuditEvents(1: audit_model.ListAuditEventsRequestDTO req) (api.get = "/api/v1/audit/events"),
+}
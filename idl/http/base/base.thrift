@@ -21,6 +21,7 @@ struct JWTClaimsDTO {
     7: optional string permission (go.tag = "json:\"permission,omitempty\" form:\"permission\" query:\"permission\""),                    // 权限
     8: optional i64 exp (go.tag = "json:\"exp,omitempty\" form:\"exp\" query:\"exp\""),                                                   // 过期时间（Unix时间戳）
     9: optional i64 iat (go.tag = "json:\"iat,omitempty\" form:\"iat\" query:\"iat\""),                                                   // 签发时间（Unix时间戳）
+    10: optional string sessionID (go.tag = "json:\"session_id,omitempty\" form:\"session_id\" query:\"session_id\""),                   // 登录会话ID
 }
 
 /**
@@ -30,6 +31,8 @@ struct TokenInfoDTO {
     1: optional string accessToken (go.tag = "json:\"access_token,omitempty\" form:\"access_token\" query:\"access_token\""), // 访问令牌
     2: optional i64 expiresIn (go.tag = "json:\"expires_in,omitempty\" form:\"expires_in\" query:\"expires_in\""),            // 访问令牌过期时间（秒）
     3: optional string tokenType (go.tag = "json:\"token_type,omitempty\" form:\"token_type\" query:\"token_type\""),         // 令牌类型（通常是"Bearer"）
+    4: optional string refreshToken (go.tag = "json:\"refresh_token,omitempty\" form:\"refresh_token\" query:\"refresh_token\""), // 刷新令牌（每次刷新后轮换）
+    5: optional i64 refreshExpiresIn (go.tag = "json:\"refresh_expires_in,omitempty\" form:\"refresh_expires_in\" query:\"refresh_expires_in\""), // 刷新令牌过期时间（秒）
 }
 
 // =================================================================
@@ -43,6 +46,16 @@ struct TokenInfoDTO {
 struct BaseResponseDTO {
     1: required i32 code = 0 (go.tag = "json:\"code\""),           // 错误码，0表示成功
     2: required string message = "success" (go.tag = "json:\"message\""), // 人类可读的提示信息
+    3: optional list<ErrorDetailDTO> details (go.tag = "json:\"details,omitempty\""), // 错误明细（如逐条列出违反的密码规则）
+}
+
+/**
+ * 错误明细DTO
+ * 用于一次请求存在多个错误原因时逐条返回
+ */
+struct ErrorDetailDTO {
+    1: required string rule (go.tag = "json:\"rule\""),       // 明细标识，如违反的规则名称
+    2: required string message (go.tag = "json:\"message\""), // 人类可读的明细说明
 }
 
 /**
@@ -21,11 +21,14 @@ include "../permission/permission_model.thrift"
  */
 struct LoginRequestDTO {
 
-    /** 用户名 */
+    /** 登录账号：用户名、邮箱或手机号 */
     1: optional string username (api.body = "username", api.vd = "@:len($) > 0; msg:'用户名不能为空'", go.tag = "json:\"username\""),
 
     /** 密码 */
     2: optional string password (api.body = "password", api.vd = "@:len($) > 0; msg:'密码不能为空'", go.tag = "json:\"password\""),
+
+    /** 登录后代表的组织ID，为空时使用主要成员关系所在组织 */
+    3: optional string organizationID (api.body = "organization_id", api.vd = "@:len($)==0 || len($)==36; msg:'组织ID格式不正确'", go.tag = "json:\"organization_id,omitempty\""),
 }
 
 /**
@@ -51,6 +54,28 @@ struct LoginResponseDTO {
 
     /** 用户角色ID列表 */
     6: optional list<string> roleIDs (go.tag = "json:\"role_ids,omitempty\""),
+
+    /** 是否需要完成多因素认证（为 true 时不返回令牌，需携带挑战令牌调用 MFA 校验接口） */
+    7: optional bool mfaRequired (go.tag = "json:\"mfa_required,omitempty\""),
+
+    /** 多因素认证挑战信息 */
+    8: optional MFAChallengeDTO mfaChallenge (go.tag = "json:\"mfa_challenge,omitempty\""),
+
+    /** 所属角色要求多因素认证但尚未绑定，登录后仅可访问绑定相关接口 */
+    9: optional bool mfaEnrollmentRequired (go.tag = "json:\"mfa_enrollment_required,omitempty\""),
+
+    /** 当前代表的组织ID，菜单树、角色与权限均按该组织计算 */
+    10: optional string activeOrganizationID (go.tag = "json:\"active_organization_id,omitempty\""),
+}
+
+/**
+ * 切换当前组织请求
+ * 已登录用户切换所代表的组织，成功后重新签发访问令牌
+ */
+struct SwitchOrganizationRequestDTO {
+
+    /** 目标组织ID */
+    1: optional string organizationID (api.body = "organization_id", api.vd = "@:len($)==36; msg:'组织ID格式不正确'", go.tag = "json:\"organization_id\""),
 }
 
 /**
@@ -63,6 +88,73 @@ struct LogoutRequestDTO {
     1: optional string refreshToken (api.body = "refresh_token", api.vd = "@:len($) > 0; msg:'刷新令牌不能为空'", go.tag = "json:\"refresh_token\""),
 }
 
+// ---- 多因素认证 ----
+
+/**
+ * 多因素认证挑战
+ * 密码校验通过后签发的短期挑战令牌，用于完成第二步校验
+ */
+struct MFAChallengeDTO {
+
+    /** 挑战令牌 */
+    1: optional string challengeToken (go.tag = "json:\"challenge_token\""),
+
+    /** 挑战令牌有效期（秒） */
+    2: optional i64 expiresIn (go.tag = "json:\"expires_in\""),
+}
+
+/**
+ * 多因素认证校验请求
+ * 登录第二步：提交挑战令牌与 TOTP 验证码（或恢复码）
+ */
+struct MFAVerifyRequestDTO {
+
+    /** 挑战令牌 */
+    1: optional string challengeToken (api.body = "challenge_token", api.vd = "@:len($) > 0; msg:'挑战令牌不能为空'", go.tag = "json:\"challenge_token\""),
+
+    /** TOTP 验证码或恢复码 */
+    2: optional string code (api.body = "code", api.vd = "@:len($) > 0; msg:'验证码不能为空'", go.tag = "json:\"code\""),
+}
+
+/**
+ * 多因素认证验证码请求
+ * 确认绑定、重新生成恢复码、关闭多因素认证时提交的验证码
+ */
+struct MFACodeRequestDTO {
+
+    /** TOTP 验证码或恢复码 */
+    1: optional string code (api.body = "code", api.vd = "@:len($) > 0; msg:'验证码不能为空'", go.tag = "json:\"code\""),
+}
+
+/**
+ * 开始绑定多因素认证响应
+ * 返回密钥及供认证器扫码的 otpauth 链接，需提交验证码确认后生效
+ */
+struct MFAEnrollResponseDTO {
+
+    /** 基础响应信息 */
+    1: optional base.BaseResponseDTO baseResp (go.tag = "json:\"base_resp\""),
+
+    /** Base32 编码的 TOTP 密钥（用于手动输入） */
+    2: optional string secret (go.tag = "json:\"secret\""),
+
+    /** otpauth:// 格式的密钥配置链接（用于生成二维码） */
+    3: optional string provisioningURI (go.tag = "json:\"provisioning_uri\""),
+}
+
+/**
+ * 多因素认证恢复码响应
+ * 恢复码仅在生成时返回一次，每个恢复码只能使用一次
+ */
+struct MFARecoveryCodesResponseDTO {
+
+    /** 基础响应信息 */
+    1: optional base.BaseResponseDTO baseResp (go.tag = "json:\"base_resp\""),
+
+    /** 一次性恢复码 */
+    2: optional list<string> recoveryCodes (go.tag = "json:\"recovery_codes\""),
+}
+
 // ---- 密码管理 ----
 
 /**
@@ -132,6 +224,90 @@ struct RefreshTokenResponseDTO {
     2: optional base.TokenInfoDTO tokenInfo (go.tag = "json:\"token_info,omitempty\""),
 }
 
+// ---- 登录会话管理 ----
+
+/**
+ * 登录会话数据传输对象
+ * 每次登录产生一个会话，刷新令牌在会话内轮换
+ */
+struct SessionDTO {
+
+    /** 会话ID */
+    1: optional string sessionID (go.tag = "json:\"session_id\""),
+
+    /** 设备名称 */
+    2: optional string device (go.tag = "json:\"device,omitempty\""),
+
+    /** 登录IP地址 */
+    3: optional string ipAddress (go.tag = "json:\"ip_address,omitempty\""),
+
+    /** 客户端User-Agent */
+    4: optional string userAgent (go.tag = "json:\"user_agent,omitempty\""),
+
+    /** 登录时间（Unix时间戳，秒） */
+    5: optional i64 issuedAt (go.tag = "json:\"issued_at\""),
+
+    /** 最近活跃时间（Unix时间戳，秒） */
+    6: optional i64 lastSeenAt (go.tag = "json:\"last_seen_at\""),
+
+    /** 会话过期时间（Unix时间戳，秒） */
+    7: optional i64 expiresAt (go.tag = "json:\"expires_at\""),
+
+    /** 是否为当前请求所属会话 */
+    8: optional bool current (go.tag = "json:\"current\""),
+}
+
+/**
+ * 会话列表响应
+ */
+struct ListSessionsResponseDTO {
+
+    /** 基础响应信息 */
+    1: optional base.BaseResponseDTO baseResp (go.tag = "json:\"base_resp\""),
+
+    /** 会话列表（按最近活跃时间倒序） */
+    2: optional list<SessionDTO> sessions (go.tag = "json:\"sessions\""),
+}
+
+/**
+ * 吊销当前用户指定会话请求
+ */
+struct RevokeMySessionRequestDTO {
+
+    /** 会话ID */
+    1: optional string sessionID (api.path = "sessionID", api.vd = "@:len($)==36; msg:'会话ID格式不正确'", go.tag = "json:\"-\""),
+}
+
+/**
+ * 获取指定用户会话列表请求（管理员）
+ */
+struct ListUserSessionsRequestDTO {
+
+    /** 用户ID */
+    1: optional string userID (api.path = "userID", api.vd = "@:len($)==36; msg:'用户ID格式不正确'", go.tag = "json:\"-\""),
+}
+
+/**
+ * 吊销指定用户的单个会话请求（管理员）
+ */
+struct RevokeUserSessionRequestDTO {
+
+    /** 用户ID */
+    1: optional string userID (api.path = "userID", api.vd = "@:len($)==36; msg:'用户ID格式不正确'", go.tag = "json:\"-\""),
+
+    /** 会话ID */
+    2: optional string sessionID (api.path = "sessionID", api.vd = "@:len($)==36; msg:'会话ID格式不正确'", go.tag = "json:\"-\""),
+}
+
+/**
+ * 吊销指定用户全部会话请求（管理员）
+ */
+struct RevokeUserSessionsRequestDTO {
+
+    /** 用户ID */
+    1: optional string userID (api.path = "userID", api.vd = "@:len($)==36; msg:'用户ID格式不正确'", go.tag = "json:\"-\""),
+}
+
 // =================================================================
 // 3. 用户管理模块 DTO (User Management)
 // =================================================================
@@ -217,6 +393,9 @@ struct UserProfileDTO {
 
     /** 主部门ID */
     25: optional string primaryDepartmentID (go.tag = "json:\"primary_department_id,omitempty\""),
+
+    /** 是否已启用多因素认证 */
+    26: optional bool mfaEnabled (go.tag = "json:\"mfa_enabled\""),
 }
 
 /**
@@ -423,6 +602,9 @@ struct ListUsersRequestDTO {
 
     /** 按用户状态筛选 */
     3: optional i32 status (api.query = "status", go.tag = "json:\"status,omitempty\""),
+
+    /** 仅返回在该时间（毫秒时间戳）之前到期、尚未过期的账户，按过期时间升序 */
+    4: optional core.TimestampMS accountExpiresBefore (api.query = "account_expires_before", go.tag = "json:\"account_expires_before,omitempty\""),
 }
 
 /**
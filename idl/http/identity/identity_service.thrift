@@ -59,6 +59,60 @@ service IdentityService {
      * 使用刷新令牌获取新的访问令牌
      */
     identity_model.RefreshTokenResponseDTO refreshToken(1: identity_model.RefreshTokenRequestDTO req) (api.post = "/api/v1/identity/auth/refresh"),
+
+    /**
+     * 多因素认证校验
+     * 登录第二步：校验挑战令牌与验证码，通过后返回访问令牌和用户信息
+     */
+    identity_model.LoginResponseDTO verifyMFA(1: identity_model.MFAVerifyRequestDTO req) (api.post = "/api/v1/identity/auth/mfa/verify"),
+
+    /**
+     * 切换当前组织
+     * 按目标组织重新计算菜单、角色与权限，并签发代表该组织的访问令牌
+     */
+    identity_model.LoginResponseDTO switchOrganization(1: identity_model.SwitchOrganizationRequestDTO req) (api.post = "/api/v1/identity/auth/switch-organization"),
+
+    /**
+     * 开始绑定多因素认证
+     * 为当前用户生成 TOTP 密钥，确认前不生效
+     */
+    identity_model.MFAEnrollResponseDTO enrollMFA() (api.post = "/api/v1/identity/auth/mfa/enroll"),
+
+    /**
+     * 确认绑定多因素认证
+     * 提交认证器生成的验证码，成功后启用多因素认证并返回恢复码
+     */
+    identity_model.MFARecoveryCodesResponseDTO confirmMFAEnrollment(1: identity_model.MFACodeRequestDTO req) (api.post = "/api/v1/identity/auth/mfa/enroll/confirm"),
+
+    /**
+     * 重新生成恢复码
+     * 提交 TOTP 验证码后生成新的恢复码，原有恢复码全部失效
+     */
+    identity_model.MFARecoveryCodesResponseDTO regenerateMFARecoveryCodes(1: identity_model.MFACodeRequestDTO req) (api.post = "/api/v1/identity/auth/mfa/recovery-codes"),
+
+    /**
+     * 关闭多因素认证
+     * 提交 TOTP 验证码或恢复码后关闭当前用户的多因素认证
+     */
+    base.OperationStatusResponseDTO disableMFA(1: identity_model.MFACodeRequestDTO req) (api.post = "/api/v1/identity/auth/mfa/disable"),
+
+    /**
+     * 获取当前用户的登录会话
+     * 列出当前用户所有有效的登录会话（设备、IP、登录时间、最近活跃时间）
+     */
+    identity_model.ListSessionsResponseDTO listMySessions() (api.get = "/api/v1/identity/auth/sessions"),
+
+    /**
+     * 吊销当前用户的指定会话
+     * 使指定会话的访问令牌与刷新令牌立即失效
+     */
+    base.OperationStatusResponseDTO revokeMySession(1: identity_model.RevokeMySessionRequestDTO req) (api.delete = "/api/v1/identity/auth/sessions/:sessionID"),
+
+    /**
+     * 吊销当前用户的其他会话
+     * 保留当前会话，使其他设备上的登录全部失效
+     */
+    base.OperationStatusResponseDTO revokeMyOtherSessions() (api.post = "/api/v1/identity/auth/sessions/revoke-others"),
     // =================================================================
     // 2. 用户管理模块 (User Management)
     // =================================================================
@@ -122,6 +176,24 @@ service IdentityService {
      * 管理员解锁被锁定的用户
      */
     base.OperationStatusResponseDTO unlockUser(1: identity_model.UnlockUserRequestDTO req) (api.put = "/api/v1/identity/users/:userID/unlock"),
+
+    /**
+     * 获取用户登录会话
+     * 管理员查看指定用户所有有效的登录会话
+     */
+    identity_model.ListSessionsResponseDTO listUserSessions(1: identity_model.ListUserSessionsRequestDTO req) (api.get = "/api/v1/identity/users/:userID/sessions"),
+
+    /**
+     * 吊销用户指定会话
+     * 管理员强制下线指定用户的单个会话
+     */
+    base.OperationStatusResponseDTO revokeUserSession(1: identity_model.RevokeUserSessionRequestDTO req) (api.delete = "/api/v1/identity/users/:userID/sessions/:sessionID"),
+
+    /**
+     * 吊销用户全部会话
+     * 管理员强制下线指定用户的所有会话
+     */
+    base.OperationStatusResponseDTO revokeUserSessions(1: identity_model.RevokeUserSessionsRequestDTO req) (api.delete = "/api/v1/identity/users/:userID/sessions"),
     // =================================================================
     // 3. 成员关系管理模块 (Membership Management)
     // =================================================================
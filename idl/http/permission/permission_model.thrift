@@ -61,6 +61,15 @@ struct RoleDefinitionDTO {
 
     /** 当前角色绑定的用户数量（非持久化字段，查询时动态计算） */
     11: optional i64 userCount (go.tag = "json:\"user_count,omitempty\""),
+
+    /** 拥有该角色的用户是否必须启用多因素认证 */
+    12: optional bool mfaRequired (go.tag = "json:\"mfa_required\""),
+
+    /** 父角色ID，当前角色继承父角色的全部权限 */
+    13: optional string parentRoleID (go.tag = "json:\"parent_role_id,omitempty\""),
+
+    /** 生效权限列表：自身权限与继承权限合并去重（仅详情查询返回） */
+    14: optional list<PermissionDTO> effectivePermissions (go.tag = "json:\"effective_permissions,omitempty\""),
 }
 
 /** 用户角色分配DTO */
@@ -86,6 +95,18 @@ struct UserRoleAssignmentDTO {
 
     /** 最后更新时间 */
     7: optional core.TimestampMS updatedAt (go.tag = "json:\"updated_at\""),
+
+    /** 生效时间，为空表示分配后立即生效 */
+    8: optional core.TimestampMS effectiveFrom (go.tag = "json:\"effective_from,omitempty\""),
+
+    /** 过期时间，为空表示永久有效 */
+    9: optional core.TimestampMS expiresAt (go.tag = "json:\"expires_at,omitempty\""),
+
+    /** 当前是否处于有效期内 */
+    10: optional bool active (go.tag = "json:\"active\""),
+
+    /** 授权所属组织ID，为空表示全局授权 */
+    11: optional string organizationID (go.tag = "json:\"organization_id,omitempty\""),
 }
 
 // 角色定义请求/响应 DTO
@@ -104,6 +125,12 @@ struct RoleDefinitionCreateRequestDTO {
 
     /** 是否为系统内置角色 */
     4: optional bool isSystemRole (api.body = "is_system_role", go.tag = "json:\"is_system_role,omitempty\""),
+
+    /** 拥有该角色的用户是否必须启用多因素认证 */
+    5: optional bool mfaRequired (api.body = "mfa_required", go.tag = "json:\"mfa_required,omitempty\""),
+
+    /** 父角色ID，当前角色继承父角色的全部权限 */
+    6: optional string parentRoleID (api.body = "parent_role_id", go.tag = "json:\"parent_role_id,omitempty\""),
 }
 
 /** 角色定义创建响应DTO */
@@ -133,6 +160,12 @@ struct RoleDefinitionUpdateRequestDTO {
 
     /** 角色名称 */
     5: optional string name (api.body = "name", go.tag = "json:\"name,omitempty\""),
+
+    /** 拥有该角色的用户是否必须启用多因素认证 */
+    6: optional bool mfaRequired (api.body = "mfa_required", go.tag = "json:\"mfa_required,omitempty\""),
+
+    /** 父角色ID，传空字符串表示取消继承 */
+    7: optional string parentRoleID (api.body = "parent_role_id", go.tag = "json:\"parent_role_id,omitempty\""),
 }
 
 /** 角色定义更新响应DTO */
@@ -198,6 +231,42 @@ struct RoleDefinitionListResponseDTO {
     3: optional base.PageResponseDTO page (go.tag = "json:\"page\""),
 }
 
+/** 权限目录项DTO */
+struct PermissionCatalogItemDTO {
+
+    /** 权限标识，格式为 resource:action */
+    1: optional string permission (go.tag = "json:\"permission\""),
+
+    /** 权限作用的资源 */
+    2: optional string resource (go.tag = "json:\"resource\""),
+
+    /** 对资源执行的操作 */
+    3: optional string action (go.tag = "json:\"action\""),
+
+    /** 权限描述 */
+    4: optional string description (go.tag = "json:\"description\""),
+}
+
+/** 权限目录分组DTO */
+struct PermissionCatalogGroupDTO {
+
+    /** 分组名称 */
+    1: optional string name (go.tag = "json:\"name\""),
+
+    /** 分组内的权限列表 */
+    2: optional list<PermissionCatalogItemDTO> permissions (go.tag = "json:\"permissions\""),
+}
+
+/** 获取权限目录响应DTO */
+struct GetPermissionCatalogResponseDTO {
+
+    /** 响应状态码 */
+    1: optional base.BaseResponseDTO baseResp (go.tag = "json:\"base_resp\""),
+
+    /** 按分组排列的权限目录 */
+    2: optional list<PermissionCatalogGroupDTO> groups (go.tag = "json:\"groups\""),
+}
+
 /** 用户角色分配响应DTO */
 struct AssignRoleToUserResponseDTO {
 
@@ -236,6 +305,12 @@ struct UserRoleQueryRequestDTO {
 
     /** 分页请求参数 */
     3: optional base.PageRequestDTO page (api.none = "true", go.tag = "json:\"page,omitempty\""),
+
+    /** 仅返回在该时间之前过期的分配 (毫秒时间戳) */
+    4: optional core.TimestampMS expiringBefore (api.query = "expiringBefore", go.tag = "json:\"expiring_before,omitempty\""),
+
+    /** 仅返回该组织内的授权 */
+    5: optional string organizationID (api.query = "organizationID", api.vd = "@:len($)==0 || len($)==36; msg:'组织ID格式不正确'", go.tag = "json:\"organization_id,omitempty\""),
 }
 
 /** 用户角色列表响应DTO */
@@ -279,6 +354,15 @@ struct BatchBindUsersToRoleRequestDTO {
 
     /** 用户ID列表 */
     2: optional list<string> userIDs (api.body = "userIDs", api.vd = "@:len($) > 0; msg:'用户ID列表不能为空'", go.tag = "json:\"user_ids\""),
+
+    /** 生效时间 (毫秒时间戳)，为空表示立即生效 */
+    3: optional core.TimestampMS effectiveFrom (api.body = "effective_from", go.tag = "json:\"effective_from,omitempty\""),
+
+    /** 过期时间 (毫秒时间戳)，为空表示永久有效 */
+    4: optional core.TimestampMS expiresAt (api.body = "expires_at", go.tag = "json:\"expires_at,omitempty\""),
+
+    /** 授权所属组织ID，为空表示全局授权；只替换该范围内的绑定，用户必须是该组织的有效成员 */
+    5: optional string organizationID (api.body = "organization_id", api.vd = "@:len($)==0 || len($)==36; msg:'组织ID格式不正确'", go.tag = "json:\"organization_id,omitempty\""),
 }
 
 /** 批量绑定用户到角色响应DTO */
@@ -306,6 +390,28 @@ struct UploadMenuRequestDTO {
 
     /** YAML 格式的菜单配置文件 */
     1: optional binary menuFile (api.form = "menu_file", go.tag = "form:\"menu_file\""),
+
+    /** 仅预检查：校验菜单配置并报告影响，不保存任何数据 */
+    2: optional bool dryRun (api.form = "dry_run", go.tag = "form:\"dry_run\""),
+
+    /** 强制上传：即使会导致角色失去菜单权限也继续上传 */
+    3: optional bool force (api.form = "force", go.tag = "form:\"force\""),
+}
+
+/** 菜单配置校验问题DTO */
+struct MenuValidationIssueDTO {
+
+    /** 问题所在菜单的语义ID */
+    1: optional string semanticID (go.tag = "json:\"semantic_id,omitempty\""),
+
+    /** 问题字段 */
+    2: optional string field (go.tag = "json:\"field\""),
+
+    /** 问题在 YAML 中的行号 */
+    3: optional i32 line (go.tag = "json:\"line\""),
+
+    /** 问题描述 */
+    4: optional string message (go.tag = "json:\"message\""),
 }
 
 /** 菜单节点DTO */
@@ -346,6 +452,178 @@ struct GetMenuTreeResponseDTO {
     2: optional list<MenuNodeDTO> menuTree (go.tag = "json:\"menu_tree\""),
 }
 
+/** 菜单版本DTO */
+struct MenuVersionDTO {
+
+    /** 版本标识 */
+    1: optional string version (go.tag = "json:\"version\""),
+
+    /** 菜单节点数量 */
+    2: optional i64 menuCount (go.tag = "json:\"menu_count\""),
+
+    /** 上传时间 */
+    3: optional core.TimestampMS uploadedAt (go.tag = "json:\"uploaded_at\""),
+
+    /** 上传者用户ID */
+    4: optional string uploadedBy (go.tag = "json:\"uploaded_by,omitempty\""),
+
+    /** 是否为当前生效版本 */
+    5: optional bool isActive (go.tag = "json:\"is_active\""),
+
+    /** 最近一次激活时间 */
+    6: optional core.TimestampMS activatedAt (go.tag = "json:\"activated_at,omitempty\""),
+
+    /** 最近一次激活的操作者用户ID */
+    7: optional string activatedBy (go.tag = "json:\"activated_by,omitempty\""),
+}
+
+/** 菜单版本列表响应DTO */
+struct ListMenuVersionsResponseDTO {
+
+    /** 响应状态码 */
+    1: optional base.BaseResponseDTO baseResp (go.tag = "json:\"base_resp\""),
+
+    /** 按上传时间倒序排列的菜单版本 */
+    2: optional list<MenuVersionDTO> versions (go.tag = "json:\"versions\""),
+}
+
+/** 菜单版本差异请求DTO */
+struct DiffMenuVersionsRequestDTO {
+
+    /** 基准版本 */
+    1: optional string fromVersion (api.query = "from", api.vd = "@:len($) > 0; msg:'基准版本不能为空'", go.tag = "json:\"from,omitempty\""),
+
+    /** 目标版本，为空时与生效版本比较 */
+    2: optional string toVersion (api.query = "to", go.tag = "json:\"to,omitempty\""),
+}
+
+/** 菜单版本间的单个菜单变更DTO */
+struct MenuVersionChangeDTO {
+
+    /** 菜单语义ID */
+    1: optional string semanticID (go.tag = "json:\"semantic_id\""),
+
+    /** 目标版本中的菜单名称（删除时为基准版本中的名称） */
+    2: optional string name (go.tag = "json:\"name,omitempty\""),
+
+    /** 基准版本中的菜单名称 */
+    3: optional string previousName (go.tag = "json:\"previous_name,omitempty\""),
+
+    /** 目标版本中的路由路径 */
+    4: optional string path (go.tag = "json:\"path,omitempty\""),
+
+    /** 基准版本中的路由路径 */
+    5: optional string previousPath (go.tag = "json:\"previous_path,omitempty\""),
+
+    /** 目标版本中父菜单的语义ID */
+    6: optional string parentSemanticID (go.tag = "json:\"parent_semantic_id,omitempty\""),
+
+    /** 基准版本中父菜单的语义ID */
+    7: optional string previousParentSemanticID (go.tag = "json:\"previous_parent_semantic_id,omitempty\""),
+}
+
+/** 菜单版本差异响应DTO */
+struct DiffMenuVersionsResponseDTO {
+
+    /** 响应状态码 */
+    1: optional base.BaseResponseDTO baseResp (go.tag = "json:\"base_resp\""),
+
+    /** 基准版本 */
+    2: optional string fromVersion (go.tag = "json:\"from_version\""),
+
+    /** 目标版本 */
+    3: optional string toVersion (go.tag = "json:\"to_version\""),
+
+    /** 新增的菜单 */
+    4: optional list<MenuVersionChangeDTO> added (go.tag = "json:\"added\""),
+
+    /** 删除的菜单 */
+    5: optional list<MenuVersionChangeDTO> removed (go.tag = "json:\"removed\""),
+
+    /** 父菜单或路由路径发生变化的菜单 */
+    6: optional list<MenuVersionChangeDTO> moved (go.tag = "json:\"moved\""),
+
+    /** 名称发生变化的菜单 */
+    7: optional list<MenuVersionChangeDTO> renamed (go.tag = "json:\"renamed\""),
+}
+
+/** 孤立的角色菜单映射DTO */
+struct OrphanRoleMenuMappingDTO {
+
+    /** 角色ID */
+    1: optional string roleID (go.tag = "json:\"role_id\""),
+
+    /** 角色名称 */
+    2: optional string roleName (go.tag = "json:\"role_name,omitempty\""),
+
+    /** 菜单语义ID */
+    3: optional string menuID (go.tag = "json:\"menu_id\""),
+
+    /** 菜单权限 */
+    4: optional string permission (go.tag = "json:\"permission\""),
+}
+
+/** 激活菜单版本请求DTO */
+struct ActivateMenuVersionRequestDTO {
+
+    /** 要激活的版本标识 */
+    1: optional string version (api.path = "version", api.vd = "@:len($) > 0; msg:'版本标识不能为空'", go.tag = "json:\"-\""),
+}
+
+/** 激活菜单版本响应DTO */
+struct ActivateMenuVersionResponseDTO {
+
+    /** 响应状态码 */
+    1: optional base.BaseResponseDTO baseResp (go.tag = "json:\"base_resp\""),
+
+    /** 激活后的生效版本 */
+    2: optional MenuVersionDTO version (go.tag = "json:\"version\""),
+
+    /** 激活后指向不存在菜单的角色菜单映射 */
+    3: optional list<OrphanRoleMenuMappingDTO> orphanMappings (go.tag = "json:\"orphan_mappings\""),
+}
+
+/** 菜单上传响应DTO */
+struct UploadMenuResponseDTO {
+
+    /** 响应状态码 */
+    1: optional base.BaseResponseDTO baseResp (go.tag = "json:\"base_resp\""),
+
+    /** 是否为仅预检查 */
+    2: optional bool dryRun (go.tag = "json:\"dry_run\""),
+
+    /** 菜单配置是否通过校验 */
+    3: optional bool valid (go.tag = "json:\"valid\""),
+
+    /** 校验发现的问题 */
+    4: optional list<MenuValidationIssueDTO> issues (go.tag = "json:\"issues\""),
+
+    /** 与当前生效版本相比的菜单变更 */
+    5: optional DiffMenuVersionsResponseDTO diff (go.tag = "json:\"diff,omitempty\""),
+
+    /** 上传后角色将失去访问权限的菜单映射 */
+    6: optional list<OrphanRoleMenuMappingDTO> accessLoss (go.tag = "json:\"access_loss\""),
+
+    /** 是否已保存并激活新版本 */
+    7: optional bool applied (go.tag = "json:\"applied\""),
+
+    /** 新版本标识，仅在实际上传后返回 */
+    8: optional string version (go.tag = "json:\"version,omitempty\""),
+}
+
+/** 孤立角色菜单映射查询响应DTO */
+struct GetOrphanRoleMenuMappingsResponseDTO {
+
+    /** 响应状态码 */
+    1: optional base.BaseResponseDTO baseResp (go.tag = "json:\"base_resp\""),
+
+    /** 当前生效版本 */
+    2: optional string version (go.tag = "json:\"version\""),
+
+    /** 孤立的角色菜单映射 */
+    3: optional list<OrphanRoleMenuMappingDTO> mappings (go.tag = "json:\"mappings\""),
+}
+
 // =================================================================
 // 3. 菜单权限管理模块 DTO (Menu Permission Management)
 // =================================================================
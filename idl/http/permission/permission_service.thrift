@@ -64,6 +64,16 @@ service PermissionService {
      */
     permission_model.RoleDefinitionListResponseDTO ListRoleDefinitions(1: permission_model.RoleDefinitionQueryRequestDTO req) (api.get = "/api/v1/permission/roles"),
 
+    /**
+     * GetPermissionCatalog：获取权限目录
+     *
+     * 返回网关路由声明的全部接口权限（resource:action）及其分组与描述，
+     * 角色定义中的权限必须来自该目录。
+     *
+     * @return resp (GetPermissionCatalogResponseDTO) - 按分组排列的权限目录
+     */
+    permission_model.GetPermissionCatalogResponseDTO GetPermissionCatalog() (api.get = "/api/v1/permission/catalog"),
+
     /**
      * GetLastUserRoleAssignment：获取用户最后一次角色分配
      *
@@ -111,11 +121,13 @@ service PermissionService {
 	 * UploadMenu：上传菜单
 	 *
 	 * 上传菜单配置文件，用于定义系统的菜单结构和权限。
+	 * 上传前校验菜单配置；dry_run 时只返回校验结果与角色权限影响，
+	 * 会导致角色失去菜单权限的上传需指定 force。
 	 *
 	 * @param req (UploadMenuRequestDTO) - 上传菜单请求参数
-	 * @return err (core.Error) - 错误信息
+	 * @return resp (UploadMenuResponseDTO) - 校验结果、版本变更与角色权限影响
 	 */
-    base.OperationStatusResponseDTO UploadMenu(1: permission_model.UploadMenuRequestDTO req) (api.post = "/api/v1/permission/menu/upload"),
+    permission_model.UploadMenuResponseDTO UploadMenu(1: permission_model.UploadMenuRequestDTO req) (api.post = "/api/v1/permission/menu/upload"),
 
     /**
 	 * GetMenuTree：获取菜单树
@@ -126,6 +138,44 @@ service PermissionService {
 	 * @return err (core.Error) - 错误信息
 	 */
     permission_model.GetMenuTreeResponseDTO GetMenuTree() (api.get = "/api/v1/permission/menu/tree"),
+
+    /**
+	 * ListMenuVersions：列出菜单版本
+	 *
+	 * 列出全部菜单版本及其上传者、上传时间和生效状态。
+	 *
+	 * @return resp (ListMenuVersionsResponseDTO) - 菜单版本列表
+	 */
+    permission_model.ListMenuVersionsResponseDTO ListMenuVersions() (api.get = "/api/v1/permission/menu/versions"),
+
+    /**
+	 * DiffMenuVersions：比较菜单版本
+	 *
+	 * 按语义ID比较两个菜单版本，给出新增、删除、移动和重命名的菜单。
+	 *
+	 * @param req (DiffMenuVersionsRequestDTO) - 基准版本与目标版本
+	 * @return resp (DiffMenuVersionsResponseDTO) - 版本差异
+	 */
+    permission_model.DiffMenuVersionsResponseDTO DiffMenuVersions(1: permission_model.DiffMenuVersionsRequestDTO req) (api.get = "/api/v1/permission/menu/versions/diff"),
+
+    /**
+	 * ActivateMenuVersion：激活菜单版本
+	 *
+	 * 将指定的历史版本设为生效版本，用于回滚错误的菜单上传。
+	 *
+	 * @param req (ActivateMenuVersionRequestDTO) - 要激活的版本
+	 * @return resp (ActivateMenuVersionResponseDTO) - 生效版本及孤立的角色菜单映射
+	 */
+    permission_model.ActivateMenuVersionResponseDTO ActivateMenuVersion(1: permission_model.ActivateMenuVersionRequestDTO req) (api.post = "/api/v1/permission/menu/versions/:version/activate"),
+
+    /**
+	 * GetOrphanRoleMenuMappings：查询孤立的角色菜单映射
+	 *
+	 * 列出指向生效版本中不存在菜单的角色菜单映射。
+	 *
+	 * @return resp (GetOrphanRoleMenuMappingsResponseDTO) - 孤立的角色菜单映射
+	 */
+    permission_model.GetOrphanRoleMenuMappingsResponseDTO GetOrphanRoleMenuMappings() (api.get = "/api/v1/permission/menu/orphan-mappings"),
     // -----------------------------------------------------------------
     // 菜单权限管理模块 (Menu Permission Management)
     // -----------------------------------------------------------------
@@ -67,6 +67,9 @@ struct UserProfile {
 
     /** 上次登录时间 */
     20: optional core.TimestampMS lastLoginTime,
+
+    /** 是否已启用多因素认证 (TOTP) */
+    27: optional bool mfaEnabled = false,
     // --- 审计与版本控制 ---
 
     /** 创建时间 */
@@ -300,6 +303,9 @@ struct RoleDefinition {
 
     /** 是否为系统内置角色，不可删除 */
     7: optional bool isSystemRole = false,
+
+    /** 拥有该角色的用户是否必须启用多因素认证 */
+    13: optional bool mfaRequired = false,
     // --- 审计信息 ---
 
     /** 创建者用户ID */
@@ -316,6 +322,12 @@ struct RoleDefinition {
 
     /** 当前角色绑定的用户数量（非持久化字段，查询时动态计算） */
     12: optional i64 userCount,
+
+    /** 父角色ID，当前角色继承父角色的全部权限 */
+    14: optional core.UUID parentRoleID,
+
+    /** 生效权限列表：自身权限与沿继承链继承的权限合并去重（仅详情查询返回） */
+    15: optional list<Permission> effectivePermissions,
 }
 
 /**
@@ -332,6 +344,21 @@ struct UserRoleAssignment {
 
     /** 分配的角色ID (对应 RoleDefinition.id) */
     3: optional core.UUID roleID,
+
+    // --- 有效期 ---
+
+    /** 生效时间，为空表示分配后立即生效 */
+    4: optional core.TimestampMS effectiveFrom,
+
+    /** 过期时间，为空表示永久有效；过期后由后台任务自动撤销 */
+    5: optional core.TimestampMS expiresAt,
+
+    /** 当前是否处于有效期内（非持久化字段，查询时动态计算） */
+    6: optional bool active,
+
+    /** 授权所属组织ID，为空表示全局授权，在所有组织中生效 */
+    7: optional core.UUID organizationID,
+
     // --- 审计信息 ---
 
     /** 创建者用户ID */
@@ -376,4 +403,59 @@ struct MenuNode {
 
     /** 权限级别 (可选): read, write, full, none */
     8: optional string permissionLevel,
-}
\ No newline at end of file
+}
+
+/**
+ * 审计事件 (AuditEvent)
+ * 记录一次变更类操作：谁、在何时、对哪个对象做了什么，以及变更前后的内容和执行结果。
+ */
+struct AuditEvent {
+
+    /** 审计事件ID */
+    1: optional core.UUID id,
+
+    /** 操作者用户ID，未认证的操作（如登录）为空 */
+    2: optional core.UUID actorID,
+
+    /** 操作者当前代表的组织ID */
+    3: optional core.UUID actorOrganizationID,
+
+    /** 操作名称，即被调用的 RPC 方法名，如 AssignRoleToUser */
+    4: optional string action,
+
+    /** 操作对象类型，如 user、role、menu */
+    5: optional string targetType,
+
+    /** 操作对象ID（登录类操作为用户名） */
+    6: optional string targetID,
+
+    /** 变更前内容（JSON），敏感字段已脱敏 */
+    7: optional string before,
+
+    /** 变更后内容（JSON），未记录变更明细时为请求参数，敏感字段已脱敏 */
+    8: optional string after,
+
+    /** 发生变化的顶层字段 */
+    9: optional list<string> changedFields,
+
+    /** 请求ID */
+    10: optional string requestID,
+
+    /** 链路追踪ID */
+    11: optional string traceID,
+
+    /** 客户端IP地址 */
+    12: optional string ipAddress,
+
+    /** 执行结果：success 或 failure */
+    13: optional string result,
+
+    /** 失败时的业务错误码 */
+    14: optional i32 errorCode,
+
+    /** 失败时的错误信息 */
+    15: optional string errorMessage,
+
+    /** 发生时间 */
+    16: optional core.TimestampMS createdAt,
+}
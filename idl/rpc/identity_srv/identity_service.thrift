@@ -32,6 +32,13 @@ service IdentityService {
      */
     LoginResponse Login(1: LoginRequest req),
 
+    /**
+     * 切换用户当前代表的组织。
+     * @param req 包含用户ID和目标组织ID，用户必须是该组织的有效成员。
+     * @return 以目标组织计算的菜单树、角色与权限，结构与登录响应一致。
+     */
+    LoginResponse SwitchOrganization(1: SwitchOrganizationRequest req),
+
     /**
      * 修改当前用户密码。
      * @param req 包含用户ID、旧密码和新密码。
@@ -49,6 +56,40 @@ service IdentityService {
      * @param req 包含需要强制修改密码的用户ID。
      */
     void ForcePasswordChange(1: ForcePasswordChangeRequest req),
+
+    /**
+     * 开始绑定 TOTP 多因素认证。
+     * @param req 包含用户ID。
+     * @return 新生成的密钥及供认证器扫码的 otpauth 链接，确认前不生效。
+     */
+    BeginMFAEnrollmentResponse BeginMFAEnrollment(1: BeginMFAEnrollmentRequest req),
+
+    /**
+     * 确认绑定 TOTP 多因素认证。
+     * @param req 包含用户ID和认证器生成的验证码。
+     * @return 一次性恢复码，仅在此时返回明文。
+     */
+    MFARecoveryCodesResponse ConfirmMFAEnrollment(1: MFACodeRequest req),
+
+    /**
+     * 校验多因素认证验证码（登录第二步）。
+     * @param req 包含用户ID和 TOTP 验证码或恢复码。
+     * @return 校验结果，使用恢复码时返回剩余恢复码数量。
+     */
+    VerifyMFAResponse VerifyMFA(1: MFACodeRequest req),
+
+    /**
+     * 关闭多因素认证。
+     * @param req 包含用户ID和 TOTP 验证码或恢复码。
+     */
+    void DisableMFA(1: MFACodeRequest req),
+
+    /**
+     * 重新生成多因素认证恢复码，原有恢复码全部失效。
+     * @param req 包含用户ID和 TOTP 验证码。
+     * @return 新的一次性恢复码。
+     */
+    MFARecoveryCodesResponse RegenerateMFARecoveryCodes(1: MFACodeRequest req),
     // -----------------------------------------------------------------
     // 用户管理模块 (User Management)
     // -----------------------------------------------------------------
@@ -353,15 +394,44 @@ service IdentityService {
 
     /**
      * 上传并解析菜单配置文件 (menu.yaml)。
+     * 上传前校验菜单配置；dryRun 时只返回校验结果与影响报告，
+     * 会导致角色失去菜单权限的上传需指定 force。
      * @param req 包含 YAML 文件内容的请求。
+     * @return 校验结果、版本变更与角色权限影响。
      */
-    void UploadMenu(1: UploadMenuRequest req),
+    UploadMenuResponse UploadMenu(1: UploadMenuRequest req),
 
     /**
      * 获取指定用户的菜单树。
      * @return 用户可见的菜单树结构。
      */
     GetMenuTreeResponse GetMenuTree(),
+
+    /**
+     * 列出全部菜单版本及其上传者、上传时间和生效状态。
+     * @return 按上传时间倒序排列的菜单版本列表。
+     */
+    ListMenuVersionsResponse ListMenuVersions(),
+
+    /**
+     * 按语义ID比较两个菜单版本，给出新增、删除、移动和重命名的菜单。
+     * @param req 包含基准版本和目标版本的请求，目标版本为空时与生效版本比较。
+     * @return 版本差异。
+     */
+    DiffMenuVersionsResponse DiffMenuVersions(1: DiffMenuVersionsRequest req),
+
+    /**
+     * 将指定的历史版本设为生效版本，用于回滚错误的菜单上传。
+     * @param req 包含版本标识和操作者的请求。
+     * @return 激活结果及指向生效版本中不存在菜单的角色菜单映射。
+     */
+    ActivateMenuVersionResponse ActivateMenuVersion(1: ActivateMenuVersionRequest req),
+
+    /**
+     * 列出指向生效版本中不存在菜单的角色菜单映射。
+     * @return 生效版本及孤立的角色菜单映射列表。
+     */
+    GetOrphanRoleMenuMappingsResponse GetOrphanRoleMenuMappings(),
     // -----------------------------------------------------------------
     // 菜单权限管理模块 (Menu Permission Management)
     // -----------------------------------------------------------------
@@ -407,6 +477,42 @@ service IdentityService {
      * @return 用户的合并菜单权限列表（去重，取最高权限）。
      */
     GetUserMenuPermissionsResponse GetUserMenuPermissions(1: GetUserMenuPermissionsRequest req),
+
+    // -----------------------------------------------------------------
+    // 接口权限校验模块 (API Authorization)
+    // -----------------------------------------------------------------
+
+    /**
+     * 检查用户是否具有指定资源的操作权限（基于 Casbin p 策略）。
+     * @param req 包含用户ID、资源和操作的请求。
+     * @return 权限检查结果。
+     */
+    CheckPermissionResponse CheckPermission(1: CheckPermissionRequest req),
+
+    /**
+     * 检查用户是否拥有任一指定角色。
+     * @param req 包含用户ID和角色名称（或角色ID）列表的请求。
+     * @return 角色检查结果。
+     */
+    CheckRoleResponse CheckRole(1: CheckRoleRequest req),
+
+    /**
+     * 批量获取用户的全部生效接口权限（含继承角色的权限），供网关缓存后本地鉴权。
+     * @param req 包含用户ID的请求。
+     * @return 用户的生效角色与权限列表。
+     */
+    GetUserEffectivePermissionsResponse GetUserEffectivePermissions(1: GetUserEffectivePermissionsRequest req),
+
+    // -----------------------------------------------------------------
+    // 审计日志模块 (Audit Log)
+    // -----------------------------------------------------------------
+
+    /**
+     * 按条件分页查询审计事件，按发生时间倒序排列。
+     * @param req 包含操作者、操作、对象、结果、时间范围等过滤条件及分页参数。
+     * @return 审计事件列表及分页信息。
+     */
+    ListAuditEventsResponse ListAuditEvents(1: ListAuditEventsRequest req),
 }
 
 // =================================================================
@@ -416,11 +522,17 @@ service IdentityService {
 /** 用户登录请求 */
 struct LoginRequest {
 
-    /** 用户名 */
+    /**
+     * 登录账号：用户名、邮箱或手机号
+     * 优先按用户名精确匹配；未匹配时含 @ 的按邮箱、纯数字的按手机号匹配，须唯一对应一个账户
+     */
     1: optional string username,
 
     /** 密码 (应在传输过程中加密) */
     2: optional string password,
+
+    /** 登录后代表的组织ID，为空时使用主要成员关系所在组织 */
+    3: optional core.UUID organizationID,
 }
 
 /** 用户登录响应 */
@@ -440,6 +552,25 @@ struct LoginResponse {
 
     /** 用户拥有的菜单权限列表（菜单ID -> 权限） */
     5: optional list<MenuPermission> permissions,
+
+    /** 用户已启用多因素认证，需完成验证码校验后方可签发令牌 */
+    6: optional bool mfaRequired,
+
+    /** 用户所属角色要求多因素认证但用户尚未绑定 */
+    7: optional bool mfaEnrollmentRequired,
+
+    /** 当前代表的组织ID，菜单树、角色与权限均按该组织计算；无有效成员关系时为空 */
+    8: optional core.UUID activeOrganizationID,
+}
+
+/** 切换当前组织请求 */
+struct SwitchOrganizationRequest {
+
+    /** 用户ID */
+    1: optional core.UUID userID,
+
+    /** 目标组织ID */
+    2: optional core.UUID organizationID,
 }
 
 /** 修改密码请求 */
@@ -472,6 +603,50 @@ struct ForcePasswordChangeRequest {
     1: optional core.UUID userID,
 }
 
+/** 开始绑定多因素认证请求 */
+struct BeginMFAEnrollmentRequest {
+
+    /** 用户ID */
+    1: optional core.UUID userID,
+}
+
+/** 开始绑定多因素认证响应 */
+struct BeginMFAEnrollmentResponse {
+
+    /** Base32 编码的 TOTP 密钥 */
+    1: optional string secret,
+
+    /** otpauth:// 格式的密钥配置链接 */
+    2: optional string provisioningURI,
+}
+
+/** 多因素认证验证码请求 */
+struct MFACodeRequest {
+
+    /** 用户ID */
+    1: optional core.UUID userID,
+
+    /** TOTP 验证码或恢复码 */
+    2: optional string code,
+}
+
+/** 多因素认证恢复码响应 */
+struct MFARecoveryCodesResponse {
+
+    /** 一次性恢复码明文 */
+    1: optional list<string> recoveryCodes,
+}
+
+/** 多因素认证校验响应 */
+struct VerifyMFAResponse {
+
+    /** 是否使用了恢复码 */
+    1: optional bool usedRecoveryCode,
+
+    /** 剩余可用的恢复码数量 */
+    2: optional i32 remainingRecoveryCodes,
+}
+
 // =================================================================
 // 用户管理相关 (User Management)
 // =================================================================
@@ -528,6 +703,8 @@ struct ListUsersRequest {
     1: optional base.PageRequest page,
     2: optional core.UUID organizationID,
     3: optional enums.UserStatus status,
+    /** 仅返回尚未过期、且账户过期时间不晚于该时间的用户（即将过期的账户），按过期时间升序 */
+    4: optional core.TimestampMS accountExpiresBefore,
 }
 
 /** 列出用户响应 */
@@ -744,6 +921,12 @@ struct RoleDefinitionCreateRequest {
 
     /** 是否为系统内置角色 */
     4: optional bool isSystemRole = false,
+
+    /** 拥有该角色的用户是否必须启用多因素认证 */
+    5: optional bool mfaRequired = false,
+
+    /** 父角色ID，当前角色继承父角色的全部权限 */
+    6: optional core.UUID parentRoleID,
 }
 
 /** 角色定义更新请求 */
@@ -755,6 +938,12 @@ struct RoleDefinitionUpdateRequest {
 
     /** 角色名称 */
     5: optional string name,
+
+    /** 拥有该角色的用户是否必须启用多因素认证 */
+    6: optional bool mfaRequired,
+
+    /** 父角色ID，传空字符串表示取消继承 */
+    7: optional string parentRoleID,
 }
 
 /** 角色定义查询请求 */
@@ -782,6 +971,15 @@ struct AssignRoleToUserRequest {
 
     /** 分配者用户ID */
     3: optional core.UUID assignedBy,
+
+    /** 生效时间，为空表示立即生效 */
+    4: optional core.TimestampMS effectiveFrom,
+
+    /** 过期时间，为空表示永久有效 */
+    5: optional core.TimestampMS expiresAt,
+
+    /** 授权所属组织ID，为空表示全局授权；非空时用户必须是该组织的有效成员 */
+    6: optional core.UUID organizationID,
 }
 
 /** 更新用户角色分配请求 */
@@ -798,6 +996,12 @@ struct UpdateUserRoleAssignmentRequest {
 
     /** 更新者用户ID */
     4: optional core.UUID updatedBy,
+
+    /** 生效时间，传 0 表示清除（立即生效） */
+    5: optional core.TimestampMS effectiveFrom,
+
+    /** 过期时间，传 0 表示清除（永久有效） */
+    6: optional core.TimestampMS expiresAt,
 }
 
 /** 撤销用户角色分配请求 */
@@ -811,6 +1015,9 @@ struct RevokeRoleFromUserRequest {
 
     /** 操作者用户ID */
     3: optional core.UUID revokedBy,
+
+    /** 授权所属组织ID，为空表示撤销全局授权 */
+    4: optional core.UUID organizationID,
 }
 
 /** 用户角色分配响应 */
@@ -825,6 +1032,12 @@ struct UserRoleQueryRequest {
     1: optional core.UUID userID,
     2: optional core.UUID roleID,
     3: optional base.PageRequest page,
+
+    /** 仅返回在该时间之前过期的分配，用于查看即将到期的临时授权 */
+    4: optional core.TimestampMS expiringBefore,
+
+    /** 仅返回该组织内的授权 */
+    5: optional core.UUID organizationID,
 }
 
 /** 用户角色列表响应 */
@@ -861,6 +1074,15 @@ struct BatchBindUsersToRoleRequest {
 
     /** 操作者用户ID */
     3: optional core.UUID operatorID,
+
+    /** 生效时间，为空表示立即生效，对本次绑定的所有用户生效 */
+    4: optional core.TimestampMS effectiveFrom,
+
+    /** 过期时间，为空表示永久有效，对本次绑定的所有用户生效 */
+    5: optional core.TimestampMS expiresAt,
+
+    /** 授权所属组织ID，为空表示全局授权；只替换该范围内的绑定 */
+    6: optional core.UUID organizationID,
 }
 
 /** 批量绑定用户到角色响应 */
@@ -909,6 +1131,56 @@ struct UploadMenuRequest {
 
     /** YAML 格式的菜单配置内容 */
     1: optional string yamlContent,
+
+    /** 操作者用户ID，记录为版本上传者 */
+    2: optional core.UUID operatorID,
+
+    /** 仅预检查：校验菜单配置并报告影响，不保存任何数据 */
+    3: optional bool dryRun,
+
+    /** 强制上传：即使会导致角色失去菜单权限也继续上传 */
+    4: optional bool force,
+}
+
+/** 菜单配置校验问题 */
+struct MenuValidationIssue {
+
+    /** 问题所在菜单的语义ID */
+    1: optional string semanticID,
+
+    /** 问题字段 */
+    2: optional string field,
+
+    /** 问题在 YAML 中的行号 */
+    3: optional i32 line,
+
+    /** 问题描述 */
+    4: optional string message,
+}
+
+/** 菜单上传响应 */
+struct UploadMenuResponse {
+
+    /** 是否为仅预检查 */
+    1: optional bool dryRun,
+
+    /** 菜单配置是否通过校验 */
+    2: optional bool valid,
+
+    /** 校验发现的问题 */
+    3: optional list<MenuValidationIssue> issues,
+
+    /** 与当前生效版本相比的菜单变更，尚无生效版本时为空 */
+    4: optional DiffMenuVersionsResponse diff,
+
+    /** 上传后将失效的角色菜单映射，即角色将失去访问权限的菜单 */
+    5: optional list<OrphanRoleMenuMapping> accessLoss,
+
+    /** 是否已保存并激活新版本 */
+    6: optional bool applied,
+
+    /** 新版本标识，仅在实际上传后返回 */
+    7: optional string version,
 }
 
 /** 菜单树获取响应 */
@@ -918,6 +1190,141 @@ struct GetMenuTreeResponse {
     1: optional list<identity_model.MenuNode> menuTree,
 }
 
+/** 菜单版本信息 */
+struct MenuVersionInfo {
+
+    /** 版本标识 */
+    1: optional string version,
+
+    /** 菜单节点数量 */
+    2: optional i64 menuCount,
+
+    /** 上传时间 */
+    3: optional core.TimestampMS uploadedAt,
+
+    /** 上传者用户ID（早期上传的版本可能为空） */
+    4: optional core.UUID uploadedBy,
+
+    /** 是否为当前生效版本 */
+    5: optional bool isActive,
+
+    /** 最近一次激活时间，从未显式激活时为空 */
+    6: optional core.TimestampMS activatedAt,
+
+    /** 最近一次激活的操作者用户ID */
+    7: optional core.UUID activatedBy,
+}
+
+/** 菜单版本列表响应 */
+struct ListMenuVersionsResponse {
+
+    /** 按上传时间倒序排列的菜单版本 */
+    1: optional list<MenuVersionInfo> versions,
+}
+
+/** 菜单版本差异请求 */
+struct DiffMenuVersionsRequest {
+
+    /** 基准版本 */
+    1: optional string fromVersion,
+
+    /** 目标版本，为空时使用生效版本 */
+    2: optional string toVersion,
+}
+
+/** 菜单版本间的单个菜单变更 */
+struct MenuVersionChange {
+
+    /** 菜单语义ID */
+    1: optional string semanticID,
+
+    /** 目标版本中的菜单名称（删除时为基准版本中的名称） */
+    2: optional string name,
+
+    /** 基准版本中的菜单名称 */
+    3: optional string previousName,
+
+    /** 目标版本中的路由路径 */
+    4: optional string path,
+
+    /** 基准版本中的路由路径 */
+    5: optional string previousPath,
+
+    /** 目标版本中父菜单的语义ID，顶级菜单为空 */
+    6: optional string parentSemanticID,
+
+    /** 基准版本中父菜单的语义ID，顶级菜单为空 */
+    7: optional string previousParentSemanticID,
+}
+
+/** 菜单版本差异响应 */
+struct DiffMenuVersionsResponse {
+
+    /** 基准版本 */
+    1: optional string fromVersion,
+
+    /** 目标版本 */
+    2: optional string toVersion,
+
+    /** 目标版本中新增的菜单 */
+    3: optional list<MenuVersionChange> added,
+
+    /** 目标版本中删除的菜单 */
+    4: optional list<MenuVersionChange> removed,
+
+    /** 父菜单或路由路径发生变化的菜单 */
+    5: optional list<MenuVersionChange> moved,
+
+    /** 名称发生变化的菜单 */
+    6: optional list<MenuVersionChange> renamed,
+}
+
+/** 孤立的角色菜单映射：映射的菜单在生效版本中不存在 */
+struct OrphanRoleMenuMapping {
+
+    /** 角色ID */
+    1: optional core.UUID roleID,
+
+    /** 角色名称，角色已删除时为空 */
+    2: optional string roleName,
+
+    /** 菜单语义ID */
+    3: optional string menuID,
+
+    /** 菜单权限 */
+    4: optional string permission,
+}
+
+/** 激活菜单版本请求 */
+struct ActivateMenuVersionRequest {
+
+    /** 要激活的版本标识 */
+    1: optional string version,
+
+    /** 操作者用户ID */
+    2: optional core.UUID operatorID,
+}
+
+/** 激活菜单版本响应 */
+struct ActivateMenuVersionResponse {
+
+    /** 激活后的生效版本信息 */
+    1: optional MenuVersionInfo version,
+
+    /** 激活后指向不存在菜单的角色菜单映射 */
+    2: optional list<OrphanRoleMenuMapping> orphanMappings,
+}
+
+/** 孤立角色菜单映射查询响应 */
+struct GetOrphanRoleMenuMappingsResponse {
+
+    /** 当前生效版本 */
+    1: optional string version,
+
+    /** 孤立的角色菜单映射 */
+    2: optional list<OrphanRoleMenuMapping> mappings,
+}
+
 // =================================================================
 // 菜单权限管理 (Menu Permission Management)
 // =================================================================
@@ -987,6 +1394,9 @@ struct GetUserMenuTreeRequest {
 
     /** 用户ID */
     1: optional core.UUID userID,
+
+    /** 当前代表的组织ID，为空时只计算全局授权的角色 */
+    2: optional core.UUID organizationID,
 }
 
 /** 获取用户菜单树响应 */
@@ -1053,6 +1463,9 @@ struct GetUserMenuPermissionsRequest {
 
     /** 用户ID */
     1: optional core.UUID userID,
+
+    /** 当前代表的组织ID，为空时只计算全局授权的角色 */
+    2: optional core.UUID organizationID,
 }
 
 /** 获取用户菜单权限响应 */
@@ -1066,4 +1479,128 @@ struct GetUserMenuPermissionsResponse {
 
     /** 用户拥有的角色列表 */
     3: optional list<core.UUID> roleIDs,
-}
\ No newline at end of file
+}
+
+// =================================================================
+// 接口权限校验相关 (API Authorization)
+// =================================================================
+
+/** 检查接口权限请求 */
+struct CheckPermissionRequest {
+
+    /** 用户ID */
+    1: optional core.UUID userID,
+
+    /** 资源标识，如 user、role */
+    2: optional string resource,
+
+    /** 操作标识，如 read、create */
+    3: optional string action,
+
+    /** 当前代表的组织ID，为空时只计算全局授权的角色 */
+    4: optional core.UUID organizationID,
+}
+
+/** 检查接口权限响应 */
+struct CheckPermissionResponse {
+
+    /** 是否具有权限 */
+    1: optional bool allowed,
+
+    /** 授予该权限的角色ID（超管或无权限时为空） */
+    2: optional core.UUID matchedRoleID,
+}
+
+/** 检查用户角色请求 */
+struct CheckRoleRequest {
+
+    /** 用户ID */
+    1: optional core.UUID userID,
+
+    /** 角色名称或角色ID列表，拥有任一即视为通过 */
+    2: optional list<string> roles,
+
+    /** 当前代表的组织ID，为空时只计算全局授权的角色 */
+    3: optional core.UUID organizationID,
+}
+
+/** 检查用户角色响应 */
+struct CheckRoleResponse {
+
+    /** 是否拥有任一指定角色 */
+    1: optional bool hasRole,
+
+    /** 匹配到的角色名称 */
+    2: optional string matchedRole,
+}
+
+/** 获取用户生效接口权限请求 */
+struct GetUserEffectivePermissionsRequest {
+
+    /** 用户ID */
+    1: optional core.UUID userID,
+
+    /** 当前代表的组织ID，为空时只计算全局授权的角色 */
+    2: optional core.UUID organizationID,
+}
+
+/** 获取用户生效接口权限响应 */
+struct GetUserEffectivePermissionsResponse {
+
+    /** 用户ID */
+    1: optional core.UUID userID,
+
+    /** 用户当前生效的角色ID列表（含沿继承链继承的父角色） */
+    2: optional list<core.UUID> roleIDs,
+
+    /** 是否为超级管理员，超级管理员拥有全部权限 */
+    3: optional bool isSuperAdmin,
+
+    /** 去重后的生效权限列表 */
+    4: optional list<identity_model.Permission> permissions,
+}
+
+// =================================================================
+// 审计日志相关 (Audit Log)
+// =================================================================
+
+/** 审计事件查询请求 */
+struct ListAuditEventsRequest {
+
+    /** 操作者用户ID */
+    1: optional core.UUID actorID,
+
+    /** 操作名称 */
+    2: optional string action,
+
+    /** 操作对象类型 */
+    3: optional string targetType,
+
+    /** 操作对象ID */
+    4: optional string targetID,
+
+    /** 执行结果：success 或 failure */
+    5: optional string result,
+
+    /** 请求ID */
+    6: optional string requestID,
+
+    /** 起始时间（含） */
+    7: optional core.TimestampMS startTime,
+
+    /** 截止时间（不含） */
+    8: optional core.TimestampMS endTime,
+
+    /** 分页参数 */
+    9: optional base.PageRequest page,
+}
+
+/** 审计事件查询响应 */
+struct ListAuditEventsResponse {
+
+    /** 审计事件列表 */
+    1: optional list<identity_model.AuditEvent> events,
+
+    /** 分页信息 */
+    2: optional base.PageResponse page,
+}
@@ -0,0 +1,51 @@
+// Package auditlog 提供变更类 RPC 的审计记录能力
+// 审计中间件按方法登记表为每次调用创建审计条目，业务逻辑可通过上下文补充操作对象与变更前后快照
+package auditlog
+
+import "context"
+
+// Entry 一次变更操作的审计条目
+// 由审计中间件创建并放入上下文，业务逻辑未补充快照时以脱敏后的请求内容作为变更后内容
+type Entry struct {
+	TargetType string
+	TargetID   string
+	Before     interface{}
+	After      interface{}
+
+	changeSet bool
+}
+
+// HasChange 返回业务逻辑是否已通过 SetChange 补充变更前后快照
+func (e *Entry) HasChange() bool {
+	return e.changeSet
+}
+
+type entryKey struct{}
+
+// WithEntry 将审计条目放入上下文
+func WithEntry(ctx context.Context, entry *Entry) context.Context {
+	return context.WithValue(ctx, entryKey{}, entry)
+}
+
+// FromContext 获取上下文中的审计条目，当前调用不需要审计时返回 nil
+func FromContext(ctx context.Context) *Entry {
+	entry, _ := ctx.Value(entryKey{}).(*Entry)
+	return entry
+}
+
+// SetTarget 设置操作对象ID，用于请求中不直接携带对象ID的操作（如上传菜单生成的版本号）
+func SetTarget(ctx context.Context, targetID string) {
+	if entry := FromContext(ctx); entry != nil {
+		entry.TargetID = targetID
+	}
+}
+
+// SetChange 设置操作对象变更前后的快照，快照在写入审计记录前统一脱敏
+// 不需要审计的调用中调用此函数不产生任何效果
+func SetChange(ctx context.Context, before, after interface{}) {
+	if entry := FromContext(ctx); entry != nil {
+		entry.Before = before
+		entry.After = after
+		entry.changeSet = true
+	}
+}
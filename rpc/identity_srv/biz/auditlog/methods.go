@@ -0,0 +1,138 @@
+package auditlog
+
+import "reflect"
+
+// 审计对象类型
+const (
+	TargetUser           = "user"
+	TargetOrganization   = "organization"
+	TargetDepartment     = "department"
+	TargetMembership     = "membership"
+	TargetLogo           = "logo"
+	TargetRole           = "role"
+	TargetRoleAssignment = "role_assignment"
+	TargetMenu           = "menu"
+)
+
+// MethodSpec 变更类 RPC 方法的审计配置
+type MethodSpec struct {
+	// TargetType 操作对象类型
+	TargetType string
+	// RequestField 请求中携带操作对象ID的字段名，请求参数本身为ID时留空
+	RequestField string
+	// ResultField 请求不含对象ID时（如创建操作）从响应中读取对象ID的字段名
+	ResultField string
+	// OmitPayload 请求含验证码、文件内容等不宜留存的数据时不记录请求内容
+	OmitPayload bool
+}
+
+// auditedMethods 需要记录审计事件的 RPC 方法，新增变更类接口需同步登记
+var auditedMethods = map[string]MethodSpec{
+	// 认证与多因素认证
+	"Login":                      {TargetType: TargetUser, RequestField: "Username"},
+	"SwitchOrganization":         {TargetType: TargetUser, RequestField: "UserID"},
+	"ChangePassword":             {TargetType: TargetUser, RequestField: "UserID"},
+	"ResetPassword":              {TargetType: TargetUser, RequestField: "UserID"},
+	"ForcePasswordChange":        {TargetType: TargetUser, RequestField: "UserID"},
+	"BeginMFAEnrollment":         {TargetType: TargetUser, RequestField: "UserID"},
+	"ConfirmMFAEnrollment":       {TargetType: TargetUser, RequestField: "UserID", OmitPayload: true},
+	"VerifyMFA":                  {TargetType: TargetUser, RequestField: "UserID", OmitPayload: true},
+	"DisableMFA":                 {TargetType: TargetUser, RequestField: "UserID", OmitPayload: true},
+	"RegenerateMFARecoveryCodes": {TargetType: TargetUser, RequestField: "UserID", OmitPayload: true},
+
+	// 用户管理
+	"CreateUser":       {TargetType: TargetUser, ResultField: "ID"},
+	"UpdateUser":       {TargetType: TargetUser, RequestField: "UserID"},
+	"DeleteUser":       {TargetType: TargetUser, RequestField: "UserID"},
+	"ChangeUserStatus": {TargetType: TargetUser, RequestField: "UserID"},
+	"UnlockUser":       {TargetType: TargetUser, RequestField: "UserID"},
+
+	// 组织与部门
+	"CreateOrganization": {TargetType: TargetOrganization, ResultField: "ID"},
+	"UpdateOrganization": {TargetType: TargetOrganization, RequestField: "OrganizationID"},
+	"DeleteOrganization": {TargetType: TargetOrganization},
+	"CreateDepartment":   {TargetType: TargetDepartment, ResultField: "ID"},
+	"UpdateDepartment":   {TargetType: TargetDepartment, RequestField: "DepartmentID"},
+	"DeleteDepartment":   {TargetType: TargetDepartment},
+
+	// 成员关系
+	"AddMembership":    {TargetType: TargetMembership, ResultField: "ID"},
+	"UpdateMembership": {TargetType: TargetMembership, RequestField: "MembershipID"},
+	"RemoveMembership": {TargetType: TargetMembership},
+
+	// 组织Logo
+	"UploadTemporaryLogo":    {TargetType: TargetLogo, ResultField: "ID", OmitPayload: true},
+	"DeleteOrganizationLogo": {TargetType: TargetLogo, RequestField: "LogoID"},
+	"BindLogoToOrganization": {TargetType: TargetLogo, RequestField: "LogoID"},
+
+	// 角色定义与角色分配
+	"CreateRoleDefinition":     {TargetType: TargetRole, ResultField: "Id"},
+	"UpdateRoleDefinition":     {TargetType: TargetRole, RequestField: "RoleDefinitionID"},
+	"DeleteRoleDefinition":     {TargetType: TargetRole},
+	"ConfigureRoleMenus":       {TargetType: TargetRole, RequestField: "RoleID"},
+	"AssignRoleToUser":         {TargetType: TargetRoleAssignment, ResultField: "AssignmentID"},
+	"UpdateUserRoleAssignment": {TargetType: TargetRoleAssignment, RequestField: "AssignmentID"},
+	"RevokeRoleFromUser":       {TargetType: TargetRoleAssignment},
+	"BatchBindUsersToRole":     {TargetType: TargetRole, RequestField: "RoleID"},
+
+	// 菜单
+	"UploadMenu":          {TargetType: TargetMenu, OmitPayload: true},
+	"ActivateMenuVersion": {TargetType: TargetMenu, RequestField: "Version"},
+}
+
+// LookupMethod 返回 RPC 方法的审计配置，查询类方法不在登记表中
+func LookupMethod(method string) (MethodSpec, bool) {
+	spec, ok := auditedMethods[method]
+	return spec, ok
+}
+
+// TargetID 从请求参数或响应中解析操作对象ID
+// 请求参数本身为字符串（如按ID删除）时直接作为对象ID
+func (s MethodSpec) TargetID(request, result interface{}) string {
+	if id, ok := request.(string); ok {
+		return id
+	}
+
+	if s.RequestField != "" {
+		if id := stringField(request, s.RequestField); id != "" {
+			return id
+		}
+	}
+
+	if s.ResultField != "" {
+		return stringField(result, s.ResultField)
+	}
+
+	return ""
+}
+
+// stringField 读取结构体（或其指针）中字符串或字符串指针类型字段的值
+func stringField(v interface{}, name string) string {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return ""
+		}
+
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return ""
+	}
+
+	field := rv.FieldByName(name)
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return ""
+		}
+
+		field = field.Elem()
+	}
+
+	if field.Kind() != reflect.String {
+		return ""
+	}
+
+	return field.String()
+}
@@ -0,0 +1,112 @@
+package auditlog
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// redactedValue 敏感字段脱敏后的占位值
+const redactedValue = "***"
+
+// sensitiveKeys 需要脱敏的字段名（小写），在任意嵌套层级按 JSON 字段名匹配
+var sensitiveKeys = map[string]struct{}{
+	"password":        {},
+	"oldpassword":     {},
+	"newpassword":     {},
+	"passwordhash":    {},
+	"secret":          {},
+	"provisioninguri": {},
+	"recoverycodes":   {},
+	"challengetoken":  {},
+}
+
+// Snapshot 将变更前后内容序列化为脱敏后的 JSON，并计算发生变化的顶层字段
+// 只有变更后内容时（如创建操作或以请求内容代替快照），变更字段为变更后内容的全部顶层字段
+func Snapshot(before, after interface{}) (beforeJSON, afterJSON string, changedFields []string) {
+	beforeValue := redactedJSONValue(before)
+	afterValue := redactedJSONValue(after)
+
+	return marshalValue(beforeValue), marshalValue(afterValue), diffTopLevel(beforeValue, afterValue)
+}
+
+// redactedJSONValue 将任意值转换为 JSON 通用结构并脱敏，nil 或无法序列化时返回 nil
+func redactedJSONValue(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+
+	return redact(value)
+}
+
+// redact 递归替换敏感字段的值
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if _, ok := sensitiveKeys[strings.ToLower(key)]; ok {
+				v[key] = redactedValue
+				continue
+			}
+
+			v[key] = redact(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redact(item)
+		}
+	}
+
+	return value
+}
+
+func marshalValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// diffTopLevel 比较两个 JSON 对象的顶层字段，返回排序后的变化字段名
+func diffTopLevel(before, after interface{}) []string {
+	beforeMap, _ := before.(map[string]interface{})
+	afterMap, _ := after.(map[string]interface{})
+
+	keys := make(map[string]struct{}, len(beforeMap)+len(afterMap))
+	for key := range beforeMap {
+		keys[key] = struct{}{}
+	}
+
+	for key := range afterMap {
+		keys[key] = struct{}{}
+	}
+
+	changed := make([]string, 0, len(keys))
+
+	for key := range keys {
+		if !reflect.DeepEqual(beforeMap[key], afterMap[key]) {
+			changed = append(changed, key)
+		}
+	}
+
+	sort.Strings(changed)
+
+	return changed
+}
@@ -0,0 +1,103 @@
+package auditlog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type profileSnapshot struct {
+	Username string `json:"username"`
+	Email    string `json:"email,omitempty"`
+	Status   string `json:"status"`
+}
+
+type resetPasswordPayload struct {
+	UserID      *string `json:"userID,omitempty"`
+	NewPassword *string `json:"newPassword,omitempty"`
+}
+
+func TestSnapshot_ChangedFields(t *testing.T) {
+	before := &profileSnapshot{Username: "alice", Email: "a@example.com", Status: "active"}
+	after := &profileSnapshot{Username: "alice", Status: "suspended"}
+
+	beforeJSON, afterJSON, changed := Snapshot(before, after)
+
+	assert.JSONEq(t, `{"username":"alice","email":"a@example.com","status":"active"}`, beforeJSON)
+	assert.JSONEq(t, `{"username":"alice","status":"suspended"}`, afterJSON)
+	assert.Equal(t, []string{"email", "status"}, changed)
+}
+
+func TestSnapshot_RedactsSensitiveFields(t *testing.T) {
+	userID := "8f9b3c1e-0000-4000-8000-000000000001"
+	password := "P@ssw0rd!"
+
+	beforeJSON, afterJSON, changed := Snapshot(nil, &resetPasswordPayload{
+		UserID:      &userID,
+		NewPassword: &password,
+	})
+
+	assert.Empty(t, beforeJSON)
+	assert.NotContains(t, afterJSON, password)
+	assert.JSONEq(t, `{"userID":"`+userID+`","newPassword":"***"}`, afterJSON)
+	assert.Equal(t, []string{"newPassword", "userID"}, changed)
+}
+
+func TestSnapshot_RedactsNestedFields(t *testing.T) {
+	after := map[string]interface{}{
+		"users": []interface{}{
+			map[string]interface{}{"username": "bob", "Password": "secret"},
+		},
+	}
+
+	_, afterJSON, _ := Snapshot(nil, after)
+
+	assert.JSONEq(t, `{"users":[{"username":"bob","Password":"***"}]}`, afterJSON)
+}
+
+func TestSnapshot_NilPointer(t *testing.T) {
+	var before *profileSnapshot
+
+	beforeJSON, afterJSON, changed := Snapshot(before, nil)
+
+	assert.Empty(t, beforeJSON)
+	assert.Empty(t, afterJSON)
+	assert.Empty(t, changed)
+}
+
+func TestMethodSpec_TargetID(t *testing.T) {
+	userID := "8f9b3c1e-0000-4000-8000-000000000001"
+
+	spec, ok := LookupMethod("ResetPassword")
+	require.True(t, ok)
+	assert.Equal(t, userID, spec.TargetID(&resetPasswordPayload{UserID: &userID}, nil))
+
+	spec, ok = LookupMethod("DeleteOrganization")
+	require.True(t, ok)
+	assert.Equal(t, "org-1", spec.TargetID("org-1", nil))
+
+	spec, ok = LookupMethod("CreateUser")
+	require.True(t, ok)
+	assert.Equal(t, userID, spec.TargetID(&resetPasswordPayload{}, &struct{ ID *string }{ID: &userID}))
+
+	_, ok = LookupMethod("ListUsers")
+	assert.False(t, ok)
+}
+
+func TestSetChange_WithoutEntry(t *testing.T) {
+	ctx := context.Background()
+
+	SetChange(ctx, "before", "after")
+	SetTarget(ctx, "target")
+	assert.Nil(t, FromContext(ctx))
+
+	entry := &Entry{TargetType: TargetMenu}
+	ctx = WithEntry(ctx, entry)
+
+	SetTarget(ctx, "v2")
+	SetChange(ctx, "v1", "v2")
+	assert.Equal(t, "v2", entry.TargetID)
+	assert.True(t, entry.HasChange())
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/casbin/casbin/v2/util"
 	gormadapter "github.com/casbin/gorm-adapter/v3"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/config"
 	"github.com/rs/zerolog"
@@ -13,68 +15,158 @@ import (
 )
 
 // CasbinManager Casbin 权限管理器
+// 多实例部署时通过 Watcher 互相通知策略变更，收到其他实例的通知后重新加载策略
 type CasbinManager struct {
-	enforcer *casbin.Enforcer
+	enforcer *casbin.SyncedEnforcer
 	db       *gorm.DB
 	logger   *zerolog.Logger
+
+	watcher persist.Watcher
+	reload  chan struct{}
+	stop    chan struct{}
+	stopped chan struct{}
 }
 
 // NewCasbinManager 创建 Casbin 管理器
+// watcher 为 nil 时不进行多实例策略同步
 func NewCasbinManager(
 	db *gorm.DB,
 	config *config.CasbinConfig,
+	watcher persist.Watcher,
 	logger *zerolog.Logger,
 ) (*CasbinManager, error) {
-	// 1. 确保数据表存在
-	err := db.AutoMigrate(&models.CasbinRule{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to migrate casbin rules table: %w", err)
-	}
+	// 1. 创建 GORM Adapter
+	// casbin_rule 表结构由版本化迁移维护，关闭 Adapter 自带的建表与自动迁移
+	// TurnOffAutoMigrate 会改写传入的实例，使用独立会话避免影响共享的数据库连接
+	adapterDB := db.Session(&gorm.Session{})
+	gormadapter.TurnOffAutoMigrate(adapterDB)
 
-	// 2. 创建 GORM Adapter
-	adapter, err := gormadapter.NewAdapterByDBUseTableName(db, "", "casbin_rule")
+	adapter, err := gormadapter.NewAdapterByDBUseTableName(adapterDB, "", "casbin_rule")
 	if err != nil {
 		return nil, fmt.Errorf("failed to create gorm adapter: %w", err)
 	}
 
-	// 3. 创建 Casbin 执行器
-	enforcer, err := casbin.NewEnforcer(config.ModelPath, adapter)
+	// 2. 创建 Casbin 执行器
+	enforcer, err := casbin.NewSyncedEnforcer(config.ModelPath, adapter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create casbin enforcer: %w", err)
 	}
 
-	// 4. 配置执行器
+	// 3. 配置执行器，全局域 * 的分组策略通过 KeyMatch 在每个组织域中生效
 	enforcer.EnableAutoSave(true)
 	enforcer.EnableAutoBuildRoleLinks(true)
 	enforcer.EnableLog(config.EnableLog)
+	enforcer.AddNamedDomainMatchingFunc(models.PolicyTypeUserRole, "KeyMatch", util.KeyMatch)
 
-	// 5. 加载策略数据
+	// 4. 加载策略数据
 	err = enforcer.LoadPolicy()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load policy: %w", err)
 	}
 
+	cm := &CasbinManager{
+		enforcer: enforcer,
+		db:       db,
+		logger:   logger,
+	}
+
+	// 5. 注册策略变更 Watcher
+	if watcher != nil {
+		if err := cm.startWatcher(watcher); err != nil {
+			return nil, err
+		}
+	}
+
 	logger.Info().
 		Str("model_path", config.ModelPath).
 		Bool("auto_save", true).
 		Bool("auto_build_role_links", true).
+		Bool("watcher", watcher != nil).
 		Msg("Casbin manager initialized successfully")
 
-	return &CasbinManager{
-		enforcer: enforcer,
-		db:       db,
-		logger:   logger,
-	}, nil
+	return cm, nil
 }
 
 // GetEnforcer 获取 Casbin Enforcer 实例
-func (cm *CasbinManager) GetEnforcer() *casbin.Enforcer {
+func (cm *CasbinManager) GetEnforcer() *casbin.SyncedEnforcer {
 	return cm.enforcer
 }
 
-// AddUserRole 为用户添加角色
-func (cm *CasbinManager) AddUserRole(userID, roleID string) error {
-	added, err := cm.enforcer.AddRoleForUser(userID, roleID)
+// Close 停止策略同步并关闭 Watcher
+func (cm *CasbinManager) Close() {
+	if cm.watcher == nil {
+		return
+	}
+
+	cm.watcher.Close()
+	close(cm.stop)
+	<-cm.stopped
+}
+
+// startWatcher 注册 Watcher 并启动策略重载协程
+// 通过 Enforcer API 修改策略时由 Casbin 自动通知；仅更新内存模型的变更需调用 notifyPolicyChanged
+func (cm *CasbinManager) startWatcher(watcher persist.Watcher) error {
+	if err := cm.enforcer.SetWatcher(watcher); err != nil {
+		return fmt.Errorf("failed to set casbin watcher: %w", err)
+	}
+
+	// SetWatcher 会注册默认的同步重载回调，这里替换为合并触发的异步重载
+	if err := watcher.SetUpdateCallback(cm.onPolicyUpdate); err != nil {
+		return fmt.Errorf("failed to set watcher callback: %w", err)
+	}
+
+	cm.watcher = watcher
+	cm.reload = make(chan struct{}, 1)
+	cm.stop = make(chan struct{})
+	cm.stopped = make(chan struct{})
+
+	go cm.reloadLoop()
+
+	return nil
+}
+
+// onPolicyUpdate 收到其他实例的策略变更通知，短时间内的多次通知合并为一次重载
+func (cm *CasbinManager) onPolicyUpdate(string) {
+	select {
+	case cm.reload <- struct{}{}:
+	default:
+	}
+}
+
+// reloadLoop 串行执行策略重载
+func (cm *CasbinManager) reloadLoop() {
+	defer close(cm.stopped)
+
+	for {
+		select {
+		case <-cm.stop:
+			return
+		case <-cm.reload:
+			if err := cm.enforcer.LoadPolicy(); err != nil {
+				cm.logger.Error().Err(err).Msg("重新加载 Casbin 策略失败")
+				continue
+			}
+
+			cm.logger.Info().Msg("已根据其他实例的变更通知重新加载 Casbin 策略")
+		}
+	}
+}
+
+// notifyPolicyChanged 通知其他实例策略已变更
+// 用于绕过 Enforcer API 直接写库、仅同步内存模型的场景
+func (cm *CasbinManager) notifyPolicyChanged() {
+	if cm.watcher == nil {
+		return
+	}
+
+	if err := cm.watcher.Update(); err != nil {
+		cm.logger.Error().Err(err).Msg("通知其他实例策略变更失败")
+	}
+}
+
+// AddUserRole 在指定域内为用户添加角色，domain 为组织ID或 models.GlobalDomain
+func (cm *CasbinManager) AddUserRole(userID, roleID, domain string) error {
+	added, err := cm.enforcer.AddRoleForUser(userID, roleID, domain)
 	if err != nil {
 		return fmt.Errorf("添加用户角色失败: %w", err)
 	}
@@ -94,9 +186,9 @@ func (cm *CasbinManager) AddUserRole(userID, roleID string) error {
 	return nil
 }
 
-// RemoveUserRole 移除用户角色
-func (cm *CasbinManager) RemoveUserRole(userID, roleID string) error {
-	removed, err := cm.enforcer.DeleteRoleForUser(userID, roleID)
+// RemoveUserRole 移除用户在指定域内的角色
+func (cm *CasbinManager) RemoveUserRole(userID, roleID, domain string) error {
+	removed, err := cm.enforcer.DeleteRoleForUser(userID, roleID, domain)
 	if err != nil {
 		return fmt.Errorf("移除用户角色失败: %w", err)
 	}
@@ -116,9 +208,9 @@ func (cm *CasbinManager) RemoveUserRole(userID, roleID string) error {
 	return nil
 }
 
-// GetUserRoles 获取用户的所有角色
-func (cm *CasbinManager) GetUserRoles(userID string) ([]string, error) {
-	roles, err := cm.enforcer.GetRolesForUser(userID)
+// GetUserRoles 获取用户在指定域内的所有角色，包含全局授权的角色
+func (cm *CasbinManager) GetUserRoles(userID, domain string) ([]string, error) {
+	roles, err := cm.enforcer.GetRolesForUser(userID, domain)
 	if err != nil {
 		return nil, fmt.Errorf("获取用户角色失败: %w", err)
 	}
@@ -178,14 +270,14 @@ func (cm *CasbinManager) RemoveRolePermission(roleID, resource, action string) e
 	return nil
 }
 
-// HasPermission 检查用户是否具有指定权限
-func (cm *CasbinManager) HasPermission(userID, resource, action string) (bool, error) {
-	return cm.enforcer.Enforce(userID, resource, action)
+// HasPermission 检查用户或角色在指定域内是否具有指定权限
+func (cm *CasbinManager) HasPermission(subject, domain, resource, action string) (bool, error) {
+	return cm.enforcer.Enforce(subject, domain, resource, action)
 }
 
-// GetUserPermissions 获取用户的所有权限
-func (cm *CasbinManager) GetUserPermissions(userID string) ([][]string, error) {
-	permissions, err := cm.enforcer.GetImplicitPermissionsForUser(userID)
+// GetUserPermissions 获取用户在指定域内的所有权限
+func (cm *CasbinManager) GetUserPermissions(userID, domain string) ([][]string, error) {
+	permissions, err := cm.enforcer.GetImplicitPermissionsForUser(userID, domain)
 	if err != nil {
 		return nil, fmt.Errorf("获取用户权限失败: %w", err)
 	}
@@ -264,6 +356,16 @@ func (cm *CasbinManager) GetRoleMenuMappings(roleID string) ([][]string, error)
 	return policies, nil
 }
 
+// GetAllRoleMenuMappings 获取全部角色的菜单映射，格式与 GetRoleMenuMappings 相同
+func (cm *CasbinManager) GetAllRoleMenuMappings() ([][]string, error) {
+	policies, err := cm.enforcer.GetNamedPolicy("p2")
+	if err != nil {
+		return nil, fmt.Errorf("获取角色菜单映射失败: %w", err)
+	}
+
+	return policies, nil
+}
+
 // ClearRoleMenuMappings 清空角色的所有菜单映射
 func (cm *CasbinManager) ClearRoleMenuMappings(roleID string) error {
 	removed, err := cm.enforcer.RemoveFilteredNamedPolicy("p2", 0, roleID)
@@ -278,17 +380,17 @@ func (cm *CasbinManager) ClearRoleMenuMappings(roleID string) error {
 	return nil
 }
 
-// SyncUserRoles 同步用户角色（替换现有角色）
-func (cm *CasbinManager) SyncUserRoles(userID string, roleIDs []string) error {
-	// 1. 清除用户的旧角色关系
-	_, err := cm.enforcer.DeleteRolesForUser(userID)
+// SyncUserRoles 同步用户在指定域内的角色（替换该域内的现有角色）
+func (cm *CasbinManager) SyncUserRoles(userID, domain string, roleIDs []string) error {
+	// 1. 清除用户在该域内的旧角色关系
+	_, err := cm.enforcer.DeleteRolesForUser(userID, domain)
 	if err != nil {
 		return fmt.Errorf("清除用户旧角色关系失败: %w", err)
 	}
 
 	// 2. 添加新的角色关系
 	for _, roleID := range roleIDs {
-		err = cm.AddUserRole(userID, roleID)
+		err = cm.AddUserRole(userID, roleID, domain)
 		if err != nil {
 			cm.logger.Error().
 				Str("user_id", userID).
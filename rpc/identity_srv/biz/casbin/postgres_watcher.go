@@ -0,0 +1,185 @@
+package casbin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+// 监听连接断开后的重连退避区间
+const (
+	watcherMinBackoff = time.Second
+	watcherMaxBackoff = 30 * time.Second
+)
+
+// PostgresWatcher 基于 PostgreSQL LISTEN/NOTIFY 的 Casbin Watcher
+// 每个实例持有一条专用的监听连接；策略变更时通过共享连接池执行 pg_notify，
+// 负载为发送方实例ID，监听方据此忽略自身发出的通知。
+// 监听连接断开重连后会触发一次回调，以补偿断线期间可能遗漏的通知。
+type PostgresWatcher struct {
+	id      string
+	channel string
+	dsn     string
+	db      *gorm.DB
+	logger  *zerolog.Logger
+
+	mu       sync.RWMutex
+	callback func(string)
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewPostgresWatcher 创建 PostgreSQL Watcher 并开始监听
+// dsn 用于建立专用监听连接，db 用于发送通知
+func NewPostgresWatcher(
+	db *gorm.DB,
+	dsn, channel string,
+	logger *zerolog.Logger,
+) (*PostgresWatcher, error) {
+	if channel == "" {
+		return nil, errors.New("watcher channel must not be empty")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	w := &PostgresWatcher{
+		id:      uuid.NewString(),
+		channel: channel,
+		dsn:     dsn,
+		db:      db,
+		logger:  logger,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+
+	// 首次连接同步建立，配置错误时启动即失败
+	conn, err := w.listen(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	go w.run(ctx, conn)
+
+	logger.Info().
+		Str("channel", channel).
+		Str("instance_id", w.id).
+		Msg("Casbin policy watcher started")
+
+	return w, nil
+}
+
+// SetUpdateCallback 设置收到其他实例变更通知时的回调
+func (w *PostgresWatcher) SetUpdateCallback(callback func(string)) error {
+	w.mu.Lock()
+	w.callback = callback
+	w.mu.Unlock()
+
+	return nil
+}
+
+// Update 通知其他实例策略已变更
+func (w *PostgresWatcher) Update() error {
+	if err := w.db.Exec("SELECT pg_notify(?, ?)", w.channel, w.id).Error; err != nil {
+		return fmt.Errorf("发送策略变更通知失败: %w", err)
+	}
+
+	return nil
+}
+
+// Close 停止监听并关闭专用连接
+func (w *PostgresWatcher) Close() {
+	w.cancel()
+	<-w.done
+}
+
+// listen 建立专用连接并订阅通知通道
+func (w *PostgresWatcher) listen(ctx context.Context) (*pgx.Conn, error) {
+	conn, err := pgx.Connect(ctx, w.dsn)
+	if err != nil {
+		return nil, fmt.Errorf("建立策略监听连接失败: %w", err)
+	}
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{w.channel}.Sanitize()); err != nil {
+		_ = conn.Close(context.Background())
+		return nil, fmt.Errorf("订阅策略变更通道失败: %w", err)
+	}
+
+	return conn, nil
+}
+
+// run 循环等待通知，连接异常时按指数退避重连
+func (w *PostgresWatcher) run(ctx context.Context, conn *pgx.Conn) {
+	defer close(w.done)
+
+	backoff := watcherMinBackoff
+
+	for {
+		if conn == nil {
+			var err error
+
+			conn, err = w.listen(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				w.logger.Warn().Err(err).Dur("retry_in", backoff).Msg("Casbin policy watcher reconnect failed")
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(backoff):
+				}
+
+				backoff = min(backoff*2, watcherMaxBackoff)
+
+				continue
+			}
+
+			backoff = watcherMinBackoff
+
+			w.logger.Info().Str("channel", w.channel).Msg("Casbin policy watcher reconnected")
+			w.notify("")
+		}
+
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			_ = conn.Close(context.Background())
+			conn = nil
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			w.logger.Warn().Err(err).Msg("Casbin policy watcher connection lost")
+
+			continue
+		}
+
+		if notification.Payload == w.id {
+			continue
+		}
+
+		w.notify(notification.Payload)
+	}
+}
+
+// notify 调用已注册的回调
+func (w *PostgresWatcher) notify(msg string) {
+	w.mu.RLock()
+	callback := w.callback
+	w.mu.RUnlock()
+
+	if callback != nil {
+		callback(msg)
+	}
+}
@@ -0,0 +1,90 @@
+package casbin
+
+import (
+	"fmt"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+)
+
+// MaxRoleInheritanceDepth 角色继承链允许的最大父级层数
+// Casbin 角色管理器默认最多解析 10 层分组关系，其中用户到角色占一层，这里保留余量
+const MaxRoleInheritanceDepth = 8
+
+// ApplyRoleInheritanceChanges 将已落库的角色继承变更同步到内存中的 Enforcer
+// 角色继承与用户角色分配同为 g 分组策略，每一项均为 [child_role_id, parent_role_id]，
+// 继承关系不区分组织，统一写入全局域
+func (cm *CasbinManager) ApplyRoleInheritanceChanges(added, removed [][]string) error {
+	return cm.ApplyUserRoleChanges(withGlobalDomain(added), withGlobalDomain(removed))
+}
+
+// withGlobalDomain 为角色继承规则补齐全局域
+func withGlobalDomain(rules [][]string) [][]string {
+	result := make([][]string, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, []string{rule[0], rule[1], models.GlobalDomain})
+	}
+
+	return result
+}
+
+// GetRoleAncestors 沿继承链获取角色的全部父级角色（不含自身），由近及远排列
+func (cm *CasbinManager) GetRoleAncestors(roleID string) ([]string, error) {
+	ancestors, err := cm.enforcer.GetImplicitRolesForUser(roleID, models.GlobalDomain)
+	if err != nil {
+		return nil, fmt.Errorf("获取角色继承链失败: %w", err)
+	}
+
+	return ancestors, nil
+}
+
+// ExpandInheritedRoles 返回角色列表及其全部父级角色，保持原有顺序并去重
+func (cm *CasbinManager) ExpandInheritedRoles(roleIDs []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(roleIDs))
+	result := make([]string, 0, len(roleIDs))
+
+	add := func(roleID string) {
+		if _, ok := seen[roleID]; ok {
+			return
+		}
+
+		seen[roleID] = struct{}{}
+		result = append(result, roleID)
+	}
+
+	for _, roleID := range roleIDs {
+		add(roleID)
+
+		ancestors, err := cm.GetRoleAncestors(roleID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ancestor := range ancestors {
+			add(ancestor)
+		}
+	}
+
+	return result, nil
+}
+
+// GetInheritedRoleMenuMappings 获取角色自身及其全部父级角色的菜单映射
+// 返回格式与 GetRoleMenuMappings 相同，每条映射的 role_id 为映射实际所属的角色
+func (cm *CasbinManager) GetInheritedRoleMenuMappings(roleID string) ([][]string, error) {
+	roleIDs, err := cm.ExpandInheritedRoles([]string{roleID})
+	if err != nil {
+		return nil, err
+	}
+
+	var policies [][]string
+
+	for _, id := range roleIDs {
+		mappings, err := cm.GetRoleMenuMappings(id)
+		if err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, mappings...)
+	}
+
+	return policies, nil
+}
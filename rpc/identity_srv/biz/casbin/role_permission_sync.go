@@ -0,0 +1,201 @@
+package casbin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+)
+
+// RolePermissionDrift 角色定义权限与 Casbin 权限策略之间的差异
+// 每一项均为 [role_id, resource, action]
+type RolePermissionDrift struct {
+	Missing [][]string // 角色定义中存在但缺少权限策略
+	Extra   [][]string // 存在权限策略但角色定义中没有对应权限
+}
+
+// IsEmpty 是否不存在差异
+func (d *RolePermissionDrift) IsEmpty() bool {
+	return len(d.Missing) == 0 && len(d.Extra) == 0
+}
+
+// ApplyRolePermissionChanges 将已落库的角色权限变更同步到内存中的 Enforcer
+// 权限策略已由调用方与角色定义在同一事务中写入 casbin_rule，这里只更新内存模型；
+// 无论内存更新是否成功都会通知其他实例重新加载
+func (cm *CasbinManager) ApplyRolePermissionChanges(added, removed [][]string) error {
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	defer cm.notifyPolicyChanged()
+
+	lock := cm.enforcer.GetLock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	m := cm.enforcer.GetModel()
+
+	if len(removed) > 0 {
+		if _, err := m.RemovePoliciesWithAffected("p", models.PolicyTypePermission, removed); err != nil {
+			return fmt.Errorf("更新内存角色权限策略失败: %w", err)
+		}
+	}
+
+	if len(added) > 0 {
+		if _, err := m.AddPoliciesWithAffected("p", models.PolicyTypePermission, added); err != nil {
+			return fmt.Errorf("更新内存角色权限策略失败: %w", err)
+		}
+	}
+
+	cm.logger.Debug().
+		Int("added", len(added)).
+		Int("removed", len(removed)).
+		Msg("内存角色权限策略已同步")
+
+	return nil
+}
+
+// GetRolePermissions 获取角色自身的权限策略，每一项为 [role_id, resource, action]
+func (cm *CasbinManager) GetRolePermissions(roleID string) ([][]string, error) {
+	policies, err := cm.enforcer.GetFilteredPolicy(0, roleID)
+	if err != nil {
+		return nil, fmt.Errorf("获取角色权限策略失败: %w", err)
+	}
+
+	return policies, nil
+}
+
+// ReconcileRolePermissions 以 role_definitions.permissions 为准核对 casbin_rule 中的角色权限策略
+// 仅核对主体为现有角色的策略，手工维护的其他主体策略不受影响；fix 为 true 时在同一事务中补齐缺失、删除多余的策略，并重新加载内存策略
+func (cm *CasbinManager) ReconcileRolePermissions(
+	ctx context.Context,
+	d dal.DAL,
+	fix bool,
+) (*RolePermissionDrift, error) {
+	roles, _, err := d.RoleDefinition().FindAll(ctx, base.NewQueryOptions().WithFetchAll(true))
+	if err != nil {
+		return nil, fmt.Errorf("获取角色定义失败: %w", err)
+	}
+
+	rules, err := d.CasbinRule().ListRolePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	drift := diffRolePermissions(roles, rules)
+	if drift.IsEmpty() {
+		cm.logger.Info().
+			Int("roles", len(roles)).
+			Msg("角色权限策略与角色定义一致")
+
+		return drift, nil
+	}
+
+	cm.logger.Warn().
+		Int("missing", len(drift.Missing)).
+		Int("extra", len(drift.Extra)).
+		Msg("角色权限策略与角色定义不一致")
+
+	if !fix {
+		return drift, nil
+	}
+
+	err = d.WithTransaction(ctx, func(ctx context.Context, tx dal.DAL) error {
+		for _, role := range roles {
+			permissions := RolePermissionRules(role.Permissions)
+			if _, _, err := tx.CasbinRule().ReplaceRolePermissions(ctx, role.ID.String(), permissions, ""); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return drift, fmt.Errorf("修复角色权限策略失败: %w", err)
+	}
+
+	if err := cm.enforcer.LoadPolicy(); err != nil {
+		return drift, fmt.Errorf("重新加载策略失败: %w", err)
+	}
+
+	cm.notifyPolicyChanged()
+
+	cm.logger.Info().
+		Int("added", len(drift.Missing)).
+		Int("removed", len(drift.Extra)).
+		Msg("角色权限策略已按角色定义修复")
+
+	return drift, nil
+}
+
+// RolePermissionRules 将角色定义的权限列表转换为权限策略参数 [resource, action]，忽略空项
+func RolePermissionRules(permissions models.Permissions) [][]string {
+	rules := make([][]string, 0, len(permissions))
+
+	for _, p := range permissions {
+		if p == nil || p.Resource == "" || p.Action == "" {
+			continue
+		}
+
+		rules = append(rules, []string{p.Resource, p.Action})
+	}
+
+	return rules
+}
+
+// diffRolePermissions 比较角色定义权限与权限策略，结果按角色ID、资源、操作排序
+func diffRolePermissions(roles []*models.RoleDefinition, rules []*models.CasbinRule) *RolePermissionDrift {
+	expected := make(map[[3]string]struct{})
+	roleIDs := make(map[string]struct{}, len(roles))
+
+	for _, role := range roles {
+		roleID := role.ID.String()
+		roleIDs[roleID] = struct{}{}
+
+		for _, p := range RolePermissionRules(role.Permissions) {
+			expected[[3]string{roleID, p[0], p[1]}] = struct{}{}
+		}
+	}
+
+	actual := make(map[[3]string]struct{}, len(rules))
+	for _, r := range rules {
+		if _, ok := roleIDs[r.V0]; ok {
+			actual[[3]string{r.V0, r.V1, r.V2}] = struct{}{}
+		}
+	}
+
+	drift := &RolePermissionDrift{}
+
+	for key := range expected {
+		if _, ok := actual[key]; !ok {
+			drift.Missing = append(drift.Missing, []string{key[0], key[1], key[2]})
+		}
+	}
+
+	for key := range actual {
+		if _, ok := expected[key]; !ok {
+			drift.Extra = append(drift.Extra, []string{key[0], key[1], key[2]})
+		}
+	}
+
+	sortPermissionRules(drift.Missing)
+	sortPermissionRules(drift.Extra)
+
+	return drift
+}
+
+// sortPermissionRules 按角色ID、资源、操作对权限策略排序，便于日志比对
+func sortPermissionRules(rules [][]string) {
+	sort.Slice(rules, func(i, j int) bool {
+		for k := 0; k < 3; k++ {
+			if rules[i][k] != rules[j][k] {
+				return rules[i][k] < rules[j][k]
+			}
+		}
+
+		return false
+	})
+}
@@ -0,0 +1,42 @@
+package casbin
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffRolePermissions(t *testing.T) {
+	role := &models.RoleDefinition{
+		BaseModel: models.BaseModel{ID: uuid.New()},
+		Permissions: models.Permissions{
+			{Resource: "user", Action: "read"},
+			{Resource: "user", Action: "create"},
+		},
+	}
+	roleID := role.ID.String()
+
+	rules := []*models.CasbinRule{
+		{Ptype: models.PolicyTypePermission, V0: roleID, V1: "user", V2: "read"},
+		{Ptype: models.PolicyTypePermission, V0: roleID, V1: "user", V2: "delete"},
+		// 主体不是现有角色的策略不参与核对
+		{Ptype: models.PolicyTypePermission, V0: "manual", V1: "user", V2: "delete"},
+	}
+
+	drift := diffRolePermissions([]*models.RoleDefinition{role}, rules)
+
+	assert.Equal(t, [][]string{{roleID, "user", "create"}}, drift.Missing)
+	assert.Equal(t, [][]string{{roleID, "user", "delete"}}, drift.Extra)
+}
+
+func TestRolePermissionRules_SkipsEmpty(t *testing.T) {
+	rules := RolePermissionRules(models.Permissions{
+		{Resource: "role", Action: "read"},
+		{Resource: "", Action: "read"},
+		nil,
+	})
+
+	assert.Equal(t, [][]string{{"role", "read"}}, rules)
+}
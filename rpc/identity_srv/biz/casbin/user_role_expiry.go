@@ -0,0 +1,92 @@
+package casbin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+)
+
+// expiredAssignmentBatchSize 每轮清理的过期角色分配数量上限
+const expiredAssignmentBatchSize = 500
+
+// SweepUserRoleExpiry 处理角色分配有效期带来的分组策略变化
+//   - 删除已过期的角色分配；用户不再持有该角色的其他有效分配时同时删除分组策略
+//   - 为生效时间落在 (since, now] 区间的角色分配补写分组策略
+//
+// 所有写入均为幂等操作，重复处理同一区间不会产生冲突。返回本次清理与补写的角色分配数量
+func (cm *CasbinManager) SweepUserRoleExpiry(ctx context.Context, d dal.DAL, since, now int64) (int64, error) {
+	var added, removed [][]string
+
+	expired, err := d.UserRoleAssignment().FindExpired(ctx, now, expiredAssignmentBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("查询过期角色分配失败: %w", err)
+	}
+
+	if len(expired) > 0 {
+		err = d.WithTransaction(ctx, func(ctx context.Context, tx dal.DAL) error {
+			for _, a := range expired {
+				if err := tx.UserRoleAssignment().Delete(ctx, a.ID.String()); err != nil {
+					return err
+				}
+			}
+
+			for _, a := range expired {
+				userID, roleID, domain := a.UserID.String(), a.RoleID.String(), a.Domain()
+
+				effective, err := tx.UserRoleAssignment().
+					HasEffectiveUserRole(ctx, userID, roleID, a.OrganizationIDString())
+				if err != nil {
+					return err
+				}
+
+				if effective {
+					continue
+				}
+
+				if err := tx.CasbinRule().RemoveUserRole(ctx, userID, roleID, domain); err != nil {
+					return err
+				}
+
+				removed = append(removed, []string{userID, roleID, domain})
+			}
+
+			return nil
+		})
+		if err != nil {
+			return 0, fmt.Errorf("清理过期角色分配失败: %w", err)
+		}
+	}
+
+	activated, err := d.UserRoleAssignment().FindBecameEffective(ctx, since, now)
+	if err != nil {
+		return 0, fmt.Errorf("查询到期生效的角色分配失败: %w", err)
+	}
+
+	if len(activated) > 0 {
+		err = d.WithTransaction(ctx, func(ctx context.Context, tx dal.DAL) error {
+			for _, a := range activated {
+				userID, roleID, domain := a.UserID.String(), a.RoleID.String(), a.Domain()
+				if err := tx.CasbinRule().AddUserRole(ctx, userID, roleID, domain, ""); err != nil {
+					return err
+				}
+
+				added = append(added, []string{userID, roleID, domain})
+			}
+
+			return nil
+		})
+		if err != nil {
+			return 0, fmt.Errorf("补写到期生效的分组策略失败: %w", err)
+		}
+	}
+
+	if len(added) > 0 || len(removed) > 0 {
+		cm.logger.Info().
+			Int("expired", len(expired)).
+			Int("activated", len(activated)).
+			Msg("角色分配有效期变化已同步到分组策略")
+	}
+
+	return int64(len(expired) + len(activated)), cm.ApplyUserRoleChanges(added, removed)
+}
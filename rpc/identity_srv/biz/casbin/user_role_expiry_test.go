@@ -0,0 +1,184 @@
+package casbin
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/google/uuid"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/assignment"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/policy"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// expiryAssignments 内存中的角色分配仓储，effective 记录仍持有有效分配的 "用户ID/角色ID/组织ID"；未实现的方法调用时 panic
+type expiryAssignments struct {
+	assignment.UserRoleAssignmentRepository
+
+	expired   []*models.UserRoleAssignment
+	activated []*models.UserRoleAssignment
+	effective map[string]bool
+
+	deleted          []string
+	since, now       int64
+	findExpiredError error
+}
+
+func (r *expiryAssignments) FindExpired(_ context.Context, now int64, _ int) ([]*models.UserRoleAssignment, error) {
+	r.now = now
+	return r.expired, r.findExpiredError
+}
+
+func (r *expiryAssignments) FindBecameEffective(
+	_ context.Context,
+	since, now int64,
+) ([]*models.UserRoleAssignment, error) {
+	r.since = since
+	return r.activated, nil
+}
+
+func (r *expiryAssignments) Delete(_ context.Context, id string) error {
+	r.deleted = append(r.deleted, id)
+	return nil
+}
+
+func (r *expiryAssignments) HasEffectiveUserRole(_ context.Context, userID, roleID, organizationID string) (bool, error) {
+	return r.effective[userID+"/"+roleID+"/"+organizationID], nil
+}
+
+// recordingRules 记录分组策略写入；未实现的方法调用时 panic
+type recordingRules struct {
+	policy.CasbinRuleRepository
+
+	added, removed [][]string
+}
+
+func (r *recordingRules) AddUserRole(_ context.Context, userID, roleID, domain, _ string) error {
+	r.added = append(r.added, []string{userID, roleID, domain})
+	return nil
+}
+
+func (r *recordingRules) RemoveUserRole(_ context.Context, userID, roleID, domain string) error {
+	r.removed = append(r.removed, []string{userID, roleID, domain})
+	return nil
+}
+
+// expiryDAL 事务直接在同一实例上执行；未实现的方法调用时 panic
+type expiryDAL struct {
+	dal.DAL
+
+	assignments *expiryAssignments
+	rules       *recordingRules
+}
+
+func (d *expiryDAL) UserRoleAssignment() assignment.UserRoleAssignmentRepository {
+	return d.assignments
+}
+
+func (d *expiryDAL) CasbinRule() policy.CasbinRuleRepository {
+	return d.rules
+}
+
+func (d *expiryDAL) WithTransaction(ctx context.Context, fn func(ctx context.Context, tx dal.DAL) error) error {
+	return fn(ctx, d)
+}
+
+// newTestCasbinManager 创建仅使用内存策略的管理器
+func newTestCasbinManager(t *testing.T) *CasbinManager {
+	t.Helper()
+
+	enforcer, err := casbin.NewSyncedEnforcer("../../config/permission_model.conf")
+	require.NoError(t, err)
+
+	logger := zerolog.Nop()
+
+	return &CasbinManager{enforcer: enforcer, logger: &logger}
+}
+
+func newAssignment(userID, roleID uuid.UUID, org *uuid.UUID) *models.UserRoleAssignment {
+	return &models.UserRoleAssignment{
+		BaseModel:      models.BaseModel{ID: uuid.New()},
+		UserID:         userID,
+		RoleID:         roleID,
+		OrganizationID: org,
+	}
+}
+
+func TestSweepUserRoleExpiry(t *testing.T) {
+	ctx := context.Background()
+	cm := newTestCasbinManager(t)
+
+	userA, userB, userC := uuid.New(), uuid.New(), uuid.New()
+	role := uuid.New()
+	org := uuid.New()
+
+	expiredA := newAssignment(userA, role, nil)
+	// userB 的组织授权过期，但同一范围内仍有其他有效分配，保留分组策略
+	expiredB := newAssignment(userB, role, &org)
+	activatedC := newAssignment(userC, role, &org)
+
+	_, err := cm.enforcer.AddGroupingPolicies([][]string{
+		{userA.String(), role.String(), models.GlobalDomain},
+		{userB.String(), role.String(), org.String()},
+	})
+	require.NoError(t, err)
+
+	d := &expiryDAL{
+		assignments: &expiryAssignments{
+			expired:   []*models.UserRoleAssignment{expiredA, expiredB},
+			activated: []*models.UserRoleAssignment{activatedC},
+			effective: map[string]bool{userB.String() + "/" + role.String() + "/" + org.String(): true},
+		},
+		rules: &recordingRules{},
+	}
+
+	count, err := cm.SweepUserRoleExpiry(ctx, d, 1000, 2000)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	assert.Equal(t, int64(1000), d.assignments.since)
+	assert.Equal(t, int64(2000), d.assignments.now)
+	assert.Equal(t, []string{expiredA.ID.String(), expiredB.ID.String()}, d.assignments.deleted)
+
+	assert.Equal(t, [][]string{{userA.String(), role.String(), models.GlobalDomain}}, d.rules.removed)
+	assert.Equal(t, [][]string{{userC.String(), role.String(), org.String()}}, d.rules.added)
+
+	// 内存策略与数据库写入保持一致
+	hasA, _ := cm.enforcer.HasGroupingPolicy(userA.String(), role.String(), models.GlobalDomain)
+	hasB, _ := cm.enforcer.HasGroupingPolicy(userB.String(), role.String(), org.String())
+	hasC, _ := cm.enforcer.HasGroupingPolicy(userC.String(), role.String(), org.String())
+	assert.False(t, hasA)
+	assert.True(t, hasB)
+	assert.True(t, hasC)
+}
+
+func TestSweepUserRoleExpiry_NothingToDo(t *testing.T) {
+	cm := newTestCasbinManager(t)
+	d := &expiryDAL{assignments: &expiryAssignments{}, rules: &recordingRules{}}
+
+	count, err := cm.SweepUserRoleExpiry(context.Background(), d, 0, 2000)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.Empty(t, d.rules.added)
+	assert.Empty(t, d.rules.removed)
+}
+
+func TestSweepUserRoleExpiry_QueryError(t *testing.T) {
+	cm := newTestCasbinManager(t)
+	d := &expiryDAL{
+		assignments: &expiryAssignments{
+			findExpiredError: fmt.Errorf("connection reset"),
+			activated:        []*models.UserRoleAssignment{newAssignment(uuid.New(), uuid.New(), nil)},
+		},
+		rules: &recordingRules{},
+	}
+
+	_, err := cm.SweepUserRoleExpiry(context.Background(), d, 0, 2000)
+	assert.Error(t, err)
+	assert.Empty(t, d.rules.added, "查询失败时不补写分组策略")
+}
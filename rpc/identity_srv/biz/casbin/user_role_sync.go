@@ -0,0 +1,205 @@
+package casbin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+)
+
+// 启动时用户角色策略对账模式
+const (
+	ReconcileModeOff    = "off"    // 不对账
+	ReconcileModeReport = "report" // 仅报告差异
+	ReconcileModeFix    = "fix"    // 报告差异并以分配表为准修复
+)
+
+// UserRoleDrift 用户角色分配表与 Casbin 分组策略之间的差异
+// 每一项均为 [user_id, role_id, domain]
+type UserRoleDrift struct {
+	Missing [][]string // 已分配角色但缺少分组策略
+	Extra   [][]string // 存在分组策略但没有对应的角色分配
+}
+
+// IsEmpty 是否不存在差异
+func (d *UserRoleDrift) IsEmpty() bool {
+	return len(d.Missing) == 0 && len(d.Extra) == 0
+}
+
+// ApplyUserRoleChanges 将已落库的用户角色变更同步到内存中的 Enforcer
+// 每条规则为 [user_id, role_id, domain]，domain 为授权所属组织ID或 models.GlobalDomain
+// 分组策略已由调用方与角色分配在同一事务中写入 casbin_rule，这里只更新内存模型与角色链接；
+// 无论内存更新是否成功都会通知其他实例重新加载
+func (cm *CasbinManager) ApplyUserRoleChanges(added, removed [][]string) error {
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	defer cm.notifyPolicyChanged()
+
+	if err := cm.updateUserRoles(model.PolicyRemove, removed); err != nil {
+		return err
+	}
+
+	if err := cm.updateUserRoles(model.PolicyAdd, added); err != nil {
+		return err
+	}
+
+	cm.logger.Debug().
+		Int("added", len(added)).
+		Int("removed", len(removed)).
+		Msg("内存用户角色策略已同步")
+
+	return nil
+}
+
+// updateUserRoles 增删内存中的用户角色分组策略，并增量重建受影响的角色链接
+func (cm *CasbinManager) updateUserRoles(op model.PolicyOp, rules [][]string) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	var (
+		affected [][]string
+		err      error
+	)
+
+	lock := cm.enforcer.GetLock()
+	lock.Lock()
+	defer lock.Unlock()
+
+	m := cm.enforcer.GetModel()
+	if op == model.PolicyAdd {
+		affected, err = m.AddPoliciesWithAffected("g", models.PolicyTypeUserRole, rules)
+	} else {
+		affected, err = m.RemovePoliciesWithAffected("g", models.PolicyTypeUserRole, rules)
+	}
+
+	if err != nil {
+		return fmt.Errorf("更新内存用户角色策略失败: %w", err)
+	}
+
+	if len(affected) == 0 {
+		return nil
+	}
+
+	if err := cm.enforcer.BuildIncrementalRoleLinks(op, models.PolicyTypeUserRole, affected); err != nil {
+		return fmt.Errorf("更新用户角色链接失败: %w", err)
+	}
+
+	return nil
+}
+
+// ReconcileUserRoles 以 user_role_assignments 为准核对 casbin_rule 中的用户角色分组策略
+// fix 为 true 时在同一事务中补齐缺失、删除多余的策略，并重新加载内存策略
+func (cm *CasbinManager) ReconcileUserRoles(ctx context.Context, d dal.DAL, fix bool) (*UserRoleDrift, error) {
+	assignments, err := d.UserRoleAssignment().ListAllUserRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取用户角色分配失败: %w", err)
+	}
+
+	rules, err := d.CasbinRule().ListUserRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	drift := diffUserRoles(assignments, rules)
+	if drift.IsEmpty() {
+		cm.logger.Info().
+			Int("assignments", len(assignments)).
+			Msg("用户角色策略与角色分配一致")
+
+		return drift, nil
+	}
+
+	cm.logger.Warn().
+		Int("missing", len(drift.Missing)).
+		Int("extra", len(drift.Extra)).
+		Interface("missing_rules", drift.Missing).
+		Interface("extra_rules", drift.Extra).
+		Msg("用户角色策略与角色分配不一致")
+
+	if !fix {
+		return drift, nil
+	}
+
+	err = d.WithTransaction(ctx, func(ctx context.Context, tx dal.DAL) error {
+		for _, rule := range drift.Missing {
+			if err := tx.CasbinRule().AddUserRole(ctx, rule[0], rule[1], rule[2], ""); err != nil {
+				return err
+			}
+		}
+
+		for _, rule := range drift.Extra {
+			if err := tx.CasbinRule().RemoveUserRole(ctx, rule[0], rule[1], rule[2]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return drift, fmt.Errorf("修复用户角色策略失败: %w", err)
+	}
+
+	if err := cm.enforcer.LoadPolicy(); err != nil {
+		return drift, fmt.Errorf("重新加载策略失败: %w", err)
+	}
+
+	cm.notifyPolicyChanged()
+
+	cm.logger.Info().
+		Int("added", len(drift.Missing)).
+		Int("removed", len(drift.Extra)).
+		Msg("用户角色策略已按角色分配修复")
+
+	return drift, nil
+}
+
+// diffUserRoles 比较角色分配与分组策略，结果按用户ID、角色ID、域排序
+func diffUserRoles(assignments []*models.UserRoleAssignment, rules []*models.CasbinRule) *UserRoleDrift {
+	expected := make(map[[3]string]struct{}, len(assignments))
+	for _, a := range assignments {
+		expected[[3]string{a.UserID.String(), a.RoleID.String(), a.Domain()}] = struct{}{}
+	}
+
+	actual := make(map[[3]string]struct{}, len(rules))
+	for _, r := range rules {
+		actual[[3]string{r.V0, r.V1, r.V2}] = struct{}{}
+	}
+
+	drift := &UserRoleDrift{}
+
+	for key := range expected {
+		if _, ok := actual[key]; !ok {
+			drift.Missing = append(drift.Missing, []string{key[0], key[1], key[2]})
+		}
+	}
+
+	for key := range actual {
+		if _, ok := expected[key]; !ok {
+			drift.Extra = append(drift.Extra, []string{key[0], key[1], key[2]})
+		}
+	}
+
+	sortRules(drift.Missing)
+	sortRules(drift.Extra)
+
+	return drift
+}
+
+// sortRules 按字段顺序对策略排序，便于日志比对
+func sortRules(rules [][]string) {
+	sort.Slice(rules, func(i, j int) bool {
+		for k := range rules[i] {
+			if rules[i][k] != rules[j][k] {
+				return rules[i][k] < rules[j][k]
+			}
+		}
+
+		return false
+	})
+}
@@ -0,0 +1,55 @@
+package casbin
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffUserRoles(t *testing.T) {
+	userA, userB := uuid.New(), uuid.New()
+	roleX, roleY := uuid.New(), uuid.New()
+	org := uuid.New()
+
+	assignments := []*models.UserRoleAssignment{
+		{UserID: userA, RoleID: roleX},
+		{UserID: userB, RoleID: roleY},
+		{UserID: userA, RoleID: roleY, OrganizationID: &org},
+	}
+	rules := []*models.CasbinRule{
+		{Ptype: models.PolicyTypeUserRole, V0: userA.String(), V1: roleX.String(), V2: models.GlobalDomain},
+		{Ptype: models.PolicyTypeUserRole, V0: userA.String(), V1: roleY.String(), V2: models.GlobalDomain},
+	}
+
+	drift := diffUserRoles(assignments, rules)
+
+	expectedMissing := [][]string{
+		{userA.String(), roleY.String(), org.String()},
+		{userB.String(), roleY.String(), models.GlobalDomain},
+	}
+	sortRules(expectedMissing)
+
+	assert.Equal(t, expectedMissing, drift.Missing)
+	assert.Equal(t, [][]string{{userA.String(), roleY.String(), models.GlobalDomain}}, drift.Extra)
+	assert.False(t, drift.IsEmpty())
+}
+
+func TestDiffUserRoles_InSync(t *testing.T) {
+	user, role := uuid.New(), uuid.New()
+	org := uuid.New()
+
+	drift := diffUserRoles(
+		[]*models.UserRoleAssignment{
+			{UserID: user, RoleID: role},
+			{UserID: user, RoleID: role, OrganizationID: &org},
+		},
+		[]*models.CasbinRule{
+			{Ptype: models.PolicyTypeUserRole, V0: user.String(), V1: role.String(), V2: models.GlobalDomain},
+			{Ptype: models.PolicyTypeUserRole, V0: user.String(), V1: role.String(), V2: org.String()},
+		},
+	)
+
+	assert.True(t, drift.IsEmpty())
+}
@@ -0,0 +1,102 @@
+package casbin
+
+import (
+	"sync"
+
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/google/uuid"
+)
+
+// 多实例策略同步所使用的 Watcher 类型
+const (
+	WatcherTypeNone     = "none"     // 不同步，仅适用于单实例部署
+	WatcherTypePostgres = "postgres" // 基于 PostgreSQL LISTEN/NOTIFY
+)
+
+// 确保 Watcher 实现满足 Casbin 接口
+var (
+	_ persist.Watcher = (*PostgresWatcher)(nil)
+	_ persist.Watcher = (*MemoryWatcher)(nil)
+)
+
+// MemoryWatcherHub 进程内策略变更广播中心
+// 同一 Hub 下的多个 MemoryWatcher 相当于共享同一通知通道的多个实例，主要用于测试
+type MemoryWatcherHub struct {
+	mu       sync.RWMutex
+	watchers map[string]*MemoryWatcher
+}
+
+// NewMemoryWatcherHub 创建进程内广播中心
+func NewMemoryWatcherHub() *MemoryWatcherHub {
+	return &MemoryWatcherHub{watchers: make(map[string]*MemoryWatcher)}
+}
+
+// NewWatcher 创建挂载在该 Hub 上的 Watcher
+func (h *MemoryWatcherHub) NewWatcher() *MemoryWatcher {
+	w := &MemoryWatcher{id: uuid.NewString(), hub: h}
+
+	h.mu.Lock()
+	h.watchers[w.id] = w
+	h.mu.Unlock()
+
+	return w
+}
+
+// broadcast 向除发送方外的所有 Watcher 投递变更通知
+func (h *MemoryWatcherHub) broadcast(senderID string) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for id, w := range h.watchers {
+		if id != senderID {
+			w.notify(senderID)
+		}
+	}
+}
+
+// remove 从 Hub 中移除 Watcher
+func (h *MemoryWatcherHub) remove(id string) {
+	h.mu.Lock()
+	delete(h.watchers, id)
+	h.mu.Unlock()
+}
+
+// MemoryWatcher 进程内 Watcher 实现
+type MemoryWatcher struct {
+	id  string
+	hub *MemoryWatcherHub
+
+	mu       sync.RWMutex
+	callback func(string)
+}
+
+// SetUpdateCallback 设置收到其他实例变更通知时的回调
+func (w *MemoryWatcher) SetUpdateCallback(callback func(string)) error {
+	w.mu.Lock()
+	w.callback = callback
+	w.mu.Unlock()
+
+	return nil
+}
+
+// Update 通知其他实例策略已变更
+func (w *MemoryWatcher) Update() error {
+	w.hub.broadcast(w.id)
+	return nil
+}
+
+// Close 停止接收通知
+func (w *MemoryWatcher) Close() {
+	w.hub.remove(w.id)
+}
+
+// notify 调用已注册的回调
+func (w *MemoryWatcher) notify(msg string) {
+	w.mu.RLock()
+	callback := w.callback
+	w.mu.RUnlock()
+
+	if callback != nil {
+		callback(msg)
+	}
+}
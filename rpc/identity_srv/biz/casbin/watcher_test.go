@@ -0,0 +1,35 @@
+package casbin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryWatcher_NotifiesOtherInstances(t *testing.T) {
+	hub := NewMemoryWatcherHub()
+	sender, receiver := hub.NewWatcher(), hub.NewWatcher()
+
+	var senderCalls, receiverCalls int
+
+	_ = sender.SetUpdateCallback(func(string) { senderCalls++ })
+	_ = receiver.SetUpdateCallback(func(string) { receiverCalls++ })
+
+	assert.NoError(t, sender.Update())
+	assert.Equal(t, 0, senderCalls)
+	assert.Equal(t, 1, receiverCalls)
+
+	receiver.Close()
+
+	assert.NoError(t, sender.Update())
+	assert.Equal(t, 1, receiverCalls)
+}
+
+func TestCasbinManager_OnPolicyUpdateCoalesces(t *testing.T) {
+	cm := &CasbinManager{reload: make(chan struct{}, 1)}
+
+	cm.onPolicyUpdate("a")
+	cm.onPolicyUpdate("b")
+
+	assert.Len(t, cm.reload, 1)
+}
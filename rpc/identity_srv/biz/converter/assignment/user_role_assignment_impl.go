@@ -26,12 +26,23 @@ func (c *ConverterImpl) ModelToThrift(
 	userID := model.UserID.String()
 	roleID := model.RoleID.String()
 
+	active := model.IsActive()
+
 	result := &identity_srv.UserRoleAssignment{
-		Id:        &id,
-		UserID:    &userID,
-		RoleID:    &roleID,
-		CreatedAt: &model.CreatedAt,
-		UpdatedAt: &model.UpdatedAt,
+		Id:            &id,
+		UserID:        &userID,
+		RoleID:        &roleID,
+		EffectiveFrom: model.EffectiveFrom,
+		ExpiresAt:     model.ExpiresAt,
+		Active:        &active,
+		CreatedAt:     &model.CreatedAt,
+		UpdatedAt:     &model.UpdatedAt,
+	}
+
+	// 全局授权不返回组织ID
+	if model.OrganizationID != nil {
+		organizationID := model.OrganizationID.String()
+		result.OrganizationID = &organizationID
 	}
 
 	// 安全处理可选的 CreatedBy 字段
@@ -0,0 +1,13 @@
+package audit
+
+import (
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+)
+
+// Converter 审计事件转换器接口
+type Converter interface {
+	// Model -> Thrift 转换
+	ModelToThrift(*models.AuditEvent) *identity_srv.AuditEvent
+	ModelsToThrift([]*models.AuditEvent) []*identity_srv.AuditEvent
+}
@@ -0,0 +1,71 @@
+package audit
+
+import (
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/convutil"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+)
+
+// ConverterImpl 审计事件转换器实现
+type ConverterImpl struct{}
+
+// NewConverter 创建审计事件转换器
+func NewConverter() Converter {
+	return &ConverterImpl{}
+}
+
+// ModelToThrift 将 models.AuditEvent 转换为 identity_srv.AuditEvent
+func (c *ConverterImpl) ModelToThrift(model *models.AuditEvent) *identity_srv.AuditEvent {
+	if model == nil {
+		return nil
+	}
+
+	id := model.ID.String()
+	action := model.Action
+	targetType := model.TargetType
+	result := model.Result
+	createdAt := model.CreatedAt
+
+	thrift := &identity_srv.AuditEvent{
+		Id:            &id,
+		Action:        &action,
+		TargetType:    &targetType,
+		TargetID:      convutil.StringPtr(model.TargetID),
+		Before:        convutil.StringPtr(model.Before),
+		After:         convutil.StringPtr(model.After),
+		ChangedFields: model.ChangedFields,
+		RequestID:     convutil.StringPtr(model.RequestID),
+		TraceID:       convutil.StringPtr(model.TraceID),
+		IpAddress:     convutil.StringPtr(model.IPAddress),
+		Result_:       &result,
+		CreatedAt:     &createdAt,
+	}
+
+	if model.ActorID != nil {
+		actorID := model.ActorID.String()
+		thrift.ActorID = &actorID
+	}
+
+	if model.ActorOrganizationID != nil {
+		actorOrganizationID := model.ActorOrganizationID.String()
+		thrift.ActorOrganizationID = &actorOrganizationID
+	}
+
+	if model.Result == models.AuditResultFailure {
+		errorCode := model.ErrorCode
+		thrift.ErrorCode = &errorCode
+		thrift.ErrorMessage = convutil.StringPtr(model.ErrorMessage)
+	}
+
+	return thrift
+}
+
+// ModelsToThrift 批量转换审计事件
+func (c *ConverterImpl) ModelsToThrift(events []*models.AuditEvent) []*identity_srv.AuditEvent {
+	result := make([]*identity_srv.AuditEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, c.ModelToThrift(event))
+	}
+
+	return result
+}
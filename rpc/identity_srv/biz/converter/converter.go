@@ -2,6 +2,7 @@ package converter
 
 import (
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/assignment"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/audit"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/authentication"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/base"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/definition"
@@ -82,6 +83,10 @@ type Converter interface {
 	Menu() menu.Converter
 	RoleDefinition() definition.Converter
 	UserRoleAssignment() assignment.Converter
+
+	// AuditEvent 审计事件转换器
+	// 负责 AuditEvent Model → Thrift DTO 的转换
+	AuditEvent() audit.Converter
 	// ============================================================================
 	// 基础设施转换器 - 通用工具
 	// ============================================================================
@@ -2,6 +2,7 @@ package converter
 
 import (
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/assignment"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/audit"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/authentication"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/base"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/definition"
@@ -49,6 +50,7 @@ type Impl struct {
 	menuConverter               menu.Converter
 	roleDefinitionConverter     definition.Converter
 	userRoleAssignmentConverter assignment.Converter
+	auditEventConverter         audit.Converter
 	// ============================================================================
 	// 基础设施转换器 - 通用工具
 	// ============================================================================
@@ -91,6 +93,7 @@ func NewConverter() Converter {
 		menuConverter:               menu.NewConverter(),
 		roleDefinitionConverter:     definition.NewConverter(enumConverter),
 		userRoleAssignmentConverter: assignment.NewConverter(),
+		auditEventConverter:         audit.NewConverter(),
 		// 基础设施转换器
 		enumConverter: enumConverter,
 		baseConverter: baseConverter,
@@ -154,6 +157,11 @@ func (c *Impl) UserRoleAssignment() assignment.Converter {
 	return c.userRoleAssignmentConverter
 }
 
+// AuditEvent 返回审计事件转换器
+func (c *Impl) AuditEvent() audit.Converter {
+	return c.auditEventConverter
+}
+
 // ============================================================================
 // 子转换器访问方法 - 基础设施
 // ============================================================================
@@ -35,20 +35,53 @@ func (c *ConverterImpl) ModelToThrift(
 		updatedBy = &s
 	}
 
+	var parentRoleID *string
+
+	if model.ParentRoleID != nil {
+		s := model.ParentRoleID.String()
+		parentRoleID = &s
+	}
+
 	status := c.converter.ModelRoleStatusToThrift(model.Status)
 
-	// 简化实现，直接返回基本字段，权限转换暂时跳过
-	return &identity_srv.RoleDefinition{
+	result := &identity_srv.RoleDefinition{
 		Id:           convutil.StringPtr(model.ID.String()),
 		Name:         convutil.StringPtr(model.Name),
 		Description:  convutil.StringPtr(model.Description),
 		Status:       &status,
-		Permissions:  []*identity_srv.Permission{}, // 暂时返回空数组
+		Permissions:  permissionsToThrift(model.Permissions),
 		IsSystemRole: model.IsSystemRole,
+		MfaRequired:  model.MFARequired,
 		CreatedBy:    createdBy,
 		UpdatedBy:    updatedBy,
 		CreatedAt:    &model.CreatedAt,
 		UpdatedAt:    &model.UpdatedAt,
 		UserCount:    &model.UserCount, // 新增：用户数量
+		ParentRoleID: parentRoleID,
 	}
+
+	// 生效权限仅在详情查询时计算
+	if model.EffectivePermissions != nil {
+		result.EffectivePermissions = permissionsToThrift(model.EffectivePermissions)
+	}
+
+	return result
+}
+
+// permissionsToThrift 转换权限列表
+func permissionsToThrift(permissions models.Permissions) []*identity_srv.Permission {
+	result := make([]*identity_srv.Permission, 0, len(permissions))
+	for _, p := range permissions {
+		if p == nil {
+			continue
+		}
+
+		result = append(result, &identity_srv.Permission{
+			Resource:    convutil.StringPtr(p.Resource),
+			Action:      convutil.StringPtr(p.Action),
+			Description: convutil.StringPtr(p.Description),
+		})
+	}
+
+	return result
 }
@@ -100,6 +100,7 @@ func (c *ConverterImpl) ModelUserProfileToThrift(
 	}
 
 	dto.MustChangePassword = model.MustChangePassword
+	dto.MfaEnabled = model.MFAEnabled
 
 	// 处理可选时间戳字段
 	if model.AccountExpiry != nil && *model.AccountExpiry > 0 {
@@ -144,7 +145,9 @@ func (c *ConverterImpl) CreateUserRequestToModel(
 	// 处理密码哈希
 	if req.Password != nil {
 		if hash, err := convutil.HashPassword(*req.Password); err == nil {
+			changedAt := models.GetCurrentTimestamp()
 			model.PasswordHash = hash
+			model.PasswordChangedAt = &changedAt
 		}
 	}
 
@@ -16,6 +16,15 @@ type UserRoleAssignmentQueryConditions struct {
 	// RoleID 角色ID，用于查询指定角色的分配情况
 	RoleID *string `json:"role_id,omitempty"`
 
+	// Scope 数据范围，仅返回范围内组织成员的角色分配，nil 表示不限制
+	Scope *base.DataScope `json:"-"`
+
+	// ExpiringBefore 仅返回在该时间（毫秒时间戳）之前过期的角色分配
+	ExpiringBefore *int64 `json:"expiring_before,omitempty"`
+
+	// OrganizationID 仅返回该组织内的角色分配
+	OrganizationID *string `json:"organization_id,omitempty"`
+
 	// Page 分页查询选项
 	Page *base.QueryOptions `json:"page,omitempty"`
 }
@@ -46,11 +55,11 @@ type UserRoleAssignmentRepository interface {
 		page *base.QueryOptions,
 	) ([]*models.UserRoleAssignment, *models.PageResult, error)
 
-	// FindByUserAndRole 查询指定用户和角色的分配记录
-	// 用于验证用户是否拥有某个角色，或获取具体的分配详情
+	// FindByUserAndRole 查询指定用户和角色在授权范围内的分配记录
+	// organizationID 为空时查询全局授权，用于验证用户是否拥有某个角色，或获取具体的分配详情
 	FindByUserAndRole(
 		ctx context.Context,
-		userID, roleID string,
+		userID, roleID, organizationID string,
 	) (*models.UserRoleAssignment, error)
 
 	// GetLastUserRoleAssignment 获取用户最后一次的角色分配信息
@@ -60,20 +69,21 @@ type UserRoleAssignmentRepository interface {
 		userID string,
 	) (*models.UserRoleAssignment, error)
 
-	// GetActiveRolesByUserID 获取用户所有活跃的角色ID列表（未删除）
+	// GetActiveRolesByUserID 获取用户所有活跃的角色ID列表（未删除且处于有效期内）
 	// 用于多角色权限合并场景，返回用户当前拥有的所有角色ID
 	GetActiveRolesByUserID(
 		ctx context.Context,
 		userID string,
 	) ([]string, error)
 
-	// GetActiveRoleIDsWithStatus 获取用户所有处于指定状态的角色ID列表
+	// GetActiveRoleIDsWithStatus 获取用户在指定组织内所有处于指定状态的角色ID列表
 	// 此方法会联表查询 user_role_assignments 和 role_definitions
-	// 只返回角色状态匹配的角色ID（通常用于获取 Active 状态的角色）
+	// 返回全局授权与该组织内授权的角色，organizationID 为空时只返回全局授权的角色
+	// 只返回角色状态匹配且分配处于有效期内的角色ID（通常用于获取 Active 状态的角色）
 	// 专门用于登录等需要验证角色可用性的场景
 	GetActiveRoleIDsWithStatus(
 		ctx context.Context,
-		userID string,
+		userID, organizationID string,
 		status models.RoleStatus,
 	) ([]string, error)
 
@@ -84,7 +94,7 @@ type UserRoleAssignmentRepository interface {
 		conditions *UserRoleAssignmentQueryConditions,
 	) ([]*models.UserRoleAssignment, *models.PageResult, error)
 
-	// GetRolesByUserIDs 批量查询多个用户的角色分配
+	// GetRolesByUserIDs 批量查询多个用户处于有效期内的角色分配
 	// 返回: map[userID][]roleID，避免 N+1 查询问题
 	GetRolesByUserIDs(
 		ctx context.Context,
@@ -95,9 +105,9 @@ type UserRoleAssignmentRepository interface {
 	// 业务验证方法
 	// ============================================================================
 
-	// CheckUserRoleExists 检查用户是否已分配指定角色
+	// CheckUserRoleExists 检查用户在授权范围内是否已分配指定角色，organizationID 为空时检查全局授权
 	// 用于分配前的重复性验证，避免重复分配
-	CheckUserRoleExists(ctx context.Context, userID, roleID string) (bool, error)
+	CheckUserRoleExists(ctx context.Context, userID, roleID, organizationID string) (bool, error)
 
 	// CountByUserID 统计指定用户的角色分配数量
 	// 用于用户角色概览和权限分析
@@ -124,17 +134,42 @@ type UserRoleAssignmentRepository interface {
 	// 用于用户离职或权限批量调整场景
 	BatchRevokeUserRoles(ctx context.Context, assignmentIDs []string) error
 
-	// GetAllUserIDsByRoleID 获取指定角色下所有用户ID（不分页）
+	// GetAllUserIDsByRoleID 获取指定角色下所有用户ID（不分页，包含尚未生效的分配）
 	// 用于获取某个角色下所有用户的场景
 	GetAllUserIDsByRoleID(ctx context.Context, roleID string) ([]string, error)
 
-	// ReplaceRoleUsers 批量替换角色的用户绑定（事务操作）
-	// 先删除该角色下所有旧的用户绑定，再创建新的用户绑定
-	// 用于批量更新角色用户的场景，确保数据一致性
+	// GetUserIDsByRoleAndOrganization 获取指定角色在授权范围内的用户ID（不分页，包含尚未生效的分配）
+	// organizationID 为空时只返回全局授权的用户，用于按授权范围替换角色绑定
+	GetUserIDsByRoleAndOrganization(ctx context.Context, roleID, organizationID string) ([]string, error)
+
+	// ListAllUserRoles 获取全部未删除且处于有效期内的用户角色分配（仅包含用户ID、角色ID与组织ID）
+	// 用于与 Casbin 分组策略进行全量对账
+	ListAllUserRoles(ctx context.Context) ([]*models.UserRoleAssignment, error)
+
+	// ReplaceRoleUsers 批量替换角色在授权范围内的用户绑定（事务操作）
+	// 先删除该角色在该范围内所有旧的用户绑定，再创建新的用户绑定，新绑定使用相同的有效期
+	// organizationID 为空时替换全局授权，用于批量更新角色用户的场景，确保数据一致性
 	ReplaceRoleUsers(
 		ctx context.Context,
-		roleID string,
+		roleID, organizationID string,
 		userIDs []string,
 		operatorID string,
+		effectiveFrom, expiresAt *int64,
 	) error
+
+	// ============================================================================
+	// 有效期相关方法
+	// ============================================================================
+
+	// HasEffectiveUserRole 检查用户当前在授权范围内是否持有处于有效期内的指定角色分配
+	// organizationID 为空时检查全局授权，用于决定是否保留对应的 Casbin 分组策略
+	HasEffectiveUserRole(ctx context.Context, userID, roleID, organizationID string) (bool, error)
+
+	// FindExpired 查询在指定时间之前已过期的角色分配，按过期时间升序，最多返回 limit 条
+	// 用于后台任务清理过期的临时授权
+	FindExpired(ctx context.Context, now int64, limit int) ([]*models.UserRoleAssignment, error)
+
+	// FindBecameEffective 查询生效时间落在 (since, now] 区间且尚未过期的角色分配（仅包含用户ID、角色ID与组织ID）
+	// 用于后台任务为到达生效时间的授权补写 Casbin 分组策略
+	FindBecameEffective(ctx context.Context, since, now int64) ([]*models.UserRoleAssignment, error)
 }
@@ -2,6 +2,7 @@ package assignment
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
@@ -64,15 +65,16 @@ func (r *UserRoleAssignmentRepositoryImpl) FindByRoleID(
 	return r.FindAll(ctx, opts)
 }
 
-// FindByUserAndRole 查询指定用户和角色的分配记录
+// FindByUserAndRole 查询指定用户和角色在授权范围内的分配记录
 func (r *UserRoleAssignmentRepositoryImpl) FindByUserAndRole(
 	ctx context.Context,
-	userID, roleID string,
+	userID, roleID, organizationID string,
 ) (*models.UserRoleAssignment, error) {
 	var assignment models.UserRoleAssignment
 
 	err := r.db.WithContext(ctx).
 		Where("user_id = ? AND role_id = ?", userID, roleID).
+		Scopes(inOrganization("user_role_assignments", organizationID)).
 		First(&assignment).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -114,10 +116,11 @@ func (r *UserRoleAssignmentRepositoryImpl) GetActiveRolesByUserID(
 ) ([]string, error) {
 	var assignments []models.UserRoleAssignment
 
-	// 查询用户所有活跃的角色分配
+	// 查询用户所有处于有效期内的角色分配
 	err := r.db.WithContext(ctx).
 		Select("role_id").
 		Where("user_id = ?", userID).
+		Scopes(effectiveAt("user_role_assignments", time.Now().UnixMilli())).
 		Find(&assignments).Error
 	if err != nil {
 		return nil, err
@@ -132,24 +135,28 @@ func (r *UserRoleAssignmentRepositoryImpl) GetActiveRolesByUserID(
 	return roleIDs, nil
 }
 
-// GetActiveRoleIDsWithStatus 获取用户所有处于指定状态的角色ID列表
+// GetActiveRoleIDsWithStatus 获取用户在指定组织内所有处于指定状态的角色ID列表
 // 此方法会联表查询 user_role_assignments 和 role_definitions
-// 只返回角色状态匹配的角色ID（通常用于获取 Active 状态的角色）
+// 返回全局授权与该组织内授权的角色，只返回角色状态匹配的角色ID（通常用于获取 Active 状态的角色）
 // 专门用于登录等需要验证角色可用性的场景
 func (r *UserRoleAssignmentRepositoryImpl) GetActiveRoleIDsWithStatus(
 	ctx context.Context,
-	userID string,
+	userID, organizationID string,
 	status models.RoleStatus,
 ) ([]string, error) {
 	var roleIDs []string
 
 	// 联表查询：user_role_assignments JOIN role_definitions
-	// 只返回匹配指定状态的角色ID
+	// 只返回匹配指定状态的角色ID，同一角色同时存在全局与组织授权时只返回一次
 	err := r.db.WithContext(ctx).
 		Model(&models.UserRoleAssignment{}).
-		Select("user_role_assignments.role_id").
+		Distinct("user_role_assignments.role_id").
 		Joins("JOIN role_definitions ON role_definitions.id = user_role_assignments.role_id").
 		Where("user_role_assignments.user_id = ?", userID).
+		Scopes(
+			effectiveAt("user_role_assignments", time.Now().UnixMilli()),
+			visibleInOrganization("user_role_assignments", organizationID),
+		).
 		Where("role_definitions.status = ?", status).
 		Where("role_definitions.deleted_at IS NULL").
 		Pluck("user_role_assignments.role_id", &roleIDs).Error
@@ -166,23 +173,37 @@ func (r *UserRoleAssignmentRepositoryImpl) FindWithConditions(
 	conditions *UserRoleAssignmentQueryConditions,
 ) ([]*models.UserRoleAssignment, *models.PageResult, error) {
 	opts := base.NewQueryOptions()
+	if conditions != nil && conditions.Page != nil {
+		opts = opts.WithPage(conditions.Page.Page, conditions.Page.PageSize).
+			WithOrder(conditions.Page.OrderBy, conditions.Page.OrderDesc)
+	}
 
-	if conditions != nil {
-		if conditions.UserID != nil {
-			opts = opts.WithFilter("user_id", *conditions.UserID)
-		}
+	baseRepo := r.BaseRepository.(*base.BaseRepositoryImpl[models.UserRoleAssignment])
+
+	qb := baseRepo.NewQueryBuilder(ctx).
+		WithSoftDelete(opts)
 
-		if conditions.RoleID != nil {
-			opts = opts.WithFilter("role_id", *conditions.RoleID)
+	if conditions != nil {
+		qb = qb.WhereEqual("user_id", conditions.UserID).
+			WhereEqual("role_id", conditions.RoleID).
+			WhereEqual("organization_id", conditions.OrganizationID)
+
+		if conditions.ExpiringBefore != nil {
+			qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
+				return db.Where("user_role_assignments.expires_at IS NOT NULL AND user_role_assignments.expires_at < ?",
+					*conditions.ExpiringBefore)
+			})
 		}
 
-		if conditions.Page != nil {
-			opts = opts.WithPage(conditions.Page.Page, conditions.Page.PageSize).
-				WithOrder(conditions.Page.OrderBy, conditions.Page.OrderDesc)
+		// 数据范围过滤：按被分配用户的组织成员关系限制
+		if conditions.Scope != nil {
+			qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
+				return conditions.Scope.ApplyMember(db, "user_role_assignments.user_id")
+			})
 		}
 	}
 
-	return r.FindAll(ctx, opts)
+	return qb.WithOrder(opts).FindWithPagination(opts)
 }
 
 // GetRolesByUserIDs 批量查询多个用户的角色分配
@@ -201,6 +222,7 @@ func (r *UserRoleAssignmentRepositoryImpl) GetRolesByUserIDs(
 	err := r.db.WithContext(ctx).
 		Select("user_id, role_id").
 		Where("user_id IN ?", userIDs).
+		Scopes(effectiveAt("user_role_assignments", time.Now().UnixMilli())).
 		Find(&assignments).Error
 	if err != nil {
 		return nil, err
@@ -222,16 +244,17 @@ func (r *UserRoleAssignmentRepositoryImpl) GetRolesByUserIDs(
 // 业务验证方法
 // ============================================================================
 
-// CheckUserRoleExists 检查用户是否已分配指定角色
+// CheckUserRoleExists 检查用户在授权范围内是否已分配指定角色
 func (r *UserRoleAssignmentRepositoryImpl) CheckUserRoleExists(
 	ctx context.Context,
-	userID, roleID string,
+	userID, roleID, organizationID string,
 ) (bool, error) {
 	var count int64
 
 	err := r.db.WithContext(ctx).
 		Model(&models.UserRoleAssignment{}).
 		Where("user_id = ? AND role_id = ?", userID, roleID).
+		Scopes(inOrganization("user_role_assignments", organizationID)).
 		Count(&count).Error
 	if err != nil {
 		return false, err
@@ -325,21 +348,67 @@ func (r *UserRoleAssignmentRepositoryImpl) GetAllUserIDsByRoleID(
 	return userIDs, nil
 }
 
-// ReplaceRoleUsers 批量替换角色的用户绑定（事务操作）
+// GetUserIDsByRoleAndOrganization 获取指定角色在授权范围内的用户ID（不分页）
+func (r *UserRoleAssignmentRepositoryImpl) GetUserIDsByRoleAndOrganization(
+	ctx context.Context,
+	roleID, organizationID string,
+) ([]string, error) {
+	var userIDs []string
+
+	err := r.db.WithContext(ctx).
+		Model(&models.UserRoleAssignment{}).
+		Where("role_id = ?", roleID).
+		Scopes(inOrganization("user_role_assignments", organizationID)).
+		Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+// ListAllUserRoles 获取全部未删除且处于有效期内的用户角色分配
+func (r *UserRoleAssignmentRepositoryImpl) ListAllUserRoles(
+	ctx context.Context,
+) ([]*models.UserRoleAssignment, error) {
+	var assignments []*models.UserRoleAssignment
+
+	err := r.db.WithContext(ctx).
+		Select("user_id", "role_id", "organization_id").
+		Scopes(effectiveAt("user_role_assignments", time.Now().UnixMilli())).
+		Find(&assignments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// ReplaceRoleUsers 批量替换角色在授权范围内的用户绑定（事务操作）
 func (r *UserRoleAssignmentRepositoryImpl) ReplaceRoleUsers(
 	ctx context.Context,
-	roleID string,
+	roleID, organizationID string,
 	userIDs []string,
 	operatorID string,
+	effectiveFrom, expiresAt *int64,
 ) error {
+	var orgID *uuid.UUID
+	if organizationID != "" {
+		parsed := uuid.MustParse(organizationID)
+		orgID = &parsed
+	}
+
 	// 使用事务确保数据一致性
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// 1. 删除该角色下所有旧的用户绑定
-		if err := tx.Where("role_id = ?", roleID).Delete(&models.UserRoleAssignment{}).Error; err != nil {
+		// 1. 删除该角色在授权范围内所有旧的用户绑定
+		err := tx.Where("role_id = ?", roleID).
+			Scopes(inOrganization("user_role_assignments", organizationID)).
+			Delete(&models.UserRoleAssignment{}).Error
+		if err != nil {
 			return err
 		}
 
-		// 2. 如果没有新的用户列表，直接返回（清空该角色的所有用户）
+		// 2. 如果没有新的用户列表，直接返回（清空该范围内角色的所有用户）
 		if len(userIDs) == 0 {
 			return nil
 		}
@@ -348,8 +417,11 @@ func (r *UserRoleAssignmentRepositoryImpl) ReplaceRoleUsers(
 		assignments := make([]*models.UserRoleAssignment, 0, len(userIDs))
 		for _, userID := range userIDs {
 			assignment := &models.UserRoleAssignment{
-				UserID: uuid.MustParse(userID),
-				RoleID: uuid.MustParse(roleID),
+				UserID:         uuid.MustParse(userID),
+				RoleID:         uuid.MustParse(roleID),
+				OrganizationID: orgID,
+				EffectiveFrom:  effectiveFrom,
+				ExpiresAt:      expiresAt,
 			}
 			if operatorID != "" {
 				createdByUUID := uuid.MustParse(operatorID)
@@ -367,3 +439,96 @@ func (r *UserRoleAssignmentRepositoryImpl) ReplaceRoleUsers(
 		return nil
 	})
 }
+
+// HasEffectiveUserRole 检查用户当前在授权范围内是否持有处于有效期内的指定角色分配
+func (r *UserRoleAssignmentRepositoryImpl) HasEffectiveUserRole(
+	ctx context.Context,
+	userID, roleID, organizationID string,
+) (bool, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&models.UserRoleAssignment{}).
+		Where("user_id = ? AND role_id = ?", userID, roleID).
+		Scopes(
+			effectiveAt("user_role_assignments", time.Now().UnixMilli()),
+			inOrganization("user_role_assignments", organizationID),
+		).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// FindExpired 查询在指定时间之前已过期的角色分配
+func (r *UserRoleAssignmentRepositoryImpl) FindExpired(
+	ctx context.Context,
+	now int64,
+	limit int,
+) ([]*models.UserRoleAssignment, error) {
+	var assignments []*models.UserRoleAssignment
+
+	err := r.db.WithContext(ctx).
+		Where("expires_at IS NOT NULL AND expires_at <= ?", now).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&assignments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// FindBecameEffective 查询生效时间落在 (since, now] 区间且尚未过期的角色分配
+func (r *UserRoleAssignmentRepositoryImpl) FindBecameEffective(
+	ctx context.Context,
+	since, now int64,
+) ([]*models.UserRoleAssignment, error) {
+	var assignments []*models.UserRoleAssignment
+
+	err := r.db.WithContext(ctx).
+		Select("user_id", "role_id", "organization_id").
+		Where("effective_from > ? AND effective_from <= ?", since, now).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Find(&assignments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+// effectiveAt 限定分配在指定时间（毫秒时间戳）处于有效期内
+func effectiveAt(table string, now int64) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Where("("+table+".effective_from IS NULL OR "+table+".effective_from <= ?)", now).
+			Where("("+table+".expires_at IS NULL OR "+table+".expires_at > ?)", now)
+	}
+}
+
+// inOrganization 限定分配属于指定授权范围，organizationID 为空时限定为全局授权
+func inOrganization(table, organizationID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if organizationID == "" {
+			return db.Where(table + ".organization_id IS NULL")
+		}
+
+		return db.Where(table+".organization_id = ?", organizationID)
+	}
+}
+
+// visibleInOrganization 限定分配在指定组织内生效：全局授权与该组织内的授权
+// organizationID 为空时只包含全局授权
+func visibleInOrganization(table, organizationID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if organizationID == "" {
+			return db.Where(table + ".organization_id IS NULL")
+		}
+
+		return db.Where("("+table+".organization_id IS NULL OR "+table+".organization_id = ?)", organizationID)
+	}
+}
@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+)
+
+// AuditEventQueryConditions 审计事件查询条件
+// 对应 IDL 中的 ListAuditEventsRequest，用于仓储层查询
+type AuditEventQueryConditions struct {
+	// ActorID 操作者用户ID
+	ActorID *string `json:"actor_id,omitempty"`
+
+	// Action 操作名称
+	Action *string `json:"action,omitempty"`
+
+	// TargetType 操作对象类型
+	TargetType *string `json:"target_type,omitempty"`
+
+	// TargetID 操作对象ID
+	TargetID *string `json:"target_id,omitempty"`
+
+	// Result 执行结果
+	Result *string `json:"result,omitempty"`
+
+	// RequestID 请求ID
+	RequestID *string `json:"request_id,omitempty"`
+
+	// StartTime 起始时间（含，毫秒时间戳）
+	StartTime *int64 `json:"start_time,omitempty"`
+
+	// EndTime 截止时间（不含，毫秒时间戳）
+	EndTime *int64 `json:"end_time,omitempty"`
+
+	// Page 分页查询选项
+	Page *base.QueryOptions `json:"page,omitempty"`
+}
+
+// AuditEventRepository 审计事件仓储接口
+// 审计事件只追加不修改
+type AuditEventRepository interface {
+	// 嵌入基础仓储接口
+	base.BaseRepository[models.AuditEvent]
+
+	// FindWithConditions 按条件分页查询审计事件，默认按发生时间倒序
+	FindWithConditions(
+		ctx context.Context,
+		conditions *AuditEventQueryConditions,
+	) ([]*models.AuditEvent, *models.PageResult, error)
+}
@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"gorm.io/gorm"
+)
+
+// AuditEventRepositoryImpl 审计事件仓储实现
+type AuditEventRepositoryImpl struct {
+	base.BaseRepository[models.AuditEvent]
+	db *gorm.DB
+}
+
+// NewAuditEventRepository 创建审计事件仓储实例
+func NewAuditEventRepository(db *gorm.DB) AuditEventRepository {
+	return &AuditEventRepositoryImpl{
+		BaseRepository: base.NewBaseRepository[models.AuditEvent](db),
+		db:             db,
+	}
+}
+
+// FindWithConditions 按条件分页查询审计事件，默认按发生时间倒序
+func (r *AuditEventRepositoryImpl) FindWithConditions(
+	ctx context.Context,
+	conditions *AuditEventQueryConditions,
+) ([]*models.AuditEvent, *models.PageResult, error) {
+	opts := base.NewQueryOptions()
+	if conditions != nil && conditions.Page != nil {
+		opts = opts.WithPage(conditions.Page.Page, conditions.Page.PageSize).
+			WithOrder(conditions.Page.OrderBy, conditions.Page.OrderDesc)
+	}
+
+	baseRepo := r.BaseRepository.(*base.BaseRepositoryImpl[models.AuditEvent])
+
+	qb := baseRepo.NewQueryBuilder(ctx)
+
+	if conditions != nil {
+		qb = qb.WhereEqual("actor_id", conditions.ActorID).
+			WhereEqual("action", conditions.Action).
+			WhereEqual("target_type", conditions.TargetType).
+			WhereEqual("target_id", conditions.TargetID).
+			WhereEqual("result", conditions.Result).
+			WhereEqual("request_id", conditions.RequestID)
+
+		if conditions.StartTime != nil {
+			qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
+				return db.Where("created_at >= ?", *conditions.StartTime)
+			})
+		}
+
+		if conditions.EndTime != nil {
+			qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
+				return db.Where("created_at < ?", *conditions.EndTime)
+			})
+		}
+	}
+
+	return qb.WithOrder(opts).FindWithPagination(opts)
+}
@@ -0,0 +1,52 @@
+package base
+
+import (
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"gorm.io/gorm"
+)
+
+// DataScope 行级数据范围
+// 由业务层根据调用方的角色与成员关系解析，nil 表示不限制
+type DataScope struct {
+	// OrganizationIDs 允许访问的组织ID，为空时不返回任何数据
+	OrganizationIDs []string
+}
+
+// ApplyOrganization 按组织ID列限制查询范围
+//
+// 示例：
+//
+//	scope.ApplyOrganization(db, "organizations.id")
+func (s *DataScope) ApplyOrganization(db *gorm.DB, column string) *gorm.DB {
+	if s == nil {
+		return db
+	}
+
+	if len(s.OrganizationIDs) == 0 {
+		return db.Where("1 = 0")
+	}
+
+	return db.Where(column+" IN ?", s.OrganizationIDs)
+}
+
+// ApplyMember 按用户ID列限制查询范围：用户需在范围内任一组织中存在活跃的成员关系
+//
+// 示例：
+//
+//	scope.ApplyMember(db, "user_profiles.id")
+func (s *DataScope) ApplyMember(db *gorm.DB, column string) *gorm.DB {
+	if s == nil {
+		return db
+	}
+
+	if len(s.OrganizationIDs) == 0 {
+		return db.Where("1 = 0")
+	}
+
+	members := db.Session(&gorm.Session{NewDB: true}).
+		Model(&models.UserMembership{}).
+		Select("user_id").
+		Where("organization_id IN ? AND status = ?", s.OrganizationIDs, models.MembershipStatusActive)
+
+	return db.Where(column+" IN (?)", members)
+}
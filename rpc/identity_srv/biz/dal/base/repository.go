@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
 	"gorm.io/gorm"
@@ -102,6 +103,7 @@ type BaseRepository[T any] interface {
 	SoftDelete(ctx context.Context, id string) error
 	HardDelete(ctx context.Context, id string) error
 	Restore(ctx context.Context, id string) error
+	PurgeDeleted(ctx context.Context, deletedBefore time.Time, batchSize int) (int64, error)
 
 	// 批量操作
 	BatchCreate(ctx context.Context, entities []*T) error
@@ -583,6 +585,48 @@ func (r *BaseRepositoryImpl[T]) Restore(ctx context.Context, id string) error {
 	return nil
 }
 
+// PurgeDeleted 物理删除软删除时间早于 deletedBefore 的实体，返回删除的记录数
+// 按 batchSize 分批删除，避免单条语句长时间锁表；模型不支持软删除时不做任何操作
+func (r *BaseRepositoryImpl[T]) PurgeDeleted(
+	ctx context.Context,
+	deletedBefore time.Time,
+	batchSize int,
+) (int64, error) {
+	if !r.hasSoftDelete() {
+		return 0, nil
+	}
+
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+
+	deletedAtCol := r.getDeletedAtColumn()
+
+	var total int64
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+
+		batch := r.db.WithContext(ctx).Unscoped().Model(new(T)).
+			Select("id").
+			Where(fmt.Sprintf("%s IS NOT NULL AND %s < ?", deletedAtCol, deletedAtCol), deletedBefore).
+			Limit(batchSize)
+
+		result := r.db.WithContext(ctx).Unscoped().Where("id IN (?)", batch).Delete(new(T))
+		if result.Error != nil {
+			return total, result.Error
+		}
+
+		total += result.RowsAffected
+
+		if result.RowsAffected < int64(batchSize) {
+			return total, nil
+		}
+	}
+}
+
 // BatchSoftDelete 批量软删除实体
 func (r *BaseRepositoryImpl[T]) BatchSoftDelete(ctx context.Context, ids []string) error {
 	if len(ids) == 0 {
@@ -4,12 +4,17 @@ import (
 	"context"
 
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/assignment"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/audit"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/definition"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/department"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/job"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/logo"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/membership"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/menu"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/mfa"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/organization"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/password"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/policy"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/user"
 	"gorm.io/gorm"
 )
@@ -46,6 +51,21 @@ type DAL interface {
 	// UserRoleAssignment 用户角色分配仓储
 	UserRoleAssignment() assignment.UserRoleAssignmentRepository
 
+	// MFARecoveryCode 多因素认证恢复码仓储
+	MFARecoveryCode() mfa.MFARecoveryCodeRepository
+
+	// PasswordHistory 密码历史仓储
+	PasswordHistory() password.PasswordHistoryRepository
+
+	// CasbinRule Casbin 策略规则仓储
+	CasbinRule() policy.CasbinRuleRepository
+
+	// AuditEvent 审计事件仓储
+	AuditEvent() audit.AuditEventRepository
+
+	// JobRun 定时任务执行记录仓储
+	JobRun() job.JobRunRepository
+
 	// ============================================================================
 	// 事务管理
 	// ============================================================================
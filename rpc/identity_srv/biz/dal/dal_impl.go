@@ -4,13 +4,18 @@ import (
 	"context"
 
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/assignment"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/audit"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/definition"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/department"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/job"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/logo"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/membership"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/menu"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/mfa"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/organization"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/password"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/policy"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/user"
 	"gorm.io/gorm"
 )
@@ -33,6 +38,11 @@ type DALImpl struct {
 	menuRepo               menu.MenuRepository
 	roleDefinitionRepo     definition.RoleDefinitionRepository
 	userRoleAssignmentRepo assignment.UserRoleAssignmentRepository
+	mfaRecoveryCodeRepo    mfa.MFARecoveryCodeRepository
+	passwordHistoryRepo    password.PasswordHistoryRepository
+	casbinRuleRepo         policy.CasbinRuleRepository
+	auditEventRepo         audit.AuditEventRepository
+	jobRunRepo             job.JobRunRepository
 
 	// 事务状态
 	isTransaction bool
@@ -51,6 +61,11 @@ func newDALImpl(db *gorm.DB) DAL {
 		menuRepo:               menu.NewMenuRepository(db),
 		roleDefinitionRepo:     definition.NewRoleDefinitionRepository(db),
 		userRoleAssignmentRepo: assignment.NewUserRoleAssignmentRepository(db),
+		mfaRecoveryCodeRepo:    mfa.NewMFARecoveryCodeRepository(db),
+		passwordHistoryRepo:    password.NewPasswordHistoryRepository(db),
+		casbinRuleRepo:         policy.NewCasbinRuleRepository(db),
+		auditEventRepo:         audit.NewAuditEventRepository(db),
+		jobRunRepo:             job.NewJobRunRepository(db),
 		isTransaction:          false,
 	}
 }
@@ -99,6 +114,31 @@ func (dal *DALImpl) UserRoleAssignment() assignment.UserRoleAssignmentRepository
 	return dal.userRoleAssignmentRepo
 }
 
+// MFARecoveryCode 获取多因素认证恢复码仓储
+func (dal *DALImpl) MFARecoveryCode() mfa.MFARecoveryCodeRepository {
+	return dal.mfaRecoveryCodeRepo
+}
+
+// PasswordHistory 获取密码历史仓储
+func (dal *DALImpl) PasswordHistory() password.PasswordHistoryRepository {
+	return dal.passwordHistoryRepo
+}
+
+// CasbinRule 获取 Casbin 策略规则仓储
+func (dal *DALImpl) CasbinRule() policy.CasbinRuleRepository {
+	return dal.casbinRuleRepo
+}
+
+// AuditEvent 获取审计事件仓储
+func (dal *DALImpl) AuditEvent() audit.AuditEventRepository {
+	return dal.auditEventRepo
+}
+
+// JobRun 获取定时任务执行记录仓储
+func (dal *DALImpl) JobRun() job.JobRunRepository {
+	return dal.jobRunRepo
+}
+
 // ============================================================================
 // 事务管理实现
 // ============================================================================
@@ -171,6 +211,11 @@ func (dal *DALImpl) WithDB(db *gorm.DB) DAL {
 		menuRepo:               menu.NewMenuRepository(db),
 		roleDefinitionRepo:     definition.NewRoleDefinitionRepository(db),
 		userRoleAssignmentRepo: assignment.NewUserRoleAssignmentRepository(db),
+		mfaRecoveryCodeRepo:    mfa.NewMFARecoveryCodeRepository(db),
+		passwordHistoryRepo:    password.NewPasswordHistoryRepository(db),
+		casbinRuleRepo:         policy.NewCasbinRuleRepository(db),
+		auditEventRepo:         audit.NewAuditEventRepository(db),
+		jobRunRepo:             job.NewJobRunRepository(db),
 		isTransaction:          dal.isTransaction,
 	}
 }
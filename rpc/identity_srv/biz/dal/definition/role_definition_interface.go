@@ -37,6 +37,10 @@ type RoleDefinitionRepository interface {
 	// 由于角色名称具有唯一索引，此方法返回单个结果或 nil
 	FindByName(ctx context.Context, name string) (*models.RoleDefinition, error)
 
+	// GetByIDForUpdate 根据ID查询角色定义并加行锁（SELECT ... FOR UPDATE），锁在事务结束时释放
+	// 须在事务中调用，用于校验角色继承关系时防止并发修改继承链
+	GetByIDForUpdate(ctx context.Context, id string) (*models.RoleDefinition, error)
+
 	// CheckNameExists 检查指定角色名称是否已存在
 	// 用于创建角色前的唯一性验证，避免数据库约束冲突
 	CheckNameExists(ctx context.Context, name string) (bool, error)
@@ -72,6 +76,10 @@ type RoleDefinitionRepository interface {
 	// 用于仪表板统计和数据分析
 	CountByStatus(ctx context.Context, status models.RoleStatus) (int64, error)
 
+	// CountByParentRoleID 统计直接继承指定角色的子角色数量
+	// 用于删除角色前检查是否仍被其他角色继承
+	CountByParentRoleID(ctx context.Context, parentRoleID string) (int64, error)
+
 	// ListActiveRoles 列出所有活跃状态的角色定义
 	// 常用于角色分配场景，只显示可用角色
 	ListActiveRoles(
@@ -6,6 +6,7 @@ import (
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // RoleDefinitionRepositoryImpl 角色定义仓储实现
@@ -48,6 +49,24 @@ func (r *RoleDefinitionRepositoryImpl) FindByName(
 	return &role, nil
 }
 
+// GetByIDForUpdate 根据ID查询角色定义并加行锁，锁在事务结束时释放
+func (r *RoleDefinitionRepositoryImpl) GetByIDForUpdate(
+	ctx context.Context,
+	id string,
+) (*models.RoleDefinition, error) {
+	var role models.RoleDefinition
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&role).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
 // CheckNameExists 检查指定角色名称是否已存在
 func (r *RoleDefinitionRepositoryImpl) CheckNameExists(
 	ctx context.Context,
@@ -147,6 +166,15 @@ func (r *RoleDefinitionRepositoryImpl) CountByStatus(
 	return r.Count(ctx, opts)
 }
 
+// CountByParentRoleID 统计直接继承指定角色的子角色数量
+func (r *RoleDefinitionRepositoryImpl) CountByParentRoleID(
+	ctx context.Context,
+	parentRoleID string,
+) (int64, error) {
+	opts := base.NewQueryOptions().WithFilter("parent_role_id", parentRoleID)
+	return r.Count(ctx, opts)
+}
+
 // ListActiveRoles 列出所有活跃状态的角色定义
 func (r *RoleDefinitionRepositoryImpl) ListActiveRoles(
 	ctx context.Context,
@@ -117,10 +117,11 @@ type DepartmentRepository interface {
 // DepartmentQueryConditions 部门查询条件
 // 支持多条件组合查询，提供灵活的查询能力
 type DepartmentQueryConditions struct {
-	Name           *string // 部门名称（模糊匹配）
-	OrganizationID *string // 组织ID
-	DepartmentType *string // 部门类型
-	EquipmentID    *string // 设备ID（需JOIN department_equipment表）
+	Name           *string         // 部门名称（模糊匹配）
+	OrganizationID *string         // 组织ID
+	DepartmentType *string         // 部门类型
+	EquipmentID    *string         // 设备ID（需JOIN department_equipment表）
+	Scope          *base.DataScope // 数据范围（按所属组织过滤，nil 表示不限制）
 	Page           *base.QueryOptions
 }
 
@@ -519,6 +519,9 @@ func (r *DepartmentRepositoryImpl) FindWithConditions(
 		if conditions.Name != nil && *conditions.Name != "" {
 			query = query.Where("name LIKE ?", "%"+*conditions.Name+"%")
 		}
+
+		// 数据范围过滤
+		query = conditions.Scope.ApplyOrganization(query, "organization_id")
 	}
 
 	// 处理搜索条件
@@ -561,6 +564,8 @@ func (r *DepartmentRepositoryImpl) FindWithConditions(
 		if conditions.Name != nil && *conditions.Name != "" {
 			countQuery = countQuery.Where("name LIKE ?", "%"+*conditions.Name+"%")
 		}
+
+		countQuery = conditions.Scope.ApplyOrganization(countQuery, "organization_id")
 	}
 
 	if opts.Search != "" {
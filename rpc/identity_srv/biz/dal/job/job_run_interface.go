@@ -0,0 +1,26 @@
+package job
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+)
+
+// JobRunRepository 定时任务执行记录仓储接口
+type JobRunRepository interface {
+	// 嵌入基础仓储接口
+	base.BaseRepository[models.JobRun]
+
+	// TryStart 登记一次任务执行，同一任务同一调度时间已有记录时返回 false
+	TryStart(ctx context.Context, run *models.JobRun) (bool, error)
+
+	// Finish 更新任务执行结果
+	Finish(ctx context.Context, run *models.JobRun) error
+
+	// GetLastSucceeded 获取任务最近一次执行成功的记录，不存在时返回 nil
+	GetLastSucceeded(ctx context.Context, jobName string) (*models.JobRun, error)
+
+	// DeleteStartedBefore 删除任务开始时间早于 before（毫秒）的执行记录
+	DeleteStartedBefore(ctx context.Context, jobName string, before int64) (int64, error)
+}
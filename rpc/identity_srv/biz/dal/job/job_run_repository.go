@@ -0,0 +1,85 @@
+package job
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// JobRunRepositoryImpl 定时任务执行记录仓储实现
+type JobRunRepositoryImpl struct {
+	base.BaseRepository[models.JobRun]
+	db *gorm.DB
+}
+
+// NewJobRunRepository 创建定时任务执行记录仓储实例
+func NewJobRunRepository(db *gorm.DB) JobRunRepository {
+	return &JobRunRepositoryImpl{
+		BaseRepository: base.NewBaseRepository[models.JobRun](db),
+		db:             db,
+	}
+}
+
+// TryStart 登记一次任务执行，同一任务同一调度时间已有记录时返回 false
+func (r *JobRunRepositoryImpl) TryStart(ctx context.Context, run *models.JobRun) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "job_name"}, {Name: "scheduled_at"}},
+			DoNothing: true,
+		}).
+		Create(run)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// Finish 更新任务执行结果
+func (r *JobRunRepositoryImpl) Finish(ctx context.Context, run *models.JobRun) error {
+	return r.db.WithContext(ctx).
+		Model(&models.JobRun{}).
+		Where("id = ?", run.ID).
+		Updates(map[string]interface{}{
+			"finished_at":   run.FinishedAt,
+			"status":        run.Status,
+			"affected":      run.Affected,
+			"error_message": run.ErrorMessage,
+		}).Error
+}
+
+// GetLastSucceeded 获取任务最近一次执行成功的记录，不存在时返回 nil
+func (r *JobRunRepositoryImpl) GetLastSucceeded(ctx context.Context, jobName string) (*models.JobRun, error) {
+	var runs []*models.JobRun
+
+	err := r.db.WithContext(ctx).
+		Where("job_name = ? AND status = ?", jobName, models.JobRunStatusSuccess).
+		Order("started_at DESC").
+		Limit(1).
+		Find(&runs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(runs) == 0 {
+		return nil, nil
+	}
+
+	return runs[0], nil
+}
+
+// DeleteStartedBefore 删除任务开始时间早于 before（毫秒）的执行记录
+func (r *JobRunRepositoryImpl) DeleteStartedBefore(
+	ctx context.Context,
+	jobName string,
+	before int64,
+) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("job_name = ? AND started_at < ?", jobName, before).
+		Delete(&models.JobRun{})
+
+	return result.RowsAffected, result.Error
+}
@@ -20,19 +20,47 @@ type MenuRepository interface {
 	// GetAllVersions retrieves a list of all unique menu version identifiers, sorted descending.
 	GetAllVersions(ctx context.Context) ([]string, error)
 
-	// GetLatestMenuTree retrieves the full menu tree for the most recent version.
+	// GetLatestMenuTree retrieves the full menu tree for the active version.
 	// It fetches the flat list from the DB and constructs a tree structure.
 	GetLatestMenuTree(ctx context.Context) ([]*models.Menu, error)
 
+	// GetMenusByVersion 获取指定版本的全部菜单节点（扁平列表，按排序字段升序）
+	GetMenusByVersion(ctx context.Context, version string) ([]*models.Menu, error)
+
+	// GetActiveVersion 获取当前生效的菜单版本
+	// 未显式激活过任何版本时以最近上传的版本为准，没有任何菜单时返回 gorm.ErrRecordNotFound
+	GetActiveVersion(ctx context.Context) (string, error)
+
+	// ListVersions 列出全部菜单版本及其上传、激活信息，按上传时间倒序排列
+	ListVersions(ctx context.Context) ([]*MenuVersionSummary, error)
+
+	// CreateVersion 记录新上传版本的元数据，应与 CreateMenuTree 在同一事务中调用
+	CreateVersion(ctx context.Context, version string, uploadedBy *uuid.UUID) error
+
+	// ActivateVersion 将指定版本设为生效版本，版本不存在时返回 gorm.ErrRecordNotFound
+	ActivateVersion(ctx context.Context, version string, operatorID *uuid.UUID) error
+
 	// GetBySemanticID 根据语义ID和版本查询菜单
 	// semanticID: 语义化菜单ID (来自menu.yaml)
-	// version: 菜单版本，如果为空则使用最新版本
+	// version: 菜单版本，如果为空则使用生效版本
 	GetBySemanticID(ctx context.Context, semanticID string, version string) (*models.Menu, error)
 
-	// GetBySemanticIDs 批量根据语义ID查询菜单（使用最新版本）
+	// GetBySemanticIDs 批量根据语义ID查询菜单（使用生效版本）
 	// semanticIDs: 语义化菜单ID列表
 	GetBySemanticIDs(ctx context.Context, semanticIDs []string) ([]*models.Menu, error)
 
-	// GetLatestSemanticIDMapping 获取最新版本的语义ID到UUID的映射
+	// GetLatestSemanticIDMapping 获取生效版本的语义ID到UUID的映射
 	GetLatestSemanticIDMapping(ctx context.Context) (map[string]uuid.UUID, error)
 }
+
+// MenuVersionSummary 菜单版本概要
+// 版本及节点数来自 menus 表，上传者与激活信息来自 menu_versions 表（早期上传的版本可能没有元数据）
+type MenuVersionSummary struct {
+	Version     string     `gorm:"column:version"`
+	MenuCount   int64      `gorm:"column:menu_count"`
+	UploadedAt  int64      `gorm:"column:uploaded_at"`
+	UploadedBy  *uuid.UUID `gorm:"column:uploaded_by"`
+	IsActive    bool       `gorm:"column:is_active"`
+	ActivatedAt int64      `gorm:"column:activated_at"`
+	ActivatedBy *uuid.UUID `gorm:"column:activated_by"`
+}
@@ -2,11 +2,14 @@ package menu
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // menuRepository implements the MenuRepository interface.
@@ -51,34 +54,21 @@ func (r *menuRepository) GetAllVersions(ctx context.Context) ([]string, error) {
 	return versions, err
 }
 
-// GetLatestMenuTree retrieves the full menu tree for the most recent version.
+// GetLatestMenuTree retrieves the full menu tree for the active version.
 func (r *menuRepository) GetLatestMenuTree(ctx context.Context) ([]*models.Menu, error) {
-	// Step 1: Find the latest version string based on creation time.
-	var latestVersion string
-
-	err := r.db.WithContext(ctx).
-		Model(&models.Menu{}).
-		Order("created_at DESC").
-		Limit(1).
-		Pluck("version", &latestVersion).
-		Error
-	if err != nil {
-		return nil, err
-	}
-
-	if latestVersion == "" {
+	// Step 1: Resolve the active version.
+	activeVersion, err := r.GetActiveVersion(ctx)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
 		// No menus found in the database.
 		return []*models.Menu{}, nil
 	}
 
-	// Step 2: Fetch all nodes for the latest version.
-	var menus []*models.Menu
+	if err != nil {
+		return nil, err
+	}
 
-	err = r.db.WithContext(ctx).
-		Where("version = ?", latestVersion).
-		Order("sort ASC").
-		Find(&menus).
-		Error
+	// Step 2: Fetch all nodes for the active version.
+	menus, err := r.GetMenusByVersion(ctx, activeVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -119,9 +109,9 @@ func (r *menuRepository) GetBySemanticID(
 		return nil, gorm.ErrRecordNotFound
 	}
 
-	// 如果版本为空，获取最新版本
+	// 如果版本为空，获取生效版本
 	if version == "" {
-		latestVersion, err := r.getLatestVersion(ctx)
+		latestVersion, err := r.GetActiveVersion(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -142,7 +132,7 @@ func (r *menuRepository) GetBySemanticID(
 	return &menu, nil
 }
 
-// GetBySemanticIDs 批量根据语义ID查询菜单（使用最新版本）
+// GetBySemanticIDs 批量根据语义ID查询菜单（使用生效版本）
 func (r *menuRepository) GetBySemanticIDs(
 	ctx context.Context,
 	semanticIDs []string,
@@ -151,8 +141,8 @@ func (r *menuRepository) GetBySemanticIDs(
 		return []*models.Menu{}, nil
 	}
 
-	// 获取最新版本
-	latestVersion, err := r.getLatestVersion(ctx)
+	// 获取生效版本
+	latestVersion, err := r.GetActiveVersion(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -170,12 +160,12 @@ func (r *menuRepository) GetBySemanticIDs(
 	return menus, nil
 }
 
-// GetLatestSemanticIDMapping 获取最新版本的语义ID到UUID的映射
+// GetLatestSemanticIDMapping 获取生效版本的语义ID到UUID的映射
 func (r *menuRepository) GetLatestSemanticIDMapping(
 	ctx context.Context,
 ) (map[string]uuid.UUID, error) {
-	// 获取最新版本
-	latestVersion, err := r.getLatestVersion(ctx)
+	// 获取生效版本
+	latestVersion, err := r.GetActiveVersion(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -205,6 +195,140 @@ func (r *menuRepository) GetLatestSemanticIDMapping(
 	return mapping, nil
 }
 
+// GetMenusByVersion 获取指定版本的全部菜单节点
+func (r *menuRepository) GetMenusByVersion(
+	ctx context.Context,
+	version string,
+) ([]*models.Menu, error) {
+	var menus []*models.Menu
+
+	err := r.db.WithContext(ctx).
+		Where("version = ?", version).
+		Order("sort ASC").
+		Find(&menus).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return menus, nil
+}
+
+// GetActiveVersion 获取当前生效的菜单版本
+func (r *menuRepository) GetActiveVersion(ctx context.Context) (string, error) {
+	var activeVersion string
+
+	err := r.db.WithContext(ctx).
+		Model(&models.MenuVersion{}).
+		Where("is_active = ?", true).
+		Limit(1).
+		Pluck("version", &activeVersion).
+		Error
+	if err != nil {
+		return "", err
+	}
+
+	if activeVersion != "" {
+		return activeVersion, nil
+	}
+
+	// 尚未激活过任何版本（如升级前上传的菜单），以最近上传的版本为准
+	return r.getLatestVersion(ctx)
+}
+
+// ListVersions 列出全部菜单版本及其上传、激活信息
+func (r *menuRepository) ListVersions(ctx context.Context) ([]*MenuVersionSummary, error) {
+	var versions []*MenuVersionSummary
+
+	err := r.db.WithContext(ctx).
+		Table("menus AS m").
+		Select(`m.version,
+			COUNT(*) AS menu_count,
+			MIN(m.created_at) AS uploaded_at,
+			v.uploaded_by,
+			COALESCE(v.activated_at, 0) AS activated_at,
+			v.activated_by`).
+		Joins("LEFT JOIN menu_versions AS v ON v.version = m.version AND v.deleted_at IS NULL").
+		Where("m.deleted_at IS NULL").
+		Group("m.version, v.uploaded_by, v.activated_at, v.activated_by").
+		Order("uploaded_at DESC").
+		Scan(&versions).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(versions) == 0 {
+		return versions, nil
+	}
+
+	activeVersion, err := r.GetActiveVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range versions {
+		v.IsActive = v.Version == activeVersion
+	}
+
+	return versions, nil
+}
+
+// CreateVersion 记录新上传版本的元数据
+func (r *menuRepository) CreateVersion(
+	ctx context.Context,
+	version string,
+	uploadedBy *uuid.UUID,
+) error {
+	return r.db.WithContext(ctx).
+		Create(&models.MenuVersion{Version: version, UploadedBy: uploadedBy}).
+		Error
+}
+
+// ActivateVersion 将指定版本设为生效版本
+// 早期上传、没有元数据的版本在首次激活时补录元数据
+func (r *menuRepository) ActivateVersion(
+	ctx context.Context,
+	version string,
+	operatorID *uuid.UUID,
+) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+
+		err := tx.Model(&models.Menu{}).Where("version = ?", version).Count(&count).Error
+		if err != nil {
+			return err
+		}
+
+		if count == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		// 先取消当前生效版本，避免违反生效版本的唯一索引
+		err = tx.Model(&models.MenuVersion{}).
+			Where("is_active = ? AND version <> ?", true, version).
+			Update("is_active", false).
+			Error
+		if err != nil {
+			return err
+		}
+
+		record := &models.MenuVersion{
+			Version:     version,
+			IsActive:    true,
+			ActivatedAt: time.Now().UnixMilli(),
+			ActivatedBy: operatorID,
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "version"}},
+			DoUpdates: clause.AssignmentColumns(
+				[]string{"is_active", "activated_at", "activated_by", "updated_at"},
+			),
+		}).Create(record).Error
+	})
+}
+
 // getLatestVersion 获取最新版本号的辅助方法
 func (r *menuRepository) getLatestVersion(ctx context.Context) (string, error) {
 	var latestVersion string
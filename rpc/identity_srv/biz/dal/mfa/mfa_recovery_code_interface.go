@@ -0,0 +1,26 @@
+package mfa
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+)
+
+// MFARecoveryCodeRepository 多因素认证恢复码仓储接口
+type MFARecoveryCodeRepository interface {
+	// 嵌入基础仓储接口
+	base.BaseRepository[models.MFARecoveryCode]
+
+	// ReplaceByUserID 使用新的恢复码摘要替换用户的全部恢复码
+	ReplaceByUserID(ctx context.Context, userID string, codeHashes []string) error
+
+	// Consume 将匹配的未使用恢复码标记为已使用，未找到时返回 false
+	Consume(ctx context.Context, userID string, codeHash string) (bool, error)
+
+	// CountUnused 统计用户剩余可用的恢复码数量
+	CountUnused(ctx context.Context, userID string) (int64, error)
+
+	// DeleteByUserID 删除用户的全部恢复码
+	DeleteByUserID(ctx context.Context, userID string) error
+}
@@ -0,0 +1,108 @@
+package mfa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"gorm.io/gorm"
+)
+
+// MFARecoveryCodeRepositoryImpl 多因素认证恢复码仓储实现
+type MFARecoveryCodeRepositoryImpl struct {
+	base.BaseRepository[models.MFARecoveryCode]
+	db *gorm.DB
+}
+
+// NewMFARecoveryCodeRepository 创建多因素认证恢复码仓储实例
+func NewMFARecoveryCodeRepository(db *gorm.DB) MFARecoveryCodeRepository {
+	return &MFARecoveryCodeRepositoryImpl{
+		BaseRepository: base.NewBaseRepository[models.MFARecoveryCode](db),
+		db:             db,
+	}
+}
+
+// ReplaceByUserID 使用新的恢复码摘要替换用户的全部恢复码
+// 调用方应在事务中执行，避免删除成功而写入失败导致用户没有可用恢复码
+func (r *MFARecoveryCodeRepositoryImpl) ReplaceByUserID(
+	ctx context.Context,
+	userID string,
+	codeHashes []string,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("用户ID格式错误: %w", err)
+	}
+
+	if err := r.DeleteByUserID(ctx, userID); err != nil {
+		return err
+	}
+
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	codes := make([]*models.MFARecoveryCode, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes = append(codes, &models.MFARecoveryCode{UserID: uid, CodeHash: hash})
+	}
+
+	if err := r.db.WithContext(ctx).Create(&codes).Error; err != nil {
+		return fmt.Errorf("保存恢复码失败: %w", err)
+	}
+
+	return nil
+}
+
+// Consume 将匹配的未使用恢复码标记为已使用
+// 通过条件更新保证同一恢复码在并发请求中也只能使用一次
+func (r *MFARecoveryCodeRepositoryImpl) Consume(
+	ctx context.Context,
+	userID string,
+	codeHash string,
+) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.MFARecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", models.GetCurrentTimestamp())
+
+	if result.Error != nil {
+		return false, fmt.Errorf("使用恢复码失败: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// CountUnused 统计用户剩余可用的恢复码数量
+func (r *MFARecoveryCodeRepositoryImpl) CountUnused(
+	ctx context.Context,
+	userID string,
+) (int64, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&models.MFARecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("统计恢复码失败: %w", err)
+	}
+
+	return count, nil
+}
+
+// DeleteByUserID 删除用户的全部恢复码
+// 恢复码失效后没有保留价值，直接物理删除
+func (r *MFARecoveryCodeRepositoryImpl) DeleteByUserID(ctx context.Context, userID string) error {
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("user_id = ?", userID).
+		Delete(&models.MFARecoveryCode{}).Error
+	if err != nil {
+		return fmt.Errorf("删除恢复码失败: %w", err)
+	}
+
+	return nil
+}
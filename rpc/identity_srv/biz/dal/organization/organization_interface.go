@@ -66,5 +66,6 @@ type OrganizationQueryConditions struct {
 	ParentID     *string            // 父组织ID（nil表示查询根组织）
 	FacilityType *string            // 机构类型
 	ProvinceCity *string            // 省市
+	Scope        *base.DataScope    // 数据范围（nil 表示不限制）
 	Page         *base.QueryOptions // 分页、排序、搜索参数
 }
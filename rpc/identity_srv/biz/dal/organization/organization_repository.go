@@ -248,6 +248,18 @@ func (r *OrganizationRepositoryImpl) FindWithConditions(
 				return db.Where("name LIKE ?", "%"+*conditions.Name+"%")
 			})
 		}
+
+		// 通用字段过滤（与 FindAll 保持一致）
+		for field, value := range opts.Filters {
+			qb = qb.WhereEqual(field, value)
+		}
+
+		// 数据范围过滤
+		if conditions.Scope != nil {
+			qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
+				return conditions.Scope.ApplyOrganization(db, "id")
+			})
+		}
 	}
 
 	// 应用搜索、预加载和排序
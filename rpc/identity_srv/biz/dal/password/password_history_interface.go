@@ -0,0 +1,20 @@
+package password
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+)
+
+// PasswordHistoryRepository 密码历史仓储接口
+type PasswordHistoryRepository interface {
+	// 嵌入基础仓储接口
+	base.BaseRepository[models.PasswordHistory]
+
+	// Record 记录一次密码设置，并仅保留最近 keep 条历史（keep <= 0 时不清理）
+	Record(ctx context.Context, userID string, passwordHash string, keep int) error
+
+	// ListRecent 按时间倒序获取用户最近 limit 条密码历史
+	ListRecent(ctx context.Context, userID string, limit int) ([]*models.PasswordHistory, error)
+}
@@ -0,0 +1,85 @@
+package password
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"gorm.io/gorm"
+)
+
+// PasswordHistoryRepositoryImpl 密码历史仓储实现
+type PasswordHistoryRepositoryImpl struct {
+	base.BaseRepository[models.PasswordHistory]
+	db *gorm.DB
+}
+
+// NewPasswordHistoryRepository 创建密码历史仓储实例
+func NewPasswordHistoryRepository(db *gorm.DB) PasswordHistoryRepository {
+	return &PasswordHistoryRepositoryImpl{
+		BaseRepository: base.NewBaseRepository[models.PasswordHistory](db),
+		db:             db,
+	}
+}
+
+// Record 记录一次密码设置，并清理超出保留数量的历史
+// 调用方应与密码更新放在同一事务中
+func (r *PasswordHistoryRepositoryImpl) Record(
+	ctx context.Context,
+	userID string,
+	passwordHash string,
+	keep int,
+) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("用户ID格式错误: %w", err)
+	}
+
+	history := &models.PasswordHistory{UserID: uid, PasswordHash: passwordHash}
+	if err := r.db.WithContext(ctx).Create(history).Error; err != nil {
+		return fmt.Errorf("记录密码历史失败: %w", err)
+	}
+
+	if keep <= 0 {
+		return nil
+	}
+
+	// 超出保留数量的历史没有保留价值，直接物理删除
+	keepIDs := r.db.Model(&models.PasswordHistory{}).
+		Select("id").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(keep)
+
+	err = r.db.WithContext(ctx).
+		Unscoped().
+		Where("user_id = ? AND id NOT IN (?)", userID, keepIDs).
+		Delete(&models.PasswordHistory{}).Error
+	if err != nil {
+		return fmt.Errorf("清理密码历史失败: %w", err)
+	}
+
+	return nil
+}
+
+// ListRecent 按时间倒序获取用户最近 limit 条密码历史
+func (r *PasswordHistoryRepositoryImpl) ListRecent(
+	ctx context.Context,
+	userID string,
+	limit int,
+) ([]*models.PasswordHistory, error) {
+	var histories []*models.PasswordHistory
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&histories).Error
+	if err != nil {
+		return nil, fmt.Errorf("获取密码历史失败: %w", err)
+	}
+
+	return histories, nil
+}
@@ -0,0 +1,43 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+)
+
+// CasbinRuleRepository Casbin 策略规则仓储接口
+// 直接读写 casbin_rule 表，用于与业务表在同一数据库事务中维护策略；
+// 写入后内存中的 Enforcer 需由调用方在事务提交后同步
+type CasbinRuleRepository interface {
+	// AddUserRole 写入用户角色分组策略 (g, user_id, role_id, domain)，已存在时忽略
+	// domain 为授权所属组织ID，全局授权为 models.GlobalDomain
+	AddUserRole(ctx context.Context, userID, roleID, domain, operatorID string) error
+
+	// RemoveUserRole 删除用户在指定域内的角色分组策略
+	RemoveUserRole(ctx context.Context, userID, roleID, domain string) error
+
+	// ReplaceRoleUsers 替换指定角色在指定域内的全部用户分组策略，不影响继承该角色的子角色
+	ReplaceRoleUsers(ctx context.Context, roleID, domain string, userIDs []string, operatorID string) error
+
+	// ListUserRoles 获取全部用户角色分组策略（不含角色继承策略）
+	ListUserRoles(ctx context.Context) ([]*models.CasbinRule, error)
+
+	// AddRoleInheritance 写入角色继承分组策略 (g, child_role_id, parent_role_id, *)，已存在时忽略
+	AddRoleInheritance(ctx context.Context, roleID, parentRoleID, operatorID string) error
+
+	// RemoveRoleInheritance 删除角色继承分组策略
+	RemoveRoleInheritance(ctx context.Context, roleID, parentRoleID string) error
+
+	// ReplaceRolePermissions 将角色的权限策略 (p, role_id, resource, action) 替换为指定集合
+	// permissions 每一项为 [resource, action]；仅增删有差异的策略，返回新增与删除的策略 [role_id, resource, action]
+	ReplaceRolePermissions(
+		ctx context.Context,
+		roleID string,
+		permissions [][]string,
+		operatorID string,
+	) (added, removed [][]string, err error)
+
+	// ListRolePermissions 获取全部角色权限策略
+	ListRolePermissions(ctx context.Context) ([]*models.CasbinRule, error)
+}
@@ -0,0 +1,238 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CasbinRuleRepositoryImpl Casbin 策略规则仓储实现
+// casbin_rule 由 GORM Adapter 加载时不识别软删除字段，因此删除一律为物理删除；
+// 用户角色分配与角色继承共用 g 策略，两者通过 v0 是否为角色ID区分
+type CasbinRuleRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewCasbinRuleRepository 创建 Casbin 策略规则仓储实例
+func NewCasbinRuleRepository(db *gorm.DB) CasbinRuleRepository {
+	return &CasbinRuleRepositoryImpl{db: db}
+}
+
+// AddUserRole 写入用户角色分组策略，已存在时忽略
+func (r *CasbinRuleRepositoryImpl) AddUserRole(ctx context.Context, userID, roleID, domain, operatorID string) error {
+	return r.createUserRoles(ctx, []*models.CasbinRule{newUserRoleRule(userID, roleID, domain, operatorID)})
+}
+
+// RemoveUserRole 删除用户在指定域内的角色分组策略
+func (r *CasbinRuleRepositoryImpl) RemoveUserRole(ctx context.Context, userID, roleID, domain string) error {
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("ptype = ? AND v0 = ? AND v1 = ? AND v2 = ?", models.PolicyTypeUserRole, userID, roleID, domain).
+		Delete(&models.CasbinRule{}).Error
+	if err != nil {
+		return fmt.Errorf("删除用户角色策略失败: %w", err)
+	}
+
+	return nil
+}
+
+// ReplaceRoleUsers 替换指定角色在指定域内的全部用户分组策略
+func (r *CasbinRuleRepositoryImpl) ReplaceRoleUsers(
+	ctx context.Context,
+	roleID, domain string,
+	userIDs []string,
+	operatorID string,
+) error {
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("ptype = ? AND v1 = ? AND v2 = ?", models.PolicyTypeUserRole, roleID, domain).
+		Scopes(userSubjects).
+		Delete(&models.CasbinRule{}).Error
+	if err != nil {
+		return fmt.Errorf("清除角色用户策略失败: %w", err)
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	rules := make([]*models.CasbinRule, 0, len(userIDs))
+	for _, userID := range userIDs {
+		rules = append(rules, newUserRoleRule(userID, roleID, domain, operatorID))
+	}
+
+	return r.createUserRoles(ctx, rules)
+}
+
+// ListUserRoles 获取全部用户角色分组策略
+func (r *CasbinRuleRepositoryImpl) ListUserRoles(ctx context.Context) ([]*models.CasbinRule, error) {
+	var rules []*models.CasbinRule
+
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("ptype = ?", models.PolicyTypeUserRole).
+		Scopes(userSubjects).
+		Find(&rules).Error
+	if err != nil {
+		return nil, fmt.Errorf("获取用户角色策略失败: %w", err)
+	}
+
+	return rules, nil
+}
+
+// AddRoleInheritance 写入角色继承分组策略，已存在时忽略
+func (r *CasbinRuleRepositoryImpl) AddRoleInheritance(
+	ctx context.Context,
+	roleID, parentRoleID, operatorID string,
+) error {
+	rule := &models.CasbinRule{
+		Ptype:     models.PolicyTypeRoleInheritance,
+		V0:        roleID,
+		V1:        parentRoleID,
+		V2:        models.GlobalDomain,
+		CreatedBy: operatorID,
+		UpdatedBy: operatorID,
+	}
+
+	return r.createUserRoles(ctx, []*models.CasbinRule{rule})
+}
+
+// RemoveRoleInheritance 删除角色继承分组策略
+func (r *CasbinRuleRepositoryImpl) RemoveRoleInheritance(ctx context.Context, roleID, parentRoleID string) error {
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("ptype = ? AND v0 = ? AND v1 = ?", models.PolicyTypeRoleInheritance, roleID, parentRoleID).
+		Delete(&models.CasbinRule{}).Error
+	if err != nil {
+		return fmt.Errorf("删除角色继承策略失败: %w", err)
+	}
+
+	return nil
+}
+
+// ReplaceRolePermissions 将角色的权限策略替换为指定集合，仅增删有差异的策略
+func (r *CasbinRuleRepositoryImpl) ReplaceRolePermissions(
+	ctx context.Context,
+	roleID string,
+	permissions [][]string,
+	operatorID string,
+) (added, removed [][]string, err error) {
+	var existing []*models.CasbinRule
+
+	err = r.db.WithContext(ctx).
+		Unscoped().
+		Where("ptype = ? AND v0 = ?", models.PolicyTypePermission, roleID).
+		Find(&existing).Error
+	if err != nil {
+		return nil, nil, fmt.Errorf("获取角色权限策略失败: %w", err)
+	}
+
+	desired := make(map[[2]string]struct{}, len(permissions))
+	for _, p := range permissions {
+		desired[[2]string{p[0], p[1]}] = struct{}{}
+	}
+
+	current := make(map[[2]string]struct{}, len(existing))
+
+	var staleIDs []uint
+
+	for _, rule := range existing {
+		key := [2]string{rule.V1, rule.V2}
+		current[key] = struct{}{}
+
+		if _, ok := desired[key]; !ok {
+			staleIDs = append(staleIDs, rule.ID)
+			removed = append(removed, []string{roleID, rule.V1, rule.V2})
+		}
+	}
+
+	var rules []*models.CasbinRule
+
+	for _, p := range permissions {
+		key := [2]string{p[0], p[1]}
+		if _, ok := current[key]; ok {
+			continue
+		}
+
+		// 标记为已存在，避免入参重复时重复写入
+		current[key] = struct{}{}
+
+		rules = append(rules, &models.CasbinRule{
+			Ptype:     models.PolicyTypePermission,
+			V0:        roleID,
+			V1:        p[0],
+			V2:        p[1],
+			CreatedBy: operatorID,
+			UpdatedBy: operatorID,
+		})
+		added = append(added, []string{roleID, p[0], p[1]})
+	}
+
+	if len(staleIDs) > 0 {
+		err = r.db.WithContext(ctx).
+			Unscoped().
+			Where("id IN ?", staleIDs).
+			Delete(&models.CasbinRule{}).Error
+		if err != nil {
+			return nil, nil, fmt.Errorf("删除角色权限策略失败: %w", err)
+		}
+	}
+
+	if len(rules) > 0 {
+		err = r.db.WithContext(ctx).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&rules).Error
+		if err != nil {
+			return nil, nil, fmt.Errorf("写入角色权限策略失败: %w", err)
+		}
+	}
+
+	return added, removed, nil
+}
+
+// ListRolePermissions 获取全部角色权限策略
+func (r *CasbinRuleRepositoryImpl) ListRolePermissions(ctx context.Context) ([]*models.CasbinRule, error) {
+	var rules []*models.CasbinRule
+
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("ptype = ?", models.PolicyTypePermission).
+		Find(&rules).Error
+	if err != nil {
+		return nil, fmt.Errorf("获取角色权限策略失败: %w", err)
+	}
+
+	return rules, nil
+}
+
+// userSubjects 仅保留主体为用户的分组策略，排除角色继承策略
+func userSubjects(db *gorm.DB) *gorm.DB {
+	return db.Where("v0 NOT IN (SELECT CAST(id AS TEXT) FROM role_definitions)")
+}
+
+// createUserRoles 批量写入分组策略，依赖 GORM Adapter 建立的唯一索引忽略重复规则
+func (r *CasbinRuleRepositoryImpl) createUserRoles(ctx context.Context, rules []*models.CasbinRule) error {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&rules).Error
+	if err != nil {
+		return fmt.Errorf("写入用户角色策略失败: %w", err)
+	}
+
+	return nil
+}
+
+// newUserRoleRule 构建用户角色分组策略记录
+func newUserRoleRule(userID, roleID, domain, operatorID string) *models.CasbinRule {
+	return &models.CasbinRule{
+		Ptype:     models.PolicyTypeUserRole,
+		V0:        userID,
+		V1:        roleID,
+		V2:        domain,
+		CreatedBy: operatorID,
+		UpdatedBy: operatorID,
+	}
+}
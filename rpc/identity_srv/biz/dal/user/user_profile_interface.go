@@ -26,6 +26,12 @@ type UserProfileRepository interface {
 	// GetByPhone 根据手机号获取用户档案
 	GetByPhone(ctx context.Context, phone string) (*models.UserProfile, error)
 
+	// ListByEmail 按邮箱精确查询用户档案，最多返回 limit 条（邮箱未设唯一约束，用于判断是否唯一）
+	ListByEmail(ctx context.Context, email string, limit int) ([]*models.UserProfile, error)
+
+	// ListByPhone 按手机号精确查询用户档案，最多返回 limit 条（手机号未设唯一约束，用于判断是否唯一）
+	ListByPhone(ctx context.Context, phone string, limit int) ([]*models.UserProfile, error)
+
 	// ExistsByID 检查用户ID是否存在
 	ExistsByID(ctx context.Context, userID string) (bool, error)
 
@@ -58,12 +64,38 @@ type UserProfileRepository interface {
 	// ResetLoginAttempts 重置登录尝试次数
 	ResetLoginAttempts(ctx context.Context, userID string) error
 
+	// RecordLoginFailure 记录一次登录失败并返回当前窗口内的累计失败次数
+	// 若上次失败时间早于 windowStart（毫秒时间戳），则重新从 1 开始计数
+	RecordLoginFailure(ctx context.Context, userID string, windowStart int64) (int32, error)
+
+	// LockAccount 锁定账户，lockedUntil 为空表示需手动解锁
+	LockAccount(ctx context.Context, userID string, lockedUntil *int64) error
+
+	// UnlockAccount 解锁账户，恢复为活跃状态并清除失败计数与锁定信息
+	UnlockAccount(ctx context.Context, userID string) error
+
 	// UpdateLastLoginTime 更新最后登录时间
 	UpdateLastLoginTime(ctx context.Context, userID string) error
 
 	// SetMustChangePassword 设置强制修改密码标志
 	SetMustChangePassword(ctx context.Context, userID string, mustChange bool) error
 
+	// ============================================================================
+	// 多因素认证
+	// ============================================================================
+
+	// SetMFASecret 保存待确认的 TOTP 密钥，确认绑定前不生效
+	SetMFASecret(ctx context.Context, userID string, secret string) error
+
+	// EnableMFA 启用多因素认证，并记录确认时使用的时间步
+	EnableMFA(ctx context.Context, userID string, step int64) error
+
+	// DisableMFA 关闭多因素认证并清除密钥
+	DisableMFA(ctx context.Context, userID string) error
+
+	// AdvanceMFAStep 记录校验通过的时间步，时间步未前进（重放）时返回 false
+	AdvanceMFAStep(ctx context.Context, userID string, step int64) (bool, error)
+
 	// ============================================================================
 	// 专业信息查询
 	// ============================================================================
@@ -92,6 +124,9 @@ type UserProfileRepository interface {
 	// 系统用户管理
 	// ============================================================================
 
+	// FindExpiringAccounts 查询账户过期时间在 (from, to] 区间内（毫秒时间戳）的活跃用户，按过期时间升序
+	FindExpiringAccounts(ctx context.Context, from, to int64) ([]*models.UserProfile, error)
+
 	// FindSystemUsers 查询所有系统用户
 	FindSystemUsers(ctx context.Context) ([]*models.UserProfile, error)
 
@@ -109,5 +144,7 @@ type UserProfileQueryConditions struct {
 	OrgID          *string            // 组织ID（通过成员关系查询）
 	MedicalLicense *string            // 执照号
 	Specialty      *string            // 专业领域
+	ExpiresBefore  *int64             // 账户过期时间不晚于该时间且尚未过期（毫秒时间戳）
+	Scope          *base.DataScope    // 数据范围（仅返回范围内组织的成员，nil 表示不限制）
 	Page           *base.QueryOptions // 分页、排序、搜索参数
 }
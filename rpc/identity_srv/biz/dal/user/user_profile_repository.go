@@ -9,6 +9,7 @@ import (
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // UserProfileRepositoryImpl 用户档案仓储实现
@@ -75,6 +76,44 @@ func (r *UserProfileRepositoryImpl) GetByEmail(
 	return &user, nil
 }
 
+// ListByEmail 按邮箱精确查询用户档案，最多返回 limit 条
+func (r *UserProfileRepositoryImpl) ListByEmail(
+	ctx context.Context,
+	email string,
+	limit int,
+) ([]*models.UserProfile, error) {
+	var users []*models.UserProfile
+
+	err := r.db.WithContext(ctx).
+		Where("email = ?", email).
+		Limit(limit).
+		Find(&users).Error
+	if err != nil {
+		return nil, fmt.Errorf("根据邮箱查询用户失败: %w", err)
+	}
+
+	return users, nil
+}
+
+// ListByPhone 按手机号精确查询用户档案，最多返回 limit 条
+func (r *UserProfileRepositoryImpl) ListByPhone(
+	ctx context.Context,
+	phone string,
+	limit int,
+) ([]*models.UserProfile, error) {
+	var users []*models.UserProfile
+
+	err := r.db.WithContext(ctx).
+		Where("phone = ?", phone).
+		Limit(limit).
+		Find(&users).Error
+	if err != nil {
+		return nil, fmt.Errorf("根据手机号查询用户失败: %w", err)
+	}
+
+	return users, nil
+}
+
 // GetByPhone 根据手机号获取用户档案
 func (r *UserProfileRepositoryImpl) GetByPhone(
 	ctx context.Context,
@@ -157,6 +196,7 @@ func (r *UserProfileRepositoryImpl) UpdatePassword(
 			"password_hash":        passwordHash,
 			"must_change_password": false, // Reset the must_change_password flag
 			"login_attempts":       0,     // Also reset login attempts on password change
+			"password_changed_at":  models.GetCurrentTimestamp(),
 		})
 
 	if result.Error != nil {
@@ -396,6 +436,13 @@ func (r *UserProfileRepositoryImpl) FindWithConditions(
 		})
 	}
 
+	// 应用数据范围过滤
+	if conditions != nil && conditions.Scope != nil {
+		qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
+			return conditions.Scope.ApplyMember(db, "user_profiles.id")
+		})
+	}
+
 	// 应用精确匹配条件（需要表名前缀，因为可能有 JOIN）
 	if conditions != nil {
 		if conditions.Username != nil {
@@ -431,6 +478,16 @@ func (r *UserProfileRepositoryImpl) FindWithConditions(
 			})
 		}
 
+		if conditions.ExpiresBefore != nil {
+			qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
+				return db.Where(
+					"user_profiles.account_expiry > ? AND user_profiles.account_expiry <= ?",
+					models.GetCurrentTimestamp(),
+					*conditions.ExpiresBefore,
+				)
+			})
+		}
+
 		// Specialty 模糊匹配
 		if conditions.Specialty != nil {
 			qb = qb.WhereCustom(func(db *gorm.DB) *gorm.DB {
@@ -539,7 +596,10 @@ func (r *UserProfileRepositoryImpl) ResetLoginAttempts(
 	result := r.db.WithContext(ctx).
 		Model(&models.UserProfile{}).
 		Where("id = ?", userID).
-		Update("login_attempts", 0)
+		Updates(map[string]interface{}{
+			"login_attempts":       0,
+			"last_failed_login_at": nil,
+		})
 
 	if result.Error != nil {
 		return fmt.Errorf("重置用户登录失败次数失败: %w", result.Error)
@@ -552,6 +612,90 @@ func (r *UserProfileRepositoryImpl) ResetLoginAttempts(
 	return nil
 }
 
+// RecordLoginFailure 记录登录失败并返回窗口内的累计失败次数
+// 使用单条 UPDATE ... RETURNING 保证并发登录失败时计数准确
+func (r *UserProfileRepositoryImpl) RecordLoginFailure(
+	ctx context.Context,
+	userID string,
+	windowStart int64,
+) (int32, error) {
+	var profile models.UserProfile
+
+	result := r.db.WithContext(ctx).
+		Model(&profile).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "login_attempts"}}}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"login_attempts": gorm.Expr(
+				"CASE WHEN last_failed_login_at IS NULL OR last_failed_login_at < ? "+
+					"THEN 1 ELSE login_attempts + 1 END",
+				windowStart,
+			),
+			"last_failed_login_at": models.GetCurrentTimestamp(),
+		})
+
+	if result.Error != nil {
+		return 0, fmt.Errorf("记录用户登录失败失败: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return 0, fmt.Errorf("用户不存在或已删除")
+	}
+
+	return profile.LoginAttempts, nil
+}
+
+// LockAccount 锁定账户
+func (r *UserProfileRepositoryImpl) LockAccount(
+	ctx context.Context,
+	userID string,
+	lockedUntil *int64,
+) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.UserProfile{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"status":       models.UserStatusLocked,
+			"locked_until": lockedUntil,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("锁定用户账户失败: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("用户不存在或已删除")
+	}
+
+	return nil
+}
+
+// UnlockAccount 解锁账户
+func (r *UserProfileRepositoryImpl) UnlockAccount(
+	ctx context.Context,
+	userID string,
+) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.UserProfile{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"status":               models.UserStatusActive,
+			"login_attempts":       0,
+			"last_failed_login_at": nil,
+			"locked_until":         nil,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("解锁用户账户失败: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("用户不存在或已删除")
+	}
+
+	return nil
+}
+
 // UpdateLastLoginTime 更新最后登录时间
 func (r *UserProfileRepositoryImpl) UpdateLastLoginTime(
 	ctx context.Context,
@@ -576,6 +720,105 @@ func (r *UserProfileRepositoryImpl) UpdateLastLoginTime(
 	return nil
 }
 
+// ============================================================================
+// 多因素认证
+// ============================================================================
+
+// SetMFASecret 保存待确认的 TOTP 密钥
+func (r *UserProfileRepositoryImpl) SetMFASecret(
+	ctx context.Context,
+	userID string,
+	secret string,
+) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.UserProfile{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"mfa_enabled":        false,
+			"mfa_secret":         secret,
+			"mfa_last_used_step": 0,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("保存多因素认证密钥失败: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("用户不存在或已删除")
+	}
+
+	return nil
+}
+
+// EnableMFA 启用多因素认证
+func (r *UserProfileRepositoryImpl) EnableMFA(
+	ctx context.Context,
+	userID string,
+	step int64,
+) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.UserProfile{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"mfa_enabled":        true,
+			"mfa_last_used_step": step,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("启用多因素认证失败: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("用户不存在或已删除")
+	}
+
+	return nil
+}
+
+// DisableMFA 关闭多因素认证并清除密钥
+func (r *UserProfileRepositoryImpl) DisableMFA(
+	ctx context.Context,
+	userID string,
+) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.UserProfile{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"mfa_enabled":        false,
+			"mfa_secret":         "",
+			"mfa_last_used_step": 0,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("关闭多因素认证失败: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("用户不存在或已删除")
+	}
+
+	return nil
+}
+
+// AdvanceMFAStep 记录校验通过的时间步
+// 通过条件更新保证同一时间步的验证码在并发请求中也只能使用一次
+func (r *UserProfileRepositoryImpl) AdvanceMFAStep(
+	ctx context.Context,
+	userID string,
+	step int64,
+) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.UserProfile{}).
+		Where("id = ? AND mfa_last_used_step < ?", userID, step).
+		Update("mfa_last_used_step", step)
+
+	if result.Error != nil {
+		return false, fmt.Errorf("记录多因素认证时间步失败: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
 // ============================================================================
 // 系统用户保护机制（重写基类方法）
 // ============================================================================
@@ -664,6 +907,24 @@ func (r *UserProfileRepositoryImpl) FindSystemUsers(
 	return users, nil
 }
 
+// FindExpiringAccounts 查询账户过期时间在 (from, to] 区间内的活跃用户，按过期时间升序
+func (r *UserProfileRepositoryImpl) FindExpiringAccounts(
+	ctx context.Context,
+	from, to int64,
+) ([]*models.UserProfile, error) {
+	var users []*models.UserProfile
+
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND account_expiry > ? AND account_expiry <= ?", models.UserStatusActive, from, to).
+		Order("account_expiry ASC").
+		Find(&users).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询即将过期的账户失败: %w", err)
+	}
+
+	return users, nil
+}
+
 // IsSystemUser 判断用户是否为系统用户
 func (r *UserProfileRepositoryImpl) IsSystemUser(ctx context.Context, userID string) (bool, error) {
 	var count int64
@@ -0,0 +1,152 @@
+package datascope
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/casbin"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/base"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/internal/middleware"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+)
+
+// Resolver 数据范围解析器
+// 根据调用方（网关透传的用户ID）的角色在指定菜单上的权限解析列表查询的数据范围：
+//   - 未携带调用方（内部调用）、超管角色或拥有 view_all_organizations 权限时不限制，返回 nil
+//   - 其余情况限制为调用方存在活跃成员关系的组织
+type Resolver interface {
+	// ForUsers 解析用户列表的数据范围
+	ForUsers(ctx context.Context) (*base.DataScope, error)
+
+	// ForOrganizations 解析组织、部门列表的数据范围
+	ForOrganizations(ctx context.Context) (*base.DataScope, error)
+
+	// ForRoleAssignments 解析角色分配列表的数据范围
+	ForRoleAssignments(ctx context.Context) (*base.DataScope, error)
+}
+
+// ResolverImpl 数据范围解析器实现
+type ResolverImpl struct {
+	dal           dal.DAL
+	casbinManager *casbin.CasbinManager
+	config        *config.Config
+}
+
+// NewResolver 创建数据范围解析器实例
+func NewResolver(dal dal.DAL, casbinManager *casbin.CasbinManager, cfg *config.Config) Resolver {
+	return &ResolverImpl{
+		dal:           dal,
+		casbinManager: casbinManager,
+		config:        cfg,
+	}
+}
+
+// ForUsers 解析用户列表的数据范围
+func (r *ResolverImpl) ForUsers(ctx context.Context) (*base.DataScope, error) {
+	return r.resolve(ctx, r.config.DataScope.UserMenuID)
+}
+
+// ForOrganizations 解析组织、部门列表的数据范围
+func (r *ResolverImpl) ForOrganizations(ctx context.Context) (*base.DataScope, error) {
+	return r.resolve(ctx, r.config.DataScope.OrganizationMenuID)
+}
+
+// ForRoleAssignments 解析角色分配列表的数据范围
+func (r *ResolverImpl) ForRoleAssignments(ctx context.Context) (*base.DataScope, error) {
+	return r.resolve(ctx, r.config.DataScope.RoleMenuID)
+}
+
+// resolve 按调用方在指定菜单上的权限解析数据范围
+func (r *ResolverImpl) resolve(ctx context.Context, menuID string) (*base.DataScope, error) {
+	if !r.config.DataScope.Enabled {
+		return nil, nil
+	}
+
+	callerID := middleware.GetCallerID(ctx)
+	if callerID == "" {
+		return nil, nil
+	}
+
+	unrestricted, err := r.hasAllOrganizations(ctx, callerID, middleware.GetCallerOrganizationID(ctx), menuID)
+	if err != nil {
+		return nil, err
+	}
+
+	if unrestricted {
+		return nil, nil
+	}
+
+	orgIDs, err := r.dal.UserMembership().GetUserOrganizations(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &base.DataScope{OrganizationIDs: orgIDs}, nil
+}
+
+// hasAllOrganizations 调用方在当前组织内是否拥有超管角色，或任一角色在菜单上拥有 view_all_organizations 权限
+func (r *ResolverImpl) hasAllOrganizations(ctx context.Context, userID, organizationID, menuID string) (bool, error) {
+	roleIDs, err := r.dal.UserRoleAssignment().
+		GetActiveRoleIDsWithStatus(ctx, userID, organizationID, models.RoleStatusActive)
+	if err != nil {
+		return false, fmt.Errorf("获取用户角色列表失败: %w", err)
+	}
+
+	if len(roleIDs) == 0 {
+		return false, nil
+	}
+
+	// 父角色的超管身份与菜单权限沿继承链传递给子角色
+	roleIDs, err = r.casbinManager.ExpandInheritedRoles(roleIDs)
+	if err != nil {
+		return false, err
+	}
+
+	superAdmin, err := r.hasSuperAdminRole(ctx, roleIDs)
+	if err != nil || superAdmin {
+		return superAdmin, err
+	}
+
+	for _, roleID := range roleIDs {
+		policies, err := r.casbinManager.GetRoleMenuMappings(roleID)
+		if err != nil {
+			return false, fmt.Errorf("获取角色 %s 的菜单权限失败: %w", roleID, err)
+		}
+
+		for _, policy := range policies {
+			// V1 是 menu_id，V2 是权限
+			if len(policy) >= 3 && policy[1] == menuID && policy[2] == models.MenuPermissionViewAllOrganizations {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// hasSuperAdminRole 角色列表中是否包含配置的超管角色
+func (r *ResolverImpl) hasSuperAdminRole(ctx context.Context, roleIDs []string) (bool, error) {
+	if len(r.config.SuperAdmin.RoleNames) == 0 {
+		return false, nil
+	}
+
+	roles, err := r.dal.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
+	if err != nil {
+		return false, fmt.Errorf("查询角色定义失败: %w", err)
+	}
+
+	superAdminRoles := make(map[string]bool, len(r.config.SuperAdmin.RoleNames))
+	for _, roleName := range r.config.SuperAdmin.RoleNames {
+		superAdminRoles[roleName] = true
+	}
+
+	for _, role := range roles {
+		if superAdminRoles[role.Name] {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
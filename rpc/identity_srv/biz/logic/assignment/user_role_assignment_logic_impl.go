@@ -2,33 +2,51 @@ package assignment
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/auditlog"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/casbin"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/convutil"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
 	assignmentDal "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/assignment"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/datascope"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+	"gorm.io/gorm"
 )
 
+// roleUsersSnapshot 角色在授权范围内绑定用户的审计快照
+type roleUsersSnapshot struct {
+	OrganizationID string   `json:"organizationID,omitempty"`
+	UserIDs        []string `json:"userIDs"`
+}
+
 // LogicImpl 用户角色分配业务逻辑实现
 type LogicImpl struct {
-	dal       dal.DAL
-	converter converter.Converter
+	dal           dal.DAL
+	converter     converter.Converter
+	casbinManager *casbin.CasbinManager
+	dataScope     datascope.Resolver
 }
 
 // NewUserRoleAssignmentLogic 创建用户角色分配业务逻辑实例
 func NewLogic(
 	dal dal.DAL,
 	converter converter.Converter,
+	casbinManager *casbin.CasbinManager,
+	dataScope datascope.Resolver,
 ) RoleAssignmentLogic {
 	return &LogicImpl{
-		dal:       dal,
-		converter: converter,
+		dal:           dal,
+		converter:     converter,
+		casbinManager: casbinManager,
+		dataScope:     dataScope,
 	}
 }
 
@@ -40,9 +58,10 @@ func (l *LogicImpl) AssignRoleToUser(
 	userID := *req.UserID
 	roleID := *req.RoleID
 	assignedByID := *req.AssignedBy
+	organizationID := convutil.StringValue(req.OrganizationID)
 
-	// 检查用户是否已分配该角色，避免重复分配
-	exists, err := l.dal.UserRoleAssignment().CheckUserRoleExists(ctx, userID, roleID)
+	// 检查用户是否已在该授权范围内分配该角色，避免重复分配
+	exists, err := l.dal.UserRoleAssignment().CheckUserRoleExists(ctx, userID, roleID, organizationID)
 	if err != nil {
 		return nil, errno.ErrOperationFailed.WithMessage("检查角色分配状态失败: " + err.Error())
 	}
@@ -61,10 +80,25 @@ func (l *LogicImpl) AssignRoleToUser(
 		return nil, errno.ErrRoleDefinitionNotFound
 	}
 
+	if err := validateAssignmentPeriod(req.EffectiveFrom, req.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	if err := l.ensureOrganizationMembers(ctx, organizationID, []string{userID}); err != nil {
+		return nil, err
+	}
+
 	// 创建角色分配记录
 	assignment := &models.UserRoleAssignment{
-		UserID: uuid.MustParse(userID),
-		RoleID: uuid.MustParse(roleID),
+		UserID:        uuid.MustParse(userID),
+		RoleID:        uuid.MustParse(roleID),
+		EffectiveFrom: req.EffectiveFrom,
+		ExpiresAt:     req.ExpiresAt,
+	}
+
+	if organizationID != "" {
+		orgUUID := uuid.MustParse(organizationID)
+		assignment.OrganizationID = &orgUUID
 	}
 
 	if assignedByID != "" {
@@ -72,11 +106,36 @@ func (l *LogicImpl) AssignRoleToUser(
 		assignment.CreatedBy = &assignedByUUID
 	}
 
-	// 保存到数据库
-	if err := l.dal.UserRoleAssignment().Create(ctx, assignment); err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage("创建角色分配失败: " + err.Error())
+	// 角色分配与 Casbin 分组策略在同一事务中写入
+	// 尚未到达生效时间的分配暂不写入分组策略，由后台任务在生效时补写
+	active := assignment.IsActive()
+	domain := assignment.Domain()
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := txDAL.UserRoleAssignment().Create(ctx, assignment); err != nil {
+			return errno.ErrOperationFailed.WithMessage("创建角色分配失败: " + err.Error())
+		}
+
+		if !active {
+			return nil
+		}
+
+		if err := txDAL.CasbinRule().AddUserRole(ctx, userID, roleID, domain, assignedByID); err != nil {
+			return errno.ErrOperationFailed.WithMessage("同步角色分配策略失败: " + err.Error())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	if active {
+		l.syncEnforcer(ctx, [][]string{{userID, roleID, domain}}, nil)
+	}
+
+	auditlog.SetChange(ctx, nil, l.converter.UserRoleAssignment().ModelToThrift(assignment))
+
 	return &identity_srv.UserRoleAssignmentResponse{
 		AssignmentID: convutil.StringPtr(assignment.ID.String()),
 	}, nil
@@ -104,6 +163,10 @@ func (l *LogicImpl) UpdateUserRoleAssignment(
 		return errno.ErrRoleAssignmentNotFound
 	}
 
+	oldUserID := assignment.UserID.String()
+	oldRoleID := assignment.RoleID.String()
+	beforeDTO := l.converter.UserRoleAssignment().ModelToThrift(assignment)
+
 	// 更新字段
 	if req.UserID != nil {
 		assignment.UserID = uuid.MustParse(*req.UserID)
@@ -118,11 +181,70 @@ func (l *LogicImpl) UpdateUserRoleAssignment(
 		assignment.UpdatedBy = &updatedByUUID
 	}
 
-	// 保存更新
-	if err := l.dal.UserRoleAssignment().Update(ctx, assignment); err != nil {
-		return errno.ErrOperationFailed.WithMessage("更新角色分配失败: " + err.Error())
+	// 有效期：传 0 表示清除
+	if req.EffectiveFrom != nil {
+		assignment.EffectiveFrom = nonZeroTimestamp(*req.EffectiveFrom)
+	}
+
+	if req.ExpiresAt != nil {
+		assignment.ExpiresAt = nonZeroTimestamp(*req.ExpiresAt)
+	}
+
+	if req.EffectiveFrom != nil || req.ExpiresAt != nil {
+		if err := validateAssignmentPeriod(assignment.EffectiveFrom, assignment.ExpiresAt); err != nil {
+			return err
+		}
+	}
+
+	newUserID := assignment.UserID.String()
+	newRoleID := assignment.RoleID.String()
+
+	operatorID := ""
+	if req.UpdatedBy != nil {
+		operatorID = *req.UpdatedBy
+	}
+
+	// 用户、角色或有效期变化都可能影响分组策略，同一事务中按更新后的分配重新同步
+	// 授权所属组织不随更新变化
+	organizationID := assignment.OrganizationIDString()
+	domain := assignment.Domain()
+
+	pairs := [][]string{{oldUserID, oldRoleID}}
+	if newUserID != oldUserID || newRoleID != oldRoleID {
+		pairs = append(pairs, []string{newUserID, newRoleID})
+	}
+
+	var added, removed [][]string
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := txDAL.UserRoleAssignment().Update(ctx, assignment); err != nil {
+			return errno.ErrOperationFailed.WithMessage("更新角色分配失败: " + err.Error())
+		}
+
+		for _, pair := range pairs {
+			effective, err := syncUserRoleRule(ctx, txDAL, pair[0], pair[1], organizationID, operatorID)
+			if err != nil {
+				return err
+			}
+
+			rule := []string{pair[0], pair[1], domain}
+			if effective {
+				added = append(added, rule)
+			} else {
+				removed = append(removed, rule)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
+	l.syncEnforcer(ctx, added, removed)
+
+	auditlog.SetChange(ctx, beforeDTO, l.converter.UserRoleAssignment().ModelToThrift(assignment))
+
 	return nil
 }
 
@@ -142,6 +264,7 @@ func (l *LogicImpl) RevokeRoleFromUser(
 
 	userID := *req.UserID
 	roleID := *req.RoleID
+	organizationID := convutil.StringValue(req.OrganizationID)
 
 	// 1. 检查用户是否为系统用户
 	isSystemUser, err := l.dal.UserProfile().IsSystemUser(ctx, userID)
@@ -169,8 +292,8 @@ func (l *LogicImpl) RevokeRoleFromUser(
 		}
 	}
 
-	// 3. 查找用户和角色的分配记录
-	assignment, err := l.dal.UserRoleAssignment().FindByUserAndRole(ctx, userID, roleID)
+	// 3. 查找用户和角色在授权范围内的分配记录
+	assignment, err := l.dal.UserRoleAssignment().FindByUserAndRole(ctx, userID, roleID, organizationID)
 	if err != nil {
 		return errno.ErrOperationFailed.WithMessage("查询角色分配失败: " + err.Error())
 	}
@@ -179,15 +302,36 @@ func (l *LogicImpl) RevokeRoleFromUser(
 		return errno.ErrRoleAssignmentNotFound.WithMessage("未找到该用户的角色分配记录")
 	}
 
-	// 4. 删除角色分配记录
-	if err := l.dal.UserRoleAssignment().Delete(ctx, assignment.ID.String()); err != nil {
-		return errno.ErrOperationFailed.WithMessage("撤销角色分配失败: " + err.Error())
+	// 4. 删除角色分配记录，并在同一事务中删除对应的 Casbin 分组策略
+	var effective bool
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := txDAL.UserRoleAssignment().Delete(ctx, assignment.ID.String()); err != nil {
+			return errno.ErrOperationFailed.WithMessage("撤销角色分配失败: " + err.Error())
+		}
+
+		var err error
+
+		effective, err = syncUserRoleRule(ctx, txDAL, userID, roleID, organizationID, "")
+
+		return err
+	})
+	if err != nil {
+		return err
 	}
 
+	if !effective {
+		l.syncEnforcer(ctx, nil, [][]string{{userID, roleID, assignment.Domain()}})
+	}
+
+	auditlog.SetTarget(ctx, assignment.ID.String())
+	auditlog.SetChange(ctx, l.converter.UserRoleAssignment().ModelToThrift(assignment), nil)
+
 	// 5. 审计日志
 	slog.InfoContext(ctx, "角色撤销成功",
 		"user_id", userID,
 		"role_id", roleID,
+		"organization_id", organizationID,
 	)
 
 	return nil
@@ -235,6 +379,17 @@ func (l *LogicImpl) ListUserRoleAssignments(
 		conditions.RoleID = &roleID
 	}
 
+	conditions.ExpiringBefore = req.ExpiringBefore
+	conditions.OrganizationID = req.OrganizationID
+
+	// 按调用方的组织权限限制数据范围
+	scope, err := l.dataScope.ForRoleAssignments(ctx)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("解析数据范围失败: " + err.Error())
+	}
+
+	conditions.Scope = scope
+
 	// 查询角色分配记录
 	assignments, pageResult, err := l.dal.UserRoleAssignment().FindWithConditions(ctx, conditions)
 	if err != nil {
@@ -306,6 +461,8 @@ func (l *LogicImpl) BatchBindUsersToRole(
 
 	roleID := *req.RoleID
 	userIDs := req.UserIDs
+	organizationID := convutil.StringValue(req.OrganizationID)
+	domain := models.OrganizationDomain(organizationID)
 
 	operatorID := ""
 	if req.OperatorID != nil {
@@ -322,12 +479,58 @@ func (l *LogicImpl) BatchBindUsersToRole(
 		return nil, errno.ErrRoleDefinitionNotFound
 	}
 
-	// 批量替换角色的用户绑定（事务操作）
-	err = l.dal.UserRoleAssignment().ReplaceRoleUsers(ctx, roleID, userIDs, operatorID)
+	if err := validateAssignmentPeriod(req.EffectiveFrom, req.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	if err := l.ensureOrganizationMembers(ctx, organizationID, userIDs); err != nil {
+		return nil, err
+	}
+
+	// 新绑定尚未生效时，角色下暂不保留任何分组策略，由后台任务在生效时补写
+	effectiveUserIDs := userIDs
+	probe := &models.UserRoleAssignment{EffectiveFrom: req.EffectiveFrom, ExpiresAt: req.ExpiresAt}
+
+	if !probe.IsActive() {
+		effectiveUserIDs = nil
+	}
+
+	// 批量替换角色在授权范围内的用户绑定，并在同一事务中替换对应的 Casbin 分组策略
+	var oldUserIDs []string
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		var err error
+
+		oldUserIDs, err = txDAL.UserRoleAssignment().GetUserIDsByRoleAndOrganization(ctx, roleID, organizationID)
+		if err != nil {
+			return errno.ErrOperationFailed.WithMessage("查询角色用户列表失败: " + err.Error())
+		}
+
+		err = txDAL.UserRoleAssignment().
+			ReplaceRoleUsers(ctx, roleID, organizationID, userIDs, operatorID, req.EffectiveFrom, req.ExpiresAt)
+		if err != nil {
+			return errno.ErrOperationFailed.WithMessage("批量绑定用户到角色失败: " + err.Error())
+		}
+
+		err = txDAL.CasbinRule().ReplaceRoleUsers(ctx, roleID, domain, effectiveUserIDs, operatorID)
+		if err != nil {
+			return errno.ErrOperationFailed.WithMessage("同步角色分配策略失败: " + err.Error())
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage("批量绑定用户到角色失败: " + err.Error())
+		return nil, err
 	}
 
+	added, removed := diffRoleUsers(roleID, domain, oldUserIDs, effectiveUserIDs)
+	l.syncEnforcer(ctx, added, removed)
+
+	auditlog.SetChange(ctx,
+		&roleUsersSnapshot{OrganizationID: organizationID, UserIDs: oldUserIDs},
+		&roleUsersSnapshot{OrganizationID: organizationID, UserIDs: userIDs},
+	)
+
 	successCount := int32(len(userIDs))
 	message := "批量绑定成功"
 
@@ -361,3 +564,119 @@ func (l *LogicImpl) BatchGetUserRoles(
 		UserRoles: userRoles,
 	}, nil
 }
+
+// syncEnforcer 事务提交后将用户角色变更同步到内存中的 Casbin 策略
+// 增量同步失败时全量重新加载，数据库中的策略已与角色分配保持一致
+func (l *LogicImpl) syncEnforcer(ctx context.Context, added, removed [][]string) {
+	err := l.casbinManager.ApplyUserRoleChanges(added, removed)
+	if err == nil {
+		return
+	}
+
+	slog.WarnContext(ctx, "增量同步用户角色策略失败，重新加载全部策略", "error", err)
+
+	if err := l.casbinManager.LoadPolicy(); err != nil {
+		slog.ErrorContext(ctx, "重新加载Casbin策略失败", "error", err)
+	}
+}
+
+// ensureOrganizationMembers 校验用户均为组织的有效成员，organizationID 为空（全局授权）时不校验
+func (l *LogicImpl) ensureOrganizationMembers(ctx context.Context, organizationID string, userIDs []string) error {
+	if organizationID == "" {
+		return nil
+	}
+
+	for _, userID := range userIDs {
+		_, err := l.dal.UserMembership().GetByUserAndOrganization(ctx, userID, organizationID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errno.ErrMembershipNotFound.WithMessage(
+				fmt.Sprintf("用户 %s 不是组织 %s 的有效成员，不能授予该组织内的角色", userID, organizationID),
+			)
+		}
+
+		if err != nil {
+			return errno.ErrOperationFailed.WithMessage("查询用户组织成员关系失败: " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// syncUserRoleRule 按用户当前是否在授权范围内持有该角色的有效分配，写入或删除对应的分组策略
+// 返回分组策略是否应当存在
+func syncUserRoleRule(
+	ctx context.Context,
+	txDAL dal.DAL,
+	userID, roleID, organizationID, operatorID string,
+) (bool, error) {
+	effective, err := txDAL.UserRoleAssignment().HasEffectiveUserRole(ctx, userID, roleID, organizationID)
+	if err != nil {
+		return false, errno.ErrOperationFailed.WithMessage("检查角色分配状态失败: " + err.Error())
+	}
+
+	domain := models.OrganizationDomain(organizationID)
+	if effective {
+		err = txDAL.CasbinRule().AddUserRole(ctx, userID, roleID, domain, operatorID)
+	} else {
+		err = txDAL.CasbinRule().RemoveUserRole(ctx, userID, roleID, domain)
+	}
+
+	if err != nil {
+		return false, errno.ErrOperationFailed.WithMessage("同步角色分配策略失败: " + err.Error())
+	}
+
+	return effective, nil
+}
+
+// validateAssignmentPeriod 校验角色分配有效期：过期时间须晚于生效时间且晚于当前时间
+func validateAssignmentPeriod(effectiveFrom, expiresAt *int64) error {
+	if expiresAt == nil {
+		return nil
+	}
+
+	if *expiresAt <= time.Now().UnixMilli() {
+		return errno.ErrInvalidAssignmentPeriod.WithMessage("过期时间必须晚于当前时间")
+	}
+
+	if effectiveFrom != nil && *expiresAt <= *effectiveFrom {
+		return errno.ErrInvalidAssignmentPeriod.WithMessage("过期时间必须晚于生效时间")
+	}
+
+	return nil
+}
+
+// nonZeroTimestamp 将 0 视为未设置
+func nonZeroTimestamp(ts int64) *int64 {
+	if ts == 0 {
+		return nil
+	}
+
+	return &ts
+}
+
+// diffRoleUsers 计算角色用户替换前后新增与移除的分组策略
+// 原有用户中可能存在尚未生效、没有分组策略的分配，因此新用户一律按新增处理，重复添加由内存模型忽略
+func diffRoleUsers(roleID, domain string, oldUserIDs, newUserIDs []string) (added, removed [][]string) {
+	oldSet := make(map[string]struct{}, len(oldUserIDs))
+	for _, userID := range oldUserIDs {
+		oldSet[userID] = struct{}{}
+	}
+
+	newSet := make(map[string]struct{}, len(newUserIDs))
+	for _, userID := range newUserIDs {
+		if _, ok := newSet[userID]; ok {
+			continue
+		}
+
+		newSet[userID] = struct{}{}
+		added = append(added, []string{userID, roleID, domain})
+	}
+
+	for userID := range oldSet {
+		if _, ok := newSet[userID]; !ok {
+			removed = append(removed, []string{userID, roleID, domain})
+		}
+	}
+
+	return added, removed
+}
@@ -0,0 +1,20 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// AuditLogic 审计日志业务逻辑接口
+// 审计事件由审计中间件在变更类调用结束后写入，此处只提供查询能力
+type AuditLogic interface {
+	// ListAuditEvents 按条件分页查询审计事件
+	//	@param	ctx	上下文
+	//	@param	req	包含操作者、操作、操作对象、执行结果、请求ID与时间范围等过滤条件的请求
+	//	@return	按发生时间倒序排列的审计事件列表及分页信息
+	ListAuditEvents(
+		ctx context.Context,
+		req *identity_srv.ListAuditEventsRequest,
+	) (*identity_srv.ListAuditEventsResponse, error)
+}
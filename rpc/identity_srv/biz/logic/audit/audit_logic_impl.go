@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+	auditDal "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/audit"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+)
+
+// LogicImpl 审计日志逻辑实现
+type LogicImpl struct {
+	dal       dal.DAL
+	converter converter.Converter
+}
+
+// NewLogic 创建审计日志逻辑实现
+func NewLogic(dal dal.DAL, converter converter.Converter) AuditLogic {
+	return &LogicImpl{
+		dal:       dal,
+		converter: converter,
+	}
+}
+
+// ListAuditEvents 按条件分页查询审计事件
+func (l *LogicImpl) ListAuditEvents(
+	ctx context.Context,
+	req *identity_srv.ListAuditEventsRequest,
+) (*identity_srv.ListAuditEventsResponse, error) {
+	if req == nil {
+		req = identity_srv.NewListAuditEventsRequest()
+	}
+
+	if req.StartTime != nil && req.EndTime != nil && *req.StartTime >= *req.EndTime {
+		return nil, errno.ErrInvalidParams.WithMessage("起始时间必须早于截止时间")
+	}
+
+	conditions := &auditDal.AuditEventQueryConditions{
+		ActorID:    req.ActorID,
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		Result:     req.Result_,
+		RequestID:  req.RequestID,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		Page:       l.converter.Base().PageRequestToQueryOptions(req.Page),
+	}
+
+	events, pageResult, err := l.dal.AuditEvent().FindWithConditions(ctx, conditions)
+	if err != nil {
+		return nil, errno.WrapDatabaseError(err, "查询审计事件失败")
+	}
+
+	return &identity_srv.ListAuditEventsResponse{
+		Events: l.converter.AuditEvent().ModelsToThrift(events),
+		Page:   l.converter.Base().PageResponseToThrift(pageResult),
+	}, nil
+}
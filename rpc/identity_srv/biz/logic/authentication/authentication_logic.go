@@ -14,6 +14,12 @@ type AuthenticationLogic interface {
 		req *identity_srv.LoginRequest,
 	) (*identity_srv.LoginResponse, error)
 
+	// SwitchOrganization 切换用户当前代表的组织，按目标组织重新计算菜单、角色与权限
+	SwitchOrganization(
+		ctx context.Context,
+		req *identity_srv.SwitchOrganizationRequest,
+	) (*identity_srv.LoginResponse, error)
+
 	// ============================================================================
 	// 认证和安全
 	// ============================================================================
@@ -26,4 +32,35 @@ type AuthenticationLogic interface {
 
 	// ForcePasswordChange 强制用户修改密码
 	ForcePasswordChange(ctx context.Context, req *identity_srv.ForcePasswordChangeRequest) error
+
+	// ============================================================================
+	// 多因素认证 (TOTP)
+	// ============================================================================
+
+	// BeginMFAEnrollment 生成待确认的 TOTP 密钥
+	BeginMFAEnrollment(
+		ctx context.Context,
+		req *identity_srv.BeginMFAEnrollmentRequest,
+	) (*identity_srv.BeginMFAEnrollmentResponse, error)
+
+	// ConfirmMFAEnrollment 校验验证码后启用多因素认证并生成恢复码
+	ConfirmMFAEnrollment(
+		ctx context.Context,
+		req *identity_srv.MFACodeRequest,
+	) (*identity_srv.MFARecoveryCodesResponse, error)
+
+	// VerifyMFA 校验 TOTP 验证码或恢复码
+	VerifyMFA(
+		ctx context.Context,
+		req *identity_srv.MFACodeRequest,
+	) (*identity_srv.VerifyMFAResponse, error)
+
+	// DisableMFA 关闭多因素认证
+	DisableMFA(ctx context.Context, req *identity_srv.MFACodeRequest) error
+
+	// RegenerateMFARecoveryCodes 重新生成恢复码
+	RegenerateMFARecoveryCodes(
+		ctx context.Context,
+		req *identity_srv.MFACodeRequest,
+	) (*identity_srv.MFARecoveryCodesResponse, error)
 }
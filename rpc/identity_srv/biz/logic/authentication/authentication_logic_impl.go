@@ -2,22 +2,31 @@ package authentication
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/convutil"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
 	membershipDAL "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/membership"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/menu"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/config"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/metrics"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/password"
 )
 
 // LogicImpl 用户认证逻辑实现
 type LogicImpl struct {
-	dal       dal.DAL
-	converter converter.Converter
-	menuLogic menu.MenuLogic
+	dal            dal.DAL
+	converter      converter.Converter
+	menuLogic      menu.MenuLogic
+	lockout        config.LockoutConfig
+	mfa            config.MFAConfig
+	passwordPolicy config.PasswordPolicyConfig
 }
 
 // NewLogic 创建用户认证逻辑实现
@@ -25,11 +34,17 @@ func NewLogic(
 	dal dal.DAL,
 	converter converter.Converter,
 	menuLogic menu.MenuLogic,
+	lockout config.LockoutConfig,
+	mfa config.MFAConfig,
+	passwordPolicy config.PasswordPolicyConfig,
 ) AuthenticationLogic {
 	return &LogicImpl{
-		dal:       dal,
-		converter: converter,
-		menuLogic: menuLogic,
+		dal:            dal,
+		converter:      converter,
+		menuLogic:      menuLogic,
+		lockout:        lockout,
+		mfa:            mfa,
+		passwordPolicy: passwordPolicy,
 	}
 }
 
@@ -42,21 +57,32 @@ func (l *LogicImpl) Login(
 	ctx context.Context,
 	req *identity_srv.LoginRequest,
 ) (*identity_srv.LoginResponse, error) {
-	// 根据用户名获取用户档案
-	userProfile, err := l.dal.UserProfile().GetByUsername(ctx, *req.Username)
+	// 根据登录账号（用户名、邮箱或手机号）获取用户档案
+	userProfile, err := l.resolveLoginUser(ctx, convutil.StringValue(req.Username))
 	if err != nil {
-		if errno.IsRecordNotFound(err) {
-			return nil, errno.ErrUserNotFound
+		return nil, err
+	}
+
+	// 检查锁定状态（先于密码校验，避免锁定期间继续暴力尝试）
+	if userProfile.IsLocked() {
+		if !userProfile.IsLockExpired(models.GetCurrentTimestamp()) {
+			return nil, errno.ErrUserLocked
 		}
 
-		return nil, errno.ErrOperationFailed.WithMessage("获取用户档案失败: " + err.Error())
+		// 锁定已到期，自动解锁
+		if err := l.dal.UserProfile().UnlockAccount(ctx, userProfile.ID.String()); err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage("自动解锁用户失败: " + err.Error())
+		}
+
+		userProfile.Status = models.UserStatusActive
+		userProfile.LoginAttempts = 0
+		userProfile.LastFailedLoginAt = nil
+		userProfile.LockedUntil = nil
 	}
 
 	// 验证密码
 	if !convutil.VerifyPassword(*req.Password, userProfile.PasswordHash) {
-		// 增加登录失败次数
-		_ = l.dal.UserProfile().IncrementLoginAttempts(ctx, userProfile.ID.String())
-		return nil, errno.ErrInvalidCredentials
+		return nil, l.handleLoginFailure(ctx, userProfile)
 	}
 
 	// 检查账户状态
@@ -68,6 +94,20 @@ func (l *LogicImpl) Login(
 		return nil, errno.ErrUserSuspended
 	}
 
+	// 账户超过有效期后不允许登录，需由管理员延长有效期
+	if userProfile.IsAccountExpired(models.GetCurrentTimestamp()) {
+		return nil, errno.ErrAccountExpired
+	}
+
+	// 密码超过有效期时标记为必须修改，后续登录同样被拦截直至修改密码
+	if l.isPasswordExpired(userProfile) {
+		if err := l.dal.UserProfile().SetMustChangePassword(ctx, userProfile.ID.String(), true); err != nil {
+			slog.WarnContext(ctx, "标记密码过期失败", "error", err, "userID", userProfile.ID.String())
+		}
+
+		return nil, errno.ErrPasswordExpired
+	}
+
 	// 检查是否需要强制修改密码
 	if userProfile.MustChangePassword {
 		return nil, errno.ErrMustChangePassword
@@ -104,38 +144,82 @@ func (l *LogicImpl) Login(
 		// TODO: 添加日志记录
 	}
 
+	// 确定登录后代表的组织，菜单、角色与权限均按该组织计算
+	organizationID, err := resolveActiveOrganization(memberships, convutil.StringValue(req.OrganizationID))
+	if err != nil {
+		return nil, err
+	}
+
 	// 构建登录响应
 	resp := l.converter.BuildLoginResponse(userProfile, memberships)
 
-	// 获取用户菜单树和权限信息
-	menuResp, err := l.menuLogic.GetUserMenuTree(ctx, &identity_srv.GetUserMenuTreeRequest{
-		UserID: &userID,
-	})
-	if err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage("获取用户权限失败: " + err.Error())
+	if err := l.fillOrganizationAccess(ctx, resp, userID, organizationID); err != nil {
+		return nil, err
 	}
 
-	// 检查用户是否有活跃角色
-	if len(menuResp.RoleIDs) == 0 {
-		return nil, errno.ErrNoActiveRoles.WithMessage("用户没有可用的角色，无法登录")
+	// 多因素认证：已绑定的用户需由网关完成第二步校验后再签发令牌；
+	// 角色要求但尚未绑定的用户需先完成绑定
+	if userProfile.MFAEnabled {
+		resp.MfaRequired = convutil.BoolPtr(true)
+	} else {
+		required, err := l.rolesRequireMFA(ctx, resp.RoleIDs)
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage("获取角色多因素认证要求失败: " + err.Error())
+		}
+
+		resp.MfaEnrollmentRequired = convutil.BoolPtr(required)
 	}
 
-	// 获取用户菜单权限
-	permissions, err := l.menuLogic.GetUserMenuPermissions(
-		ctx,
-		&identity_srv.GetUserMenuPermissionsRequest{
-			UserID: &userID,
-		},
-	)
+	return resp, nil
+}
+
+// handleLoginFailure 处理密码校验失败：累计失败次数，达到阈值时锁定账户
+func (l *LogicImpl) handleLoginFailure(ctx context.Context, userProfile *models.UserProfile) error {
+	userID := userProfile.ID.String()
+
+	if !l.lockout.Enabled || l.lockout.MaxAttempts <= 0 {
+		_ = l.dal.UserProfile().IncrementLoginAttempts(ctx, userID)
+		return errno.ErrInvalidCredentials
+	}
+
+	now := time.Now()
+
+	// 窗口为 0 时不限制统计范围，失败次数持续累加
+	var windowStart int64
+	if l.lockout.AttemptWindow > 0 {
+		windowStart = now.Add(-l.lockout.AttemptWindow).UnixMilli()
+	}
+
+	attempts, err := l.dal.UserProfile().RecordLoginFailure(ctx, userID, windowStart)
 	if err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage("获取用户权限失败: " + err.Error())
+		slog.WarnContext(ctx, "记录登录失败次数失败", "error", err, "userID", userID)
+		return errno.ErrInvalidCredentials
+	}
+
+	if attempts < l.lockout.MaxAttempts {
+		return errno.ErrInvalidCredentials
 	}
 
-	resp.MenuTree = menuResp.MenuTree
-	resp.RoleIDs = menuResp.RoleIDs
-	resp.Permissions = permissions.Permissions
+	// LockDuration 为 0 时不设置截止时间，需管理员手动解锁
+	var lockedUntil *int64
+	if l.lockout.LockDuration > 0 {
+		until := now.Add(l.lockout.LockDuration).UnixMilli()
+		lockedUntil = &until
+	}
 
-	return resp, nil
+	if err := l.dal.UserProfile().LockAccount(ctx, userID, lockedUntil); err != nil {
+		slog.WarnContext(ctx, "锁定用户账户失败", "error", err, "userID", userID)
+		return errno.ErrInvalidCredentials
+	}
+
+	metrics.RecordAccountLockout()
+	slog.WarnContext(ctx, "用户连续登录失败，账户已锁定",
+		"userID", userID,
+		"attempts", attempts,
+		"lockedUntil", lockedUntil,
+	)
+
+	return errno.ErrUserLocked
 }
 
 // ChangePassword 修改用户密码
@@ -166,6 +250,11 @@ func (l *LogicImpl) ChangePassword(
 		return errno.ErrInvalidPassword
 	}
 
+	// 校验密码策略与历史密码
+	if err := l.checkNewPassword(ctx, profile, *req.NewPassword_); err != nil {
+		return err
+	}
+
 	// 生成新密码哈希
 	newPasswordHash, err := convutil.HashPassword(*req.NewPassword_)
 	if err != nil {
@@ -174,7 +263,7 @@ func (l *LogicImpl) ChangePassword(
 
 	// 更新密码
 	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
-		return txDAL.UserProfile().UpdatePassword(ctx, *req.UserID, newPasswordHash)
+		return l.savePassword(ctx, txDAL, *req.UserID, newPasswordHash)
 	})
 	if err != nil {
 		return errno.ErrOperationFailed.WithMessage("更新密码失败: " + err.Error())
@@ -196,6 +285,21 @@ func (l *LogicImpl) ResetPassword(
 		return errno.ErrInvalidParams.WithMessage("新密码不能为空")
 	}
 
+	// 获取用户档案
+	profile, err := l.dal.UserProfile().GetByID(ctx, *req.UserID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return errno.ErrUserNotFound
+		}
+
+		return errno.ErrOperationFailed.WithMessage("获取用户档案失败: " + err.Error())
+	}
+
+	// 校验密码策略与历史密码
+	if err := l.checkNewPassword(ctx, profile, *req.NewPassword_); err != nil {
+		return err
+	}
+
 	// 生成新密码哈希
 	newPasswordHash, err := convutil.HashPassword(*req.NewPassword_)
 	if err != nil {
@@ -204,7 +308,7 @@ func (l *LogicImpl) ResetPassword(
 
 	// 重置密码
 	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
-		return txDAL.UserProfile().UpdatePassword(ctx, *req.UserID, newPasswordHash)
+		return l.savePassword(ctx, txDAL, *req.UserID, newPasswordHash)
 	})
 	if err != nil {
 		return errno.ErrOperationFailed.WithMessage("重置密码失败: " + err.Error())
@@ -213,6 +317,62 @@ func (l *LogicImpl) ResetPassword(
 	return nil
 }
 
+// isPasswordExpired 检查密码是否超过配置的有效期
+func (l *LogicImpl) isPasswordExpired(userProfile *models.UserProfile) bool {
+	if l.passwordPolicy.ExpiryDays <= 0 {
+		return false
+	}
+
+	maxAge := time.Duration(l.passwordPolicy.ExpiryDays) * 24 * time.Hour
+
+	return userProfile.IsPasswordExpired(models.GetCurrentTimestamp(), maxAge)
+}
+
+// checkNewPassword 按密码策略校验新密码，并拒绝与当前密码或最近使用过的密码相同的新密码
+func (l *LogicImpl) checkNewPassword(ctx context.Context, profile *models.UserProfile, newPassword string) error {
+	if violations := l.passwordPolicy.Validate(newPassword, profile.Username, profile.Email); len(violations) > 0 {
+		return errno.ErrPasswordPolicyViolation.WithDetails(password.ViolationDetails(violations))
+	}
+
+	if l.passwordPolicy.HistoryCount <= 0 {
+		return nil
+	}
+
+	reused := errno.ErrPasswordReused.WithDetails(map[string]string{
+		password.RuleReused: fmt.Sprintf("不能使用最近%d次使用过的密码", l.passwordPolicy.HistoryCount),
+	})
+
+	if convutil.VerifyPassword(newPassword, profile.PasswordHash) {
+		return reused
+	}
+
+	histories, err := l.dal.PasswordHistory().ListRecent(ctx, profile.ID.String(), l.passwordPolicy.HistoryCount)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("获取密码历史失败: " + err.Error())
+	}
+
+	for _, history := range histories {
+		if convutil.VerifyPassword(newPassword, history.PasswordHash) {
+			return reused
+		}
+	}
+
+	return nil
+}
+
+// savePassword 更新密码并记录密码历史，需在事务中调用
+func (l *LogicImpl) savePassword(ctx context.Context, txDAL dal.DAL, userID, passwordHash string) error {
+	if err := txDAL.UserProfile().UpdatePassword(ctx, userID, passwordHash); err != nil {
+		return err
+	}
+
+	if l.passwordPolicy.HistoryCount <= 0 {
+		return nil
+	}
+
+	return txDAL.PasswordHistory().Record(ctx, userID, passwordHash, l.passwordPolicy.HistoryCount)
+}
+
 // ForcePasswordChange 强制用户修改密码
 func (l *LogicImpl) ForcePasswordChange(
 	ctx context.Context,
@@ -0,0 +1,175 @@
+package authentication
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const testPassword = "Correct-Horse-1"
+
+func (r *memoryUserProfiles) getByID(userID string) (*models.UserProfile, error) {
+	for _, profile := range r.profiles {
+		if profile.ID.String() == userID {
+			return profile, nil
+		}
+	}
+
+	return nil, fmt.Errorf("用户不存在或已删除")
+}
+
+// RecordLoginFailure 与仓储实现一致：上次失败早于窗口起点时重新从 1 开始计数
+func (r *memoryUserProfiles) RecordLoginFailure(_ context.Context, userID string, windowStart int64) (int32, error) {
+	profile, err := r.getByID(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if profile.LastFailedLoginAt == nil || *profile.LastFailedLoginAt < windowStart {
+		profile.LoginAttempts = 1
+	} else {
+		profile.LoginAttempts++
+	}
+
+	now := models.GetCurrentTimestamp()
+	profile.LastFailedLoginAt = &now
+
+	return profile.LoginAttempts, nil
+}
+
+func (r *memoryUserProfiles) LockAccount(_ context.Context, userID string, lockedUntil *int64) error {
+	profile, err := r.getByID(userID)
+	if err != nil {
+		return err
+	}
+
+	profile.Status = models.UserStatusLocked
+	profile.LockedUntil = lockedUntil
+
+	return nil
+}
+
+func (r *memoryUserProfiles) UnlockAccount(_ context.Context, userID string) error {
+	profile, err := r.getByID(userID)
+	if err != nil {
+		return err
+	}
+
+	profile.Status = models.UserStatusActive
+	profile.LoginAttempts = 0
+	profile.LastFailedLoginAt = nil
+	profile.LockedUntil = nil
+
+	return nil
+}
+
+// newLockoutTestUser 创建使用 testPassword 的用户，直接保存在内存仓储中
+// 内存仓储按用户名返回同一个对象，登录逻辑对其所做的修改与仓储写入互不覆盖
+func newLockoutTestUser(t *testing.T) (*models.UserProfile, *LogicImpl) {
+	t.Helper()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	profile := newTestProfile("alice", "", "")
+	profile.PasswordHash = string(hash)
+
+	l := &LogicImpl{
+		dal: newMemoryDAL(profile),
+		lockout: config.LockoutConfig{
+			Enabled:       true,
+			MaxAttempts:   3,
+			AttemptWindow: 15 * time.Minute,
+			LockDuration:  30 * time.Minute,
+		},
+	}
+
+	return profile, l
+}
+
+func login(l *LogicImpl, password string) error {
+	username := "alice"
+
+	_, err := l.Login(context.Background(), &identity_srv.LoginRequest{
+		Username: &username,
+		Password: &password,
+	})
+
+	return err
+}
+
+func TestLogin_LocksAfterMaxAttempts(t *testing.T) {
+	profile, l := newLockoutTestUser(t)
+
+	assert.Equal(t, errno.ErrInvalidCredentials, login(l, "wrong"))
+	assert.Equal(t, errno.ErrInvalidCredentials, login(l, "wrong"))
+	assert.Equal(t, int32(2), profile.LoginAttempts)
+	assert.False(t, profile.IsLocked())
+
+	before := time.Now()
+
+	assert.Equal(t, errno.ErrUserLocked, login(l, "wrong"))
+	assert.True(t, profile.IsLocked())
+	require.NotNil(t, profile.LockedUntil)
+	assert.WithinDuration(t, before.Add(30*time.Minute), time.UnixMilli(*profile.LockedUntil), 5*time.Second)
+
+	// 锁定期间先于密码校验拒绝登录，正确的密码同样被拒绝且不再累加失败次数
+	assert.Equal(t, errno.ErrUserLocked, login(l, testPassword))
+	assert.Equal(t, int32(3), profile.LoginAttempts)
+}
+
+func TestLogin_FailuresOutsideWindowRestartCount(t *testing.T) {
+	profile, l := newLockoutTestUser(t)
+
+	// 上次失败已超出统计窗口，之前的失败次数不再计入
+	staleFailure := time.Now().Add(-time.Hour).UnixMilli()
+	profile.LoginAttempts = 2
+	profile.LastFailedLoginAt = &staleFailure
+
+	assert.Equal(t, errno.ErrInvalidCredentials, login(l, "wrong"))
+	assert.Equal(t, int32(1), profile.LoginAttempts)
+	assert.False(t, profile.IsLocked())
+}
+
+func TestLogin_AutoUnlocksExpiredLock(t *testing.T) {
+	profile, l := newLockoutTestUser(t)
+
+	lockedUntil := time.Now().Add(-time.Minute).UnixMilli()
+	lastFailure := time.Now().Add(-31 * time.Minute).UnixMilli()
+	profile.Status = models.UserStatusLocked
+	profile.LoginAttempts = 3
+	profile.LastFailedLoginAt = &lastFailure
+	profile.LockedUntil = &lockedUntil
+
+	// 锁定到期后自动解锁，本次失败从 1 重新计数而不是立即再次锁定
+	assert.Equal(t, errno.ErrInvalidCredentials, login(l, "wrong"))
+	assert.Equal(t, models.UserStatusActive, profile.Status)
+	assert.Equal(t, int32(1), profile.LoginAttempts)
+	assert.Nil(t, profile.LockedUntil)
+}
+
+func TestLogin_ManualLockNotAutoUnlocked(t *testing.T) {
+	profile, l := newLockoutTestUser(t)
+	l.lockout.LockDuration = 0
+
+	for range 2 {
+		assert.Equal(t, errno.ErrInvalidCredentials, login(l, "wrong"))
+	}
+
+	// 锁定时长为 0 时不设置截止时间，需管理员手动解锁
+	assert.Equal(t, errno.ErrUserLocked, login(l, "wrong"))
+	assert.True(t, profile.IsLocked())
+	assert.Nil(t, profile.LockedUntil)
+
+	assert.Equal(t, errno.ErrUserLocked, login(l, testPassword))
+	assert.True(t, profile.IsLocked())
+}
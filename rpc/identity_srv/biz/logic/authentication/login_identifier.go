@@ -0,0 +1,80 @@
+package authentication
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+)
+
+// loginIdentifierKind 登录账号的匹配方式
+type loginIdentifierKind int
+
+const (
+	loginByUsername loginIdentifierKind = iota
+	loginByEmail
+	loginByPhone
+)
+
+// phoneIdentifierPattern 按手机号匹配的登录账号格式（可带国际区号前缀 +）
+var phoneIdentifierPattern = regexp.MustCompile(`^\+?[0-9]{6,20}$`)
+
+// classifyLoginIdentifier 按格式判断用户名未匹配时登录账号的备选匹配方式
+// 用户名只允许字母、数字、下划线和短横线，含 @ 的账号只可能是邮箱；纯数字账号既可能是用户名也可能是手机号
+func classifyLoginIdentifier(identifier string) loginIdentifierKind {
+	switch {
+	case strings.Contains(identifier, "@"):
+		return loginByEmail
+	case phoneIdentifierPattern.MatchString(identifier):
+		return loginByPhone
+	default:
+		return loginByUsername
+	}
+}
+
+// resolveLoginUser 按登录账号查找用户
+// 1. 优先按用户名精确匹配（用户名唯一，他人的邮箱或手机号无法抢占某个用户名的登录）
+// 2. 未匹配时按格式改用邮箱或手机号匹配，须唯一对应一个账户
+// 对应多个账户时返回与密码错误相同的凭据错误，避免在校验密码前泄露邮箱或手机号被多个账户共用
+func (l *LogicImpl) resolveLoginUser(ctx context.Context, identifier string) (*models.UserProfile, error) {
+	identifier = strings.TrimSpace(identifier)
+	if identifier == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("登录账号不能为空")
+	}
+
+	userProfile, err := l.dal.UserProfile().GetByUsername(ctx, identifier)
+	if err == nil {
+		return userProfile, nil
+	}
+
+	if !errno.IsRecordNotFound(err) {
+		return nil, errno.ErrOperationFailed.WithMessage("获取用户档案失败: " + err.Error())
+	}
+
+	// 只需判断是否唯一，最多查询两条
+	var candidates []*models.UserProfile
+
+	switch classifyLoginIdentifier(identifier) {
+	case loginByEmail:
+		candidates, err = l.dal.UserProfile().ListByEmail(ctx, identifier, 2)
+	case loginByPhone:
+		candidates, err = l.dal.UserProfile().ListByPhone(ctx, identifier, 2)
+	default:
+		return nil, errno.ErrUserNotFound
+	}
+
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("获取用户档案失败: " + err.Error())
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, errno.ErrUserNotFound
+	case 1:
+		return candidates[0], nil
+	default:
+		return nil, errno.ErrInvalidCredentials
+	}
+}
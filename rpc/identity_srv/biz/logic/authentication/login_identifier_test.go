@@ -0,0 +1,152 @@
+package authentication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/user"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// memoryDAL 仅提供内存用户档案仓储的 DAL，未实现的方法调用时 panic
+type memoryDAL struct {
+	dal.DAL
+
+	users *memoryUserProfiles
+}
+
+func (d *memoryDAL) UserProfile() user.UserProfileRepository {
+	return d.users
+}
+
+// memoryUserProfiles 按 UserProfileRepository 约定在内存中保存用户档案，未实现的方法调用时 panic
+type memoryUserProfiles struct {
+	user.UserProfileRepository
+
+	profiles []*models.UserProfile
+}
+
+func newMemoryDAL(profiles ...*models.UserProfile) *memoryDAL {
+	return &memoryDAL{users: &memoryUserProfiles{profiles: profiles}}
+}
+
+func (r *memoryUserProfiles) GetByUsername(_ context.Context, username string) (*models.UserProfile, error) {
+	for _, profile := range r.profiles {
+		if profile.Username == username {
+			return profile, nil
+		}
+	}
+
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryUserProfiles) list(match func(*models.UserProfile) bool, limit int) []*models.UserProfile {
+	var matched []*models.UserProfile
+
+	for _, profile := range r.profiles {
+		if match(profile) && len(matched) < limit {
+			matched = append(matched, profile)
+		}
+	}
+
+	return matched
+}
+
+func (r *memoryUserProfiles) ListByEmail(_ context.Context, email string, limit int) ([]*models.UserProfile, error) {
+	return r.list(func(p *models.UserProfile) bool { return p.Email == email }, limit), nil
+}
+
+func (r *memoryUserProfiles) ListByPhone(_ context.Context, phone string, limit int) ([]*models.UserProfile, error) {
+	return r.list(func(p *models.UserProfile) bool { return p.Phone == phone }, limit), nil
+}
+
+func newTestProfile(username, email, phone string) *models.UserProfile {
+	profile := &models.UserProfile{
+		Username: username,
+		Email:    email,
+		Phone:    phone,
+		Status:   models.UserStatusActive,
+	}
+	profile.ID = uuid.New()
+
+	return profile
+}
+
+func TestClassifyLoginIdentifier(t *testing.T) {
+	cases := map[string]loginIdentifierKind{
+		"alice":             loginByUsername,
+		"alice_01":          loginByUsername,
+		"alice@example.com": loginByEmail,
+		"13800138000":       loginByPhone,
+		"+8613800138000":    loginByPhone,
+		"12345":             loginByUsername,
+		"138-0013-8000":     loginByUsername,
+	}
+
+	for identifier, want := range cases {
+		assert.Equal(t, want, classifyLoginIdentifier(identifier), identifier)
+	}
+}
+
+func TestResolveLoginUser(t *testing.T) {
+	alice := newTestProfile("alice", "shared@example.com", "13800138000")
+	bob := newTestProfile("bob", "shared@example.com", "13900139000")
+	// carol 的用户名恰好是 bob 的手机号，用户名匹配优先
+	carol := newTestProfile("13900139000", "carol@example.com", "13700137000")
+
+	l := &LogicImpl{dal: newMemoryDAL(alice, bob, carol)}
+
+	cases := []struct {
+		name       string
+		identifier string
+		want       *models.UserProfile
+		wantErr    error
+	}{
+		{name: "username", identifier: " alice ", want: alice},
+		{name: "unique email", identifier: "carol@example.com", want: carol},
+		{name: "unique phone", identifier: "13800138000", want: alice},
+		{name: "username wins over phone", identifier: "13900139000", want: carol},
+		{name: "unknown email", identifier: "nobody@example.com", wantErr: errno.ErrUserNotFound},
+		{name: "unknown username", identifier: "nobody", wantErr: errno.ErrUserNotFound},
+		// 多个账户共用邮箱时不得在校验密码前暴露这一事实
+		{name: "ambiguous email", identifier: "shared@example.com", wantErr: errno.ErrInvalidCredentials},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := l.resolveLoginUser(context.Background(), tc.identifier)
+			if tc.wantErr != nil {
+				assert.Equal(t, tc.wantErr, err)
+				assert.Nil(t, got)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Same(t, tc.want, got)
+		})
+	}
+}
+
+func TestLogin_AmbiguousIdentifier(t *testing.T) {
+	l := &LogicImpl{dal: newMemoryDAL(
+		newTestProfile("alice", "shared@example.com", ""),
+		newTestProfile("bob", "shared@example.com", ""),
+	)}
+
+	identifier, password := "shared@example.com", "any-password"
+
+	// 与密码错误返回相同的错误，调用方无法区分邮箱被共用与密码错误
+	_, err := l.Login(context.Background(), &identity_srv.LoginRequest{
+		Username: &identifier,
+		Password: &password,
+	})
+	assert.Equal(t, errno.ErrInvalidCredentials, err)
+}
@@ -0,0 +1,323 @@
+package authentication
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/mfa"
+)
+
+// ============================================================================
+// 多因素认证 (TOTP)
+// ============================================================================
+
+// BeginMFAEnrollment 生成待确认的 TOTP 密钥
+// 重复调用会覆盖尚未确认的密钥
+func (l *LogicImpl) BeginMFAEnrollment(
+	ctx context.Context,
+	req *identity_srv.BeginMFAEnrollmentRequest,
+) (*identity_srv.BeginMFAEnrollmentResponse, error) {
+	if req.UserID == nil || *req.UserID == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("用户ID不能为空")
+	}
+
+	userProfile, err := l.getUserProfile(ctx, *req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if userProfile.MFAEnabled {
+		return nil, errno.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := mfa.GenerateSecret()
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(err.Error())
+	}
+
+	if err := l.dal.UserProfile().SetMFASecret(ctx, *req.UserID, secret); err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("保存多因素认证密钥失败: " + err.Error())
+	}
+
+	provisioningURI := mfa.ProvisioningURI(l.mfa.Issuer, userProfile.Username, secret)
+
+	return &identity_srv.BeginMFAEnrollmentResponse{
+		Secret:          &secret,
+		ProvisioningURI: &provisioningURI,
+	}, nil
+}
+
+// ConfirmMFAEnrollment 校验验证码后启用多因素认证并生成恢复码
+func (l *LogicImpl) ConfirmMFAEnrollment(
+	ctx context.Context,
+	req *identity_srv.MFACodeRequest,
+) (*identity_srv.MFARecoveryCodesResponse, error) {
+	userProfile, err := l.getMFACodeRequestUser(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if userProfile.MFAEnabled {
+		return nil, errno.ErrMFAAlreadyEnabled
+	}
+
+	if !userProfile.HasPendingMFAEnrollment() {
+		return nil, errno.ErrMFAEnrollmentNotStarted
+	}
+
+	step, ok := mfa.ValidateCode(userProfile.MFASecret, *req.Code, time.Now(), l.mfa.AllowedSkew)
+	if !ok {
+		return nil, errno.ErrInvalidMFACode
+	}
+
+	codes, hashes, err := l.generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := txDAL.UserProfile().EnableMFA(ctx, *req.UserID, step); err != nil {
+			return err
+		}
+
+		return txDAL.MFARecoveryCode().ReplaceByUserID(ctx, *req.UserID, hashes)
+	})
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("启用多因素认证失败: " + err.Error())
+	}
+
+	return &identity_srv.MFARecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// VerifyMFA 校验 TOTP 验证码或恢复码
+// 校验失败计入登录失败次数，与密码错误共用账户锁定策略
+func (l *LogicImpl) VerifyMFA(
+	ctx context.Context,
+	req *identity_srv.MFACodeRequest,
+) (*identity_srv.VerifyMFAResponse, error) {
+	userProfile, err := l.getMFACodeRequestUser(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userProfile.MFAEnabled {
+		return nil, errno.ErrMFANotEnabled
+	}
+
+	if userProfile.IsLocked() && !userProfile.IsLockExpired(models.GetCurrentTimestamp()) {
+		return nil, errno.ErrUserLocked
+	}
+
+	usedRecoveryCode, err := l.verifyMFACode(ctx, userProfile, *req.Code, true)
+	if err != nil {
+		if err == errno.ErrInvalidMFACode {
+			if lockErr := l.handleLoginFailure(ctx, userProfile); lockErr == errno.ErrUserLocked {
+				return nil, errno.ErrUserLocked
+			}
+		}
+
+		return nil, err
+	}
+
+	resp := &identity_srv.VerifyMFAResponse{
+		UsedRecoveryCode: &usedRecoveryCode,
+	}
+
+	if usedRecoveryCode {
+		remaining, err := l.dal.MFARecoveryCode().CountUnused(ctx, *req.UserID)
+		if err != nil {
+			slog.WarnContext(ctx, "统计剩余恢复码失败", "error", err, "userID", *req.UserID)
+		} else {
+			count := int32(remaining)
+			resp.RemainingRecoveryCodes = &count
+		}
+	}
+
+	return resp, nil
+}
+
+// DisableMFA 关闭多因素认证
+// 已启用时需提供验证码或恢复码；仅存在待确认密钥时直接取消绑定
+func (l *LogicImpl) DisableMFA(ctx context.Context, req *identity_srv.MFACodeRequest) error {
+	if req.UserID == nil || *req.UserID == "" {
+		return errno.ErrInvalidParams.WithMessage("用户ID不能为空")
+	}
+
+	userProfile, err := l.getUserProfile(ctx, *req.UserID)
+	if err != nil {
+		return err
+	}
+
+	if !userProfile.MFAEnabled && !userProfile.HasPendingMFAEnrollment() {
+		return errno.ErrMFANotEnabled
+	}
+
+	if userProfile.MFAEnabled {
+		if req.Code == nil || strings.TrimSpace(*req.Code) == "" {
+			return errno.ErrInvalidParams.WithMessage("验证码不能为空")
+		}
+
+		if _, err := l.verifyMFACode(ctx, userProfile, *req.Code, true); err != nil {
+			return err
+		}
+	}
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := txDAL.UserProfile().DisableMFA(ctx, *req.UserID); err != nil {
+			return err
+		}
+
+		return txDAL.MFARecoveryCode().DeleteByUserID(ctx, *req.UserID)
+	})
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("关闭多因素认证失败: " + err.Error())
+	}
+
+	return nil
+}
+
+// RegenerateMFARecoveryCodes 重新生成恢复码，原有恢复码全部失效
+// 仅接受 TOTP 验证码，避免使用最后一个恢复码无限续期
+func (l *LogicImpl) RegenerateMFARecoveryCodes(
+	ctx context.Context,
+	req *identity_srv.MFACodeRequest,
+) (*identity_srv.MFARecoveryCodesResponse, error) {
+	userProfile, err := l.getMFACodeRequestUser(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !userProfile.MFAEnabled {
+		return nil, errno.ErrMFANotEnabled
+	}
+
+	if _, err := l.verifyMFACode(ctx, userProfile, *req.Code, false); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := l.generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		return txDAL.MFARecoveryCode().ReplaceByUserID(ctx, *req.UserID, hashes)
+	})
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("生成恢复码失败: " + err.Error())
+	}
+
+	return &identity_srv.MFARecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// verifyMFACode 校验 TOTP 验证码，allowRecoveryCode 为 true 时同时接受恢复码
+// 返回是否使用了恢复码；同一时间步的验证码与已使用的恢复码均不能再次使用
+func (l *LogicImpl) verifyMFACode(
+	ctx context.Context,
+	userProfile *models.UserProfile,
+	code string,
+	allowRecoveryCode bool,
+) (bool, error) {
+	userID := userProfile.ID.String()
+
+	if step, ok := mfa.ValidateCode(userProfile.MFASecret, code, time.Now(), l.mfa.AllowedSkew); ok {
+		advanced, err := l.dal.UserProfile().AdvanceMFAStep(ctx, userID, step)
+		if err != nil {
+			return false, errno.ErrOperationFailed.WithMessage(err.Error())
+		}
+
+		if !advanced {
+			return false, errno.ErrInvalidMFACode
+		}
+
+		return false, nil
+	}
+
+	if !allowRecoveryCode {
+		return false, errno.ErrInvalidMFACode
+	}
+
+	consumed, err := l.dal.MFARecoveryCode().Consume(ctx, userID, mfa.HashRecoveryCode(code))
+	if err != nil {
+		return false, errno.ErrOperationFailed.WithMessage(err.Error())
+	}
+
+	if !consumed {
+		return false, errno.ErrInvalidMFACode
+	}
+
+	slog.InfoContext(ctx, "用户使用恢复码完成多因素认证", "userID", userID)
+
+	return true, nil
+}
+
+// generateRecoveryCodes 生成恢复码明文及其摘要
+func (l *LogicImpl) generateRecoveryCodes() ([]string, []string, error) {
+	codes, err := mfa.GenerateRecoveryCodes(l.mfa.RecoveryCodeCount)
+	if err != nil {
+		return nil, nil, errno.ErrOperationFailed.WithMessage(err.Error())
+	}
+
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, mfa.HashRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
+
+// rolesRequireMFA 检查用户的角色中是否有要求多因素认证的角色
+func (l *LogicImpl) rolesRequireMFA(ctx context.Context, roleIDs []string) (bool, error) {
+	if len(roleIDs) == 0 {
+		return false, nil
+	}
+
+	roles, err := l.dal.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
+	if err != nil {
+		return false, err
+	}
+
+	for _, role := range roles {
+		if role.MFARequired {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getMFACodeRequestUser 校验验证码请求参数并获取用户档案
+func (l *LogicImpl) getMFACodeRequestUser(
+	ctx context.Context,
+	req *identity_srv.MFACodeRequest,
+) (*models.UserProfile, error) {
+	if req.UserID == nil || *req.UserID == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("用户ID不能为空")
+	}
+
+	if req.Code == nil || strings.TrimSpace(*req.Code) == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("验证码不能为空")
+	}
+
+	return l.getUserProfile(ctx, *req.UserID)
+}
+
+// getUserProfile 根据用户ID获取用户档案
+func (l *LogicImpl) getUserProfile(ctx context.Context, userID string) (*models.UserProfile, error) {
+	userProfile, err := l.dal.UserProfile().GetByID(ctx, userID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return nil, errno.ErrUserNotFound
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("获取用户档案失败: " + err.Error())
+	}
+
+	return userProfile, nil
+}
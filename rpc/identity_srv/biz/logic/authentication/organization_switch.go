@@ -0,0 +1,153 @@
+package authentication
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/convutil"
+	membershipDAL "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/membership"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+)
+
+// SwitchOrganization 切换用户当前代表的组织
+// 用户必须是目标组织的有效成员，且在该组织内拥有可用角色；已通过登录认证，不再要求多因素认证
+func (l *LogicImpl) SwitchOrganization(
+	ctx context.Context,
+	req *identity_srv.SwitchOrganizationRequest,
+) (*identity_srv.LoginResponse, error) {
+	if req.UserID == nil || *req.UserID == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("用户ID不能为空")
+	}
+
+	if req.OrganizationID == nil || *req.OrganizationID == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("组织ID不能为空")
+	}
+
+	userID := *req.UserID
+
+	userProfile, err := l.dal.UserProfile().GetByID(ctx, userID)
+	if err != nil {
+		if errno.IsRecordNotFound(err) {
+			return nil, errno.ErrUserNotFound
+		}
+
+		return nil, errno.ErrOperationFailed.WithMessage("获取用户档案失败: " + err.Error())
+	}
+
+	if userProfile.Status == models.UserStatusInactive {
+		return nil, errno.ErrUserInactive
+	}
+
+	if userProfile.Status == models.UserStatusSuspended {
+		return nil, errno.ErrUserSuspended
+	}
+
+	if userProfile.IsLocked() {
+		return nil, errno.ErrUserLocked
+	}
+
+	if userProfile.IsAccountExpired(models.GetCurrentTimestamp()) {
+		return nil, errno.ErrAccountExpired
+	}
+
+	activeStatus := models.MembershipStatusActive
+	memberships, _, err := l.dal.UserMembership().FindWithConditions(ctx, &membershipDAL.UserMembershipQueryConditions{
+		UserID: &userID,
+		Status: &activeStatus,
+	})
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("获取用户成员关系失败: " + err.Error())
+	}
+
+	organizationID, err := resolveActiveOrganization(memberships, *req.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := l.converter.BuildLoginResponse(userProfile, memberships)
+
+	if err := l.fillOrganizationAccess(ctx, resp, userID, organizationID); err != nil {
+		return nil, err
+	}
+
+	// 目标组织内的角色可能要求多因素认证
+	if !userProfile.MFAEnabled {
+		required, err := l.rolesRequireMFA(ctx, resp.RoleIDs)
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage("获取角色多因素认证要求失败: " + err.Error())
+		}
+
+		resp.MfaEnrollmentRequired = convutil.BoolPtr(required)
+	}
+
+	return resp, nil
+}
+
+// fillOrganizationAccess 按用户代表的组织填充登录响应中的菜单树、角色与菜单权限
+// 组织内没有可用角色时返回 ErrNoActiveRoles
+func (l *LogicImpl) fillOrganizationAccess(
+	ctx context.Context,
+	resp *identity_srv.LoginResponse,
+	userID, organizationID string,
+) error {
+	var orgID *string
+	if organizationID != "" {
+		orgID = &organizationID
+	}
+
+	menuResp, err := l.menuLogic.GetUserMenuTree(ctx, &identity_srv.GetUserMenuTreeRequest{
+		UserID:         &userID,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("获取用户权限失败: " + err.Error())
+	}
+
+	// 检查用户在该组织内是否有活跃角色
+	if len(menuResp.RoleIDs) == 0 {
+		return errno.ErrNoActiveRoles.WithMessage("用户在当前组织内没有可用的角色，无法登录")
+	}
+
+	permissions, err := l.menuLogic.GetUserMenuPermissions(ctx, &identity_srv.GetUserMenuPermissionsRequest{
+		UserID:         &userID,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("获取用户权限失败: " + err.Error())
+	}
+
+	resp.MenuTree = menuResp.MenuTree
+	resp.RoleIDs = menuResp.RoleIDs
+	resp.Permissions = permissions.Permissions
+	resp.ActiveOrganizationID = orgID
+
+	return nil
+}
+
+// resolveActiveOrganization 从用户的有效成员关系中确定当前代表的组织
+// 指定了组织时必须存在对应的成员关系；未指定时依次使用主要成员关系、首个成员关系，
+// 没有任何成员关系时返回空字符串，此时只按全局授权计算
+func resolveActiveOrganization(memberships []*models.UserMembership, requested string) (string, error) {
+	if requested != "" {
+		for _, m := range memberships {
+			if m.OrganizationID.String() == requested {
+				return requested, nil
+			}
+		}
+
+		return "", errno.ErrMembershipNotFound.WithMessage("用户不是该组织的有效成员")
+	}
+
+	for _, m := range memberships {
+		if m.IsPrimary {
+			return m.OrganizationID.String(), nil
+		}
+	}
+
+	if len(memberships) > 0 {
+		return memberships[0].OrganizationID.String(), nil
+	}
+
+	return "", nil
+}
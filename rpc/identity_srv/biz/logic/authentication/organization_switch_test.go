@@ -0,0 +1,47 @@
+package authentication
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveActiveOrganization(t *testing.T) {
+	orgA, orgB := uuid.New(), uuid.New()
+	memberships := []*models.UserMembership{
+		{OrganizationID: orgA},
+		{OrganizationID: orgB, IsPrimary: true},
+	}
+
+	t.Run("uses requested organization", func(t *testing.T) {
+		orgID, err := resolveActiveOrganization(memberships, orgA.String())
+		require.NoError(t, err)
+		assert.Equal(t, orgA.String(), orgID)
+	})
+
+	t.Run("rejects organization without membership", func(t *testing.T) {
+		_, err := resolveActiveOrganization(memberships, uuid.NewString())
+		require.Error(t, err)
+	})
+
+	t.Run("defaults to primary membership", func(t *testing.T) {
+		orgID, err := resolveActiveOrganization(memberships, "")
+		require.NoError(t, err)
+		assert.Equal(t, orgB.String(), orgID)
+	})
+
+	t.Run("falls back to first membership", func(t *testing.T) {
+		orgID, err := resolveActiveOrganization(memberships[:1], "")
+		require.NoError(t, err)
+		assert.Equal(t, orgA.String(), orgID)
+	})
+
+	t.Run("no membership means global only", func(t *testing.T) {
+		orgID, err := resolveActiveOrganization(nil, "")
+		require.NoError(t, err)
+		assert.Empty(t, orgID)
+	})
+}
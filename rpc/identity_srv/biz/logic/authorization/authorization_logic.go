@@ -0,0 +1,38 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+)
+
+// AuthorizationLogic 接口权限校验业务逻辑接口
+// 负责基于 Casbin p 策略和用户角色的接口级权限判定，供网关权限中间件调用
+type AuthorizationLogic interface {
+	// CheckPermission 检查用户是否具有指定资源的操作权限
+	//	@param	ctx	上下文
+	//	@param	req	包含用户ID、资源和操作的请求
+	//	@return	权限检查结果
+	CheckPermission(
+		ctx context.Context,
+		req *identity_srv.CheckPermissionRequest,
+	) (*identity_srv.CheckPermissionResponse, error)
+
+	// CheckRole 检查用户是否拥有任一指定角色
+	//	@param	ctx	上下文
+	//	@param	req	包含用户ID和角色名称（或角色ID）列表的请求
+	//	@return	角色检查结果
+	CheckRole(
+		ctx context.Context,
+		req *identity_srv.CheckRoleRequest,
+	) (*identity_srv.CheckRoleResponse, error)
+
+	// GetUserEffectivePermissions 批量获取用户的全部生效接口权限
+	//	@param	ctx	上下文
+	//	@param	req	包含用户ID的请求
+	//	@return	用户的生效角色与去重后的权限列表
+	GetUserEffectivePermissions(
+		ctx context.Context,
+		req *identity_srv.GetUserEffectivePermissionsRequest,
+	) (*identity_srv.GetUserEffectivePermissionsResponse, error)
+}
@@ -0,0 +1,245 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/casbin"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/convutil"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/metrics"
+)
+
+// LogicImpl 接口权限校验逻辑实现
+type LogicImpl struct {
+	dal           dal.DAL
+	casbinManager *casbin.CasbinManager
+	config        *config.Config
+}
+
+// NewLogic 创建接口权限校验逻辑实现
+func NewLogic(
+	dal dal.DAL,
+	casbinManager *casbin.CasbinManager,
+	config *config.Config,
+) AuthorizationLogic {
+	return &LogicImpl{
+		dal:           dal,
+		casbinManager: casbinManager,
+		config:        config,
+	}
+}
+
+// CheckPermission 检查用户在当前组织内是否具有指定资源的操作权限
+// 以用户在该组织内的活跃角色为主体逐一匹配 p 策略，超管角色直接放行
+func (l *LogicImpl) CheckPermission(
+	ctx context.Context,
+	req *identity_srv.CheckPermissionRequest,
+) (*identity_srv.CheckPermissionResponse, error) {
+	if req.UserID == nil || *req.UserID == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("用户ID不能为空")
+	}
+
+	if req.Resource == nil || *req.Resource == "" || req.Action == nil || *req.Action == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("资源和操作不能为空")
+	}
+
+	organizationID := convutil.StringValue(req.OrganizationID)
+
+	roles, err := l.getActiveRoles(ctx, *req.UserID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	domain := models.OrganizationDomain(organizationID)
+	allowed := false
+	resp := &identity_srv.CheckPermissionResponse{Allowed: &allowed}
+
+	for _, role := range roles {
+		if l.isSuperAdminRole(role) {
+			allowed = true
+			metrics.RecordAuthorizationDecision(true)
+
+			return resp, nil
+		}
+
+		roleID := role.ID.String()
+
+		ok, err := l.casbinManager.HasPermission(roleID, domain, *req.Resource, *req.Action)
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage(
+				fmt.Sprintf("检查角色 %s 的权限失败: %s", roleID, err.Error()),
+			)
+		}
+
+		if ok {
+			allowed = true
+			resp.MatchedRoleID = &roleID
+			metrics.RecordAuthorizationDecision(true)
+
+			return resp, nil
+		}
+	}
+
+	metrics.RecordAuthorizationDecision(false)
+
+	slog.DebugContext(ctx, "接口权限检查未通过",
+		"userID", *req.UserID,
+		"organizationID", organizationID,
+		"resource", *req.Resource,
+		"action", *req.Action,
+	)
+
+	return resp, nil
+}
+
+// CheckRole 检查用户在当前组织内是否拥有任一指定角色
+// 角色既可按名称匹配，也可按角色ID匹配
+func (l *LogicImpl) CheckRole(
+	ctx context.Context,
+	req *identity_srv.CheckRoleRequest,
+) (*identity_srv.CheckRoleResponse, error) {
+	if req.UserID == nil || *req.UserID == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("用户ID不能为空")
+	}
+
+	if len(req.Roles) == 0 {
+		return nil, errno.ErrInvalidParams.WithMessage("角色列表不能为空")
+	}
+
+	roles, err := l.getActiveRoles(ctx, *req.UserID, convutil.StringValue(req.OrganizationID))
+	if err != nil {
+		return nil, err
+	}
+
+	required := make(map[string]bool, len(req.Roles))
+	for _, role := range req.Roles {
+		required[role] = true
+	}
+
+	hasRole := false
+	resp := &identity_srv.CheckRoleResponse{HasRole: &hasRole}
+
+	for _, role := range roles {
+		if required[role.Name] || required[role.ID.String()] {
+			hasRole = true
+			resp.MatchedRole = &role.Name
+
+			return resp, nil
+		}
+	}
+
+	return resp, nil
+}
+
+// GetUserEffectivePermissions 批量获取用户在当前组织内的全部生效接口权限
+// 汇总用户在该组织内的活跃角色及其继承角色的 p 策略并去重，网关据此缓存后在本地完成鉴权
+func (l *LogicImpl) GetUserEffectivePermissions(
+	ctx context.Context,
+	req *identity_srv.GetUserEffectivePermissionsRequest,
+) (*identity_srv.GetUserEffectivePermissionsResponse, error) {
+	if req.UserID == nil || *req.UserID == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("用户ID不能为空")
+	}
+
+	roles, err := l.getActiveRoles(ctx, *req.UserID, convutil.StringValue(req.OrganizationID))
+	if err != nil {
+		return nil, err
+	}
+
+	isSuperAdmin := false
+	roleIDs := make([]string, 0, len(roles))
+	permissions := make([]*identity_srv.Permission, 0)
+	seen := make(map[[2]string]struct{})
+
+	for _, role := range roles {
+		roleID := role.ID.String()
+		roleIDs = append(roleIDs, roleID)
+
+		if l.isSuperAdminRole(role) {
+			isSuperAdmin = true
+		}
+
+		policies, err := l.casbinManager.GetRolePermissions(roleID)
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage(
+				fmt.Sprintf("获取角色 %s 的权限失败: %s", roleID, err.Error()),
+			)
+		}
+
+		for _, policy := range policies {
+			if len(policy) < 3 {
+				continue
+			}
+
+			resource, action := policy[1], policy[2]
+
+			key := [2]string{resource, action}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			permissions = append(permissions, &identity_srv.Permission{
+				Resource: &resource,
+				Action:   &action,
+			})
+		}
+	}
+
+	return &identity_srv.GetUserEffectivePermissionsResponse{
+		UserID:       req.UserID,
+		RoleIDs:      roleIDs,
+		IsSuperAdmin: &isSuperAdmin,
+		Permissions:  permissions,
+	}, nil
+}
+
+// getActiveRoles 获取用户在指定组织内所有处于活跃状态的角色定义（含全局授权），
+// 以及这些角色沿继承链继承的父角色；organizationID 为空时只包含全局授权的角色
+func (l *LogicImpl) getActiveRoles(
+	ctx context.Context,
+	userID, organizationID string,
+) ([]*models.RoleDefinition, error) {
+	roleIDs, err := l.dal.UserRoleAssignment().GetActiveRoleIDsWithStatus(
+		ctx,
+		userID,
+		organizationID,
+		models.RoleStatusActive,
+	)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("获取用户角色列表失败: " + err.Error())
+	}
+
+	if len(roleIDs) == 0 {
+		return []*models.RoleDefinition{}, nil
+	}
+
+	roleIDs, err = l.casbinManager.ExpandInheritedRoles(roleIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(err.Error())
+	}
+
+	roles, err := l.dal.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("获取角色定义失败: " + err.Error())
+	}
+
+	return roles, nil
+}
+
+// isSuperAdminRole 检查角色名称是否在配置的超管角色列表中
+func (l *LogicImpl) isSuperAdminRole(role *models.RoleDefinition) bool {
+	for _, name := range l.config.SuperAdmin.RoleNames {
+		if role.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
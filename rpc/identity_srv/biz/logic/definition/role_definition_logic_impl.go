@@ -2,26 +2,39 @@ package definition
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 
+	"github.com/google/uuid"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/auditlog"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/casbin"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
 	definitionDal "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/definition"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+	"gorm.io/gorm"
 )
 
 // LogicImpl 角色定义业务逻辑实现
 type LogicImpl struct {
-	dal       dal.DAL
-	converter converter.Converter
+	dal           dal.DAL
+	converter     converter.Converter
+	casbinManager *casbin.CasbinManager
 }
 
 // NewLogic 创建角色定义业务逻辑实例
-func NewLogic(dal dal.DAL, converter converter.Converter) RoleDefinitionLogic {
+func NewLogic(
+	dal dal.DAL,
+	converter converter.Converter,
+	casbinManager *casbin.CasbinManager,
+) RoleDefinitionLogic {
 	return &LogicImpl{
-		dal:       dal,
-		converter: converter,
+		dal:           dal,
+		converter:     converter,
+		casbinManager: casbinManager,
 	}
 }
 
@@ -41,18 +54,9 @@ func (l *LogicImpl) CreateRoleDefinition(
 	}
 
 	// 转换权限列表
-	identitys := make(models.Permissions, 0, len(req.Permissions))
-	for _, p := range req.Permissions {
-		description := ""
-		if p.Description != nil {
-			description = *p.Description
-		}
-
-		identitys = append(identitys, &models.Permission{
-			Resource:    *p.Resource,
-			Action:      *p.Action,
-			Description: description,
-		})
+	identitys, err := convertPermissions(req.Permissions)
+	if err != nil {
+		return nil, err
 	}
 
 	// 创建角色定义记录
@@ -62,13 +66,56 @@ func (l *LogicImpl) CreateRoleDefinition(
 		Status:       models.RoleStatusInactive, // 默认未激活状态
 		Permissions:  identitys,
 		IsSystemRole: req.IsSystemRole,
+		MFARequired:  req.MfaRequired,
 	}
 
-	// 保存到数据库
-	if err := l.dal.RoleDefinition().Create(ctx, roleDefinition); err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage("创建角色定义失败: " + err.Error())
+	// 角色定义、继承分组策略与权限策略在同一事务中写入
+	var inheritanceAdded, permissionsAdded [][]string
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if req.ParentRoleID != nil && *req.ParentRoleID != "" {
+			parentRoleID, err := validateParentRole(ctx, txDAL, "", *req.ParentRoleID)
+			if err != nil {
+				return err
+			}
+
+			roleDefinition.ParentRoleID = parentRoleID
+		}
+
+		if err := txDAL.RoleDefinition().Create(ctx, roleDefinition); err != nil {
+			return errno.ErrOperationFailed.WithMessage("创建角色定义失败: " + err.Error())
+		}
+
+		roleID := roleDefinition.ID.String()
+
+		if roleDefinition.ParentRoleID != nil {
+			parentRoleID := roleDefinition.ParentRoleID.String()
+
+			err := txDAL.CasbinRule().AddRoleInheritance(ctx, roleID, parentRoleID, "")
+			if err != nil {
+				return errno.ErrOperationFailed.WithMessage("同步角色继承策略失败: " + err.Error())
+			}
+
+			inheritanceAdded = [][]string{{roleID, parentRoleID}}
+		}
+
+		added, _, err := txDAL.CasbinRule().
+			ReplaceRolePermissions(ctx, roleID, casbin.RolePermissionRules(identitys), "")
+		if err != nil {
+			return errno.ErrOperationFailed.WithMessage("同步角色权限策略失败: " + err.Error())
+		}
+
+		permissionsAdded = added
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	l.syncEnforcer(ctx, inheritanceAdded, nil)
+	l.syncPermissions(ctx, permissionsAdded, nil)
+
 	// 转换为Thrift格式返回
 	return l.converter.RoleDefinition().ModelToThrift(roleDefinition), nil
 }
@@ -100,6 +147,8 @@ func (l *LogicImpl) UpdateRoleDefinition(
 		return nil, errno.ErrSystemRoleCannotModify
 	}
 
+	beforeDTO := l.converter.RoleDefinition().ModelToThrift(role)
+
 	// 更新字段
 	if req.Description != nil {
 		role.Description = *req.Description
@@ -109,6 +158,10 @@ func (l *LogicImpl) UpdateRoleDefinition(
 		role.Status = models.RoleStatus(*req.Status)
 	}
 
+	if req.MfaRequired != nil {
+		role.MFARequired = *req.MfaRequired
+	}
+
 	// 更新角色名称（如果提供）
 	if req.Name != nil {
 		// 检查新名称是否与现有名称重复（排除自身）
@@ -126,30 +179,88 @@ func (l *LogicImpl) UpdateRoleDefinition(
 	}
 
 	if req.Permissions != nil {
-		identitys := make(models.Permissions, 0, len(req.Permissions))
-		for _, p := range req.Permissions {
-			description := ""
-			if p.Description != nil {
-				description = *p.Description
+		role.Permissions, err = convertPermissions(req.Permissions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	oldParentRoleID := role.ParentRoleID
+
+	if req.ParentRoleID != nil {
+		role.ParentRoleID = nil
+
+		if *req.ParentRoleID != "" {
+			parentRoleID, err := uuid.Parse(*req.ParentRoleID)
+			if err != nil {
+				return nil, errno.ErrInvalidParams.WithMessage("父角色ID格式无效")
 			}
 
-			identitys = append(identitys, &models.Permission{
-				Resource:    *p.Resource,
-				Action:      *p.Action,
-				Description: description,
-			})
+			role.ParentRoleID = &parentRoleID
 		}
-
-		role.Permissions = identitys
 	}
 
-	// 保存更新
-	if err := l.dal.RoleDefinition().Update(ctx, role); err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage("更新角色定义失败: " + err.Error())
+	parentChanged := !uuidPtrEqual(oldParentRoleID, role.ParentRoleID)
+
+	// 角色定义更新与继承分组策略、权限策略的替换在同一事务中完成
+	var inheritanceAdded, inheritanceRemoved, permissionsAdded, permissionsRemoved [][]string
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if parentChanged && role.ParentRoleID != nil {
+			if _, err := validateParentRole(ctx, txDAL, roleID, role.ParentRoleID.String()); err != nil {
+				return err
+			}
+		}
+
+		if err := txDAL.RoleDefinition().Update(ctx, role); err != nil {
+			return errno.ErrOperationFailed.WithMessage("更新角色定义失败: " + err.Error())
+		}
+
+		if parentChanged && oldParentRoleID != nil {
+			err := txDAL.CasbinRule().RemoveRoleInheritance(ctx, roleID, oldParentRoleID.String())
+			if err != nil {
+				return errno.ErrOperationFailed.WithMessage("同步角色继承策略失败: " + err.Error())
+			}
+
+			inheritanceRemoved = [][]string{{roleID, oldParentRoleID.String()}}
+		}
+
+		if parentChanged && role.ParentRoleID != nil {
+			err := txDAL.CasbinRule().AddRoleInheritance(ctx, roleID, role.ParentRoleID.String(), "")
+			if err != nil {
+				return errno.ErrOperationFailed.WithMessage("同步角色继承策略失败: " + err.Error())
+			}
+
+			inheritanceAdded = [][]string{{roleID, role.ParentRoleID.String()}}
+		}
+
+		// 未提交权限列表时保持现有权限策略不变
+		if req.Permissions == nil {
+			return nil
+		}
+
+		added, removed, err := txDAL.CasbinRule().
+			ReplaceRolePermissions(ctx, roleID, casbin.RolePermissionRules(role.Permissions), "")
+		if err != nil {
+			return errno.ErrOperationFailed.WithMessage("同步角色权限策略失败: " + err.Error())
+		}
+
+		permissionsAdded, permissionsRemoved = added, removed
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	l.syncEnforcer(ctx, inheritanceAdded, inheritanceRemoved)
+	l.syncPermissions(ctx, permissionsAdded, permissionsRemoved)
+
 	// 转换为Thrift格式返回
-	return l.converter.RoleDefinition().ModelToThrift(role), nil
+	afterDTO := l.converter.RoleDefinition().ModelToThrift(role)
+	auditlog.SetChange(ctx, beforeDTO, afterDTO)
+
+	return afterDTO, nil
 }
 
 // DeleteRoleDefinition 删除一个角色定义
@@ -184,11 +295,50 @@ func (l *LogicImpl) DeleteRoleDefinition(ctx context.Context, roleID string) err
 		return errno.ErrRoleInUseCannotDelete
 	}
 
-	// 删除角色定义
-	if err := l.dal.RoleDefinition().Delete(ctx, roleID); err != nil {
-		return errno.ErrOperationFailed.WithMessage("删除角色定义失败: " + err.Error())
+	// 检查是否有其他角色继承该角色
+	childCount, err := l.dal.RoleDefinition().CountByParentRoleID(ctx, roleID)
+	if err != nil {
+		return errno.ErrOperationFailed.WithMessage("检查角色继承情况失败: " + err.Error())
+	}
+
+	if childCount > 0 {
+		return errno.ErrRoleInUseCannotDelete.WithMessage("角色正被其他角色继承，无法删除")
+	}
+
+	// 删除角色定义，并在同一事务中删除其继承分组策略与权限策略
+	var inheritanceRemoved, permissionsRemoved [][]string
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := txDAL.RoleDefinition().Delete(ctx, roleID); err != nil {
+			return errno.ErrOperationFailed.WithMessage("删除角色定义失败: " + err.Error())
+		}
+
+		if role.ParentRoleID != nil {
+			parentRoleID := role.ParentRoleID.String()
+
+			if err := txDAL.CasbinRule().RemoveRoleInheritance(ctx, roleID, parentRoleID); err != nil {
+				return errno.ErrOperationFailed.WithMessage("同步角色继承策略失败: " + err.Error())
+			}
+
+			inheritanceRemoved = [][]string{{roleID, parentRoleID}}
+		}
+
+		_, removed, err := txDAL.CasbinRule().ReplaceRolePermissions(ctx, roleID, nil, "")
+		if err != nil {
+			return errno.ErrOperationFailed.WithMessage("同步角色权限策略失败: " + err.Error())
+		}
+
+		permissionsRemoved = removed
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
+	l.syncEnforcer(ctx, nil, inheritanceRemoved)
+	l.syncPermissions(ctx, nil, permissionsRemoved)
+
 	return nil
 }
 
@@ -221,6 +371,18 @@ func (l *LogicImpl) GetRoleDefinition(
 	// 设置用户数量到模型（非持久化字段）
 	role.UserCount = userCount
 
+	// 计算自身与沿继承链继承的生效权限
+	role.EffectivePermissions = role.Permissions
+
+	if role.ParentRoleID != nil {
+		ancestors, err := resolveParentChain(ctx, l.dal.RoleDefinition().GetByID, role.ParentRoleID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		role.EffectivePermissions = mergeInheritedPermissions(role, ancestors)
+	}
+
 	// 转换为Thrift格式返回
 	return l.converter.RoleDefinition().ModelToThrift(role), nil
 }
@@ -299,3 +461,183 @@ func (l *LogicImpl) ListRoleDefinitions(
 		Page:  l.converter.Base().PageResponseToThrift(pageResult),
 	}, nil
 }
+
+// validateParentRole 校验父角色：必须存在、不能形成继承环、继承层级不能超过上限
+// 须在写入角色定义的事务中调用：继承链上的角色均加行锁直至事务结束，
+// 避免并发修改继承关系（如同时设置 A 继承 B、B 继承 A）各自通过校验后形成环。roleID 为空表示新建角色
+func validateParentRole(
+	ctx context.Context,
+	txDAL dal.DAL,
+	roleID, parentRoleID string,
+) (*uuid.UUID, error) {
+	if _, err := uuid.Parse(parentRoleID); err != nil {
+		return nil, errno.ErrInvalidParams.WithMessage("父角色ID格式无效")
+	}
+
+	if parentRoleID == roleID {
+		return nil, errno.ErrRoleInheritanceCycle.WithMessage("角色不能继承自身")
+	}
+
+	chain, err := resolveParentChain(ctx, txDAL.RoleDefinition().GetByIDForUpdate, parentRoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chain) == 0 {
+		return nil, errno.ErrRoleDefinitionNotFound.WithMessage("父角色不存在")
+	}
+
+	for _, ancestor := range chain {
+		if ancestor.ID.String() == roleID {
+			return nil, errno.ErrRoleInheritanceCycle.WithMessage(
+				fmt.Sprintf("角色 %s 已是该角色的子角色，不能作为父角色", chain[0].Name),
+			)
+		}
+	}
+
+	// 继承链加上角色自身的层数不能超过上限
+	if len(chain)+1 > casbin.MaxRoleInheritanceDepth {
+		return nil, errno.ErrInvalidParams.WithMessage(
+			fmt.Sprintf("角色继承层级不能超过 %d 层", casbin.MaxRoleInheritanceDepth),
+		)
+	}
+
+	parentID := chain[0].ID
+
+	return &parentID, nil
+}
+
+// resolveParentChain 从指定角色开始沿父角色向上获取继承链（含该角色自身），由近及远排列
+// getRole 为查询单个角色的方法，校验继承关系时传入加锁查询；继承链上的角色已被删除时在此截断
+func resolveParentChain(
+	ctx context.Context,
+	getRole func(ctx context.Context, id string) (*models.RoleDefinition, error),
+	roleID string,
+) ([]*models.RoleDefinition, error) {
+	var chain []*models.RoleDefinition
+
+	visited := make(map[string]struct{})
+
+	for id := roleID; id != ""; {
+		// 防御历史数据中已存在的环
+		if _, ok := visited[id]; ok {
+			break
+		}
+
+		visited[id] = struct{}{}
+
+		role, err := getRole(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				break
+			}
+
+			return nil, errno.ErrOperationFailed.WithMessage("查询父角色失败: " + err.Error())
+		}
+
+		if role == nil {
+			break
+		}
+
+		chain = append(chain, role)
+
+		id = ""
+		if role.ParentRoleID != nil {
+			id = role.ParentRoleID.String()
+		}
+	}
+
+	return chain, nil
+}
+
+// syncEnforcer 事务提交后同步内存中的角色继承策略
+// 增量同步失败时重新加载全部策略，保证内存状态与数据库一致
+func (l *LogicImpl) syncEnforcer(ctx context.Context, added, removed [][]string) {
+	err := l.casbinManager.ApplyRoleInheritanceChanges(added, removed)
+	if err == nil {
+		return
+	}
+
+	slog.WarnContext(ctx, "增量同步角色继承策略失败，重新加载全部策略", "error", err)
+
+	if err := l.casbinManager.LoadPolicy(); err != nil {
+		slog.ErrorContext(ctx, "重新加载Casbin策略失败", "error", err)
+	}
+}
+
+// syncPermissions 事务提交后同步内存中的角色权限策略
+// 增量同步失败时重新加载全部策略，保证内存状态与数据库一致
+func (l *LogicImpl) syncPermissions(ctx context.Context, added, removed [][]string) {
+	err := l.casbinManager.ApplyRolePermissionChanges(added, removed)
+	if err == nil {
+		return
+	}
+
+	slog.WarnContext(ctx, "增量同步角色权限策略失败，重新加载全部策略", "error", err)
+
+	if err := l.casbinManager.LoadPolicy(); err != nil {
+		slog.ErrorContext(ctx, "重新加载Casbin策略失败", "error", err)
+	}
+}
+
+// convertPermissions 校验并转换权限列表，资源与操作不能为空，按资源与操作去重
+func convertPermissions(permissions []*identity_srv.Permission) (models.Permissions, error) {
+	seen := make(map[[2]string]struct{}, len(permissions))
+	result := make(models.Permissions, 0, len(permissions))
+
+	for _, p := range permissions {
+		if p == nil || p.GetResource() == "" || p.GetAction() == "" {
+			return nil, errno.ErrInvalidParams.WithMessage("权限的资源和操作不能为空")
+		}
+
+		key := [2]string{p.GetResource(), p.GetAction()}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		result = append(result, &models.Permission{
+			Resource:    p.GetResource(),
+			Action:      p.GetAction(),
+			Description: p.GetDescription(),
+		})
+	}
+
+	return result, nil
+}
+
+// mergeInheritedPermissions 合并角色自身与继承链上各父角色的权限，按资源与操作去重，自身权限优先
+func mergeInheritedPermissions(
+	role *models.RoleDefinition,
+	ancestors []*models.RoleDefinition,
+) models.Permissions {
+	seen := make(map[[2]string]struct{})
+	merged := make(models.Permissions, 0, len(role.Permissions))
+
+	for _, r := range append([]*models.RoleDefinition{role}, ancestors...) {
+		for _, p := range r.Permissions {
+			if p == nil {
+				continue
+			}
+
+			key := [2]string{p.Resource, p.Action}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			merged = append(merged, p)
+		}
+	}
+
+	return merged
+}
+
+// uuidPtrEqual 比较两个可空 UUID 是否相等
+func uuidPtrEqual(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,170 @@
+package definition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/casbin"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/definition"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// lockingRoleDefinitions 内存中的角色定义仓储，记录加锁查询过的角色；未实现的方法调用时 panic
+type lockingRoleDefinitions struct {
+	definition.RoleDefinitionRepository
+
+	roles  map[string]*models.RoleDefinition
+	locked []string
+	err    error
+}
+
+func (r *lockingRoleDefinitions) GetByIDForUpdate(_ context.Context, id string) (*models.RoleDefinition, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	r.locked = append(r.locked, id)
+
+	role, ok := r.roles[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return role, nil
+}
+
+// roleDAL 未实现的方法调用时 panic
+type roleDAL struct {
+	dal.DAL
+
+	roles *lockingRoleDefinitions
+}
+
+func (d *roleDAL) RoleDefinition() definition.RoleDefinitionRepository {
+	return d.roles
+}
+
+// newRoleChain 创建 depth 层的继承链，返回由近及远排列的角色（chain[0] 继承 chain[1]，依此类推）
+func newRoleChain(depth int) (*roleDAL, []*models.RoleDefinition) {
+	chain := make([]*models.RoleDefinition, depth)
+	for i := range chain {
+		chain[i] = &models.RoleDefinition{
+			BaseModel: models.BaseModel{ID: uuid.New()},
+			Name:      fmt.Sprintf("role-%d", i),
+		}
+	}
+
+	roles := make(map[string]*models.RoleDefinition, depth)
+	for i, role := range chain {
+		if i+1 < depth {
+			parentID := chain[i+1].ID
+			role.ParentRoleID = &parentID
+		}
+
+		roles[role.ID.String()] = role
+	}
+
+	return &roleDAL{roles: &lockingRoleDefinitions{roles: roles}}, chain
+}
+
+func assertErrCode(t *testing.T, want errno.ErrNo, err error) {
+	t.Helper()
+
+	var got errno.ErrNo
+	require.True(t, errors.As(err, &got), "unexpected error: %v", err)
+	assert.Equal(t, want.Code(), got.Code(), got.Message())
+}
+
+func TestValidateParentRole(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("valid parent locks the chain", func(t *testing.T) {
+		d, chain := newRoleChain(3)
+
+		parentID, err := validateParentRole(ctx, d, uuid.NewString(), chain[0].ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, chain[0].ID, *parentID)
+
+		// 继承链上的角色均通过加锁查询获取
+		assert.Equal(t, []string{chain[0].ID.String(), chain[1].ID.String(), chain[2].ID.String()}, d.roles.locked)
+	})
+
+	t.Run("new role", func(t *testing.T) {
+		d, chain := newRoleChain(1)
+
+		_, err := validateParentRole(ctx, d, "", chain[0].ID.String())
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid parent id", func(t *testing.T) {
+		d, _ := newRoleChain(1)
+
+		_, err := validateParentRole(ctx, d, "", "not-a-uuid")
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+
+	t.Run("self", func(t *testing.T) {
+		d, chain := newRoleChain(1)
+		roleID := chain[0].ID.String()
+
+		_, err := validateParentRole(ctx, d, roleID, roleID)
+		assertErrCode(t, errno.ErrRoleInheritanceCycle, err)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		d, chain := newRoleChain(3)
+
+		// chain[2] 是 chain[0] 的祖先，不能再继承 chain[0]
+		_, err := validateParentRole(ctx, d, chain[2].ID.String(), chain[0].ID.String())
+		assertErrCode(t, errno.ErrRoleInheritanceCycle, err)
+	})
+
+	t.Run("missing parent", func(t *testing.T) {
+		d, _ := newRoleChain(1)
+
+		_, err := validateParentRole(ctx, d, "", uuid.NewString())
+		assertErrCode(t, errno.ErrRoleDefinitionNotFound, err)
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		d, chain := newRoleChain(casbin.MaxRoleInheritanceDepth - 1)
+
+		_, err := validateParentRole(ctx, d, "", chain[0].ID.String())
+		assert.NoError(t, err)
+	})
+
+	t.Run("too deep", func(t *testing.T) {
+		d, chain := newRoleChain(casbin.MaxRoleInheritanceDepth)
+
+		_, err := validateParentRole(ctx, d, "", chain[0].ID.String())
+		assertErrCode(t, errno.ErrInvalidParams, err)
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		d, chain := newRoleChain(1)
+		d.roles.err = fmt.Errorf("lock timeout")
+
+		_, err := validateParentRole(ctx, d, "", chain[0].ID.String())
+		assertErrCode(t, errno.ErrOperationFailed, err)
+	})
+}
+
+func TestResolveParentChain_StopsOnExistingCycle(t *testing.T) {
+	d, chain := newRoleChain(2)
+
+	// 历史数据中已存在的环不会导致死循环
+	childID := chain[0].ID
+	chain[1].ParentRoleID = &childID
+
+	resolved, err := resolveParentChain(context.Background(), d.roles.GetByIDForUpdate, chain[0].ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, chain, resolved)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
 	departmentDAL "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/department"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/datascope"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
@@ -15,13 +16,15 @@ import (
 type LogicImpl struct {
 	dal       dal.DAL
 	converter converter.Converter
+	dataScope datascope.Resolver
 }
 
 // NewLogic 创建部门管理业务逻辑实例
-func NewLogic(dal dal.DAL, converter converter.Converter) DepartmentLogic {
+func NewLogic(dal dal.DAL, converter converter.Converter, dataScope datascope.Resolver) DepartmentLogic {
 	return &LogicImpl{
 		dal:       dal,
 		converter: converter,
+		dataScope: dataScope,
 	}
 }
 
@@ -182,6 +185,12 @@ func (l *LogicImpl) GetDepartmentsByOrganization(
 		return nil, errno.ErrInvalidParams.WithMessage("组织ID不能为空")
 	}
 
+	// 按调用方的组织权限限制数据范围
+	scope, err := l.dataScope.ForOrganizations(ctx)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("解析数据范围失败: " + err.Error())
+	}
+
 	// 使用 Base Converter 转换分页参数
 	opts := l.converter.Base().PageRequestToQueryOptions(req.Page)
 
@@ -189,6 +198,7 @@ func (l *LogicImpl) GetDepartmentsByOrganization(
 	conditions := &departmentDAL.DepartmentQueryConditions{
 		Page:           opts,
 		OrganizationID: req.OrganizationID,
+		Scope:          scope,
 	}
 
 	// 使用 FindWithConditions 查询
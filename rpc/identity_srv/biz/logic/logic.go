@@ -2,7 +2,9 @@ package logic
 
 import (
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/assignment"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/audit"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/authentication"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/authorization"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/definition"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/department"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/logo"
@@ -43,6 +45,9 @@ type Logic interface {
 	// Logo 组织Logo管理
 	// 负责组织Logo的上传、绑定、查询和删除，支持临时Logo的生命周期管理
 	logo.LogoLogic
+
+	// LogoEnabled Logo 存储客户端是否初始化成功；未成功时 Logo 相关逻辑不可用
+	LogoEnabled() bool
 	// ============================================================================
 	// 角色与权限管理模块 - 基于新的角色权限架构
 	// ============================================================================
@@ -62,4 +67,16 @@ type Logic interface {
 	// Menu 菜单管理
 	// 负责菜单配置的上传、解析、存储以及用户菜单树的构建和权限过滤
 	menu.MenuLogic
+
+	// Authorization 接口权限校验
+	// 负责基于 Casbin p 策略的接口级权限判定和角色校验，供网关权限中间件调用
+	authorization.AuthorizationLogic
+
+	// ============================================================================
+	// 审计日志模块
+	// ============================================================================
+
+	// Audit 审计日志查询
+	// 负责按操作者、操作对象、时间范围等条件查询变更类操作留下的审计事件
+	audit.AuditLogic
 }
@@ -4,8 +4,11 @@ import (
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/casbin"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/datascope"
 	roleAssignLogic "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/assignment"
+	auditLogic "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/audit"
 	authenticationLogic "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/authentication"
+	authorizationLogic "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/authorization"
 	roleDefLogic "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/definition"
 	departmentLogic "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/department"
 	logoLogic "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/logic/logo"
@@ -60,6 +63,16 @@ type Impl struct {
 
 	// 菜单管理
 	menuLogic.MenuLogic
+
+	// 接口权限校验
+	authorizationLogic.AuthorizationLogic
+
+	// ============================================================================
+	// 审计日志
+	// ============================================================================
+
+	// 审计日志查询
+	auditLogic.AuditLogic
 }
 
 // NewLogicImpl 创建业务逻辑层实例
@@ -68,6 +81,9 @@ func NewLogicImpl(dal dal.DAL, cfg *config.Config, casbinManager *casbin.CasbinM
 	// 创建转换器实例
 	conv := converter.NewConverter()
 
+	// 创建数据范围解析器（列表查询按调用方组织权限过滤）
+	dataScope := datascope.NewResolver(dal, casbinManager, cfg)
+
 	// 创建 Logo 存储客户端
 	logoStorageClient, err := rustfsclient.NewLogoStorageClient(&cfg.LogoStorage)
 
@@ -76,9 +92,9 @@ func NewLogicImpl(dal dal.DAL, cfg *config.Config, casbinManager *casbin.CasbinM
 	if err != nil || logoStorageClient == nil {
 		// 如果 Logo 存储客户端初始化失败，仍然创建 OrganizationLogic 但传入 nil
 		// OrganizationLogic 内部会处理 logoStorageClient 为 nil 的情况
-		orgLogicImpl = orgLogic.NewLogic(dal, conv, nil)
+		orgLogicImpl = orgLogic.NewLogic(dal, conv, nil, dataScope)
 	} else {
-		orgLogicImpl = orgLogic.NewLogic(dal, conv, logoStorageClient)
+		orgLogicImpl = orgLogic.NewLogic(dal, conv, logoStorageClient, dataScope)
 	}
 
 	// 创建 LogoLogic（需要 logoStorageClient 上传文件到 S3）
@@ -116,10 +132,13 @@ func NewLogicImpl(dal dal.DAL, cfg *config.Config, casbinManager *casbin.CasbinM
 			dal,
 			conv,
 			menuLogicImpl,
+			cfg.Lockout,
+			cfg.MFA,
+			cfg.PasswordPolicy,
 		),
 
 		// 用户档案逻辑（替代传统的user模块）
-		ProfileLogic: userLogic.NewLogic(dal, conv),
+		ProfileLogic: userLogic.NewLogic(dal, conv, cfg.PasswordPolicy, dataScope),
 
 		// 用户成员关系逻辑（新增模块）
 		MembershipLogic: membershipLogic.NewLogic(dal, conv),
@@ -128,7 +147,7 @@ func NewLogicImpl(dal dal.DAL, cfg *config.Config, casbinManager *casbin.CasbinM
 		OrganizationLogic: orgLogicImpl,
 
 		// 部门管理逻辑（新增模块）
-		DepartmentLogic: departmentLogic.NewLogic(dal, conv),
+		DepartmentLogic: departmentLogic.NewLogic(dal, conv, dataScope),
 
 		// 组织Logo管理逻辑
 		LogoLogic: logoLogicImpl,
@@ -137,10 +156,10 @@ func NewLogicImpl(dal dal.DAL, cfg *config.Config, casbinManager *casbin.CasbinM
 		// ============================================================================
 
 		// 角色定义逻辑
-		RoleDefinitionLogic: roleDefLogic.NewLogic(dal, conv),
+		RoleDefinitionLogic: roleDefLogic.NewLogic(dal, conv, casbinManager),
 
 		// 用户角色分配逻辑
-		RoleAssignmentLogic: roleAssignLogic.NewLogic(dal, conv),
+		RoleAssignmentLogic: roleAssignLogic.NewLogic(dal, conv, casbinManager, dataScope),
 
 		// ============================================================================
 		// 菜单管理初始化 - 使用新的菜单权限架构
@@ -148,9 +167,24 @@ func NewLogicImpl(dal dal.DAL, cfg *config.Config, casbinManager *casbin.CasbinM
 
 		// 菜单逻辑
 		MenuLogic: menuLogicImpl,
+
+		// 接口权限校验逻辑
+		AuthorizationLogic: authorizationLogic.NewLogic(dal, casbinManager, cfg),
+
+		// ============================================================================
+		// 审计日志初始化
+		// ============================================================================
+
+		// 审计日志查询逻辑
+		AuditLogic: auditLogic.NewLogic(dal, conv),
 	}
 }
 
+// LogoEnabled Logo 存储客户端是否初始化成功（失败时 LogoLogic 为空）
+func (l *Impl) LogoEnabled() bool {
+	return l.LogoLogic != nil
+}
+
 // NewLogic 创建业务逻辑层实例（工厂函数）
 func NewLogic(dal dal.DAL, cfg *config.Config, casbinManager *casbin.CasbinManager) Logic {
 	return NewLogicImpl(dal, cfg, casbinManager)
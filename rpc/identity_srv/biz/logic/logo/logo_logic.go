@@ -31,4 +31,7 @@ type LogoLogic interface {
 		ctx context.Context,
 		req *identity_srv.BindLogoToOrganizationRequest,
 	) (*identity_srv.OrganizationLogo, error)
+
+	// CleanupExpiredLogos 清理过期的临时Logo（由后台任务定期调用），返回清理的数量
+	CleanupExpiredLogos(ctx context.Context) (int64, error)
 }
@@ -10,9 +10,13 @@ import (
 // 负责菜单配置的上传、解析、存储以及用户菜单树的构建和权限过滤
 type MenuLogic interface {
 	// UploadMenu 上传并解析菜单配置文件 (menu.yaml)
+	// dryRun 时只校验并报告影响；会导致角色失去菜单权限的上传需指定 force
 	//	@param	ctx	上下文
 	//	@param	req	包含	YAML	文件内容的请求
-	UploadMenu(ctx context.Context, req *identity_srv.UploadMenuRequest) error
+	UploadMenu(
+		ctx context.Context,
+		req *identity_srv.UploadMenuRequest,
+	) (*identity_srv.UploadMenuResponse, error)
 
 	// GetMenuTree 获取指定用户的菜单树
 	//	@param	ctx	上下文
@@ -22,6 +26,36 @@ type MenuLogic interface {
 		ctx context.Context,
 	) (*identity_srv.GetMenuTreeResponse, error)
 
+	// ListMenuVersions 列出全部菜单版本
+	//	@param	ctx	上下文
+	//	@return	按上传时间倒序排列的版本列表
+	ListMenuVersions(ctx context.Context) (*identity_srv.ListMenuVersionsResponse, error)
+
+	// DiffMenuVersions 按语义ID比较两个菜单版本
+	//	@param	ctx	上下文
+	//	@param	req	包含基准版本和目标版本的请求
+	//	@return	新增、删除、移动和重命名的菜单
+	DiffMenuVersions(
+		ctx context.Context,
+		req *identity_srv.DiffMenuVersionsRequest,
+	) (*identity_srv.DiffMenuVersionsResponse, error)
+
+	// ActivateMenuVersion 将指定版本设为生效版本
+	//	@param	ctx	上下文
+	//	@param	req	包含版本标识和操作者的请求
+	//	@return	生效版本信息及孤立的角色菜单映射
+	ActivateMenuVersion(
+		ctx context.Context,
+		req *identity_srv.ActivateMenuVersionRequest,
+	) (*identity_srv.ActivateMenuVersionResponse, error)
+
+	// GetOrphanRoleMenuMappings 列出指向生效版本中不存在菜单的角色菜单映射
+	//	@param	ctx	上下文
+	//	@return	生效版本及孤立的角色菜单映射
+	GetOrphanRoleMenuMappings(
+		ctx context.Context,
+	) (*identity_srv.GetOrphanRoleMenuMappingsResponse, error)
+
 	// ConfigureRoleMenus 配置角色的菜单权限
 	//	@param	ctx	上下文
 	//	@param	req	包含角色ID和菜单权限配置信息
@@ -2,10 +2,10 @@ package menu
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/auditlog"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/casbin"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/convutil"
@@ -16,7 +16,6 @@ import (
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
-	"gorm.io/gorm"
 )
 
 // LogicImpl 菜单管理逻辑实现
@@ -46,12 +45,37 @@ func NewLogic(
 }
 
 // UploadMenu 上传并解析菜单配置文件 (menu.yaml)
+// 上传前校验菜单配置，并与生效版本比较得出将失去访问权限的角色菜单映射；
+// dryRun 时只返回报告，实际上传遇到权限丢失且未指定 force 时拒绝上传
 func (l *LogicImpl) UploadMenu(
 	ctx context.Context,
 	req *identity_srv.UploadMenuRequest,
-) error {
+) (*identity_srv.UploadMenuResponse, error) {
 	if req.YamlContent == nil || *req.YamlContent == "" {
-		return errno.ErrInvalidParams.WithMessage("YAML内容不能为空")
+		return nil, errno.ErrInvalidParams.WithMessage("YAML内容不能为空")
+	}
+
+	dryRun := req.GetDryRun()
+
+	issues, err := parser.ValidateMenu(*req.YamlContent)
+	if err != nil {
+		return nil, errno.ErrMenuYAMLParseFailed.WithMessage(err.Error())
+	}
+
+	resp := &identity_srv.UploadMenuResponse{
+		DryRun:     convutil.BoolPtr(dryRun),
+		Valid:      convutil.BoolPtr(len(issues) == 0),
+		Issues:     menuIssuesToThrift(issues),
+		AccessLoss: []*identity_srv.OrphanRoleMenuMapping{},
+		Applied:    convutil.BoolPtr(false),
+	}
+
+	if len(issues) > 0 {
+		if dryRun {
+			return resp, nil
+		}
+
+		return nil, errno.ErrMenuConfigInvalid.WithMessage(summarizeMenuIssues(issues))
 	}
 
 	// 生成版本号，这里简单用当前时间戳
@@ -60,15 +84,65 @@ func (l *LogicImpl) UploadMenu(
 	// 使用parser模块解析YAML内容并转换为模型
 	menuModels, err := parser.ParseAndFlattenMenu(*req.YamlContent, version)
 	if err != nil {
-		return errno.ErrInvalidParams.WithMessage(fmt.Sprintf("解析菜单YAML失败: %s", err.Error()))
+		return nil, errno.ErrInvalidParams.WithMessage(fmt.Sprintf("解析菜单YAML失败: %s", err.Error()))
+	}
+
+	if err := l.assessMenuUpload(ctx, menuModels, resp); err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		return resp, nil
+	}
+
+	if len(resp.AccessLoss) > 0 && !req.GetForce() {
+		return nil, errno.ErrMenuUploadDestructive.WithMessage(fmt.Sprintf(
+			"上传将使 %d 个角色菜单映射失效，请先预检查确认影响后强制上传",
+			len(resp.AccessLoss),
+		))
+	}
+
+	// 菜单节点与版本元数据在同一事务中保存，新上传的版本立即生效
+	operatorID := parseOperatorID(req.OperatorID)
+
+	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
+		if err := txDAL.Menu().CreateMenuTree(ctx, menuModels); err != nil {
+			return errno.ErrOperationFailed.WithMessage(fmt.Sprintf("保存菜单数据失败: %s", err.Error()))
+		}
+
+		if err := txDAL.Menu().CreateVersion(ctx, version, operatorID); err != nil {
+			return errno.ErrOperationFailed.WithMessage(fmt.Sprintf("保存菜单版本失败: %s", err.Error()))
+		}
+
+		if err := txDAL.Menu().ActivateVersion(ctx, version, operatorID); err != nil {
+			return errno.ErrOperationFailed.WithMessage(fmt.Sprintf("激活菜单版本失败: %s", err.Error()))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// 使用dal模块将菜单数据保存到数据库
-	if err := l.dal.Menu().CreateMenuTree(ctx, menuModels); err != nil {
-		return errno.ErrOperationFailed.WithMessage(fmt.Sprintf("保存菜单数据失败: %s", err.Error()))
+	resp.Applied = convutil.BoolPtr(true)
+	resp.Version = &version
+	if resp.Diff != nil {
+		resp.Diff.ToVersion = &version
 	}
 
-	return nil
+	before := &menuVersionChange{}
+	if resp.Diff != nil && resp.Diff.FromVersion != nil {
+		before.ActiveVersion = *resp.Diff.FromVersion
+	}
+
+	auditlog.SetTarget(ctx, version)
+	auditlog.SetChange(ctx, before, &menuVersionChange{
+		ActiveVersion: version,
+		Diff:          resp.Diff,
+		AccessLoss:    resp.AccessLoss,
+	})
+
+	return resp, nil
 }
 
 // GetMenuTree 获取指定用户的菜单树
@@ -99,8 +173,16 @@ func (l *LogicImpl) ConfigureRoleMenus(
 	ctx context.Context,
 	req *identity_srv.ConfigureRoleMenusRequest,
 ) (*identity_srv.ConfigureRoleMenusResponse, error) {
+	// 记录变更前的菜单映射（menuID -> permission）用于审计
+	before, err := l.roleMenuMappingMap(*req.RoleID)
+	if err != nil {
+		return nil, err
+	}
+
+	after := make(map[string]string, len(req.MenuConfigs))
+
 	// 1. 清除角色的旧菜单映射
-	err := l.casbinManager.ClearRoleMenuMappings(*req.RoleID)
+	err = l.casbinManager.ClearRoleMenuMappings(*req.RoleID)
 	if err != nil {
 		return nil, errno.ErrOperationFailed.WithMessage(
 			fmt.Sprintf("清除角色旧菜单映射失败: %s", err.Error()),
@@ -126,9 +208,13 @@ func (l *LogicImpl) ConfigureRoleMenus(
 					fmt.Sprintf("添加角色菜单映射失败: %s", err.Error()),
 				)
 			}
+
+			after[*config.MenuID] = *config.Permission
 		}
 	}
 
+	auditlog.SetChange(ctx, before, after)
+
 	successMsg := "菜单权限配置成功"
 
 	return &identity_srv.ConfigureRoleMenusResponse{
@@ -158,7 +244,7 @@ func (l *LogicImpl) GetRoleMenuTree(
 	}, nil
 }
 
-// GetUserMenuTree 获取用户的菜单树（基于所有活跃角色的权限合并）
+// GetUserMenuTree 获取用户在当前组织内的菜单树（基于全局授权与该组织内授权的活跃角色的权限合并）
 func (l *LogicImpl) GetUserMenuTree(
 	ctx context.Context,
 	req *identity_srv.GetUserMenuTreeRequest,
@@ -167,10 +253,11 @@ func (l *LogicImpl) GetUserMenuTree(
 		return nil, errno.ErrInvalidParams.WithMessage("用户ID不能为空")
 	}
 
-	// 1. 获取用户的所有活跃角色ID（只包含状态为 Active 的角色）
+	// 1. 获取用户在当前组织内的所有活跃角色ID（只包含状态为 Active 的角色）
 	roleIDs, err := l.userRoleAssignmentDA.GetActiveRoleIDsWithStatus(
 		ctx,
 		*req.UserID,
+		convutil.StringValue(req.OrganizationID),
 		models.RoleStatusActive,
 	)
 	if err != nil {
@@ -209,23 +296,10 @@ func (l *LogicImpl) GetUserMenuTree(
 			break
 		}
 
-		// 获取该角色的菜单权限映射
-		policies, err := l.casbinManager.GetRoleMenuMappings(roleID)
+		// 获取该角色的菜单权限映射（含继承的父角色菜单权限）: menuID -> identity
+		rolePermissionMap, err := l.inheritedPermissionMap(roleID)
 		if err != nil {
-			return nil, errno.ErrOperationFailed.WithMessage(
-				fmt.Sprintf("获取角色 %s 的菜单权限失败: %s", roleID, err.Error()),
-			)
-		}
-
-		// 构建该角色的权限映射: menuID -> identity
-		rolePermissionMap := make(map[string]string)
-
-		for _, policy := range policies {
-			if len(policy) >= 3 {
-				menuID := policy[1]   // V1 是 menu_id
-				identity := policy[2] // V2 是 identity
-				rolePermissionMap[menuID] = identity
-			}
+			return nil, err
 		}
 
 		identityMaps = append(identityMaps, rolePermissionMap)
@@ -308,22 +382,19 @@ func (l *LogicImpl) GetRoleMenuPermissions(
 		}, nil
 	}
 
-	// 2. 普通角色：获取Casbin配置的权限
-	// 获取角色的菜单映射
-	policies, err := l.casbinManager.GetRoleMenuMappings(*req.RoleID)
+	// 2. 普通角色：获取Casbin配置的权限，包含沿继承链继承的父角色菜单权限
+	permissionMap, err := l.inheritedPermissionMap(*req.RoleID)
 	if err != nil {
-		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("获取角色菜单权限失败: %s", err.Error()))
+		return nil, err
 	}
 
 	// 转换为 Thrift 结构
-	identitys := make([]*identity_srv.MenuPermission, 0, len(policies))
-	for _, policy := range policies {
-		if len(policy) >= 3 {
-			identitys = append(identitys, &identity_srv.MenuPermission{
-				MenuID:     convutil.StringPtr(policy[1]), // V1 是 menu_id
-				Permission: convutil.StringPtr(policy[2]), // V2 是 identity
-			})
-		}
+	identitys := make([]*identity_srv.MenuPermission, 0, len(permissionMap))
+	for menuID, permission := range permissionMap {
+		identitys = append(identitys, &identity_srv.MenuPermission{
+			MenuID:     convutil.StringPtr(menuID),
+			Permission: convutil.StringPtr(permission),
+		})
 	}
 
 	return &identity_srv.GetRoleMenuPermissionsResponse{
@@ -366,8 +437,8 @@ func (l *LogicImpl) HasMenuPermission(
 	}
 
 	// 2. 普通角色：检查具体权限配置
-	// 获取角色的菜单映射
-	policies, err := l.casbinManager.GetRoleMenuMappings(*req.RoleID)
+	// 获取角色的菜单映射（含继承的父角色菜单映射）
+	policies, err := l.casbinManager.GetInheritedRoleMenuMappings(*req.RoleID)
 	if err != nil {
 		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("检查菜单权限失败: %s", err.Error()))
 	}
@@ -392,7 +463,7 @@ func (l *LogicImpl) HasMenuPermission(
 	}, nil
 }
 
-// GetUserMenuPermissions 获取用户的菜单权限列表（基于所有活跃角色合并）
+// GetUserMenuPermissions 获取用户在当前组织内的菜单权限列表（基于全局授权与该组织内授权的活跃角色合并）
 func (l *LogicImpl) GetUserMenuPermissions(
 	ctx context.Context,
 	req *identity_srv.GetUserMenuPermissionsRequest,
@@ -402,10 +473,11 @@ func (l *LogicImpl) GetUserMenuPermissions(
 		return nil, errno.ErrInvalidParams.WithMessage("用户ID不能为空")
 	}
 
-	// 2. 获取用户的所有活跃角色ID
+	// 2. 获取用户在当前组织内的所有活跃角色ID
 	roleIDs, err := l.userRoleAssignmentDA.GetActiveRoleIDsWithStatus(
 		ctx,
 		*req.UserID,
+		convutil.StringValue(req.OrganizationID),
 		models.RoleStatusActive,
 	)
 	if err != nil {
@@ -443,23 +515,10 @@ func (l *LogicImpl) GetUserMenuPermissions(
 			break
 		}
 
-		// 获取该角色的菜单权限映射
-		policies, err := l.casbinManager.GetRoleMenuMappings(roleID)
+		// 获取该角色的菜单权限映射（含继承的父角色菜单权限）
+		rolePermissionMap, err := l.inheritedPermissionMap(roleID)
 		if err != nil {
-			return nil, errno.ErrOperationFailed.WithMessage(
-				fmt.Sprintf("获取角色 %s 的菜单权限失败: %s", roleID, err.Error()),
-			)
-		}
-
-		// 构建该角色的权限映射
-		rolePermissionMap := make(map[string]string)
-
-		for _, policy := range policies {
-			if len(policy) >= 3 {
-				menuID := policy[1]     // V1 是 menu_id
-				permission := policy[2] // V2 是 permission
-				rolePermissionMap[menuID] = permission
-			}
+			return nil, err
 		}
 
 		permissionMaps = append(permissionMaps, rolePermissionMap)
@@ -647,27 +706,22 @@ func (l *LogicImpl) buildMenuTreeWithPermissions(
 }
 
 // isSuperAdminRole 检查角色是否为超管角色
-// 通过查询角色定义表，检查角色UUID对应的角色名称是否在配置的超管角色列表中
+// 通过查询角色定义表，检查角色自身或其继承的父角色名称是否在配置的超管角色列表中
 func (l *LogicImpl) isSuperAdminRole(ctx context.Context, roleID string) (bool, error) {
 	// 1. 检查配置中是否定义了超管角色
 	if len(l.config.SuperAdmin.RoleNames) == 0 {
 		return false, nil
 	}
 
-	// 2. 通过 UUID 查询角色定义，获取角色名称
-	roleDefinition, err := l.dal.RoleDefinition().GetByID(ctx, roleID)
+	// 2. 展开继承链，通过 UUID 查询角色定义，获取角色名称（不存在的角色非超管）
+	roleIDs, err := l.casbinManager.ExpandInheritedRoles([]string{roleID})
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			// 角色不存在，非超管
-			return false, nil
-		}
-
-		return false, errno.ErrOperationFailed.WithMessage("查询角色定义失败: " + err.Error())
+		return false, errno.ErrOperationFailed.WithMessage(err.Error())
 	}
 
-	if roleDefinition == nil {
-		// 角色不存在，非超管
-		return false, nil
+	roleDefinitions, err := l.dal.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
+	if err != nil {
+		return false, errno.ErrOperationFailed.WithMessage("查询角色定义失败: " + err.Error())
 	}
 
 	// 3. 创建超管角色名称的映射，用于快速查找
@@ -677,7 +731,63 @@ func (l *LogicImpl) isSuperAdminRole(ctx context.Context, roleID string) (bool,
 	}
 
 	// 4. 检查角色名称是否在超管角色列表中
-	return superAdminRoles[roleDefinition.Name], nil
+	for _, roleDefinition := range roleDefinitions {
+		if superAdminRoles[roleDefinition.Name] {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// roleMenuMappingMap 构建角色自身配置的菜单权限映射: menuID -> permission，不含继承的权限
+func (l *LogicImpl) roleMenuMappingMap(roleID string) (map[string]string, error) {
+	policies, err := l.casbinManager.GetRoleMenuMappings(roleID)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("获取角色菜单权限映射失败: " + err.Error())
+	}
+
+	mappings := make(map[string]string, len(policies))
+
+	for _, policy := range policies {
+		if len(policy) >= 3 {
+			// V1 是 menu_id，V2 是 permission
+			mappings[policy[1]] = policy[2]
+		}
+	}
+
+	return mappings, nil
+}
+
+// inheritedPermissionMap 构建角色的生效菜单权限映射: menuID -> permission
+// 包含沿继承链继承的父角色菜单权限，按角色拆分后经 mergePermissionMaps 对同一菜单取最高权限
+func (l *LogicImpl) inheritedPermissionMap(roleID string) (map[string]string, error) {
+	policies, err := l.casbinManager.GetInheritedRoleMenuMappings(roleID)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(
+			fmt.Sprintf("获取角色 %s 的菜单权限失败: %s", roleID, err.Error()),
+		)
+	}
+
+	roleMaps := make(map[string]map[string]string)
+
+	for _, policy := range policies {
+		if len(policy) >= 3 {
+			// V0 是映射所属角色，V1 是 menu_id，V2 是 permission
+			if roleMaps[policy[0]] == nil {
+				roleMaps[policy[0]] = make(map[string]string)
+			}
+
+			roleMaps[policy[0]][policy[1]] = policy[2]
+		}
+	}
+
+	maps := make([]map[string]string, 0, len(roleMaps))
+	for _, m := range roleMaps {
+		maps = append(maps, m)
+	}
+
+	return mergePermissionMaps(maps), nil
 }
 
 // getAllMenusWithFullPermissions 获取所有菜单并标记为完整权限
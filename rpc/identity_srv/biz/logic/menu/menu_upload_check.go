@@ -0,0 +1,93 @@
+package menu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/convutil"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/parser"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"gorm.io/gorm"
+)
+
+// maxSummarizedMenuIssues 错误信息中最多列出的校验问题数
+const maxSummarizedMenuIssues = 5
+
+// assessMenuUpload 将待上传的菜单与生效版本比较，填充版本变更与将失去访问权限的角色菜单映射
+// 只统计生效版本中存在、新版本中删除的菜单，此前已孤立的映射不计入
+func (l *LogicImpl) assessMenuUpload(
+	ctx context.Context,
+	menus []*models.Menu,
+	resp *identity_srv.UploadMenuResponse,
+) error {
+	activeVersion, err := l.dal.Menu().GetActiveVersion(ctx)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// 首次上传菜单，不存在可失去的访问权限
+		return nil
+	}
+
+	if err != nil {
+		return menuVersionError("获取生效菜单版本失败", err)
+	}
+
+	activeMenus, err := l.getVersionMenus(ctx, activeVersion)
+	if err != nil {
+		return err
+	}
+
+	resp.Diff = diffMenuVersions(activeMenus, menus)
+	resp.Diff.FromVersion = &activeVersion
+
+	activeIDs := menuSemanticIDSet(activeMenus)
+	uploadedIDs := menuSemanticIDSet(menus)
+
+	resp.AccessLoss, err = l.collectRoleMenuMappings(ctx, func(menuID string) bool {
+		_, active := activeIDs[menuID]
+		_, kept := uploadedIDs[menuID]
+
+		return active && !kept
+	})
+
+	return err
+}
+
+// menuIssuesToThrift 将菜单校验问题转换为 Thrift 结构
+func menuIssuesToThrift(issues []*parser.MenuIssue) []*identity_srv.MenuValidationIssue {
+	result := make([]*identity_srv.MenuValidationIssue, 0, len(issues))
+
+	for _, issue := range issues {
+		line := int32(issue.Line)
+
+		result = append(result, &identity_srv.MenuValidationIssue{
+			SemanticID: convutil.StringPtr(issue.SemanticID),
+			Field:      convutil.StringPtr(issue.Field),
+			Line:       &line,
+			Message:    convutil.StringPtr(issue.Message),
+		})
+	}
+
+	return result
+}
+
+// summarizeMenuIssues 将校验问题汇总为错误信息，问题过多时只列出前几项
+func summarizeMenuIssues(issues []*parser.MenuIssue) string {
+	shown := issues
+	if len(shown) > maxSummarizedMenuIssues {
+		shown = shown[:maxSummarizedMenuIssues]
+	}
+
+	parts := make([]string, 0, len(shown))
+	for _, issue := range shown {
+		parts = append(parts, issue.String())
+	}
+
+	summary := strings.Join(parts, "; ")
+	if len(issues) > len(shown) {
+		summary = fmt.Sprintf("%s 等 %d 个问题", summary, len(issues))
+	}
+
+	return "菜单配置校验未通过: " + summary
+}
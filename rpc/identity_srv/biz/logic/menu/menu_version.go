@@ -0,0 +1,420 @@
+package menu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/auditlog"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter/convutil"
+	menuDal "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/menu"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+	"gorm.io/gorm"
+)
+
+// menuVersionChange 菜单生效版本变更的审计快照
+type menuVersionChange struct {
+	ActiveVersion string                                 `json:"activeVersion,omitempty"`
+	Diff          *identity_srv.DiffMenuVersionsResponse `json:"diff,omitempty"`
+	AccessLoss    []*identity_srv.OrphanRoleMenuMapping  `json:"accessLoss,omitempty"`
+}
+
+// ListMenuVersions 列出全部菜单版本
+func (l *LogicImpl) ListMenuVersions(
+	ctx context.Context,
+) (*identity_srv.ListMenuVersionsResponse, error) {
+	versions, err := l.dal.Menu().ListVersions(ctx)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("获取菜单版本列表失败: %s", err.Error()))
+	}
+
+	items := make([]*identity_srv.MenuVersionInfo, 0, len(versions))
+	for _, v := range versions {
+		items = append(items, menuVersionToThrift(v))
+	}
+
+	return &identity_srv.ListMenuVersionsResponse{Versions: items}, nil
+}
+
+// DiffMenuVersions 按语义ID比较两个菜单版本，目标版本为空时与生效版本比较
+func (l *LogicImpl) DiffMenuVersions(
+	ctx context.Context,
+	req *identity_srv.DiffMenuVersionsRequest,
+) (*identity_srv.DiffMenuVersionsResponse, error) {
+	if req.FromVersion == nil || *req.FromVersion == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("基准版本不能为空")
+	}
+
+	toVersion := req.GetToVersion()
+	if toVersion == "" {
+		activeVersion, err := l.dal.Menu().GetActiveVersion(ctx)
+		if err != nil {
+			return nil, menuVersionError("获取生效菜单版本失败", err)
+		}
+
+		toVersion = activeVersion
+	}
+
+	fromMenus, err := l.getVersionMenus(ctx, *req.FromVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	toMenus, err := l.getVersionMenus(ctx, toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := diffMenuVersions(fromMenus, toMenus)
+	diff.FromVersion = req.FromVersion
+	diff.ToVersion = &toVersion
+
+	return diff, nil
+}
+
+// ActivateMenuVersion 将指定版本设为生效版本，并报告因此失效的角色菜单映射
+// 角色菜单映射按语义ID关联菜单，切换版本不会修改映射，只需关注目标版本中已不存在的菜单
+func (l *LogicImpl) ActivateMenuVersion(
+	ctx context.Context,
+	req *identity_srv.ActivateMenuVersionRequest,
+) (*identity_srv.ActivateMenuVersionResponse, error) {
+	if req.Version == nil || *req.Version == "" {
+		return nil, errno.ErrInvalidParams.WithMessage("版本标识不能为空")
+	}
+
+	previousVersion, err := l.dal.Menu().GetActiveVersion(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, menuVersionError("获取生效菜单版本失败", err)
+	}
+
+	err = l.dal.Menu().ActivateVersion(ctx, *req.Version, parseOperatorID(req.OperatorID))
+	if err != nil {
+		return nil, menuVersionError("激活菜单版本失败", err)
+	}
+
+	versions, err := l.dal.Menu().ListVersions(ctx)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(fmt.Sprintf("获取菜单版本列表失败: %s", err.Error()))
+	}
+
+	resp := &identity_srv.ActivateMenuVersionResponse{}
+
+	for _, v := range versions {
+		if v.Version == *req.Version {
+			resp.Version = menuVersionToThrift(v)
+			break
+		}
+	}
+
+	resp.OrphanMappings, err = l.findOrphanRoleMenuMappings(ctx, *req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	auditlog.SetChange(ctx, &menuVersionChange{ActiveVersion: previousVersion}, &menuVersionChange{
+		ActiveVersion: *req.Version,
+		AccessLoss:    resp.OrphanMappings,
+	})
+
+	return resp, nil
+}
+
+// GetOrphanRoleMenuMappings 列出指向生效版本中不存在菜单的角色菜单映射
+func (l *LogicImpl) GetOrphanRoleMenuMappings(
+	ctx context.Context,
+) (*identity_srv.GetOrphanRoleMenuMappingsResponse, error) {
+	activeVersion, err := l.dal.Menu().GetActiveVersion(ctx)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// 尚未上传菜单，所有映射都无从对应，不视为孤立映射
+		return &identity_srv.GetOrphanRoleMenuMappingsResponse{
+			Mappings: []*identity_srv.OrphanRoleMenuMapping{},
+		}, nil
+	}
+
+	if err != nil {
+		return nil, menuVersionError("获取生效菜单版本失败", err)
+	}
+
+	mappings, err := l.findOrphanRoleMenuMappings(ctx, activeVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity_srv.GetOrphanRoleMenuMappingsResponse{
+		Version:  &activeVersion,
+		Mappings: mappings,
+	}, nil
+}
+
+// getVersionMenus 获取指定版本的全部菜单节点，版本不存在时返回菜单不存在错误
+func (l *LogicImpl) getVersionMenus(ctx context.Context, version string) ([]*models.Menu, error) {
+	menus, err := l.dal.Menu().GetMenusByVersion(ctx, version)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(
+			fmt.Sprintf("获取菜单版本 %s 失败: %s", version, err.Error()),
+		)
+	}
+
+	if len(menus) == 0 {
+		return nil, errno.ErrMenuNotFound.WithMessage(fmt.Sprintf("菜单版本 %s 不存在", version))
+	}
+
+	return menus, nil
+}
+
+// findOrphanRoleMenuMappings 查找指向指定版本中不存在菜单的角色菜单映射，按角色与菜单排序
+func (l *LogicImpl) findOrphanRoleMenuMappings(
+	ctx context.Context,
+	version string,
+) ([]*identity_srv.OrphanRoleMenuMapping, error) {
+	menus, err := l.dal.Menu().GetMenusByVersion(ctx, version)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(
+			fmt.Sprintf("获取菜单版本 %s 失败: %s", version, err.Error()),
+		)
+	}
+
+	semanticIDs := menuSemanticIDSet(menus)
+
+	return l.collectRoleMenuMappings(ctx, func(menuID string) bool {
+		_, ok := semanticIDs[menuID]
+		return !ok
+	})
+}
+
+// collectRoleMenuMappings 收集菜单语义ID满足 match 的角色菜单映射并补充角色名称，按角色与菜单排序
+func (l *LogicImpl) collectRoleMenuMappings(
+	ctx context.Context,
+	match func(menuID string) bool,
+) ([]*identity_srv.OrphanRoleMenuMapping, error) {
+	policies, err := l.casbinManager.GetAllRoleMenuMappings()
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage(err.Error())
+	}
+
+	var matched [][]string
+
+	roleIDs := make([]string, 0)
+	seenRoles := make(map[string]struct{})
+
+	for _, policy := range policies {
+		if len(policy) < 3 || !match(policy[1]) {
+			continue
+		}
+
+		matched = append(matched, policy)
+
+		if _, ok := seenRoles[policy[0]]; !ok {
+			seenRoles[policy[0]] = struct{}{}
+			roleIDs = append(roleIDs, policy[0])
+		}
+	}
+
+	roleNames := make(map[string]string, len(roleIDs))
+
+	if len(roleIDs) > 0 {
+		roles, err := l.dal.RoleDefinition().BatchGetByIDs(ctx, roleIDs)
+		if err != nil {
+			return nil, errno.ErrOperationFailed.WithMessage("查询角色定义失败: " + err.Error())
+		}
+
+		for _, role := range roles {
+			roleNames[role.ID.String()] = role.Name
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i][0] != matched[j][0] {
+			return matched[i][0] < matched[j][0]
+		}
+
+		return matched[i][1] < matched[j][1]
+	})
+
+	result := make([]*identity_srv.OrphanRoleMenuMapping, 0, len(matched))
+
+	for _, policy := range matched {
+		mapping := &identity_srv.OrphanRoleMenuMapping{
+			RoleID:     convutil.StringPtr(policy[0]),
+			MenuID:     convutil.StringPtr(policy[1]),
+			Permission: convutil.StringPtr(policy[2]),
+		}
+
+		if name, ok := roleNames[policy[0]]; ok {
+			mapping.RoleName = convutil.StringPtr(name)
+		}
+
+		result = append(result, mapping)
+	}
+
+	return result, nil
+}
+
+// diffMenuVersions 按语义ID比较两个版本的菜单节点
+// 父菜单或路由路径变化视为移动，名称变化视为重命名，同一菜单可能同时出现在两类变更中
+func diffMenuVersions(fromMenus, toMenus []*models.Menu) *identity_srv.DiffMenuVersionsResponse {
+	from := indexMenusBySemanticID(fromMenus)
+	to := indexMenusBySemanticID(toMenus)
+
+	diff := &identity_srv.DiffMenuVersionsResponse{
+		Added:   []*identity_srv.MenuVersionChange{},
+		Removed: []*identity_srv.MenuVersionChange{},
+		Moved:   []*identity_srv.MenuVersionChange{},
+		Renamed: []*identity_srv.MenuVersionChange{},
+	}
+
+	for _, semanticID := range sortedSemanticIDs(to) {
+		current := to[semanticID]
+
+		previous, ok := from[semanticID]
+		if !ok {
+			diff.Added = append(diff.Added, &identity_srv.MenuVersionChange{
+				SemanticID:       convutil.StringPtr(semanticID),
+				Name:             convutil.StringPtr(current.name),
+				Path:             convutil.StringPtr(current.path),
+				ParentSemanticID: convutil.StringPtr(current.parent),
+			})
+
+			continue
+		}
+
+		change := &identity_srv.MenuVersionChange{
+			SemanticID:               convutil.StringPtr(semanticID),
+			Name:                     convutil.StringPtr(current.name),
+			PreviousName:             convutil.StringPtr(previous.name),
+			Path:                     convutil.StringPtr(current.path),
+			PreviousPath:             convutil.StringPtr(previous.path),
+			ParentSemanticID:         convutil.StringPtr(current.parent),
+			PreviousParentSemanticID: convutil.StringPtr(previous.parent),
+		}
+
+		if current.parent != previous.parent || current.path != previous.path {
+			diff.Moved = append(diff.Moved, change)
+		}
+
+		if current.name != previous.name {
+			diff.Renamed = append(diff.Renamed, change)
+		}
+	}
+
+	for _, semanticID := range sortedSemanticIDs(from) {
+		if _, ok := to[semanticID]; ok {
+			continue
+		}
+
+		previous := from[semanticID]
+		diff.Removed = append(diff.Removed, &identity_srv.MenuVersionChange{
+			SemanticID:               convutil.StringPtr(semanticID),
+			Name:                     convutil.StringPtr(previous.name),
+			PreviousPath:             convutil.StringPtr(previous.path),
+			PreviousParentSemanticID: convutil.StringPtr(previous.parent),
+		})
+	}
+
+	return diff
+}
+
+// menuSemanticIDSet 返回菜单节点的语义ID集合
+func menuSemanticIDSet(menus []*models.Menu) map[string]struct{} {
+	semanticIDs := make(map[string]struct{}, len(menus))
+	for _, menu := range menus {
+		semanticIDs[menu.SemanticID] = struct{}{}
+	}
+
+	return semanticIDs
+}
+
+// versionedMenu 用于版本比较的菜单节点快照，parent 为父菜单的语义ID
+type versionedMenu struct {
+	name   string
+	path   string
+	parent string
+}
+
+// indexMenusBySemanticID 将同一版本的菜单节点按语义ID建立索引，并把父菜单 UUID 转换为语义ID
+func indexMenusBySemanticID(menus []*models.Menu) map[string]versionedMenu {
+	semanticIDByID := make(map[uuid.UUID]string, len(menus))
+	for _, menu := range menus {
+		semanticIDByID[menu.ID] = menu.SemanticID
+	}
+
+	index := make(map[string]versionedMenu, len(menus))
+
+	for _, menu := range menus {
+		parent := ""
+		if menu.ParentID != nil {
+			parent = semanticIDByID[*menu.ParentID]
+		}
+
+		index[menu.SemanticID] = versionedMenu{
+			name:   menu.Name,
+			path:   menu.Path,
+			parent: parent,
+		}
+	}
+
+	return index
+}
+
+// sortedSemanticIDs 返回排序后的语义ID，保证差异结果稳定
+func sortedSemanticIDs(index map[string]versionedMenu) []string {
+	ids := make([]string, 0, len(index))
+	for id := range index {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
+
+// menuVersionToThrift 将菜单版本概要转换为 Thrift 结构
+func menuVersionToThrift(v *menuDal.MenuVersionSummary) *identity_srv.MenuVersionInfo {
+	info := &identity_srv.MenuVersionInfo{
+		Version:    convutil.StringPtr(v.Version),
+		MenuCount:  &v.MenuCount,
+		UploadedAt: &v.UploadedAt,
+		IsActive:   convutil.BoolPtr(v.IsActive),
+	}
+
+	if v.UploadedBy != nil {
+		info.UploadedBy = convutil.StringPtr(v.UploadedBy.String())
+	}
+
+	if v.ActivatedAt > 0 {
+		info.ActivatedAt = &v.ActivatedAt
+	}
+
+	if v.ActivatedBy != nil {
+		info.ActivatedBy = convutil.StringPtr(v.ActivatedBy.String())
+	}
+
+	return info
+}
+
+// menuVersionError 将菜单版本相关的数据访问错误转换为业务错误
+func menuVersionError(message string, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errno.ErrMenuNotFound.WithMessage(message + ": 菜单版本不存在")
+	}
+
+	return errno.ErrOperationFailed.WithMessage(fmt.Sprintf("%s: %s", message, err.Error()))
+}
+
+// parseOperatorID 解析操作者ID，为空或格式无效时返回 nil
+func parseOperatorID(operatorID *string) *uuid.UUID {
+	if operatorID == nil || *operatorID == "" {
+		return nil
+	}
+
+	id, err := uuid.Parse(*operatorID)
+	if err != nil {
+		return nil
+	}
+
+	return &id
+}
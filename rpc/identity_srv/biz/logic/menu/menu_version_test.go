@@ -0,0 +1,80 @@
+package menu
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMenu 构造测试用菜单节点，每个版本中的节点使用独立的 UUID
+func newMenu(semanticID, name, path string, parent *models.Menu) *models.Menu {
+	menu := &models.Menu{
+		BaseModel:  models.BaseModel{ID: uuid.New()},
+		SemanticID: semanticID,
+		Name:       name,
+		Path:       path,
+	}
+
+	if parent != nil {
+		menu.ParentID = &parent.ID
+	}
+
+	return menu
+}
+
+func semanticIDsOf(changes []*identity_srv.MenuVersionChange) []string {
+	ids := make([]string, 0, len(changes))
+	for _, c := range changes {
+		ids = append(ids, c.GetSemanticID())
+	}
+
+	return ids
+}
+
+func TestDiffMenuVersions(t *testing.T) {
+	oldSystem := newMenu("system", "系统管理", "/system", nil)
+	oldUsers := newMenu("users", "用户管理", "users", oldSystem)
+	oldAudit := newMenu("audit", "审计", "audit", oldSystem)
+	oldReports := newMenu("reports", "报表", "/reports", nil)
+
+	newSystem := newMenu("system", "系统设置", "/system", nil)
+	newReports := newMenu("reports", "报表", "/reports", nil)
+	newUsers := newMenu("users", "用户管理", "users", newReports)
+	newRoles := newMenu("roles", "角色管理", "roles", newSystem)
+
+	diff := diffMenuVersions(
+		[]*models.Menu{oldSystem, oldUsers, oldAudit, oldReports},
+		[]*models.Menu{newSystem, newReports, newUsers, newRoles},
+	)
+
+	assert.Equal(t, []string{"roles"}, semanticIDsOf(diff.Added))
+	assert.Equal(t, []string{"audit"}, semanticIDsOf(diff.Removed))
+	assert.Equal(t, []string{"users"}, semanticIDsOf(diff.Moved))
+	assert.Equal(t, []string{"system"}, semanticIDsOf(diff.Renamed))
+
+	moved := diff.Moved[0]
+	assert.Equal(t, "reports", moved.GetParentSemanticID())
+	assert.Equal(t, "system", moved.GetPreviousParentSemanticID())
+
+	renamed := diff.Renamed[0]
+	assert.Equal(t, "系统设置", renamed.GetName())
+	assert.Equal(t, "系统管理", renamed.GetPreviousName())
+}
+
+func TestDiffMenuVersions_Identical(t *testing.T) {
+	root := newMenu("system", "系统管理", "/system", nil)
+	child := newMenu("users", "用户管理", "users", root)
+
+	sameRoot := newMenu("system", "系统管理", "/system", nil)
+	sameChild := newMenu("users", "用户管理", "users", sameRoot)
+
+	diff := diffMenuVersions([]*models.Menu{root, child}, []*models.Menu{sameRoot, sameChild})
+
+	assert.Empty(t, diff.Added)
+	assert.Empty(t, diff.Removed)
+	assert.Empty(t, diff.Moved)
+	assert.Empty(t, diff.Renamed)
+}
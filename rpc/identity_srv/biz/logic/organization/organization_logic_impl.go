@@ -9,6 +9,8 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+	orgDAL "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/organization"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/datascope"
 	rustfsclient "github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/rustfs_client"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
@@ -20,6 +22,7 @@ type LogicImpl struct {
 	dal               dal.DAL
 	converter         converter.Converter
 	logoStorageClient rustfsclient.LogoStorageClient
+	dataScope         datascope.Resolver
 }
 
 // NewLogic 创建组织管理业务逻辑实例
@@ -27,11 +30,13 @@ func NewLogic(
 	dal dal.DAL,
 	converter converter.Converter,
 	logoStorageClient rustfsclient.LogoStorageClient,
+	dataScope datascope.Resolver,
 ) OrganizationLogic {
 	return &LogicImpl{
 		dal:               dal,
 		converter:         converter,
 		logoStorageClient: logoStorageClient,
+		dataScope:         dataScope,
 	}
 }
 
@@ -313,13 +318,21 @@ func (l *LogicImpl) ListOrganizations(
 	// 转换分页参数（包含 page, limit, sort, search, filter, fetchAll 等所有参数）
 	opts := l.converter.Base().PageRequestToQueryOptions(req.Page)
 
-	// 添加业务特定的 ParentID 过滤条件
-	if req.ParentID != nil {
-		opts.WithFilter("parent_id", req.ParentID)
+	// 按调用方的组织权限限制数据范围
+	scope, err := l.dataScope.ForOrganizations(ctx)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("解析数据范围失败: " + err.Error())
+	}
+
+	// 构建查询条件（ParentID 为业务特定过滤条件）
+	conditions := &orgDAL.OrganizationQueryConditions{
+		ParentID: req.ParentID,
+		Scope:    scope,
+		Page:     opts,
 	}
 
 	// 执行查询
-	organizations, pageResult, err := l.dal.Organization().FindAll(ctx, opts)
+	organizations, pageResult, err := l.dal.Organization().FindWithConditions(ctx, conditions)
 	if err != nil {
 		return nil, errno.ErrOperationFailed.WithMessage("查询组织列表失败: " + err.Error())
 	}
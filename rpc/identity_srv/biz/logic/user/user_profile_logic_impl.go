@@ -4,29 +4,39 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/auditlog"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/converter"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/user"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/datascope"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/config"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/rpc_base"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/password"
 )
 
 // LogicImpl 用户档案业务逻辑实现
 type LogicImpl struct {
-	dal       dal.DAL
-	converter converter.Converter
+	dal            dal.DAL
+	converter      converter.Converter
+	passwordPolicy config.PasswordPolicyConfig
+	dataScope      datascope.Resolver
 }
 
 // NewLogic 创建用户档案业务逻辑实例
 func NewLogic(
 	dal dal.DAL,
 	converter converter.Converter,
+	passwordPolicy config.PasswordPolicyConfig,
+	dataScope datascope.Resolver,
 ) ProfileLogic {
 	return &LogicImpl{
-		dal:       dal,
-		converter: converter,
+		dal:            dal,
+		converter:      converter,
+		passwordPolicy: passwordPolicy,
+		dataScope:      dataScope,
 	}
 }
 
@@ -87,7 +97,13 @@ func (l *LogicImpl) CreateUser(
 			return err
 		}
 
-		return nil
+		if l.passwordPolicy.HistoryCount <= 0 {
+			return nil
+		}
+
+		return txDAL.PasswordHistory().Record(
+			ctx, userProfile.ID.String(), userProfile.PasswordHash, l.passwordPolicy.HistoryCount,
+		)
 	})
 	if err != nil {
 		return nil, err
@@ -170,6 +186,9 @@ func (l *LogicImpl) UpdateUser(
 		return nil, err
 	}
 
+	// 更新会直接修改现有档案，先保留变更前快照用于审计
+	beforeDTO := l.converter.UserProfile().ModelUserProfileToThrift(existingProfile)
+
 	// 应用更新
 	updatedProfile := l.converter.UserProfile().ApplyUpdateUserToModel(existingProfile, req)
 
@@ -185,6 +204,8 @@ func (l *LogicImpl) UpdateUser(
 		return nil, err
 	}
 
+	auditlog.SetChange(ctx, beforeDTO, l.converter.UserProfile().ModelUserProfileToThrift(updatedProfile))
+
 	// 转换为响应格式
 	userProfileDTO := l.converter.UserProfile().ModelUserProfileToThrift(updatedProfile)
 
@@ -271,8 +292,25 @@ func (l *LogicImpl) ListUsers(
 		if req.OrganizationID != nil && *req.OrganizationID != "" {
 			conditions.OrgID = req.OrganizationID
 		}
+
+		// 即将过期的账户默认按过期时间升序，最先过期的排在前面
+		if req.AccountExpiresBefore != nil {
+			conditions.ExpiresBefore = req.AccountExpiresBefore
+
+			if req.Page == nil || req.Page.GetSort() == "" {
+				opts.WithOrder("user_profiles.account_expiry", false)
+			}
+		}
 	}
 
+	// 按调用方的组织权限限制数据范围
+	scope, err := l.dataScope.ForUsers(ctx)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("解析数据范围失败: " + err.Error())
+	}
+
+	conditions.Scope = scope
+
 	// 查询用户档案列表
 	profiles, pageResult, err := l.dal.UserProfile().FindWithConditions(ctx, conditions)
 	if err != nil {
@@ -330,6 +368,14 @@ func (l *LogicImpl) SearchUsers(
 		conditions.OrgID = req.OrganizationID
 	}
 
+	// 按调用方的组织权限限制数据范围
+	scope, err := l.dataScope.ForUsers(ctx)
+	if err != nil {
+		return nil, errno.ErrOperationFailed.WithMessage("解析数据范围失败: " + err.Error())
+	}
+
+	conditions.Scope = scope
+
 	// 查询用户档案列表
 	profiles, pageResult, err := l.dal.UserProfile().FindWithConditions(ctx, conditions)
 	if err != nil {
@@ -401,6 +447,11 @@ func (l *LogicImpl) validateCreateUserRequest(
 		return errno.ErrInvalidParams.WithMessage("密码不能为空")
 	}
 
+	violations := l.passwordPolicy.Validate(*req.Password, *req.Username, req.GetEmail())
+	if len(violations) > 0 {
+		return errno.ErrPasswordPolicyViolation.WithDetails(password.ViolationDetails(violations))
+	}
+
 	return nil
 }
 
@@ -455,9 +506,19 @@ func (l *LogicImpl) updateUserStatus(
 		return nil, errno.ErrOperationFailed.WithMessage("获取用户档案失败: " + err.Error())
 	}
 
+	beforeDTO := l.converter.UserProfile().ModelUserProfileToThrift(profile)
+
 	// 更新状态
 	profile.Status = status
 
+	// 手动变更状态时清除自动锁定的截止时间（手动锁定需手动解锁），
+	// 非锁定状态同时重置登录失败计数
+	profile.LockedUntil = nil
+	if status != models.UserStatusLocked {
+		profile.LoginAttempts = 0
+		profile.LastFailedLoginAt = nil
+	}
+
 	// 保存更新
 	err = l.dal.WithTransaction(ctx, func(ctx context.Context, txDAL dal.DAL) error {
 		if err := txDAL.UserProfile().Update(ctx, profile); err != nil {
@@ -470,7 +531,10 @@ func (l *LogicImpl) updateUserStatus(
 		return nil, err
 	}
 
-	return l.converter.UserProfile().ModelUserProfileToThrift(profile), nil
+	afterDTO := l.converter.UserProfile().ModelUserProfileToThrift(profile)
+	auditlog.SetChange(ctx, beforeDTO, afterDTO)
+
+	return afterDTO, nil
 }
 
 // ============================================================================
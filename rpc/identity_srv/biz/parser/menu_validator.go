@@ -0,0 +1,240 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MaxMenuDepth 菜单树允许的最大层级（根菜单为第 1 层）
+const MaxMenuDepth = 3
+
+// LayoutComponent 承载子菜单的布局组件名
+const LayoutComponent = "Layout"
+
+var (
+	// menuPathPattern 路由路径只允许字母、数字、连字符、下划线、路由参数与分隔符
+	menuPathPattern = regexp.MustCompile(`^/?[A-Za-z0-9_\-:]+(/[A-Za-z0-9_\-:]+)*$`)
+	// menuIconPattern 图标名以 Icon 开头的 PascalCase 组件名
+	menuIconPattern = regexp.MustCompile(`^Icon[A-Z][A-Za-z0-9]*$`)
+	// menuComponentPattern 页面组件为 views 目录下不带扩展名的相对路径
+	menuComponentPattern = regexp.MustCompile(`^views(/[A-Za-z0-9_\-]+)+$`)
+)
+
+var (
+	allowedRootKeys = map[string]struct{}{"menu": {}}
+	allowedNodeKeys = map[string]struct{}{
+		"name": {}, "id": {}, "path": {}, "icon": {}, "component": {}, "children": {},
+	}
+)
+
+// MenuIssue 菜单配置校验发现的问题
+type MenuIssue struct {
+	SemanticID string // 问题所在菜单的语义ID，无法定位到菜单时为空
+	Field      string // 问题字段
+	Line       int    // 问题在 YAML 中的行号
+	Message    string
+}
+
+// String 返回便于日志和错误信息展示的问题描述
+func (i *MenuIssue) String() string {
+	if i.SemanticID != "" {
+		return fmt.Sprintf("第%d行 %s.%s: %s", i.Line, i.SemanticID, i.Field, i.Message)
+	}
+
+	return fmt.Sprintf("第%d行 %s: %s", i.Line, i.Field, i.Message)
+}
+
+// ValidateMenu 校验菜单 YAML 的结构与字段格式，返回发现的全部问题
+// 仅在 YAML 语法错误时返回 error；校验不会修改任何数据，可用于上传前预检查
+func ValidateMenu(yamlContent string) ([]*MenuIssue, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(yamlContent), &doc); err != nil {
+		return nil, fmt.Errorf("解析菜单YAML失败: %w", err)
+	}
+
+	v := &menuValidator{semanticIDs: make(map[string]int)}
+
+	if len(doc.Content) == 0 {
+		v.add("", "menu", 0, "菜单配置为空")
+		return v.issues, nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		v.add("", "menu", root.Line, "根节点必须是包含 menu 字段的对象")
+		return v.issues, nil
+	}
+
+	var menu *yaml.Node
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		if _, ok := allowedRootKeys[key.Value]; !ok {
+			v.add("", key.Value, key.Line, "未知字段")
+			continue
+		}
+
+		menu = value
+	}
+
+	if menu == nil || menu.Kind != yaml.SequenceNode || len(menu.Content) == 0 {
+		v.add("", "menu", root.Line, "菜单列表不能为空")
+		return v.issues, nil
+	}
+
+	v.validateNodes(menu.Content, 1)
+
+	return v.issues, nil
+}
+
+// menuValidator 累积校验问题并记录已出现的语义ID
+type menuValidator struct {
+	issues      []*MenuIssue
+	semanticIDs map[string]int // 语义ID -> 首次出现的行号
+}
+
+func (v *menuValidator) add(semanticID, field string, line int, message string) {
+	v.issues = append(v.issues, &MenuIssue{
+		SemanticID: semanticID,
+		Field:      field,
+		Line:       line,
+		Message:    message,
+	})
+}
+
+// validateNodes 校验同一层级的菜单节点，depth 为当前层级
+func (v *menuValidator) validateNodes(nodes []*yaml.Node, depth int) {
+	siblingPaths := make(map[string]int, len(nodes))
+
+	for _, node := range nodes {
+		if node.Kind != yaml.MappingNode {
+			v.add("", "menu", node.Line, "菜单节点必须是对象")
+			continue
+		}
+
+		fields := make(map[string]*yaml.Node, len(node.Content)/2)
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			fields[key.Value] = value
+		}
+
+		id := scalarValue(fields["id"])
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if _, ok := allowedNodeKeys[key.Value]; !ok {
+				v.add(id, key.Value, key.Line, "未知字段")
+			}
+		}
+
+		v.validateIdentity(id, fields, node.Line)
+
+		path := scalarValue(fields["path"])
+		v.validatePath(id, path, depth, node.Line)
+
+		if path != "" {
+			if line, ok := siblingPaths[path]; ok {
+				v.add(id, "path", node.Line, fmt.Sprintf("与第%d行的同级菜单路由路径重复", line))
+			} else {
+				siblingPaths[path] = node.Line
+			}
+		}
+
+		if icon := scalarValue(fields["icon"]); icon != "" && !menuIconPattern.MatchString(icon) {
+			v.add(id, "icon", node.Line, fmt.Sprintf("图标 %q 格式无效，应为 Icon 开头的组件名", icon))
+		}
+
+		children := fields["children"]
+		hasChildren := children != nil && children.Kind == yaml.SequenceNode && len(children.Content) > 0
+
+		if children != nil && children.Kind != yaml.SequenceNode && children.Tag != "!!null" {
+			v.add(id, "children", children.Line, "子菜单必须是列表")
+		}
+
+		v.validateComponent(id, scalarValue(fields["component"]), hasChildren, node.Line)
+
+		if !hasChildren {
+			continue
+		}
+
+		if depth >= MaxMenuDepth {
+			v.add(id, "children", children.Line, fmt.Sprintf("菜单层级超过上限 %d", MaxMenuDepth))
+			continue
+		}
+
+		v.validateNodes(children.Content, depth+1)
+	}
+}
+
+// validateIdentity 校验名称与语义ID，语义ID需在整个菜单中唯一
+func (v *menuValidator) validateIdentity(id string, fields map[string]*yaml.Node, line int) {
+	if scalarValue(fields["name"]) == "" {
+		v.add(id, "name", line, "菜单名称不能为空")
+	}
+
+	if id == "" {
+		v.add("", "id", line, "菜单缺少语义化ID")
+		return
+	}
+
+	if first, ok := v.semanticIDs[id]; ok {
+		v.add(id, "id", line, fmt.Sprintf("语义化ID与第%d行重复", first))
+		return
+	}
+
+	v.semanticIDs[id] = line
+}
+
+// validatePath 校验路由路径：根菜单必须为绝对路径，路径段只允许安全字符
+func (v *menuValidator) validatePath(id, path string, depth, line int) {
+	if path == "" {
+		v.add(id, "path", line, "路由路径不能为空")
+		return
+	}
+
+	if depth == 1 && !strings.HasPrefix(path, "/") {
+		v.add(id, "path", line, fmt.Sprintf("根菜单路由路径 %q 必须以 / 开头", path))
+		return
+	}
+
+	if path != "/" && !menuPathPattern.MatchString(path) {
+		v.add(id, "path", line, fmt.Sprintf("路由路径 %q 格式无效", path))
+	}
+}
+
+// validateComponent 校验组件：有子菜单的节点可省略组件，其余节点必须指定 Layout 或 views 下的页面组件
+func (v *menuValidator) validateComponent(id, component string, hasChildren bool, line int) {
+	if component == "" {
+		if !hasChildren {
+			v.add(id, "component", line, "没有子菜单的菜单必须指定页面组件")
+		}
+
+		return
+	}
+
+	if component == LayoutComponent {
+		if !hasChildren {
+			v.add(id, "component", line, "Layout 组件只能用于包含子菜单的菜单")
+		}
+
+		return
+	}
+
+	if !menuComponentPattern.MatchString(component) {
+		v.add(id, "component", line,
+			fmt.Sprintf("组件 %q 格式无效，应为 Layout 或 views/ 下不带扩展名的路径", component))
+	}
+}
+
+// scalarValue 返回标量节点的值，节点不存在或不是标量时返回空字符串
+func scalarValue(node *yaml.Node) string {
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return ""
+	}
+
+	return strings.TrimSpace(node.Value)
+}
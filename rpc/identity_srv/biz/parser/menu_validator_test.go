@@ -0,0 +1,117 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMenu_Valid(t *testing.T) {
+	yamlContent := `
+menu:
+  - name: "系统设置"
+    id: "system_settings"
+    path: "/system-settings"
+    icon: "IconSystemSettings"
+    component: "Layout"
+    children:
+      - name: "组织管理"
+        id: "organization_management"
+        path: "organization-management"
+        icon: "IconOrganizationManagement"
+        component: "views/system-settings/OrganizationManagement"
+      - name: "用户详情"
+        id: "user_detail"
+        path: "users/:userID"
+        component: "views/system-settings/UserDetail"
+`
+
+	issues, err := ValidateMenu(yamlContent)
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestValidateMenu_ReportsAllIssues(t *testing.T) {
+	yamlContent := `
+version: 2
+menu:
+  - name: "系统设置"
+    id: "system_settings"
+    path: "system-settings"
+    icon: "settings"
+    component: "Layout"
+    hidden: true
+    children:
+      - name: "组织管理"
+        id: "organization_management"
+        path: "organization management"
+        component: "/views/OrganizationManagement.vue"
+      - name: "重复路径"
+        id: "system_settings"
+        path: "organization management"
+        component: "Layout"
+`
+
+	issues, err := ValidateMenu(yamlContent)
+	require.NoError(t, err)
+
+	fields := make(map[string][]string)
+	for _, issue := range issues {
+		fields[issue.Field] = append(fields[issue.Field], issue.SemanticID)
+		assert.Positive(t, issue.Line)
+	}
+
+	assert.Equal(t, []string{""}, fields["version"])
+	assert.Equal(t, []string{"system_settings"}, fields["hidden"])
+	assert.Equal(t, []string{"system_settings"}, fields["icon"])
+	assert.Equal(t, []string{"system_settings"}, fields["id"])
+	assert.ElementsMatch(
+		t,
+		[]string{"system_settings", "organization_management", "system_settings", "system_settings"},
+		fields["path"],
+	)
+	assert.ElementsMatch(t, []string{"organization_management", "system_settings"}, fields["component"])
+}
+
+func TestValidateMenu_DepthLimit(t *testing.T) {
+	yamlContent := `
+menu:
+  - name: "一级"
+    id: "level_1"
+    path: "/level-1"
+    children:
+      - name: "二级"
+        id: "level_2"
+        path: "level-2"
+        children:
+          - name: "三级"
+            id: "level_3"
+            path: "level-3"
+            children:
+              - name: "四级"
+                id: "level_4"
+                path: "level-4"
+                component: "views/Level4"
+`
+
+	issues, err := ValidateMenu(yamlContent)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "level_3", issues[0].SemanticID)
+	assert.Equal(t, "children", issues[0].Field)
+	assert.Contains(t, issues[0].Message, "菜单层级超过上限")
+}
+
+func TestValidateMenu_EmptyMenu(t *testing.T) {
+	issues, err := ValidateMenu("menu: []\n")
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "menu", issues[0].Field)
+}
+
+func TestValidateMenu_InvalidYAML(t *testing.T) {
+	_, err := ValidateMenu("menu:\n  - name: \"系统设置\n")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "解析菜单YAML失败")
+}
@@ -1,39 +1,87 @@
 package config
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"time"
 
-	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/migrations"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/migrate"
 	"github.com/rs/zerolog"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
+// 启动时的数据库迁移方式
+const (
+	// MigrationModeAuto 启动时执行未执行的迁移，多副本通过咨询锁串行执行
+	MigrationModeAuto = "auto"
+	// MigrationModeVerify 启动时只校验迁移状态，存在未执行迁移时拒绝启动
+	MigrationModeVerify = "verify"
+)
+
+// startupMigrationTimeout 启动时执行或校验迁移的超时时间（含等待其他副本释放迁移锁）
+const startupMigrationTimeout = 5 * time.Minute
+
 // InitDB 初始化数据库连接，提供给wire使用的函数
 func InitDB(cfg *Config, loggerSvc *zerolog.Logger) (*gorm.DB, error) {
 	return NewDB(&cfg.Database, &cfg.Server, loggerSvc)
 }
 
+// PostgresDSN 构建 PostgreSQL 连接字符串（key=value 格式）
+func (cfg *DatabaseConfig) PostgresDSN() string {
+	return fmt.Sprintf(
+		"host=%s user=%s password=%s dbname=%s port=%d sslmode=%s TimeZone=%s",
+		cfg.Host,
+		cfg.Username,
+		cfg.Password,
+		cfg.DBName,
+		cfg.Port,
+		cfg.SSLMode,
+		cfg.Timezone,
+	)
+}
+
 // NewDB initializes and returns a new GORM database instance.
+// 连接成功后按 MigrationMode 执行或校验数据库迁移，再执行种子数据初始化
 func NewDB(cfg *DatabaseConfig, serverCfg *ServerConfig, loggerSvc *zerolog.Logger) (*gorm.DB, error) {
+	db, err := OpenDB(cfg, serverCfg, loggerSvc)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := runStartupMigrations(db, cfg, loggerSvc); err != nil {
+		return nil, err
+	}
+
+	// 执行种子数据初始化（幂等）
+	if err := SeedDatabase(db, loggerSvc, cfg); err != nil {
+		// Seeder 失败只记录警告，不阻止服务启动
+		loggerSvc.Warn().Err(err).Msg("⚠️  种子数据初始化失败")
+	}
+
+	loggerSvc.Info().
+		Str("host", cfg.Host).
+		Int("port", cfg.Port).
+		Str("database", cfg.DBName).
+		Int("max_idle_conns", cfg.MaxIdleConns).
+		Int("max_open_conns", cfg.MaxOpenConns).
+		Dur("max_conn_lifetime", cfg.ConnMaxLifetime).
+		Dur("max_conn_idle_time", cfg.ConnMaxIdleTime).
+		Msg("Database connected successfully")
+
+	return db, nil
+}
+
+// OpenDB 建立数据库连接并配置连接池，不执行迁移与种子数据初始化
+// 供 migrate 子命令等只需要连接的场景使用
+func OpenDB(cfg *DatabaseConfig, serverCfg *ServerConfig, loggerSvc *zerolog.Logger) (*gorm.DB, error) {
 	var dialector gorm.Dialector
 
 	switch cfg.Driver {
 	case "postgres":
-		dsn := fmt.Sprintf(
-			"host=%s user=%s password=%s dbname=%s port=%d sslmode=%s TimeZone=%s",
-			cfg.Host,
-			cfg.Username,
-			cfg.Password,
-			cfg.DBName,
-			cfg.Port,
-			cfg.SSLMode,
-			cfg.Timezone,
-		)
-
-		dialector = postgres.Open(dsn)
+		dialector = postgres.Open(cfg.PostgresDSN())
 	default:
 		return nil, fmt.Errorf("不支持的数据库驱动: %s", cfg.Driver)
 	}
@@ -83,53 +131,51 @@ func NewDB(cfg *DatabaseConfig, serverCfg *ServerConfig, loggerSvc *zerolog.Logg
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	if err := autoMigrate(db); err != nil {
-		return nil, fmt.Errorf("数据库自动迁移失败: %v", err)
-	}
-
-	// 执行种子数据初始化（幂等）
-	if err := SeedDatabase(db, loggerSvc, cfg); err != nil {
-		// Seeder 失败只记录警告，不阻止服务启动
-		loggerSvc.Warn().Err(err).Msg("⚠️  种子数据初始化失败")
-	}
-
-	loggerSvc.Info().
-		Str("host", cfg.Host).
-		Int("port", cfg.Port).
-		Str("database", cfg.DBName).
-		Int("max_idle_conns", cfg.MaxIdleConns).
-		Int("max_open_conns", cfg.MaxOpenConns).
-		Dur("max_conn_lifetime", cfg.ConnMaxLifetime).
-		Dur("max_conn_idle_time", cfg.ConnMaxIdleTime).
-		Msg("Database connected successfully")
-
 	return db, nil
 }
 
-func autoMigrate(db *gorm.DB) error {
-	if db == nil {
-		return fmt.Errorf("数据库连接未初始化")
+// NewMigrator 创建使用内嵌迁移文件的迁移执行器
+func NewMigrator(db *gorm.DB) (*migrate.Migrator, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("获取底层SQL DB失败: %v", err)
 	}
 
-	log.Println("开始数据库自动迁移...")
+	return migrate.New(sqlDB, migrations.FS)
+}
 
-	// 按照依赖顺序创建表结构
-	// 分布式系统中不使用数据库外键约束，通过应用层维护数据一致性
-	err := db.AutoMigrate(
-		&models.UserProfile{},
-		&models.UserMembership{},
-		&models.Organization{},
-		&models.Department{},
-		&models.OrganizationLogo{},
-		&models.RoleDefinition{},
-		&models.UserRoleAssignment{},
-		&models.Menu{},
-	)
+// runStartupMigrations 按配置的迁移方式执行或校验数据库迁移
+func runStartupMigrations(db *gorm.DB, cfg *DatabaseConfig, loggerSvc *zerolog.Logger) error {
+	migrator, err := NewMigrator(db)
 	if err != nil {
-		return fmt.Errorf("自动迁移失败: %v", err)
+		return err
 	}
 
-	log.Println("数据库自动迁移完成")
-
-	return nil
+	ctx, cancel := context.WithTimeout(context.Background(), startupMigrationTimeout)
+	defer cancel()
+
+	switch cfg.MigrationMode {
+	case MigrationModeAuto, "":
+		executed, err := migrator.Up(ctx)
+		if err != nil {
+			return fmt.Errorf("数据库迁移失败: %w", err)
+		}
+
+		for _, migration := range executed {
+			loggerSvc.Info().
+				Int64("version", migration.Version).
+				Str("name", migration.Name).
+				Msg("Database migration applied")
+		}
+
+		return nil
+	case MigrationModeVerify:
+		if err := migrator.Verify(ctx); err != nil {
+			return fmt.Errorf("数据库结构未迁移到当前版本，请先执行 migrate up: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("不支持的数据库迁移方式: %s", cfg.MigrationMode)
+	}
 }
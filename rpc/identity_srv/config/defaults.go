@@ -30,6 +30,7 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("database.conn_max_idle_time", 5*time.Minute)
 	v.SetDefault("database.ssl_mode", "disable")
 	v.SetDefault("database.timezone", "UTC")
+	v.SetDefault("database.migration_mode", MigrationModeAuto)
 
 	// etcd配置默认值
 	v.SetDefault("etcd.address", "localhost:2379")
@@ -47,7 +48,8 @@ func setDefaults(v *viper.Viper) {
 	// 链路追踪配置默认值
 	v.SetDefault("tracing.enabled", false)
 	v.SetDefault("tracing.service_name", "identity-service")
-	v.SetDefault("tracing.endpoint", "http://localhost:14268/api/traces")
+	v.SetDefault("tracing.exporter", "otlp")
+	v.SetDefault("tracing.endpoint", "http://localhost:4318/v1/traces")
 	v.SetDefault("tracing.sampler_ratio", 1.0)
 
 	// 监控配置默认值
@@ -77,7 +79,52 @@ func setDefaults(v *viper.Viper) {
 	// Casbin 配置默认值
 	v.SetDefault("casbin.model_path", "./config/permission_model.conf")
 	v.SetDefault("casbin.enable_log", false)
+	v.SetDefault("casbin.reconcile_on_startup", "fix")
+	v.SetDefault("casbin.watcher", "postgres")
+	v.SetDefault("casbin.watcher_channel", "casbin_policy_update")
 
 	// 超级管理员配置默认值
 	v.SetDefault("super_admin.role_names", []string{"superadmin"})
+
+	// 数据范围配置默认值
+	v.SetDefault("data_scope.enabled", true)
+	v.SetDefault("data_scope.user_menu_id", "account_management")
+	v.SetDefault("data_scope.organization_menu_id", "organization_management")
+	v.SetDefault("data_scope.role_menu_id", "role_permissions")
+
+	// 账户锁定策略默认值
+	v.SetDefault("lockout.enabled", true)
+	v.SetDefault("lockout.max_attempts", 5)
+	v.SetDefault("lockout.attempt_window", 15*time.Minute)
+	v.SetDefault("lockout.lock_duration", 30*time.Minute)
+
+	// 多因素认证默认值
+	v.SetDefault("mfa.issuer", "CloudWeGo Scaffold")
+	v.SetDefault("mfa.allowed_skew", 1)
+	v.SetDefault("mfa.recovery_code_count", 10)
+
+	// 密码策略默认值
+	v.SetDefault("password_policy.min_length", 8)
+	v.SetDefault("password_policy.require_uppercase", true)
+	v.SetDefault("password_policy.require_lowercase", true)
+	v.SetDefault("password_policy.require_digit", true)
+	v.SetDefault("password_policy.require_special", false)
+	v.SetDefault("password_policy.banned_passwords", []string{
+		"password", "password1", "Password1", "Passw0rd", "12345678", "123456789",
+		"qwerty123", "Qwer1234", "Aa123456", "admin123", "Admin123", "abc12345",
+	})
+	v.SetDefault("password_policy.disallow_user_info", true)
+	v.SetDefault("password_policy.expiry_days", 0)
+	v.SetDefault("password_policy.history_count", 5)
+
+	// 后台任务调度默认值
+	v.SetDefault("scheduler.enabled", true)
+	v.SetDefault("scheduler.job_timeout", 10*time.Minute)
+	v.SetDefault("scheduler.history_retention", 30*24*time.Hour)
+	v.SetDefault("scheduler.logo_cleanup_schedule", "0 * * * *")
+	v.SetDefault("scheduler.soft_delete_purge_schedule", "30 3 * * *")
+	v.SetDefault("scheduler.soft_delete_retention", 90*24*time.Hour)
+	v.SetDefault("scheduler.account_expiry_notice_schedule", "0 1 * * *")
+	v.SetDefault("scheduler.account_expiry_notice_window", 7*24*time.Hour)
+	v.SetDefault("scheduler.role_expiry_sweep_schedule", "* * * * *")
 }
@@ -65,6 +65,18 @@ func mapEnvVarsToConfig(v *viper.Viper) {
 
 	// Logo存储配置映射
 	mapLogoStorageEnvVars(v)
+
+	// 账户锁定策略配置映射
+	mapLockoutEnvVars(v)
+
+	// 多因素认证配置映射
+	mapMFAEnvVars(v)
+
+	// 密码策略配置映射
+	mapPasswordPolicyEnvVars(v)
+
+	// 后台任务调度配置映射
+	mapSchedulerEnvVars(v)
 }
 
 // mapDatabaseEnvVars 映射数据库相关环境变量
@@ -77,6 +89,7 @@ func mapDatabaseEnvVars(v *viper.Viper) {
 	mapToViper(v, "DB_NAME", "database.dbname", nil)
 	mapToViper(v, "DB_SSLMODE", "database.sslmode", nil)
 	mapToViper(v, "DB_TIMEZONE", "database.timezone", nil)
+	mapToViper(v, "DB_MIGRATION_MODE", "database.migration_mode", nil)
 
 	// 连接池配置
 	mapToViper(v, "DB_MAX_IDLE_CONNS", "database.max_idle_conns", nil)
@@ -172,6 +185,7 @@ func mapTracingEnvVars(v *viper.Viper) {
 		return value == "true"
 	})
 	mapToViper(v, "TRACING_SERVICE_NAME", "tracing.service_name", nil)
+	mapToViper(v, "TRACING_EXPORTER", "tracing.exporter", nil)
 	mapToViper(v, "TRACING_ENDPOINT", "tracing.endpoint", nil)
 	mapToViper(v, "TRACING_SAMPLER_RATIO", "tracing.sampler_ratio", func(value string) interface{} {
 		if val, err := strconv.ParseFloat(value, 64); err == nil {
@@ -274,6 +288,146 @@ func mapLogoStorageEnvVars(v *viper.Viper) {
 	)
 }
 
+// mapLockoutEnvVars 映射账户锁定策略相关环境变量
+func mapLockoutEnvVars(v *viper.Viper) {
+	mapToViper(v, "LOCKOUT_ENABLED", "lockout.enabled", func(value string) interface{} {
+		return value == "true"
+	})
+	mapToViper(v, "LOCKOUT_MAX_ATTEMPTS", "lockout.max_attempts", func(value string) interface{} {
+		if val, err := strconv.Atoi(value); err == nil {
+			return val
+		}
+
+		return 5
+	})
+	mapToViper(
+		v,
+		"LOCKOUT_ATTEMPT_WINDOW",
+		"lockout.attempt_window",
+		func(value string) interface{} {
+			return parseDurationWithDefault(value, 15*time.Minute)
+		},
+	)
+	mapToViper(v, "LOCKOUT_DURATION", "lockout.lock_duration", func(value string) interface{} {
+		return parseDurationWithDefault(value, 30*time.Minute)
+	})
+}
+
+// mapMFAEnvVars 映射多因素认证相关环境变量
+func mapMFAEnvVars(v *viper.Viper) {
+	mapToViper(v, "MFA_ISSUER", "mfa.issuer", nil)
+	mapToViper(v, "MFA_ALLOWED_SKEW", "mfa.allowed_skew", func(value string) interface{} {
+		if val, err := strconv.Atoi(value); err == nil {
+			return val
+		}
+
+		return 1
+	})
+	mapToViper(
+		v,
+		"MFA_RECOVERY_CODE_COUNT",
+		"mfa.recovery_code_count",
+		func(value string) interface{} {
+			if val, err := strconv.Atoi(value); err == nil {
+				return val
+			}
+
+			return 10
+		},
+	)
+}
+
+// mapPasswordPolicyEnvVars 映射密码策略相关环境变量
+func mapPasswordPolicyEnvVars(v *viper.Viper) {
+	parseBool := func(value string) interface{} {
+		return value == "true"
+	}
+
+	mapToViper(v, "PASSWORD_MIN_LENGTH", "password_policy.min_length", func(value string) interface{} {
+		if val, err := strconv.Atoi(value); err == nil {
+			return val
+		}
+
+		return 8
+	})
+	mapToViper(v, "PASSWORD_REQUIRE_UPPERCASE", "password_policy.require_uppercase", parseBool)
+	mapToViper(v, "PASSWORD_REQUIRE_LOWERCASE", "password_policy.require_lowercase", parseBool)
+	mapToViper(v, "PASSWORD_REQUIRE_DIGIT", "password_policy.require_digit", parseBool)
+	mapToViper(v, "PASSWORD_REQUIRE_SPECIAL", "password_policy.require_special", parseBool)
+	mapToViper(v, "PASSWORD_DISALLOW_USER_INFO", "password_policy.disallow_user_info", parseBool)
+	mapToViper(v, "PASSWORD_BANNED_LIST", "password_policy.banned_passwords", func(value string) interface{} {
+		items := strings.Split(value, ",")
+
+		result := make([]string, 0, len(items))
+		for _, item := range items {
+			trimmed := strings.TrimSpace(item)
+			if trimmed != "" {
+				result = append(result, trimmed)
+			}
+		}
+
+		return result
+	})
+	mapToViper(v, "PASSWORD_EXPIRY_DAYS", "password_policy.expiry_days", func(value string) interface{} {
+		if val, err := strconv.Atoi(value); err == nil {
+			return val
+		}
+
+		return 0
+	})
+	mapToViper(v, "PASSWORD_HISTORY_COUNT", "password_policy.history_count", func(value string) interface{} {
+		if val, err := strconv.Atoi(value); err == nil {
+			return val
+		}
+
+		return 5
+	})
+}
+
+// mapSchedulerEnvVars 映射后台任务调度相关环境变量
+// 调度表达式允许显式设置为 "-" 以关闭对应任务
+func mapSchedulerEnvVars(v *viper.Viper) {
+	mapToViper(v, "SCHEDULER_ENABLED", "scheduler.enabled", func(value string) interface{} {
+		return value == "true"
+	})
+	mapToViper(v, "SCHEDULER_JOB_TIMEOUT", "scheduler.job_timeout", func(value string) interface{} {
+		return parseDurationWithDefault(value, 10*time.Minute)
+	})
+	mapToViper(
+		v,
+		"SCHEDULER_HISTORY_RETENTION",
+		"scheduler.history_retention",
+		func(value string) interface{} {
+			return parseDurationWithDefault(value, 30*24*time.Hour)
+		},
+	)
+	mapToViper(v, "SCHEDULER_LOGO_CLEANUP_SCHEDULE", "scheduler.logo_cleanup_schedule", nil)
+	mapToViper(v, "SCHEDULER_SOFT_DELETE_PURGE_SCHEDULE", "scheduler.soft_delete_purge_schedule", nil)
+	mapToViper(
+		v,
+		"SCHEDULER_SOFT_DELETE_RETENTION",
+		"scheduler.soft_delete_retention",
+		func(value string) interface{} {
+			return parseDurationWithDefault(value, 90*24*time.Hour)
+		},
+	)
+	mapToViper(
+		v,
+		"SCHEDULER_ACCOUNT_EXPIRY_NOTICE_SCHEDULE",
+		"scheduler.account_expiry_notice_schedule",
+		nil,
+	)
+	mapToViper(
+		v,
+		"SCHEDULER_ACCOUNT_EXPIRY_NOTICE_WINDOW",
+		"scheduler.account_expiry_notice_window",
+		func(value string) interface{} {
+			return parseDurationWithDefault(value, 7*24*time.Hour)
+		},
+	)
+	mapToViper(v, "SCHEDULER_ROLE_EXPIRY_SWEEP_SCHEDULE", "scheduler.role_expiry_sweep_schedule", nil)
+}
+
 // loadDotEnvFirst 在给定路径列表中查找首个 .env 并加载到环境变量（若未找到则忽略）。
 func loadDotEnvFirst(paths []string) {
 	for _, p := range paths {
@@ -1,26 +1,36 @@
 package config
 
-import "time"
+import (
+	"time"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/password"
+)
 
 // Config 总配置结构
 // 包含服务、数据库、日志、Tracing、Metrics 等配置段。
 // 加载顺序：默认值 -> .env -> 环境变量(同名覆盖)。
 type Config struct {
-	Database    DatabaseConfig    `mapstructure:"database"`
-	Server      ServerConfig      `mapstructure:"server"`
-	HealthCheck HealthCheckConfig `mapstructure:"health_check"`
-	Etcd        EtcdConfig        `mapstructure:"etcd"`
-	Log         LogConfig         `mapstructure:"log"`
-	Tracing     TracingConfig     `mapstructure:"tracing"`
-	Metrics     MetricsConfig     `mapstructure:"metrics"`
-	LogoStorage LogoStorageConfig `mapstructure:"logo_storage"`
-	Casbin      CasbinConfig      `mapstructure:"casbin"`
-	SuperAdmin  SuperAdminConfig  `mapstructure:"super_admin"`
+	Database       DatabaseConfig       `mapstructure:"database"`
+	Server         ServerConfig         `mapstructure:"server"`
+	HealthCheck    HealthCheckConfig    `mapstructure:"health_check"`
+	Etcd           EtcdConfig           `mapstructure:"etcd"`
+	Log            LogConfig            `mapstructure:"log"`
+	Tracing        TracingConfig        `mapstructure:"tracing"`
+	Metrics        MetricsConfig        `mapstructure:"metrics"`
+	LogoStorage    LogoStorageConfig    `mapstructure:"logo_storage"`
+	Casbin         CasbinConfig         `mapstructure:"casbin"`
+	SuperAdmin     SuperAdminConfig     `mapstructure:"super_admin"`
+	DataScope      DataScopeConfig      `mapstructure:"data_scope"`
+	Lockout        LockoutConfig        `mapstructure:"lockout"`
+	MFA            MFAConfig            `mapstructure:"mfa"`
+	PasswordPolicy PasswordPolicyConfig `mapstructure:"password_policy"`
+	Scheduler      SchedulerConfig      `mapstructure:"scheduler"`
 }
 
 // DatabaseConfig 数据库配置
 // 相关环境变量：DB_HOST, DB_PORT, DB_USERNAME, DB_PASSWORD, DB_NAME,
-// DB_SSLMODE, DB_TIMEZONE, DB_DRIVER, DB_MAX_IDLE_CONNS, DB_MAX_OPEN_CONNS, DB_CONN_MAX_LIFETIME
+// DB_SSLMODE, DB_TIMEZONE, DB_DRIVER, DB_MAX_IDLE_CONNS, DB_MAX_OPEN_CONNS, DB_CONN_MAX_LIFETIME,
+// DB_MIGRATION_MODE
 type DatabaseConfig struct {
 	// 基础配置
 	Driver   string `mapstructure:"driver"`
@@ -37,6 +47,10 @@ type DatabaseConfig struct {
 	MaxOpenConns    int           `mapstructure:"max_open_conns"`     // 最大打开连接数
 	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`  // 连接最大生命周期(分钟)
 	ConnMaxIdleTime time.Duration `mapstructure:"conn_max_idle_time"` // 连接最大空闲时间(分钟)
+
+	// MigrationMode 启动时的数据库迁移方式：auto（执行未执行的迁移）/ verify（存在未执行迁移时拒绝启动）
+	// 生产环境建议使用 verify，并在发布流程中通过 migrate up 子命令单独执行迁移
+	MigrationMode string `mapstructure:"migration_mode"`
 }
 
 // ServerConfig 服务器配置
@@ -82,10 +96,13 @@ type LogConfig struct {
 }
 
 // TracingConfig 链路追踪配置
+// 相关环境变量：TRACING_ENABLED, TRACING_SERVICE_NAME, TRACING_EXPORTER, TRACING_ENDPOINT, TRACING_SAMPLER_RATIO
 // 当 Enabled=true 时，向 Endpoint 上报链路数据；SamplerRatio 控制采样率[0.0,1.0]
+// Exporter 可选 otlp（OTLP/HTTP，Endpoint 为完整 URL）或 stdout（输出到标准输出，便于本地调试）
 type TracingConfig struct {
 	Enabled      bool    `mapstructure:"enabled"`
 	ServiceName  string  `mapstructure:"service_name"`
+	Exporter     string  `mapstructure:"exporter"`
 	Endpoint     string  `mapstructure:"endpoint"`
 	SamplerRatio float64 `mapstructure:"sampler_ratio"`
 }
@@ -121,10 +138,19 @@ type LogoStorageConfig struct {
 }
 
 // CasbinConfig Casbin 配置
-// 相关环境变量：CASBIN_MODEL_PATH, CASBIN_ENABLE_LOG
+// 相关环境变量：CASBIN_MODEL_PATH, CASBIN_ENABLE_LOG, CASBIN_RECONCILE_ON_STARTUP,
+// CASBIN_WATCHER, CASBIN_WATCHER_CHANNEL
 type CasbinConfig struct {
 	ModelPath string `mapstructure:"model_path"`
 	EnableLog bool   `mapstructure:"enable_log"`
+
+	// ReconcileOnStartup 启动时核对用户角色分组策略与角色分配表、角色权限策略与角色定义：off / report / fix
+	ReconcileOnStartup string `mapstructure:"reconcile_on_startup"`
+
+	// Watcher 多实例策略同步方式：postgres（LISTEN/NOTIFY）/ none（单实例部署）
+	Watcher string `mapstructure:"watcher"`
+	// WatcherChannel 策略变更通知使用的 PostgreSQL 通道名，所有实例需保持一致
+	WatcherChannel string `mapstructure:"watcher_channel"`
 }
 
 // SuperAdminConfig 超级管理员配置
@@ -134,3 +160,67 @@ type SuperAdminConfig struct {
 	// 支持多个角色名称，例如：["super_admin", "system_admin"]
 	RoleNames []string `mapstructure:"role_names"`
 }
+
+// DataScopeConfig 数据范围配置
+// 相关环境变量：DATA_SCOPE_ENABLED, DATA_SCOPE_USER_MENU_ID, DATA_SCOPE_ORGANIZATION_MENU_ID,
+// DATA_SCOPE_ROLE_MENU_ID
+// 列表查询按调用方角色在对应菜单上的权限（view_own_organization / view_all_organizations）过滤，
+// 仅拥有本组织权限的用户只能看到其活跃成员关系所在组织的数据
+type DataScopeConfig struct {
+	Enabled            bool   `mapstructure:"enabled"`              // 是否启用数据范围过滤
+	UserMenuID         string `mapstructure:"user_menu_id"`         // 决定用户列表范围的菜单ID
+	OrganizationMenuID string `mapstructure:"organization_menu_id"` // 决定组织、部门列表范围的菜单ID
+	RoleMenuID         string `mapstructure:"role_menu_id"`         // 决定角色分配列表范围的菜单ID
+}
+
+// LockoutConfig 账户锁定策略配置
+// 相关环境变量：LOCKOUT_ENABLED, LOCKOUT_MAX_ATTEMPTS, LOCKOUT_ATTEMPT_WINDOW, LOCKOUT_DURATION
+// 在 AttemptWindow 时间窗口内连续登录失败达到 MaxAttempts 次后，账户将被置为锁定状态；
+// LockDuration 为自动解锁的冷却时间，为 0 时仅允许管理员手动解锁。
+type LockoutConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`        // 是否启用自动锁定
+	MaxAttempts   int32         `mapstructure:"max_attempts"`   // 触发锁定的连续失败次数
+	AttemptWindow time.Duration `mapstructure:"attempt_window"` // 失败次数统计窗口，超过窗口后重新计数（0 表示不限制）
+	LockDuration  time.Duration `mapstructure:"lock_duration"`  // 锁定时长，到期后自动解锁（0 表示需手动解锁）
+}
+
+// MFAConfig 多因素认证 (TOTP) 配置
+// 相关环境变量：MFA_ISSUER, MFA_ALLOWED_SKEW, MFA_RECOVERY_CODE_COUNT
+type MFAConfig struct {
+	Issuer            string `mapstructure:"issuer"`              // 认证器中显示的签发方名称
+	AllowedSkew       int    `mapstructure:"allowed_skew"`        // 允许的时钟偏差（时间步数）
+	RecoveryCodeCount int    `mapstructure:"recovery_code_count"` // 每次生成的恢复码数量
+}
+
+// PasswordPolicyConfig 密码策略配置
+// 相关环境变量：PASSWORD_MIN_LENGTH, PASSWORD_REQUIRE_UPPERCASE, PASSWORD_REQUIRE_LOWERCASE,
+// PASSWORD_REQUIRE_DIGIT, PASSWORD_REQUIRE_SPECIAL, PASSWORD_BANNED_LIST, PASSWORD_DISALLOW_USER_INFO,
+// PASSWORD_EXPIRY_DAYS, PASSWORD_HISTORY_COUNT
+type PasswordPolicyConfig struct {
+	password.Policy `mapstructure:",squash"` // 密码复杂度规则
+
+	ExpiryDays   int `mapstructure:"expiry_days"`   // 密码有效天数，到期后须修改（0 表示永不过期）
+	HistoryCount int `mapstructure:"history_count"` // 禁止重复使用最近的密码个数（0 表示不限制）
+}
+
+// SchedulerConfig 后台任务调度配置
+// 相关环境变量：SCHEDULER_ENABLED, SCHEDULER_JOB_TIMEOUT, SCHEDULER_HISTORY_RETENTION,
+// SCHEDULER_LOGO_CLEANUP_SCHEDULE, SCHEDULER_SOFT_DELETE_PURGE_SCHEDULE, SCHEDULER_SOFT_DELETE_RETENTION,
+// SCHEDULER_ACCOUNT_EXPIRY_NOTICE_SCHEDULE, SCHEDULER_ACCOUNT_EXPIRY_NOTICE_WINDOW,
+// SCHEDULER_ROLE_EXPIRY_SWEEP_SCHEDULE
+// 调度表达式为标准 5 段 cron（分 时 日 月 周，UTC）或 @daily、@every 1h 等描述符，设置为 "-" 表示关闭该任务。
+// 多实例部署时每次调度只由取得 PostgreSQL 咨询锁的一个实例执行。
+type SchedulerConfig struct {
+	Enabled          bool          `mapstructure:"enabled"`           // 是否启用后台任务调度
+	JobTimeout       time.Duration `mapstructure:"job_timeout"`       // 单次任务执行超时时间
+	HistoryRetention time.Duration `mapstructure:"history_retention"` // 任务执行记录保留时长（0 表示永久保留）
+
+	LogoCleanupSchedule     string        `mapstructure:"logo_cleanup_schedule"`      // 过期临时Logo清理
+	SoftDeletePurgeSchedule string        `mapstructure:"soft_delete_purge_schedule"` // 软删除数据物理清理
+	SoftDeleteRetention     time.Duration `mapstructure:"soft_delete_retention"`      // 软删除数据保留时长，超过后物理删除
+
+	AccountExpiryNoticeSchedule string        `mapstructure:"account_expiry_notice_schedule"` // 账户到期提醒
+	AccountExpiryNoticeWindow   time.Duration `mapstructure:"account_expiry_notice_window"`   // 提醒窗口，在该时长内到期的账户会被提醒
+
+	RoleExpirySweepSchedule string `mapstructure:"role_expiry_sweep_schedule"` // 清理过期角色分配、补写到期生效的分组策略
+}
@@ -164,6 +164,19 @@ func (s *IdentityServiceImpl) Login(
 	return resp, nil
 }
 
+// SwitchOrganization implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) SwitchOrganization(
+	ctx context.Context,
+	req *identity_srv.SwitchOrganizationRequest,
+) (resp *identity_srv.LoginResponse, err error) {
+	resp, err = s.logic.SwitchOrganization(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
 // ChangePassword implements the IdentityServiceImpl interface.
 func (s *IdentityServiceImpl) ChangePassword(
 	ctx context.Context,
@@ -203,6 +216,71 @@ func (s *IdentityServiceImpl) ForcePasswordChange(
 	return nil
 }
 
+// BeginMFAEnrollment implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) BeginMFAEnrollment(
+	ctx context.Context,
+	req *identity_srv.BeginMFAEnrollmentRequest,
+) (resp *identity_srv.BeginMFAEnrollmentResponse, err error) {
+	resp, err = s.logic.BeginMFAEnrollment(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// ConfirmMFAEnrollment implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ConfirmMFAEnrollment(
+	ctx context.Context,
+	req *identity_srv.MFACodeRequest,
+) (resp *identity_srv.MFARecoveryCodesResponse, err error) {
+	resp, err = s.logic.ConfirmMFAEnrollment(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// VerifyMFA implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) VerifyMFA(
+	ctx context.Context,
+	req *identity_srv.MFACodeRequest,
+) (resp *identity_srv.VerifyMFAResponse, err error) {
+	resp, err = s.logic.VerifyMFA(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// DisableMFA implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) DisableMFA(
+	ctx context.Context,
+	req *identity_srv.MFACodeRequest,
+) (err error) {
+	err = s.logic.DisableMFA(ctx, req)
+	if err != nil {
+		return errno.ToKitexError(err)
+	}
+
+	return nil
+}
+
+// RegenerateMFARecoveryCodes implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) RegenerateMFARecoveryCodes(
+	ctx context.Context,
+	req *identity_srv.MFACodeRequest,
+) (resp *identity_srv.MFARecoveryCodesResponse, err error) {
+	resp, err = s.logic.RegenerateMFARecoveryCodes(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
 // ===========================================================================
 // OrgManagement
 // ===========================================================================
@@ -653,13 +731,13 @@ func (s *IdentityServiceImpl) BatchGetUserRoles(
 func (s *IdentityServiceImpl) UploadMenu(
 	ctx context.Context,
 	req *identity_srv.UploadMenuRequest,
-) (err error) {
-	err = s.logic.UploadMenu(ctx, req)
+) (resp *identity_srv.UploadMenuResponse, err error) {
+	resp, err = s.logic.UploadMenu(ctx, req)
 	if err != nil {
-		return errno.ToKitexError(err)
+		return nil, errno.ToKitexError(err)
 	}
 
-	return nil
+	return resp, nil
 }
 
 // GetMenuTree implements the IdentityServiceImpl interface.
@@ -674,6 +752,56 @@ func (s *IdentityServiceImpl) GetMenuTree(
 	return resp, nil
 }
 
+// ListMenuVersions implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ListMenuVersions(
+	ctx context.Context,
+) (resp *identity_srv.ListMenuVersionsResponse, err error) {
+	resp, err = s.logic.ListMenuVersions(ctx)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// DiffMenuVersions implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) DiffMenuVersions(
+	ctx context.Context,
+	req *identity_srv.DiffMenuVersionsRequest,
+) (resp *identity_srv.DiffMenuVersionsResponse, err error) {
+	resp, err = s.logic.DiffMenuVersions(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// ActivateMenuVersion implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ActivateMenuVersion(
+	ctx context.Context,
+	req *identity_srv.ActivateMenuVersionRequest,
+) (resp *identity_srv.ActivateMenuVersionResponse, err error) {
+	resp, err = s.logic.ActivateMenuVersion(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// GetOrphanRoleMenuMappings implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) GetOrphanRoleMenuMappings(
+	ctx context.Context,
+) (resp *identity_srv.GetOrphanRoleMenuMappingsResponse, err error) {
+	resp, err = s.logic.GetOrphanRoleMenuMappings(ctx)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
 // ConfigureRoleMenus implements the IdentityServiceImpl interface.
 func (s *IdentityServiceImpl) ConfigureRoleMenus(
 	ctx context.Context,
@@ -751,3 +879,59 @@ func (s *IdentityServiceImpl) GetUserMenuPermissions(
 
 	return resp, nil
 }
+
+// CheckPermission implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) CheckPermission(
+	ctx context.Context,
+	req *identity_srv.CheckPermissionRequest,
+) (resp *identity_srv.CheckPermissionResponse, err error) {
+	resp, err = s.logic.CheckPermission(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// CheckRole implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) CheckRole(
+	ctx context.Context,
+	req *identity_srv.CheckRoleRequest,
+) (resp *identity_srv.CheckRoleResponse, err error) {
+	resp, err = s.logic.CheckRole(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// GetUserEffectivePermissions implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) GetUserEffectivePermissions(
+	ctx context.Context,
+	req *identity_srv.GetUserEffectivePermissionsRequest,
+) (resp *identity_srv.GetUserEffectivePermissionsResponse, err error) {
+	resp, err = s.logic.GetUserEffectivePermissions(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
+
+// ===========================================================================
+// Audit
+// ===========================================================================
+
+// ListAuditEvents implements the IdentityServiceImpl interface.
+func (s *IdentityServiceImpl) ListAuditEvents(
+	ctx context.Context,
+	req *identity_srv.ListAuditEventsRequest,
+) (resp *identity_srv.ListAuditEventsResponse, err error) {
+	resp, err = s.logic.ListAuditEvents(ctx, req)
+	if err != nil {
+		return nil, errno.ToKitexError(err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/endpoint"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/google/uuid"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/auditlog"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// auditWriteTimeout 写入审计记录的超时时间，与请求本身的超时无关
+	auditWriteTimeout = 3 * time.Second
+	// maxAuditErrorMessageLength 审计记录中错误信息的最大字符数
+	maxAuditErrorMessageLength = 500
+)
+
+// AuditMiddleware RPC服务端审计中间件
+// 职责：
+// 1. 为登记在审计方法表中的变更类调用创建审计条目并放入上下文
+// 2. 调用结束后汇总操作者、操作对象、变更前后快照、追踪ID、客户端IP与执行结果写入审计表
+//
+// 设计原则：
+// - 审计写入失败只记录日志，不影响业务调用结果
+// - 查询类调用不记录，避免审计表被读请求淹没
+type AuditMiddleware struct {
+	dal    dal.DAL
+	logger *zerolog.Logger
+}
+
+// NewAuditMiddleware 创建审计中间件实例
+func NewAuditMiddleware(dal dal.DAL, logger *zerolog.Logger) *AuditMiddleware {
+	if logger == nil {
+		defaultLogger := zerolog.Nop()
+		logger = &defaultLogger
+	}
+
+	return &AuditMiddleware{
+		dal:    dal,
+		logger: logger,
+	}
+}
+
+// ServerMiddleware 返回Kitex服务端中间件
+func (m *AuditMiddleware) ServerMiddleware() endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, req, resp interface{}) error {
+			ri := rpcinfo.GetRPCInfo(ctx)
+			if ri == nil {
+				return next(ctx, req, resp)
+			}
+
+			method := ri.To().Method()
+
+			spec, ok := auditlog.LookupMethod(method)
+			if !ok {
+				return next(ctx, req, resp)
+			}
+
+			entry := &auditlog.Entry{TargetType: spec.TargetType}
+			ctx = auditlog.WithEntry(ctx, entry)
+
+			err := next(ctx, req, resp)
+
+			m.record(ctx, ri, method, spec, entry, req, resp, err)
+
+			return err
+		}
+	}
+}
+
+// record 构建审计事件并写入审计表
+func (m *AuditMiddleware) record(
+	ctx context.Context,
+	ri rpcinfo.RPCInfo,
+	method string,
+	spec auditlog.MethodSpec,
+	entry *auditlog.Entry,
+	req, resp interface{},
+	err error,
+) {
+	request := firstArgument(req)
+	result := resultValue(resp)
+
+	if entry.TargetID == "" {
+		entry.TargetID = spec.TargetID(request, result)
+	}
+
+	// 业务逻辑未补充快照时，以请求内容作为变更后内容
+	if !entry.HasChange() && !spec.OmitPayload {
+		if _, isID := request.(string); !isID {
+			entry.After = request
+		}
+	}
+
+	before, after, changedFields := auditlog.Snapshot(entry.Before, entry.After)
+
+	event := &models.AuditEvent{
+		ActorID:             parseAuditUUID(GetCallerID(ctx)),
+		ActorOrganizationID: parseAuditUUID(GetCallerOrganizationID(ctx)),
+		Action:              method,
+		TargetType:          entry.TargetType,
+		TargetID:            entry.TargetID,
+		Before:              before,
+		After:               after,
+		ChangedFields:       changedFields,
+		RequestID:           GetRequestID(ctx),
+		TraceID:             GetTraceID(ctx),
+		IPAddress:           GetClientIP(ctx),
+		Result:              models.AuditResultSuccess,
+	}
+
+	// 业务错误由框架从 handler 返回值中提取并记录在 Invocation 上
+	if bizErr := ri.Invocation().BizStatusErr(); bizErr != nil {
+		event.Result = models.AuditResultFailure
+		event.ErrorCode = bizErr.BizStatusCode()
+		event.ErrorMessage = bizErr.BizMessage()
+	}
+
+	if err != nil {
+		event.Result = models.AuditResultFailure
+
+		if bizErr, ok := kerrors.FromBizStatusError(err); ok {
+			event.ErrorCode = bizErr.BizStatusCode()
+			event.ErrorMessage = bizErr.BizMessage()
+		} else {
+			event.ErrorCode = int32(errno.ErrorCodeOperationFailed)
+			event.ErrorMessage = err.Error()
+		}
+	}
+
+	event.ErrorMessage = truncateRunes(event.ErrorMessage, maxAuditErrorMessageLength)
+
+	// 请求结束后上下文可能已取消，审计写入使用独立的超时控制
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auditWriteTimeout)
+	defer cancel()
+
+	if createErr := m.dal.AuditEvent().Create(writeCtx, event); createErr != nil {
+		m.logger.Error().
+			Err(createErr).
+			Str("method", method).
+			Str("request_id", event.RequestID).
+			Msg("Failed to write audit event")
+	}
+}
+
+// firstArgument 获取 Kitex 参数包装中的请求参数
+func firstArgument(req interface{}) interface{} {
+	if args, ok := req.(interface{ GetFirstArgument() interface{} }); ok {
+		return args.GetFirstArgument()
+	}
+
+	return nil
+}
+
+// resultValue 获取 Kitex 结果包装中的响应
+func resultValue(resp interface{}) interface{} {
+	if result, ok := resp.(interface{ GetResult() interface{} }); ok {
+		return result.GetResult()
+	}
+
+	return nil
+}
+
+// parseAuditUUID 解析 metainfo 中的ID，缺失或格式无效时返回 nil
+func parseAuditUUID(id string) *uuid.UUID {
+	if id == "" {
+		return nil
+	}
+
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return nil
+	}
+
+	return &parsed
+}
+
+// truncateRunes 按字符数截断字符串
+func truncateRunes(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+
+	return string(runes[:limit])
+}
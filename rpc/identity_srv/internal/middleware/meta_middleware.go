@@ -131,6 +131,36 @@ func GetTraceID(ctx context.Context) string {
 	return ""
 }
 
+// GetCallerID 从 RPC 上下文获取调用方用户ID
+// 由网关在认证通过后写入 metainfo；内部调用或未认证的请求返回空字符串
+func GetCallerID(ctx context.Context) string {
+	if id, ok := metainfo.GetPersistentValue(ctx, "user_id"); ok {
+		return id
+	}
+
+	return ""
+}
+
+// GetCallerOrganizationID 从 RPC 上下文获取调用方当前代表的组织ID
+// 由网关按访问令牌中的组织写入 metainfo；未携带时返回空字符串，此时只计算全局授权的角色
+func GetCallerOrganizationID(ctx context.Context) string {
+	if id, ok := metainfo.GetPersistentValue(ctx, "organization_id"); ok {
+		return id
+	}
+
+	return ""
+}
+
+// GetClientIP 从 RPC 上下文获取发起请求的客户端IP
+// 由网关按 HTTP 请求的来源地址写入 metainfo；内部调用返回空字符串
+func GetClientIP(ctx context.Context) string {
+	if ip, ok := metainfo.GetPersistentValue(ctx, "client_ip"); ok {
+		return ip
+	}
+
+	return ""
+}
+
 // LoggingAttrs 返回用于结构化日志的属性
 // 返回 map[string]interface{} 用于 zerolog
 func LoggingAttrs(ctx context.Context) map[string]interface{} {
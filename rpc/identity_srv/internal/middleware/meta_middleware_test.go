@@ -283,6 +283,42 @@ func TestGetTraceID(t *testing.T) {
 	})
 }
 
+func TestGetCallerID(t *testing.T) {
+	t.Run("returns ID when present", func(t *testing.T) {
+		ctx := createContextWithMeta(map[string]string{
+			"user_id": "test-user-id",
+		})
+
+		result := GetCallerID(ctx)
+		assert.Equal(t, "test-user-id", result)
+	})
+
+	t.Run("returns empty string when not present", func(t *testing.T) {
+		ctx := context.Background()
+
+		result := GetCallerID(ctx)
+		assert.Equal(t, "", result)
+	})
+}
+
+func TestGetCallerOrganizationID(t *testing.T) {
+	t.Run("returns ID when present", func(t *testing.T) {
+		ctx := createContextWithMeta(map[string]string{
+			"organization_id": "test-org-id",
+		})
+
+		result := GetCallerOrganizationID(ctx)
+		assert.Equal(t, "test-org-id", result)
+	})
+
+	t.Run("returns empty string when not present", func(t *testing.T) {
+		ctx := context.Background()
+
+		result := GetCallerOrganizationID(ctx)
+		assert.Equal(t, "", result)
+	})
+}
+
 func TestLoggingAttrs(t *testing.T) {
 	t.Run("returns attributes for both IDs", func(t *testing.T) {
 		ctx := createContextWithMeta(map[string]string{
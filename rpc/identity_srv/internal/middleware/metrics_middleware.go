@@ -0,0 +1,56 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/endpoint"
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/metrics"
+)
+
+// MetricsMiddleware RPC服务端指标中间件
+// 按方法记录请求数、耗时以及业务错误码
+type MetricsMiddleware struct{}
+
+// NewMetricsMiddleware 创建指标中间件实例
+func NewMetricsMiddleware() *MetricsMiddleware {
+	return &MetricsMiddleware{}
+}
+
+// ServerMiddleware 返回Kitex服务端中间件
+func (m *MetricsMiddleware) ServerMiddleware() endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, req, resp interface{}) error {
+			start := time.Now()
+
+			err := next(ctx, req, resp)
+
+			method := "unknown"
+			var code int32
+
+			if ri := rpcinfo.GetRPCInfo(ctx); ri != nil {
+				method = ri.To().Method()
+
+				// 业务错误由框架从 handler 返回值中提取并记录在 Invocation 上
+				if bizErr := ri.Invocation().BizStatusErr(); bizErr != nil {
+					code = bizErr.BizStatusCode()
+				}
+			}
+
+			if err != nil {
+				if bizErr, ok := kerrors.FromBizStatusError(err); ok {
+					code = bizErr.BizStatusCode()
+				} else {
+					code = int32(errno.ErrorCodeOperationFailed)
+				}
+			}
+
+			metrics.RecordRPC(method, code, time.Since(start).Seconds())
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/cloudwego/kitex/pkg/kerrors"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/errno"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rpcRequestCount 从指标注册表读取指定方法与错误码的 RPC 请求计数
+func rpcRequestCount(t *testing.T, method string, code int32) float64 {
+	t.Helper()
+
+	families, err := metrics.Registry.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != "identity_srv_rpc_requests_total" {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+
+			if labels["method"] == method && labels["code"] == strconv.Itoa(int(code)) {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+
+	return 0
+}
+
+// newRPCContext 构造携带服务端 RPCInfo 的请求上下文
+func newRPCContext(method string) (context.Context, rpcinfo.InvocationSetter) {
+	invocation := rpcinfo.NewInvocation("IdentityService", method)
+	ri := rpcinfo.NewRPCInfo(nil, rpcinfo.NewEndpointInfo("IdentityService", method, nil, nil), invocation, nil, nil)
+
+	return rpcinfo.NewCtxWithRPCInfo(context.Background(), ri), invocation
+}
+
+func TestMetricsMiddleware_ServerMiddleware(t *testing.T) {
+	bizErr := kerrors.NewBizStatusError(int32(errno.ErrorCodeUserNotFound), "用户不存在")
+
+	tests := []struct {
+		name     string
+		method   string
+		handler  func(ctx context.Context, req, resp interface{}) error
+		setBiz   bool
+		wantCode int32
+	}{
+		{
+			name:     "success",
+			method:   "MetricsSuccess",
+			handler:  func(context.Context, interface{}, interface{}) error { return nil },
+			wantCode: 0,
+		},
+		{
+			name:     "business error on invocation",
+			method:   "MetricsBizInvocation",
+			handler:  func(context.Context, interface{}, interface{}) error { return nil },
+			setBiz:   true,
+			wantCode: int32(errno.ErrorCodeUserNotFound),
+		},
+		{
+			name:     "returned business error",
+			method:   "MetricsBizReturned",
+			handler:  func(context.Context, interface{}, interface{}) error { return bizErr },
+			wantCode: int32(errno.ErrorCodeUserNotFound),
+		},
+		{
+			name:     "unexpected error",
+			method:   "MetricsUnexpected",
+			handler:  func(context.Context, interface{}, interface{}) error { return errors.New("boom") },
+			wantCode: int32(errno.ErrorCodeOperationFailed),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, invocation := newRPCContext(tt.method)
+			if tt.setBiz {
+				invocation.SetBizStatusErr(bizErr)
+			}
+
+			before := rpcRequestCount(t, tt.method, tt.wantCode)
+
+			endpoint := NewMetricsMiddleware().ServerMiddleware()(tt.handler)
+			_ = endpoint(ctx, nil, nil)
+
+			assert.Equal(t, before+1, rpcRequestCount(t, tt.method, tt.wantCode))
+		})
+	}
+
+	t.Run("without rpcinfo", func(t *testing.T) {
+		before := rpcRequestCount(t, "unknown", 0)
+
+		endpoint := NewMetricsMiddleware().ServerMiddleware()(
+			func(context.Context, interface{}, interface{}) error { return nil })
+		require.NoError(t, endpoint(context.Background(), nil, nil))
+
+		assert.Equal(t, before+1, rpcRequestCount(t, "unknown", 0))
+	})
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/cloudwego/kitex/pkg/endpoint"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/internal/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingMiddleware RPC服务端链路追踪中间件
+// 从 metainfo 中提取上游通过 TTHeader 传递的 W3C traceparent，为每次调用创建服务端 Span
+type TracingMiddleware struct {
+	tracer trace.Tracer
+}
+
+// NewTracingMiddleware 创建链路追踪中间件实例
+func NewTracingMiddleware() *TracingMiddleware {
+	return &TracingMiddleware{tracer: otel.Tracer(tracing.TracerName)}
+}
+
+// ServerMiddleware 返回Kitex服务端中间件
+func (m *TracingMiddleware) ServerMiddleware() endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, req, resp interface{}) error {
+			ctx = otel.GetTextMapPropagator().Extract(ctx, tracing.NewMetainfoCarrier(ctx))
+
+			service, method := "unknown", "unknown"
+			ri := rpcinfo.GetRPCInfo(ctx)
+
+			if ri != nil {
+				service = ri.To().ServiceName()
+				method = ri.To().Method()
+			}
+
+			ctx, span := m.tracer.Start(
+				ctx,
+				service+"/"+method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.RPCSystemKey.String("kitex"),
+					semconv.RPCService(service),
+					semconv.RPCMethod(method),
+				),
+			)
+			defer span.End()
+
+			err := next(ctx, req, resp)
+
+			if ri != nil {
+				if bizErr := ri.Invocation().BizStatusErr(); bizErr != nil {
+					span.SetAttributes(attribute.Int("rpc.biz_status_code", int(bizErr.BizStatusCode())))
+				}
+			}
+
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			return err
+		}
+	}
+}
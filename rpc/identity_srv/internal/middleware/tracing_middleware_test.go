@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/internal/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingMiddleware_ServerMiddleware(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+
+	shutdown, err := tracing.InitTracing(
+		context.Background(),
+		&config.TracingConfig{ServiceName: "identity-service-test", SamplerRatio: 1},
+		exporter,
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = shutdown(context.Background()) })
+
+	// 模拟上游（网关）通过 metainfo 传递的 W3C traceparent
+	upstream := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01, 0x02, 0x03},
+		SpanID:     trace.SpanID{0x0a, 0x0b},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+
+	carrier := tracing.NewMetainfoCarrier(context.Background())
+	otel.GetTextMapPropagator().Inject(trace.ContextWithSpanContext(context.Background(), upstream), carrier)
+
+	tests := []struct {
+		name      string
+		handler   func(ctx context.Context, req, resp interface{}) error
+		wantError bool
+	}{
+		{
+			name: "continues upstream trace",
+			handler: func(ctx context.Context, req, resp interface{}) error {
+				// 业务逻辑中应能取得服务端 Span
+				assert.True(t, trace.SpanContextFromContext(ctx).IsValid())
+				return nil
+			},
+		},
+		{
+			name: "records handler error",
+			handler: func(ctx context.Context, req, resp interface{}) error {
+				return errors.New("boom")
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter.Reset()
+
+			endpoint := NewTracingMiddleware().ServerMiddleware()(tt.handler)
+			err := endpoint(carrier.Context(), nil, nil)
+			assert.Equal(t, tt.wantError, err != nil)
+
+			require.NoError(t, forceFlush())
+
+			spans := exporter.GetSpans()
+			require.Len(t, spans, 1)
+
+			span := spans[0]
+			assert.Equal(t, upstream.TraceID(), span.SpanContext.TraceID())
+			assert.Equal(t, upstream.SpanID(), span.Parent.SpanID())
+			assert.Equal(t, trace.SpanKindServer, span.SpanKind)
+
+			if tt.wantError {
+				assert.Equal(t, codes.Error, span.Status.Code)
+			}
+		})
+	}
+}
+
+// forceFlush 强制导出批处理队列中的 Span
+func forceFlush() error {
+	type flusher interface {
+		ForceFlush(ctx context.Context) error
+	}
+
+	if provider, ok := otel.GetTracerProvider().(flusher); ok {
+		return provider.ForceFlush(context.Background())
+	}
+
+	return nil
+}
@@ -0,0 +1,273 @@
+// Package scheduler 提供进程内的后台任务调度
+//
+// 任务按 cron 表达式（UTC）触发；多实例部署时每次调度由取得 PostgreSQL 咨询锁的实例执行，
+// 并以 job_runs 表中（任务名称，计划执行时间）唯一约束保证同一调度只执行一次。
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/job"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/cron"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/metrics"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// lockKeyPrefix 任务咨询锁名称前缀，与任务名称拼接后经 hashtext 转换为锁ID
+	lockKeyPrefix = "identity_srv:job:"
+	// bookkeepingTimeout 登记执行结果、清理历史记录的超时时间，与任务本身的超时无关
+	bookkeepingTimeout = 10 * time.Second
+	// maxErrorMessageLength 执行记录中错误信息的最大字符数
+	maxErrorMessageLength = 500
+)
+
+// RunFunc 任务执行函数，返回本次处理的记录数
+type RunFunc func(ctx context.Context) (int64, error)
+
+// scheduledJob 已登记的任务
+type scheduledJob struct {
+	name     string
+	schedule cron.Schedule
+	run      RunFunc
+}
+
+// Scheduler 后台任务调度器
+type Scheduler struct {
+	db     *sql.DB
+	runs   job.JobRunRepository
+	cfg    *config.SchedulerConfig
+	logger *zerolog.Logger
+
+	// instance 当前实例标识（主机名:进程号），记录在执行记录中便于排查
+	instance string
+	jobs     []scheduledJob
+}
+
+// New 创建后台任务调度器
+func New(
+	db *sql.DB,
+	runs job.JobRunRepository,
+	cfg *config.SchedulerConfig,
+	logger *zerolog.Logger,
+) *Scheduler {
+	if logger == nil {
+		defaultLogger := zerolog.Nop()
+		logger = &defaultLogger
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return &Scheduler{
+		db:       db,
+		runs:     runs,
+		cfg:      cfg,
+		logger:   logger,
+		instance: fmt.Sprintf("%s:%d", hostname, os.Getpid()),
+	}
+}
+
+// Register 按调度表达式登记任务，表达式为空或 "-" 时不登记（视为关闭该任务）
+func (s *Scheduler) Register(name, spec string, run RunFunc) error {
+	if spec == "" || spec == "-" {
+		s.logger.Info().Str("job", name).Msg("Scheduled job disabled")
+		return nil
+	}
+
+	for _, j := range s.jobs {
+		if j.name == name {
+			return fmt.Errorf("job %q already registered", name)
+		}
+	}
+
+	schedule, err := cron.Parse(spec)
+	if err != nil {
+		return fmt.Errorf("job %q: %w", name, err)
+	}
+
+	s.jobs = append(s.jobs, scheduledJob{name: name, schedule: schedule, run: run})
+
+	return nil
+}
+
+// Run 启动所有已登记任务的调度循环，直到 ctx 取消且正在执行的任务结束后返回
+func (s *Scheduler) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for _, j := range s.jobs {
+		wg.Add(1)
+
+		go func(j scheduledJob) {
+			defer wg.Done()
+			s.loop(ctx, j)
+		}(j)
+	}
+
+	wg.Wait()
+}
+
+// loop 单个任务的调度循环
+// 执行耗时超过调度间隔时，期间错过的调度不再补执行
+func (s *Scheduler) loop(ctx context.Context, j scheduledJob) {
+	next := j.schedule.Next(time.Now().UTC())
+
+	for {
+		if next.IsZero() {
+			s.logger.Warn().Str("job", j.name).Msg("Scheduled job has no upcoming run")
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.runOnce(ctx, j, next)
+
+		now := time.Now().UTC()
+		if now.Before(next) {
+			now = next
+		}
+
+		next = j.schedule.Next(now)
+	}
+}
+
+// runOnce 尝试执行一次调度
+// 1. 取得任务咨询锁，其他实例正在执行时跳过
+// 2. 登记执行记录，本次调度已被其他实例执行过时跳过
+// 3. 执行任务并登记结果、记录指标，清理超过保留时长的执行记录
+func (s *Scheduler) runOnce(ctx context.Context, j scheduledJob, scheduledAt time.Time) {
+	// 咨询锁属于会话级资源，加锁与解锁需在同一连接上完成
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		s.logger.Error().Err(err).Str("job", j.name).Msg("Failed to acquire connection for scheduled job")
+		return
+	}
+	defer conn.Close()
+
+	lockKey := lockKeyPrefix + j.name
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", lockKey).
+		Scan(&locked); err != nil {
+		s.logger.Error().Err(err).Str("job", j.name).Msg("Failed to acquire scheduled job lock")
+		return
+	}
+
+	if !locked {
+		metrics.RecordJobSkipped(j.name)
+		return
+	}
+
+	defer func() {
+		unlockCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), bookkeepingTimeout)
+		defer cancel()
+
+		if _, err := conn.ExecContext(unlockCtx, "SELECT pg_advisory_unlock(hashtext($1))", lockKey); err != nil {
+			s.logger.Error().Err(err).Str("job", j.name).Msg("Failed to release scheduled job lock")
+		}
+	}()
+
+	startedAt := time.Now()
+	run := &models.JobRun{
+		JobName:     j.name,
+		ScheduledAt: scheduledAt.UnixMilli(),
+		StartedAt:   startedAt.UnixMilli(),
+		Status:      models.JobRunStatusRunning,
+		Instance:    s.instance,
+	}
+
+	started, err := s.runs.TryStart(ctx, run)
+	if err != nil {
+		s.logger.Error().Err(err).Str("job", j.name).Msg("Failed to record scheduled job start")
+		return
+	}
+
+	if !started {
+		metrics.RecordJobSkipped(j.name)
+		return
+	}
+
+	affected, runErr := s.execute(ctx, j)
+
+	finishedAt := time.Now()
+	finishedAtMilli := finishedAt.UnixMilli()
+	run.FinishedAt = &finishedAtMilli
+	run.Affected = affected
+	run.Status = models.JobRunStatusSuccess
+
+	if runErr != nil {
+		run.Status = models.JobRunStatusFailure
+		run.ErrorMessage = truncateRunes(runErr.Error(), maxErrorMessageLength)
+	}
+
+	metrics.RecordJobRun(j.name, runErr == nil, finishedAt.Sub(startedAt).Seconds())
+
+	// 任务结束时上下文可能已取消（服务退出），登记结果使用独立的超时控制
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), bookkeepingTimeout)
+	defer cancel()
+
+	if err := s.runs.Finish(writeCtx, run); err != nil {
+		s.logger.Error().Err(err).Str("job", j.name).Msg("Failed to record scheduled job result")
+	}
+
+	event := s.logger.Info()
+	if runErr != nil {
+		event = s.logger.Error().Err(runErr)
+	}
+
+	event.Str("job", j.name).
+		Int64("affected", affected).
+		Dur("duration", finishedAt.Sub(startedAt)).
+		Msg("Scheduled job finished")
+
+	if s.cfg.HistoryRetention > 0 {
+		before := finishedAt.Add(-s.cfg.HistoryRetention).UnixMilli()
+		if _, err := s.runs.DeleteStartedBefore(writeCtx, j.name, before); err != nil {
+			s.logger.Error().Err(err).Str("job", j.name).Msg("Failed to prune scheduled job history")
+		}
+	}
+}
+
+// execute 在超时控制下执行任务，任务发生 panic 时转换为错误
+func (s *Scheduler) execute(ctx context.Context, j scheduledJob) (affected int64, err error) {
+	if s.cfg.JobTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, s.cfg.JobTimeout)
+		defer cancel()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return j.run(ctx)
+}
+
+// truncateRunes 按字符数截断字符串
+func truncateRunes(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+
+	return string(runes[:limit])
+}
@@ -0,0 +1,46 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+)
+
+// MetainfoCarrier 基于 Kitex metainfo 的 TextMapCarrier
+// traceparent/tracestate 作为瞬态值写入 metainfo，由 TTHeader 传递给下一跳服务
+type MetainfoCarrier struct {
+	ctx context.Context
+}
+
+// NewMetainfoCarrier 创建 metainfo 载体
+func NewMetainfoCarrier(ctx context.Context) *MetainfoCarrier {
+	return &MetainfoCarrier{ctx: ctx}
+}
+
+// Context 返回写入传播字段后的 context
+func (c *MetainfoCarrier) Context() context.Context {
+	return c.ctx
+}
+
+// Get 实现 propagation.TextMapCarrier
+func (c *MetainfoCarrier) Get(key string) string {
+	value, _ := metainfo.GetValue(c.ctx, key)
+	return value
+}
+
+// Set 实现 propagation.TextMapCarrier
+func (c *MetainfoCarrier) Set(key, value string) {
+	c.ctx = metainfo.WithValue(c.ctx, key, value)
+}
+
+// Keys 实现 propagation.TextMapCarrier
+func (c *MetainfoCarrier) Keys() []string {
+	values := metainfo.GetAllValues(c.ctx)
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
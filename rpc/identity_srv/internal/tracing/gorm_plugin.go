@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// gormSpanKey 在 gorm.Statement 中暂存 Span 的键
+const gormSpanKey = "otel:span"
+
+// GormPlugin GORM 链路追踪插件
+// 为每次 Create/Query/Update/Delete/Row/Raw 操作创建客户端 Span，记录 SQL 与影响行数
+type GormPlugin struct {
+	tracer trace.Tracer
+}
+
+// NewGormPlugin 创建 GORM 链路追踪插件
+func NewGormPlugin() gorm.Plugin {
+	return &GormPlugin{tracer: otel.Tracer(TracerName)}
+}
+
+// Name 实现 gorm.Plugin
+func (p *GormPlugin) Name() string {
+	return "otel-tracing"
+}
+
+// Initialize 实现 gorm.Plugin，为各类操作注册前后回调
+func (p *GormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+
+	return errors.Join(
+		cb.Create().Before("gorm:create").Register("otel:before_create", p.before("gorm.create")),
+		cb.Create().After("gorm:create").Register("otel:after_create", p.after),
+		cb.Query().Before("gorm:query").Register("otel:before_query", p.before("gorm.query")),
+		cb.Query().After("gorm:query").Register("otel:after_query", p.after),
+		cb.Update().Before("gorm:update").Register("otel:before_update", p.before("gorm.update")),
+		cb.Update().After("gorm:update").Register("otel:after_update", p.after),
+		cb.Delete().Before("gorm:delete").Register("otel:before_delete", p.before("gorm.delete")),
+		cb.Delete().After("gorm:delete").Register("otel:after_delete", p.after),
+		cb.Row().Before("gorm:row").Register("otel:before_row", p.before("gorm.row")),
+		cb.Row().After("gorm:row").Register("otel:after_row", p.after),
+		cb.Raw().Before("gorm:raw").Register("otel:before_raw", p.before("gorm.raw")),
+		cb.Raw().After("gorm:raw").Register("otel:after_raw", p.after),
+	)
+}
+
+// before 在操作执行前开启 Span
+func (p *GormPlugin) before(spanName string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Statement == nil || db.Statement.Context == nil {
+			return
+		}
+
+		ctx, span := p.tracer.Start(
+			db.Statement.Context,
+			spanName,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.DBSystemPostgreSQL,
+				attribute.String("db.table", db.Statement.Table),
+			),
+		)
+
+		db.Statement.Context = ctx
+		db.InstanceSet(gormSpanKey, span)
+	}
+}
+
+// after 在操作执行后记录 SQL、影响行数与错误并结束 Span
+func (p *GormPlugin) after(db *gorm.DB) {
+	value, ok := db.InstanceGet(gormSpanKey)
+	if !ok {
+		return
+	}
+
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	span.SetAttributes(
+		semconv.DBQueryText(db.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", db.Statement.RowsAffected),
+	)
+
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
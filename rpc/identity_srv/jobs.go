@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/internal/scheduler"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/metrics"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/wire"
+	"github.com/rs/zerolog"
+)
+
+// 后台任务名称，同时用作咨询锁名称、执行记录与指标的 job 标签
+const (
+	jobLogoCleanup         = "logo_cleanup"
+	jobSoftDeletePurge     = "soft_delete_purge"
+	jobAccountExpiryNotice = "account_expiry_notice"
+	jobRoleExpirySweep     = "role_expiry_sweep"
+)
+
+// softDeletePurgeBatchSize 物理清理软删除数据时单条语句删除的最大记录数
+const softDeletePurgeBatchSize = 500
+
+// newScheduler 创建后台任务调度器并登记维护任务
+func newScheduler(
+	cfg *config.SchedulerConfig,
+	svc *wire.ServiceWithDB,
+	logger *zerolog.Logger,
+) (*scheduler.Scheduler, error) {
+	sqlDB, err := svc.DB.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	s := scheduler.New(sqlDB, svc.DAL.JobRun(), cfg, logger)
+
+	// 清理超过有效期仍未绑定到组织的临时Logo（删除存储文件并软删除记录）
+	// Logo 存储未配置时没有可清理的文件，不登记该任务
+	if svc.Service.LogoEnabled() {
+		if err := s.Register(jobLogoCleanup, cfg.LogoCleanupSchedule, svc.Service.CleanupExpiredLogos); err != nil {
+			return nil, err
+		}
+	} else {
+		logger.Info().Str("job", jobLogoCleanup).Msg("Logo storage unavailable, scheduled job not registered")
+	}
+
+	if err := s.Register(jobSoftDeletePurge, cfg.SoftDeletePurgeSchedule, func(ctx context.Context) (int64, error) {
+		return purgeSoftDeleted(ctx, svc, cfg.SoftDeleteRetention)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.Register(jobAccountExpiryNotice, cfg.AccountExpiryNoticeSchedule, func(ctx context.Context) (int64, error) {
+		return noticeExpiringAccounts(ctx, svc, cfg.AccountExpiryNoticeWindow, logger)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.Register(jobRoleExpirySweep, cfg.RoleExpirySweepSchedule, func(ctx context.Context) (int64, error) {
+		return sweepUserRoleExpiry(ctx, svc)
+	}); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// purgeSoftDeleted 物理删除软删除时间超过保留时长的业务数据
+// 菜单由版本化上传整体管理，Casbin 策略由适配器管理，均不在清理范围内
+func purgeSoftDeleted(ctx context.Context, svc *wire.ServiceWithDB, retention time.Duration) (int64, error) {
+	if retention <= 0 {
+		return 0, nil
+	}
+
+	d := svc.DAL
+	deletedBefore := time.Now().Add(-retention)
+
+	purgers := []func(context.Context, time.Time, int) (int64, error){
+		d.UserProfile().PurgeDeleted,
+		d.UserMembership().PurgeDeleted,
+		d.Organization().PurgeDeleted,
+		d.Department().PurgeDeleted,
+		d.Logo().PurgeDeleted,
+		d.RoleDefinition().PurgeDeleted,
+		d.UserRoleAssignment().PurgeDeleted,
+		d.MFARecoveryCode().PurgeDeleted,
+		d.PasswordHistory().PurgeDeleted,
+	}
+
+	var total int64
+
+	for _, purge := range purgers {
+		n, err := purge(ctx, deletedBefore, softDeletePurgeBatchSize)
+		total += n
+
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+// noticeExpiringAccounts 提醒在 window 内即将超过有效期的活跃账户
+// 逐个账户输出告警日志并更新 identity_srv_auth_accounts_expiring_soon 指标，供日志告警或监控规则通知管理员；
+// 需要查看完整名单时可通过用户列表接口的 account_expires_before 条件查询
+func noticeExpiringAccounts(
+	ctx context.Context,
+	svc *wire.ServiceWithDB,
+	window time.Duration,
+	logger *zerolog.Logger,
+) (int64, error) {
+	if window <= 0 {
+		return 0, nil
+	}
+
+	now := time.Now()
+
+	users, err := svc.DAL.UserProfile().FindExpiringAccounts(ctx, now.UnixMilli(), now.Add(window).UnixMilli())
+	if err != nil {
+		return 0, err
+	}
+
+	metrics.SetAccountsExpiringSoon(len(users))
+
+	for _, user := range users {
+		logger.Warn().
+			Str("user_id", user.ID.String()).
+			Str("username", user.Username).
+			Time("account_expiry", time.UnixMilli(*user.AccountExpiry)).
+			Msg("Account expires soon")
+	}
+
+	return int64(len(users)), nil
+}
+
+// sweepUserRoleExpiry 清理过期角色分配，并为到达生效时间的分配补写分组策略
+// 从上次执行成功的开始时间续扫，服务停机或任务失败期间到达生效时间的分配也会被补写；
+// 没有成功记录（首次执行或记录已被清理）时从头扫描，补写操作幂等
+func sweepUserRoleExpiry(ctx context.Context, svc *wire.ServiceWithDB) (int64, error) {
+	last, err := svc.DAL.JobRun().GetLastSucceeded(ctx, jobRoleExpirySweep)
+	if err != nil {
+		return 0, err
+	}
+
+	var since int64
+	if last != nil {
+		since = last.StartedAt
+	}
+
+	return svc.Casbin.SweepUserRoleExpiry(ctx, svc.DAL, since, time.Now().UnixMilli())
+}
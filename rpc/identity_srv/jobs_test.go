@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/casbin"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/assignment"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/dal/job"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/models"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lastRunRepository 返回固定的最近成功执行记录；未实现的方法调用时 panic
+type lastRunRepository struct {
+	job.JobRunRepository
+
+	last    *models.JobRun
+	jobName string
+}
+
+func (r *lastRunRepository) GetLastSucceeded(_ context.Context, jobName string) (*models.JobRun, error) {
+	r.jobName = jobName
+	return r.last, nil
+}
+
+// sweepWindowRepository 记录扫描区间，不返回任何角色分配；未实现的方法调用时 panic
+type sweepWindowRepository struct {
+	assignment.UserRoleAssignmentRepository
+
+	since, now int64
+}
+
+func (r *sweepWindowRepository) FindExpired(context.Context, int64, int) ([]*models.UserRoleAssignment, error) {
+	return nil, nil
+}
+
+func (r *sweepWindowRepository) FindBecameEffective(
+	_ context.Context,
+	since, now int64,
+) ([]*models.UserRoleAssignment, error) {
+	r.since, r.now = since, now
+	return nil, nil
+}
+
+// sweepDAL 未实现的方法调用时 panic
+type sweepDAL struct {
+	dal.DAL
+
+	jobRuns     *lastRunRepository
+	assignments *sweepWindowRepository
+}
+
+func (d *sweepDAL) JobRun() job.JobRunRepository {
+	return d.jobRuns
+}
+
+func (d *sweepDAL) UserRoleAssignment() assignment.UserRoleAssignmentRepository {
+	return d.assignments
+}
+
+func TestSweepUserRoleExpiry_ResumesFromLastSuccess(t *testing.T) {
+	t.Run("last success", func(t *testing.T) {
+		d := &sweepDAL{
+			jobRuns:     &lastRunRepository{last: &models.JobRun{StartedAt: 1000}},
+			assignments: &sweepWindowRepository{},
+		}
+		before := time.Now().UnixMilli()
+
+		_, err := sweepUserRoleExpiry(context.Background(), &wire.ServiceWithDB{DAL: d, Casbin: &casbin.CasbinManager{}})
+		require.NoError(t, err)
+
+		assert.Equal(t, jobRoleExpirySweep, d.jobRuns.jobName)
+		assert.Equal(t, int64(1000), d.assignments.since)
+		assert.GreaterOrEqual(t, d.assignments.now, before)
+	})
+
+	t.Run("no success record", func(t *testing.T) {
+		d := &sweepDAL{jobRuns: &lastRunRepository{}, assignments: &sweepWindowRepository{since: -1}}
+
+		_, err := sweepUserRoleExpiry(context.Background(), &wire.ServiceWithDB{DAL: d, Casbin: &casbin.CasbinManager{}})
+		require.NoError(t, err)
+
+		// 从头扫描，补写停机期间到达生效时间的全部分配
+		assert.Zero(t, d.assignments.since)
+	})
+}
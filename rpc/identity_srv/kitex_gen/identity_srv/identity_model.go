@@ -74,6 +74,7 @@ type UserProfile struct {
 	MustChangePassword    bool              `thrift:"mustChangePassword,14,optional" frugal:"14,optional,bool" json:"mustChangePassword,omitempty"`
 	AccountExpiry         *core.TimestampMS `thrift:"accountExpiry,15,optional" frugal:"15,optional,i64" json:"accountExpiry,omitempty"`
 	LastLoginTime         *core.TimestampMS `thrift:"lastLoginTime,20,optional" frugal:"20,optional,i64" json:"lastLoginTime,omitempty"`
+	MfaEnabled            bool              `thrift:"mfaEnabled,27,optional" frugal:"27,optional,bool" json:"mfaEnabled,omitempty"`
 	CreatedAt             *core.TimestampMS `thrift:"createdAt,16,optional" frugal:"16,optional,i64" json:"createdAt,omitempty"`
 	UpdatedAt             *core.TimestampMS `thrift:"updatedAt,17,optional" frugal:"17,optional,i64" json:"updatedAt,omitempty"`
 	CreatedBy             *core.UUID        `thrift:"createdBy,18,optional" frugal:"18,optional,string" json:"createdBy,omitempty"`
@@ -89,6 +90,7 @@ func NewUserProfile() *UserProfile {
 	return &UserProfile{
 		LoginAttempts:      0,
 		MustChangePassword: false,
+		MfaEnabled:         false,
 		Version:            1,
 		Deleted:            false,
 	}
@@ -97,6 +99,7 @@ func NewUserProfile() *UserProfile {
 func (p *UserProfile) InitDefault() {
 	p.LoginAttempts = 0
 	p.MustChangePassword = false
+	p.MfaEnabled = false
 	p.Version = 1
 	p.Deleted = false
 }
@@ -254,6 +257,15 @@ func (p *UserProfile) GetLastLoginTime() (v core.TimestampMS) {
 	return *p.LastLoginTime
 }
 
+var UserProfile_MfaEnabled_DEFAULT bool = false
+
+func (p *UserProfile) GetMfaEnabled() (v bool) {
+	if !p.IsSetMfaEnabled() {
+		return UserProfile_MfaEnabled_DEFAULT
+	}
+	return p.MfaEnabled
+}
+
 var UserProfile_CreatedAt_DEFAULT core.TimestampMS
 
 func (p *UserProfile) GetCreatedAt() (v core.TimestampMS) {
@@ -385,6 +397,9 @@ func (p *UserProfile) SetAccountExpiry(val *core.TimestampMS) {
 func (p *UserProfile) SetLastLoginTime(val *core.TimestampMS) {
 	p.LastLoginTime = val
 }
+func (p *UserProfile) SetMfaEnabled(val bool) {
+	p.MfaEnabled = val
+}
 func (p *UserProfile) SetCreatedAt(val *core.TimestampMS) {
 	p.CreatedAt = val
 }
@@ -481,6 +496,10 @@ func (p *UserProfile) IsSetLastLoginTime() bool {
 	return p.LastLoginTime != nil
 }
 
+func (p *UserProfile) IsSetMfaEnabled() bool {
+	return p.MfaEnabled != UserProfile_MfaEnabled_DEFAULT
+}
+
 func (p *UserProfile) IsSetCreatedAt() bool {
 	return p.CreatedAt != nil
 }
@@ -542,6 +561,7 @@ var fieldIDToName_UserProfile = map[int16]string{
 	14: "mustChangePassword",
 	15: "accountExpiry",
 	20: "lastLoginTime",
+	27: "mfaEnabled",
 	16: "createdAt",
 	17: "updatedAt",
 	18: "createdBy",
@@ -1392,27 +1412,32 @@ var fieldIDToName_Permission = map[int16]string{
 }
 
 type RoleDefinition struct {
-	Id           *core.UUID        `thrift:"id,1,optional" frugal:"1,optional,string" json:"id,omitempty"`
-	Name         *string           `thrift:"name,2,optional" frugal:"2,optional,string" json:"name,omitempty"`
-	Description  *string           `thrift:"description,3,optional" frugal:"3,optional,string" json:"description,omitempty"`
-	Status       *core.RoleStatus  `thrift:"status,5,optional" frugal:"5,optional,RoleStatus" json:"status,omitempty"`
-	Permissions  []*Permission     `thrift:"permissions,6,optional" frugal:"6,optional,list<Permission>" json:"permissions,omitempty"`
-	IsSystemRole bool              `thrift:"isSystemRole,7,optional" frugal:"7,optional,bool" json:"isSystemRole,omitempty"`
-	CreatedBy    *core.UUID        `thrift:"createdBy,8,optional" frugal:"8,optional,string" json:"createdBy,omitempty"`
-	UpdatedBy    *core.UUID        `thrift:"updatedBy,9,optional" frugal:"9,optional,string" json:"updatedBy,omitempty"`
-	CreatedAt    *core.TimestampMS `thrift:"createdAt,10,optional" frugal:"10,optional,i64" json:"createdAt,omitempty"`
-	UpdatedAt    *core.TimestampMS `thrift:"updatedAt,11,optional" frugal:"11,optional,i64" json:"updatedAt,omitempty"`
-	UserCount    *int64            `thrift:"userCount,12,optional" frugal:"12,optional,i64" json:"userCount,omitempty"`
+	Id                   *core.UUID        `thrift:"id,1,optional" frugal:"1,optional,string" json:"id,omitempty"`
+	Name                 *string           `thrift:"name,2,optional" frugal:"2,optional,string" json:"name,omitempty"`
+	Description          *string           `thrift:"description,3,optional" frugal:"3,optional,string" json:"description,omitempty"`
+	Status               *core.RoleStatus  `thrift:"status,5,optional" frugal:"5,optional,RoleStatus" json:"status,omitempty"`
+	Permissions          []*Permission     `thrift:"permissions,6,optional" frugal:"6,optional,list<Permission>" json:"permissions,omitempty"`
+	IsSystemRole         bool              `thrift:"isSystemRole,7,optional" frugal:"7,optional,bool" json:"isSystemRole,omitempty"`
+	MfaRequired          bool              `thrift:"mfaRequired,13,optional" frugal:"13,optional,bool" json:"mfaRequired,omitempty"`
+	CreatedBy            *core.UUID        `thrift:"createdBy,8,optional" frugal:"8,optional,string" json:"createdBy,omitempty"`
+	UpdatedBy            *core.UUID        `thrift:"updatedBy,9,optional" frugal:"9,optional,string" json:"updatedBy,omitempty"`
+	CreatedAt            *core.TimestampMS `thrift:"createdAt,10,optional" frugal:"10,optional,i64" json:"createdAt,omitempty"`
+	UpdatedAt            *core.TimestampMS `thrift:"updatedAt,11,optional" frugal:"11,optional,i64" json:"updatedAt,omitempty"`
+	UserCount            *int64            `thrift:"userCount,12,optional" frugal:"12,optional,i64" json:"userCount,omitempty"`
+	ParentRoleID         *core.UUID        `thrift:"parentRoleID,14,optional" frugal:"14,optional,string" json:"parentRoleID,omitempty"`
+	EffectivePermissions []*Permission     `thrift:"effectivePermissions,15,optional" frugal:"15,optional,list<Permission>" json:"effectivePermissions,omitempty"`
 }
 
 func NewRoleDefinition() *RoleDefinition {
 	return &RoleDefinition{
 		IsSystemRole: false,
+		MfaRequired:  false,
 	}
 }
 
 func (p *RoleDefinition) InitDefault() {
 	p.IsSystemRole = false
+	p.MfaRequired = false
 }
 
 var RoleDefinition_Id_DEFAULT core.UUID
@@ -1469,6 +1494,15 @@ func (p *RoleDefinition) GetIsSystemRole() (v bool) {
 	return p.IsSystemRole
 }
 
+var RoleDefinition_MfaRequired_DEFAULT bool = false
+
+func (p *RoleDefinition) GetMfaRequired() (v bool) {
+	if !p.IsSetMfaRequired() {
+		return RoleDefinition_MfaRequired_DEFAULT
+	}
+	return p.MfaRequired
+}
+
 var RoleDefinition_CreatedBy_DEFAULT core.UUID
 
 func (p *RoleDefinition) GetCreatedBy() (v core.UUID) {
@@ -1513,6 +1547,24 @@ func (p *RoleDefinition) GetUserCount() (v int64) {
 	}
 	return *p.UserCount
 }
+
+var RoleDefinition_ParentRoleID_DEFAULT core.UUID
+
+func (p *RoleDefinition) GetParentRoleID() (v core.UUID) {
+	if !p.IsSetParentRoleID() {
+		return RoleDefinition_ParentRoleID_DEFAULT
+	}
+	return *p.ParentRoleID
+}
+
+var RoleDefinition_EffectivePermissions_DEFAULT []*Permission
+
+func (p *RoleDefinition) GetEffectivePermissions() (v []*Permission) {
+	if !p.IsSetEffectivePermissions() {
+		return RoleDefinition_EffectivePermissions_DEFAULT
+	}
+	return p.EffectivePermissions
+}
 func (p *RoleDefinition) SetId(val *core.UUID) {
 	p.Id = val
 }
@@ -1531,6 +1583,9 @@ func (p *RoleDefinition) SetPermissions(val []*Permission) {
 func (p *RoleDefinition) SetIsSystemRole(val bool) {
 	p.IsSystemRole = val
 }
+func (p *RoleDefinition) SetMfaRequired(val bool) {
+	p.MfaRequired = val
+}
 func (p *RoleDefinition) SetCreatedBy(val *core.UUID) {
 	p.CreatedBy = val
 }
@@ -1546,6 +1601,12 @@ func (p *RoleDefinition) SetUpdatedAt(val *core.TimestampMS) {
 func (p *RoleDefinition) SetUserCount(val *int64) {
 	p.UserCount = val
 }
+func (p *RoleDefinition) SetParentRoleID(val *core.UUID) {
+	p.ParentRoleID = val
+}
+func (p *RoleDefinition) SetEffectivePermissions(val []*Permission) {
+	p.EffectivePermissions = val
+}
 
 func (p *RoleDefinition) IsSetId() bool {
 	return p.Id != nil
@@ -1571,6 +1632,10 @@ func (p *RoleDefinition) IsSetIsSystemRole() bool {
 	return p.IsSystemRole != RoleDefinition_IsSystemRole_DEFAULT
 }
 
+func (p *RoleDefinition) IsSetMfaRequired() bool {
+	return p.MfaRequired != RoleDefinition_MfaRequired_DEFAULT
+}
+
 func (p *RoleDefinition) IsSetCreatedBy() bool {
 	return p.CreatedBy != nil
 }
@@ -1591,6 +1656,14 @@ func (p *RoleDefinition) IsSetUserCount() bool {
 	return p.UserCount != nil
 }
 
+func (p *RoleDefinition) IsSetParentRoleID() bool {
+	return p.ParentRoleID != nil
+}
+
+func (p *RoleDefinition) IsSetEffectivePermissions() bool {
+	return p.EffectivePermissions != nil
+}
+
 func (p *RoleDefinition) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1605,21 +1678,28 @@ var fieldIDToName_RoleDefinition = map[int16]string{
 	5:  "status",
 	6:  "permissions",
 	7:  "isSystemRole",
+	13: "mfaRequired",
 	8:  "createdBy",
 	9:  "updatedBy",
 	10: "createdAt",
 	11: "updatedAt",
 	12: "userCount",
+	14: "parentRoleID",
+	15: "effectivePermissions",
 }
 
 type UserRoleAssignment struct {
-	Id        *core.UUID        `thrift:"id,1,optional" frugal:"1,optional,string" json:"id,omitempty"`
-	UserID    *core.UUID        `thrift:"userID,2,optional" frugal:"2,optional,string" json:"userID,omitempty"`
-	RoleID    *core.UUID        `thrift:"roleID,3,optional" frugal:"3,optional,string" json:"roleID,omitempty"`
-	CreatedBy *core.UUID        `thrift:"createdBy,11,optional" frugal:"11,optional,string" json:"createdBy,omitempty"`
-	UpdatedBy *core.UUID        `thrift:"updatedBy,12,optional" frugal:"12,optional,string" json:"updatedBy,omitempty"`
-	CreatedAt *core.TimestampMS `thrift:"createdAt,13,optional" frugal:"13,optional,i64" json:"createdAt,omitempty"`
-	UpdatedAt *core.TimestampMS `thrift:"updatedAt,14,optional" frugal:"14,optional,i64" json:"updatedAt,omitempty"`
+	Id             *core.UUID        `thrift:"id,1,optional" frugal:"1,optional,string" json:"id,omitempty"`
+	UserID         *core.UUID        `thrift:"userID,2,optional" frugal:"2,optional,string" json:"userID,omitempty"`
+	RoleID         *core.UUID        `thrift:"roleID,3,optional" frugal:"3,optional,string" json:"roleID,omitempty"`
+	EffectiveFrom  *core.TimestampMS `thrift:"effectiveFrom,4,optional" frugal:"4,optional,i64" json:"effectiveFrom,omitempty"`
+	ExpiresAt      *core.TimestampMS `thrift:"expiresAt,5,optional" frugal:"5,optional,i64" json:"expiresAt,omitempty"`
+	Active         *bool             `thrift:"active,6,optional" frugal:"6,optional,bool" json:"active,omitempty"`
+	OrganizationID *core.UUID        `thrift:"organizationID,7,optional" frugal:"7,optional,string" json:"organizationID,omitempty"`
+	CreatedBy      *core.UUID        `thrift:"createdBy,11,optional" frugal:"11,optional,string" json:"createdBy,omitempty"`
+	UpdatedBy      *core.UUID        `thrift:"updatedBy,12,optional" frugal:"12,optional,string" json:"updatedBy,omitempty"`
+	CreatedAt      *core.TimestampMS `thrift:"createdAt,13,optional" frugal:"13,optional,i64" json:"createdAt,omitempty"`
+	UpdatedAt      *core.TimestampMS `thrift:"updatedAt,14,optional" frugal:"14,optional,i64" json:"updatedAt,omitempty"`
 }
 
 func NewUserRoleAssignment() *UserRoleAssignment {
@@ -1656,6 +1736,42 @@ func (p *UserRoleAssignment) GetRoleID() (v core.UUID) {
 	return *p.RoleID
 }
 
+var UserRoleAssignment_EffectiveFrom_DEFAULT core.TimestampMS
+
+func (p *UserRoleAssignment) GetEffectiveFrom() (v core.TimestampMS) {
+	if !p.IsSetEffectiveFrom() {
+		return UserRoleAssignment_EffectiveFrom_DEFAULT
+	}
+	return *p.EffectiveFrom
+}
+
+var UserRoleAssignment_ExpiresAt_DEFAULT core.TimestampMS
+
+func (p *UserRoleAssignment) GetExpiresAt() (v core.TimestampMS) {
+	if !p.IsSetExpiresAt() {
+		return UserRoleAssignment_ExpiresAt_DEFAULT
+	}
+	return *p.ExpiresAt
+}
+
+var UserRoleAssignment_Active_DEFAULT bool
+
+func (p *UserRoleAssignment) GetActive() (v bool) {
+	if !p.IsSetActive() {
+		return UserRoleAssignment_Active_DEFAULT
+	}
+	return *p.Active
+}
+
+var UserRoleAssignment_OrganizationID_DEFAULT core.UUID
+
+func (p *UserRoleAssignment) GetOrganizationID() (v core.UUID) {
+	if !p.IsSetOrganizationID() {
+		return UserRoleAssignment_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
+
 var UserRoleAssignment_CreatedBy_DEFAULT core.UUID
 
 func (p *UserRoleAssignment) GetCreatedBy() (v core.UUID) {
@@ -1700,6 +1816,18 @@ func (p *UserRoleAssignment) SetUserID(val *core.UUID) {
 func (p *UserRoleAssignment) SetRoleID(val *core.UUID) {
 	p.RoleID = val
 }
+func (p *UserRoleAssignment) SetEffectiveFrom(val *core.TimestampMS) {
+	p.EffectiveFrom = val
+}
+func (p *UserRoleAssignment) SetExpiresAt(val *core.TimestampMS) {
+	p.ExpiresAt = val
+}
+func (p *UserRoleAssignment) SetActive(val *bool) {
+	p.Active = val
+}
+func (p *UserRoleAssignment) SetOrganizationID(val *core.UUID) {
+	p.OrganizationID = val
+}
 func (p *UserRoleAssignment) SetCreatedBy(val *core.UUID) {
 	p.CreatedBy = val
 }
@@ -1725,6 +1853,22 @@ func (p *UserRoleAssignment) IsSetRoleID() bool {
 	return p.RoleID != nil
 }
 
+func (p *UserRoleAssignment) IsSetEffectiveFrom() bool {
+	return p.EffectiveFrom != nil
+}
+
+func (p *UserRoleAssignment) IsSetExpiresAt() bool {
+	return p.ExpiresAt != nil
+}
+
+func (p *UserRoleAssignment) IsSetActive() bool {
+	return p.Active != nil
+}
+
+func (p *UserRoleAssignment) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
 func (p *UserRoleAssignment) IsSetCreatedBy() bool {
 	return p.CreatedBy != nil
 }
@@ -1752,6 +1896,10 @@ var fieldIDToName_UserRoleAssignment = map[int16]string{
 	1:  "id",
 	2:  "userID",
 	3:  "roleID",
+	4:  "effectiveFrom",
+	5:  "expiresAt",
+	6:  "active",
+	7:  "organizationID",
 	11: "createdBy",
 	12: "updatedBy",
 	13: "createdAt",
@@ -1921,3 +2069,311 @@ var fieldIDToName_MenuNode = map[int16]string{
 	7: "hasPermission",
 	8: "permissionLevel",
 }
+
+type AuditEvent struct {
+	Id                  *core.UUID        `thrift:"id,1,optional" frugal:"1,optional,string" json:"id,omitempty"`
+	ActorID             *core.UUID        `thrift:"actorID,2,optional" frugal:"2,optional,string" json:"actorID,omitempty"`
+	ActorOrganizationID *core.UUID        `thrift:"actorOrganizationID,3,optional" frugal:"3,optional,string" json:"actorOrganizationID,omitempty"`
+	Action              *string           `thrift:"action,4,optional" frugal:"4,optional,string" json:"action,omitempty"`
+	TargetType          *string           `thrift:"targetType,5,optional" frugal:"5,optional,string" json:"targetType,omitempty"`
+	TargetID            *string           `thrift:"targetID,6,optional" frugal:"6,optional,string" json:"targetID,omitempty"`
+	Before              *string           `thrift:"before,7,optional" frugal:"7,optional,string" json:"before,omitempty"`
+	After               *string           `thrift:"after,8,optional" frugal:"8,optional,string" json:"after,omitempty"`
+	ChangedFields       []string          `thrift:"changedFields,9,optional" frugal:"9,optional,list<string>" json:"changedFields,omitempty"`
+	RequestID           *string           `thrift:"requestID,10,optional" frugal:"10,optional,string" json:"requestID,omitempty"`
+	TraceID             *string           `thrift:"traceID,11,optional" frugal:"11,optional,string" json:"traceID,omitempty"`
+	IpAddress           *string           `thrift:"ipAddress,12,optional" frugal:"12,optional,string" json:"ipAddress,omitempty"`
+	Result_             *string           `thrift:"result,13,optional" frugal:"13,optional,string" json:"result,omitempty"`
+	ErrorCode           *int32            `thrift:"errorCode,14,optional" frugal:"14,optional,i32" json:"errorCode,omitempty"`
+	ErrorMessage        *string           `thrift:"errorMessage,15,optional" frugal:"15,optional,string" json:"errorMessage,omitempty"`
+	CreatedAt           *core.TimestampMS `thrift:"createdAt,16,optional" frugal:"16,optional,i64" json:"createdAt,omitempty"`
+}
+
+func NewAuditEvent() *AuditEvent {
+	return &AuditEvent{}
+}
+
+func (p *AuditEvent) InitDefault() {
+}
+
+var AuditEvent_Id_DEFAULT core.UUID
+
+func (p *AuditEvent) GetId() (v core.UUID) {
+	if !p.IsSetId() {
+		return AuditEvent_Id_DEFAULT
+	}
+	return *p.Id
+}
+
+var AuditEvent_ActorID_DEFAULT core.UUID
+
+func (p *AuditEvent) GetActorID() (v core.UUID) {
+	if !p.IsSetActorID() {
+		return AuditEvent_ActorID_DEFAULT
+	}
+	return *p.ActorID
+}
+
+var AuditEvent_ActorOrganizationID_DEFAULT core.UUID
+
+func (p *AuditEvent) GetActorOrganizationID() (v core.UUID) {
+	if !p.IsSetActorOrganizationID() {
+		return AuditEvent_ActorOrganizationID_DEFAULT
+	}
+	return *p.ActorOrganizationID
+}
+
+var AuditEvent_Action_DEFAULT string
+
+func (p *AuditEvent) GetAction() (v string) {
+	if !p.IsSetAction() {
+		return AuditEvent_Action_DEFAULT
+	}
+	return *p.Action
+}
+
+var AuditEvent_TargetType_DEFAULT string
+
+func (p *AuditEvent) GetTargetType() (v string) {
+	if !p.IsSetTargetType() {
+		return AuditEvent_TargetType_DEFAULT
+	}
+	return *p.TargetType
+}
+
+var AuditEvent_TargetID_DEFAULT string
+
+func (p *AuditEvent) GetTargetID() (v string) {
+	if !p.IsSetTargetID() {
+		return AuditEvent_TargetID_DEFAULT
+	}
+	return *p.TargetID
+}
+
+var AuditEvent_Before_DEFAULT string
+
+func (p *AuditEvent) GetBefore() (v string) {
+	if !p.IsSetBefore() {
+		return AuditEvent_Before_DEFAULT
+	}
+	return *p.Before
+}
+
+var AuditEvent_After_DEFAULT string
+
+func (p *AuditEvent) GetAfter() (v string) {
+	if !p.IsSetAfter() {
+		return AuditEvent_After_DEFAULT
+	}
+	return *p.After
+}
+
+var AuditEvent_ChangedFields_DEFAULT []string
+
+func (p *AuditEvent) GetChangedFields() (v []string) {
+	if !p.IsSetChangedFields() {
+		return AuditEvent_ChangedFields_DEFAULT
+	}
+	return p.ChangedFields
+}
+
+var AuditEvent_RequestID_DEFAULT string
+
+func (p *AuditEvent) GetRequestID() (v string) {
+	if !p.IsSetRequestID() {
+		return AuditEvent_RequestID_DEFAULT
+	}
+	return *p.RequestID
+}
+
+var AuditEvent_TraceID_DEFAULT string
+
+func (p *AuditEvent) GetTraceID() (v string) {
+	if !p.IsSetTraceID() {
+		return AuditEvent_TraceID_DEFAULT
+	}
+	return *p.TraceID
+}
+
+var AuditEvent_IpAddress_DEFAULT string
+
+func (p *AuditEvent) GetIpAddress() (v string) {
+	if !p.IsSetIpAddress() {
+		return AuditEvent_IpAddress_DEFAULT
+	}
+	return *p.IpAddress
+}
+
+var AuditEvent_Result__DEFAULT string
+
+func (p *AuditEvent) GetResult_() (v string) {
+	if !p.IsSetResult_() {
+		return AuditEvent_Result__DEFAULT
+	}
+	return *p.Result_
+}
+
+var AuditEvent_ErrorCode_DEFAULT int32
+
+func (p *AuditEvent) GetErrorCode() (v int32) {
+	if !p.IsSetErrorCode() {
+		return AuditEvent_ErrorCode_DEFAULT
+	}
+	return *p.ErrorCode
+}
+
+var AuditEvent_ErrorMessage_DEFAULT string
+
+func (p *AuditEvent) GetErrorMessage() (v string) {
+	if !p.IsSetErrorMessage() {
+		return AuditEvent_ErrorMessage_DEFAULT
+	}
+	return *p.ErrorMessage
+}
+
+var AuditEvent_CreatedAt_DEFAULT core.TimestampMS
+
+func (p *AuditEvent) GetCreatedAt() (v core.TimestampMS) {
+	if !p.IsSetCreatedAt() {
+		return AuditEvent_CreatedAt_DEFAULT
+	}
+	return *p.CreatedAt
+}
+func (p *AuditEvent) SetId(val *core.UUID) {
+	p.Id = val
+}
+func (p *AuditEvent) SetActorID(val *core.UUID) {
+	p.ActorID = val
+}
+func (p *AuditEvent) SetActorOrganizationID(val *core.UUID) {
+	p.ActorOrganizationID = val
+}
+func (p *AuditEvent) SetAction(val *string) {
+	p.Action = val
+}
+func (p *AuditEvent) SetTargetType(val *string) {
+	p.TargetType = val
+}
+func (p *AuditEvent) SetTargetID(val *string) {
+	p.TargetID = val
+}
+func (p *AuditEvent) SetBefore(val *string) {
+	p.Before = val
+}
+func (p *AuditEvent) SetAfter(val *string) {
+	p.After = val
+}
+func (p *AuditEvent) SetChangedFields(val []string) {
+	p.ChangedFields = val
+}
+func (p *AuditEvent) SetRequestID(val *string) {
+	p.RequestID = val
+}
+func (p *AuditEvent) SetTraceID(val *string) {
+	p.TraceID = val
+}
+func (p *AuditEvent) SetIpAddress(val *string) {
+	p.IpAddress = val
+}
+func (p *AuditEvent) SetResult_(val *string) {
+	p.Result_ = val
+}
+func (p *AuditEvent) SetErrorCode(val *int32) {
+	p.ErrorCode = val
+}
+func (p *AuditEvent) SetErrorMessage(val *string) {
+	p.ErrorMessage = val
+}
+func (p *AuditEvent) SetCreatedAt(val *core.TimestampMS) {
+	p.CreatedAt = val
+}
+
+func (p *AuditEvent) IsSetId() bool {
+	return p.Id != nil
+}
+
+func (p *AuditEvent) IsSetActorID() bool {
+	return p.ActorID != nil
+}
+
+func (p *AuditEvent) IsSetActorOrganizationID() bool {
+	return p.ActorOrganizationID != nil
+}
+
+func (p *AuditEvent) IsSetAction() bool {
+	return p.Action != nil
+}
+
+func (p *AuditEvent) IsSetTargetType() bool {
+	return p.TargetType != nil
+}
+
+func (p *AuditEvent) IsSetTargetID() bool {
+	return p.TargetID != nil
+}
+
+func (p *AuditEvent) IsSetBefore() bool {
+	return p.Before != nil
+}
+
+func (p *AuditEvent) IsSetAfter() bool {
+	return p.After != nil
+}
+
+func (p *AuditEvent) IsSetChangedFields() bool {
+	return p.ChangedFields != nil
+}
+
+func (p *AuditEvent) IsSetRequestID() bool {
+	return p.RequestID != nil
+}
+
+func (p *AuditEvent) IsSetTraceID() bool {
+	return p.TraceID != nil
+}
+
+func (p *AuditEvent) IsSetIpAddress() bool {
+	return p.IpAddress != nil
+}
+
+func (p *AuditEvent) IsSetResult_() bool {
+	return p.Result_ != nil
+}
+
+func (p *AuditEvent) IsSetErrorCode() bool {
+	return p.ErrorCode != nil
+}
+
+func (p *AuditEvent) IsSetErrorMessage() bool {
+	return p.ErrorMessage != nil
+}
+
+func (p *AuditEvent) IsSetCreatedAt() bool {
+	return p.CreatedAt != nil
+}
+
+func (p *AuditEvent) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AuditEvent(%+v)", *p)
+}
+
+var fieldIDToName_AuditEvent = map[int16]string{
+	1:  "id",
+	2:  "actorID",
+	3:  "actorOrganizationID",
+	4:  "action",
+	5:  "targetType",
+	6:  "targetID",
+	7:  "before",
+	8:  "after",
+	9:  "changedFields",
+	10: "requestID",
+	11: "traceID",
+	12: "ipAddress",
+	13: "result",
+	14: "errorCode",
+	15: "errorMessage",
+	16: "createdAt",
+}
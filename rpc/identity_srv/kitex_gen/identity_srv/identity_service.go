@@ -10,8 +10,9 @@ import (
 )
 
 type LoginRequest struct {
-	Username *string `thrift:"username,1,optional" frugal:"1,optional,string" json:"username,omitempty"`
-	Password *string `thrift:"password,2,optional" frugal:"2,optional,string" json:"password,omitempty"`
+	Username       *string    `thrift:"username,1,optional" frugal:"1,optional,string" json:"username,omitempty"`
+	Password       *string    `thrift:"password,2,optional" frugal:"2,optional,string" json:"password,omitempty"`
+	OrganizationID *core.UUID `thrift:"organizationID,3,optional" frugal:"3,optional,string" json:"organizationID,omitempty"`
 }
 
 func NewLoginRequest() *LoginRequest {
@@ -38,12 +39,24 @@ func (p *LoginRequest) GetPassword() (v string) {
 	}
 	return *p.Password
 }
+
+var LoginRequest_OrganizationID_DEFAULT core.UUID
+
+func (p *LoginRequest) GetOrganizationID() (v core.UUID) {
+	if !p.IsSetOrganizationID() {
+		return LoginRequest_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
 func (p *LoginRequest) SetUsername(val *string) {
 	p.Username = val
 }
 func (p *LoginRequest) SetPassword(val *string) {
 	p.Password = val
 }
+func (p *LoginRequest) SetOrganizationID(val *core.UUID) {
+	p.OrganizationID = val
+}
 
 func (p *LoginRequest) IsSetUsername() bool {
 	return p.Username != nil
@@ -53,6 +66,10 @@ func (p *LoginRequest) IsSetPassword() bool {
 	return p.Password != nil
 }
 
+func (p *LoginRequest) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
 func (p *LoginRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -63,14 +80,18 @@ func (p *LoginRequest) String() string {
 var fieldIDToName_LoginRequest = map[int16]string{
 	1: "username",
 	2: "password",
+	3: "organizationID",
 }
 
 type LoginResponse struct {
-	UserProfile *UserProfile      `thrift:"userProfile,1,optional" frugal:"1,optional,UserProfile" json:"userProfile,omitempty"`
-	Memberships []*UserMembership `thrift:"memberships,2,optional" frugal:"2,optional,list<UserMembership>" json:"memberships,omitempty"`
-	MenuTree    []*MenuNode       `thrift:"menuTree,3,optional" frugal:"3,optional,list<MenuNode>" json:"menuTree,omitempty"`
-	RoleIDs     []string          `thrift:"roleIDs,4,optional" frugal:"4,optional,list<string>" json:"roleIDs,omitempty"`
-	Permissions []*MenuPermission `thrift:"permissions,5,optional" frugal:"5,optional,list<MenuPermission>" json:"permissions,omitempty"`
+	UserProfile           *UserProfile      `thrift:"userProfile,1,optional" frugal:"1,optional,UserProfile" json:"userProfile,omitempty"`
+	Memberships           []*UserMembership `thrift:"memberships,2,optional" frugal:"2,optional,list<UserMembership>" json:"memberships,omitempty"`
+	MenuTree              []*MenuNode       `thrift:"menuTree,3,optional" frugal:"3,optional,list<MenuNode>" json:"menuTree,omitempty"`
+	RoleIDs               []string          `thrift:"roleIDs,4,optional" frugal:"4,optional,list<string>" json:"roleIDs,omitempty"`
+	Permissions           []*MenuPermission `thrift:"permissions,5,optional" frugal:"5,optional,list<MenuPermission>" json:"permissions,omitempty"`
+	MfaRequired           *bool             `thrift:"mfaRequired,6,optional" frugal:"6,optional,bool" json:"mfaRequired,omitempty"`
+	MfaEnrollmentRequired *bool             `thrift:"mfaEnrollmentRequired,7,optional" frugal:"7,optional,bool" json:"mfaEnrollmentRequired,omitempty"`
+	ActiveOrganizationID  *core.UUID        `thrift:"activeOrganizationID,8,optional" frugal:"8,optional,string" json:"activeOrganizationID,omitempty"`
 }
 
 func NewLoginResponse() *LoginResponse {
@@ -124,6 +145,33 @@ func (p *LoginResponse) GetPermissions() (v []*MenuPermission) {
 	}
 	return p.Permissions
 }
+
+var LoginResponse_MfaRequired_DEFAULT bool
+
+func (p *LoginResponse) GetMfaRequired() (v bool) {
+	if !p.IsSetMfaRequired() {
+		return LoginResponse_MfaRequired_DEFAULT
+	}
+	return *p.MfaRequired
+}
+
+var LoginResponse_MfaEnrollmentRequired_DEFAULT bool
+
+func (p *LoginResponse) GetMfaEnrollmentRequired() (v bool) {
+	if !p.IsSetMfaEnrollmentRequired() {
+		return LoginResponse_MfaEnrollmentRequired_DEFAULT
+	}
+	return *p.MfaEnrollmentRequired
+}
+
+var LoginResponse_ActiveOrganizationID_DEFAULT core.UUID
+
+func (p *LoginResponse) GetActiveOrganizationID() (v core.UUID) {
+	if !p.IsSetActiveOrganizationID() {
+		return LoginResponse_ActiveOrganizationID_DEFAULT
+	}
+	return *p.ActiveOrganizationID
+}
 func (p *LoginResponse) SetUserProfile(val *UserProfile) {
 	p.UserProfile = val
 }
@@ -139,6 +187,15 @@ func (p *LoginResponse) SetRoleIDs(val []string) {
 func (p *LoginResponse) SetPermissions(val []*MenuPermission) {
 	p.Permissions = val
 }
+func (p *LoginResponse) SetMfaRequired(val *bool) {
+	p.MfaRequired = val
+}
+func (p *LoginResponse) SetMfaEnrollmentRequired(val *bool) {
+	p.MfaEnrollmentRequired = val
+}
+func (p *LoginResponse) SetActiveOrganizationID(val *core.UUID) {
+	p.ActiveOrganizationID = val
+}
 
 func (p *LoginResponse) IsSetUserProfile() bool {
 	return p.UserProfile != nil
@@ -160,6 +217,18 @@ func (p *LoginResponse) IsSetPermissions() bool {
 	return p.Permissions != nil
 }
 
+func (p *LoginResponse) IsSetMfaRequired() bool {
+	return p.MfaRequired != nil
+}
+
+func (p *LoginResponse) IsSetMfaEnrollmentRequired() bool {
+	return p.MfaEnrollmentRequired != nil
+}
+
+func (p *LoginResponse) IsSetActiveOrganizationID() bool {
+	return p.ActiveOrganizationID != nil
+}
+
 func (p *LoginResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -173,6 +242,65 @@ var fieldIDToName_LoginResponse = map[int16]string{
 	3: "menuTree",
 	4: "roleIDs",
 	5: "permissions",
+	6: "mfaRequired",
+	7: "mfaEnrollmentRequired",
+	8: "activeOrganizationID",
+}
+
+type SwitchOrganizationRequest struct {
+	UserID         *core.UUID `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+	OrganizationID *core.UUID `thrift:"organizationID,2,optional" frugal:"2,optional,string" json:"organizationID,omitempty"`
+}
+
+func NewSwitchOrganizationRequest() *SwitchOrganizationRequest {
+	return &SwitchOrganizationRequest{}
+}
+
+func (p *SwitchOrganizationRequest) InitDefault() {
+}
+
+var SwitchOrganizationRequest_UserID_DEFAULT core.UUID
+
+func (p *SwitchOrganizationRequest) GetUserID() (v core.UUID) {
+	if !p.IsSetUserID() {
+		return SwitchOrganizationRequest_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var SwitchOrganizationRequest_OrganizationID_DEFAULT core.UUID
+
+func (p *SwitchOrganizationRequest) GetOrganizationID() (v core.UUID) {
+	if !p.IsSetOrganizationID() {
+		return SwitchOrganizationRequest_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
+func (p *SwitchOrganizationRequest) SetUserID(val *core.UUID) {
+	p.UserID = val
+}
+func (p *SwitchOrganizationRequest) SetOrganizationID(val *core.UUID) {
+	p.OrganizationID = val
+}
+
+func (p *SwitchOrganizationRequest) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *SwitchOrganizationRequest) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
+func (p *SwitchOrganizationRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SwitchOrganizationRequest(%+v)", *p)
+}
+
+var fieldIDToName_SwitchOrganizationRequest = map[int16]string{
+	1: "userID",
+	2: "organizationID",
 }
 
 type ChangePasswordRequest struct {
@@ -343,6 +471,250 @@ var fieldIDToName_ForcePasswordChangeRequest = map[int16]string{
 	1: "userID",
 }
 
+type BeginMFAEnrollmentRequest struct {
+	UserID *core.UUID `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+}
+
+func NewBeginMFAEnrollmentRequest() *BeginMFAEnrollmentRequest {
+	return &BeginMFAEnrollmentRequest{}
+}
+
+func (p *BeginMFAEnrollmentRequest) InitDefault() {
+}
+
+var BeginMFAEnrollmentRequest_UserID_DEFAULT core.UUID
+
+func (p *BeginMFAEnrollmentRequest) GetUserID() (v core.UUID) {
+	if !p.IsSetUserID() {
+		return BeginMFAEnrollmentRequest_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+func (p *BeginMFAEnrollmentRequest) SetUserID(val *core.UUID) {
+	p.UserID = val
+}
+
+func (p *BeginMFAEnrollmentRequest) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *BeginMFAEnrollmentRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BeginMFAEnrollmentRequest(%+v)", *p)
+}
+
+var fieldIDToName_BeginMFAEnrollmentRequest = map[int16]string{
+	1: "userID",
+}
+
+type BeginMFAEnrollmentResponse struct {
+	Secret          *string `thrift:"secret,1,optional" frugal:"1,optional,string" json:"secret,omitempty"`
+	ProvisioningURI *string `thrift:"provisioningURI,2,optional" frugal:"2,optional,string" json:"provisioningURI,omitempty"`
+}
+
+func NewBeginMFAEnrollmentResponse() *BeginMFAEnrollmentResponse {
+	return &BeginMFAEnrollmentResponse{}
+}
+
+func (p *BeginMFAEnrollmentResponse) InitDefault() {
+}
+
+var BeginMFAEnrollmentResponse_Secret_DEFAULT string
+
+func (p *BeginMFAEnrollmentResponse) GetSecret() (v string) {
+	if !p.IsSetSecret() {
+		return BeginMFAEnrollmentResponse_Secret_DEFAULT
+	}
+	return *p.Secret
+}
+
+var BeginMFAEnrollmentResponse_ProvisioningURI_DEFAULT string
+
+func (p *BeginMFAEnrollmentResponse) GetProvisioningURI() (v string) {
+	if !p.IsSetProvisioningURI() {
+		return BeginMFAEnrollmentResponse_ProvisioningURI_DEFAULT
+	}
+	return *p.ProvisioningURI
+}
+func (p *BeginMFAEnrollmentResponse) SetSecret(val *string) {
+	p.Secret = val
+}
+func (p *BeginMFAEnrollmentResponse) SetProvisioningURI(val *string) {
+	p.ProvisioningURI = val
+}
+
+func (p *BeginMFAEnrollmentResponse) IsSetSecret() bool {
+	return p.Secret != nil
+}
+
+func (p *BeginMFAEnrollmentResponse) IsSetProvisioningURI() bool {
+	return p.ProvisioningURI != nil
+}
+
+func (p *BeginMFAEnrollmentResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BeginMFAEnrollmentResponse(%+v)", *p)
+}
+
+var fieldIDToName_BeginMFAEnrollmentResponse = map[int16]string{
+	1: "secret",
+	2: "provisioningURI",
+}
+
+type MFACodeRequest struct {
+	UserID *core.UUID `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+	Code   *string    `thrift:"code,2,optional" frugal:"2,optional,string" json:"code,omitempty"`
+}
+
+func NewMFACodeRequest() *MFACodeRequest {
+	return &MFACodeRequest{}
+}
+
+func (p *MFACodeRequest) InitDefault() {
+}
+
+var MFACodeRequest_UserID_DEFAULT core.UUID
+
+func (p *MFACodeRequest) GetUserID() (v core.UUID) {
+	if !p.IsSetUserID() {
+		return MFACodeRequest_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var MFACodeRequest_Code_DEFAULT string
+
+func (p *MFACodeRequest) GetCode() (v string) {
+	if !p.IsSetCode() {
+		return MFACodeRequest_Code_DEFAULT
+	}
+	return *p.Code
+}
+func (p *MFACodeRequest) SetUserID(val *core.UUID) {
+	p.UserID = val
+}
+func (p *MFACodeRequest) SetCode(val *string) {
+	p.Code = val
+}
+
+func (p *MFACodeRequest) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *MFACodeRequest) IsSetCode() bool {
+	return p.Code != nil
+}
+
+func (p *MFACodeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MFACodeRequest(%+v)", *p)
+}
+
+var fieldIDToName_MFACodeRequest = map[int16]string{
+	1: "userID",
+	2: "code",
+}
+
+type MFARecoveryCodesResponse struct {
+	RecoveryCodes []string `thrift:"recoveryCodes,1,optional" frugal:"1,optional,list<string>" json:"recoveryCodes,omitempty"`
+}
+
+func NewMFARecoveryCodesResponse() *MFARecoveryCodesResponse {
+	return &MFARecoveryCodesResponse{}
+}
+
+func (p *MFARecoveryCodesResponse) InitDefault() {
+}
+
+var MFARecoveryCodesResponse_RecoveryCodes_DEFAULT []string
+
+func (p *MFARecoveryCodesResponse) GetRecoveryCodes() (v []string) {
+	if !p.IsSetRecoveryCodes() {
+		return MFARecoveryCodesResponse_RecoveryCodes_DEFAULT
+	}
+	return p.RecoveryCodes
+}
+func (p *MFARecoveryCodesResponse) SetRecoveryCodes(val []string) {
+	p.RecoveryCodes = val
+}
+
+func (p *MFARecoveryCodesResponse) IsSetRecoveryCodes() bool {
+	return p.RecoveryCodes != nil
+}
+
+func (p *MFARecoveryCodesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MFARecoveryCodesResponse(%+v)", *p)
+}
+
+var fieldIDToName_MFARecoveryCodesResponse = map[int16]string{
+	1: "recoveryCodes",
+}
+
+type VerifyMFAResponse struct {
+	UsedRecoveryCode       *bool  `thrift:"usedRecoveryCode,1,optional" frugal:"1,optional,bool" json:"usedRecoveryCode,omitempty"`
+	RemainingRecoveryCodes *int32 `thrift:"remainingRecoveryCodes,2,optional" frugal:"2,optional,i32" json:"remainingRecoveryCodes,omitempty"`
+}
+
+func NewVerifyMFAResponse() *VerifyMFAResponse {
+	return &VerifyMFAResponse{}
+}
+
+func (p *VerifyMFAResponse) InitDefault() {
+}
+
+var VerifyMFAResponse_UsedRecoveryCode_DEFAULT bool
+
+func (p *VerifyMFAResponse) GetUsedRecoveryCode() (v bool) {
+	if !p.IsSetUsedRecoveryCode() {
+		return VerifyMFAResponse_UsedRecoveryCode_DEFAULT
+	}
+	return *p.UsedRecoveryCode
+}
+
+var VerifyMFAResponse_RemainingRecoveryCodes_DEFAULT int32
+
+func (p *VerifyMFAResponse) GetRemainingRecoveryCodes() (v int32) {
+	if !p.IsSetRemainingRecoveryCodes() {
+		return VerifyMFAResponse_RemainingRecoveryCodes_DEFAULT
+	}
+	return *p.RemainingRecoveryCodes
+}
+func (p *VerifyMFAResponse) SetUsedRecoveryCode(val *bool) {
+	p.UsedRecoveryCode = val
+}
+func (p *VerifyMFAResponse) SetRemainingRecoveryCodes(val *int32) {
+	p.RemainingRecoveryCodes = val
+}
+
+func (p *VerifyMFAResponse) IsSetUsedRecoveryCode() bool {
+	return p.UsedRecoveryCode != nil
+}
+
+func (p *VerifyMFAResponse) IsSetRemainingRecoveryCodes() bool {
+	return p.RemainingRecoveryCodes != nil
+}
+
+func (p *VerifyMFAResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerifyMFAResponse(%+v)", *p)
+}
+
+var fieldIDToName_VerifyMFAResponse = map[int16]string{
+	1: "usedRecoveryCode",
+	2: "remainingRecoveryCodes",
+}
+
 type CreateUserRequest struct {
 	Username           *string           `thrift:"username,1,optional" frugal:"1,optional,string" json:"username,omitempty"`
 	Password           *string           `thrift:"password,2,optional" frugal:"2,optional,string" json:"password,omitempty"`
@@ -946,9 +1318,10 @@ var fieldIDToName_DeleteUserRequest = map[int16]string{
 }
 
 type ListUsersRequest struct {
-	Page           *rpc_base.PageRequest `thrift:"page,1,optional" frugal:"1,optional,rpc_base.PageRequest" json:"page,omitempty"`
-	OrganizationID *core.UUID            `thrift:"organizationID,2,optional" frugal:"2,optional,string" json:"organizationID,omitempty"`
-	Status         *core.UserStatus      `thrift:"status,3,optional" frugal:"3,optional,UserStatus" json:"status,omitempty"`
+	Page                 *rpc_base.PageRequest `thrift:"page,1,optional" frugal:"1,optional,rpc_base.PageRequest" json:"page,omitempty"`
+	OrganizationID       *core.UUID            `thrift:"organizationID,2,optional" frugal:"2,optional,string" json:"organizationID,omitempty"`
+	Status               *core.UserStatus      `thrift:"status,3,optional" frugal:"3,optional,UserStatus" json:"status,omitempty"`
+	AccountExpiresBefore *core.TimestampMS     `thrift:"accountExpiresBefore,4,optional" frugal:"4,optional,i64" json:"accountExpiresBefore,omitempty"`
 }
 
 func NewListUsersRequest() *ListUsersRequest {
@@ -984,6 +1357,15 @@ func (p *ListUsersRequest) GetStatus() (v core.UserStatus) {
 	}
 	return *p.Status
 }
+
+var ListUsersRequest_AccountExpiresBefore_DEFAULT core.TimestampMS
+
+func (p *ListUsersRequest) GetAccountExpiresBefore() (v core.TimestampMS) {
+	if !p.IsSetAccountExpiresBefore() {
+		return ListUsersRequest_AccountExpiresBefore_DEFAULT
+	}
+	return *p.AccountExpiresBefore
+}
 func (p *ListUsersRequest) SetPage(val *rpc_base.PageRequest) {
 	p.Page = val
 }
@@ -993,6 +1375,9 @@ func (p *ListUsersRequest) SetOrganizationID(val *core.UUID) {
 func (p *ListUsersRequest) SetStatus(val *core.UserStatus) {
 	p.Status = val
 }
+func (p *ListUsersRequest) SetAccountExpiresBefore(val *core.TimestampMS) {
+	p.AccountExpiresBefore = val
+}
 
 func (p *ListUsersRequest) IsSetPage() bool {
 	return p.Page != nil
@@ -1006,6 +1391,10 @@ func (p *ListUsersRequest) IsSetStatus() bool {
 	return p.Status != nil
 }
 
+func (p *ListUsersRequest) IsSetAccountExpiresBefore() bool {
+	return p.AccountExpiresBefore != nil
+}
+
 func (p *ListUsersRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1017,6 +1406,7 @@ var fieldIDToName_ListUsersRequest = map[int16]string{
 	1: "page",
 	2: "organizationID",
 	3: "status",
+	4: "accountExpiresBefore",
 }
 
 type ListUsersResponse struct {
@@ -2641,16 +3031,20 @@ type RoleDefinitionCreateRequest struct {
 	Description  *string       `thrift:"description,2,optional" frugal:"2,optional,string" json:"description,omitempty"`
 	Permissions  []*Permission `thrift:"permissions,3,optional" frugal:"3,optional,list<Permission>" json:"permissions,omitempty"`
 	IsSystemRole bool          `thrift:"isSystemRole,4,optional" frugal:"4,optional,bool" json:"isSystemRole,omitempty"`
+	MfaRequired  bool          `thrift:"mfaRequired,5,optional" frugal:"5,optional,bool" json:"mfaRequired,omitempty"`
+	ParentRoleID *core.UUID    `thrift:"parentRoleID,6,optional" frugal:"6,optional,string" json:"parentRoleID,omitempty"`
 }
 
 func NewRoleDefinitionCreateRequest() *RoleDefinitionCreateRequest {
 	return &RoleDefinitionCreateRequest{
 		IsSystemRole: false,
+		MfaRequired:  false,
 	}
 }
 
 func (p *RoleDefinitionCreateRequest) InitDefault() {
 	p.IsSystemRole = false
+	p.MfaRequired = false
 }
 
 var RoleDefinitionCreateRequest_Name_DEFAULT string
@@ -2688,6 +3082,24 @@ func (p *RoleDefinitionCreateRequest) GetIsSystemRole() (v bool) {
 	}
 	return p.IsSystemRole
 }
+
+var RoleDefinitionCreateRequest_MfaRequired_DEFAULT bool = false
+
+func (p *RoleDefinitionCreateRequest) GetMfaRequired() (v bool) {
+	if !p.IsSetMfaRequired() {
+		return RoleDefinitionCreateRequest_MfaRequired_DEFAULT
+	}
+	return p.MfaRequired
+}
+
+var RoleDefinitionCreateRequest_ParentRoleID_DEFAULT core.UUID
+
+func (p *RoleDefinitionCreateRequest) GetParentRoleID() (v core.UUID) {
+	if !p.IsSetParentRoleID() {
+		return RoleDefinitionCreateRequest_ParentRoleID_DEFAULT
+	}
+	return *p.ParentRoleID
+}
 func (p *RoleDefinitionCreateRequest) SetName(val *string) {
 	p.Name = val
 }
@@ -2700,6 +3112,12 @@ func (p *RoleDefinitionCreateRequest) SetPermissions(val []*Permission) {
 func (p *RoleDefinitionCreateRequest) SetIsSystemRole(val bool) {
 	p.IsSystemRole = val
 }
+func (p *RoleDefinitionCreateRequest) SetMfaRequired(val bool) {
+	p.MfaRequired = val
+}
+func (p *RoleDefinitionCreateRequest) SetParentRoleID(val *core.UUID) {
+	p.ParentRoleID = val
+}
 
 func (p *RoleDefinitionCreateRequest) IsSetName() bool {
 	return p.Name != nil
@@ -2717,6 +3135,14 @@ func (p *RoleDefinitionCreateRequest) IsSetIsSystemRole() bool {
 	return p.IsSystemRole != RoleDefinitionCreateRequest_IsSystemRole_DEFAULT
 }
 
+func (p *RoleDefinitionCreateRequest) IsSetMfaRequired() bool {
+	return p.MfaRequired != RoleDefinitionCreateRequest_MfaRequired_DEFAULT
+}
+
+func (p *RoleDefinitionCreateRequest) IsSetParentRoleID() bool {
+	return p.ParentRoleID != nil
+}
+
 func (p *RoleDefinitionCreateRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -2729,6 +3155,8 @@ var fieldIDToName_RoleDefinitionCreateRequest = map[int16]string{
 	2: "description",
 	3: "permissions",
 	4: "isSystemRole",
+	5: "mfaRequired",
+	6: "parentRoleID",
 }
 
 type RoleDefinitionUpdateRequest struct {
@@ -2737,6 +3165,8 @@ type RoleDefinitionUpdateRequest struct {
 	Status           *core.RoleStatus `thrift:"status,3,optional" frugal:"3,optional,RoleStatus" json:"status,omitempty"`
 	Permissions      []*Permission    `thrift:"permissions,4,optional" frugal:"4,optional,list<Permission>" json:"permissions,omitempty"`
 	Name             *string          `thrift:"name,5,optional" frugal:"5,optional,string" json:"name,omitempty"`
+	MfaRequired      *bool            `thrift:"mfaRequired,6,optional" frugal:"6,optional,bool" json:"mfaRequired,omitempty"`
+	ParentRoleID     *string          `thrift:"parentRoleID,7,optional" frugal:"7,optional,string" json:"parentRoleID,omitempty"`
 }
 
 func NewRoleDefinitionUpdateRequest() *RoleDefinitionUpdateRequest {
@@ -2790,6 +3220,24 @@ func (p *RoleDefinitionUpdateRequest) GetName() (v string) {
 	}
 	return *p.Name
 }
+
+var RoleDefinitionUpdateRequest_MfaRequired_DEFAULT bool
+
+func (p *RoleDefinitionUpdateRequest) GetMfaRequired() (v bool) {
+	if !p.IsSetMfaRequired() {
+		return RoleDefinitionUpdateRequest_MfaRequired_DEFAULT
+	}
+	return *p.MfaRequired
+}
+
+var RoleDefinitionUpdateRequest_ParentRoleID_DEFAULT string
+
+func (p *RoleDefinitionUpdateRequest) GetParentRoleID() (v string) {
+	if !p.IsSetParentRoleID() {
+		return RoleDefinitionUpdateRequest_ParentRoleID_DEFAULT
+	}
+	return *p.ParentRoleID
+}
 func (p *RoleDefinitionUpdateRequest) SetRoleDefinitionID(val *core.UUID) {
 	p.RoleDefinitionID = val
 }
@@ -2805,6 +3253,12 @@ func (p *RoleDefinitionUpdateRequest) SetPermissions(val []*Permission) {
 func (p *RoleDefinitionUpdateRequest) SetName(val *string) {
 	p.Name = val
 }
+func (p *RoleDefinitionUpdateRequest) SetMfaRequired(val *bool) {
+	p.MfaRequired = val
+}
+func (p *RoleDefinitionUpdateRequest) SetParentRoleID(val *string) {
+	p.ParentRoleID = val
+}
 
 func (p *RoleDefinitionUpdateRequest) IsSetRoleDefinitionID() bool {
 	return p.RoleDefinitionID != nil
@@ -2826,6 +3280,14 @@ func (p *RoleDefinitionUpdateRequest) IsSetName() bool {
 	return p.Name != nil
 }
 
+func (p *RoleDefinitionUpdateRequest) IsSetMfaRequired() bool {
+	return p.MfaRequired != nil
+}
+
+func (p *RoleDefinitionUpdateRequest) IsSetParentRoleID() bool {
+	return p.ParentRoleID != nil
+}
+
 func (p *RoleDefinitionUpdateRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -2839,6 +3301,8 @@ var fieldIDToName_RoleDefinitionUpdateRequest = map[int16]string{
 	3: "status",
 	4: "permissions",
 	5: "name",
+	6: "mfaRequired",
+	7: "parentRoleID",
 }
 
 type RoleDefinitionQueryRequest struct {
@@ -2990,9 +3454,12 @@ var fieldIDToName_RoleDefinitionListResponse = map[int16]string{
 }
 
 type AssignRoleToUserRequest struct {
-	UserID     *core.UUID `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
-	RoleID     *core.UUID `thrift:"roleID,2,optional" frugal:"2,optional,string" json:"roleID,omitempty"`
-	AssignedBy *core.UUID `thrift:"assignedBy,3,optional" frugal:"3,optional,string" json:"assignedBy,omitempty"`
+	UserID         *core.UUID        `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+	RoleID         *core.UUID        `thrift:"roleID,2,optional" frugal:"2,optional,string" json:"roleID,omitempty"`
+	AssignedBy     *core.UUID        `thrift:"assignedBy,3,optional" frugal:"3,optional,string" json:"assignedBy,omitempty"`
+	EffectiveFrom  *core.TimestampMS `thrift:"effectiveFrom,4,optional" frugal:"4,optional,i64" json:"effectiveFrom,omitempty"`
+	ExpiresAt      *core.TimestampMS `thrift:"expiresAt,5,optional" frugal:"5,optional,i64" json:"expiresAt,omitempty"`
+	OrganizationID *core.UUID        `thrift:"organizationID,6,optional" frugal:"6,optional,string" json:"organizationID,omitempty"`
 }
 
 func NewAssignRoleToUserRequest() *AssignRoleToUserRequest {
@@ -3028,6 +3495,33 @@ func (p *AssignRoleToUserRequest) GetAssignedBy() (v core.UUID) {
 	}
 	return *p.AssignedBy
 }
+
+var AssignRoleToUserRequest_EffectiveFrom_DEFAULT core.TimestampMS
+
+func (p *AssignRoleToUserRequest) GetEffectiveFrom() (v core.TimestampMS) {
+	if !p.IsSetEffectiveFrom() {
+		return AssignRoleToUserRequest_EffectiveFrom_DEFAULT
+	}
+	return *p.EffectiveFrom
+}
+
+var AssignRoleToUserRequest_ExpiresAt_DEFAULT core.TimestampMS
+
+func (p *AssignRoleToUserRequest) GetExpiresAt() (v core.TimestampMS) {
+	if !p.IsSetExpiresAt() {
+		return AssignRoleToUserRequest_ExpiresAt_DEFAULT
+	}
+	return *p.ExpiresAt
+}
+
+var AssignRoleToUserRequest_OrganizationID_DEFAULT core.UUID
+
+func (p *AssignRoleToUserRequest) GetOrganizationID() (v core.UUID) {
+	if !p.IsSetOrganizationID() {
+		return AssignRoleToUserRequest_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
 func (p *AssignRoleToUserRequest) SetUserID(val *core.UUID) {
 	p.UserID = val
 }
@@ -3037,7 +3531,16 @@ func (p *AssignRoleToUserRequest) SetRoleID(val *core.UUID) {
 func (p *AssignRoleToUserRequest) SetAssignedBy(val *core.UUID) {
 	p.AssignedBy = val
 }
-
+func (p *AssignRoleToUserRequest) SetEffectiveFrom(val *core.TimestampMS) {
+	p.EffectiveFrom = val
+}
+func (p *AssignRoleToUserRequest) SetExpiresAt(val *core.TimestampMS) {
+	p.ExpiresAt = val
+}
+func (p *AssignRoleToUserRequest) SetOrganizationID(val *core.UUID) {
+	p.OrganizationID = val
+}
+
 func (p *AssignRoleToUserRequest) IsSetUserID() bool {
 	return p.UserID != nil
 }
@@ -3050,6 +3553,18 @@ func (p *AssignRoleToUserRequest) IsSetAssignedBy() bool {
 	return p.AssignedBy != nil
 }
 
+func (p *AssignRoleToUserRequest) IsSetEffectiveFrom() bool {
+	return p.EffectiveFrom != nil
+}
+
+func (p *AssignRoleToUserRequest) IsSetExpiresAt() bool {
+	return p.ExpiresAt != nil
+}
+
+func (p *AssignRoleToUserRequest) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
 func (p *AssignRoleToUserRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3061,13 +3576,18 @@ var fieldIDToName_AssignRoleToUserRequest = map[int16]string{
 	1: "userID",
 	2: "roleID",
 	3: "assignedBy",
+	4: "effectiveFrom",
+	5: "expiresAt",
+	6: "organizationID",
 }
 
 type UpdateUserRoleAssignmentRequest struct {
-	AssignmentID *core.UUID `thrift:"assignmentID,1,optional" frugal:"1,optional,string" json:"assignmentID,omitempty"`
-	UserID       *core.UUID `thrift:"userID,2,optional" frugal:"2,optional,string" json:"userID,omitempty"`
-	RoleID       *core.UUID `thrift:"roleID,3,optional" frugal:"3,optional,string" json:"roleID,omitempty"`
-	UpdatedBy    *core.UUID `thrift:"updatedBy,4,optional" frugal:"4,optional,string" json:"updatedBy,omitempty"`
+	AssignmentID  *core.UUID        `thrift:"assignmentID,1,optional" frugal:"1,optional,string" json:"assignmentID,omitempty"`
+	UserID        *core.UUID        `thrift:"userID,2,optional" frugal:"2,optional,string" json:"userID,omitempty"`
+	RoleID        *core.UUID        `thrift:"roleID,3,optional" frugal:"3,optional,string" json:"roleID,omitempty"`
+	UpdatedBy     *core.UUID        `thrift:"updatedBy,4,optional" frugal:"4,optional,string" json:"updatedBy,omitempty"`
+	EffectiveFrom *core.TimestampMS `thrift:"effectiveFrom,5,optional" frugal:"5,optional,i64" json:"effectiveFrom,omitempty"`
+	ExpiresAt     *core.TimestampMS `thrift:"expiresAt,6,optional" frugal:"6,optional,i64" json:"expiresAt,omitempty"`
 }
 
 func NewUpdateUserRoleAssignmentRequest() *UpdateUserRoleAssignmentRequest {
@@ -3112,6 +3632,24 @@ func (p *UpdateUserRoleAssignmentRequest) GetUpdatedBy() (v core.UUID) {
 	}
 	return *p.UpdatedBy
 }
+
+var UpdateUserRoleAssignmentRequest_EffectiveFrom_DEFAULT core.TimestampMS
+
+func (p *UpdateUserRoleAssignmentRequest) GetEffectiveFrom() (v core.TimestampMS) {
+	if !p.IsSetEffectiveFrom() {
+		return UpdateUserRoleAssignmentRequest_EffectiveFrom_DEFAULT
+	}
+	return *p.EffectiveFrom
+}
+
+var UpdateUserRoleAssignmentRequest_ExpiresAt_DEFAULT core.TimestampMS
+
+func (p *UpdateUserRoleAssignmentRequest) GetExpiresAt() (v core.TimestampMS) {
+	if !p.IsSetExpiresAt() {
+		return UpdateUserRoleAssignmentRequest_ExpiresAt_DEFAULT
+	}
+	return *p.ExpiresAt
+}
 func (p *UpdateUserRoleAssignmentRequest) SetAssignmentID(val *core.UUID) {
 	p.AssignmentID = val
 }
@@ -3124,6 +3662,12 @@ func (p *UpdateUserRoleAssignmentRequest) SetRoleID(val *core.UUID) {
 func (p *UpdateUserRoleAssignmentRequest) SetUpdatedBy(val *core.UUID) {
 	p.UpdatedBy = val
 }
+func (p *UpdateUserRoleAssignmentRequest) SetEffectiveFrom(val *core.TimestampMS) {
+	p.EffectiveFrom = val
+}
+func (p *UpdateUserRoleAssignmentRequest) SetExpiresAt(val *core.TimestampMS) {
+	p.ExpiresAt = val
+}
 
 func (p *UpdateUserRoleAssignmentRequest) IsSetAssignmentID() bool {
 	return p.AssignmentID != nil
@@ -3141,6 +3685,14 @@ func (p *UpdateUserRoleAssignmentRequest) IsSetUpdatedBy() bool {
 	return p.UpdatedBy != nil
 }
 
+func (p *UpdateUserRoleAssignmentRequest) IsSetEffectiveFrom() bool {
+	return p.EffectiveFrom != nil
+}
+
+func (p *UpdateUserRoleAssignmentRequest) IsSetExpiresAt() bool {
+	return p.ExpiresAt != nil
+}
+
 func (p *UpdateUserRoleAssignmentRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3153,12 +3705,15 @@ var fieldIDToName_UpdateUserRoleAssignmentRequest = map[int16]string{
 	2: "userID",
 	3: "roleID",
 	4: "updatedBy",
+	5: "effectiveFrom",
+	6: "expiresAt",
 }
 
 type RevokeRoleFromUserRequest struct {
-	UserID    *core.UUID `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
-	RoleID    *core.UUID `thrift:"roleID,2,optional" frugal:"2,optional,string" json:"roleID,omitempty"`
-	RevokedBy *core.UUID `thrift:"revokedBy,3,optional" frugal:"3,optional,string" json:"revokedBy,omitempty"`
+	UserID         *core.UUID `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+	RoleID         *core.UUID `thrift:"roleID,2,optional" frugal:"2,optional,string" json:"roleID,omitempty"`
+	RevokedBy      *core.UUID `thrift:"revokedBy,3,optional" frugal:"3,optional,string" json:"revokedBy,omitempty"`
+	OrganizationID *core.UUID `thrift:"organizationID,4,optional" frugal:"4,optional,string" json:"organizationID,omitempty"`
 }
 
 func NewRevokeRoleFromUserRequest() *RevokeRoleFromUserRequest {
@@ -3194,6 +3749,15 @@ func (p *RevokeRoleFromUserRequest) GetRevokedBy() (v core.UUID) {
 	}
 	return *p.RevokedBy
 }
+
+var RevokeRoleFromUserRequest_OrganizationID_DEFAULT core.UUID
+
+func (p *RevokeRoleFromUserRequest) GetOrganizationID() (v core.UUID) {
+	if !p.IsSetOrganizationID() {
+		return RevokeRoleFromUserRequest_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
 func (p *RevokeRoleFromUserRequest) SetUserID(val *core.UUID) {
 	p.UserID = val
 }
@@ -3203,6 +3767,9 @@ func (p *RevokeRoleFromUserRequest) SetRoleID(val *core.UUID) {
 func (p *RevokeRoleFromUserRequest) SetRevokedBy(val *core.UUID) {
 	p.RevokedBy = val
 }
+func (p *RevokeRoleFromUserRequest) SetOrganizationID(val *core.UUID) {
+	p.OrganizationID = val
+}
 
 func (p *RevokeRoleFromUserRequest) IsSetUserID() bool {
 	return p.UserID != nil
@@ -3216,6 +3783,10 @@ func (p *RevokeRoleFromUserRequest) IsSetRevokedBy() bool {
 	return p.RevokedBy != nil
 }
 
+func (p *RevokeRoleFromUserRequest) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
 func (p *RevokeRoleFromUserRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3227,6 +3798,7 @@ var fieldIDToName_RevokeRoleFromUserRequest = map[int16]string{
 	1: "userID",
 	2: "roleID",
 	3: "revokedBy",
+	4: "organizationID",
 }
 
 type UserRoleAssignmentResponse struct {
@@ -3268,9 +3840,11 @@ var fieldIDToName_UserRoleAssignmentResponse = map[int16]string{
 }
 
 type UserRoleQueryRequest struct {
-	UserID *core.UUID            `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
-	RoleID *core.UUID            `thrift:"roleID,2,optional" frugal:"2,optional,string" json:"roleID,omitempty"`
-	Page   *rpc_base.PageRequest `thrift:"page,3,optional" frugal:"3,optional,rpc_base.PageRequest" json:"page,omitempty"`
+	UserID         *core.UUID            `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+	RoleID         *core.UUID            `thrift:"roleID,2,optional" frugal:"2,optional,string" json:"roleID,omitempty"`
+	Page           *rpc_base.PageRequest `thrift:"page,3,optional" frugal:"3,optional,rpc_base.PageRequest" json:"page,omitempty"`
+	ExpiringBefore *core.TimestampMS     `thrift:"expiringBefore,4,optional" frugal:"4,optional,i64" json:"expiringBefore,omitempty"`
+	OrganizationID *core.UUID            `thrift:"organizationID,5,optional" frugal:"5,optional,string" json:"organizationID,omitempty"`
 }
 
 func NewUserRoleQueryRequest() *UserRoleQueryRequest {
@@ -3306,6 +3880,24 @@ func (p *UserRoleQueryRequest) GetPage() (v *rpc_base.PageRequest) {
 	}
 	return p.Page
 }
+
+var UserRoleQueryRequest_ExpiringBefore_DEFAULT core.TimestampMS
+
+func (p *UserRoleQueryRequest) GetExpiringBefore() (v core.TimestampMS) {
+	if !p.IsSetExpiringBefore() {
+		return UserRoleQueryRequest_ExpiringBefore_DEFAULT
+	}
+	return *p.ExpiringBefore
+}
+
+var UserRoleQueryRequest_OrganizationID_DEFAULT core.UUID
+
+func (p *UserRoleQueryRequest) GetOrganizationID() (v core.UUID) {
+	if !p.IsSetOrganizationID() {
+		return UserRoleQueryRequest_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
 func (p *UserRoleQueryRequest) SetUserID(val *core.UUID) {
 	p.UserID = val
 }
@@ -3315,6 +3907,12 @@ func (p *UserRoleQueryRequest) SetRoleID(val *core.UUID) {
 func (p *UserRoleQueryRequest) SetPage(val *rpc_base.PageRequest) {
 	p.Page = val
 }
+func (p *UserRoleQueryRequest) SetExpiringBefore(val *core.TimestampMS) {
+	p.ExpiringBefore = val
+}
+func (p *UserRoleQueryRequest) SetOrganizationID(val *core.UUID) {
+	p.OrganizationID = val
+}
 
 func (p *UserRoleQueryRequest) IsSetUserID() bool {
 	return p.UserID != nil
@@ -3328,6 +3926,14 @@ func (p *UserRoleQueryRequest) IsSetPage() bool {
 	return p.Page != nil
 }
 
+func (p *UserRoleQueryRequest) IsSetExpiringBefore() bool {
+	return p.ExpiringBefore != nil
+}
+
+func (p *UserRoleQueryRequest) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
 func (p *UserRoleQueryRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3339,6 +3945,8 @@ var fieldIDToName_UserRoleQueryRequest = map[int16]string{
 	1: "userID",
 	2: "roleID",
 	3: "page",
+	4: "expiringBefore",
+	5: "organizationID",
 }
 
 type UserRoleListResponse struct {
@@ -3492,9 +4100,12 @@ var fieldIDToName_GetUsersByRoleResponse = map[int16]string{
 }
 
 type BatchBindUsersToRoleRequest struct {
-	RoleID     *core.UUID  `thrift:"roleID,1,optional" frugal:"1,optional,string" json:"roleID,omitempty"`
-	UserIDs    []core.UUID `thrift:"userIDs,2,optional" frugal:"2,optional,list<string>" json:"userIDs,omitempty"`
-	OperatorID *core.UUID  `thrift:"operatorID,3,optional" frugal:"3,optional,string" json:"operatorID,omitempty"`
+	RoleID         *core.UUID        `thrift:"roleID,1,optional" frugal:"1,optional,string" json:"roleID,omitempty"`
+	UserIDs        []core.UUID       `thrift:"userIDs,2,optional" frugal:"2,optional,list<string>" json:"userIDs,omitempty"`
+	OperatorID     *core.UUID        `thrift:"operatorID,3,optional" frugal:"3,optional,string" json:"operatorID,omitempty"`
+	EffectiveFrom  *core.TimestampMS `thrift:"effectiveFrom,4,optional" frugal:"4,optional,i64" json:"effectiveFrom,omitempty"`
+	ExpiresAt      *core.TimestampMS `thrift:"expiresAt,5,optional" frugal:"5,optional,i64" json:"expiresAt,omitempty"`
+	OrganizationID *core.UUID        `thrift:"organizationID,6,optional" frugal:"6,optional,string" json:"organizationID,omitempty"`
 }
 
 func NewBatchBindUsersToRoleRequest() *BatchBindUsersToRoleRequest {
@@ -3530,6 +4141,33 @@ func (p *BatchBindUsersToRoleRequest) GetOperatorID() (v core.UUID) {
 	}
 	return *p.OperatorID
 }
+
+var BatchBindUsersToRoleRequest_EffectiveFrom_DEFAULT core.TimestampMS
+
+func (p *BatchBindUsersToRoleRequest) GetEffectiveFrom() (v core.TimestampMS) {
+	if !p.IsSetEffectiveFrom() {
+		return BatchBindUsersToRoleRequest_EffectiveFrom_DEFAULT
+	}
+	return *p.EffectiveFrom
+}
+
+var BatchBindUsersToRoleRequest_ExpiresAt_DEFAULT core.TimestampMS
+
+func (p *BatchBindUsersToRoleRequest) GetExpiresAt() (v core.TimestampMS) {
+	if !p.IsSetExpiresAt() {
+		return BatchBindUsersToRoleRequest_ExpiresAt_DEFAULT
+	}
+	return *p.ExpiresAt
+}
+
+var BatchBindUsersToRoleRequest_OrganizationID_DEFAULT core.UUID
+
+func (p *BatchBindUsersToRoleRequest) GetOrganizationID() (v core.UUID) {
+	if !p.IsSetOrganizationID() {
+		return BatchBindUsersToRoleRequest_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
 func (p *BatchBindUsersToRoleRequest) SetRoleID(val *core.UUID) {
 	p.RoleID = val
 }
@@ -3539,6 +4177,15 @@ func (p *BatchBindUsersToRoleRequest) SetUserIDs(val []core.UUID) {
 func (p *BatchBindUsersToRoleRequest) SetOperatorID(val *core.UUID) {
 	p.OperatorID = val
 }
+func (p *BatchBindUsersToRoleRequest) SetEffectiveFrom(val *core.TimestampMS) {
+	p.EffectiveFrom = val
+}
+func (p *BatchBindUsersToRoleRequest) SetExpiresAt(val *core.TimestampMS) {
+	p.ExpiresAt = val
+}
+func (p *BatchBindUsersToRoleRequest) SetOrganizationID(val *core.UUID) {
+	p.OrganizationID = val
+}
 
 func (p *BatchBindUsersToRoleRequest) IsSetRoleID() bool {
 	return p.RoleID != nil
@@ -3552,6 +4199,18 @@ func (p *BatchBindUsersToRoleRequest) IsSetOperatorID() bool {
 	return p.OperatorID != nil
 }
 
+func (p *BatchBindUsersToRoleRequest) IsSetEffectiveFrom() bool {
+	return p.EffectiveFrom != nil
+}
+
+func (p *BatchBindUsersToRoleRequest) IsSetExpiresAt() bool {
+	return p.ExpiresAt != nil
+}
+
+func (p *BatchBindUsersToRoleRequest) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
 func (p *BatchBindUsersToRoleRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3563,6 +4222,9 @@ var fieldIDToName_BatchBindUsersToRoleRequest = map[int16]string{
 	1: "roleID",
 	2: "userIDs",
 	3: "operatorID",
+	4: "effectiveFrom",
+	5: "expiresAt",
+	6: "organizationID",
 }
 
 type BatchBindUsersToRoleResponse struct {
@@ -3763,7 +4425,10 @@ var fieldIDToName_BatchGetUserRolesResponse = map[int16]string{
 }
 
 type UploadMenuRequest struct {
-	YamlContent *string `thrift:"yamlContent,1,optional" frugal:"1,optional,string" json:"yamlContent,omitempty"`
+	YamlContent *string    `thrift:"yamlContent,1,optional" frugal:"1,optional,string" json:"yamlContent,omitempty"`
+	OperatorID  *core.UUID `thrift:"operatorID,2,optional" frugal:"2,optional,string" json:"operatorID,omitempty"`
+	DryRun      *bool      `thrift:"dryRun,3,optional" frugal:"3,optional,bool" json:"dryRun,omitempty"`
+	Force       *bool      `thrift:"force,4,optional" frugal:"4,optional,bool" json:"force,omitempty"`
 }
 
 func NewUploadMenuRequest() *UploadMenuRequest {
@@ -3781,14 +4446,62 @@ func (p *UploadMenuRequest) GetYamlContent() (v string) {
 	}
 	return *p.YamlContent
 }
+
+var UploadMenuRequest_OperatorID_DEFAULT core.UUID
+
+func (p *UploadMenuRequest) GetOperatorID() (v core.UUID) {
+	if !p.IsSetOperatorID() {
+		return UploadMenuRequest_OperatorID_DEFAULT
+	}
+	return *p.OperatorID
+}
+
+var UploadMenuRequest_DryRun_DEFAULT bool
+
+func (p *UploadMenuRequest) GetDryRun() (v bool) {
+	if !p.IsSetDryRun() {
+		return UploadMenuRequest_DryRun_DEFAULT
+	}
+	return *p.DryRun
+}
+
+var UploadMenuRequest_Force_DEFAULT bool
+
+func (p *UploadMenuRequest) GetForce() (v bool) {
+	if !p.IsSetForce() {
+		return UploadMenuRequest_Force_DEFAULT
+	}
+	return *p.Force
+}
 func (p *UploadMenuRequest) SetYamlContent(val *string) {
 	p.YamlContent = val
 }
+func (p *UploadMenuRequest) SetOperatorID(val *core.UUID) {
+	p.OperatorID = val
+}
+func (p *UploadMenuRequest) SetDryRun(val *bool) {
+	p.DryRun = val
+}
+func (p *UploadMenuRequest) SetForce(val *bool) {
+	p.Force = val
+}
 
 func (p *UploadMenuRequest) IsSetYamlContent() bool {
 	return p.YamlContent != nil
 }
 
+func (p *UploadMenuRequest) IsSetOperatorID() bool {
+	return p.OperatorID != nil
+}
+
+func (p *UploadMenuRequest) IsSetDryRun() bool {
+	return p.DryRun != nil
+}
+
+func (p *UploadMenuRequest) IsSetForce() bool {
+	return p.Force != nil
+}
+
 func (p *UploadMenuRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -3798,1222 +4511,3402 @@ func (p *UploadMenuRequest) String() string {
 
 var fieldIDToName_UploadMenuRequest = map[int16]string{
 	1: "yamlContent",
+	2: "operatorID",
+	3: "dryRun",
+	4: "force",
 }
 
-type GetMenuTreeResponse struct {
-	MenuTree []*MenuNode `thrift:"menuTree,1,optional" frugal:"1,optional,list<MenuNode>" json:"menuTree,omitempty"`
+type MenuValidationIssue struct {
+	SemanticID *string `thrift:"semanticID,1,optional" frugal:"1,optional,string" json:"semanticID,omitempty"`
+	Field      *string `thrift:"field,2,optional" frugal:"2,optional,string" json:"field,omitempty"`
+	Line       *int32  `thrift:"line,3,optional" frugal:"3,optional,i32" json:"line,omitempty"`
+	Message    *string `thrift:"message,4,optional" frugal:"4,optional,string" json:"message,omitempty"`
 }
 
-func NewGetMenuTreeResponse() *GetMenuTreeResponse {
-	return &GetMenuTreeResponse{}
+func NewMenuValidationIssue() *MenuValidationIssue {
+	return &MenuValidationIssue{}
 }
 
-func (p *GetMenuTreeResponse) InitDefault() {
+func (p *MenuValidationIssue) InitDefault() {
 }
 
-var GetMenuTreeResponse_MenuTree_DEFAULT []*MenuNode
+var MenuValidationIssue_SemanticID_DEFAULT string
 
-func (p *GetMenuTreeResponse) GetMenuTree() (v []*MenuNode) {
-	if !p.IsSetMenuTree() {
-		return GetMenuTreeResponse_MenuTree_DEFAULT
+func (p *MenuValidationIssue) GetSemanticID() (v string) {
+	if !p.IsSetSemanticID() {
+		return MenuValidationIssue_SemanticID_DEFAULT
 	}
-	return p.MenuTree
-}
-func (p *GetMenuTreeResponse) SetMenuTree(val []*MenuNode) {
-	p.MenuTree = val
+	return *p.SemanticID
 }
 
-func (p *GetMenuTreeResponse) IsSetMenuTree() bool {
-	return p.MenuTree != nil
-}
+var MenuValidationIssue_Field_DEFAULT string
 
-func (p *GetMenuTreeResponse) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *MenuValidationIssue) GetField() (v string) {
+	if !p.IsSetField() {
+		return MenuValidationIssue_Field_DEFAULT
 	}
-	return fmt.Sprintf("GetMenuTreeResponse(%+v)", *p)
+	return *p.Field
 }
 
-var fieldIDToName_GetMenuTreeResponse = map[int16]string{
-	1: "menuTree",
-}
+var MenuValidationIssue_Line_DEFAULT int32
 
-type MenuConfig struct {
-	MenuID     *string `thrift:"menuID,1,optional" frugal:"1,optional,string" json:"menuID,omitempty"`
-	Permission *string `thrift:"permission,2,optional" frugal:"2,optional,string" json:"permission,omitempty"`
+func (p *MenuValidationIssue) GetLine() (v int32) {
+	if !p.IsSetLine() {
+		return MenuValidationIssue_Line_DEFAULT
+	}
+	return *p.Line
 }
 
-func NewMenuConfig() *MenuConfig {
-	return &MenuConfig{}
+var MenuValidationIssue_Message_DEFAULT string
+
+func (p *MenuValidationIssue) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return MenuValidationIssue_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *MenuValidationIssue) SetSemanticID(val *string) {
+	p.SemanticID = val
+}
+func (p *MenuValidationIssue) SetField(val *string) {
+	p.Field = val
+}
+func (p *MenuValidationIssue) SetLine(val *int32) {
+	p.Line = val
+}
+func (p *MenuValidationIssue) SetMessage(val *string) {
+	p.Message = val
 }
 
-func (p *MenuConfig) InitDefault() {
+func (p *MenuValidationIssue) IsSetSemanticID() bool {
+	return p.SemanticID != nil
 }
 
-var MenuConfig_MenuID_DEFAULT string
+func (p *MenuValidationIssue) IsSetField() bool {
+	return p.Field != nil
+}
 
-func (p *MenuConfig) GetMenuID() (v string) {
-	if !p.IsSetMenuID() {
-		return MenuConfig_MenuID_DEFAULT
-	}
-	return *p.MenuID
+func (p *MenuValidationIssue) IsSetLine() bool {
+	return p.Line != nil
 }
 
-var MenuConfig_Permission_DEFAULT string
+func (p *MenuValidationIssue) IsSetMessage() bool {
+	return p.Message != nil
+}
 
-func (p *MenuConfig) GetPermission() (v string) {
-	if !p.IsSetPermission() {
-		return MenuConfig_Permission_DEFAULT
+func (p *MenuValidationIssue) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Permission
+	return fmt.Sprintf("MenuValidationIssue(%+v)", *p)
 }
-func (p *MenuConfig) SetMenuID(val *string) {
-	p.MenuID = val
+
+var fieldIDToName_MenuValidationIssue = map[int16]string{
+	1: "semanticID",
+	2: "field",
+	3: "line",
+	4: "message",
 }
-func (p *MenuConfig) SetPermission(val *string) {
-	p.Permission = val
+
+type UploadMenuResponse struct {
+	DryRun     *bool                     `thrift:"dryRun,1,optional" frugal:"1,optional,bool" json:"dryRun,omitempty"`
+	Valid      *bool                     `thrift:"valid,2,optional" frugal:"2,optional,bool" json:"valid,omitempty"`
+	Issues     []*MenuValidationIssue    `thrift:"issues,3,optional" frugal:"3,optional,list<MenuValidationIssue>" json:"issues,omitempty"`
+	Diff       *DiffMenuVersionsResponse `thrift:"diff,4,optional" frugal:"4,optional,DiffMenuVersionsResponse" json:"diff,omitempty"`
+	AccessLoss []*OrphanRoleMenuMapping  `thrift:"accessLoss,5,optional" frugal:"5,optional,list<OrphanRoleMenuMapping>" json:"accessLoss,omitempty"`
+	Applied    *bool                     `thrift:"applied,6,optional" frugal:"6,optional,bool" json:"applied,omitempty"`
+	Version    *string                   `thrift:"version,7,optional" frugal:"7,optional,string" json:"version,omitempty"`
 }
 
-func (p *MenuConfig) IsSetMenuID() bool {
-	return p.MenuID != nil
+func NewUploadMenuResponse() *UploadMenuResponse {
+	return &UploadMenuResponse{}
 }
 
-func (p *MenuConfig) IsSetPermission() bool {
-	return p.Permission != nil
+func (p *UploadMenuResponse) InitDefault() {
 }
 
-func (p *MenuConfig) String() string {
-	if p == nil {
-		return "<nil>"
+var UploadMenuResponse_DryRun_DEFAULT bool
+
+func (p *UploadMenuResponse) GetDryRun() (v bool) {
+	if !p.IsSetDryRun() {
+		return UploadMenuResponse_DryRun_DEFAULT
 	}
-	return fmt.Sprintf("MenuConfig(%+v)", *p)
+	return *p.DryRun
 }
 
-var fieldIDToName_MenuConfig = map[int16]string{
-	1: "menuID",
-	2: "permission",
+var UploadMenuResponse_Valid_DEFAULT bool
+
+func (p *UploadMenuResponse) GetValid() (v bool) {
+	if !p.IsSetValid() {
+		return UploadMenuResponse_Valid_DEFAULT
+	}
+	return *p.Valid
 }
 
-type MenuPermission struct {
-	MenuID     *string `thrift:"menuID,1,optional" frugal:"1,optional,string" json:"menuID,omitempty"`
-	Permission *string `thrift:"permission,2,optional" frugal:"2,optional,string" json:"permission,omitempty"`
+var UploadMenuResponse_Issues_DEFAULT []*MenuValidationIssue
+
+func (p *UploadMenuResponse) GetIssues() (v []*MenuValidationIssue) {
+	if !p.IsSetIssues() {
+		return UploadMenuResponse_Issues_DEFAULT
+	}
+	return p.Issues
 }
 
-func NewMenuPermission() *MenuPermission {
-	return &MenuPermission{}
+var UploadMenuResponse_Diff_DEFAULT *DiffMenuVersionsResponse
+
+func (p *UploadMenuResponse) GetDiff() (v *DiffMenuVersionsResponse) {
+	if !p.IsSetDiff() {
+		return UploadMenuResponse_Diff_DEFAULT
+	}
+	return p.Diff
 }
 
-func (p *MenuPermission) InitDefault() {
+var UploadMenuResponse_AccessLoss_DEFAULT []*OrphanRoleMenuMapping
+
+func (p *UploadMenuResponse) GetAccessLoss() (v []*OrphanRoleMenuMapping) {
+	if !p.IsSetAccessLoss() {
+		return UploadMenuResponse_AccessLoss_DEFAULT
+	}
+	return p.AccessLoss
 }
 
-var MenuPermission_MenuID_DEFAULT string
+var UploadMenuResponse_Applied_DEFAULT bool
 
-func (p *MenuPermission) GetMenuID() (v string) {
-	if !p.IsSetMenuID() {
-		return MenuPermission_MenuID_DEFAULT
+func (p *UploadMenuResponse) GetApplied() (v bool) {
+	if !p.IsSetApplied() {
+		return UploadMenuResponse_Applied_DEFAULT
 	}
-	return *p.MenuID
+	return *p.Applied
 }
 
-var MenuPermission_Permission_DEFAULT string
+var UploadMenuResponse_Version_DEFAULT string
 
-func (p *MenuPermission) GetPermission() (v string) {
-	if !p.IsSetPermission() {
-		return MenuPermission_Permission_DEFAULT
+func (p *UploadMenuResponse) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return UploadMenuResponse_Version_DEFAULT
 	}
-	return *p.Permission
+	return *p.Version
 }
-func (p *MenuPermission) SetMenuID(val *string) {
-	p.MenuID = val
+func (p *UploadMenuResponse) SetDryRun(val *bool) {
+	p.DryRun = val
 }
-func (p *MenuPermission) SetPermission(val *string) {
-	p.Permission = val
+func (p *UploadMenuResponse) SetValid(val *bool) {
+	p.Valid = val
 }
-
-func (p *MenuPermission) IsSetMenuID() bool {
-	return p.MenuID != nil
+func (p *UploadMenuResponse) SetIssues(val []*MenuValidationIssue) {
+	p.Issues = val
+}
+func (p *UploadMenuResponse) SetDiff(val *DiffMenuVersionsResponse) {
+	p.Diff = val
+}
+func (p *UploadMenuResponse) SetAccessLoss(val []*OrphanRoleMenuMapping) {
+	p.AccessLoss = val
+}
+func (p *UploadMenuResponse) SetApplied(val *bool) {
+	p.Applied = val
+}
+func (p *UploadMenuResponse) SetVersion(val *string) {
+	p.Version = val
 }
 
-func (p *MenuPermission) IsSetPermission() bool {
-	return p.Permission != nil
+func (p *UploadMenuResponse) IsSetDryRun() bool {
+	return p.DryRun != nil
 }
 
-func (p *MenuPermission) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("MenuPermission(%+v)", *p)
+func (p *UploadMenuResponse) IsSetValid() bool {
+	return p.Valid != nil
 }
 
-var fieldIDToName_MenuPermission = map[int16]string{
-	1: "menuID",
-	2: "permission",
+func (p *UploadMenuResponse) IsSetIssues() bool {
+	return p.Issues != nil
 }
 
-type ConfigureRoleMenusRequest struct {
-	RoleID      *core.UUID    `thrift:"roleID,1,optional" frugal:"1,optional,string" json:"roleID,omitempty"`
-	MenuConfigs []*MenuConfig `thrift:"menuConfigs,2,optional" frugal:"2,optional,list<MenuConfig>" json:"menuConfigs,omitempty"`
-	OperatorID  *core.UUID    `thrift:"operatorID,3,optional" frugal:"3,optional,string" json:"operatorID,omitempty"`
+func (p *UploadMenuResponse) IsSetDiff() bool {
+	return p.Diff != nil
 }
 
-func NewConfigureRoleMenusRequest() *ConfigureRoleMenusRequest {
-	return &ConfigureRoleMenusRequest{}
+func (p *UploadMenuResponse) IsSetAccessLoss() bool {
+	return p.AccessLoss != nil
 }
 
-func (p *ConfigureRoleMenusRequest) InitDefault() {
+func (p *UploadMenuResponse) IsSetApplied() bool {
+	return p.Applied != nil
 }
 
-var ConfigureRoleMenusRequest_RoleID_DEFAULT core.UUID
+func (p *UploadMenuResponse) IsSetVersion() bool {
+	return p.Version != nil
+}
 
-func (p *ConfigureRoleMenusRequest) GetRoleID() (v core.UUID) {
-	if !p.IsSetRoleID() {
-		return ConfigureRoleMenusRequest_RoleID_DEFAULT
+func (p *UploadMenuResponse) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.RoleID
+	return fmt.Sprintf("UploadMenuResponse(%+v)", *p)
 }
 
-var ConfigureRoleMenusRequest_MenuConfigs_DEFAULT []*MenuConfig
-
-func (p *ConfigureRoleMenusRequest) GetMenuConfigs() (v []*MenuConfig) {
-	if !p.IsSetMenuConfigs() {
-		return ConfigureRoleMenusRequest_MenuConfigs_DEFAULT
-	}
-	return p.MenuConfigs
+var fieldIDToName_UploadMenuResponse = map[int16]string{
+	1: "dryRun",
+	2: "valid",
+	3: "issues",
+	4: "diff",
+	5: "accessLoss",
+	6: "applied",
+	7: "version",
 }
 
-var ConfigureRoleMenusRequest_OperatorID_DEFAULT core.UUID
+type GetMenuTreeResponse struct {
+	MenuTree []*MenuNode `thrift:"menuTree,1,optional" frugal:"1,optional,list<MenuNode>" json:"menuTree,omitempty"`
+}
 
-func (p *ConfigureRoleMenusRequest) GetOperatorID() (v core.UUID) {
-	if !p.IsSetOperatorID() {
-		return ConfigureRoleMenusRequest_OperatorID_DEFAULT
+func NewGetMenuTreeResponse() *GetMenuTreeResponse {
+	return &GetMenuTreeResponse{}
+}
+
+func (p *GetMenuTreeResponse) InitDefault() {
+}
+
+var GetMenuTreeResponse_MenuTree_DEFAULT []*MenuNode
+
+func (p *GetMenuTreeResponse) GetMenuTree() (v []*MenuNode) {
+	if !p.IsSetMenuTree() {
+		return GetMenuTreeResponse_MenuTree_DEFAULT
 	}
-	return *p.OperatorID
+	return p.MenuTree
 }
-func (p *ConfigureRoleMenusRequest) SetRoleID(val *core.UUID) {
-	p.RoleID = val
+func (p *GetMenuTreeResponse) SetMenuTree(val []*MenuNode) {
+	p.MenuTree = val
 }
-func (p *ConfigureRoleMenusRequest) SetMenuConfigs(val []*MenuConfig) {
-	p.MenuConfigs = val
+
+func (p *GetMenuTreeResponse) IsSetMenuTree() bool {
+	return p.MenuTree != nil
 }
-func (p *ConfigureRoleMenusRequest) SetOperatorID(val *core.UUID) {
-	p.OperatorID = val
+
+func (p *GetMenuTreeResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetMenuTreeResponse(%+v)", *p)
 }
 
-func (p *ConfigureRoleMenusRequest) IsSetRoleID() bool {
-	return p.RoleID != nil
+var fieldIDToName_GetMenuTreeResponse = map[int16]string{
+	1: "menuTree",
 }
 
-func (p *ConfigureRoleMenusRequest) IsSetMenuConfigs() bool {
-	return p.MenuConfigs != nil
+type MenuVersionInfo struct {
+	Version     *string           `thrift:"version,1,optional" frugal:"1,optional,string" json:"version,omitempty"`
+	MenuCount   *int64            `thrift:"menuCount,2,optional" frugal:"2,optional,i64" json:"menuCount,omitempty"`
+	UploadedAt  *core.TimestampMS `thrift:"uploadedAt,3,optional" frugal:"3,optional,i64" json:"uploadedAt,omitempty"`
+	UploadedBy  *core.UUID        `thrift:"uploadedBy,4,optional" frugal:"4,optional,string" json:"uploadedBy,omitempty"`
+	IsActive    *bool             `thrift:"isActive,5,optional" frugal:"5,optional,bool" json:"isActive,omitempty"`
+	ActivatedAt *core.TimestampMS `thrift:"activatedAt,6,optional" frugal:"6,optional,i64" json:"activatedAt,omitempty"`
+	ActivatedBy *core.UUID        `thrift:"activatedBy,7,optional" frugal:"7,optional,string" json:"activatedBy,omitempty"`
 }
 
-func (p *ConfigureRoleMenusRequest) IsSetOperatorID() bool {
-	return p.OperatorID != nil
+func NewMenuVersionInfo() *MenuVersionInfo {
+	return &MenuVersionInfo{}
 }
 
-func (p *ConfigureRoleMenusRequest) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *MenuVersionInfo) InitDefault() {
+}
+
+var MenuVersionInfo_Version_DEFAULT string
+
+func (p *MenuVersionInfo) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return MenuVersionInfo_Version_DEFAULT
 	}
-	return fmt.Sprintf("ConfigureRoleMenusRequest(%+v)", *p)
+	return *p.Version
 }
 
-var fieldIDToName_ConfigureRoleMenusRequest = map[int16]string{
-	1: "roleID",
-	2: "menuConfigs",
-	3: "operatorID",
+var MenuVersionInfo_MenuCount_DEFAULT int64
+
+func (p *MenuVersionInfo) GetMenuCount() (v int64) {
+	if !p.IsSetMenuCount() {
+		return MenuVersionInfo_MenuCount_DEFAULT
+	}
+	return *p.MenuCount
 }
 
-type ConfigureRoleMenusResponse struct {
-	Success *bool   `thrift:"success,1,optional" frugal:"1,optional,bool" json:"success,omitempty"`
-	Message *string `thrift:"message,2,optional" frugal:"2,optional,string" json:"message,omitempty"`
+var MenuVersionInfo_UploadedAt_DEFAULT core.TimestampMS
+
+func (p *MenuVersionInfo) GetUploadedAt() (v core.TimestampMS) {
+	if !p.IsSetUploadedAt() {
+		return MenuVersionInfo_UploadedAt_DEFAULT
+	}
+	return *p.UploadedAt
 }
 
-func NewConfigureRoleMenusResponse() *ConfigureRoleMenusResponse {
-	return &ConfigureRoleMenusResponse{}
+var MenuVersionInfo_UploadedBy_DEFAULT core.UUID
+
+func (p *MenuVersionInfo) GetUploadedBy() (v core.UUID) {
+	if !p.IsSetUploadedBy() {
+		return MenuVersionInfo_UploadedBy_DEFAULT
+	}
+	return *p.UploadedBy
 }
 
-func (p *ConfigureRoleMenusResponse) InitDefault() {
+var MenuVersionInfo_IsActive_DEFAULT bool
+
+func (p *MenuVersionInfo) GetIsActive() (v bool) {
+	if !p.IsSetIsActive() {
+		return MenuVersionInfo_IsActive_DEFAULT
+	}
+	return *p.IsActive
 }
 
-var ConfigureRoleMenusResponse_Success_DEFAULT bool
+var MenuVersionInfo_ActivatedAt_DEFAULT core.TimestampMS
 
-func (p *ConfigureRoleMenusResponse) GetSuccess() (v bool) {
-	if !p.IsSetSuccess() {
-		return ConfigureRoleMenusResponse_Success_DEFAULT
+func (p *MenuVersionInfo) GetActivatedAt() (v core.TimestampMS) {
+	if !p.IsSetActivatedAt() {
+		return MenuVersionInfo_ActivatedAt_DEFAULT
 	}
-	return *p.Success
+	return *p.ActivatedAt
 }
 
-var ConfigureRoleMenusResponse_Message_DEFAULT string
+var MenuVersionInfo_ActivatedBy_DEFAULT core.UUID
 
-func (p *ConfigureRoleMenusResponse) GetMessage() (v string) {
-	if !p.IsSetMessage() {
-		return ConfigureRoleMenusResponse_Message_DEFAULT
+func (p *MenuVersionInfo) GetActivatedBy() (v core.UUID) {
+	if !p.IsSetActivatedBy() {
+		return MenuVersionInfo_ActivatedBy_DEFAULT
 	}
-	return *p.Message
+	return *p.ActivatedBy
 }
-func (p *ConfigureRoleMenusResponse) SetSuccess(val *bool) {
-	p.Success = val
+func (p *MenuVersionInfo) SetVersion(val *string) {
+	p.Version = val
 }
-func (p *ConfigureRoleMenusResponse) SetMessage(val *string) {
-	p.Message = val
+func (p *MenuVersionInfo) SetMenuCount(val *int64) {
+	p.MenuCount = val
+}
+func (p *MenuVersionInfo) SetUploadedAt(val *core.TimestampMS) {
+	p.UploadedAt = val
+}
+func (p *MenuVersionInfo) SetUploadedBy(val *core.UUID) {
+	p.UploadedBy = val
+}
+func (p *MenuVersionInfo) SetIsActive(val *bool) {
+	p.IsActive = val
+}
+func (p *MenuVersionInfo) SetActivatedAt(val *core.TimestampMS) {
+	p.ActivatedAt = val
+}
+func (p *MenuVersionInfo) SetActivatedBy(val *core.UUID) {
+	p.ActivatedBy = val
 }
 
-func (p *ConfigureRoleMenusResponse) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *MenuVersionInfo) IsSetVersion() bool {
+	return p.Version != nil
 }
 
-func (p *ConfigureRoleMenusResponse) IsSetMessage() bool {
-	return p.Message != nil
+func (p *MenuVersionInfo) IsSetMenuCount() bool {
+	return p.MenuCount != nil
 }
 
-func (p *ConfigureRoleMenusResponse) String() string {
+func (p *MenuVersionInfo) IsSetUploadedAt() bool {
+	return p.UploadedAt != nil
+}
+
+func (p *MenuVersionInfo) IsSetUploadedBy() bool {
+	return p.UploadedBy != nil
+}
+
+func (p *MenuVersionInfo) IsSetIsActive() bool {
+	return p.IsActive != nil
+}
+
+func (p *MenuVersionInfo) IsSetActivatedAt() bool {
+	return p.ActivatedAt != nil
+}
+
+func (p *MenuVersionInfo) IsSetActivatedBy() bool {
+	return p.ActivatedBy != nil
+}
+
+func (p *MenuVersionInfo) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ConfigureRoleMenusResponse(%+v)", *p)
+	return fmt.Sprintf("MenuVersionInfo(%+v)", *p)
 }
 
-var fieldIDToName_ConfigureRoleMenusResponse = map[int16]string{
-	1: "success",
-	2: "message",
+var fieldIDToName_MenuVersionInfo = map[int16]string{
+	1: "version",
+	2: "menuCount",
+	3: "uploadedAt",
+	4: "uploadedBy",
+	5: "isActive",
+	6: "activatedAt",
+	7: "activatedBy",
 }
 
-type GetRoleMenuTreeRequest struct {
-	RoleID *core.UUID `thrift:"roleID,1,optional" frugal:"1,optional,string" json:"roleID,omitempty"`
+type ListMenuVersionsResponse struct {
+	Versions []*MenuVersionInfo `thrift:"versions,1,optional" frugal:"1,optional,list<MenuVersionInfo>" json:"versions,omitempty"`
 }
 
-func NewGetRoleMenuTreeRequest() *GetRoleMenuTreeRequest {
-	return &GetRoleMenuTreeRequest{}
+func NewListMenuVersionsResponse() *ListMenuVersionsResponse {
+	return &ListMenuVersionsResponse{}
 }
 
-func (p *GetRoleMenuTreeRequest) InitDefault() {
+func (p *ListMenuVersionsResponse) InitDefault() {
 }
 
-var GetRoleMenuTreeRequest_RoleID_DEFAULT core.UUID
+var ListMenuVersionsResponse_Versions_DEFAULT []*MenuVersionInfo
 
-func (p *GetRoleMenuTreeRequest) GetRoleID() (v core.UUID) {
-	if !p.IsSetRoleID() {
-		return GetRoleMenuTreeRequest_RoleID_DEFAULT
+func (p *ListMenuVersionsResponse) GetVersions() (v []*MenuVersionInfo) {
+	if !p.IsSetVersions() {
+		return ListMenuVersionsResponse_Versions_DEFAULT
 	}
-	return *p.RoleID
+	return p.Versions
 }
-func (p *GetRoleMenuTreeRequest) SetRoleID(val *core.UUID) {
-	p.RoleID = val
+func (p *ListMenuVersionsResponse) SetVersions(val []*MenuVersionInfo) {
+	p.Versions = val
 }
 
-func (p *GetRoleMenuTreeRequest) IsSetRoleID() bool {
-	return p.RoleID != nil
+func (p *ListMenuVersionsResponse) IsSetVersions() bool {
+	return p.Versions != nil
 }
 
-func (p *GetRoleMenuTreeRequest) String() string {
+func (p *ListMenuVersionsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetRoleMenuTreeRequest(%+v)", *p)
+	return fmt.Sprintf("ListMenuVersionsResponse(%+v)", *p)
 }
 
-var fieldIDToName_GetRoleMenuTreeRequest = map[int16]string{
-	1: "roleID",
+var fieldIDToName_ListMenuVersionsResponse = map[int16]string{
+	1: "versions",
 }
 
-type GetRoleMenuTreeResponse struct {
-	MenuTree []*MenuNode `thrift:"menuTree,1,optional" frugal:"1,optional,list<MenuNode>" json:"menuTree,omitempty"`
-	RoleID   *core.UUID  `thrift:"roleID,2,optional" frugal:"2,optional,string" json:"roleID,omitempty"`
+type DiffMenuVersionsRequest struct {
+	FromVersion *string `thrift:"fromVersion,1,optional" frugal:"1,optional,string" json:"fromVersion,omitempty"`
+	ToVersion   *string `thrift:"toVersion,2,optional" frugal:"2,optional,string" json:"toVersion,omitempty"`
 }
 
-func NewGetRoleMenuTreeResponse() *GetRoleMenuTreeResponse {
-	return &GetRoleMenuTreeResponse{}
+func NewDiffMenuVersionsRequest() *DiffMenuVersionsRequest {
+	return &DiffMenuVersionsRequest{}
 }
 
-func (p *GetRoleMenuTreeResponse) InitDefault() {
+func (p *DiffMenuVersionsRequest) InitDefault() {
 }
 
-var GetRoleMenuTreeResponse_MenuTree_DEFAULT []*MenuNode
+var DiffMenuVersionsRequest_FromVersion_DEFAULT string
 
-func (p *GetRoleMenuTreeResponse) GetMenuTree() (v []*MenuNode) {
-	if !p.IsSetMenuTree() {
-		return GetRoleMenuTreeResponse_MenuTree_DEFAULT
+func (p *DiffMenuVersionsRequest) GetFromVersion() (v string) {
+	if !p.IsSetFromVersion() {
+		return DiffMenuVersionsRequest_FromVersion_DEFAULT
 	}
-	return p.MenuTree
+	return *p.FromVersion
 }
 
-var GetRoleMenuTreeResponse_RoleID_DEFAULT core.UUID
+var DiffMenuVersionsRequest_ToVersion_DEFAULT string
 
-func (p *GetRoleMenuTreeResponse) GetRoleID() (v core.UUID) {
-	if !p.IsSetRoleID() {
-		return GetRoleMenuTreeResponse_RoleID_DEFAULT
+func (p *DiffMenuVersionsRequest) GetToVersion() (v string) {
+	if !p.IsSetToVersion() {
+		return DiffMenuVersionsRequest_ToVersion_DEFAULT
 	}
-	return *p.RoleID
+	return *p.ToVersion
 }
-func (p *GetRoleMenuTreeResponse) SetMenuTree(val []*MenuNode) {
-	p.MenuTree = val
+func (p *DiffMenuVersionsRequest) SetFromVersion(val *string) {
+	p.FromVersion = val
 }
-func (p *GetRoleMenuTreeResponse) SetRoleID(val *core.UUID) {
-	p.RoleID = val
+func (p *DiffMenuVersionsRequest) SetToVersion(val *string) {
+	p.ToVersion = val
 }
 
-func (p *GetRoleMenuTreeResponse) IsSetMenuTree() bool {
-	return p.MenuTree != nil
+func (p *DiffMenuVersionsRequest) IsSetFromVersion() bool {
+	return p.FromVersion != nil
 }
 
-func (p *GetRoleMenuTreeResponse) IsSetRoleID() bool {
-	return p.RoleID != nil
+func (p *DiffMenuVersionsRequest) IsSetToVersion() bool {
+	return p.ToVersion != nil
 }
 
-func (p *GetRoleMenuTreeResponse) String() string {
+func (p *DiffMenuVersionsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetRoleMenuTreeResponse(%+v)", *p)
+	return fmt.Sprintf("DiffMenuVersionsRequest(%+v)", *p)
 }
 
-var fieldIDToName_GetRoleMenuTreeResponse = map[int16]string{
-	1: "menuTree",
-	2: "roleID",
+var fieldIDToName_DiffMenuVersionsRequest = map[int16]string{
+	1: "fromVersion",
+	2: "toVersion",
 }
 
-type GetUserMenuTreeRequest struct {
-	UserID *core.UUID `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+type MenuVersionChange struct {
+	SemanticID               *string `thrift:"semanticID,1,optional" frugal:"1,optional,string" json:"semanticID,omitempty"`
+	Name                     *string `thrift:"name,2,optional" frugal:"2,optional,string" json:"name,omitempty"`
+	PreviousName             *string `thrift:"previousName,3,optional" frugal:"3,optional,string" json:"previousName,omitempty"`
+	Path                     *string `thrift:"path,4,optional" frugal:"4,optional,string" json:"path,omitempty"`
+	PreviousPath             *string `thrift:"previousPath,5,optional" frugal:"5,optional,string" json:"previousPath,omitempty"`
+	ParentSemanticID         *string `thrift:"parentSemanticID,6,optional" frugal:"6,optional,string" json:"parentSemanticID,omitempty"`
+	PreviousParentSemanticID *string `thrift:"previousParentSemanticID,7,optional" frugal:"7,optional,string" json:"previousParentSemanticID,omitempty"`
 }
 
-func NewGetUserMenuTreeRequest() *GetUserMenuTreeRequest {
-	return &GetUserMenuTreeRequest{}
+func NewMenuVersionChange() *MenuVersionChange {
+	return &MenuVersionChange{}
 }
 
-func (p *GetUserMenuTreeRequest) InitDefault() {
+func (p *MenuVersionChange) InitDefault() {
 }
 
-var GetUserMenuTreeRequest_UserID_DEFAULT core.UUID
+var MenuVersionChange_SemanticID_DEFAULT string
 
-func (p *GetUserMenuTreeRequest) GetUserID() (v core.UUID) {
-	if !p.IsSetUserID() {
-		return GetUserMenuTreeRequest_UserID_DEFAULT
+func (p *MenuVersionChange) GetSemanticID() (v string) {
+	if !p.IsSetSemanticID() {
+		return MenuVersionChange_SemanticID_DEFAULT
 	}
-	return *p.UserID
-}
-func (p *GetUserMenuTreeRequest) SetUserID(val *core.UUID) {
-	p.UserID = val
+	return *p.SemanticID
 }
 
-func (p *GetUserMenuTreeRequest) IsSetUserID() bool {
-	return p.UserID != nil
-}
+var MenuVersionChange_Name_DEFAULT string
 
-func (p *GetUserMenuTreeRequest) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *MenuVersionChange) GetName() (v string) {
+	if !p.IsSetName() {
+		return MenuVersionChange_Name_DEFAULT
 	}
-	return fmt.Sprintf("GetUserMenuTreeRequest(%+v)", *p)
+	return *p.Name
 }
 
-var fieldIDToName_GetUserMenuTreeRequest = map[int16]string{
-	1: "userID",
-}
+var MenuVersionChange_PreviousName_DEFAULT string
 
-type GetUserMenuTreeResponse struct {
-	MenuTree []*MenuNode `thrift:"menuTree,1,optional" frugal:"1,optional,list<MenuNode>" json:"menuTree,omitempty"`
-	UserID   *core.UUID  `thrift:"userID,2,optional" frugal:"2,optional,string" json:"userID,omitempty"`
-	RoleIDs  []core.UUID `thrift:"roleIDs,3,optional" frugal:"3,optional,list<string>" json:"roleIDs,omitempty"`
+func (p *MenuVersionChange) GetPreviousName() (v string) {
+	if !p.IsSetPreviousName() {
+		return MenuVersionChange_PreviousName_DEFAULT
+	}
+	return *p.PreviousName
 }
 
-func NewGetUserMenuTreeResponse() *GetUserMenuTreeResponse {
-	return &GetUserMenuTreeResponse{}
-}
+var MenuVersionChange_Path_DEFAULT string
 
-func (p *GetUserMenuTreeResponse) InitDefault() {
+func (p *MenuVersionChange) GetPath() (v string) {
+	if !p.IsSetPath() {
+		return MenuVersionChange_Path_DEFAULT
+	}
+	return *p.Path
 }
 
-var GetUserMenuTreeResponse_MenuTree_DEFAULT []*MenuNode
+var MenuVersionChange_PreviousPath_DEFAULT string
 
-func (p *GetUserMenuTreeResponse) GetMenuTree() (v []*MenuNode) {
-	if !p.IsSetMenuTree() {
-		return GetUserMenuTreeResponse_MenuTree_DEFAULT
+func (p *MenuVersionChange) GetPreviousPath() (v string) {
+	if !p.IsSetPreviousPath() {
+		return MenuVersionChange_PreviousPath_DEFAULT
 	}
-	return p.MenuTree
+	return *p.PreviousPath
 }
 
-var GetUserMenuTreeResponse_UserID_DEFAULT core.UUID
+var MenuVersionChange_ParentSemanticID_DEFAULT string
 
-func (p *GetUserMenuTreeResponse) GetUserID() (v core.UUID) {
-	if !p.IsSetUserID() {
-		return GetUserMenuTreeResponse_UserID_DEFAULT
+func (p *MenuVersionChange) GetParentSemanticID() (v string) {
+	if !p.IsSetParentSemanticID() {
+		return MenuVersionChange_ParentSemanticID_DEFAULT
 	}
-	return *p.UserID
+	return *p.ParentSemanticID
 }
 
-var GetUserMenuTreeResponse_RoleIDs_DEFAULT []core.UUID
+var MenuVersionChange_PreviousParentSemanticID_DEFAULT string
 
-func (p *GetUserMenuTreeResponse) GetRoleIDs() (v []core.UUID) {
-	if !p.IsSetRoleIDs() {
-		return GetUserMenuTreeResponse_RoleIDs_DEFAULT
+func (p *MenuVersionChange) GetPreviousParentSemanticID() (v string) {
+	if !p.IsSetPreviousParentSemanticID() {
+		return MenuVersionChange_PreviousParentSemanticID_DEFAULT
 	}
-	return p.RoleIDs
+	return *p.PreviousParentSemanticID
 }
-func (p *GetUserMenuTreeResponse) SetMenuTree(val []*MenuNode) {
-	p.MenuTree = val
+func (p *MenuVersionChange) SetSemanticID(val *string) {
+	p.SemanticID = val
 }
-func (p *GetUserMenuTreeResponse) SetUserID(val *core.UUID) {
-	p.UserID = val
+func (p *MenuVersionChange) SetName(val *string) {
+	p.Name = val
 }
-func (p *GetUserMenuTreeResponse) SetRoleIDs(val []core.UUID) {
-	p.RoleIDs = val
+func (p *MenuVersionChange) SetPreviousName(val *string) {
+	p.PreviousName = val
+}
+func (p *MenuVersionChange) SetPath(val *string) {
+	p.Path = val
+}
+func (p *MenuVersionChange) SetPreviousPath(val *string) {
+	p.PreviousPath = val
+}
+func (p *MenuVersionChange) SetParentSemanticID(val *string) {
+	p.ParentSemanticID = val
+}
+func (p *MenuVersionChange) SetPreviousParentSemanticID(val *string) {
+	p.PreviousParentSemanticID = val
 }
 
-func (p *GetUserMenuTreeResponse) IsSetMenuTree() bool {
-	return p.MenuTree != nil
+func (p *MenuVersionChange) IsSetSemanticID() bool {
+	return p.SemanticID != nil
 }
 
-func (p *GetUserMenuTreeResponse) IsSetUserID() bool {
-	return p.UserID != nil
+func (p *MenuVersionChange) IsSetName() bool {
+	return p.Name != nil
 }
 
-func (p *GetUserMenuTreeResponse) IsSetRoleIDs() bool {
-	return p.RoleIDs != nil
+func (p *MenuVersionChange) IsSetPreviousName() bool {
+	return p.PreviousName != nil
 }
 
-func (p *GetUserMenuTreeResponse) String() string {
+func (p *MenuVersionChange) IsSetPath() bool {
+	return p.Path != nil
+}
+
+func (p *MenuVersionChange) IsSetPreviousPath() bool {
+	return p.PreviousPath != nil
+}
+
+func (p *MenuVersionChange) IsSetParentSemanticID() bool {
+	return p.ParentSemanticID != nil
+}
+
+func (p *MenuVersionChange) IsSetPreviousParentSemanticID() bool {
+	return p.PreviousParentSemanticID != nil
+}
+
+func (p *MenuVersionChange) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserMenuTreeResponse(%+v)", *p)
+	return fmt.Sprintf("MenuVersionChange(%+v)", *p)
 }
 
-var fieldIDToName_GetUserMenuTreeResponse = map[int16]string{
-	1: "menuTree",
-	2: "userID",
-	3: "roleIDs",
+var fieldIDToName_MenuVersionChange = map[int16]string{
+	1: "semanticID",
+	2: "name",
+	3: "previousName",
+	4: "path",
+	5: "previousPath",
+	6: "parentSemanticID",
+	7: "previousParentSemanticID",
 }
 
-type GetRoleMenuPermissionsRequest struct {
-	RoleID *core.UUID `thrift:"roleID,1,optional" frugal:"1,optional,string" json:"roleID,omitempty"`
+type DiffMenuVersionsResponse struct {
+	FromVersion *string              `thrift:"fromVersion,1,optional" frugal:"1,optional,string" json:"fromVersion,omitempty"`
+	ToVersion   *string              `thrift:"toVersion,2,optional" frugal:"2,optional,string" json:"toVersion,omitempty"`
+	Added       []*MenuVersionChange `thrift:"added,3,optional" frugal:"3,optional,list<MenuVersionChange>" json:"added,omitempty"`
+	Removed     []*MenuVersionChange `thrift:"removed,4,optional" frugal:"4,optional,list<MenuVersionChange>" json:"removed,omitempty"`
+	Moved       []*MenuVersionChange `thrift:"moved,5,optional" frugal:"5,optional,list<MenuVersionChange>" json:"moved,omitempty"`
+	Renamed     []*MenuVersionChange `thrift:"renamed,6,optional" frugal:"6,optional,list<MenuVersionChange>" json:"renamed,omitempty"`
 }
 
-func NewGetRoleMenuPermissionsRequest() *GetRoleMenuPermissionsRequest {
-	return &GetRoleMenuPermissionsRequest{}
+func NewDiffMenuVersionsResponse() *DiffMenuVersionsResponse {
+	return &DiffMenuVersionsResponse{}
 }
 
-func (p *GetRoleMenuPermissionsRequest) InitDefault() {
+func (p *DiffMenuVersionsResponse) InitDefault() {
 }
 
-var GetRoleMenuPermissionsRequest_RoleID_DEFAULT core.UUID
+var DiffMenuVersionsResponse_FromVersion_DEFAULT string
 
-func (p *GetRoleMenuPermissionsRequest) GetRoleID() (v core.UUID) {
-	if !p.IsSetRoleID() {
-		return GetRoleMenuPermissionsRequest_RoleID_DEFAULT
+func (p *DiffMenuVersionsResponse) GetFromVersion() (v string) {
+	if !p.IsSetFromVersion() {
+		return DiffMenuVersionsResponse_FromVersion_DEFAULT
 	}
-	return *p.RoleID
-}
-func (p *GetRoleMenuPermissionsRequest) SetRoleID(val *core.UUID) {
-	p.RoleID = val
+	return *p.FromVersion
 }
 
-func (p *GetRoleMenuPermissionsRequest) IsSetRoleID() bool {
-	return p.RoleID != nil
-}
+var DiffMenuVersionsResponse_ToVersion_DEFAULT string
 
-func (p *GetRoleMenuPermissionsRequest) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *DiffMenuVersionsResponse) GetToVersion() (v string) {
+	if !p.IsSetToVersion() {
+		return DiffMenuVersionsResponse_ToVersion_DEFAULT
 	}
-	return fmt.Sprintf("GetRoleMenuPermissionsRequest(%+v)", *p)
+	return *p.ToVersion
 }
 
-var fieldIDToName_GetRoleMenuPermissionsRequest = map[int16]string{
-	1: "roleID",
-}
+var DiffMenuVersionsResponse_Added_DEFAULT []*MenuVersionChange
 
-type GetRoleMenuPermissionsResponse struct {
-	Permissions []*MenuPermission `thrift:"permissions,1,optional" frugal:"1,optional,list<MenuPermission>" json:"permissions,omitempty"`
-	RoleID      *core.UUID        `thrift:"roleID,2,optional" frugal:"2,optional,string" json:"roleID,omitempty"`
+func (p *DiffMenuVersionsResponse) GetAdded() (v []*MenuVersionChange) {
+	if !p.IsSetAdded() {
+		return DiffMenuVersionsResponse_Added_DEFAULT
+	}
+	return p.Added
 }
 
-func NewGetRoleMenuPermissionsResponse() *GetRoleMenuPermissionsResponse {
-	return &GetRoleMenuPermissionsResponse{}
-}
+var DiffMenuVersionsResponse_Removed_DEFAULT []*MenuVersionChange
 
-func (p *GetRoleMenuPermissionsResponse) InitDefault() {
+func (p *DiffMenuVersionsResponse) GetRemoved() (v []*MenuVersionChange) {
+	if !p.IsSetRemoved() {
+		return DiffMenuVersionsResponse_Removed_DEFAULT
+	}
+	return p.Removed
 }
 
-var GetRoleMenuPermissionsResponse_Permissions_DEFAULT []*MenuPermission
+var DiffMenuVersionsResponse_Moved_DEFAULT []*MenuVersionChange
 
-func (p *GetRoleMenuPermissionsResponse) GetPermissions() (v []*MenuPermission) {
-	if !p.IsSetPermissions() {
-		return GetRoleMenuPermissionsResponse_Permissions_DEFAULT
+func (p *DiffMenuVersionsResponse) GetMoved() (v []*MenuVersionChange) {
+	if !p.IsSetMoved() {
+		return DiffMenuVersionsResponse_Moved_DEFAULT
 	}
-	return p.Permissions
+	return p.Moved
 }
 
-var GetRoleMenuPermissionsResponse_RoleID_DEFAULT core.UUID
+var DiffMenuVersionsResponse_Renamed_DEFAULT []*MenuVersionChange
 
-func (p *GetRoleMenuPermissionsResponse) GetRoleID() (v core.UUID) {
-	if !p.IsSetRoleID() {
-		return GetRoleMenuPermissionsResponse_RoleID_DEFAULT
+func (p *DiffMenuVersionsResponse) GetRenamed() (v []*MenuVersionChange) {
+	if !p.IsSetRenamed() {
+		return DiffMenuVersionsResponse_Renamed_DEFAULT
 	}
-	return *p.RoleID
+	return p.Renamed
 }
-func (p *GetRoleMenuPermissionsResponse) SetPermissions(val []*MenuPermission) {
-	p.Permissions = val
+func (p *DiffMenuVersionsResponse) SetFromVersion(val *string) {
+	p.FromVersion = val
 }
-func (p *GetRoleMenuPermissionsResponse) SetRoleID(val *core.UUID) {
-	p.RoleID = val
+func (p *DiffMenuVersionsResponse) SetToVersion(val *string) {
+	p.ToVersion = val
+}
+func (p *DiffMenuVersionsResponse) SetAdded(val []*MenuVersionChange) {
+	p.Added = val
+}
+func (p *DiffMenuVersionsResponse) SetRemoved(val []*MenuVersionChange) {
+	p.Removed = val
+}
+func (p *DiffMenuVersionsResponse) SetMoved(val []*MenuVersionChange) {
+	p.Moved = val
+}
+func (p *DiffMenuVersionsResponse) SetRenamed(val []*MenuVersionChange) {
+	p.Renamed = val
 }
 
-func (p *GetRoleMenuPermissionsResponse) IsSetPermissions() bool {
-	return p.Permissions != nil
+func (p *DiffMenuVersionsResponse) IsSetFromVersion() bool {
+	return p.FromVersion != nil
 }
 
-func (p *GetRoleMenuPermissionsResponse) IsSetRoleID() bool {
-	return p.RoleID != nil
+func (p *DiffMenuVersionsResponse) IsSetToVersion() bool {
+	return p.ToVersion != nil
 }
 
-func (p *GetRoleMenuPermissionsResponse) String() string {
+func (p *DiffMenuVersionsResponse) IsSetAdded() bool {
+	return p.Added != nil
+}
+
+func (p *DiffMenuVersionsResponse) IsSetRemoved() bool {
+	return p.Removed != nil
+}
+
+func (p *DiffMenuVersionsResponse) IsSetMoved() bool {
+	return p.Moved != nil
+}
+
+func (p *DiffMenuVersionsResponse) IsSetRenamed() bool {
+	return p.Renamed != nil
+}
+
+func (p *DiffMenuVersionsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetRoleMenuPermissionsResponse(%+v)", *p)
+	return fmt.Sprintf("DiffMenuVersionsResponse(%+v)", *p)
 }
 
-var fieldIDToName_GetRoleMenuPermissionsResponse = map[int16]string{
-	1: "permissions",
-	2: "roleID",
+var fieldIDToName_DiffMenuVersionsResponse = map[int16]string{
+	1: "fromVersion",
+	2: "toVersion",
+	3: "added",
+	4: "removed",
+	5: "moved",
+	6: "renamed",
 }
 
-type HasMenuPermissionRequest struct {
+type OrphanRoleMenuMapping struct {
 	RoleID     *core.UUID `thrift:"roleID,1,optional" frugal:"1,optional,string" json:"roleID,omitempty"`
-	MenuID     *string    `thrift:"menuID,2,optional" frugal:"2,optional,string" json:"menuID,omitempty"`
-	Permission *string    `thrift:"permission,3,optional" frugal:"3,optional,string" json:"permission,omitempty"`
+	RoleName   *string    `thrift:"roleName,2,optional" frugal:"2,optional,string" json:"roleName,omitempty"`
+	MenuID     *string    `thrift:"menuID,3,optional" frugal:"3,optional,string" json:"menuID,omitempty"`
+	Permission *string    `thrift:"permission,4,optional" frugal:"4,optional,string" json:"permission,omitempty"`
 }
 
-func NewHasMenuPermissionRequest() *HasMenuPermissionRequest {
-	return &HasMenuPermissionRequest{}
+func NewOrphanRoleMenuMapping() *OrphanRoleMenuMapping {
+	return &OrphanRoleMenuMapping{}
 }
 
-func (p *HasMenuPermissionRequest) InitDefault() {
+func (p *OrphanRoleMenuMapping) InitDefault() {
 }
 
-var HasMenuPermissionRequest_RoleID_DEFAULT core.UUID
+var OrphanRoleMenuMapping_RoleID_DEFAULT core.UUID
 
-func (p *HasMenuPermissionRequest) GetRoleID() (v core.UUID) {
+func (p *OrphanRoleMenuMapping) GetRoleID() (v core.UUID) {
 	if !p.IsSetRoleID() {
-		return HasMenuPermissionRequest_RoleID_DEFAULT
+		return OrphanRoleMenuMapping_RoleID_DEFAULT
 	}
 	return *p.RoleID
 }
 
-var HasMenuPermissionRequest_MenuID_DEFAULT string
+var OrphanRoleMenuMapping_RoleName_DEFAULT string
 
-func (p *HasMenuPermissionRequest) GetMenuID() (v string) {
+func (p *OrphanRoleMenuMapping) GetRoleName() (v string) {
+	if !p.IsSetRoleName() {
+		return OrphanRoleMenuMapping_RoleName_DEFAULT
+	}
+	return *p.RoleName
+}
+
+var OrphanRoleMenuMapping_MenuID_DEFAULT string
+
+func (p *OrphanRoleMenuMapping) GetMenuID() (v string) {
 	if !p.IsSetMenuID() {
-		return HasMenuPermissionRequest_MenuID_DEFAULT
+		return OrphanRoleMenuMapping_MenuID_DEFAULT
 	}
 	return *p.MenuID
 }
 
-var HasMenuPermissionRequest_Permission_DEFAULT string
+var OrphanRoleMenuMapping_Permission_DEFAULT string
 
-func (p *HasMenuPermissionRequest) GetPermission() (v string) {
+func (p *OrphanRoleMenuMapping) GetPermission() (v string) {
 	if !p.IsSetPermission() {
-		return HasMenuPermissionRequest_Permission_DEFAULT
+		return OrphanRoleMenuMapping_Permission_DEFAULT
 	}
 	return *p.Permission
 }
-func (p *HasMenuPermissionRequest) SetRoleID(val *core.UUID) {
+func (p *OrphanRoleMenuMapping) SetRoleID(val *core.UUID) {
 	p.RoleID = val
 }
-func (p *HasMenuPermissionRequest) SetMenuID(val *string) {
+func (p *OrphanRoleMenuMapping) SetRoleName(val *string) {
+	p.RoleName = val
+}
+func (p *OrphanRoleMenuMapping) SetMenuID(val *string) {
 	p.MenuID = val
 }
-func (p *HasMenuPermissionRequest) SetPermission(val *string) {
+func (p *OrphanRoleMenuMapping) SetPermission(val *string) {
 	p.Permission = val
 }
 
-func (p *HasMenuPermissionRequest) IsSetRoleID() bool {
+func (p *OrphanRoleMenuMapping) IsSetRoleID() bool {
 	return p.RoleID != nil
 }
 
-func (p *HasMenuPermissionRequest) IsSetMenuID() bool {
+func (p *OrphanRoleMenuMapping) IsSetRoleName() bool {
+	return p.RoleName != nil
+}
+
+func (p *OrphanRoleMenuMapping) IsSetMenuID() bool {
 	return p.MenuID != nil
 }
 
-func (p *HasMenuPermissionRequest) IsSetPermission() bool {
+func (p *OrphanRoleMenuMapping) IsSetPermission() bool {
 	return p.Permission != nil
 }
 
-func (p *HasMenuPermissionRequest) String() string {
+func (p *OrphanRoleMenuMapping) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("HasMenuPermissionRequest(%+v)", *p)
+	return fmt.Sprintf("OrphanRoleMenuMapping(%+v)", *p)
 }
 
-var fieldIDToName_HasMenuPermissionRequest = map[int16]string{
+var fieldIDToName_OrphanRoleMenuMapping = map[int16]string{
 	1: "roleID",
-	2: "menuID",
-	3: "permission",
-}
-
-type HasMenuPermissionResponse struct {
-	HasPermission *bool      `thrift:"hasPermission,1,optional" frugal:"1,optional,bool" json:"hasPermission,omitempty"`
-	RoleID        *core.UUID `thrift:"roleID,2,optional" frugal:"2,optional,string" json:"roleID,omitempty"`
-	MenuID        *string    `thrift:"menuID,3,optional" frugal:"3,optional,string" json:"menuID,omitempty"`
-	Permission    *string    `thrift:"permission,4,optional" frugal:"4,optional,string" json:"permission,omitempty"`
-}
-
-func NewHasMenuPermissionResponse() *HasMenuPermissionResponse {
-	return &HasMenuPermissionResponse{}
+	2: "roleName",
+	3: "menuID",
+	4: "permission",
 }
 
-func (p *HasMenuPermissionResponse) InitDefault() {
+type ActivateMenuVersionRequest struct {
+	Version    *string    `thrift:"version,1,optional" frugal:"1,optional,string" json:"version,omitempty"`
+	OperatorID *core.UUID `thrift:"operatorID,2,optional" frugal:"2,optional,string" json:"operatorID,omitempty"`
 }
 
-var HasMenuPermissionResponse_HasPermission_DEFAULT bool
-
-func (p *HasMenuPermissionResponse) GetHasPermission() (v bool) {
-	if !p.IsSetHasPermission() {
-		return HasMenuPermissionResponse_HasPermission_DEFAULT
-	}
-	return *p.HasPermission
+func NewActivateMenuVersionRequest() *ActivateMenuVersionRequest {
+	return &ActivateMenuVersionRequest{}
 }
 
-var HasMenuPermissionResponse_RoleID_DEFAULT core.UUID
-
-func (p *HasMenuPermissionResponse) GetRoleID() (v core.UUID) {
-	if !p.IsSetRoleID() {
-		return HasMenuPermissionResponse_RoleID_DEFAULT
-	}
-	return *p.RoleID
+func (p *ActivateMenuVersionRequest) InitDefault() {
 }
 
-var HasMenuPermissionResponse_MenuID_DEFAULT string
+var ActivateMenuVersionRequest_Version_DEFAULT string
 
-func (p *HasMenuPermissionResponse) GetMenuID() (v string) {
-	if !p.IsSetMenuID() {
-		return HasMenuPermissionResponse_MenuID_DEFAULT
+func (p *ActivateMenuVersionRequest) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return ActivateMenuVersionRequest_Version_DEFAULT
 	}
-	return *p.MenuID
+	return *p.Version
 }
 
-var HasMenuPermissionResponse_Permission_DEFAULT string
+var ActivateMenuVersionRequest_OperatorID_DEFAULT core.UUID
 
-func (p *HasMenuPermissionResponse) GetPermission() (v string) {
-	if !p.IsSetPermission() {
-		return HasMenuPermissionResponse_Permission_DEFAULT
+func (p *ActivateMenuVersionRequest) GetOperatorID() (v core.UUID) {
+	if !p.IsSetOperatorID() {
+		return ActivateMenuVersionRequest_OperatorID_DEFAULT
 	}
-	return *p.Permission
-}
-func (p *HasMenuPermissionResponse) SetHasPermission(val *bool) {
-	p.HasPermission = val
-}
-func (p *HasMenuPermissionResponse) SetRoleID(val *core.UUID) {
-	p.RoleID = val
-}
-func (p *HasMenuPermissionResponse) SetMenuID(val *string) {
-	p.MenuID = val
-}
-func (p *HasMenuPermissionResponse) SetPermission(val *string) {
-	p.Permission = val
+	return *p.OperatorID
 }
-
-func (p *HasMenuPermissionResponse) IsSetHasPermission() bool {
-	return p.HasPermission != nil
+func (p *ActivateMenuVersionRequest) SetVersion(val *string) {
+	p.Version = val
 }
-
-func (p *HasMenuPermissionResponse) IsSetRoleID() bool {
-	return p.RoleID != nil
+func (p *ActivateMenuVersionRequest) SetOperatorID(val *core.UUID) {
+	p.OperatorID = val
 }
 
-func (p *HasMenuPermissionResponse) IsSetMenuID() bool {
-	return p.MenuID != nil
+func (p *ActivateMenuVersionRequest) IsSetVersion() bool {
+	return p.Version != nil
 }
 
-func (p *HasMenuPermissionResponse) IsSetPermission() bool {
-	return p.Permission != nil
+func (p *ActivateMenuVersionRequest) IsSetOperatorID() bool {
+	return p.OperatorID != nil
 }
 
-func (p *HasMenuPermissionResponse) String() string {
+func (p *ActivateMenuVersionRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("HasMenuPermissionResponse(%+v)", *p)
+	return fmt.Sprintf("ActivateMenuVersionRequest(%+v)", *p)
 }
 
-var fieldIDToName_HasMenuPermissionResponse = map[int16]string{
-	1: "hasPermission",
-	2: "roleID",
-	3: "menuID",
-	4: "permission",
+var fieldIDToName_ActivateMenuVersionRequest = map[int16]string{
+	1: "version",
+	2: "operatorID",
 }
 
-type GetUserMenuPermissionsRequest struct {
-	UserID *core.UUID `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+type ActivateMenuVersionResponse struct {
+	Version        *MenuVersionInfo         `thrift:"version,1,optional" frugal:"1,optional,MenuVersionInfo" json:"version,omitempty"`
+	OrphanMappings []*OrphanRoleMenuMapping `thrift:"orphanMappings,2,optional" frugal:"2,optional,list<OrphanRoleMenuMapping>" json:"orphanMappings,omitempty"`
 }
 
-func NewGetUserMenuPermissionsRequest() *GetUserMenuPermissionsRequest {
-	return &GetUserMenuPermissionsRequest{}
+func NewActivateMenuVersionResponse() *ActivateMenuVersionResponse {
+	return &ActivateMenuVersionResponse{}
 }
 
-func (p *GetUserMenuPermissionsRequest) InitDefault() {
+func (p *ActivateMenuVersionResponse) InitDefault() {
 }
 
-var GetUserMenuPermissionsRequest_UserID_DEFAULT core.UUID
+var ActivateMenuVersionResponse_Version_DEFAULT *MenuVersionInfo
 
-func (p *GetUserMenuPermissionsRequest) GetUserID() (v core.UUID) {
-	if !p.IsSetUserID() {
-		return GetUserMenuPermissionsRequest_UserID_DEFAULT
+func (p *ActivateMenuVersionResponse) GetVersion() (v *MenuVersionInfo) {
+	if !p.IsSetVersion() {
+		return ActivateMenuVersionResponse_Version_DEFAULT
 	}
-	return *p.UserID
+	return p.Version
 }
-func (p *GetUserMenuPermissionsRequest) SetUserID(val *core.UUID) {
-	p.UserID = val
+
+var ActivateMenuVersionResponse_OrphanMappings_DEFAULT []*OrphanRoleMenuMapping
+
+func (p *ActivateMenuVersionResponse) GetOrphanMappings() (v []*OrphanRoleMenuMapping) {
+	if !p.IsSetOrphanMappings() {
+		return ActivateMenuVersionResponse_OrphanMappings_DEFAULT
+	}
+	return p.OrphanMappings
+}
+func (p *ActivateMenuVersionResponse) SetVersion(val *MenuVersionInfo) {
+	p.Version = val
+}
+func (p *ActivateMenuVersionResponse) SetOrphanMappings(val []*OrphanRoleMenuMapping) {
+	p.OrphanMappings = val
 }
 
-func (p *GetUserMenuPermissionsRequest) IsSetUserID() bool {
-	return p.UserID != nil
+func (p *ActivateMenuVersionResponse) IsSetVersion() bool {
+	return p.Version != nil
 }
 
-func (p *GetUserMenuPermissionsRequest) String() string {
+func (p *ActivateMenuVersionResponse) IsSetOrphanMappings() bool {
+	return p.OrphanMappings != nil
+}
+
+func (p *ActivateMenuVersionResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserMenuPermissionsRequest(%+v)", *p)
-}
-
-var fieldIDToName_GetUserMenuPermissionsRequest = map[int16]string{
-	1: "userID",
+	return fmt.Sprintf("ActivateMenuVersionResponse(%+v)", *p)
 }
 
-type GetUserMenuPermissionsResponse struct {
-	Permissions []*MenuPermission `thrift:"permissions,1,optional" frugal:"1,optional,list<MenuPermission>" json:"permissions,omitempty"`
-	UserID      *core.UUID        `thrift:"userID,2,optional" frugal:"2,optional,string" json:"userID,omitempty"`
-	RoleIDs     []core.UUID       `thrift:"roleIDs,3,optional" frugal:"3,optional,list<string>" json:"roleIDs,omitempty"`
+var fieldIDToName_ActivateMenuVersionResponse = map[int16]string{
+	1: "version",
+	2: "orphanMappings",
 }
 
-func NewGetUserMenuPermissionsResponse() *GetUserMenuPermissionsResponse {
-	return &GetUserMenuPermissionsResponse{}
+type GetOrphanRoleMenuMappingsResponse struct {
+	Version  *string                  `thrift:"version,1,optional" frugal:"1,optional,string" json:"version,omitempty"`
+	Mappings []*OrphanRoleMenuMapping `thrift:"mappings,2,optional" frugal:"2,optional,list<OrphanRoleMenuMapping>" json:"mappings,omitempty"`
 }
 
-func (p *GetUserMenuPermissionsResponse) InitDefault() {
+func NewGetOrphanRoleMenuMappingsResponse() *GetOrphanRoleMenuMappingsResponse {
+	return &GetOrphanRoleMenuMappingsResponse{}
 }
 
-var GetUserMenuPermissionsResponse_Permissions_DEFAULT []*MenuPermission
-
-func (p *GetUserMenuPermissionsResponse) GetPermissions() (v []*MenuPermission) {
-	if !p.IsSetPermissions() {
-		return GetUserMenuPermissionsResponse_Permissions_DEFAULT
-	}
-	return p.Permissions
+func (p *GetOrphanRoleMenuMappingsResponse) InitDefault() {
 }
 
-var GetUserMenuPermissionsResponse_UserID_DEFAULT core.UUID
+var GetOrphanRoleMenuMappingsResponse_Version_DEFAULT string
 
-func (p *GetUserMenuPermissionsResponse) GetUserID() (v core.UUID) {
-	if !p.IsSetUserID() {
-		return GetUserMenuPermissionsResponse_UserID_DEFAULT
+func (p *GetOrphanRoleMenuMappingsResponse) GetVersion() (v string) {
+	if !p.IsSetVersion() {
+		return GetOrphanRoleMenuMappingsResponse_Version_DEFAULT
 	}
-	return *p.UserID
+	return *p.Version
 }
 
-var GetUserMenuPermissionsResponse_RoleIDs_DEFAULT []core.UUID
+var GetOrphanRoleMenuMappingsResponse_Mappings_DEFAULT []*OrphanRoleMenuMapping
 
-func (p *GetUserMenuPermissionsResponse) GetRoleIDs() (v []core.UUID) {
-	if !p.IsSetRoleIDs() {
-		return GetUserMenuPermissionsResponse_RoleIDs_DEFAULT
+func (p *GetOrphanRoleMenuMappingsResponse) GetMappings() (v []*OrphanRoleMenuMapping) {
+	if !p.IsSetMappings() {
+		return GetOrphanRoleMenuMappingsResponse_Mappings_DEFAULT
 	}
-	return p.RoleIDs
-}
-func (p *GetUserMenuPermissionsResponse) SetPermissions(val []*MenuPermission) {
-	p.Permissions = val
-}
-func (p *GetUserMenuPermissionsResponse) SetUserID(val *core.UUID) {
-	p.UserID = val
+	return p.Mappings
 }
-func (p *GetUserMenuPermissionsResponse) SetRoleIDs(val []core.UUID) {
-	p.RoleIDs = val
+func (p *GetOrphanRoleMenuMappingsResponse) SetVersion(val *string) {
+	p.Version = val
 }
-
-func (p *GetUserMenuPermissionsResponse) IsSetPermissions() bool {
-	return p.Permissions != nil
+func (p *GetOrphanRoleMenuMappingsResponse) SetMappings(val []*OrphanRoleMenuMapping) {
+	p.Mappings = val
 }
 
-func (p *GetUserMenuPermissionsResponse) IsSetUserID() bool {
-	return p.UserID != nil
+func (p *GetOrphanRoleMenuMappingsResponse) IsSetVersion() bool {
+	return p.Version != nil
 }
 
-func (p *GetUserMenuPermissionsResponse) IsSetRoleIDs() bool {
-	return p.RoleIDs != nil
+func (p *GetOrphanRoleMenuMappingsResponse) IsSetMappings() bool {
+	return p.Mappings != nil
 }
 
-func (p *GetUserMenuPermissionsResponse) String() string {
+func (p *GetOrphanRoleMenuMappingsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("GetUserMenuPermissionsResponse(%+v)", *p)
+	return fmt.Sprintf("GetOrphanRoleMenuMappingsResponse(%+v)", *p)
 }
 
-var fieldIDToName_GetUserMenuPermissionsResponse = map[int16]string{
-	1: "permissions",
-	2: "userID",
-	3: "roleIDs",
+var fieldIDToName_GetOrphanRoleMenuMappingsResponse = map[int16]string{
+	1: "version",
+	2: "mappings",
 }
 
-type IdentityService interface {
-	Login(ctx context.Context, req *LoginRequest) (r *LoginResponse, err error)
+type MenuConfig struct {
+	MenuID     *string `thrift:"menuID,1,optional" frugal:"1,optional,string" json:"menuID,omitempty"`
+	Permission *string `thrift:"permission,2,optional" frugal:"2,optional,string" json:"permission,omitempty"`
+}
 
-	ChangePassword(ctx context.Context, req *ChangePasswordRequest) (err error)
+func NewMenuConfig() *MenuConfig {
+	return &MenuConfig{}
+}
 
-	ResetPassword(ctx context.Context, req *ResetPasswordRequest) (err error)
+func (p *MenuConfig) InitDefault() {
+}
 
-	ForcePasswordChange(ctx context.Context, req *ForcePasswordChangeRequest) (err error)
+var MenuConfig_MenuID_DEFAULT string
+
+func (p *MenuConfig) GetMenuID() (v string) {
+	if !p.IsSetMenuID() {
+		return MenuConfig_MenuID_DEFAULT
+	}
+	return *p.MenuID
+}
+
+var MenuConfig_Permission_DEFAULT string
+
+func (p *MenuConfig) GetPermission() (v string) {
+	if !p.IsSetPermission() {
+		return MenuConfig_Permission_DEFAULT
+	}
+	return *p.Permission
+}
+func (p *MenuConfig) SetMenuID(val *string) {
+	p.MenuID = val
+}
+func (p *MenuConfig) SetPermission(val *string) {
+	p.Permission = val
+}
+
+func (p *MenuConfig) IsSetMenuID() bool {
+	return p.MenuID != nil
+}
+
+func (p *MenuConfig) IsSetPermission() bool {
+	return p.Permission != nil
+}
+
+func (p *MenuConfig) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MenuConfig(%+v)", *p)
+}
+
+var fieldIDToName_MenuConfig = map[int16]string{
+	1: "menuID",
+	2: "permission",
+}
+
+type MenuPermission struct {
+	MenuID     *string `thrift:"menuID,1,optional" frugal:"1,optional,string" json:"menuID,omitempty"`
+	Permission *string `thrift:"permission,2,optional" frugal:"2,optional,string" json:"permission,omitempty"`
+}
+
+func NewMenuPermission() *MenuPermission {
+	return &MenuPermission{}
+}
+
+func (p *MenuPermission) InitDefault() {
+}
+
+var MenuPermission_MenuID_DEFAULT string
+
+func (p *MenuPermission) GetMenuID() (v string) {
+	if !p.IsSetMenuID() {
+		return MenuPermission_MenuID_DEFAULT
+	}
+	return *p.MenuID
+}
+
+var MenuPermission_Permission_DEFAULT string
+
+func (p *MenuPermission) GetPermission() (v string) {
+	if !p.IsSetPermission() {
+		return MenuPermission_Permission_DEFAULT
+	}
+	return *p.Permission
+}
+func (p *MenuPermission) SetMenuID(val *string) {
+	p.MenuID = val
+}
+func (p *MenuPermission) SetPermission(val *string) {
+	p.Permission = val
+}
+
+func (p *MenuPermission) IsSetMenuID() bool {
+	return p.MenuID != nil
+}
+
+func (p *MenuPermission) IsSetPermission() bool {
+	return p.Permission != nil
+}
+
+func (p *MenuPermission) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MenuPermission(%+v)", *p)
+}
+
+var fieldIDToName_MenuPermission = map[int16]string{
+	1: "menuID",
+	2: "permission",
+}
+
+type ConfigureRoleMenusRequest struct {
+	RoleID      *core.UUID    `thrift:"roleID,1,optional" frugal:"1,optional,string" json:"roleID,omitempty"`
+	MenuConfigs []*MenuConfig `thrift:"menuConfigs,2,optional" frugal:"2,optional,list<MenuConfig>" json:"menuConfigs,omitempty"`
+	OperatorID  *core.UUID    `thrift:"operatorID,3,optional" frugal:"3,optional,string" json:"operatorID,omitempty"`
+}
+
+func NewConfigureRoleMenusRequest() *ConfigureRoleMenusRequest {
+	return &ConfigureRoleMenusRequest{}
+}
+
+func (p *ConfigureRoleMenusRequest) InitDefault() {
+}
+
+var ConfigureRoleMenusRequest_RoleID_DEFAULT core.UUID
+
+func (p *ConfigureRoleMenusRequest) GetRoleID() (v core.UUID) {
+	if !p.IsSetRoleID() {
+		return ConfigureRoleMenusRequest_RoleID_DEFAULT
+	}
+	return *p.RoleID
+}
+
+var ConfigureRoleMenusRequest_MenuConfigs_DEFAULT []*MenuConfig
+
+func (p *ConfigureRoleMenusRequest) GetMenuConfigs() (v []*MenuConfig) {
+	if !p.IsSetMenuConfigs() {
+		return ConfigureRoleMenusRequest_MenuConfigs_DEFAULT
+	}
+	return p.MenuConfigs
+}
+
+var ConfigureRoleMenusRequest_OperatorID_DEFAULT core.UUID
+
+func (p *ConfigureRoleMenusRequest) GetOperatorID() (v core.UUID) {
+	if !p.IsSetOperatorID() {
+		return ConfigureRoleMenusRequest_OperatorID_DEFAULT
+	}
+	return *p.OperatorID
+}
+func (p *ConfigureRoleMenusRequest) SetRoleID(val *core.UUID) {
+	p.RoleID = val
+}
+func (p *ConfigureRoleMenusRequest) SetMenuConfigs(val []*MenuConfig) {
+	p.MenuConfigs = val
+}
+func (p *ConfigureRoleMenusRequest) SetOperatorID(val *core.UUID) {
+	p.OperatorID = val
+}
+
+func (p *ConfigureRoleMenusRequest) IsSetRoleID() bool {
+	return p.RoleID != nil
+}
+
+func (p *ConfigureRoleMenusRequest) IsSetMenuConfigs() bool {
+	return p.MenuConfigs != nil
+}
+
+func (p *ConfigureRoleMenusRequest) IsSetOperatorID() bool {
+	return p.OperatorID != nil
+}
+
+func (p *ConfigureRoleMenusRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConfigureRoleMenusRequest(%+v)", *p)
+}
+
+var fieldIDToName_ConfigureRoleMenusRequest = map[int16]string{
+	1: "roleID",
+	2: "menuConfigs",
+	3: "operatorID",
+}
+
+type ConfigureRoleMenusResponse struct {
+	Success *bool   `thrift:"success,1,optional" frugal:"1,optional,bool" json:"success,omitempty"`
+	Message *string `thrift:"message,2,optional" frugal:"2,optional,string" json:"message,omitempty"`
+}
+
+func NewConfigureRoleMenusResponse() *ConfigureRoleMenusResponse {
+	return &ConfigureRoleMenusResponse{}
+}
+
+func (p *ConfigureRoleMenusResponse) InitDefault() {
+}
+
+var ConfigureRoleMenusResponse_Success_DEFAULT bool
+
+func (p *ConfigureRoleMenusResponse) GetSuccess() (v bool) {
+	if !p.IsSetSuccess() {
+		return ConfigureRoleMenusResponse_Success_DEFAULT
+	}
+	return *p.Success
+}
+
+var ConfigureRoleMenusResponse_Message_DEFAULT string
+
+func (p *ConfigureRoleMenusResponse) GetMessage() (v string) {
+	if !p.IsSetMessage() {
+		return ConfigureRoleMenusResponse_Message_DEFAULT
+	}
+	return *p.Message
+}
+func (p *ConfigureRoleMenusResponse) SetSuccess(val *bool) {
+	p.Success = val
+}
+func (p *ConfigureRoleMenusResponse) SetMessage(val *string) {
+	p.Message = val
+}
+
+func (p *ConfigureRoleMenusResponse) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ConfigureRoleMenusResponse) IsSetMessage() bool {
+	return p.Message != nil
+}
+
+func (p *ConfigureRoleMenusResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ConfigureRoleMenusResponse(%+v)", *p)
+}
+
+var fieldIDToName_ConfigureRoleMenusResponse = map[int16]string{
+	1: "success",
+	2: "message",
+}
+
+type GetRoleMenuTreeRequest struct {
+	RoleID *core.UUID `thrift:"roleID,1,optional" frugal:"1,optional,string" json:"roleID,omitempty"`
+}
+
+func NewGetRoleMenuTreeRequest() *GetRoleMenuTreeRequest {
+	return &GetRoleMenuTreeRequest{}
+}
+
+func (p *GetRoleMenuTreeRequest) InitDefault() {
+}
+
+var GetRoleMenuTreeRequest_RoleID_DEFAULT core.UUID
+
+func (p *GetRoleMenuTreeRequest) GetRoleID() (v core.UUID) {
+	if !p.IsSetRoleID() {
+		return GetRoleMenuTreeRequest_RoleID_DEFAULT
+	}
+	return *p.RoleID
+}
+func (p *GetRoleMenuTreeRequest) SetRoleID(val *core.UUID) {
+	p.RoleID = val
+}
+
+func (p *GetRoleMenuTreeRequest) IsSetRoleID() bool {
+	return p.RoleID != nil
+}
+
+func (p *GetRoleMenuTreeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRoleMenuTreeRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetRoleMenuTreeRequest = map[int16]string{
+	1: "roleID",
+}
+
+type GetRoleMenuTreeResponse struct {
+	MenuTree []*MenuNode `thrift:"menuTree,1,optional" frugal:"1,optional,list<MenuNode>" json:"menuTree,omitempty"`
+	RoleID   *core.UUID  `thrift:"roleID,2,optional" frugal:"2,optional,string" json:"roleID,omitempty"`
+}
+
+func NewGetRoleMenuTreeResponse() *GetRoleMenuTreeResponse {
+	return &GetRoleMenuTreeResponse{}
+}
+
+func (p *GetRoleMenuTreeResponse) InitDefault() {
+}
+
+var GetRoleMenuTreeResponse_MenuTree_DEFAULT []*MenuNode
+
+func (p *GetRoleMenuTreeResponse) GetMenuTree() (v []*MenuNode) {
+	if !p.IsSetMenuTree() {
+		return GetRoleMenuTreeResponse_MenuTree_DEFAULT
+	}
+	return p.MenuTree
+}
+
+var GetRoleMenuTreeResponse_RoleID_DEFAULT core.UUID
+
+func (p *GetRoleMenuTreeResponse) GetRoleID() (v core.UUID) {
+	if !p.IsSetRoleID() {
+		return GetRoleMenuTreeResponse_RoleID_DEFAULT
+	}
+	return *p.RoleID
+}
+func (p *GetRoleMenuTreeResponse) SetMenuTree(val []*MenuNode) {
+	p.MenuTree = val
+}
+func (p *GetRoleMenuTreeResponse) SetRoleID(val *core.UUID) {
+	p.RoleID = val
+}
+
+func (p *GetRoleMenuTreeResponse) IsSetMenuTree() bool {
+	return p.MenuTree != nil
+}
+
+func (p *GetRoleMenuTreeResponse) IsSetRoleID() bool {
+	return p.RoleID != nil
+}
+
+func (p *GetRoleMenuTreeResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRoleMenuTreeResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetRoleMenuTreeResponse = map[int16]string{
+	1: "menuTree",
+	2: "roleID",
+}
+
+type GetUserMenuTreeRequest struct {
+	UserID         *core.UUID `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+	OrganizationID *core.UUID `thrift:"organizationID,2,optional" frugal:"2,optional,string" json:"organizationID,omitempty"`
+}
+
+func NewGetUserMenuTreeRequest() *GetUserMenuTreeRequest {
+	return &GetUserMenuTreeRequest{}
+}
+
+func (p *GetUserMenuTreeRequest) InitDefault() {
+}
+
+var GetUserMenuTreeRequest_UserID_DEFAULT core.UUID
+
+func (p *GetUserMenuTreeRequest) GetUserID() (v core.UUID) {
+	if !p.IsSetUserID() {
+		return GetUserMenuTreeRequest_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var GetUserMenuTreeRequest_OrganizationID_DEFAULT core.UUID
+
+func (p *GetUserMenuTreeRequest) GetOrganizationID() (v core.UUID) {
+	if !p.IsSetOrganizationID() {
+		return GetUserMenuTreeRequest_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
+func (p *GetUserMenuTreeRequest) SetUserID(val *core.UUID) {
+	p.UserID = val
+}
+func (p *GetUserMenuTreeRequest) SetOrganizationID(val *core.UUID) {
+	p.OrganizationID = val
+}
+
+func (p *GetUserMenuTreeRequest) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *GetUserMenuTreeRequest) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
+func (p *GetUserMenuTreeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserMenuTreeRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetUserMenuTreeRequest = map[int16]string{
+	1: "userID",
+	2: "organizationID",
+}
+
+type GetUserMenuTreeResponse struct {
+	MenuTree []*MenuNode `thrift:"menuTree,1,optional" frugal:"1,optional,list<MenuNode>" json:"menuTree,omitempty"`
+	UserID   *core.UUID  `thrift:"userID,2,optional" frugal:"2,optional,string" json:"userID,omitempty"`
+	RoleIDs  []core.UUID `thrift:"roleIDs,3,optional" frugal:"3,optional,list<string>" json:"roleIDs,omitempty"`
+}
+
+func NewGetUserMenuTreeResponse() *GetUserMenuTreeResponse {
+	return &GetUserMenuTreeResponse{}
+}
+
+func (p *GetUserMenuTreeResponse) InitDefault() {
+}
+
+var GetUserMenuTreeResponse_MenuTree_DEFAULT []*MenuNode
+
+func (p *GetUserMenuTreeResponse) GetMenuTree() (v []*MenuNode) {
+	if !p.IsSetMenuTree() {
+		return GetUserMenuTreeResponse_MenuTree_DEFAULT
+	}
+	return p.MenuTree
+}
+
+var GetUserMenuTreeResponse_UserID_DEFAULT core.UUID
+
+func (p *GetUserMenuTreeResponse) GetUserID() (v core.UUID) {
+	if !p.IsSetUserID() {
+		return GetUserMenuTreeResponse_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var GetUserMenuTreeResponse_RoleIDs_DEFAULT []core.UUID
+
+func (p *GetUserMenuTreeResponse) GetRoleIDs() (v []core.UUID) {
+	if !p.IsSetRoleIDs() {
+		return GetUserMenuTreeResponse_RoleIDs_DEFAULT
+	}
+	return p.RoleIDs
+}
+func (p *GetUserMenuTreeResponse) SetMenuTree(val []*MenuNode) {
+	p.MenuTree = val
+}
+func (p *GetUserMenuTreeResponse) SetUserID(val *core.UUID) {
+	p.UserID = val
+}
+func (p *GetUserMenuTreeResponse) SetRoleIDs(val []core.UUID) {
+	p.RoleIDs = val
+}
+
+func (p *GetUserMenuTreeResponse) IsSetMenuTree() bool {
+	return p.MenuTree != nil
+}
+
+func (p *GetUserMenuTreeResponse) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *GetUserMenuTreeResponse) IsSetRoleIDs() bool {
+	return p.RoleIDs != nil
+}
+
+func (p *GetUserMenuTreeResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserMenuTreeResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetUserMenuTreeResponse = map[int16]string{
+	1: "menuTree",
+	2: "userID",
+	3: "roleIDs",
+}
+
+type GetRoleMenuPermissionsRequest struct {
+	RoleID *core.UUID `thrift:"roleID,1,optional" frugal:"1,optional,string" json:"roleID,omitempty"`
+}
+
+func NewGetRoleMenuPermissionsRequest() *GetRoleMenuPermissionsRequest {
+	return &GetRoleMenuPermissionsRequest{}
+}
+
+func (p *GetRoleMenuPermissionsRequest) InitDefault() {
+}
+
+var GetRoleMenuPermissionsRequest_RoleID_DEFAULT core.UUID
+
+func (p *GetRoleMenuPermissionsRequest) GetRoleID() (v core.UUID) {
+	if !p.IsSetRoleID() {
+		return GetRoleMenuPermissionsRequest_RoleID_DEFAULT
+	}
+	return *p.RoleID
+}
+func (p *GetRoleMenuPermissionsRequest) SetRoleID(val *core.UUID) {
+	p.RoleID = val
+}
+
+func (p *GetRoleMenuPermissionsRequest) IsSetRoleID() bool {
+	return p.RoleID != nil
+}
+
+func (p *GetRoleMenuPermissionsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRoleMenuPermissionsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetRoleMenuPermissionsRequest = map[int16]string{
+	1: "roleID",
+}
+
+type GetRoleMenuPermissionsResponse struct {
+	Permissions []*MenuPermission `thrift:"permissions,1,optional" frugal:"1,optional,list<MenuPermission>" json:"permissions,omitempty"`
+	RoleID      *core.UUID        `thrift:"roleID,2,optional" frugal:"2,optional,string" json:"roleID,omitempty"`
+}
+
+func NewGetRoleMenuPermissionsResponse() *GetRoleMenuPermissionsResponse {
+	return &GetRoleMenuPermissionsResponse{}
+}
+
+func (p *GetRoleMenuPermissionsResponse) InitDefault() {
+}
+
+var GetRoleMenuPermissionsResponse_Permissions_DEFAULT []*MenuPermission
+
+func (p *GetRoleMenuPermissionsResponse) GetPermissions() (v []*MenuPermission) {
+	if !p.IsSetPermissions() {
+		return GetRoleMenuPermissionsResponse_Permissions_DEFAULT
+	}
+	return p.Permissions
+}
+
+var GetRoleMenuPermissionsResponse_RoleID_DEFAULT core.UUID
+
+func (p *GetRoleMenuPermissionsResponse) GetRoleID() (v core.UUID) {
+	if !p.IsSetRoleID() {
+		return GetRoleMenuPermissionsResponse_RoleID_DEFAULT
+	}
+	return *p.RoleID
+}
+func (p *GetRoleMenuPermissionsResponse) SetPermissions(val []*MenuPermission) {
+	p.Permissions = val
+}
+func (p *GetRoleMenuPermissionsResponse) SetRoleID(val *core.UUID) {
+	p.RoleID = val
+}
+
+func (p *GetRoleMenuPermissionsResponse) IsSetPermissions() bool {
+	return p.Permissions != nil
+}
+
+func (p *GetRoleMenuPermissionsResponse) IsSetRoleID() bool {
+	return p.RoleID != nil
+}
+
+func (p *GetRoleMenuPermissionsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetRoleMenuPermissionsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetRoleMenuPermissionsResponse = map[int16]string{
+	1: "permissions",
+	2: "roleID",
+}
+
+type HasMenuPermissionRequest struct {
+	RoleID     *core.UUID `thrift:"roleID,1,optional" frugal:"1,optional,string" json:"roleID,omitempty"`
+	MenuID     *string    `thrift:"menuID,2,optional" frugal:"2,optional,string" json:"menuID,omitempty"`
+	Permission *string    `thrift:"permission,3,optional" frugal:"3,optional,string" json:"permission,omitempty"`
+}
+
+func NewHasMenuPermissionRequest() *HasMenuPermissionRequest {
+	return &HasMenuPermissionRequest{}
+}
+
+func (p *HasMenuPermissionRequest) InitDefault() {
+}
+
+var HasMenuPermissionRequest_RoleID_DEFAULT core.UUID
+
+func (p *HasMenuPermissionRequest) GetRoleID() (v core.UUID) {
+	if !p.IsSetRoleID() {
+		return HasMenuPermissionRequest_RoleID_DEFAULT
+	}
+	return *p.RoleID
+}
+
+var HasMenuPermissionRequest_MenuID_DEFAULT string
+
+func (p *HasMenuPermissionRequest) GetMenuID() (v string) {
+	if !p.IsSetMenuID() {
+		return HasMenuPermissionRequest_MenuID_DEFAULT
+	}
+	return *p.MenuID
+}
+
+var HasMenuPermissionRequest_Permission_DEFAULT string
+
+func (p *HasMenuPermissionRequest) GetPermission() (v string) {
+	if !p.IsSetPermission() {
+		return HasMenuPermissionRequest_Permission_DEFAULT
+	}
+	return *p.Permission
+}
+func (p *HasMenuPermissionRequest) SetRoleID(val *core.UUID) {
+	p.RoleID = val
+}
+func (p *HasMenuPermissionRequest) SetMenuID(val *string) {
+	p.MenuID = val
+}
+func (p *HasMenuPermissionRequest) SetPermission(val *string) {
+	p.Permission = val
+}
+
+func (p *HasMenuPermissionRequest) IsSetRoleID() bool {
+	return p.RoleID != nil
+}
+
+func (p *HasMenuPermissionRequest) IsSetMenuID() bool {
+	return p.MenuID != nil
+}
+
+func (p *HasMenuPermissionRequest) IsSetPermission() bool {
+	return p.Permission != nil
+}
+
+func (p *HasMenuPermissionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HasMenuPermissionRequest(%+v)", *p)
+}
+
+var fieldIDToName_HasMenuPermissionRequest = map[int16]string{
+	1: "roleID",
+	2: "menuID",
+	3: "permission",
+}
+
+type HasMenuPermissionResponse struct {
+	HasPermission *bool      `thrift:"hasPermission,1,optional" frugal:"1,optional,bool" json:"hasPermission,omitempty"`
+	RoleID        *core.UUID `thrift:"roleID,2,optional" frugal:"2,optional,string" json:"roleID,omitempty"`
+	MenuID        *string    `thrift:"menuID,3,optional" frugal:"3,optional,string" json:"menuID,omitempty"`
+	Permission    *string    `thrift:"permission,4,optional" frugal:"4,optional,string" json:"permission,omitempty"`
+}
+
+func NewHasMenuPermissionResponse() *HasMenuPermissionResponse {
+	return &HasMenuPermissionResponse{}
+}
+
+func (p *HasMenuPermissionResponse) InitDefault() {
+}
+
+var HasMenuPermissionResponse_HasPermission_DEFAULT bool
+
+func (p *HasMenuPermissionResponse) GetHasPermission() (v bool) {
+	if !p.IsSetHasPermission() {
+		return HasMenuPermissionResponse_HasPermission_DEFAULT
+	}
+	return *p.HasPermission
+}
+
+var HasMenuPermissionResponse_RoleID_DEFAULT core.UUID
+
+func (p *HasMenuPermissionResponse) GetRoleID() (v core.UUID) {
+	if !p.IsSetRoleID() {
+		return HasMenuPermissionResponse_RoleID_DEFAULT
+	}
+	return *p.RoleID
+}
+
+var HasMenuPermissionResponse_MenuID_DEFAULT string
+
+func (p *HasMenuPermissionResponse) GetMenuID() (v string) {
+	if !p.IsSetMenuID() {
+		return HasMenuPermissionResponse_MenuID_DEFAULT
+	}
+	return *p.MenuID
+}
+
+var HasMenuPermissionResponse_Permission_DEFAULT string
+
+func (p *HasMenuPermissionResponse) GetPermission() (v string) {
+	if !p.IsSetPermission() {
+		return HasMenuPermissionResponse_Permission_DEFAULT
+	}
+	return *p.Permission
+}
+func (p *HasMenuPermissionResponse) SetHasPermission(val *bool) {
+	p.HasPermission = val
+}
+func (p *HasMenuPermissionResponse) SetRoleID(val *core.UUID) {
+	p.RoleID = val
+}
+func (p *HasMenuPermissionResponse) SetMenuID(val *string) {
+	p.MenuID = val
+}
+func (p *HasMenuPermissionResponse) SetPermission(val *string) {
+	p.Permission = val
+}
+
+func (p *HasMenuPermissionResponse) IsSetHasPermission() bool {
+	return p.HasPermission != nil
+}
+
+func (p *HasMenuPermissionResponse) IsSetRoleID() bool {
+	return p.RoleID != nil
+}
+
+func (p *HasMenuPermissionResponse) IsSetMenuID() bool {
+	return p.MenuID != nil
+}
+
+func (p *HasMenuPermissionResponse) IsSetPermission() bool {
+	return p.Permission != nil
+}
+
+func (p *HasMenuPermissionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("HasMenuPermissionResponse(%+v)", *p)
+}
+
+var fieldIDToName_HasMenuPermissionResponse = map[int16]string{
+	1: "hasPermission",
+	2: "roleID",
+	3: "menuID",
+	4: "permission",
+}
+
+type GetUserMenuPermissionsRequest struct {
+	UserID         *core.UUID `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+	OrganizationID *core.UUID `thrift:"organizationID,2,optional" frugal:"2,optional,string" json:"organizationID,omitempty"`
+}
+
+func NewGetUserMenuPermissionsRequest() *GetUserMenuPermissionsRequest {
+	return &GetUserMenuPermissionsRequest{}
+}
+
+func (p *GetUserMenuPermissionsRequest) InitDefault() {
+}
+
+var GetUserMenuPermissionsRequest_UserID_DEFAULT core.UUID
+
+func (p *GetUserMenuPermissionsRequest) GetUserID() (v core.UUID) {
+	if !p.IsSetUserID() {
+		return GetUserMenuPermissionsRequest_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var GetUserMenuPermissionsRequest_OrganizationID_DEFAULT core.UUID
+
+func (p *GetUserMenuPermissionsRequest) GetOrganizationID() (v core.UUID) {
+	if !p.IsSetOrganizationID() {
+		return GetUserMenuPermissionsRequest_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
+func (p *GetUserMenuPermissionsRequest) SetUserID(val *core.UUID) {
+	p.UserID = val
+}
+func (p *GetUserMenuPermissionsRequest) SetOrganizationID(val *core.UUID) {
+	p.OrganizationID = val
+}
+
+func (p *GetUserMenuPermissionsRequest) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *GetUserMenuPermissionsRequest) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
+func (p *GetUserMenuPermissionsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserMenuPermissionsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetUserMenuPermissionsRequest = map[int16]string{
+	1: "userID",
+	2: "organizationID",
+}
+
+type GetUserMenuPermissionsResponse struct {
+	Permissions []*MenuPermission `thrift:"permissions,1,optional" frugal:"1,optional,list<MenuPermission>" json:"permissions,omitempty"`
+	UserID      *core.UUID        `thrift:"userID,2,optional" frugal:"2,optional,string" json:"userID,omitempty"`
+	RoleIDs     []core.UUID       `thrift:"roleIDs,3,optional" frugal:"3,optional,list<string>" json:"roleIDs,omitempty"`
+}
+
+func NewGetUserMenuPermissionsResponse() *GetUserMenuPermissionsResponse {
+	return &GetUserMenuPermissionsResponse{}
+}
+
+func (p *GetUserMenuPermissionsResponse) InitDefault() {
+}
+
+var GetUserMenuPermissionsResponse_Permissions_DEFAULT []*MenuPermission
+
+func (p *GetUserMenuPermissionsResponse) GetPermissions() (v []*MenuPermission) {
+	if !p.IsSetPermissions() {
+		return GetUserMenuPermissionsResponse_Permissions_DEFAULT
+	}
+	return p.Permissions
+}
+
+var GetUserMenuPermissionsResponse_UserID_DEFAULT core.UUID
+
+func (p *GetUserMenuPermissionsResponse) GetUserID() (v core.UUID) {
+	if !p.IsSetUserID() {
+		return GetUserMenuPermissionsResponse_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var GetUserMenuPermissionsResponse_RoleIDs_DEFAULT []core.UUID
+
+func (p *GetUserMenuPermissionsResponse) GetRoleIDs() (v []core.UUID) {
+	if !p.IsSetRoleIDs() {
+		return GetUserMenuPermissionsResponse_RoleIDs_DEFAULT
+	}
+	return p.RoleIDs
+}
+func (p *GetUserMenuPermissionsResponse) SetPermissions(val []*MenuPermission) {
+	p.Permissions = val
+}
+func (p *GetUserMenuPermissionsResponse) SetUserID(val *core.UUID) {
+	p.UserID = val
+}
+func (p *GetUserMenuPermissionsResponse) SetRoleIDs(val []core.UUID) {
+	p.RoleIDs = val
+}
+
+func (p *GetUserMenuPermissionsResponse) IsSetPermissions() bool {
+	return p.Permissions != nil
+}
+
+func (p *GetUserMenuPermissionsResponse) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *GetUserMenuPermissionsResponse) IsSetRoleIDs() bool {
+	return p.RoleIDs != nil
+}
+
+func (p *GetUserMenuPermissionsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserMenuPermissionsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetUserMenuPermissionsResponse = map[int16]string{
+	1: "permissions",
+	2: "userID",
+	3: "roleIDs",
+}
+
+type CheckPermissionRequest struct {
+	UserID         *core.UUID `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+	Resource       *string    `thrift:"resource,2,optional" frugal:"2,optional,string" json:"resource,omitempty"`
+	Action         *string    `thrift:"action,3,optional" frugal:"3,optional,string" json:"action,omitempty"`
+	OrganizationID *core.UUID `thrift:"organizationID,4,optional" frugal:"4,optional,string" json:"organizationID,omitempty"`
+}
+
+func NewCheckPermissionRequest() *CheckPermissionRequest {
+	return &CheckPermissionRequest{}
+}
+
+func (p *CheckPermissionRequest) InitDefault() {
+}
+
+var CheckPermissionRequest_UserID_DEFAULT core.UUID
+
+func (p *CheckPermissionRequest) GetUserID() (v core.UUID) {
+	if !p.IsSetUserID() {
+		return CheckPermissionRequest_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var CheckPermissionRequest_Resource_DEFAULT string
+
+func (p *CheckPermissionRequest) GetResource() (v string) {
+	if !p.IsSetResource() {
+		return CheckPermissionRequest_Resource_DEFAULT
+	}
+	return *p.Resource
+}
+
+var CheckPermissionRequest_Action_DEFAULT string
+
+func (p *CheckPermissionRequest) GetAction() (v string) {
+	if !p.IsSetAction() {
+		return CheckPermissionRequest_Action_DEFAULT
+	}
+	return *p.Action
+}
+
+var CheckPermissionRequest_OrganizationID_DEFAULT core.UUID
+
+func (p *CheckPermissionRequest) GetOrganizationID() (v core.UUID) {
+	if !p.IsSetOrganizationID() {
+		return CheckPermissionRequest_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
+func (p *CheckPermissionRequest) SetUserID(val *core.UUID) {
+	p.UserID = val
+}
+func (p *CheckPermissionRequest) SetResource(val *string) {
+	p.Resource = val
+}
+func (p *CheckPermissionRequest) SetAction(val *string) {
+	p.Action = val
+}
+func (p *CheckPermissionRequest) SetOrganizationID(val *core.UUID) {
+	p.OrganizationID = val
+}
+
+func (p *CheckPermissionRequest) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *CheckPermissionRequest) IsSetResource() bool {
+	return p.Resource != nil
+}
+
+func (p *CheckPermissionRequest) IsSetAction() bool {
+	return p.Action != nil
+}
+
+func (p *CheckPermissionRequest) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
+func (p *CheckPermissionRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckPermissionRequest(%+v)", *p)
+}
+
+var fieldIDToName_CheckPermissionRequest = map[int16]string{
+	1: "userID",
+	2: "resource",
+	3: "action",
+	4: "organizationID",
+}
+
+type CheckPermissionResponse struct {
+	Allowed       *bool      `thrift:"allowed,1,optional" frugal:"1,optional,bool" json:"allowed,omitempty"`
+	MatchedRoleID *core.UUID `thrift:"matchedRoleID,2,optional" frugal:"2,optional,string" json:"matchedRoleID,omitempty"`
+}
+
+func NewCheckPermissionResponse() *CheckPermissionResponse {
+	return &CheckPermissionResponse{}
+}
+
+func (p *CheckPermissionResponse) InitDefault() {
+}
+
+var CheckPermissionResponse_Allowed_DEFAULT bool
+
+func (p *CheckPermissionResponse) GetAllowed() (v bool) {
+	if !p.IsSetAllowed() {
+		return CheckPermissionResponse_Allowed_DEFAULT
+	}
+	return *p.Allowed
+}
+
+var CheckPermissionResponse_MatchedRoleID_DEFAULT core.UUID
+
+func (p *CheckPermissionResponse) GetMatchedRoleID() (v core.UUID) {
+	if !p.IsSetMatchedRoleID() {
+		return CheckPermissionResponse_MatchedRoleID_DEFAULT
+	}
+	return *p.MatchedRoleID
+}
+func (p *CheckPermissionResponse) SetAllowed(val *bool) {
+	p.Allowed = val
+}
+func (p *CheckPermissionResponse) SetMatchedRoleID(val *core.UUID) {
+	p.MatchedRoleID = val
+}
+
+func (p *CheckPermissionResponse) IsSetAllowed() bool {
+	return p.Allowed != nil
+}
+
+func (p *CheckPermissionResponse) IsSetMatchedRoleID() bool {
+	return p.MatchedRoleID != nil
+}
+
+func (p *CheckPermissionResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckPermissionResponse(%+v)", *p)
+}
+
+var fieldIDToName_CheckPermissionResponse = map[int16]string{
+	1: "allowed",
+	2: "matchedRoleID",
+}
+
+type CheckRoleRequest struct {
+	UserID         *core.UUID `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+	Roles          []string   `thrift:"roles,2,optional" frugal:"2,optional,list<string>" json:"roles,omitempty"`
+	OrganizationID *core.UUID `thrift:"organizationID,3,optional" frugal:"3,optional,string" json:"organizationID,omitempty"`
+}
+
+func NewCheckRoleRequest() *CheckRoleRequest {
+	return &CheckRoleRequest{}
+}
+
+func (p *CheckRoleRequest) InitDefault() {
+}
+
+var CheckRoleRequest_UserID_DEFAULT core.UUID
+
+func (p *CheckRoleRequest) GetUserID() (v core.UUID) {
+	if !p.IsSetUserID() {
+		return CheckRoleRequest_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var CheckRoleRequest_Roles_DEFAULT []string
+
+func (p *CheckRoleRequest) GetRoles() (v []string) {
+	if !p.IsSetRoles() {
+		return CheckRoleRequest_Roles_DEFAULT
+	}
+	return p.Roles
+}
+
+var CheckRoleRequest_OrganizationID_DEFAULT core.UUID
+
+func (p *CheckRoleRequest) GetOrganizationID() (v core.UUID) {
+	if !p.IsSetOrganizationID() {
+		return CheckRoleRequest_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
+func (p *CheckRoleRequest) SetUserID(val *core.UUID) {
+	p.UserID = val
+}
+func (p *CheckRoleRequest) SetRoles(val []string) {
+	p.Roles = val
+}
+func (p *CheckRoleRequest) SetOrganizationID(val *core.UUID) {
+	p.OrganizationID = val
+}
+
+func (p *CheckRoleRequest) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *CheckRoleRequest) IsSetRoles() bool {
+	return p.Roles != nil
+}
+
+func (p *CheckRoleRequest) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
+func (p *CheckRoleRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckRoleRequest(%+v)", *p)
+}
+
+var fieldIDToName_CheckRoleRequest = map[int16]string{
+	1: "userID",
+	2: "roles",
+	3: "organizationID",
+}
+
+type CheckRoleResponse struct {
+	HasRole     *bool   `thrift:"hasRole,1,optional" frugal:"1,optional,bool" json:"hasRole,omitempty"`
+	MatchedRole *string `thrift:"matchedRole,2,optional" frugal:"2,optional,string" json:"matchedRole,omitempty"`
+}
+
+func NewCheckRoleResponse() *CheckRoleResponse {
+	return &CheckRoleResponse{}
+}
+
+func (p *CheckRoleResponse) InitDefault() {
+}
+
+var CheckRoleResponse_HasRole_DEFAULT bool
+
+func (p *CheckRoleResponse) GetHasRole() (v bool) {
+	if !p.IsSetHasRole() {
+		return CheckRoleResponse_HasRole_DEFAULT
+	}
+	return *p.HasRole
+}
+
+var CheckRoleResponse_MatchedRole_DEFAULT string
+
+func (p *CheckRoleResponse) GetMatchedRole() (v string) {
+	if !p.IsSetMatchedRole() {
+		return CheckRoleResponse_MatchedRole_DEFAULT
+	}
+	return *p.MatchedRole
+}
+func (p *CheckRoleResponse) SetHasRole(val *bool) {
+	p.HasRole = val
+}
+func (p *CheckRoleResponse) SetMatchedRole(val *string) {
+	p.MatchedRole = val
+}
+
+func (p *CheckRoleResponse) IsSetHasRole() bool {
+	return p.HasRole != nil
+}
+
+func (p *CheckRoleResponse) IsSetMatchedRole() bool {
+	return p.MatchedRole != nil
+}
+
+func (p *CheckRoleResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckRoleResponse(%+v)", *p)
+}
+
+var fieldIDToName_CheckRoleResponse = map[int16]string{
+	1: "hasRole",
+	2: "matchedRole",
+}
+
+type GetUserEffectivePermissionsRequest struct {
+	UserID         *core.UUID `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+	OrganizationID *core.UUID `thrift:"organizationID,2,optional" frugal:"2,optional,string" json:"organizationID,omitempty"`
+}
+
+func NewGetUserEffectivePermissionsRequest() *GetUserEffectivePermissionsRequest {
+	return &GetUserEffectivePermissionsRequest{}
+}
+
+func (p *GetUserEffectivePermissionsRequest) InitDefault() {
+}
+
+var GetUserEffectivePermissionsRequest_UserID_DEFAULT core.UUID
+
+func (p *GetUserEffectivePermissionsRequest) GetUserID() (v core.UUID) {
+	if !p.IsSetUserID() {
+		return GetUserEffectivePermissionsRequest_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var GetUserEffectivePermissionsRequest_OrganizationID_DEFAULT core.UUID
+
+func (p *GetUserEffectivePermissionsRequest) GetOrganizationID() (v core.UUID) {
+	if !p.IsSetOrganizationID() {
+		return GetUserEffectivePermissionsRequest_OrganizationID_DEFAULT
+	}
+	return *p.OrganizationID
+}
+func (p *GetUserEffectivePermissionsRequest) SetUserID(val *core.UUID) {
+	p.UserID = val
+}
+func (p *GetUserEffectivePermissionsRequest) SetOrganizationID(val *core.UUID) {
+	p.OrganizationID = val
+}
+
+func (p *GetUserEffectivePermissionsRequest) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *GetUserEffectivePermissionsRequest) IsSetOrganizationID() bool {
+	return p.OrganizationID != nil
+}
+
+func (p *GetUserEffectivePermissionsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserEffectivePermissionsRequest(%+v)", *p)
+}
+
+var fieldIDToName_GetUserEffectivePermissionsRequest = map[int16]string{
+	1: "userID",
+	2: "organizationID",
+}
+
+type GetUserEffectivePermissionsResponse struct {
+	UserID       *core.UUID    `thrift:"userID,1,optional" frugal:"1,optional,string" json:"userID,omitempty"`
+	RoleIDs      []core.UUID   `thrift:"roleIDs,2,optional" frugal:"2,optional,list<string>" json:"roleIDs,omitempty"`
+	IsSuperAdmin *bool         `thrift:"isSuperAdmin,3,optional" frugal:"3,optional,bool" json:"isSuperAdmin,omitempty"`
+	Permissions  []*Permission `thrift:"permissions,4,optional" frugal:"4,optional,list<Permission>" json:"permissions,omitempty"`
+}
+
+func NewGetUserEffectivePermissionsResponse() *GetUserEffectivePermissionsResponse {
+	return &GetUserEffectivePermissionsResponse{}
+}
+
+func (p *GetUserEffectivePermissionsResponse) InitDefault() {
+}
+
+var GetUserEffectivePermissionsResponse_UserID_DEFAULT core.UUID
+
+func (p *GetUserEffectivePermissionsResponse) GetUserID() (v core.UUID) {
+	if !p.IsSetUserID() {
+		return GetUserEffectivePermissionsResponse_UserID_DEFAULT
+	}
+	return *p.UserID
+}
+
+var GetUserEffectivePermissionsResponse_RoleIDs_DEFAULT []core.UUID
+
+func (p *GetUserEffectivePermissionsResponse) GetRoleIDs() (v []core.UUID) {
+	if !p.IsSetRoleIDs() {
+		return GetUserEffectivePermissionsResponse_RoleIDs_DEFAULT
+	}
+	return p.RoleIDs
+}
+
+var GetUserEffectivePermissionsResponse_IsSuperAdmin_DEFAULT bool
+
+func (p *GetUserEffectivePermissionsResponse) GetIsSuperAdmin() (v bool) {
+	if !p.IsSetIsSuperAdmin() {
+		return GetUserEffectivePermissionsResponse_IsSuperAdmin_DEFAULT
+	}
+	return *p.IsSuperAdmin
+}
+
+var GetUserEffectivePermissionsResponse_Permissions_DEFAULT []*Permission
+
+func (p *GetUserEffectivePermissionsResponse) GetPermissions() (v []*Permission) {
+	if !p.IsSetPermissions() {
+		return GetUserEffectivePermissionsResponse_Permissions_DEFAULT
+	}
+	return p.Permissions
+}
+func (p *GetUserEffectivePermissionsResponse) SetUserID(val *core.UUID) {
+	p.UserID = val
+}
+func (p *GetUserEffectivePermissionsResponse) SetRoleIDs(val []core.UUID) {
+	p.RoleIDs = val
+}
+func (p *GetUserEffectivePermissionsResponse) SetIsSuperAdmin(val *bool) {
+	p.IsSuperAdmin = val
+}
+func (p *GetUserEffectivePermissionsResponse) SetPermissions(val []*Permission) {
+	p.Permissions = val
+}
+
+func (p *GetUserEffectivePermissionsResponse) IsSetUserID() bool {
+	return p.UserID != nil
+}
+
+func (p *GetUserEffectivePermissionsResponse) IsSetRoleIDs() bool {
+	return p.RoleIDs != nil
+}
+
+func (p *GetUserEffectivePermissionsResponse) IsSetIsSuperAdmin() bool {
+	return p.IsSuperAdmin != nil
+}
+
+func (p *GetUserEffectivePermissionsResponse) IsSetPermissions() bool {
+	return p.Permissions != nil
+}
+
+func (p *GetUserEffectivePermissionsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetUserEffectivePermissionsResponse(%+v)", *p)
+}
+
+var fieldIDToName_GetUserEffectivePermissionsResponse = map[int16]string{
+	1: "userID",
+	2: "roleIDs",
+	3: "isSuperAdmin",
+	4: "permissions",
+}
+
+type ListAuditEventsRequest struct {
+	ActorID    *core.UUID            `thrift:"actorID,1,optional" frugal:"1,optional,string" json:"actorID,omitempty"`
+	Action     *string               `thrift:"action,2,optional" frugal:"2,optional,string" json:"action,omitempty"`
+	TargetType *string               `thrift:"targetType,3,optional" frugal:"3,optional,string" json:"targetType,omitempty"`
+	TargetID   *string               `thrift:"targetID,4,optional" frugal:"4,optional,string" json:"targetID,omitempty"`
+	Result_    *string               `thrift:"result,5,optional" frugal:"5,optional,string" json:"result,omitempty"`
+	RequestID  *string               `thrift:"requestID,6,optional" frugal:"6,optional,string" json:"requestID,omitempty"`
+	StartTime  *core.TimestampMS     `thrift:"startTime,7,optional" frugal:"7,optional,i64" json:"startTime,omitempty"`
+	EndTime    *core.TimestampMS     `thrift:"endTime,8,optional" frugal:"8,optional,i64" json:"endTime,omitempty"`
+	Page       *rpc_base.PageRequest `thrift:"page,9,optional" frugal:"9,optional,rpc_base.PageRequest" json:"page,omitempty"`
+}
+
+func NewListAuditEventsRequest() *ListAuditEventsRequest {
+	return &ListAuditEventsRequest{}
+}
+
+func (p *ListAuditEventsRequest) InitDefault() {
+}
+
+var ListAuditEventsRequest_ActorID_DEFAULT core.UUID
+
+func (p *ListAuditEventsRequest) GetActorID() (v core.UUID) {
+	if !p.IsSetActorID() {
+		return ListAuditEventsRequest_ActorID_DEFAULT
+	}
+	return *p.ActorID
+}
+
+var ListAuditEventsRequest_Action_DEFAULT string
+
+func (p *ListAuditEventsRequest) GetAction() (v string) {
+	if !p.IsSetAction() {
+		return ListAuditEventsRequest_Action_DEFAULT
+	}
+	return *p.Action
+}
+
+var ListAuditEventsRequest_TargetType_DEFAULT string
+
+func (p *ListAuditEventsRequest) GetTargetType() (v string) {
+	if !p.IsSetTargetType() {
+		return ListAuditEventsRequest_TargetType_DEFAULT
+	}
+	return *p.TargetType
+}
+
+var ListAuditEventsRequest_TargetID_DEFAULT string
+
+func (p *ListAuditEventsRequest) GetTargetID() (v string) {
+	if !p.IsSetTargetID() {
+		return ListAuditEventsRequest_TargetID_DEFAULT
+	}
+	return *p.TargetID
+}
+
+var ListAuditEventsRequest_Result__DEFAULT string
+
+func (p *ListAuditEventsRequest) GetResult_() (v string) {
+	if !p.IsSetResult_() {
+		return ListAuditEventsRequest_Result__DEFAULT
+	}
+	return *p.Result_
+}
+
+var ListAuditEventsRequest_RequestID_DEFAULT string
+
+func (p *ListAuditEventsRequest) GetRequestID() (v string) {
+	if !p.IsSetRequestID() {
+		return ListAuditEventsRequest_RequestID_DEFAULT
+	}
+	return *p.RequestID
+}
+
+var ListAuditEventsRequest_StartTime_DEFAULT core.TimestampMS
+
+func (p *ListAuditEventsRequest) GetStartTime() (v core.TimestampMS) {
+	if !p.IsSetStartTime() {
+		return ListAuditEventsRequest_StartTime_DEFAULT
+	}
+	return *p.StartTime
+}
+
+var ListAuditEventsRequest_EndTime_DEFAULT core.TimestampMS
+
+func (p *ListAuditEventsRequest) GetEndTime() (v core.TimestampMS) {
+	if !p.IsSetEndTime() {
+		return ListAuditEventsRequest_EndTime_DEFAULT
+	}
+	return *p.EndTime
+}
+
+var ListAuditEventsRequest_Page_DEFAULT *rpc_base.PageRequest
+
+func (p *ListAuditEventsRequest) GetPage() (v *rpc_base.PageRequest) {
+	if !p.IsSetPage() {
+		return ListAuditEventsRequest_Page_DEFAULT
+	}
+	return p.Page
+}
+func (p *ListAuditEventsRequest) SetActorID(val *core.UUID) {
+	p.ActorID = val
+}
+func (p *ListAuditEventsRequest) SetAction(val *string) {
+	p.Action = val
+}
+func (p *ListAuditEventsRequest) SetTargetType(val *string) {
+	p.TargetType = val
+}
+func (p *ListAuditEventsRequest) SetTargetID(val *string) {
+	p.TargetID = val
+}
+func (p *ListAuditEventsRequest) SetResult_(val *string) {
+	p.Result_ = val
+}
+func (p *ListAuditEventsRequest) SetRequestID(val *string) {
+	p.RequestID = val
+}
+func (p *ListAuditEventsRequest) SetStartTime(val *core.TimestampMS) {
+	p.StartTime = val
+}
+func (p *ListAuditEventsRequest) SetEndTime(val *core.TimestampMS) {
+	p.EndTime = val
+}
+func (p *ListAuditEventsRequest) SetPage(val *rpc_base.PageRequest) {
+	p.Page = val
+}
+
+func (p *ListAuditEventsRequest) IsSetActorID() bool {
+	return p.ActorID != nil
+}
+
+func (p *ListAuditEventsRequest) IsSetAction() bool {
+	return p.Action != nil
+}
+
+func (p *ListAuditEventsRequest) IsSetTargetType() bool {
+	return p.TargetType != nil
+}
+
+func (p *ListAuditEventsRequest) IsSetTargetID() bool {
+	return p.TargetID != nil
+}
+
+func (p *ListAuditEventsRequest) IsSetResult_() bool {
+	return p.Result_ != nil
+}
+
+func (p *ListAuditEventsRequest) IsSetRequestID() bool {
+	return p.RequestID != nil
+}
+
+func (p *ListAuditEventsRequest) IsSetStartTime() bool {
+	return p.StartTime != nil
+}
+
+func (p *ListAuditEventsRequest) IsSetEndTime() bool {
+	return p.EndTime != nil
+}
+
+func (p *ListAuditEventsRequest) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListAuditEventsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAuditEventsRequest(%+v)", *p)
+}
+
+var fieldIDToName_ListAuditEventsRequest = map[int16]string{
+	1: "actorID",
+	2: "action",
+	3: "targetType",
+	4: "targetID",
+	5: "result",
+	6: "requestID",
+	7: "startTime",
+	8: "endTime",
+	9: "page",
+}
+
+type ListAuditEventsResponse struct {
+	Events []*AuditEvent          `thrift:"events,1,optional" frugal:"1,optional,list<AuditEvent>" json:"events,omitempty"`
+	Page   *rpc_base.PageResponse `thrift:"page,2,optional" frugal:"2,optional,rpc_base.PageResponse" json:"page,omitempty"`
+}
+
+func NewListAuditEventsResponse() *ListAuditEventsResponse {
+	return &ListAuditEventsResponse{}
+}
+
+func (p *ListAuditEventsResponse) InitDefault() {
+}
+
+var ListAuditEventsResponse_Events_DEFAULT []*AuditEvent
+
+func (p *ListAuditEventsResponse) GetEvents() (v []*AuditEvent) {
+	if !p.IsSetEvents() {
+		return ListAuditEventsResponse_Events_DEFAULT
+	}
+	return p.Events
+}
+
+var ListAuditEventsResponse_Page_DEFAULT *rpc_base.PageResponse
+
+func (p *ListAuditEventsResponse) GetPage() (v *rpc_base.PageResponse) {
+	if !p.IsSetPage() {
+		return ListAuditEventsResponse_Page_DEFAULT
+	}
+	return p.Page
+}
+func (p *ListAuditEventsResponse) SetEvents(val []*AuditEvent) {
+	p.Events = val
+}
+func (p *ListAuditEventsResponse) SetPage(val *rpc_base.PageResponse) {
+	p.Page = val
+}
+
+func (p *ListAuditEventsResponse) IsSetEvents() bool {
+	return p.Events != nil
+}
+
+func (p *ListAuditEventsResponse) IsSetPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListAuditEventsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListAuditEventsResponse(%+v)", *p)
+}
+
+var fieldIDToName_ListAuditEventsResponse = map[int16]string{
+	1: "events",
+	2: "page",
+}
+
+type IdentityService interface {
+	Login(ctx context.Context, req *LoginRequest) (r *LoginResponse, err error)
+
+	SwitchOrganization(ctx context.Context, req *SwitchOrganizationRequest) (r *LoginResponse, err error)
+
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest) (err error)
+
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest) (err error)
+
+	ForcePasswordChange(ctx context.Context, req *ForcePasswordChangeRequest) (err error)
+
+	BeginMFAEnrollment(ctx context.Context, req *BeginMFAEnrollmentRequest) (r *BeginMFAEnrollmentResponse, err error)
+
+	ConfirmMFAEnrollment(ctx context.Context, req *MFACodeRequest) (r *MFARecoveryCodesResponse, err error)
+
+	VerifyMFA(ctx context.Context, req *MFACodeRequest) (r *VerifyMFAResponse, err error)
+
+	DisableMFA(ctx context.Context, req *MFACodeRequest) (err error)
+
+	RegenerateMFARecoveryCodes(ctx context.Context, req *MFACodeRequest) (r *MFARecoveryCodesResponse, err error)
+
+	CreateUser(ctx context.Context, req *CreateUserRequest) (r *UserProfile, err error)
+
+	GetUser(ctx context.Context, req *GetUserRequest) (r *UserProfile, err error)
+
+	UpdateUser(ctx context.Context, req *UpdateUserRequest) (r *UserProfile, err error)
+
+	DeleteUser(ctx context.Context, req *DeleteUserRequest) (err error)
+
+	ListUsers(ctx context.Context, req *ListUsersRequest) (r *ListUsersResponse, err error)
+
+	SearchUsers(ctx context.Context, req *SearchUsersRequest) (r *SearchUsersResponse, err error)
+
+	ChangeUserStatus(ctx context.Context, req *ChangeUserStatusRequest) (err error)
 
-	CreateUser(ctx context.Context, req *CreateUserRequest) (r *UserProfile, err error)
+	UnlockUser(ctx context.Context, req *UnlockUserRequest) (err error)
 
-	GetUser(ctx context.Context, req *GetUserRequest) (r *UserProfile, err error)
+	CreateOrganization(ctx context.Context, req *CreateOrganizationRequest) (r *Organization, err error)
 
-	UpdateUser(ctx context.Context, req *UpdateUserRequest) (r *UserProfile, err error)
+	GetOrganization(ctx context.Context, req *GetOrganizationRequest) (r *Organization, err error)
+
+	UpdateOrganization(ctx context.Context, req *UpdateOrganizationRequest) (r *Organization, err error)
+
+	DeleteOrganization(ctx context.Context, organizationID core.UUID) (err error)
+
+	ListOrganizations(ctx context.Context, req *ListOrganizationsRequest) (r *ListOrganizationsResponse, err error)
+
+	AddMembership(ctx context.Context, req *AddMembershipRequest) (r *UserMembership, err error)
+
+	UpdateMembership(ctx context.Context, req *UpdateMembershipRequest) (r *UserMembership, err error)
+
+	RemoveMembership(ctx context.Context, membershipID core.UUID) (err error)
+
+	GetMembership(ctx context.Context, membershipID core.UUID) (r *UserMembership, err error)
+
+	GetUserMemberships(ctx context.Context, req *GetUserMembershipsRequest) (r *GetUserMembershipsResponse, err error)
+
+	GetPrimaryMembership(ctx context.Context, userID core.UUID) (r *UserMembership, err error)
+
+	CheckMembership(ctx context.Context, req *CheckMembershipRequest) (r bool, err error)
+
+	CreateDepartment(ctx context.Context, req *CreateDepartmentRequest) (r *Department, err error)
+
+	GetDepartment(ctx context.Context, req *GetDepartmentRequest) (r *Department, err error)
+
+	UpdateDepartment(ctx context.Context, req *UpdateDepartmentRequest) (r *Department, err error)
+
+	DeleteDepartment(ctx context.Context, departmentID core.UUID) (err error)
+
+	GetOrganizationDepartments(ctx context.Context, req *GetOrganizationDepartmentsRequest) (r *GetOrganizationDepartmentsResponse, err error)
+
+	UploadTemporaryLogo(ctx context.Context, req *UploadTemporaryLogoRequest) (r *OrganizationLogo, err error)
+
+	GetOrganizationLogo(ctx context.Context, req *GetOrganizationLogoRequest) (r *OrganizationLogo, err error)
+
+	DeleteOrganizationLogo(ctx context.Context, req *DeleteOrganizationLogoRequest) (err error)
+
+	BindLogoToOrganization(ctx context.Context, req *BindLogoToOrganizationRequest) (r *OrganizationLogo, err error)
+
+	CreateRoleDefinition(ctx context.Context, req *RoleDefinitionCreateRequest) (r *RoleDefinition, err error)
+
+	UpdateRoleDefinition(ctx context.Context, req *RoleDefinitionUpdateRequest) (r *RoleDefinition, err error)
+
+	DeleteRoleDefinition(ctx context.Context, roleID core.UUID) (err error)
+
+	GetRoleDefinition(ctx context.Context, roleID core.UUID) (r *RoleDefinition, err error)
+
+	ListRoleDefinitions(ctx context.Context, req *RoleDefinitionQueryRequest) (r *RoleDefinitionListResponse, err error)
+
+	AssignRoleToUser(ctx context.Context, req *AssignRoleToUserRequest) (r *UserRoleAssignmentResponse, err error)
+
+	UpdateUserRoleAssignment(ctx context.Context, req *UpdateUserRoleAssignmentRequest) (err error)
+
+	RevokeRoleFromUser(ctx context.Context, req *RevokeRoleFromUserRequest) (err error)
+
+	GetLastUserRoleAssignment(ctx context.Context, userID core.UUID) (r *UserRoleAssignment, err error)
+
+	ListUserRoleAssignments(ctx context.Context, req *UserRoleQueryRequest) (r *UserRoleListResponse, err error)
+
+	GetUsersByRole(ctx context.Context, req *GetUsersByRoleRequest) (r *GetUsersByRoleResponse, err error)
+
+	BatchBindUsersToRole(ctx context.Context, req *BatchBindUsersToRoleRequest) (r *BatchBindUsersToRoleResponse, err error)
+
+	BatchGetUserRoles(ctx context.Context, req *BatchGetUserRolesRequest) (r *BatchGetUserRolesResponse, err error)
+
+	UploadMenu(ctx context.Context, req *UploadMenuRequest) (r *UploadMenuResponse, err error)
+
+	GetMenuTree(ctx context.Context) (r *GetMenuTreeResponse, err error)
+
+	ListMenuVersions(ctx context.Context) (r *ListMenuVersionsResponse, err error)
+
+	DiffMenuVersions(ctx context.Context, req *DiffMenuVersionsRequest) (r *DiffMenuVersionsResponse, err error)
+
+	ActivateMenuVersion(ctx context.Context, req *ActivateMenuVersionRequest) (r *ActivateMenuVersionResponse, err error)
+
+	GetOrphanRoleMenuMappings(ctx context.Context) (r *GetOrphanRoleMenuMappingsResponse, err error)
+
+	ConfigureRoleMenus(ctx context.Context, req *ConfigureRoleMenusRequest) (r *ConfigureRoleMenusResponse, err error)
+
+	GetRoleMenuTree(ctx context.Context, req *GetRoleMenuTreeRequest) (r *GetRoleMenuTreeResponse, err error)
+
+	GetUserMenuTree(ctx context.Context, req *GetUserMenuTreeRequest) (r *GetUserMenuTreeResponse, err error)
+
+	GetRoleMenuPermissions(ctx context.Context, req *GetRoleMenuPermissionsRequest) (r *GetRoleMenuPermissionsResponse, err error)
+
+	HasMenuPermission(ctx context.Context, req *HasMenuPermissionRequest) (r *HasMenuPermissionResponse, err error)
+
+	GetUserMenuPermissions(ctx context.Context, req *GetUserMenuPermissionsRequest) (r *GetUserMenuPermissionsResponse, err error)
+
+	CheckPermission(ctx context.Context, req *CheckPermissionRequest) (r *CheckPermissionResponse, err error)
+
+	CheckRole(ctx context.Context, req *CheckRoleRequest) (r *CheckRoleResponse, err error)
+
+	GetUserEffectivePermissions(ctx context.Context, req *GetUserEffectivePermissionsRequest) (r *GetUserEffectivePermissionsResponse, err error)
+
+	ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest) (r *ListAuditEventsResponse, err error)
+}
+
+type IdentityServiceLoginArgs struct {
+	Req *LoginRequest `thrift:"req,1" frugal:"1,default,LoginRequest" json:"req"`
+}
+
+func NewIdentityServiceLoginArgs() *IdentityServiceLoginArgs {
+	return &IdentityServiceLoginArgs{}
+}
+
+func (p *IdentityServiceLoginArgs) InitDefault() {
+}
+
+var IdentityServiceLoginArgs_Req_DEFAULT *LoginRequest
+
+func (p *IdentityServiceLoginArgs) GetReq() (v *LoginRequest) {
+	if !p.IsSetReq() {
+		return IdentityServiceLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *IdentityServiceLoginArgs) SetReq(val *LoginRequest) {
+	p.Req = val
+}
+
+func (p *IdentityServiceLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceLoginArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceLoginArgs(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceLoginArgs = map[int16]string{
+	1: "req",
+}
+
+type IdentityServiceLoginResult struct {
+	Success *LoginResponse `thrift:"success,0,optional" frugal:"0,optional,LoginResponse" json:"success,omitempty"`
+}
+
+func NewIdentityServiceLoginResult() *IdentityServiceLoginResult {
+	return &IdentityServiceLoginResult{}
+}
+
+func (p *IdentityServiceLoginResult) InitDefault() {
+}
+
+var IdentityServiceLoginResult_Success_DEFAULT *LoginResponse
+
+func (p *IdentityServiceLoginResult) GetSuccess() (v *LoginResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceLoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LoginResponse)
+}
+
+func (p *IdentityServiceLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceLoginResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceLoginResult(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceLoginResult = map[int16]string{
+	0: "success",
+}
+
+type IdentityServiceSwitchOrganizationArgs struct {
+	Req *SwitchOrganizationRequest `thrift:"req,1" frugal:"1,default,SwitchOrganizationRequest" json:"req"`
+}
+
+func NewIdentityServiceSwitchOrganizationArgs() *IdentityServiceSwitchOrganizationArgs {
+	return &IdentityServiceSwitchOrganizationArgs{}
+}
+
+func (p *IdentityServiceSwitchOrganizationArgs) InitDefault() {
+}
+
+var IdentityServiceSwitchOrganizationArgs_Req_DEFAULT *SwitchOrganizationRequest
+
+func (p *IdentityServiceSwitchOrganizationArgs) GetReq() (v *SwitchOrganizationRequest) {
+	if !p.IsSetReq() {
+		return IdentityServiceSwitchOrganizationArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *IdentityServiceSwitchOrganizationArgs) SetReq(val *SwitchOrganizationRequest) {
+	p.Req = val
+}
+
+func (p *IdentityServiceSwitchOrganizationArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceSwitchOrganizationArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceSwitchOrganizationArgs(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceSwitchOrganizationArgs = map[int16]string{
+	1: "req",
+}
+
+type IdentityServiceSwitchOrganizationResult struct {
+	Success *LoginResponse `thrift:"success,0,optional" frugal:"0,optional,LoginResponse" json:"success,omitempty"`
+}
+
+func NewIdentityServiceSwitchOrganizationResult() *IdentityServiceSwitchOrganizationResult {
+	return &IdentityServiceSwitchOrganizationResult{}
+}
+
+func (p *IdentityServiceSwitchOrganizationResult) InitDefault() {
+}
+
+var IdentityServiceSwitchOrganizationResult_Success_DEFAULT *LoginResponse
+
+func (p *IdentityServiceSwitchOrganizationResult) GetSuccess() (v *LoginResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceSwitchOrganizationResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceSwitchOrganizationResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LoginResponse)
+}
+
+func (p *IdentityServiceSwitchOrganizationResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceSwitchOrganizationResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceSwitchOrganizationResult(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceSwitchOrganizationResult = map[int16]string{
+	0: "success",
+}
+
+type IdentityServiceChangePasswordArgs struct {
+	Req *ChangePasswordRequest `thrift:"req,1" frugal:"1,default,ChangePasswordRequest" json:"req"`
+}
+
+func NewIdentityServiceChangePasswordArgs() *IdentityServiceChangePasswordArgs {
+	return &IdentityServiceChangePasswordArgs{}
+}
+
+func (p *IdentityServiceChangePasswordArgs) InitDefault() {
+}
+
+var IdentityServiceChangePasswordArgs_Req_DEFAULT *ChangePasswordRequest
+
+func (p *IdentityServiceChangePasswordArgs) GetReq() (v *ChangePasswordRequest) {
+	if !p.IsSetReq() {
+		return IdentityServiceChangePasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *IdentityServiceChangePasswordArgs) SetReq(val *ChangePasswordRequest) {
+	p.Req = val
+}
+
+func (p *IdentityServiceChangePasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceChangePasswordArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceChangePasswordArgs(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceChangePasswordArgs = map[int16]string{
+	1: "req",
+}
 
-	DeleteUser(ctx context.Context, req *DeleteUserRequest) (err error)
+type IdentityServiceChangePasswordResult struct {
+}
 
-	ListUsers(ctx context.Context, req *ListUsersRequest) (r *ListUsersResponse, err error)
+func NewIdentityServiceChangePasswordResult() *IdentityServiceChangePasswordResult {
+	return &IdentityServiceChangePasswordResult{}
+}
 
-	SearchUsers(ctx context.Context, req *SearchUsersRequest) (r *SearchUsersResponse, err error)
+func (p *IdentityServiceChangePasswordResult) InitDefault() {
+}
 
-	ChangeUserStatus(ctx context.Context, req *ChangeUserStatusRequest) (err error)
+func (p *IdentityServiceChangePasswordResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceChangePasswordResult(%+v)", *p)
+}
 
-	UnlockUser(ctx context.Context, req *UnlockUserRequest) (err error)
+var fieldIDToName_IdentityServiceChangePasswordResult = map[int16]string{}
 
-	CreateOrganization(ctx context.Context, req *CreateOrganizationRequest) (r *Organization, err error)
+type IdentityServiceResetPasswordArgs struct {
+	Req *ResetPasswordRequest `thrift:"req,1" frugal:"1,default,ResetPasswordRequest" json:"req"`
+}
 
-	GetOrganization(ctx context.Context, req *GetOrganizationRequest) (r *Organization, err error)
+func NewIdentityServiceResetPasswordArgs() *IdentityServiceResetPasswordArgs {
+	return &IdentityServiceResetPasswordArgs{}
+}
 
-	UpdateOrganization(ctx context.Context, req *UpdateOrganizationRequest) (r *Organization, err error)
+func (p *IdentityServiceResetPasswordArgs) InitDefault() {
+}
 
-	DeleteOrganization(ctx context.Context, organizationID core.UUID) (err error)
+var IdentityServiceResetPasswordArgs_Req_DEFAULT *ResetPasswordRequest
 
-	ListOrganizations(ctx context.Context, req *ListOrganizationsRequest) (r *ListOrganizationsResponse, err error)
+func (p *IdentityServiceResetPasswordArgs) GetReq() (v *ResetPasswordRequest) {
+	if !p.IsSetReq() {
+		return IdentityServiceResetPasswordArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *IdentityServiceResetPasswordArgs) SetReq(val *ResetPasswordRequest) {
+	p.Req = val
+}
 
-	AddMembership(ctx context.Context, req *AddMembershipRequest) (r *UserMembership, err error)
+func (p *IdentityServiceResetPasswordArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	UpdateMembership(ctx context.Context, req *UpdateMembershipRequest) (r *UserMembership, err error)
+func (p *IdentityServiceResetPasswordArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceResetPasswordArgs(%+v)", *p)
+}
 
-	RemoveMembership(ctx context.Context, membershipID core.UUID) (err error)
+var fieldIDToName_IdentityServiceResetPasswordArgs = map[int16]string{
+	1: "req",
+}
 
-	GetMembership(ctx context.Context, membershipID core.UUID) (r *UserMembership, err error)
+type IdentityServiceResetPasswordResult struct {
+}
 
-	GetUserMemberships(ctx context.Context, req *GetUserMembershipsRequest) (r *GetUserMembershipsResponse, err error)
+func NewIdentityServiceResetPasswordResult() *IdentityServiceResetPasswordResult {
+	return &IdentityServiceResetPasswordResult{}
+}
 
-	GetPrimaryMembership(ctx context.Context, userID core.UUID) (r *UserMembership, err error)
+func (p *IdentityServiceResetPasswordResult) InitDefault() {
+}
 
-	CheckMembership(ctx context.Context, req *CheckMembershipRequest) (r bool, err error)
+func (p *IdentityServiceResetPasswordResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceResetPasswordResult(%+v)", *p)
+}
 
-	CreateDepartment(ctx context.Context, req *CreateDepartmentRequest) (r *Department, err error)
+var fieldIDToName_IdentityServiceResetPasswordResult = map[int16]string{}
 
-	GetDepartment(ctx context.Context, req *GetDepartmentRequest) (r *Department, err error)
+type IdentityServiceForcePasswordChangeArgs struct {
+	Req *ForcePasswordChangeRequest `thrift:"req,1" frugal:"1,default,ForcePasswordChangeRequest" json:"req"`
+}
 
-	UpdateDepartment(ctx context.Context, req *UpdateDepartmentRequest) (r *Department, err error)
+func NewIdentityServiceForcePasswordChangeArgs() *IdentityServiceForcePasswordChangeArgs {
+	return &IdentityServiceForcePasswordChangeArgs{}
+}
 
-	DeleteDepartment(ctx context.Context, departmentID core.UUID) (err error)
+func (p *IdentityServiceForcePasswordChangeArgs) InitDefault() {
+}
 
-	GetOrganizationDepartments(ctx context.Context, req *GetOrganizationDepartmentsRequest) (r *GetOrganizationDepartmentsResponse, err error)
+var IdentityServiceForcePasswordChangeArgs_Req_DEFAULT *ForcePasswordChangeRequest
 
-	UploadTemporaryLogo(ctx context.Context, req *UploadTemporaryLogoRequest) (r *OrganizationLogo, err error)
+func (p *IdentityServiceForcePasswordChangeArgs) GetReq() (v *ForcePasswordChangeRequest) {
+	if !p.IsSetReq() {
+		return IdentityServiceForcePasswordChangeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *IdentityServiceForcePasswordChangeArgs) SetReq(val *ForcePasswordChangeRequest) {
+	p.Req = val
+}
 
-	GetOrganizationLogo(ctx context.Context, req *GetOrganizationLogoRequest) (r *OrganizationLogo, err error)
+func (p *IdentityServiceForcePasswordChangeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	DeleteOrganizationLogo(ctx context.Context, req *DeleteOrganizationLogoRequest) (err error)
+func (p *IdentityServiceForcePasswordChangeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceForcePasswordChangeArgs(%+v)", *p)
+}
 
-	BindLogoToOrganization(ctx context.Context, req *BindLogoToOrganizationRequest) (r *OrganizationLogo, err error)
+var fieldIDToName_IdentityServiceForcePasswordChangeArgs = map[int16]string{
+	1: "req",
+}
 
-	CreateRoleDefinition(ctx context.Context, req *RoleDefinitionCreateRequest) (r *RoleDefinition, err error)
+type IdentityServiceForcePasswordChangeResult struct {
+}
 
-	UpdateRoleDefinition(ctx context.Context, req *RoleDefinitionUpdateRequest) (r *RoleDefinition, err error)
+func NewIdentityServiceForcePasswordChangeResult() *IdentityServiceForcePasswordChangeResult {
+	return &IdentityServiceForcePasswordChangeResult{}
+}
 
-	DeleteRoleDefinition(ctx context.Context, roleID core.UUID) (err error)
+func (p *IdentityServiceForcePasswordChangeResult) InitDefault() {
+}
 
-	GetRoleDefinition(ctx context.Context, roleID core.UUID) (r *RoleDefinition, err error)
+func (p *IdentityServiceForcePasswordChangeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceForcePasswordChangeResult(%+v)", *p)
+}
 
-	ListRoleDefinitions(ctx context.Context, req *RoleDefinitionQueryRequest) (r *RoleDefinitionListResponse, err error)
+var fieldIDToName_IdentityServiceForcePasswordChangeResult = map[int16]string{}
 
-	AssignRoleToUser(ctx context.Context, req *AssignRoleToUserRequest) (r *UserRoleAssignmentResponse, err error)
+type IdentityServiceBeginMFAEnrollmentArgs struct {
+	Req *BeginMFAEnrollmentRequest `thrift:"req,1" frugal:"1,default,BeginMFAEnrollmentRequest" json:"req"`
+}
 
-	UpdateUserRoleAssignment(ctx context.Context, req *UpdateUserRoleAssignmentRequest) (err error)
+func NewIdentityServiceBeginMFAEnrollmentArgs() *IdentityServiceBeginMFAEnrollmentArgs {
+	return &IdentityServiceBeginMFAEnrollmentArgs{}
+}
 
-	RevokeRoleFromUser(ctx context.Context, req *RevokeRoleFromUserRequest) (err error)
+func (p *IdentityServiceBeginMFAEnrollmentArgs) InitDefault() {
+}
 
-	GetLastUserRoleAssignment(ctx context.Context, userID core.UUID) (r *UserRoleAssignment, err error)
+var IdentityServiceBeginMFAEnrollmentArgs_Req_DEFAULT *BeginMFAEnrollmentRequest
 
-	ListUserRoleAssignments(ctx context.Context, req *UserRoleQueryRequest) (r *UserRoleListResponse, err error)
+func (p *IdentityServiceBeginMFAEnrollmentArgs) GetReq() (v *BeginMFAEnrollmentRequest) {
+	if !p.IsSetReq() {
+		return IdentityServiceBeginMFAEnrollmentArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *IdentityServiceBeginMFAEnrollmentArgs) SetReq(val *BeginMFAEnrollmentRequest) {
+	p.Req = val
+}
 
-	GetUsersByRole(ctx context.Context, req *GetUsersByRoleRequest) (r *GetUsersByRoleResponse, err error)
+func (p *IdentityServiceBeginMFAEnrollmentArgs) IsSetReq() bool {
+	return p.Req != nil
+}
 
-	BatchBindUsersToRole(ctx context.Context, req *BatchBindUsersToRoleRequest) (r *BatchBindUsersToRoleResponse, err error)
+func (p *IdentityServiceBeginMFAEnrollmentArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceBeginMFAEnrollmentArgs(%+v)", *p)
+}
 
-	BatchGetUserRoles(ctx context.Context, req *BatchGetUserRolesRequest) (r *BatchGetUserRolesResponse, err error)
+var fieldIDToName_IdentityServiceBeginMFAEnrollmentArgs = map[int16]string{
+	1: "req",
+}
 
-	UploadMenu(ctx context.Context, req *UploadMenuRequest) (err error)
+type IdentityServiceBeginMFAEnrollmentResult struct {
+	Success *BeginMFAEnrollmentResponse `thrift:"success,0,optional" frugal:"0,optional,BeginMFAEnrollmentResponse" json:"success,omitempty"`
+}
 
-	GetMenuTree(ctx context.Context) (r *GetMenuTreeResponse, err error)
+func NewIdentityServiceBeginMFAEnrollmentResult() *IdentityServiceBeginMFAEnrollmentResult {
+	return &IdentityServiceBeginMFAEnrollmentResult{}
+}
 
-	ConfigureRoleMenus(ctx context.Context, req *ConfigureRoleMenusRequest) (r *ConfigureRoleMenusResponse, err error)
+func (p *IdentityServiceBeginMFAEnrollmentResult) InitDefault() {
+}
 
-	GetRoleMenuTree(ctx context.Context, req *GetRoleMenuTreeRequest) (r *GetRoleMenuTreeResponse, err error)
+var IdentityServiceBeginMFAEnrollmentResult_Success_DEFAULT *BeginMFAEnrollmentResponse
 
-	GetUserMenuTree(ctx context.Context, req *GetUserMenuTreeRequest) (r *GetUserMenuTreeResponse, err error)
+func (p *IdentityServiceBeginMFAEnrollmentResult) GetSuccess() (v *BeginMFAEnrollmentResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceBeginMFAEnrollmentResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceBeginMFAEnrollmentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BeginMFAEnrollmentResponse)
+}
 
-	GetRoleMenuPermissions(ctx context.Context, req *GetRoleMenuPermissionsRequest) (r *GetRoleMenuPermissionsResponse, err error)
+func (p *IdentityServiceBeginMFAEnrollmentResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
 
-	HasMenuPermission(ctx context.Context, req *HasMenuPermissionRequest) (r *HasMenuPermissionResponse, err error)
+func (p *IdentityServiceBeginMFAEnrollmentResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceBeginMFAEnrollmentResult(%+v)", *p)
+}
 
-	GetUserMenuPermissions(ctx context.Context, req *GetUserMenuPermissionsRequest) (r *GetUserMenuPermissionsResponse, err error)
+var fieldIDToName_IdentityServiceBeginMFAEnrollmentResult = map[int16]string{
+	0: "success",
 }
 
-type IdentityServiceLoginArgs struct {
-	Req *LoginRequest `thrift:"req,1" frugal:"1,default,LoginRequest" json:"req"`
+type IdentityServiceConfirmMFAEnrollmentArgs struct {
+	Req *MFACodeRequest `thrift:"req,1" frugal:"1,default,MFACodeRequest" json:"req"`
 }
 
-func NewIdentityServiceLoginArgs() *IdentityServiceLoginArgs {
-	return &IdentityServiceLoginArgs{}
+func NewIdentityServiceConfirmMFAEnrollmentArgs() *IdentityServiceConfirmMFAEnrollmentArgs {
+	return &IdentityServiceConfirmMFAEnrollmentArgs{}
 }
 
-func (p *IdentityServiceLoginArgs) InitDefault() {
+func (p *IdentityServiceConfirmMFAEnrollmentArgs) InitDefault() {
 }
 
-var IdentityServiceLoginArgs_Req_DEFAULT *LoginRequest
+var IdentityServiceConfirmMFAEnrollmentArgs_Req_DEFAULT *MFACodeRequest
 
-func (p *IdentityServiceLoginArgs) GetReq() (v *LoginRequest) {
+func (p *IdentityServiceConfirmMFAEnrollmentArgs) GetReq() (v *MFACodeRequest) {
 	if !p.IsSetReq() {
-		return IdentityServiceLoginArgs_Req_DEFAULT
+		return IdentityServiceConfirmMFAEnrollmentArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *IdentityServiceLoginArgs) SetReq(val *LoginRequest) {
+func (p *IdentityServiceConfirmMFAEnrollmentArgs) SetReq(val *MFACodeRequest) {
 	p.Req = val
 }
 
-func (p *IdentityServiceLoginArgs) IsSetReq() bool {
+func (p *IdentityServiceConfirmMFAEnrollmentArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceLoginArgs) String() string {
+func (p *IdentityServiceConfirmMFAEnrollmentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceLoginArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceConfirmMFAEnrollmentArgs(%+v)", *p)
 }
 
-var fieldIDToName_IdentityServiceLoginArgs = map[int16]string{
+var fieldIDToName_IdentityServiceConfirmMFAEnrollmentArgs = map[int16]string{
 	1: "req",
 }
 
-type IdentityServiceLoginResult struct {
-	Success *LoginResponse `thrift:"success,0,optional" frugal:"0,optional,LoginResponse" json:"success,omitempty"`
+type IdentityServiceConfirmMFAEnrollmentResult struct {
+	Success *MFARecoveryCodesResponse `thrift:"success,0,optional" frugal:"0,optional,MFARecoveryCodesResponse" json:"success,omitempty"`
 }
 
-func NewIdentityServiceLoginResult() *IdentityServiceLoginResult {
-	return &IdentityServiceLoginResult{}
+func NewIdentityServiceConfirmMFAEnrollmentResult() *IdentityServiceConfirmMFAEnrollmentResult {
+	return &IdentityServiceConfirmMFAEnrollmentResult{}
 }
 
-func (p *IdentityServiceLoginResult) InitDefault() {
+func (p *IdentityServiceConfirmMFAEnrollmentResult) InitDefault() {
 }
 
-var IdentityServiceLoginResult_Success_DEFAULT *LoginResponse
+var IdentityServiceConfirmMFAEnrollmentResult_Success_DEFAULT *MFARecoveryCodesResponse
 
-func (p *IdentityServiceLoginResult) GetSuccess() (v *LoginResponse) {
+func (p *IdentityServiceConfirmMFAEnrollmentResult) GetSuccess() (v *MFARecoveryCodesResponse) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceLoginResult_Success_DEFAULT
+		return IdentityServiceConfirmMFAEnrollmentResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *IdentityServiceLoginResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LoginResponse)
+func (p *IdentityServiceConfirmMFAEnrollmentResult) SetSuccess(x interface{}) {
+	p.Success = x.(*MFARecoveryCodesResponse)
 }
 
-func (p *IdentityServiceLoginResult) IsSetSuccess() bool {
+func (p *IdentityServiceConfirmMFAEnrollmentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceLoginResult) String() string {
+func (p *IdentityServiceConfirmMFAEnrollmentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceLoginResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceConfirmMFAEnrollmentResult(%+v)", *p)
 }
 
-var fieldIDToName_IdentityServiceLoginResult = map[int16]string{
+var fieldIDToName_IdentityServiceConfirmMFAEnrollmentResult = map[int16]string{
 	0: "success",
 }
 
-type IdentityServiceChangePasswordArgs struct {
-	Req *ChangePasswordRequest `thrift:"req,1" frugal:"1,default,ChangePasswordRequest" json:"req"`
+type IdentityServiceVerifyMFAArgs struct {
+	Req *MFACodeRequest `thrift:"req,1" frugal:"1,default,MFACodeRequest" json:"req"`
 }
 
-func NewIdentityServiceChangePasswordArgs() *IdentityServiceChangePasswordArgs {
-	return &IdentityServiceChangePasswordArgs{}
+func NewIdentityServiceVerifyMFAArgs() *IdentityServiceVerifyMFAArgs {
+	return &IdentityServiceVerifyMFAArgs{}
 }
 
-func (p *IdentityServiceChangePasswordArgs) InitDefault() {
+func (p *IdentityServiceVerifyMFAArgs) InitDefault() {
 }
 
-var IdentityServiceChangePasswordArgs_Req_DEFAULT *ChangePasswordRequest
+var IdentityServiceVerifyMFAArgs_Req_DEFAULT *MFACodeRequest
 
-func (p *IdentityServiceChangePasswordArgs) GetReq() (v *ChangePasswordRequest) {
+func (p *IdentityServiceVerifyMFAArgs) GetReq() (v *MFACodeRequest) {
 	if !p.IsSetReq() {
-		return IdentityServiceChangePasswordArgs_Req_DEFAULT
+		return IdentityServiceVerifyMFAArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *IdentityServiceChangePasswordArgs) SetReq(val *ChangePasswordRequest) {
+func (p *IdentityServiceVerifyMFAArgs) SetReq(val *MFACodeRequest) {
 	p.Req = val
 }
 
-func (p *IdentityServiceChangePasswordArgs) IsSetReq() bool {
+func (p *IdentityServiceVerifyMFAArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceChangePasswordArgs) String() string {
+func (p *IdentityServiceVerifyMFAArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceChangePasswordArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceVerifyMFAArgs(%+v)", *p)
 }
 
-var fieldIDToName_IdentityServiceChangePasswordArgs = map[int16]string{
+var fieldIDToName_IdentityServiceVerifyMFAArgs = map[int16]string{
 	1: "req",
 }
 
-type IdentityServiceChangePasswordResult struct {
+type IdentityServiceVerifyMFAResult struct {
+	Success *VerifyMFAResponse `thrift:"success,0,optional" frugal:"0,optional,VerifyMFAResponse" json:"success,omitempty"`
 }
 
-func NewIdentityServiceChangePasswordResult() *IdentityServiceChangePasswordResult {
-	return &IdentityServiceChangePasswordResult{}
+func NewIdentityServiceVerifyMFAResult() *IdentityServiceVerifyMFAResult {
+	return &IdentityServiceVerifyMFAResult{}
 }
 
-func (p *IdentityServiceChangePasswordResult) InitDefault() {
+func (p *IdentityServiceVerifyMFAResult) InitDefault() {
 }
 
-func (p *IdentityServiceChangePasswordResult) String() string {
+var IdentityServiceVerifyMFAResult_Success_DEFAULT *VerifyMFAResponse
+
+func (p *IdentityServiceVerifyMFAResult) GetSuccess() (v *VerifyMFAResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceVerifyMFAResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceVerifyMFAResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VerifyMFAResponse)
+}
+
+func (p *IdentityServiceVerifyMFAResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceVerifyMFAResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceChangePasswordResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceVerifyMFAResult(%+v)", *p)
 }
 
-var fieldIDToName_IdentityServiceChangePasswordResult = map[int16]string{}
+var fieldIDToName_IdentityServiceVerifyMFAResult = map[int16]string{
+	0: "success",
+}
 
-type IdentityServiceResetPasswordArgs struct {
-	Req *ResetPasswordRequest `thrift:"req,1" frugal:"1,default,ResetPasswordRequest" json:"req"`
+type IdentityServiceDisableMFAArgs struct {
+	Req *MFACodeRequest `thrift:"req,1" frugal:"1,default,MFACodeRequest" json:"req"`
 }
 
-func NewIdentityServiceResetPasswordArgs() *IdentityServiceResetPasswordArgs {
-	return &IdentityServiceResetPasswordArgs{}
+func NewIdentityServiceDisableMFAArgs() *IdentityServiceDisableMFAArgs {
+	return &IdentityServiceDisableMFAArgs{}
 }
 
-func (p *IdentityServiceResetPasswordArgs) InitDefault() {
+func (p *IdentityServiceDisableMFAArgs) InitDefault() {
 }
 
-var IdentityServiceResetPasswordArgs_Req_DEFAULT *ResetPasswordRequest
+var IdentityServiceDisableMFAArgs_Req_DEFAULT *MFACodeRequest
 
-func (p *IdentityServiceResetPasswordArgs) GetReq() (v *ResetPasswordRequest) {
+func (p *IdentityServiceDisableMFAArgs) GetReq() (v *MFACodeRequest) {
 	if !p.IsSetReq() {
-		return IdentityServiceResetPasswordArgs_Req_DEFAULT
+		return IdentityServiceDisableMFAArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *IdentityServiceResetPasswordArgs) SetReq(val *ResetPasswordRequest) {
+func (p *IdentityServiceDisableMFAArgs) SetReq(val *MFACodeRequest) {
 	p.Req = val
 }
 
-func (p *IdentityServiceResetPasswordArgs) IsSetReq() bool {
+func (p *IdentityServiceDisableMFAArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceResetPasswordArgs) String() string {
+func (p *IdentityServiceDisableMFAArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceResetPasswordArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceDisableMFAArgs(%+v)", *p)
 }
 
-var fieldIDToName_IdentityServiceResetPasswordArgs = map[int16]string{
+var fieldIDToName_IdentityServiceDisableMFAArgs = map[int16]string{
 	1: "req",
 }
 
-type IdentityServiceResetPasswordResult struct {
+type IdentityServiceDisableMFAResult struct {
 }
 
-func NewIdentityServiceResetPasswordResult() *IdentityServiceResetPasswordResult {
-	return &IdentityServiceResetPasswordResult{}
+func NewIdentityServiceDisableMFAResult() *IdentityServiceDisableMFAResult {
+	return &IdentityServiceDisableMFAResult{}
 }
 
-func (p *IdentityServiceResetPasswordResult) InitDefault() {
+func (p *IdentityServiceDisableMFAResult) InitDefault() {
 }
 
-func (p *IdentityServiceResetPasswordResult) String() string {
+func (p *IdentityServiceDisableMFAResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceResetPasswordResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceDisableMFAResult(%+v)", *p)
 }
 
-var fieldIDToName_IdentityServiceResetPasswordResult = map[int16]string{}
+var fieldIDToName_IdentityServiceDisableMFAResult = map[int16]string{}
 
-type IdentityServiceForcePasswordChangeArgs struct {
-	Req *ForcePasswordChangeRequest `thrift:"req,1" frugal:"1,default,ForcePasswordChangeRequest" json:"req"`
+type IdentityServiceRegenerateMFARecoveryCodesArgs struct {
+	Req *MFACodeRequest `thrift:"req,1" frugal:"1,default,MFACodeRequest" json:"req"`
 }
 
-func NewIdentityServiceForcePasswordChangeArgs() *IdentityServiceForcePasswordChangeArgs {
-	return &IdentityServiceForcePasswordChangeArgs{}
+func NewIdentityServiceRegenerateMFARecoveryCodesArgs() *IdentityServiceRegenerateMFARecoveryCodesArgs {
+	return &IdentityServiceRegenerateMFARecoveryCodesArgs{}
 }
 
-func (p *IdentityServiceForcePasswordChangeArgs) InitDefault() {
+func (p *IdentityServiceRegenerateMFARecoveryCodesArgs) InitDefault() {
 }
 
-var IdentityServiceForcePasswordChangeArgs_Req_DEFAULT *ForcePasswordChangeRequest
+var IdentityServiceRegenerateMFARecoveryCodesArgs_Req_DEFAULT *MFACodeRequest
 
-func (p *IdentityServiceForcePasswordChangeArgs) GetReq() (v *ForcePasswordChangeRequest) {
+func (p *IdentityServiceRegenerateMFARecoveryCodesArgs) GetReq() (v *MFACodeRequest) {
 	if !p.IsSetReq() {
-		return IdentityServiceForcePasswordChangeArgs_Req_DEFAULT
+		return IdentityServiceRegenerateMFARecoveryCodesArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *IdentityServiceForcePasswordChangeArgs) SetReq(val *ForcePasswordChangeRequest) {
+func (p *IdentityServiceRegenerateMFARecoveryCodesArgs) SetReq(val *MFACodeRequest) {
 	p.Req = val
 }
 
-func (p *IdentityServiceForcePasswordChangeArgs) IsSetReq() bool {
+func (p *IdentityServiceRegenerateMFARecoveryCodesArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceForcePasswordChangeArgs) String() string {
+func (p *IdentityServiceRegenerateMFARecoveryCodesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceForcePasswordChangeArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceRegenerateMFARecoveryCodesArgs(%+v)", *p)
 }
 
-var fieldIDToName_IdentityServiceForcePasswordChangeArgs = map[int16]string{
+var fieldIDToName_IdentityServiceRegenerateMFARecoveryCodesArgs = map[int16]string{
 	1: "req",
 }
 
-type IdentityServiceForcePasswordChangeResult struct {
+type IdentityServiceRegenerateMFARecoveryCodesResult struct {
+	Success *MFARecoveryCodesResponse `thrift:"success,0,optional" frugal:"0,optional,MFARecoveryCodesResponse" json:"success,omitempty"`
 }
 
-func NewIdentityServiceForcePasswordChangeResult() *IdentityServiceForcePasswordChangeResult {
-	return &IdentityServiceForcePasswordChangeResult{}
+func NewIdentityServiceRegenerateMFARecoveryCodesResult() *IdentityServiceRegenerateMFARecoveryCodesResult {
+	return &IdentityServiceRegenerateMFARecoveryCodesResult{}
 }
 
-func (p *IdentityServiceForcePasswordChangeResult) InitDefault() {
+func (p *IdentityServiceRegenerateMFARecoveryCodesResult) InitDefault() {
 }
 
-func (p *IdentityServiceForcePasswordChangeResult) String() string {
+var IdentityServiceRegenerateMFARecoveryCodesResult_Success_DEFAULT *MFARecoveryCodesResponse
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesResult) GetSuccess() (v *MFARecoveryCodesResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceRegenerateMFARecoveryCodesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceRegenerateMFARecoveryCodesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*MFARecoveryCodesResponse)
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceRegenerateMFARecoveryCodesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceForcePasswordChangeResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceRegenerateMFARecoveryCodesResult(%+v)", *p)
 }
 
-var fieldIDToName_IdentityServiceForcePasswordChangeResult = map[int16]string{}
+var fieldIDToName_IdentityServiceRegenerateMFARecoveryCodesResult = map[int16]string{
+	0: "success",
+}
 
 type IdentityServiceCreateUserArgs struct {
 	Req *CreateUserRequest `thrift:"req,1" frugal:"1,default,CreateUserRequest" json:"req"`
@@ -7920,142 +10813,427 @@ func (p *IdentityServiceBatchGetUserRolesResult) InitDefault() {
 
 var IdentityServiceBatchGetUserRolesResult_Success_DEFAULT *BatchGetUserRolesResponse
 
-func (p *IdentityServiceBatchGetUserRolesResult) GetSuccess() (v *BatchGetUserRolesResponse) {
+func (p *IdentityServiceBatchGetUserRolesResult) GetSuccess() (v *BatchGetUserRolesResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceBatchGetUserRolesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceBatchGetUserRolesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*BatchGetUserRolesResponse)
+}
+
+func (p *IdentityServiceBatchGetUserRolesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceBatchGetUserRolesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceBatchGetUserRolesResult(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceBatchGetUserRolesResult = map[int16]string{
+	0: "success",
+}
+
+type IdentityServiceUploadMenuArgs struct {
+	Req *UploadMenuRequest `thrift:"req,1" frugal:"1,default,UploadMenuRequest" json:"req"`
+}
+
+func NewIdentityServiceUploadMenuArgs() *IdentityServiceUploadMenuArgs {
+	return &IdentityServiceUploadMenuArgs{}
+}
+
+func (p *IdentityServiceUploadMenuArgs) InitDefault() {
+}
+
+var IdentityServiceUploadMenuArgs_Req_DEFAULT *UploadMenuRequest
+
+func (p *IdentityServiceUploadMenuArgs) GetReq() (v *UploadMenuRequest) {
+	if !p.IsSetReq() {
+		return IdentityServiceUploadMenuArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *IdentityServiceUploadMenuArgs) SetReq(val *UploadMenuRequest) {
+	p.Req = val
+}
+
+func (p *IdentityServiceUploadMenuArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceUploadMenuArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceUploadMenuArgs(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceUploadMenuArgs = map[int16]string{
+	1: "req",
+}
+
+type IdentityServiceUploadMenuResult struct {
+	Success *UploadMenuResponse `thrift:"success,0,optional" frugal:"0,optional,UploadMenuResponse" json:"success,omitempty"`
+}
+
+func NewIdentityServiceUploadMenuResult() *IdentityServiceUploadMenuResult {
+	return &IdentityServiceUploadMenuResult{}
+}
+
+func (p *IdentityServiceUploadMenuResult) InitDefault() {
+}
+
+var IdentityServiceUploadMenuResult_Success_DEFAULT *UploadMenuResponse
+
+func (p *IdentityServiceUploadMenuResult) GetSuccess() (v *UploadMenuResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceUploadMenuResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceUploadMenuResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UploadMenuResponse)
+}
+
+func (p *IdentityServiceUploadMenuResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceUploadMenuResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceUploadMenuResult(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceUploadMenuResult = map[int16]string{
+	0: "success",
+}
+
+type IdentityServiceGetMenuTreeArgs struct {
+}
+
+func NewIdentityServiceGetMenuTreeArgs() *IdentityServiceGetMenuTreeArgs {
+	return &IdentityServiceGetMenuTreeArgs{}
+}
+
+func (p *IdentityServiceGetMenuTreeArgs) InitDefault() {
+}
+
+func (p *IdentityServiceGetMenuTreeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceGetMenuTreeArgs(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceGetMenuTreeArgs = map[int16]string{}
+
+type IdentityServiceGetMenuTreeResult struct {
+	Success *GetMenuTreeResponse `thrift:"success,0,optional" frugal:"0,optional,GetMenuTreeResponse" json:"success,omitempty"`
+}
+
+func NewIdentityServiceGetMenuTreeResult() *IdentityServiceGetMenuTreeResult {
+	return &IdentityServiceGetMenuTreeResult{}
+}
+
+func (p *IdentityServiceGetMenuTreeResult) InitDefault() {
+}
+
+var IdentityServiceGetMenuTreeResult_Success_DEFAULT *GetMenuTreeResponse
+
+func (p *IdentityServiceGetMenuTreeResult) GetSuccess() (v *GetMenuTreeResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceGetMenuTreeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceGetMenuTreeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetMenuTreeResponse)
+}
+
+func (p *IdentityServiceGetMenuTreeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceGetMenuTreeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceGetMenuTreeResult(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceGetMenuTreeResult = map[int16]string{
+	0: "success",
+}
+
+type IdentityServiceListMenuVersionsArgs struct {
+}
+
+func NewIdentityServiceListMenuVersionsArgs() *IdentityServiceListMenuVersionsArgs {
+	return &IdentityServiceListMenuVersionsArgs{}
+}
+
+func (p *IdentityServiceListMenuVersionsArgs) InitDefault() {
+}
+
+func (p *IdentityServiceListMenuVersionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceListMenuVersionsArgs(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceListMenuVersionsArgs = map[int16]string{}
+
+type IdentityServiceListMenuVersionsResult struct {
+	Success *ListMenuVersionsResponse `thrift:"success,0,optional" frugal:"0,optional,ListMenuVersionsResponse" json:"success,omitempty"`
+}
+
+func NewIdentityServiceListMenuVersionsResult() *IdentityServiceListMenuVersionsResult {
+	return &IdentityServiceListMenuVersionsResult{}
+}
+
+func (p *IdentityServiceListMenuVersionsResult) InitDefault() {
+}
+
+var IdentityServiceListMenuVersionsResult_Success_DEFAULT *ListMenuVersionsResponse
+
+func (p *IdentityServiceListMenuVersionsResult) GetSuccess() (v *ListMenuVersionsResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceListMenuVersionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceListMenuVersionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListMenuVersionsResponse)
+}
+
+func (p *IdentityServiceListMenuVersionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceListMenuVersionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceListMenuVersionsResult(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceListMenuVersionsResult = map[int16]string{
+	0: "success",
+}
+
+type IdentityServiceDiffMenuVersionsArgs struct {
+	Req *DiffMenuVersionsRequest `thrift:"req,1" frugal:"1,default,DiffMenuVersionsRequest" json:"req"`
+}
+
+func NewIdentityServiceDiffMenuVersionsArgs() *IdentityServiceDiffMenuVersionsArgs {
+	return &IdentityServiceDiffMenuVersionsArgs{}
+}
+
+func (p *IdentityServiceDiffMenuVersionsArgs) InitDefault() {
+}
+
+var IdentityServiceDiffMenuVersionsArgs_Req_DEFAULT *DiffMenuVersionsRequest
+
+func (p *IdentityServiceDiffMenuVersionsArgs) GetReq() (v *DiffMenuVersionsRequest) {
+	if !p.IsSetReq() {
+		return IdentityServiceDiffMenuVersionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *IdentityServiceDiffMenuVersionsArgs) SetReq(val *DiffMenuVersionsRequest) {
+	p.Req = val
+}
+
+func (p *IdentityServiceDiffMenuVersionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceDiffMenuVersionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceDiffMenuVersionsArgs(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceDiffMenuVersionsArgs = map[int16]string{
+	1: "req",
+}
+
+type IdentityServiceDiffMenuVersionsResult struct {
+	Success *DiffMenuVersionsResponse `thrift:"success,0,optional" frugal:"0,optional,DiffMenuVersionsResponse" json:"success,omitempty"`
+}
+
+func NewIdentityServiceDiffMenuVersionsResult() *IdentityServiceDiffMenuVersionsResult {
+	return &IdentityServiceDiffMenuVersionsResult{}
+}
+
+func (p *IdentityServiceDiffMenuVersionsResult) InitDefault() {
+}
+
+var IdentityServiceDiffMenuVersionsResult_Success_DEFAULT *DiffMenuVersionsResponse
+
+func (p *IdentityServiceDiffMenuVersionsResult) GetSuccess() (v *DiffMenuVersionsResponse) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceBatchGetUserRolesResult_Success_DEFAULT
+		return IdentityServiceDiffMenuVersionsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *IdentityServiceBatchGetUserRolesResult) SetSuccess(x interface{}) {
-	p.Success = x.(*BatchGetUserRolesResponse)
+func (p *IdentityServiceDiffMenuVersionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*DiffMenuVersionsResponse)
 }
 
-func (p *IdentityServiceBatchGetUserRolesResult) IsSetSuccess() bool {
+func (p *IdentityServiceDiffMenuVersionsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceBatchGetUserRolesResult) String() string {
+func (p *IdentityServiceDiffMenuVersionsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceBatchGetUserRolesResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceDiffMenuVersionsResult(%+v)", *p)
 }
 
-var fieldIDToName_IdentityServiceBatchGetUserRolesResult = map[int16]string{
+var fieldIDToName_IdentityServiceDiffMenuVersionsResult = map[int16]string{
 	0: "success",
 }
 
-type IdentityServiceUploadMenuArgs struct {
-	Req *UploadMenuRequest `thrift:"req,1" frugal:"1,default,UploadMenuRequest" json:"req"`
+type IdentityServiceActivateMenuVersionArgs struct {
+	Req *ActivateMenuVersionRequest `thrift:"req,1" frugal:"1,default,ActivateMenuVersionRequest" json:"req"`
 }
 
-func NewIdentityServiceUploadMenuArgs() *IdentityServiceUploadMenuArgs {
-	return &IdentityServiceUploadMenuArgs{}
+func NewIdentityServiceActivateMenuVersionArgs() *IdentityServiceActivateMenuVersionArgs {
+	return &IdentityServiceActivateMenuVersionArgs{}
 }
 
-func (p *IdentityServiceUploadMenuArgs) InitDefault() {
+func (p *IdentityServiceActivateMenuVersionArgs) InitDefault() {
 }
 
-var IdentityServiceUploadMenuArgs_Req_DEFAULT *UploadMenuRequest
+var IdentityServiceActivateMenuVersionArgs_Req_DEFAULT *ActivateMenuVersionRequest
 
-func (p *IdentityServiceUploadMenuArgs) GetReq() (v *UploadMenuRequest) {
+func (p *IdentityServiceActivateMenuVersionArgs) GetReq() (v *ActivateMenuVersionRequest) {
 	if !p.IsSetReq() {
-		return IdentityServiceUploadMenuArgs_Req_DEFAULT
+		return IdentityServiceActivateMenuVersionArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *IdentityServiceUploadMenuArgs) SetReq(val *UploadMenuRequest) {
+func (p *IdentityServiceActivateMenuVersionArgs) SetReq(val *ActivateMenuVersionRequest) {
 	p.Req = val
 }
 
-func (p *IdentityServiceUploadMenuArgs) IsSetReq() bool {
+func (p *IdentityServiceActivateMenuVersionArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *IdentityServiceUploadMenuArgs) String() string {
+func (p *IdentityServiceActivateMenuVersionArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceUploadMenuArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceActivateMenuVersionArgs(%+v)", *p)
 }
 
-var fieldIDToName_IdentityServiceUploadMenuArgs = map[int16]string{
+var fieldIDToName_IdentityServiceActivateMenuVersionArgs = map[int16]string{
 	1: "req",
 }
 
-type IdentityServiceUploadMenuResult struct {
+type IdentityServiceActivateMenuVersionResult struct {
+	Success *ActivateMenuVersionResponse `thrift:"success,0,optional" frugal:"0,optional,ActivateMenuVersionResponse" json:"success,omitempty"`
 }
 
-func NewIdentityServiceUploadMenuResult() *IdentityServiceUploadMenuResult {
-	return &IdentityServiceUploadMenuResult{}
+func NewIdentityServiceActivateMenuVersionResult() *IdentityServiceActivateMenuVersionResult {
+	return &IdentityServiceActivateMenuVersionResult{}
 }
 
-func (p *IdentityServiceUploadMenuResult) InitDefault() {
+func (p *IdentityServiceActivateMenuVersionResult) InitDefault() {
 }
 
-func (p *IdentityServiceUploadMenuResult) String() string {
+var IdentityServiceActivateMenuVersionResult_Success_DEFAULT *ActivateMenuVersionResponse
+
+func (p *IdentityServiceActivateMenuVersionResult) GetSuccess() (v *ActivateMenuVersionResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceActivateMenuVersionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceActivateMenuVersionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ActivateMenuVersionResponse)
+}
+
+func (p *IdentityServiceActivateMenuVersionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceActivateMenuVersionResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceUploadMenuResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceActivateMenuVersionResult(%+v)", *p)
 }
 
-var fieldIDToName_IdentityServiceUploadMenuResult = map[int16]string{}
+var fieldIDToName_IdentityServiceActivateMenuVersionResult = map[int16]string{
+	0: "success",
+}
 
-type IdentityServiceGetMenuTreeArgs struct {
+type IdentityServiceGetOrphanRoleMenuMappingsArgs struct {
 }
 
-func NewIdentityServiceGetMenuTreeArgs() *IdentityServiceGetMenuTreeArgs {
-	return &IdentityServiceGetMenuTreeArgs{}
+func NewIdentityServiceGetOrphanRoleMenuMappingsArgs() *IdentityServiceGetOrphanRoleMenuMappingsArgs {
+	return &IdentityServiceGetOrphanRoleMenuMappingsArgs{}
 }
 
-func (p *IdentityServiceGetMenuTreeArgs) InitDefault() {
+func (p *IdentityServiceGetOrphanRoleMenuMappingsArgs) InitDefault() {
 }
 
-func (p *IdentityServiceGetMenuTreeArgs) String() string {
+func (p *IdentityServiceGetOrphanRoleMenuMappingsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceGetMenuTreeArgs(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceGetOrphanRoleMenuMappingsArgs(%+v)", *p)
 }
 
-var fieldIDToName_IdentityServiceGetMenuTreeArgs = map[int16]string{}
+var fieldIDToName_IdentityServiceGetOrphanRoleMenuMappingsArgs = map[int16]string{}
 
-type IdentityServiceGetMenuTreeResult struct {
-	Success *GetMenuTreeResponse `thrift:"success,0,optional" frugal:"0,optional,GetMenuTreeResponse" json:"success,omitempty"`
+type IdentityServiceGetOrphanRoleMenuMappingsResult struct {
+	Success *GetOrphanRoleMenuMappingsResponse `thrift:"success,0,optional" frugal:"0,optional,GetOrphanRoleMenuMappingsResponse" json:"success,omitempty"`
 }
 
-func NewIdentityServiceGetMenuTreeResult() *IdentityServiceGetMenuTreeResult {
-	return &IdentityServiceGetMenuTreeResult{}
+func NewIdentityServiceGetOrphanRoleMenuMappingsResult() *IdentityServiceGetOrphanRoleMenuMappingsResult {
+	return &IdentityServiceGetOrphanRoleMenuMappingsResult{}
 }
 
-func (p *IdentityServiceGetMenuTreeResult) InitDefault() {
+func (p *IdentityServiceGetOrphanRoleMenuMappingsResult) InitDefault() {
 }
 
-var IdentityServiceGetMenuTreeResult_Success_DEFAULT *GetMenuTreeResponse
+var IdentityServiceGetOrphanRoleMenuMappingsResult_Success_DEFAULT *GetOrphanRoleMenuMappingsResponse
 
-func (p *IdentityServiceGetMenuTreeResult) GetSuccess() (v *GetMenuTreeResponse) {
+func (p *IdentityServiceGetOrphanRoleMenuMappingsResult) GetSuccess() (v *GetOrphanRoleMenuMappingsResponse) {
 	if !p.IsSetSuccess() {
-		return IdentityServiceGetMenuTreeResult_Success_DEFAULT
+		return IdentityServiceGetOrphanRoleMenuMappingsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *IdentityServiceGetMenuTreeResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetMenuTreeResponse)
+func (p *IdentityServiceGetOrphanRoleMenuMappingsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetOrphanRoleMenuMappingsResponse)
 }
 
-func (p *IdentityServiceGetMenuTreeResult) IsSetSuccess() bool {
+func (p *IdentityServiceGetOrphanRoleMenuMappingsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *IdentityServiceGetMenuTreeResult) String() string {
+func (p *IdentityServiceGetOrphanRoleMenuMappingsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IdentityServiceGetMenuTreeResult(%+v)", *p)
+	return fmt.Sprintf("IdentityServiceGetOrphanRoleMenuMappingsResult(%+v)", *p)
 }
 
-var fieldIDToName_IdentityServiceGetMenuTreeResult = map[int16]string{
+var fieldIDToName_IdentityServiceGetOrphanRoleMenuMappingsResult = map[int16]string{
 	0: "success",
 }
 
@@ -8514,3 +11692,307 @@ func (p *IdentityServiceGetUserMenuPermissionsResult) String() string {
 var fieldIDToName_IdentityServiceGetUserMenuPermissionsResult = map[int16]string{
 	0: "success",
 }
+
+type IdentityServiceCheckPermissionArgs struct {
+	Req *CheckPermissionRequest `thrift:"req,1" frugal:"1,default,CheckPermissionRequest" json:"req"`
+}
+
+func NewIdentityServiceCheckPermissionArgs() *IdentityServiceCheckPermissionArgs {
+	return &IdentityServiceCheckPermissionArgs{}
+}
+
+func (p *IdentityServiceCheckPermissionArgs) InitDefault() {
+}
+
+var IdentityServiceCheckPermissionArgs_Req_DEFAULT *CheckPermissionRequest
+
+func (p *IdentityServiceCheckPermissionArgs) GetReq() (v *CheckPermissionRequest) {
+	if !p.IsSetReq() {
+		return IdentityServiceCheckPermissionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *IdentityServiceCheckPermissionArgs) SetReq(val *CheckPermissionRequest) {
+	p.Req = val
+}
+
+func (p *IdentityServiceCheckPermissionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceCheckPermissionArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceCheckPermissionArgs(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceCheckPermissionArgs = map[int16]string{
+	1: "req",
+}
+
+type IdentityServiceCheckPermissionResult struct {
+	Success *CheckPermissionResponse `thrift:"success,0,optional" frugal:"0,optional,CheckPermissionResponse" json:"success,omitempty"`
+}
+
+func NewIdentityServiceCheckPermissionResult() *IdentityServiceCheckPermissionResult {
+	return &IdentityServiceCheckPermissionResult{}
+}
+
+func (p *IdentityServiceCheckPermissionResult) InitDefault() {
+}
+
+var IdentityServiceCheckPermissionResult_Success_DEFAULT *CheckPermissionResponse
+
+func (p *IdentityServiceCheckPermissionResult) GetSuccess() (v *CheckPermissionResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceCheckPermissionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceCheckPermissionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckPermissionResponse)
+}
+
+func (p *IdentityServiceCheckPermissionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceCheckPermissionResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceCheckPermissionResult(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceCheckPermissionResult = map[int16]string{
+	0: "success",
+}
+
+type IdentityServiceCheckRoleArgs struct {
+	Req *CheckRoleRequest `thrift:"req,1" frugal:"1,default,CheckRoleRequest" json:"req"`
+}
+
+func NewIdentityServiceCheckRoleArgs() *IdentityServiceCheckRoleArgs {
+	return &IdentityServiceCheckRoleArgs{}
+}
+
+func (p *IdentityServiceCheckRoleArgs) InitDefault() {
+}
+
+var IdentityServiceCheckRoleArgs_Req_DEFAULT *CheckRoleRequest
+
+func (p *IdentityServiceCheckRoleArgs) GetReq() (v *CheckRoleRequest) {
+	if !p.IsSetReq() {
+		return IdentityServiceCheckRoleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *IdentityServiceCheckRoleArgs) SetReq(val *CheckRoleRequest) {
+	p.Req = val
+}
+
+func (p *IdentityServiceCheckRoleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceCheckRoleArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceCheckRoleArgs(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceCheckRoleArgs = map[int16]string{
+	1: "req",
+}
+
+type IdentityServiceCheckRoleResult struct {
+	Success *CheckRoleResponse `thrift:"success,0,optional" frugal:"0,optional,CheckRoleResponse" json:"success,omitempty"`
+}
+
+func NewIdentityServiceCheckRoleResult() *IdentityServiceCheckRoleResult {
+	return &IdentityServiceCheckRoleResult{}
+}
+
+func (p *IdentityServiceCheckRoleResult) InitDefault() {
+}
+
+var IdentityServiceCheckRoleResult_Success_DEFAULT *CheckRoleResponse
+
+func (p *IdentityServiceCheckRoleResult) GetSuccess() (v *CheckRoleResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceCheckRoleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceCheckRoleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckRoleResponse)
+}
+
+func (p *IdentityServiceCheckRoleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceCheckRoleResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceCheckRoleResult(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceCheckRoleResult = map[int16]string{
+	0: "success",
+}
+
+type IdentityServiceGetUserEffectivePermissionsArgs struct {
+	Req *GetUserEffectivePermissionsRequest `thrift:"req,1" frugal:"1,default,GetUserEffectivePermissionsRequest" json:"req"`
+}
+
+func NewIdentityServiceGetUserEffectivePermissionsArgs() *IdentityServiceGetUserEffectivePermissionsArgs {
+	return &IdentityServiceGetUserEffectivePermissionsArgs{}
+}
+
+func (p *IdentityServiceGetUserEffectivePermissionsArgs) InitDefault() {
+}
+
+var IdentityServiceGetUserEffectivePermissionsArgs_Req_DEFAULT *GetUserEffectivePermissionsRequest
+
+func (p *IdentityServiceGetUserEffectivePermissionsArgs) GetReq() (v *GetUserEffectivePermissionsRequest) {
+	if !p.IsSetReq() {
+		return IdentityServiceGetUserEffectivePermissionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *IdentityServiceGetUserEffectivePermissionsArgs) SetReq(val *GetUserEffectivePermissionsRequest) {
+	p.Req = val
+}
+
+func (p *IdentityServiceGetUserEffectivePermissionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceGetUserEffectivePermissionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceGetUserEffectivePermissionsArgs(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceGetUserEffectivePermissionsArgs = map[int16]string{
+	1: "req",
+}
+
+type IdentityServiceGetUserEffectivePermissionsResult struct {
+	Success *GetUserEffectivePermissionsResponse `thrift:"success,0,optional" frugal:"0,optional,GetUserEffectivePermissionsResponse" json:"success,omitempty"`
+}
+
+func NewIdentityServiceGetUserEffectivePermissionsResult() *IdentityServiceGetUserEffectivePermissionsResult {
+	return &IdentityServiceGetUserEffectivePermissionsResult{}
+}
+
+func (p *IdentityServiceGetUserEffectivePermissionsResult) InitDefault() {
+}
+
+var IdentityServiceGetUserEffectivePermissionsResult_Success_DEFAULT *GetUserEffectivePermissionsResponse
+
+func (p *IdentityServiceGetUserEffectivePermissionsResult) GetSuccess() (v *GetUserEffectivePermissionsResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceGetUserEffectivePermissionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceGetUserEffectivePermissionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetUserEffectivePermissionsResponse)
+}
+
+func (p *IdentityServiceGetUserEffectivePermissionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceGetUserEffectivePermissionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceGetUserEffectivePermissionsResult(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceGetUserEffectivePermissionsResult = map[int16]string{
+	0: "success",
+}
+
+type IdentityServiceListAuditEventsArgs struct {
+	Req *ListAuditEventsRequest `thrift:"req,1" frugal:"1,default,ListAuditEventsRequest" json:"req"`
+}
+
+func NewIdentityServiceListAuditEventsArgs() *IdentityServiceListAuditEventsArgs {
+	return &IdentityServiceListAuditEventsArgs{}
+}
+
+func (p *IdentityServiceListAuditEventsArgs) InitDefault() {
+}
+
+var IdentityServiceListAuditEventsArgs_Req_DEFAULT *ListAuditEventsRequest
+
+func (p *IdentityServiceListAuditEventsArgs) GetReq() (v *ListAuditEventsRequest) {
+	if !p.IsSetReq() {
+		return IdentityServiceListAuditEventsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *IdentityServiceListAuditEventsArgs) SetReq(val *ListAuditEventsRequest) {
+	p.Req = val
+}
+
+func (p *IdentityServiceListAuditEventsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *IdentityServiceListAuditEventsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceListAuditEventsArgs(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceListAuditEventsArgs = map[int16]string{
+	1: "req",
+}
+
+type IdentityServiceListAuditEventsResult struct {
+	Success *ListAuditEventsResponse `thrift:"success,0,optional" frugal:"0,optional,ListAuditEventsResponse" json:"success,omitempty"`
+}
+
+func NewIdentityServiceListAuditEventsResult() *IdentityServiceListAuditEventsResult {
+	return &IdentityServiceListAuditEventsResult{}
+}
+
+func (p *IdentityServiceListAuditEventsResult) InitDefault() {
+}
+
+var IdentityServiceListAuditEventsResult_Success_DEFAULT *ListAuditEventsResponse
+
+func (p *IdentityServiceListAuditEventsResult) GetSuccess() (v *ListAuditEventsResponse) {
+	if !p.IsSetSuccess() {
+		return IdentityServiceListAuditEventsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *IdentityServiceListAuditEventsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListAuditEventsResponse)
+}
+
+func (p *IdentityServiceListAuditEventsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *IdentityServiceListAuditEventsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IdentityServiceListAuditEventsResult(%+v)", *p)
+}
+
+var fieldIDToName_IdentityServiceListAuditEventsResult = map[int16]string{
+	0: "success",
+}
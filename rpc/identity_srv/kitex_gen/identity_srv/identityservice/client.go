@@ -13,9 +13,15 @@ import (
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
 type Client interface {
 	Login(ctx context.Context, req *identity_srv.LoginRequest, callOptions ...callopt.Option) (r *identity_srv.LoginResponse, err error)
+	SwitchOrganization(ctx context.Context, req *identity_srv.SwitchOrganizationRequest, callOptions ...callopt.Option) (r *identity_srv.LoginResponse, err error)
 	ChangePassword(ctx context.Context, req *identity_srv.ChangePasswordRequest, callOptions ...callopt.Option) (err error)
 	ResetPassword(ctx context.Context, req *identity_srv.ResetPasswordRequest, callOptions ...callopt.Option) (err error)
 	ForcePasswordChange(ctx context.Context, req *identity_srv.ForcePasswordChangeRequest, callOptions ...callopt.Option) (err error)
+	BeginMFAEnrollment(ctx context.Context, req *identity_srv.BeginMFAEnrollmentRequest, callOptions ...callopt.Option) (r *identity_srv.BeginMFAEnrollmentResponse, err error)
+	ConfirmMFAEnrollment(ctx context.Context, req *identity_srv.MFACodeRequest, callOptions ...callopt.Option) (r *identity_srv.MFARecoveryCodesResponse, err error)
+	VerifyMFA(ctx context.Context, req *identity_srv.MFACodeRequest, callOptions ...callopt.Option) (r *identity_srv.VerifyMFAResponse, err error)
+	DisableMFA(ctx context.Context, req *identity_srv.MFACodeRequest, callOptions ...callopt.Option) (err error)
+	RegenerateMFARecoveryCodes(ctx context.Context, req *identity_srv.MFACodeRequest, callOptions ...callopt.Option) (r *identity_srv.MFARecoveryCodesResponse, err error)
 	CreateUser(ctx context.Context, req *identity_srv.CreateUserRequest, callOptions ...callopt.Option) (r *identity_srv.UserProfile, err error)
 	GetUser(ctx context.Context, req *identity_srv.GetUserRequest, callOptions ...callopt.Option) (r *identity_srv.UserProfile, err error)
 	UpdateUser(ctx context.Context, req *identity_srv.UpdateUserRequest, callOptions ...callopt.Option) (r *identity_srv.UserProfile, err error)
@@ -58,14 +64,22 @@ type Client interface {
 	GetUsersByRole(ctx context.Context, req *identity_srv.GetUsersByRoleRequest, callOptions ...callopt.Option) (r *identity_srv.GetUsersByRoleResponse, err error)
 	BatchBindUsersToRole(ctx context.Context, req *identity_srv.BatchBindUsersToRoleRequest, callOptions ...callopt.Option) (r *identity_srv.BatchBindUsersToRoleResponse, err error)
 	BatchGetUserRoles(ctx context.Context, req *identity_srv.BatchGetUserRolesRequest, callOptions ...callopt.Option) (r *identity_srv.BatchGetUserRolesResponse, err error)
-	UploadMenu(ctx context.Context, req *identity_srv.UploadMenuRequest, callOptions ...callopt.Option) (err error)
+	UploadMenu(ctx context.Context, req *identity_srv.UploadMenuRequest, callOptions ...callopt.Option) (r *identity_srv.UploadMenuResponse, err error)
 	GetMenuTree(ctx context.Context, callOptions ...callopt.Option) (r *identity_srv.GetMenuTreeResponse, err error)
+	ListMenuVersions(ctx context.Context, callOptions ...callopt.Option) (r *identity_srv.ListMenuVersionsResponse, err error)
+	DiffMenuVersions(ctx context.Context, req *identity_srv.DiffMenuVersionsRequest, callOptions ...callopt.Option) (r *identity_srv.DiffMenuVersionsResponse, err error)
+	ActivateMenuVersion(ctx context.Context, req *identity_srv.ActivateMenuVersionRequest, callOptions ...callopt.Option) (r *identity_srv.ActivateMenuVersionResponse, err error)
+	GetOrphanRoleMenuMappings(ctx context.Context, callOptions ...callopt.Option) (r *identity_srv.GetOrphanRoleMenuMappingsResponse, err error)
 	ConfigureRoleMenus(ctx context.Context, req *identity_srv.ConfigureRoleMenusRequest, callOptions ...callopt.Option) (r *identity_srv.ConfigureRoleMenusResponse, err error)
 	GetRoleMenuTree(ctx context.Context, req *identity_srv.GetRoleMenuTreeRequest, callOptions ...callopt.Option) (r *identity_srv.GetRoleMenuTreeResponse, err error)
 	GetUserMenuTree(ctx context.Context, req *identity_srv.GetUserMenuTreeRequest, callOptions ...callopt.Option) (r *identity_srv.GetUserMenuTreeResponse, err error)
 	GetRoleMenuPermissions(ctx context.Context, req *identity_srv.GetRoleMenuPermissionsRequest, callOptions ...callopt.Option) (r *identity_srv.GetRoleMenuPermissionsResponse, err error)
 	HasMenuPermission(ctx context.Context, req *identity_srv.HasMenuPermissionRequest, callOptions ...callopt.Option) (r *identity_srv.HasMenuPermissionResponse, err error)
 	GetUserMenuPermissions(ctx context.Context, req *identity_srv.GetUserMenuPermissionsRequest, callOptions ...callopt.Option) (r *identity_srv.GetUserMenuPermissionsResponse, err error)
+	CheckPermission(ctx context.Context, req *identity_srv.CheckPermissionRequest, callOptions ...callopt.Option) (r *identity_srv.CheckPermissionResponse, err error)
+	CheckRole(ctx context.Context, req *identity_srv.CheckRoleRequest, callOptions ...callopt.Option) (r *identity_srv.CheckRoleResponse, err error)
+	GetUserEffectivePermissions(ctx context.Context, req *identity_srv.GetUserEffectivePermissionsRequest, callOptions ...callopt.Option) (r *identity_srv.GetUserEffectivePermissionsResponse, err error)
+	ListAuditEvents(ctx context.Context, req *identity_srv.ListAuditEventsRequest, callOptions ...callopt.Option) (r *identity_srv.ListAuditEventsResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -102,6 +116,11 @@ func (p *kIdentityServiceClient) Login(ctx context.Context, req *identity_srv.Lo
 	return p.kClient.Login(ctx, req)
 }
 
+func (p *kIdentityServiceClient) SwitchOrganization(ctx context.Context, req *identity_srv.SwitchOrganizationRequest, callOptions ...callopt.Option) (r *identity_srv.LoginResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SwitchOrganization(ctx, req)
+}
+
 func (p *kIdentityServiceClient) ChangePassword(ctx context.Context, req *identity_srv.ChangePasswordRequest, callOptions ...callopt.Option) (err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ChangePassword(ctx, req)
@@ -117,6 +136,31 @@ func (p *kIdentityServiceClient) ForcePasswordChange(ctx context.Context, req *i
 	return p.kClient.ForcePasswordChange(ctx, req)
 }
 
+func (p *kIdentityServiceClient) BeginMFAEnrollment(ctx context.Context, req *identity_srv.BeginMFAEnrollmentRequest, callOptions ...callopt.Option) (r *identity_srv.BeginMFAEnrollmentResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BeginMFAEnrollment(ctx, req)
+}
+
+func (p *kIdentityServiceClient) ConfirmMFAEnrollment(ctx context.Context, req *identity_srv.MFACodeRequest, callOptions ...callopt.Option) (r *identity_srv.MFARecoveryCodesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ConfirmMFAEnrollment(ctx, req)
+}
+
+func (p *kIdentityServiceClient) VerifyMFA(ctx context.Context, req *identity_srv.MFACodeRequest, callOptions ...callopt.Option) (r *identity_srv.VerifyMFAResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.VerifyMFA(ctx, req)
+}
+
+func (p *kIdentityServiceClient) DisableMFA(ctx context.Context, req *identity_srv.MFACodeRequest, callOptions ...callopt.Option) (err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DisableMFA(ctx, req)
+}
+
+func (p *kIdentityServiceClient) RegenerateMFARecoveryCodes(ctx context.Context, req *identity_srv.MFACodeRequest, callOptions ...callopt.Option) (r *identity_srv.MFARecoveryCodesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RegenerateMFARecoveryCodes(ctx, req)
+}
+
 func (p *kIdentityServiceClient) CreateUser(ctx context.Context, req *identity_srv.CreateUserRequest, callOptions ...callopt.Option) (r *identity_srv.UserProfile, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.CreateUser(ctx, req)
@@ -327,7 +371,7 @@ func (p *kIdentityServiceClient) BatchGetUserRoles(ctx context.Context, req *ide
 	return p.kClient.BatchGetUserRoles(ctx, req)
 }
 
-func (p *kIdentityServiceClient) UploadMenu(ctx context.Context, req *identity_srv.UploadMenuRequest, callOptions ...callopt.Option) (err error) {
+func (p *kIdentityServiceClient) UploadMenu(ctx context.Context, req *identity_srv.UploadMenuRequest, callOptions ...callopt.Option) (r *identity_srv.UploadMenuResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.UploadMenu(ctx, req)
 }
@@ -337,6 +381,26 @@ func (p *kIdentityServiceClient) GetMenuTree(ctx context.Context, callOptions ..
 	return p.kClient.GetMenuTree(ctx)
 }
 
+func (p *kIdentityServiceClient) ListMenuVersions(ctx context.Context, callOptions ...callopt.Option) (r *identity_srv.ListMenuVersionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListMenuVersions(ctx)
+}
+
+func (p *kIdentityServiceClient) DiffMenuVersions(ctx context.Context, req *identity_srv.DiffMenuVersionsRequest, callOptions ...callopt.Option) (r *identity_srv.DiffMenuVersionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DiffMenuVersions(ctx, req)
+}
+
+func (p *kIdentityServiceClient) ActivateMenuVersion(ctx context.Context, req *identity_srv.ActivateMenuVersionRequest, callOptions ...callopt.Option) (r *identity_srv.ActivateMenuVersionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ActivateMenuVersion(ctx, req)
+}
+
+func (p *kIdentityServiceClient) GetOrphanRoleMenuMappings(ctx context.Context, callOptions ...callopt.Option) (r *identity_srv.GetOrphanRoleMenuMappingsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetOrphanRoleMenuMappings(ctx)
+}
+
 func (p *kIdentityServiceClient) ConfigureRoleMenus(ctx context.Context, req *identity_srv.ConfigureRoleMenusRequest, callOptions ...callopt.Option) (r *identity_srv.ConfigureRoleMenusResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ConfigureRoleMenus(ctx, req)
@@ -366,3 +430,23 @@ func (p *kIdentityServiceClient) GetUserMenuPermissions(ctx context.Context, req
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetUserMenuPermissions(ctx, req)
 }
+
+func (p *kIdentityServiceClient) CheckPermission(ctx context.Context, req *identity_srv.CheckPermissionRequest, callOptions ...callopt.Option) (r *identity_srv.CheckPermissionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CheckPermission(ctx, req)
+}
+
+func (p *kIdentityServiceClient) CheckRole(ctx context.Context, req *identity_srv.CheckRoleRequest, callOptions ...callopt.Option) (r *identity_srv.CheckRoleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CheckRole(ctx, req)
+}
+
+func (p *kIdentityServiceClient) GetUserEffectivePermissions(ctx context.Context, req *identity_srv.GetUserEffectivePermissionsRequest, callOptions ...callopt.Option) (r *identity_srv.GetUserEffectivePermissionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetUserEffectivePermissions(ctx, req)
+}
+
+func (p *kIdentityServiceClient) ListAuditEvents(ctx context.Context, req *identity_srv.ListAuditEventsRequest, callOptions ...callopt.Option) (r *identity_srv.ListAuditEventsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAuditEvents(ctx, req)
+}
@@ -21,6 +21,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"SwitchOrganization": kitex.NewMethodInfo(
+		switchOrganizationHandler,
+		newIdentityServiceSwitchOrganizationArgs,
+		newIdentityServiceSwitchOrganizationResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"ChangePassword": kitex.NewMethodInfo(
 		changePasswordHandler,
 		newIdentityServiceChangePasswordArgs,
@@ -42,6 +49,41 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"BeginMFAEnrollment": kitex.NewMethodInfo(
+		beginMFAEnrollmentHandler,
+		newIdentityServiceBeginMFAEnrollmentArgs,
+		newIdentityServiceBeginMFAEnrollmentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ConfirmMFAEnrollment": kitex.NewMethodInfo(
+		confirmMFAEnrollmentHandler,
+		newIdentityServiceConfirmMFAEnrollmentArgs,
+		newIdentityServiceConfirmMFAEnrollmentResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"VerifyMFA": kitex.NewMethodInfo(
+		verifyMFAHandler,
+		newIdentityServiceVerifyMFAArgs,
+		newIdentityServiceVerifyMFAResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DisableMFA": kitex.NewMethodInfo(
+		disableMFAHandler,
+		newIdentityServiceDisableMFAArgs,
+		newIdentityServiceDisableMFAResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RegenerateMFARecoveryCodes": kitex.NewMethodInfo(
+		regenerateMFARecoveryCodesHandler,
+		newIdentityServiceRegenerateMFARecoveryCodesArgs,
+		newIdentityServiceRegenerateMFARecoveryCodesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"CreateUser": kitex.NewMethodInfo(
 		createUserHandler,
 		newIdentityServiceCreateUserArgs,
@@ -350,6 +392,34 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ListMenuVersions": kitex.NewMethodInfo(
+		listMenuVersionsHandler,
+		newIdentityServiceListMenuVersionsArgs,
+		newIdentityServiceListMenuVersionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DiffMenuVersions": kitex.NewMethodInfo(
+		diffMenuVersionsHandler,
+		newIdentityServiceDiffMenuVersionsArgs,
+		newIdentityServiceDiffMenuVersionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ActivateMenuVersion": kitex.NewMethodInfo(
+		activateMenuVersionHandler,
+		newIdentityServiceActivateMenuVersionArgs,
+		newIdentityServiceActivateMenuVersionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetOrphanRoleMenuMappings": kitex.NewMethodInfo(
+		getOrphanRoleMenuMappingsHandler,
+		newIdentityServiceGetOrphanRoleMenuMappingsArgs,
+		newIdentityServiceGetOrphanRoleMenuMappingsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 	"ConfigureRoleMenus": kitex.NewMethodInfo(
 		configureRoleMenusHandler,
 		newIdentityServiceConfigureRoleMenusArgs,
@@ -392,6 +462,34 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"CheckPermission": kitex.NewMethodInfo(
+		checkPermissionHandler,
+		newIdentityServiceCheckPermissionArgs,
+		newIdentityServiceCheckPermissionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CheckRole": kitex.NewMethodInfo(
+		checkRoleHandler,
+		newIdentityServiceCheckRoleArgs,
+		newIdentityServiceCheckRoleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetUserEffectivePermissions": kitex.NewMethodInfo(
+		getUserEffectivePermissionsHandler,
+		newIdentityServiceGetUserEffectivePermissionsArgs,
+		newIdentityServiceGetUserEffectivePermissionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListAuditEvents": kitex.NewMethodInfo(
+		listAuditEventsHandler,
+		newIdentityServiceListAuditEventsArgs,
+		newIdentityServiceListAuditEventsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -476,6 +574,24 @@ func newIdentityServiceLoginResult() interface{} {
 	return identity_srv.NewIdentityServiceLoginResult()
 }
 
+func switchOrganizationHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*identity_srv.IdentityServiceSwitchOrganizationArgs)
+	realResult := result.(*identity_srv.IdentityServiceSwitchOrganizationResult)
+	success, err := handler.(identity_srv.IdentityService).SwitchOrganization(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIdentityServiceSwitchOrganizationArgs() interface{} {
+	return identity_srv.NewIdentityServiceSwitchOrganizationArgs()
+}
+
+func newIdentityServiceSwitchOrganizationResult() interface{} {
+	return identity_srv.NewIdentityServiceSwitchOrganizationResult()
+}
+
 func changePasswordHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*identity_srv.IdentityServiceChangePasswordArgs)
 
@@ -530,6 +646,96 @@ func newIdentityServiceForcePasswordChangeResult() interface{} {
 	return identity_srv.NewIdentityServiceForcePasswordChangeResult()
 }
 
+func beginMFAEnrollmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*identity_srv.IdentityServiceBeginMFAEnrollmentArgs)
+	realResult := result.(*identity_srv.IdentityServiceBeginMFAEnrollmentResult)
+	success, err := handler.(identity_srv.IdentityService).BeginMFAEnrollment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIdentityServiceBeginMFAEnrollmentArgs() interface{} {
+	return identity_srv.NewIdentityServiceBeginMFAEnrollmentArgs()
+}
+
+func newIdentityServiceBeginMFAEnrollmentResult() interface{} {
+	return identity_srv.NewIdentityServiceBeginMFAEnrollmentResult()
+}
+
+func confirmMFAEnrollmentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*identity_srv.IdentityServiceConfirmMFAEnrollmentArgs)
+	realResult := result.(*identity_srv.IdentityServiceConfirmMFAEnrollmentResult)
+	success, err := handler.(identity_srv.IdentityService).ConfirmMFAEnrollment(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIdentityServiceConfirmMFAEnrollmentArgs() interface{} {
+	return identity_srv.NewIdentityServiceConfirmMFAEnrollmentArgs()
+}
+
+func newIdentityServiceConfirmMFAEnrollmentResult() interface{} {
+	return identity_srv.NewIdentityServiceConfirmMFAEnrollmentResult()
+}
+
+func verifyMFAHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*identity_srv.IdentityServiceVerifyMFAArgs)
+	realResult := result.(*identity_srv.IdentityServiceVerifyMFAResult)
+	success, err := handler.(identity_srv.IdentityService).VerifyMFA(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIdentityServiceVerifyMFAArgs() interface{} {
+	return identity_srv.NewIdentityServiceVerifyMFAArgs()
+}
+
+func newIdentityServiceVerifyMFAResult() interface{} {
+	return identity_srv.NewIdentityServiceVerifyMFAResult()
+}
+
+func disableMFAHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*identity_srv.IdentityServiceDisableMFAArgs)
+
+	err := handler.(identity_srv.IdentityService).DisableMFA(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+func newIdentityServiceDisableMFAArgs() interface{} {
+	return identity_srv.NewIdentityServiceDisableMFAArgs()
+}
+
+func newIdentityServiceDisableMFAResult() interface{} {
+	return identity_srv.NewIdentityServiceDisableMFAResult()
+}
+
+func regenerateMFARecoveryCodesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*identity_srv.IdentityServiceRegenerateMFARecoveryCodesArgs)
+	realResult := result.(*identity_srv.IdentityServiceRegenerateMFARecoveryCodesResult)
+	success, err := handler.(identity_srv.IdentityService).RegenerateMFARecoveryCodes(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIdentityServiceRegenerateMFARecoveryCodesArgs() interface{} {
+	return identity_srv.NewIdentityServiceRegenerateMFARecoveryCodesArgs()
+}
+
+func newIdentityServiceRegenerateMFARecoveryCodesResult() interface{} {
+	return identity_srv.NewIdentityServiceRegenerateMFARecoveryCodesResult()
+}
+
 func createUserHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*identity_srv.IdentityServiceCreateUserArgs)
 	realResult := result.(*identity_srv.IdentityServiceCreateUserResult)
@@ -1288,12 +1494,12 @@ func newIdentityServiceBatchGetUserRolesResult() interface{} {
 
 func uploadMenuHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*identity_srv.IdentityServiceUploadMenuArgs)
-
-	err := handler.(identity_srv.IdentityService).UploadMenu(ctx, realArg.Req)
+	realResult := result.(*identity_srv.IdentityServiceUploadMenuResult)
+	success, err := handler.(identity_srv.IdentityService).UploadMenu(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
-
+	realResult.Success = success
 	return nil
 }
 func newIdentityServiceUploadMenuArgs() interface{} {
@@ -1322,6 +1528,78 @@ func newIdentityServiceGetMenuTreeResult() interface{} {
 	return identity_srv.NewIdentityServiceGetMenuTreeResult()
 }
 
+func listMenuVersionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	_ = arg.(*identity_srv.IdentityServiceListMenuVersionsArgs)
+	realResult := result.(*identity_srv.IdentityServiceListMenuVersionsResult)
+	success, err := handler.(identity_srv.IdentityService).ListMenuVersions(ctx)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIdentityServiceListMenuVersionsArgs() interface{} {
+	return identity_srv.NewIdentityServiceListMenuVersionsArgs()
+}
+
+func newIdentityServiceListMenuVersionsResult() interface{} {
+	return identity_srv.NewIdentityServiceListMenuVersionsResult()
+}
+
+func diffMenuVersionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*identity_srv.IdentityServiceDiffMenuVersionsArgs)
+	realResult := result.(*identity_srv.IdentityServiceDiffMenuVersionsResult)
+	success, err := handler.(identity_srv.IdentityService).DiffMenuVersions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIdentityServiceDiffMenuVersionsArgs() interface{} {
+	return identity_srv.NewIdentityServiceDiffMenuVersionsArgs()
+}
+
+func newIdentityServiceDiffMenuVersionsResult() interface{} {
+	return identity_srv.NewIdentityServiceDiffMenuVersionsResult()
+}
+
+func activateMenuVersionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*identity_srv.IdentityServiceActivateMenuVersionArgs)
+	realResult := result.(*identity_srv.IdentityServiceActivateMenuVersionResult)
+	success, err := handler.(identity_srv.IdentityService).ActivateMenuVersion(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIdentityServiceActivateMenuVersionArgs() interface{} {
+	return identity_srv.NewIdentityServiceActivateMenuVersionArgs()
+}
+
+func newIdentityServiceActivateMenuVersionResult() interface{} {
+	return identity_srv.NewIdentityServiceActivateMenuVersionResult()
+}
+
+func getOrphanRoleMenuMappingsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	_ = arg.(*identity_srv.IdentityServiceGetOrphanRoleMenuMappingsArgs)
+	realResult := result.(*identity_srv.IdentityServiceGetOrphanRoleMenuMappingsResult)
+	success, err := handler.(identity_srv.IdentityService).GetOrphanRoleMenuMappings(ctx)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIdentityServiceGetOrphanRoleMenuMappingsArgs() interface{} {
+	return identity_srv.NewIdentityServiceGetOrphanRoleMenuMappingsArgs()
+}
+
+func newIdentityServiceGetOrphanRoleMenuMappingsResult() interface{} {
+	return identity_srv.NewIdentityServiceGetOrphanRoleMenuMappingsResult()
+}
+
 func configureRoleMenusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*identity_srv.IdentityServiceConfigureRoleMenusArgs)
 	realResult := result.(*identity_srv.IdentityServiceConfigureRoleMenusResult)
@@ -1430,6 +1708,78 @@ func newIdentityServiceGetUserMenuPermissionsResult() interface{} {
 	return identity_srv.NewIdentityServiceGetUserMenuPermissionsResult()
 }
 
+func checkPermissionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*identity_srv.IdentityServiceCheckPermissionArgs)
+	realResult := result.(*identity_srv.IdentityServiceCheckPermissionResult)
+	success, err := handler.(identity_srv.IdentityService).CheckPermission(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIdentityServiceCheckPermissionArgs() interface{} {
+	return identity_srv.NewIdentityServiceCheckPermissionArgs()
+}
+
+func newIdentityServiceCheckPermissionResult() interface{} {
+	return identity_srv.NewIdentityServiceCheckPermissionResult()
+}
+
+func checkRoleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*identity_srv.IdentityServiceCheckRoleArgs)
+	realResult := result.(*identity_srv.IdentityServiceCheckRoleResult)
+	success, err := handler.(identity_srv.IdentityService).CheckRole(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIdentityServiceCheckRoleArgs() interface{} {
+	return identity_srv.NewIdentityServiceCheckRoleArgs()
+}
+
+func newIdentityServiceCheckRoleResult() interface{} {
+	return identity_srv.NewIdentityServiceCheckRoleResult()
+}
+
+func getUserEffectivePermissionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*identity_srv.IdentityServiceGetUserEffectivePermissionsArgs)
+	realResult := result.(*identity_srv.IdentityServiceGetUserEffectivePermissionsResult)
+	success, err := handler.(identity_srv.IdentityService).GetUserEffectivePermissions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIdentityServiceGetUserEffectivePermissionsArgs() interface{} {
+	return identity_srv.NewIdentityServiceGetUserEffectivePermissionsArgs()
+}
+
+func newIdentityServiceGetUserEffectivePermissionsResult() interface{} {
+	return identity_srv.NewIdentityServiceGetUserEffectivePermissionsResult()
+}
+
+func listAuditEventsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*identity_srv.IdentityServiceListAuditEventsArgs)
+	realResult := result.(*identity_srv.IdentityServiceListAuditEventsResult)
+	success, err := handler.(identity_srv.IdentityService).ListAuditEvents(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newIdentityServiceListAuditEventsArgs() interface{} {
+	return identity_srv.NewIdentityServiceListAuditEventsArgs()
+}
+
+func newIdentityServiceListAuditEventsResult() interface{} {
+	return identity_srv.NewIdentityServiceListAuditEventsResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -1450,6 +1800,16 @@ func (p *kClient) Login(ctx context.Context, req *identity_srv.LoginRequest) (r
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) SwitchOrganization(ctx context.Context, req *identity_srv.SwitchOrganizationRequest) (r *identity_srv.LoginResponse, err error) {
+	var _args identity_srv.IdentityServiceSwitchOrganizationArgs
+	_args.Req = req
+	var _result identity_srv.IdentityServiceSwitchOrganizationResult
+	if err = p.c.Call(ctx, "SwitchOrganization", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) ChangePassword(ctx context.Context, req *identity_srv.ChangePasswordRequest) (err error) {
 	var _args identity_srv.IdentityServiceChangePasswordArgs
 	_args.Req = req
@@ -1480,6 +1840,56 @@ func (p *kClient) ForcePasswordChange(ctx context.Context, req *identity_srv.For
 	return nil
 }
 
+func (p *kClient) BeginMFAEnrollment(ctx context.Context, req *identity_srv.BeginMFAEnrollmentRequest) (r *identity_srv.BeginMFAEnrollmentResponse, err error) {
+	var _args identity_srv.IdentityServiceBeginMFAEnrollmentArgs
+	_args.Req = req
+	var _result identity_srv.IdentityServiceBeginMFAEnrollmentResult
+	if err = p.c.Call(ctx, "BeginMFAEnrollment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ConfirmMFAEnrollment(ctx context.Context, req *identity_srv.MFACodeRequest) (r *identity_srv.MFARecoveryCodesResponse, err error) {
+	var _args identity_srv.IdentityServiceConfirmMFAEnrollmentArgs
+	_args.Req = req
+	var _result identity_srv.IdentityServiceConfirmMFAEnrollmentResult
+	if err = p.c.Call(ctx, "ConfirmMFAEnrollment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) VerifyMFA(ctx context.Context, req *identity_srv.MFACodeRequest) (r *identity_srv.VerifyMFAResponse, err error) {
+	var _args identity_srv.IdentityServiceVerifyMFAArgs
+	_args.Req = req
+	var _result identity_srv.IdentityServiceVerifyMFAResult
+	if err = p.c.Call(ctx, "VerifyMFA", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DisableMFA(ctx context.Context, req *identity_srv.MFACodeRequest) (err error) {
+	var _args identity_srv.IdentityServiceDisableMFAArgs
+	_args.Req = req
+	var _result identity_srv.IdentityServiceDisableMFAResult
+	if err = p.c.Call(ctx, "DisableMFA", &_args, &_result); err != nil {
+		return
+	}
+	return nil
+}
+
+func (p *kClient) RegenerateMFARecoveryCodes(ctx context.Context, req *identity_srv.MFACodeRequest) (r *identity_srv.MFARecoveryCodesResponse, err error) {
+	var _args identity_srv.IdentityServiceRegenerateMFARecoveryCodesArgs
+	_args.Req = req
+	var _result identity_srv.IdentityServiceRegenerateMFARecoveryCodesResult
+	if err = p.c.Call(ctx, "RegenerateMFARecoveryCodes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) CreateUser(ctx context.Context, req *identity_srv.CreateUserRequest) (r *identity_srv.UserProfile, err error) {
 	var _args identity_srv.IdentityServiceCreateUserArgs
 	_args.Req = req
@@ -1900,14 +2310,14 @@ func (p *kClient) BatchGetUserRoles(ctx context.Context, req *identity_srv.Batch
 	return _result.GetSuccess(), nil
 }
 
-func (p *kClient) UploadMenu(ctx context.Context, req *identity_srv.UploadMenuRequest) (err error) {
+func (p *kClient) UploadMenu(ctx context.Context, req *identity_srv.UploadMenuRequest) (r *identity_srv.UploadMenuResponse, err error) {
 	var _args identity_srv.IdentityServiceUploadMenuArgs
 	_args.Req = req
 	var _result identity_srv.IdentityServiceUploadMenuResult
 	if err = p.c.Call(ctx, "UploadMenu", &_args, &_result); err != nil {
 		return
 	}
-	return nil
+	return _result.GetSuccess(), nil
 }
 
 func (p *kClient) GetMenuTree(ctx context.Context) (r *identity_srv.GetMenuTreeResponse, err error) {
@@ -1919,6 +2329,44 @@ func (p *kClient) GetMenuTree(ctx context.Context) (r *identity_srv.GetMenuTreeR
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) ListMenuVersions(ctx context.Context) (r *identity_srv.ListMenuVersionsResponse, err error) {
+	var _args identity_srv.IdentityServiceListMenuVersionsArgs
+	var _result identity_srv.IdentityServiceListMenuVersionsResult
+	if err = p.c.Call(ctx, "ListMenuVersions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DiffMenuVersions(ctx context.Context, req *identity_srv.DiffMenuVersionsRequest) (r *identity_srv.DiffMenuVersionsResponse, err error) {
+	var _args identity_srv.IdentityServiceDiffMenuVersionsArgs
+	_args.Req = req
+	var _result identity_srv.IdentityServiceDiffMenuVersionsResult
+	if err = p.c.Call(ctx, "DiffMenuVersions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ActivateMenuVersion(ctx context.Context, req *identity_srv.ActivateMenuVersionRequest) (r *identity_srv.ActivateMenuVersionResponse, err error) {
+	var _args identity_srv.IdentityServiceActivateMenuVersionArgs
+	_args.Req = req
+	var _result identity_srv.IdentityServiceActivateMenuVersionResult
+	if err = p.c.Call(ctx, "ActivateMenuVersion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetOrphanRoleMenuMappings(ctx context.Context) (r *identity_srv.GetOrphanRoleMenuMappingsResponse, err error) {
+	var _args identity_srv.IdentityServiceGetOrphanRoleMenuMappingsArgs
+	var _result identity_srv.IdentityServiceGetOrphanRoleMenuMappingsResult
+	if err = p.c.Call(ctx, "GetOrphanRoleMenuMappings", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) ConfigureRoleMenus(ctx context.Context, req *identity_srv.ConfigureRoleMenusRequest) (r *identity_srv.ConfigureRoleMenusResponse, err error) {
 	var _args identity_srv.IdentityServiceConfigureRoleMenusArgs
 	_args.Req = req
@@ -1978,3 +2426,43 @@ func (p *kClient) GetUserMenuPermissions(ctx context.Context, req *identity_srv.
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) CheckPermission(ctx context.Context, req *identity_srv.CheckPermissionRequest) (r *identity_srv.CheckPermissionResponse, err error) {
+	var _args identity_srv.IdentityServiceCheckPermissionArgs
+	_args.Req = req
+	var _result identity_srv.IdentityServiceCheckPermissionResult
+	if err = p.c.Call(ctx, "CheckPermission", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CheckRole(ctx context.Context, req *identity_srv.CheckRoleRequest) (r *identity_srv.CheckRoleResponse, err error) {
+	var _args identity_srv.IdentityServiceCheckRoleArgs
+	_args.Req = req
+	var _result identity_srv.IdentityServiceCheckRoleResult
+	if err = p.c.Call(ctx, "CheckRole", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetUserEffectivePermissions(ctx context.Context, req *identity_srv.GetUserEffectivePermissionsRequest) (r *identity_srv.GetUserEffectivePermissionsResponse, err error) {
+	var _args identity_srv.IdentityServiceGetUserEffectivePermissionsArgs
+	_args.Req = req
+	var _result identity_srv.IdentityServiceGetUserEffectivePermissionsResult
+	if err = p.c.Call(ctx, "GetUserEffectivePermissions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAuditEvents(ctx context.Context, req *identity_srv.ListAuditEventsRequest) (r *identity_srv.ListAuditEventsResponse, err error) {
+	var _args identity_srv.IdentityServiceListAuditEventsArgs
+	_args.Req = req
+	var _result identity_srv.IdentityServiceListAuditEventsResult
+	if err = p.c.Call(ctx, "ListAuditEvents", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
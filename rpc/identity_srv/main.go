@@ -7,15 +7,19 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/cloudwego/kitex/pkg/rpcinfo"
 	"github.com/cloudwego/kitex/pkg/transmeta"
 	"github.com/cloudwego/kitex/server"
 	etcd "github.com/kitex-contrib/registry-etcd"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/casbin"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/config"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/internal/middleware"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/internal/tracing"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/kitex_gen/identity_srv/identityservice"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/pkg/metrics"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/wire"
 )
 
@@ -80,13 +84,74 @@ func checkDatabase(db *sql.DB) error {
 	return db.PingContext(ctx)
 }
 
+// reconcilePolicies 启动时以角色分配表、角色定义为准核对 Casbin 用户角色分组策略与角色权限策略
+// report 模式仅记录差异；fix 模式同时修复差异并重新加载内存策略
+func reconcilePolicies(mode string, svc *wire.ServiceWithDB) error {
+	switch mode {
+	case casbin.ReconcileModeOff, "":
+		return nil
+	case casbin.ReconcileModeReport, casbin.ReconcileModeFix:
+	default:
+		return fmt.Errorf("unsupported reconcile mode %q", mode)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	drift, err := svc.Casbin.ReconcileUserRoles(ctx, svc.DAL, mode == casbin.ReconcileModeFix)
+	if err != nil {
+		return err
+	}
+
+	if !drift.IsEmpty() {
+		log.Printf("Casbin user role drift detected: missing=%d, extra=%d, mode=%s",
+			len(drift.Missing), len(drift.Extra), mode)
+	}
+
+	permDrift, err := svc.Casbin.ReconcileRolePermissions(ctx, svc.DAL, mode == casbin.ReconcileModeFix)
+	if err != nil {
+		return err
+	}
+
+	if !permDrift.IsEmpty() {
+		log.Printf("Casbin role permission drift detected: missing=%d, extra=%d, mode=%s",
+			len(permDrift.Missing), len(permDrift.Extra), mode)
+	}
+
+	return nil
+}
+
 func main() {
+	// migrate 子命令：执行、回滚或查看数据库迁移后直接退出，不启动服务
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrateCommand(os.Args[2:]); err != nil {
+			log.Fatalf("migrate: %v", err)
+		}
+
+		return
+	}
+
 	// 1. 加载配置
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		log.Fatalf("failed to load config: %v", err)
 	}
 
+	// 初始化链路追踪（需先于数据库与 RPC 服务初始化）
+	if cfg.Tracing.Enabled {
+		shutdown, err := tracing.InitTracing(context.Background(), &cfg.Tracing, nil)
+		if err != nil {
+			log.Fatalf("failed to init tracing: %v", err)
+		}
+
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			_ = shutdown(ctx)
+		}()
+	}
+
 	// 在独立的 goroutine 中启动健康检查服务器
 	// 使用不同的端口进行健康检查是一个最佳实践
 	go runHealthCheckServer(cfg.HealthCheck.Port)
@@ -105,6 +170,29 @@ func main() {
 
 	dbForHealthCheck = sqlDB
 
+	// 退出时停止多实例策略同步
+	defer serviceWithDB.Casbin.Close()
+
+	// 核对 Casbin 用户角色分组策略与角色分配表、角色权限策略与角色定义
+	if err := reconcilePolicies(cfg.Casbin.ReconcileOnStartup, serviceWithDB); err != nil {
+		log.Fatalf("failed to reconcile casbin policies: %v", err)
+	}
+
+	// 在独立端口暴露 Prometheus 指标（含数据库连接池指标）
+	if cfg.Metrics.Enabled {
+		if err := metrics.RegisterDBStats(sqlDB, cfg.Database.DBName); err != nil {
+			log.Fatalf("failed to register db stats collector: %v", err)
+		}
+
+		go func() {
+			log.Printf("Metrics server starting on port %d", cfg.Metrics.Port)
+
+			if err := metrics.StartServer(cfg.Metrics.Port, cfg.Metrics.Path); err != nil {
+				log.Printf("metrics server stopped with error: %v", err)
+			}
+		}()
+	}
+
 	// 3. 配置并启动服务器
 	// 解析监听地址
 	addr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port))
@@ -127,18 +215,46 @@ func main() {
 		log.Fatalf("failed to initialize logger: %v", err)
 	}
 
+	// 启动后台维护任务（过期临时Logo清理、软删除数据物理清理、账户到期提醒、角色分配有效期同步）
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	if cfg.Scheduler.Enabled {
+		jobScheduler, err := newScheduler(&cfg.Scheduler, serviceWithDB, logger)
+		if err != nil {
+			log.Fatalf("failed to create job scheduler: %v", err)
+		}
+
+		go jobScheduler.Run(jobCtx)
+	}
+
 	// 创建MetaInfo中间件
 	metaMiddleware := middleware.NewMetaInfoMiddleware(logger)
 
-	// 创建并配置 Kitex Server
-	svr := identityservice.NewServer(
-		serviceImpl,
+	serverOptions := []server.Option{
 		server.WithServerBasicInfo(&rpcinfo.EndpointBasicInfo{ServiceName: serviceName}),
 		server.WithRegistry(r),
 		server.WithServiceAddr(addr),
 		server.WithMetaHandler(transmeta.ServerTTHeaderHandler),
 		server.WithMiddleware(metaMiddleware.ServerMiddleware()),
-	)
+		// 审计中间件依赖 MetaInfo 中间件补全的 request_id，需在其后注册
+		server.WithMiddleware(middleware.NewAuditMiddleware(serviceWithDB.DAL, logger).ServerMiddleware()),
+	}
+
+	// 创建链路追踪中间件，为每次调用创建服务端 Span 并衔接上游链路
+	if cfg.Tracing.Enabled {
+		serverOptions = append(serverOptions,
+			server.WithMiddleware(middleware.NewTracingMiddleware().ServerMiddleware()))
+	}
+
+	// 创建RPC指标中间件
+	if cfg.Metrics.Enabled {
+		serverOptions = append(serverOptions,
+			server.WithMiddleware(middleware.NewMetricsMiddleware().ServerMiddleware()))
+	}
+
+	// 创建并配置 Kitex Server
+	svr := identityservice.NewServer(serviceImpl, serverOptions...)
 
 	log.Printf("Identity service starting on %s", addr.String())
 
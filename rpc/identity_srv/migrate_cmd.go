@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/config"
+)
+
+// migrateCommandTimeout migrate 子命令的超时时间（含等待其他实例释放迁移锁）
+const migrateCommandTimeout = 10 * time.Minute
+
+// migrateUsage migrate 子命令用法说明
+const migrateUsage = `用法: identity_srv migrate <command>
+
+命令:
+  up          执行全部未执行的迁移
+  down [N]    回滚最近执行的 N 个迁移（默认 1）
+  status      查看迁移执行状态
+`
+
+// runMigrateCommand 执行 migrate 子命令
+// 与服务启动使用相同的配置加载方式，只建立数据库连接，不执行种子数据初始化
+func runMigrateCommand(args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprint(os.Stderr, migrateUsage) }
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return fmt.Errorf("缺少迁移命令")
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	logger, err := config.CreateLogger(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	db, err := config.OpenDB(&cfg.Database, &cfg.Server, logger)
+	if err != nil {
+		return err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get sql.DB from gorm: %w", err)
+	}
+	defer sqlDB.Close()
+
+	migrator, err := config.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), migrateCommandTimeout)
+	defer cancel()
+
+	switch command := fs.Arg(0); command {
+	case "up":
+		executed, err := migrator.Up(ctx)
+		for _, migration := range executed {
+			fmt.Printf("applied  %04d_%s\n", migration.Version, migration.Name)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(executed) == 0 {
+			fmt.Println("数据库已是最新版本")
+		}
+
+		return nil
+	case "down":
+		steps := 1
+
+		if fs.NArg() > 1 {
+			steps, err = strconv.Atoi(fs.Arg(1))
+			if err != nil || steps <= 0 {
+				return fmt.Errorf("回滚步数必须为正整数: %s", fs.Arg(1))
+			}
+		}
+
+		reverted, err := migrator.Down(ctx, steps)
+		for _, migration := range reverted {
+			fmt.Printf("reverted %04d_%s\n", migration.Version, migration.Name)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(reverted) == 0 {
+			fmt.Println("没有可回滚的迁移")
+		}
+
+		return nil
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+
+		for _, status := range statuses {
+			state, appliedAt := "pending", "-"
+
+			if status.Applied {
+				state = "applied"
+				appliedAt = time.UnixMilli(status.AppliedAt).Format(time.RFC3339)
+			}
+
+			if status.Unknown {
+				state = "applied (unknown to this build)"
+			}
+
+			fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+		}
+
+		return w.Flush()
+	default:
+		fs.Usage()
+		return fmt.Errorf("未知的迁移命令: %s", command)
+	}
+}
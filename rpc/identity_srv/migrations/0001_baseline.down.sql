@@ -0,0 +1,11 @@
+-- 回滚基线结构会删除全部业务数据，仅用于开发与测试环境重建数据库
+
+DROP TABLE IF EXISTS "casbin_rule";
+DROP TABLE IF EXISTS "menus";
+DROP TABLE IF EXISTS "user_role_assignments";
+DROP TABLE IF EXISTS "role_definitions";
+DROP TABLE IF EXISTS "organization_logos";
+DROP TABLE IF EXISTS "departments";
+DROP TABLE IF EXISTS "organizations";
+DROP TABLE IF EXISTS "user_memberships";
+DROP TABLE IF EXISTS "user_profiles";
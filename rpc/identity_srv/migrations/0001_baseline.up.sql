@@ -0,0 +1,336 @@
+-- 基线结构：与此前 GORM AutoMigrate 创建的表结构完全一致，后续新增的表与列由之后的迁移补齐
+-- 所有语句均可重复执行，已由 AutoMigrate 建表的数据库执行本迁移时不会产生结构变更，仅登记版本
+-- 分布式系统中不使用数据库外键约束，通过应用层维护数据一致性（menus 的自关联约束沿用 AutoMigrate 的历史结构）
+
+-- user_profiles
+CREATE TABLE IF NOT EXISTS "user_profiles" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "username" varchar(20) NOT NULL,
+    "password_hash" varchar(255) NOT NULL,
+    "email" varchar(255),
+    "phone" varchar(20),
+    "is_system_user" boolean NOT NULL DEFAULT false,
+    "first_name" varchar(50),
+    "last_name" varchar(50),
+    "real_name" varchar(100),
+    "gender" integer DEFAULT 0,
+    "professional_title" varchar(100),
+    "license_number" varchar(100),
+    "specialties" text,
+    "employee_id" varchar(50),
+    "status" integer NOT NULL DEFAULT 2,
+    "login_attempts" integer NOT NULL DEFAULT 0,
+    "must_change_password" boolean NOT NULL DEFAULT false,
+    "account_expiry" bigint,
+    "created_by" uuid,
+    "updated_by" uuid,
+    "last_login_time" bigint,
+    "version" integer NOT NULL DEFAULT 1,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_status" ON "user_profiles" ("status");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_employee_id" ON "user_profiles" ("employee_id");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_license_number" ON "user_profiles" ("license_number");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_real_name" ON "user_profiles" ("real_name");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_is_system_user" ON "user_profiles" ("is_system_user");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_phone" ON "user_profiles" ("phone");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_email" ON "user_profiles" ("email");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_user_profiles_username" ON "user_profiles" ("username");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_deleted_at" ON "user_profiles" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_updated_at" ON "user_profiles" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_user_profiles_created_at" ON "user_profiles" ("created_at");
+COMMENT ON COLUMN "user_profiles"."id" IS '主键';
+COMMENT ON COLUMN "user_profiles"."created_at" IS '创建时间';
+COMMENT ON COLUMN "user_profiles"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "user_profiles"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "user_profiles"."username" IS '用户名，唯一索引';
+COMMENT ON COLUMN "user_profiles"."password_hash" IS '密码哈希';
+COMMENT ON COLUMN "user_profiles"."email" IS '邮箱，索引';
+COMMENT ON COLUMN "user_profiles"."phone" IS '手机号，索引';
+COMMENT ON COLUMN "user_profiles"."is_system_user" IS '是否为系统内置用户';
+COMMENT ON COLUMN "user_profiles"."first_name" IS '名';
+COMMENT ON COLUMN "user_profiles"."last_name" IS '姓';
+COMMENT ON COLUMN "user_profiles"."real_name" IS '真实姓名，索引';
+COMMENT ON COLUMN "user_profiles"."gender" IS '性别';
+COMMENT ON COLUMN "user_profiles"."professional_title" IS '专业标题';
+COMMENT ON COLUMN "user_profiles"."license_number" IS '许可证号，索引';
+COMMENT ON COLUMN "user_profiles"."specialties" IS '专业专长';
+COMMENT ON COLUMN "user_profiles"."employee_id" IS '员工ID，索引';
+COMMENT ON COLUMN "user_profiles"."status" IS '用户状态';
+COMMENT ON COLUMN "user_profiles"."login_attempts" IS '登录尝试次数';
+COMMENT ON COLUMN "user_profiles"."must_change_password" IS '是否必须修改密码';
+COMMENT ON COLUMN "user_profiles"."account_expiry" IS '账户过期时间';
+COMMENT ON COLUMN "user_profiles"."created_by" IS '创建者ID';
+COMMENT ON COLUMN "user_profiles"."updated_by" IS '更新者ID';
+COMMENT ON COLUMN "user_profiles"."last_login_time" IS '最后登录时间';
+COMMENT ON COLUMN "user_profiles"."version" IS '版本号';
+
+-- user_memberships
+CREATE TABLE IF NOT EXISTS "user_memberships" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "user_id" uuid NOT NULL,
+    "organization_id" uuid NOT NULL,
+    "department_id" uuid,
+    "status" integer NOT NULL DEFAULT 1,
+    "is_primary" boolean NOT NULL DEFAULT false,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_user_memberships_is_primary" ON "user_memberships" ("is_primary");
+CREATE INDEX IF NOT EXISTS "idx_user_memberships_status" ON "user_memberships" ("status");
+CREATE INDEX IF NOT EXISTS "idx_dept_memberships" ON "user_memberships" ("department_id");
+CREATE INDEX IF NOT EXISTS "idx_org_memberships" ON "user_memberships" ("organization_id");
+CREATE INDEX IF NOT EXISTS "idx_user_memberships" ON "user_memberships" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_user_memberships_deleted_at" ON "user_memberships" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_user_memberships_updated_at" ON "user_memberships" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_user_memberships_created_at" ON "user_memberships" ("created_at");
+COMMENT ON COLUMN "user_memberships"."id" IS '主键';
+COMMENT ON COLUMN "user_memberships"."created_at" IS '创建时间';
+COMMENT ON COLUMN "user_memberships"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "user_memberships"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "user_memberships"."user_id" IS '用户ID';
+COMMENT ON COLUMN "user_memberships"."organization_id" IS '组织ID';
+COMMENT ON COLUMN "user_memberships"."department_id" IS '部门ID';
+COMMENT ON COLUMN "user_memberships"."status" IS '成员状态';
+COMMENT ON COLUMN "user_memberships"."is_primary" IS '是否主要成员';
+
+-- organizations
+CREATE TABLE IF NOT EXISTS "organizations" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "code" varchar(50) NOT NULL,
+    "name" varchar(100) NOT NULL,
+    "parent_id" uuid,
+    "facility_type" varchar(100),
+    "accreditation_status" varchar(100),
+    "province_city" json,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_organizations_facility_type" ON "organizations" ("facility_type");
+CREATE INDEX IF NOT EXISTS "idx_parent_org" ON "organizations" ("parent_id");
+CREATE INDEX IF NOT EXISTS "idx_organizations_name" ON "organizations" ("name");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_organizations_code" ON "organizations" ("code");
+CREATE INDEX IF NOT EXISTS "idx_organizations_deleted_at" ON "organizations" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_organizations_updated_at" ON "organizations" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_organizations_created_at" ON "organizations" ("created_at");
+COMMENT ON COLUMN "organizations"."id" IS '主键';
+COMMENT ON COLUMN "organizations"."created_at" IS '创建时间';
+COMMENT ON COLUMN "organizations"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "organizations"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "organizations"."code" IS '组织代码，必须唯一';
+COMMENT ON COLUMN "organizations"."name" IS '组织名称，用于搜索';
+COMMENT ON COLUMN "organizations"."parent_id" IS '支持层级组织结构';
+COMMENT ON COLUMN "organizations"."facility_type" IS '组织类型';
+COMMENT ON COLUMN "organizations"."accreditation_status" IS '认证状态';
+COMMENT ON COLUMN "organizations"."province_city" IS '组织所在省市列表';
+
+-- departments
+CREATE TABLE IF NOT EXISTS "departments" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "name" varchar(100) NOT NULL,
+    "organization_id" uuid NOT NULL,
+    "department_type" varchar(100),
+    "available_equipment" text,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_departments_department_type" ON "departments" ("department_type");
+CREATE INDEX IF NOT EXISTS "idx_org_departments" ON "departments" ("organization_id");
+CREATE INDEX IF NOT EXISTS "idx_departments_name" ON "departments" ("name");
+CREATE INDEX IF NOT EXISTS "idx_departments_deleted_at" ON "departments" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_departments_updated_at" ON "departments" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_departments_created_at" ON "departments" ("created_at");
+COMMENT ON COLUMN "departments"."id" IS '主键';
+COMMENT ON COLUMN "departments"."created_at" IS '创建时间';
+COMMENT ON COLUMN "departments"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "departments"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "departments"."name" IS '部门名称，用于搜索';
+COMMENT ON COLUMN "departments"."organization_id" IS '组织ID';
+COMMENT ON COLUMN "departments"."department_type" IS '部门类型';
+COMMENT ON COLUMN "departments"."available_equipment" IS 'JSON 存储 list<ULID>';
+
+-- organization_logos
+CREATE TABLE IF NOT EXISTS "organization_logos" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "status" smallint NOT NULL DEFAULT 0,
+    "bound_organization_id" uuid,
+    "file_id" varchar(500) NOT NULL,
+    "file_name" varchar(255) NOT NULL,
+    "file_size" bigint NOT NULL,
+    "mime_type" varchar(100) NOT NULL,
+    "expires_at" bigint,
+    "uploaded_by" uuid NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_organization_logos_uploaded_by" ON "organization_logos" ("uploaded_by");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_organization_logos_file_id" ON "organization_logos" ("file_id");
+CREATE INDEX IF NOT EXISTS "idx_organization_logos_bound_organization_id" ON "organization_logos" ("bound_organization_id");
+CREATE INDEX IF NOT EXISTS "idx_organization_logos_status" ON "organization_logos" ("status");
+CREATE INDEX IF NOT EXISTS "idx_organization_logos_deleted_at" ON "organization_logos" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_organization_logos_updated_at" ON "organization_logos" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_organization_logos_created_at" ON "organization_logos" ("created_at");
+COMMENT ON COLUMN "organization_logos"."id" IS '主键';
+COMMENT ON COLUMN "organization_logos"."created_at" IS '创建时间';
+COMMENT ON COLUMN "organization_logos"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "organization_logos"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "organization_logos"."status" IS 'Logo状态';
+COMMENT ON COLUMN "organization_logos"."bound_organization_id" IS '绑定的组织ID（临时状态时为NULL）';
+COMMENT ON COLUMN "organization_logos"."file_id" IS 'S3存储路径: organization-logos/{uuid}.{ext}';
+COMMENT ON COLUMN "organization_logos"."file_name" IS '原始文件名';
+COMMENT ON COLUMN "organization_logos"."file_size" IS '文件大小（字节）';
+COMMENT ON COLUMN "organization_logos"."mime_type" IS 'MIME类型（image/png, image/jpeg等）';
+COMMENT ON COLUMN "organization_logos"."expires_at" IS '过期时间（毫秒时间戳，临时状态必填）';
+COMMENT ON COLUMN "organization_logos"."uploaded_by" IS '上传者用户ID';
+
+-- role_definitions
+CREATE TABLE IF NOT EXISTS "role_definitions" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "name" varchar(50) NOT NULL,
+    "description" text,
+    "status" integer NOT NULL,
+    "permissions" jsonb,
+    "is_system_role" boolean NOT NULL DEFAULT false,
+    "created_by" uuid,
+    "updated_by" uuid,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_role_definitions_name" ON "role_definitions" ("name");
+CREATE INDEX IF NOT EXISTS "idx_role_definitions_deleted_at" ON "role_definitions" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_role_definitions_updated_at" ON "role_definitions" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_role_definitions_created_at" ON "role_definitions" ("created_at");
+COMMENT ON COLUMN "role_definitions"."id" IS '主键';
+COMMENT ON COLUMN "role_definitions"."created_at" IS '创建时间';
+COMMENT ON COLUMN "role_definitions"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "role_definitions"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "role_definitions"."name" IS '角色唯一名称';
+COMMENT ON COLUMN "role_definitions"."description" IS '角色详细描述';
+COMMENT ON COLUMN "role_definitions"."status" IS '角色状态:1-活跃,2-未激活,3-已弃用';
+COMMENT ON COLUMN "role_definitions"."permissions" IS '角色拥有的权限列表';
+COMMENT ON COLUMN "role_definitions"."is_system_role" IS '是否为系统内置角色';
+COMMENT ON COLUMN "role_definitions"."created_by" IS '创建者ID';
+COMMENT ON COLUMN "role_definitions"."updated_by" IS '最后更新者ID';
+
+-- user_role_assignments
+CREATE TABLE IF NOT EXISTS "user_role_assignments" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "user_id" uuid NOT NULL,
+    "role_id" uuid NOT NULL,
+    "created_by" uuid,
+    "updated_by" uuid,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_role_id" ON "user_role_assignments" ("role_id");
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_user_id" ON "user_role_assignments" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_deleted_at" ON "user_role_assignments" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_updated_at" ON "user_role_assignments" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_created_at" ON "user_role_assignments" ("created_at");
+COMMENT ON COLUMN "user_role_assignments"."id" IS '主键';
+COMMENT ON COLUMN "user_role_assignments"."created_at" IS '创建时间';
+COMMENT ON COLUMN "user_role_assignments"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "user_role_assignments"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "user_role_assignments"."user_id" IS '用户ID';
+COMMENT ON COLUMN "user_role_assignments"."role_id" IS '角色ID';
+COMMENT ON COLUMN "user_role_assignments"."created_by" IS '创建者ID';
+COMMENT ON COLUMN "user_role_assignments"."updated_by" IS '最后更新者ID';
+
+-- menus
+CREATE TABLE IF NOT EXISTS "menus" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "semantic_id" varchar(100) NOT NULL,
+    "version" varchar(50) NOT NULL,
+    "name" varchar(100) NOT NULL,
+    "path" varchar(255) NOT NULL,
+    "component" varchar(255),
+    "icon" varchar(100),
+    "parent_id" uuid,
+    "sort" bigint NOT NULL DEFAULT 0,
+    "created_by" uuid,
+    "updated_by" uuid,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_menus_children" FOREIGN KEY ("parent_id") REFERENCES "menus"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_menus_parent_id" ON "menus" ("parent_id");
+CREATE INDEX IF NOT EXISTS "idx_menus_version" ON "menus" ("version");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_semantic_version" ON "menus" ("semantic_id","version");
+CREATE INDEX IF NOT EXISTS "idx_menus_deleted_at" ON "menus" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_menus_updated_at" ON "menus" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_menus_created_at" ON "menus" ("created_at");
+COMMENT ON COLUMN "menus"."id" IS '主键';
+COMMENT ON COLUMN "menus"."created_at" IS '创建时间';
+COMMENT ON COLUMN "menus"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "menus"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "menus"."semantic_id" IS '语义化标识符';
+COMMENT ON COLUMN "menus"."version" IS '版本标识';
+COMMENT ON COLUMN "menus"."name" IS '菜单显示名称';
+COMMENT ON COLUMN "menus"."path" IS '前端路由路径';
+COMMENT ON COLUMN "menus"."component" IS '前端组件的路径';
+COMMENT ON COLUMN "menus"."icon" IS '菜单图标的标识符	';
+COMMENT ON COLUMN "menus"."parent_id" IS '父菜单ID';
+COMMENT ON COLUMN "menus"."sort" IS '排序字段';
+COMMENT ON COLUMN "menus"."created_by" IS '创建者ID';
+COMMENT ON COLUMN "menus"."updated_by" IS '最后更新者ID';
+
+-- casbin_rule
+CREATE TABLE IF NOT EXISTS "casbin_rule" (
+    "id" bigserial,
+    "ptype" varchar(100),
+    "v0" varchar(256),
+    "v1" varchar(256),
+    "v2" varchar(256),
+    "v3" varchar(256),
+    "v4" varchar(256),
+    "v5" varchar(256),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "created_by" varchar(100),
+    "updated_by" varchar(100),
+    "comment" varchar(500),
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_casbin_rule_deleted_at" ON "casbin_rule" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_casbin_rule_updated_at" ON "casbin_rule" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_casbin_rule_created_at" ON "casbin_rule" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_casbin_rule_v5" ON "casbin_rule" ("v5");
+CREATE INDEX IF NOT EXISTS "idx_casbin_rule_v4" ON "casbin_rule" ("v4");
+CREATE INDEX IF NOT EXISTS "idx_casbin_rule_v3" ON "casbin_rule" ("v3");
+CREATE INDEX IF NOT EXISTS "idx_casbin_rule_v2" ON "casbin_rule" ("v2");
+CREATE INDEX IF NOT EXISTS "idx_casbin_rule_v1" ON "casbin_rule" ("v1");
+CREATE INDEX IF NOT EXISTS "idx_casbin_rule_v0" ON "casbin_rule" ("v0");
+CREATE INDEX IF NOT EXISTS "idx_casbin_rule_ptype" ON "casbin_rule" ("ptype");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_casbin_rule" ON "casbin_rule" ("ptype","v0","v1","v2","v3","v4","v5");
+COMMENT ON COLUMN "casbin_rule"."id" IS '主键';
+COMMENT ON COLUMN "casbin_rule"."ptype" IS '策略类型：p, p2, p3, g, g2, g3';
+COMMENT ON COLUMN "casbin_rule"."v0" IS '根据 ptype 不同含义不同';
+COMMENT ON COLUMN "casbin_rule"."v1" IS '根据 ptype 不同含义不同';
+COMMENT ON COLUMN "casbin_rule"."v2" IS '根据 ptype 不同含义不同';
+COMMENT ON COLUMN "casbin_rule"."v3" IS '根据 ptype 不同含义不同';
+COMMENT ON COLUMN "casbin_rule"."v4" IS '扩展字段1';
+COMMENT ON COLUMN "casbin_rule"."v5" IS '扩展字段2';
+COMMENT ON COLUMN "casbin_rule"."created_at" IS '创建时间';
+COMMENT ON COLUMN "casbin_rule"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "casbin_rule"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "casbin_rule"."created_by" IS '创建者用户ID';
+COMMENT ON COLUMN "casbin_rule"."updated_by" IS '更新者用户ID';
+COMMENT ON COLUMN "casbin_rule"."comment" IS '策略说明';
@@ -0,0 +1,3 @@
+-- 将全局域的用户角色分组策略恢复为未记录域，供按二元分组定义 (g = _, _) 加载策略的旧版本使用
+-- 组织范围的授权无法用二元分组表达，回滚前需先撤销组织范围的角色分配
+UPDATE "casbin_rule" SET "v2" = '' WHERE "ptype" = 'g' AND "v2" = '*';
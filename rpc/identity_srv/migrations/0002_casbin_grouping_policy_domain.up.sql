@@ -0,0 +1,3 @@
+-- 为未记录域的历史用户角色分组策略补齐全局域
+-- 按三元分组定义 (g = _, _, _) 加载策略时，缺少域的分组策略会导致加载失败
+UPDATE "casbin_rule" SET "v2" = '*' WHERE "ptype" = 'g' AND "v2" = '';
@@ -0,0 +1,24 @@
+-- 定时任务执行记录
+CREATE TABLE IF NOT EXISTS "job_runs" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "job_name" varchar(100) NOT NULL,
+    "scheduled_at" bigint NOT NULL,
+    "started_at" bigint NOT NULL,
+    "finished_at" bigint,
+    "status" varchar(20) NOT NULL,
+    "affected" bigint NOT NULL DEFAULT 0,
+    "error_message" varchar(500),
+    "instance" varchar(255),
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_job_runs_schedule" ON "job_runs" ("job_name","scheduled_at");
+CREATE INDEX IF NOT EXISTS "idx_job_runs_started_at" ON "job_runs" ("started_at");
+COMMENT ON COLUMN "job_runs"."id" IS '主键';
+COMMENT ON COLUMN "job_runs"."job_name" IS '任务名称';
+COMMENT ON COLUMN "job_runs"."scheduled_at" IS '计划执行时间（毫秒）';
+COMMENT ON COLUMN "job_runs"."started_at" IS '开始时间（毫秒）';
+COMMENT ON COLUMN "job_runs"."finished_at" IS '结束时间（毫秒），执行中为空';
+COMMENT ON COLUMN "job_runs"."status" IS '执行状态：running/success/failure';
+COMMENT ON COLUMN "job_runs"."affected" IS '处理的记录数';
+COMMENT ON COLUMN "job_runs"."error_message" IS '失败时的错误信息';
+COMMENT ON COLUMN "job_runs"."instance" IS '执行任务的服务实例';
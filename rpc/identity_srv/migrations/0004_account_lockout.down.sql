@@ -0,0 +1,2 @@
+ALTER TABLE "user_profiles" DROP COLUMN IF EXISTS "locked_until";
+ALTER TABLE "user_profiles" DROP COLUMN IF EXISTS "last_failed_login_at";
@@ -0,0 +1,5 @@
+-- 账户锁定：记录最近一次登录失败时间与锁定截止时间
+ALTER TABLE "user_profiles" ADD COLUMN IF NOT EXISTS "last_failed_login_at" bigint;
+ALTER TABLE "user_profiles" ADD COLUMN IF NOT EXISTS "locked_until" bigint;
+COMMENT ON COLUMN "user_profiles"."last_failed_login_at" IS '最近一次登录失败时间';
+COMMENT ON COLUMN "user_profiles"."locked_until" IS '锁定截止时间，为空表示需手动解锁';
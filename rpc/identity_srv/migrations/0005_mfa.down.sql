@@ -0,0 +1,6 @@
+-- 回滚会删除全部多因素认证绑定与恢复码，用户需重新绑定
+DROP TABLE IF EXISTS "mfa_recovery_codes";
+ALTER TABLE "role_definitions" DROP COLUMN IF EXISTS "mfa_required";
+ALTER TABLE "user_profiles" DROP COLUMN IF EXISTS "mfa_last_used_step";
+ALTER TABLE "user_profiles" DROP COLUMN IF EXISTS "mfa_secret";
+ALTER TABLE "user_profiles" DROP COLUMN IF EXISTS "mfa_enabled";
@@ -0,0 +1,33 @@
+-- 多因素认证：用户的 TOTP 绑定状态、角色的多因素认证要求与一次性恢复码
+ALTER TABLE "user_profiles" ADD COLUMN IF NOT EXISTS "mfa_enabled" boolean NOT NULL DEFAULT false;
+ALTER TABLE "user_profiles" ADD COLUMN IF NOT EXISTS "mfa_secret" varchar(64);
+ALTER TABLE "user_profiles" ADD COLUMN IF NOT EXISTS "mfa_last_used_step" bigint NOT NULL DEFAULT 0;
+COMMENT ON COLUMN "user_profiles"."mfa_enabled" IS '是否启用多因素认证';
+COMMENT ON COLUMN "user_profiles"."mfa_secret" IS 'TOTP密钥';
+COMMENT ON COLUMN "user_profiles"."mfa_last_used_step" IS '最近一次校验通过的TOTP时间步';
+
+ALTER TABLE "role_definitions" ADD COLUMN IF NOT EXISTS "mfa_required" boolean NOT NULL DEFAULT false;
+COMMENT ON COLUMN "role_definitions"."mfa_required" IS '是否要求多因素认证';
+
+-- mfa_recovery_codes
+CREATE TABLE IF NOT EXISTS "mfa_recovery_codes" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "user_id" uuid NOT NULL,
+    "code_hash" varchar(64) NOT NULL,
+    "used_at" bigint,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_mfa_recovery_codes_user_id" ON "mfa_recovery_codes" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_mfa_recovery_codes_deleted_at" ON "mfa_recovery_codes" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_mfa_recovery_codes_updated_at" ON "mfa_recovery_codes" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_mfa_recovery_codes_created_at" ON "mfa_recovery_codes" ("created_at");
+COMMENT ON COLUMN "mfa_recovery_codes"."id" IS '主键';
+COMMENT ON COLUMN "mfa_recovery_codes"."created_at" IS '创建时间';
+COMMENT ON COLUMN "mfa_recovery_codes"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "mfa_recovery_codes"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "mfa_recovery_codes"."user_id" IS '用户ID';
+COMMENT ON COLUMN "mfa_recovery_codes"."code_hash" IS '恢复码SHA-256摘要';
+COMMENT ON COLUMN "mfa_recovery_codes"."used_at" IS '使用时间，为空表示未使用';
@@ -0,0 +1,3 @@
+-- 回滚会删除历史密码记录，禁止重复使用历史密码的校验从空记录重新开始
+DROP TABLE IF EXISTS "password_histories";
+ALTER TABLE "user_profiles" DROP COLUMN IF EXISTS "password_changed_at";
@@ -0,0 +1,24 @@
+-- 密码策略：记录密码最近修改时间（用于密码过期判断）与历史密码（用于禁止重复使用）
+ALTER TABLE "user_profiles" ADD COLUMN IF NOT EXISTS "password_changed_at" bigint;
+COMMENT ON COLUMN "user_profiles"."password_changed_at" IS '密码最近修改时间';
+
+-- password_histories
+CREATE TABLE IF NOT EXISTS "password_histories" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "user_id" uuid NOT NULL,
+    "password_hash" varchar(255) NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_password_histories_user_id" ON "password_histories" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_password_histories_deleted_at" ON "password_histories" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_password_histories_updated_at" ON "password_histories" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_password_histories_created_at" ON "password_histories" ("created_at");
+COMMENT ON COLUMN "password_histories"."id" IS '主键';
+COMMENT ON COLUMN "password_histories"."created_at" IS '创建时间';
+COMMENT ON COLUMN "password_histories"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "password_histories"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "password_histories"."user_id" IS '用户ID';
+COMMENT ON COLUMN "password_histories"."password_hash" IS '密码哈希';
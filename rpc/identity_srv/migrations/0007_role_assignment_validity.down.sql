@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "idx_user_role_assignments_expires_at";
+DROP INDEX IF EXISTS "idx_user_role_assignments_effective_from";
+ALTER TABLE "user_role_assignments" DROP COLUMN IF EXISTS "expires_at";
+ALTER TABLE "user_role_assignments" DROP COLUMN IF EXISTS "effective_from";
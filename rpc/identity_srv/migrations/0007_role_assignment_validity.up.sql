@@ -0,0 +1,7 @@
+-- 用户角色分配的生效时间与过期时间，均为空表示立即生效且永久有效
+ALTER TABLE "user_role_assignments" ADD COLUMN IF NOT EXISTS "effective_from" bigint;
+ALTER TABLE "user_role_assignments" ADD COLUMN IF NOT EXISTS "expires_at" bigint;
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_effective_from" ON "user_role_assignments" ("effective_from");
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_expires_at" ON "user_role_assignments" ("expires_at");
+COMMENT ON COLUMN "user_role_assignments"."effective_from" IS '生效时间，为空表示立即生效';
+COMMENT ON COLUMN "user_role_assignments"."expires_at" IS '过期时间，为空表示永久有效';
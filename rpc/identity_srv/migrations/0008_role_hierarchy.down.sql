@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "idx_role_definitions_parent_role_id";
+ALTER TABLE "role_definitions" DROP COLUMN IF EXISTS "parent_role_id";
@@ -0,0 +1,4 @@
+-- 角色继承：角色定义的父角色
+ALTER TABLE "role_definitions" ADD COLUMN IF NOT EXISTS "parent_role_id" uuid;
+CREATE INDEX IF NOT EXISTS "idx_role_definitions_parent_role_id" ON "role_definitions" ("parent_role_id");
+COMMENT ON COLUMN "role_definitions"."parent_role_id" IS '父角色ID，继承父角色的全部权限';
@@ -0,0 +1,2 @@
+-- 回滚会删除菜单版本历史，菜单数据本身保留在 menus 表中
+DROP TABLE IF EXISTS "menu_versions";
@@ -0,0 +1,27 @@
+-- 菜单版本历史，同一时刻只有一个生效版本
+CREATE TABLE IF NOT EXISTS "menu_versions" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "created_at" bigint,
+    "updated_at" bigint,
+    "deleted_at" timestamptz,
+    "version" varchar(50) NOT NULL,
+    "is_active" boolean NOT NULL DEFAULT false,
+    "uploaded_by" uuid,
+    "activated_at" bigint,
+    "activated_by" uuid,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_menu_version_active" ON "menu_versions" ("is_active") WHERE is_active;
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_menu_versions_version" ON "menu_versions" ("version");
+CREATE INDEX IF NOT EXISTS "idx_menu_versions_deleted_at" ON "menu_versions" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_menu_versions_updated_at" ON "menu_versions" ("updated_at");
+CREATE INDEX IF NOT EXISTS "idx_menu_versions_created_at" ON "menu_versions" ("created_at");
+COMMENT ON COLUMN "menu_versions"."id" IS '主键';
+COMMENT ON COLUMN "menu_versions"."created_at" IS '创建时间';
+COMMENT ON COLUMN "menu_versions"."updated_at" IS '更新时间';
+COMMENT ON COLUMN "menu_versions"."deleted_at" IS '删除时间';
+COMMENT ON COLUMN "menu_versions"."version" IS '版本标识，与 menus.version 对应';
+COMMENT ON COLUMN "menu_versions"."is_active" IS '是否为当前生效版本';
+COMMENT ON COLUMN "menu_versions"."uploaded_by" IS '上传者ID';
+COMMENT ON COLUMN "menu_versions"."activated_at" IS '最近一次生效时间（毫秒）';
+COMMENT ON COLUMN "menu_versions"."activated_by" IS '最近一次激活操作者ID';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "idx_user_role_assignments_organization_id";
+ALTER TABLE "user_role_assignments" DROP COLUMN IF EXISTS "organization_id";
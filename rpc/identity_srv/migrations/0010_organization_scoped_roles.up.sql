@@ -0,0 +1,4 @@
+-- 组织范围的角色授权，为空表示全局授权
+ALTER TABLE "user_role_assignments" ADD COLUMN IF NOT EXISTS "organization_id" uuid;
+CREATE INDEX IF NOT EXISTS "idx_user_role_assignments_organization_id" ON "user_role_assignments" ("organization_id");
+COMMENT ON COLUMN "user_role_assignments"."organization_id" IS '授权所属组织ID，为空表示全局授权';
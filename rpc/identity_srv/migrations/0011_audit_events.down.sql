@@ -0,0 +1,2 @@
+-- 回滚会删除全部审计日志，生产环境回滚前需先行导出
+DROP TABLE IF EXISTS "audit_events";
@@ -0,0 +1,42 @@
+-- 管理操作与安全事件审计日志
+CREATE TABLE IF NOT EXISTS "audit_events" (
+    "id" uuid DEFAULT gen_random_uuid(),
+    "actor_id" uuid,
+    "actor_organization_id" uuid,
+    "action" varchar(100) NOT NULL,
+    "target_type" varchar(50) NOT NULL,
+    "target_id" varchar(255),
+    "before" text,
+    "after" text,
+    "changed_fields" jsonb,
+    "request_id" varchar(64),
+    "trace_id" varchar(64),
+    "ip_address" varchar(64),
+    "result" varchar(20) NOT NULL,
+    "error_code" integer,
+    "error_message" varchar(500),
+    "created_at" bigint,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_audit_events_created_at" ON "audit_events" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_audit_events_result" ON "audit_events" ("result");
+CREATE INDEX IF NOT EXISTS "idx_audit_events_request_id" ON "audit_events" ("request_id");
+CREATE INDEX IF NOT EXISTS "idx_audit_events_target" ON "audit_events" ("target_type","target_id");
+CREATE INDEX IF NOT EXISTS "idx_audit_events_action" ON "audit_events" ("action");
+CREATE INDEX IF NOT EXISTS "idx_audit_events_actor_id" ON "audit_events" ("actor_id");
+COMMENT ON COLUMN "audit_events"."id" IS '主键';
+COMMENT ON COLUMN "audit_events"."actor_id" IS '操作者用户ID，未认证的操作为空';
+COMMENT ON COLUMN "audit_events"."actor_organization_id" IS '操作者当前代表的组织ID';
+COMMENT ON COLUMN "audit_events"."action" IS '操作名称（RPC方法名）';
+COMMENT ON COLUMN "audit_events"."target_type" IS '操作对象类型';
+COMMENT ON COLUMN "audit_events"."target_id" IS '操作对象ID';
+COMMENT ON COLUMN "audit_events"."before" IS '变更前内容（JSON，已脱敏）';
+COMMENT ON COLUMN "audit_events"."after" IS '变更后内容（JSON，已脱敏）';
+COMMENT ON COLUMN "audit_events"."changed_fields" IS '发生变化的顶层字段';
+COMMENT ON COLUMN "audit_events"."request_id" IS '请求ID';
+COMMENT ON COLUMN "audit_events"."trace_id" IS '链路追踪ID';
+COMMENT ON COLUMN "audit_events"."ip_address" IS '客户端IP地址';
+COMMENT ON COLUMN "audit_events"."result" IS '执行结果：success/failure';
+COMMENT ON COLUMN "audit_events"."error_code" IS '失败时的业务错误码';
+COMMENT ON COLUMN "audit_events"."error_message" IS '失败时的错误信息';
+COMMENT ON COLUMN "audit_events"."created_at" IS '发生时间';
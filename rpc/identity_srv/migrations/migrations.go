@@ -0,0 +1,12 @@
+// Package migrations 内嵌 identity_srv 的版本化 SQL 迁移文件
+//
+// 文件命名规则：{版本号}_{描述}.up.sql / {版本号}_{描述}.down.sql，版本号递增且不可复用。
+// 已发布的迁移文件不可修改，结构或数据变更一律新增迁移。
+package migrations
+
+import "embed"
+
+// FS 内嵌的迁移文件
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// 审计事件执行结果
+const (
+	AuditResultSuccess = "success"
+	AuditResultFailure = "failure"
+)
+
+// AuditFields 发生变化的字段列表，以 JSONB 存储
+type AuditFields []string
+
+// Value 实现了 driver.Valuer 接口，用于将 AuditFields 类型写入数据库。
+func (f AuditFields) Value() (driver.Value, error) {
+	if len(f) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(f)
+}
+
+// Scan 实现了 sql.Scanner 接口，用于从数据库读取数据到 AuditFields 类型。
+func (f *AuditFields) Scan(value interface{}) error {
+	if value == nil {
+		*f = nil
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(bytes, f)
+}
+
+// AuditEvent 审计事件
+// 每次变更类 RPC 调用记录一条，只追加不修改，也不参与软删除
+type AuditEvent struct {
+	ID                  uuid.UUID   `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid();comment:主键"`
+	ActorID             *uuid.UUID  `gorm:"column:actor_id;type:uuid;index;comment:操作者用户ID，未认证的操作为空"`
+	ActorOrganizationID *uuid.UUID  `gorm:"column:actor_organization_id;type:uuid;comment:操作者当前代表的组织ID"`
+	Action              string      `gorm:"column:action;not null;size:100;index;comment:操作名称（RPC方法名）"`
+	TargetType          string      `gorm:"column:target_type;not null;size:50;index:idx_audit_events_target;comment:操作对象类型"`
+	TargetID            string      `gorm:"column:target_id;size:255;index:idx_audit_events_target;comment:操作对象ID"`
+	Before              string      `gorm:"column:before;type:text;comment:变更前内容（JSON，已脱敏）"`
+	After               string      `gorm:"column:after;type:text;comment:变更后内容（JSON，已脱敏）"`
+	ChangedFields       AuditFields `gorm:"column:changed_fields;type:jsonb;comment:发生变化的顶层字段"`
+	RequestID           string      `gorm:"column:request_id;size:64;index;comment:请求ID"`
+	TraceID             string      `gorm:"column:trace_id;size:64;comment:链路追踪ID"`
+	IPAddress           string      `gorm:"column:ip_address;size:64;comment:客户端IP地址"`
+	Result              string      `gorm:"column:result;not null;size:20;index;comment:执行结果：success/failure"`
+	ErrorCode           int32       `gorm:"column:error_code;comment:失败时的业务错误码"`
+	ErrorMessage        string      `gorm:"column:error_message;size:500;comment:失败时的错误信息"`
+	CreatedAt           int64       `gorm:"column:created_at;autoCreateTime:milli;index;comment:发生时间"`
+}
+
+// TableName 指定表名
+func (AuditEvent) TableName() string {
+	return "audit_events"
+}
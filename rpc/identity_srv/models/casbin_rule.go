@@ -38,13 +38,27 @@ const (
 	// PolicyTypeMenuMapping 菜单映射策略类型：角色 -> 菜单 -> 权限
 	PolicyTypeMenuMapping = "p2"
 
+	// PolicyTypeUserRole 用户角色分配策略类型：用户 -> 角色（对应模型中的 g）
+	PolicyTypeUserRole = "g"
+
 	// PolicyTypeRoleInheritance 角色继承策略类型：子角色 -> 父角色
+	// 与用户角色分配共用 g，使 g() 匹配时沿继承链传递父角色的权限
 	PolicyTypeRoleInheritance = "g"
-
-	// PolicyTypeUserRole 用户角色分配策略类型：用户 -> 角色
-	PolicyTypeUserRole = "g2"
 )
 
+// GlobalDomain 全局域，存放于分组策略的 V2 字段
+// 全局授权的用户角色与角色继承关系均位于该域，并通过域匹配函数在每个组织域中生效
+const GlobalDomain = "*"
+
+// OrganizationDomain 返回组织对应的 Casbin 域，组织ID为空时返回 GlobalDomain
+func OrganizationDomain(organizationID string) string {
+	if organizationID == "" {
+		return GlobalDomain
+	}
+
+	return organizationID
+}
+
 // 菜单权限类型常量定义
 // 基于组织范围的权限控制
 const (
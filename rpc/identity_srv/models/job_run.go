@@ -0,0 +1,29 @@
+package models
+
+import "github.com/google/uuid"
+
+// 定时任务执行状态
+const (
+	JobRunStatusRunning = "running"
+	JobRunStatusSuccess = "success"
+	JobRunStatusFailure = "failure"
+)
+
+// JobRun 定时任务执行记录
+// 同一任务的同一调度时间只允许一条记录，多副本部署时据此保证每次调度只执行一次
+type JobRun struct {
+	ID           uuid.UUID `gorm:"column:id;primaryKey;type:uuid;default:gen_random_uuid();comment:主键"`
+	JobName      string    `gorm:"column:job_name;not null;size:100;uniqueIndex:idx_job_runs_schedule,priority:1;comment:任务名称"`
+	ScheduledAt  int64     `gorm:"column:scheduled_at;not null;uniqueIndex:idx_job_runs_schedule,priority:2;comment:计划执行时间（毫秒）"`
+	StartedAt    int64     `gorm:"column:started_at;not null;index;comment:开始时间（毫秒）"`
+	FinishedAt   *int64    `gorm:"column:finished_at;comment:结束时间（毫秒），执行中为空"`
+	Status       string    `gorm:"column:status;not null;size:20;comment:执行状态：running/success/failure"`
+	Affected     int64     `gorm:"column:affected;not null;default:0;comment:处理的记录数"`
+	ErrorMessage string    `gorm:"column:error_message;size:500;comment:失败时的错误信息"`
+	Instance     string    `gorm:"column:instance;size:255;comment:执行任务的服务实例"`
+}
+
+// TableName 指定表名
+func (JobRun) TableName() string {
+	return "job_runs"
+}
@@ -0,0 +1,20 @@
+package models
+
+import "github.com/google/uuid"
+
+// MenuVersion 菜单版本元数据
+// 每次上传菜单配置生成一个版本，菜单节点本身存储在 menus 表中；同一时刻仅有一个生效版本
+type MenuVersion struct {
+	BaseModel
+
+	Version     string     `gorm:"column:version;not null;uniqueIndex;size:50;comment:版本标识，与 menus.version 对应"`
+	IsActive    bool       `gorm:"column:is_active;not null;default:false;index:idx_menu_version_active,unique,where:is_active;comment:是否为当前生效版本"`
+	UploadedBy  *uuid.UUID `gorm:"column:uploaded_by;type:uuid;comment:上传者ID"`
+	ActivatedAt int64      `gorm:"column:activated_at;comment:最近一次生效时间（毫秒）"`
+	ActivatedBy *uuid.UUID `gorm:"column:activated_by;type:uuid;comment:最近一次激活操作者ID"`
+}
+
+// TableName 指定表名
+func (MenuVersion) TableName() string {
+	return "menu_versions"
+}
@@ -0,0 +1,23 @@
+package models
+
+import "github.com/google/uuid"
+
+// MFARecoveryCode 多因素认证恢复码
+// 仅存储恢复码摘要，每个恢复码只能使用一次
+type MFARecoveryCode struct {
+	BaseModel
+
+	UserID   uuid.UUID `gorm:"column:user_id;type:uuid;not null;index;comment:用户ID"`
+	CodeHash string    `gorm:"column:code_hash;not null;size:64;comment:恢复码SHA-256摘要"`
+	UsedAt   *int64    `gorm:"column:used_at;comment:使用时间，为空表示未使用"`
+}
+
+// TableName 指定表名
+func (MFARecoveryCode) TableName() string {
+	return "mfa_recovery_codes"
+}
+
+// IsUsed 检查恢复码是否已被使用
+func (c *MFARecoveryCode) IsUsed() bool {
+	return c.UsedAt != nil
+}
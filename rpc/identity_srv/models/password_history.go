@@ -0,0 +1,17 @@
+package models
+
+import "github.com/google/uuid"
+
+// PasswordHistory 密码历史
+// 每次设置密码时记录一条，用于阻止重复使用近期的密码
+type PasswordHistory struct {
+	BaseModel
+
+	UserID       uuid.UUID `gorm:"column:user_id;type:uuid;not null;index;comment:用户ID"`
+	PasswordHash string    `gorm:"column:password_hash;not null;size:255;comment:密码哈希"`
+}
+
+// TableName 指定表名
+func (PasswordHistory) TableName() string {
+	return "password_histories"
+}
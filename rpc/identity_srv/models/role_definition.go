@@ -41,11 +41,16 @@ type RoleDefinition struct {
 	Status       RoleStatus  `gorm:"column:status;not null;comment:角色状态:1-活跃,2-未激活,3-已弃用"`
 	Permissions  Permissions `gorm:"column:permissions;type:jsonb;comment:角色拥有的权限列表"`
 	IsSystemRole bool        `gorm:"column:is_system_role;not null;default:false;comment:是否为系统内置角色"`
+	MFARequired  bool        `gorm:"column:mfa_required;not null;default:false;comment:是否要求多因素认证"`
 	CreatedBy    *uuid.UUID  `gorm:"column:created_by;type:uuid;comment:创建者ID"`
 	UpdatedBy    *uuid.UUID  `gorm:"column:updated_by;type:uuid;comment:最后更新者ID"`
+	ParentRoleID *uuid.UUID  `gorm:"column:parent_role_id;type:uuid;index;comment:父角色ID，继承父角色的全部权限"`
 
 	// 当前角色绑定的用户数量（非数据库字段，用于业务逻辑传递）
 	UserCount int64 `gorm:"-" json:"user_count,omitempty"`
+
+	// 自身权限与继承权限合并后的生效权限（非数据库字段，仅详情查询时填充）
+	EffectivePermissions Permissions `gorm:"-" json:"effective_permissions,omitempty"`
 }
 
 // TableName 指定表名
@@ -87,5 +92,9 @@ func (r *RoleDefinition) validateFields(isCreate bool) error {
 		}
 	}
 
+	if r.ParentRoleID != nil && *r.ParentRoleID == r.ID {
+		return fmt.Errorf("角色不能继承自身")
+	}
+
 	return nil
 }
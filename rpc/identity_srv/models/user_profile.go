@@ -38,7 +38,15 @@ type UserProfile struct {
 	Status             UserStatus `gorm:"column:status;not null;default:2;index;comment:用户状态"`
 	LoginAttempts      int32      `gorm:"column:login_attempts;not null;default:0;comment:登录尝试次数"`
 	MustChangePassword bool       `gorm:"column:must_change_password;not null;default:false;comment:是否必须修改密码"`
+	PasswordChangedAt  *int64     `gorm:"column:password_changed_at;comment:密码最近修改时间"`
 	AccountExpiry      *int64     `gorm:"column:account_expiry;comment:账户过期时间"`
+	LastFailedLoginAt  *int64     `gorm:"column:last_failed_login_at;comment:最近一次登录失败时间"`
+	LockedUntil        *int64     `gorm:"column:locked_until;comment:锁定截止时间，为空表示需手动解锁"`
+
+	// 多因素认证 (TOTP)
+	MFAEnabled      bool   `gorm:"column:mfa_enabled;not null;default:false;comment:是否启用多因素认证"`
+	MFASecret       string `gorm:"column:mfa_secret;size:64;comment:TOTP密钥"`
+	MFALastUsedStep int64  `gorm:"column:mfa_last_used_step;not null;default:0;comment:最近一次校验通过的TOTP时间步"`
 
 	// 审计信息
 	CreatedBy     *uuid.UUID `gorm:"column:created_by;type:uuid;comment:创建者ID"`
@@ -108,23 +116,52 @@ func (u *UserProfile) IsActive() bool {
 	}
 
 	// 检查账户是否过期
-	if u.AccountExpiry != nil && time.Now().UnixMilli() > *u.AccountExpiry {
+	if u.IsAccountExpired(time.Now().UnixMilli()) {
 		return false
 	}
 
 	return true
 }
 
+// IsAccountExpired 检查账户在给定时间（毫秒时间戳）是否已超过有效期，未设置有效期视为永不过期
+func (u *UserProfile) IsAccountExpired(now int64) bool {
+	return u.AccountExpiry != nil && *u.AccountExpiry > 0 && now > *u.AccountExpiry
+}
+
 // IsLocked 检查用户是否被锁定
 func (u *UserProfile) IsLocked() bool {
 	return u.Status == UserStatusLocked
 }
 
+// IsLockExpired 检查锁定是否已到期（仅对设置了锁定截止时间的自动锁定有效）
+func (u *UserProfile) IsLockExpired(now int64) bool {
+	return u.IsLocked() && u.LockedUntil != nil && now >= *u.LockedUntil
+}
+
 // ShouldChangePassword 检查是否需要强制修改密码
 func (u *UserProfile) ShouldChangePassword() bool {
 	return u.MustChangePassword
 }
 
+// IsPasswordExpired 检查密码是否超过有效期，未记录修改时间时以创建时间为准
+func (u *UserProfile) IsPasswordExpired(now int64, maxAge time.Duration) bool {
+	if maxAge <= 0 {
+		return false
+	}
+
+	changedAt := u.CreatedAt
+	if u.PasswordChangedAt != nil {
+		changedAt = *u.PasswordChangedAt
+	}
+
+	return now-changedAt >= maxAge.Milliseconds()
+}
+
+// HasPendingMFAEnrollment 检查是否存在待确认的多因素认证密钥
+func (u *UserProfile) HasPendingMFAEnrollment() bool {
+	return !u.MFAEnabled && u.MFASecret != ""
+}
+
 // IsSystem 判断是否为系统用户
 func (u *UserProfile) IsSystem() bool {
 	return u.IsSystemUser
@@ -2,6 +2,7 @@ package models
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -15,6 +16,13 @@ type UserRoleAssignment struct {
 	RoleID    uuid.UUID  `gorm:"column:role_id;not null;index;type:uuid;comment:角色ID"`
 	CreatedBy *uuid.UUID `gorm:"column:created_by;type:uuid;comment:创建者ID"`
 	UpdatedBy *uuid.UUID `gorm:"column:updated_by;type:uuid;comment:最后更新者ID"`
+
+	// 授权所属组织，为空表示全局授权，在用户代表的任何组织中均生效
+	OrganizationID *uuid.UUID `gorm:"column:organization_id;index;type:uuid;comment:授权所属组织ID，为空表示全局授权"`
+
+	// 有效期（毫秒时间戳），用于临时授权
+	EffectiveFrom *int64 `gorm:"column:effective_from;index;comment:生效时间，为空表示立即生效"`
+	ExpiresAt     *int64 `gorm:"column:expires_at;index;comment:过期时间，为空表示永久有效"`
 }
 
 // TableName 指定表名
@@ -33,12 +41,44 @@ func (u *UserRoleAssignment) BeforeUpdate(tx *gorm.DB) error {
 	return u.validateFields(tx)
 }
 
+// Domain 返回分配对应的 Casbin 域：组织授权为组织ID，全局授权为 GlobalDomain
+func (u *UserRoleAssignment) Domain() string {
+	return OrganizationDomain(u.OrganizationIDString())
+}
+
+// OrganizationIDString 返回授权所属组织ID，全局授权返回空字符串
+func (u *UserRoleAssignment) OrganizationIDString() string {
+	if u.OrganizationID == nil {
+		return ""
+	}
+
+	return u.OrganizationID.String()
+}
+
+// IsEffectiveAt 判断分配在指定时间（毫秒时间戳）是否处于有效期内
+func (u *UserRoleAssignment) IsEffectiveAt(now int64) bool {
+	if u.EffectiveFrom != nil && now < *u.EffectiveFrom {
+		return false
+	}
+
+	return u.ExpiresAt == nil || now < *u.ExpiresAt
+}
+
+// IsActive 判断分配当前是否有效
+func (u *UserRoleAssignment) IsActive() bool {
+	return u.IsEffectiveAt(time.Now().UnixMilli())
+}
+
 // validateFields 验证字段的业务规则。
 func (u *UserRoleAssignment) validateFields(tx *gorm.DB) error {
 	if u.RoleID == uuid.Nil {
 		return fmt.Errorf("角色ID不能为空")
 	}
 
+	if u.EffectiveFrom != nil && u.ExpiresAt != nil && *u.ExpiresAt <= *u.EffectiveFrom {
+		return fmt.Errorf("过期时间必须晚于生效时间")
+	}
+
 	// 验证引用的角色是否存在
 	var roleCount int64
 	if err := tx.Model(&RoleDefinition{}).Where("id = ?", u.RoleID).Count(&roleCount).Error; err != nil {
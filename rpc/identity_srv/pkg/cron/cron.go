@@ -0,0 +1,232 @@
+// Package cron 解析 cron 调度表达式并计算下一次执行时间
+//
+// 支持标准 5 段表达式（分 时 日 月 周），字段可使用 *、列表(1,2)、范围(1-5)与步长(*/15、1-30/5)；
+// 周字段 0 与 7 均表示周日。日与周同时受限时按 cron 惯例任一满足即执行。
+// 另支持描述符 @yearly(@annually)、@monthly、@weekly、@daily(@midnight)、@hourly 与 @every <duration>。
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule 调度计划
+type Schedule interface {
+	// Next 返回晚于 t 的下一次执行时间
+	Next(t time.Time) time.Time
+}
+
+// descriptors 描述符对应的标准表达式
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// field 单个字段的取值范围
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// maxSearchYears 查找下一次执行时间的最大年数，避免 2 月 30 日等永不满足的表达式死循环
+const maxSearchYears = 5
+
+// Parse 解析调度表达式
+func Parse(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+
+	if strings.HasPrefix(spec, "@every ") {
+		interval, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid @every interval %q: %w", spec, err)
+		}
+
+		if interval < time.Second {
+			return nil, fmt.Errorf("@every interval must be at least 1s: %q", spec)
+		}
+
+		return EverySchedule{Interval: interval}, nil
+	}
+
+	if expr, ok := descriptors[spec]; ok {
+		spec = expr
+	}
+
+	parts := strings.Fields(spec)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("cron expression %q must have %d fields", spec, len(fields))
+	}
+
+	sets := make([]uint64, len(fields))
+
+	for i, part := range parts {
+		set, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, err
+		}
+
+		sets[i] = set
+	}
+
+	// 周字段的 7 与 0 同为周日
+	if sets[4]&(1<<7) != 0 {
+		sets[4] |= 1
+		sets[4] &^= 1 << 7
+	}
+
+	return &SpecSchedule{
+		minute:     sets[0],
+		hour:       sets[1],
+		dayOfMonth: sets[2],
+		month:      sets[3],
+		dayOfWeek:  sets[4],
+		domStar:    parts[2] == "*" || strings.HasPrefix(parts[2], "*/"),
+		dowStar:    parts[4] == "*" || strings.HasPrefix(parts[4], "*/"),
+	}, nil
+}
+
+// parseField 将字段解析为取值位图
+func parseField(expr string, f field) (uint64, error) {
+	var set uint64
+
+	for _, item := range strings.Split(expr, ",") {
+		rangeExpr, step := item, 1
+
+		if idx := strings.Index(item, "/"); idx >= 0 {
+			rangeExpr = item[:idx]
+
+			n, err := strconv.Atoi(item[idx+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step in %s field %q", f.name, item)
+			}
+
+			step = n
+		}
+
+		low, high := f.min, f.max
+
+		switch {
+		case rangeExpr == "*":
+		case strings.Contains(rangeExpr, "-"):
+			bounds := strings.SplitN(rangeExpr, "-", 2)
+
+			var err error
+			if low, err = parseValue(bounds[0], f); err != nil {
+				return 0, err
+			}
+
+			if high, err = parseValue(bounds[1], f); err != nil {
+				return 0, err
+			}
+
+			if low > high {
+				return 0, fmt.Errorf("invalid range in %s field %q", f.name, item)
+			}
+		default:
+			value, err := parseValue(rangeExpr, f)
+			if err != nil {
+				return 0, err
+			}
+
+			// 单个值带步长（如 5/10）表示从该值开始到字段最大值
+			low = value
+			if step == 1 {
+				high = value
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+
+	return set, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s field value %q out of range [%d, %d]", f.name, s, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+// SpecSchedule 由 cron 表达式描述的调度计划，按传入时间的时区计算
+type SpecSchedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+
+	// domStar / dowStar 日、周字段未受限，用于决定两者的组合方式
+	domStar, dowStar bool
+}
+
+// Next 返回晚于 t 的下一次执行时间（精确到分钟），找不到时返回零值
+func (s *SpecSchedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// dayMatches 日与周均受限时任一满足即可，否则两者都需满足
+func (s *SpecSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dowMatch := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+
+	if !s.domStar && !s.dowStar {
+		return domMatch || dowMatch
+	}
+
+	return domMatch && dowMatch
+}
+
+// EverySchedule 固定间隔的调度计划
+// 执行时间按间隔对齐到固定起点而非进程启动时间，多个实例计算出的执行时间一致
+type EverySchedule struct {
+	Interval time.Duration
+}
+
+// Next 返回晚于 t 的下一个间隔对齐时间
+func (s EverySchedule) Next(t time.Time) time.Time {
+	next := t.Truncate(s.Interval).Add(s.Interval)
+	return next.In(t.Location())
+}
@@ -0,0 +1,77 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustTime(t *testing.T, value string) time.Time {
+	t.Helper()
+
+	parsed, err := time.Parse("2006-01-02 15:04", value)
+	require.NoError(t, err)
+
+	return parsed
+}
+
+func TestParse_Next(t *testing.T) {
+	tests := []struct {
+		spec string
+		from string
+		want string
+	}{
+		{spec: "0 * * * *", from: "2026-03-10 08:15", want: "2026-03-10 09:00"},
+		{spec: "*/15 * * * *", from: "2026-03-10 08:15", want: "2026-03-10 08:30"},
+		{spec: "30 3 * * *", from: "2026-03-10 03:30", want: "2026-03-11 03:30"},
+		{spec: "0 9 * * 1-5", from: "2026-03-13 10:00", want: "2026-03-16 09:00"},
+		{spec: "0 0 1 */3 *", from: "2026-02-15 00:00", want: "2026-04-01 00:00"},
+		{spec: "0 0 * * 7", from: "2026-03-10 00:00", want: "2026-03-15 00:00"},
+		{spec: "0 12 13 * 5", from: "2026-03-01 00:00", want: "2026-03-06 12:00"},
+		{spec: "@daily", from: "2026-12-31 23:59", want: "2027-01-01 00:00"},
+		{spec: "@monthly", from: "2026-01-31 12:00", want: "2026-02-01 00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			schedule, err := Parse(tt.spec)
+			require.NoError(t, err)
+
+			assert.Equal(t, mustTime(t, tt.want), schedule.Next(mustTime(t, tt.from)))
+		})
+	}
+}
+
+func TestParse_Every(t *testing.T) {
+	schedule, err := Parse("@every 10m")
+	require.NoError(t, err)
+
+	from := mustTime(t, "2026-03-10 08:17")
+	assert.Equal(t, mustTime(t, "2026-03-10 08:20"), schedule.Next(from))
+	assert.Equal(t, mustTime(t, "2026-03-10 08:30"), schedule.Next(mustTime(t, "2026-03-10 08:20")))
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"@every 10",
+		"@every 100ms",
+	} {
+		_, err := Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestSpecSchedule_NeverMatches(t *testing.T) {
+	schedule, err := Parse("0 0 30 2 *")
+	require.NoError(t, err)
+
+	assert.True(t, schedule.Next(mustTime(t, "2026-01-01 00:00")).IsZero())
+}
@@ -42,6 +42,14 @@ const (
 	ErrorCodeMustChangePassword     = 201018
 	ErrorCodeSystemUserCannotDelete    = 201019 // 系统用户无法删除
 	ErrorCodeSystemUserCannotModifyKey = 201020 // 系统用户关键属性无法修改
+	ErrorCodeUserLocked                = 201021 // 用户因多次登录失败被锁定
+	ErrorCodeMFANotEnabled             = 201022 // 未启用多因素认证
+	ErrorCodeMFAAlreadyEnabled         = 201023 // 已启用多因素认证
+	ErrorCodeInvalidMFACode            = 201024 // 多因素认证验证码错误
+	ErrorCodeMFAEnrollmentNotStarted   = 201025 // 未开始绑定多因素认证
+	ErrorCodePasswordPolicyViolation   = 201026 // 密码不符合密码策略
+	ErrorCodePasswordReused            = 201027 // 密码与近期使用过的密码重复
+	ErrorCodeAccountExpired            = 201028 // 账户已超过有效期
 
 	// 组织相关错误 (202xxx)
 	ErrorCodeOrganizationNotFound                    = 202001
@@ -95,4 +103,7 @@ const (
 	ErrorCodeMenuPermissionDenied        = 207015 // 菜单权限不足
 	ErrorCodeNoActiveRoles               = 207016 // 用户没有可用角色
 	ErrorCodeSystemRoleCannotRevoke      = 207017 // 系统用户的系统角色无法撤销
+	ErrorCodeInvalidAssignmentPeriod     = 207018 // 角色分配有效期无效
+	ErrorCodeRoleInheritanceCycle        = 207019 // 角色继承关系形成环
+	ErrorCodeMenuUploadDestructive       = 207020 // 菜单上传将导致角色失去菜单权限
 )
@@ -35,12 +35,28 @@ func (e ErrNo) WithMessage(msg string) ErrNo {
 func (e ErrNo) Code() int32     { return e.ErrCode }
 func (e ErrNo) Message() string { return e.ErrMsg }
 
+// DetailedErrNo 携带结构化明细的业务错误
+// 明细通过 BizStatusError 的 Extra 透传给调用方，例如密码策略校验时逐条返回违反的规则
+type DetailedErrNo struct {
+	ErrNo
+	Details map[string]string
+}
+
+// WithDetails 附加结构化明细
+func (e ErrNo) WithDetails(details map[string]string) DetailedErrNo {
+	return DetailedErrNo{ErrNo: e, Details: details}
+}
+
 // ToKitexError 转换为Kitex错误
 func ToKitexError(err error) error {
 	if err == nil {
 		return nil
 	}
 
+	if e, ok := err.(DetailedErrNo); ok {
+		return kerrors.NewBizStatusErrorWithExtra(e.Code(), e.Message(), e.Details)
+	}
+
 	if e, ok := err.(ErrNo); ok {
 		// 使用Kitex的NewBizStatusError创建业务错误
 		return kerrors.NewBizStatusError(e.Code(), e.Message())
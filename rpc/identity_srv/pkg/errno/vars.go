@@ -19,6 +19,19 @@ var (
 	ErrInvalidCredentials     = NewErrNo(ErrorCodeInvalidCredentials, "用户名或密码错误")
 	ErrUserSuspended          = NewErrNo(ErrorCodeUserSuspended, "用户已被暂停")
 	ErrMustChangePassword     = NewErrNo(ErrorCodeMustChangePassword, "请先修改密码")
+	ErrUserLocked             = NewErrNo(ErrorCodeUserLocked, "账户已被锁定，请稍后重试或联系管理员")
+	ErrAccountExpired         = NewErrNo(ErrorCodeAccountExpired, "账户已过期，请联系管理员")
+
+	// 多因素认证相关错误
+	ErrMFANotEnabled           = NewErrNo(ErrorCodeMFANotEnabled, "未启用多因素认证")
+	ErrMFAAlreadyEnabled       = NewErrNo(ErrorCodeMFAAlreadyEnabled, "已启用多因素认证")
+	ErrInvalidMFACode          = NewErrNo(ErrorCodeInvalidMFACode, "验证码错误或已使用")
+	ErrMFAEnrollmentNotStarted = NewErrNo(ErrorCodeMFAEnrollmentNotStarted, "请先获取多因素认证密钥")
+
+	// 密码策略相关错误
+	ErrPasswordPolicyViolation = NewErrNo(ErrorCodePasswordPolicyViolation, "密码不符合安全策略")
+	ErrPasswordReused          = NewErrNo(ErrorCodePasswordReused, "不能使用近期使用过的密码")
+	ErrPasswordExpired         = ErrMustChangePassword.WithMessage("密码已过期，请修改密码")
 
 	// 系统用户保护相关错误
 	ErrSystemUserCannotDelete    = NewErrNo(ErrorCodeSystemUserCannotDelete, "系统用户无法删除")
@@ -74,12 +87,14 @@ var (
 	ErrSystemRoleCannotModify = NewErrNo(ErrorCodeSystemRoleCannotModify, "系统角色无法修改")
 	ErrSystemRoleCannotDelete = NewErrNo(ErrorCodeSystemRoleCannotDelete, "系统角色无法删除")
 	ErrRoleInUseCannotDelete  = NewErrNo(ErrorCodeRoleInUseCannotDelete, "角色正在使用中，无法删除")
+	ErrRoleInheritanceCycle   = NewErrNo(ErrorCodeRoleInheritanceCycle, "角色继承关系不能形成环")
 
 	// 用户角色分配相关错误
 	ErrRoleAssignmentNotFound      = NewErrNo(ErrorCodeRoleAssignmentNotFound, "用户角色分配不存在")
 	ErrRoleAssignmentAlreadyExists = NewErrNo(ErrorCodeRoleAssignmentAlreadyExists, "用户角色分配已存在")
 	ErrRoleAssignmentConflict      = NewErrNo(ErrorCodeRoleAssignmentConflict, "用户角色分配冲突")
 	ErrAssignerPermissionDenied    = NewErrNo(ErrorCodeAssignerPermissionDenied, "分配者权限不足")
+	ErrInvalidAssignmentPeriod     = NewErrNo(ErrorCodeInvalidAssignmentPeriod, "角色分配有效期无效")
 
 	// 菜单权限相关错误
 	ErrMenuNotFound         = NewErrNo(ErrorCodeMenuNotFound, "菜单不存在")
@@ -88,6 +103,7 @@ var (
 	ErrMenuYAMLParseFailed  = NewErrNo(ErrorCodeMenuYAMLParseFailed, "菜单YAML解析失败")
 	ErrMenuTreeInvalid      = NewErrNo(ErrorCodeMenuTreeInvalid, "菜单树结构无效")
 	ErrMenuPermissionDenied = NewErrNo(ErrorCodeMenuPermissionDenied, "菜单权限不足")
+	ErrMenuUploadDestructive = NewErrNo(ErrorCodeMenuUploadDestructive, "菜单上传将导致角色失去菜单权限")
 	ErrNoActiveRoles        = NewErrNo(ErrorCodeNoActiveRoles, "用户没有可用角色")
 	ErrSystemRoleCannotRevoke = NewErrNo(ErrorCodeSystemRoleCannotRevoke, "系统用户的系统角色无法撤销")
 )
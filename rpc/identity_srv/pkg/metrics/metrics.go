@@ -0,0 +1,192 @@
+// Package metrics 提供 identity_srv 的 Prometheus 指标定义与暴露
+// 包含 RPC 服务端指标、业务计数器以及数据库连接池指标
+package metrics
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "identity_srv"
+
+// Registry 服务指标注册表
+var Registry = prometheus.NewRegistry()
+
+var (
+	// rpcRequestsTotal RPC 请求总数，按方法与业务错误码区分
+	rpcRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "rpc",
+			Name:      "requests_total",
+			Help:      "RPC 请求总数",
+		},
+		[]string{"method", "code"},
+	)
+
+	// rpcRequestDuration RPC 请求耗时分布
+	rpcRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "rpc",
+			Name:      "request_duration_seconds",
+			Help:      "RPC 请求耗时（秒）",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+
+	// accountLockoutsTotal 因连续登录失败触发的账户锁定次数
+	accountLockoutsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "auth",
+			Name:      "account_lockouts_total",
+			Help:      "连续登录失败触发的账户锁定次数",
+		},
+	)
+
+	// authorizationDecisionsTotal 接口权限判定次数，按判定结果区分
+	authorizationDecisionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "casbin",
+			Name:      "decisions_total",
+			Help:      "Casbin 接口权限判定次数",
+		},
+		[]string{"result"},
+	)
+
+	// accountsExpiringSoon 即将超过有效期的活跃账户数，由账户到期提醒任务定期刷新
+	accountsExpiringSoon = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "auth",
+			Name:      "accounts_expiring_soon",
+			Help:      "提醒窗口内即将超过有效期的活跃账户数",
+		},
+	)
+
+	// jobRunsTotal 后台任务执行次数，按任务与结果（success/failure/skipped）区分
+	jobRunsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "job",
+			Name:      "runs_total",
+			Help:      "后台任务执行次数",
+		},
+		[]string{"job", "result"},
+	)
+
+	// jobRunDuration 后台任务执行耗时分布
+	jobRunDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "job",
+			Name:      "run_duration_seconds",
+			Help:      "后台任务执行耗时（秒）",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 15, 60, 300, 900},
+		},
+		[]string{"job"},
+	)
+
+	// jobLastSuccessTimestamp 后台任务最近一次成功完成的时间
+	jobLastSuccessTimestamp = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: "job",
+			Name:      "last_success_timestamp_seconds",
+			Help:      "后台任务最近一次成功完成的 Unix 时间戳（秒）",
+		},
+		[]string{"job"},
+	)
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpcRequestsTotal,
+		rpcRequestDuration,
+		accountLockoutsTotal,
+		authorizationDecisionsTotal,
+		accountsExpiringSoon,
+		jobRunsTotal,
+		jobRunDuration,
+		jobLastSuccessTimestamp,
+	)
+}
+
+// RecordRPC 记录一次 RPC 调用
+func RecordRPC(method string, code int32, seconds float64) {
+	rpcRequestsTotal.WithLabelValues(method, fmt.Sprintf("%d", code)).Inc()
+	rpcRequestDuration.WithLabelValues(method).Observe(seconds)
+}
+
+// RecordAccountLockout 记录一次账户锁定
+func RecordAccountLockout() {
+	accountLockoutsTotal.Inc()
+}
+
+// RecordAuthorizationDecision 记录一次接口权限判定结果
+func RecordAuthorizationDecision(allowed bool) {
+	result := "denied"
+	if allowed {
+		result = "allowed"
+	}
+
+	authorizationDecisionsTotal.WithLabelValues(result).Inc()
+}
+
+// SetAccountsExpiringSoon 更新即将超过有效期的活跃账户数
+func SetAccountsExpiringSoon(count int) {
+	accountsExpiringSoon.Set(float64(count))
+}
+
+// RecordJobRun 记录一次本实例执行的后台任务
+func RecordJobRun(job string, success bool, seconds float64) {
+	result := "failure"
+	if success {
+		result = "success"
+		jobLastSuccessTimestamp.WithLabelValues(job).SetToCurrentTime()
+	}
+
+	jobRunsTotal.WithLabelValues(job, result).Inc()
+	jobRunDuration.WithLabelValues(job).Observe(seconds)
+}
+
+// RecordJobSkipped 记录一次因其他实例正在或已经执行而跳过的后台任务
+func RecordJobSkipped(job string) {
+	jobRunsTotal.WithLabelValues(job, "skipped").Inc()
+}
+
+// RegisterDBStats 注册数据库连接池指标（打开/使用中/空闲连接数、等待次数等）
+func RegisterDBStats(db *sql.DB, dbName string) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// StartServer 在独立端口启动 Prometheus 指标服务（阻塞调用）
+func StartServer(port int, path string) error {
+	if path == "" {
+		path = "/metrics"
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(path, promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry}))
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: mux,
+	}
+
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordRPC(t *testing.T) {
+	requests := rpcRequestsTotal.WithLabelValues("TestRecordRPC", "200006")
+	before := testutil.ToFloat64(requests)
+
+	RecordRPC("TestRecordRPC", 200006, 0.02)
+	RecordRPC("TestRecordRPC", 200006, 0.03)
+	RecordRPC("TestRecordRPC", 0, 0.01)
+
+	assert.Equal(t, before+2, testutil.ToFloat64(requests))
+	assert.Equal(t, float64(1), testutil.ToFloat64(rpcRequestsTotal.WithLabelValues("TestRecordRPC", "0")))
+}
+
+func TestRecordAuthorizationDecision(t *testing.T) {
+	allowed := testutil.ToFloat64(authorizationDecisionsTotal.WithLabelValues("allowed"))
+	denied := testutil.ToFloat64(authorizationDecisionsTotal.WithLabelValues("denied"))
+
+	RecordAuthorizationDecision(true)
+	RecordAuthorizationDecision(false)
+	RecordAuthorizationDecision(false)
+
+	assert.Equal(t, allowed+1, testutil.ToFloat64(authorizationDecisionsTotal.WithLabelValues("allowed")))
+	assert.Equal(t, denied+2, testutil.ToFloat64(authorizationDecisionsTotal.WithLabelValues("denied")))
+}
+
+func TestRecordJobRun(t *testing.T) {
+	const job = "test_record_job_run"
+
+	RecordJobRun(job, false, 1)
+	assert.Zero(t, testutil.ToFloat64(jobLastSuccessTimestamp.WithLabelValues(job)))
+
+	RecordJobRun(job, true, 2)
+	RecordJobSkipped(job)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(jobRunsTotal.WithLabelValues(job, "success")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(jobRunsTotal.WithLabelValues(job, "failure")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(jobRunsTotal.WithLabelValues(job, "skipped")))
+	assert.Positive(t, testutil.ToFloat64(jobLastSuccessTimestamp.WithLabelValues(job)))
+}
+
+func TestRegistryExposition(t *testing.T) {
+	RecordAccountLockout()
+	SetAccountsExpiringSoon(3)
+	RecordRPC("TestRegistryExposition", 0, 0.01)
+
+	server := httptest.NewServer(promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	for _, name := range []string{
+		"identity_srv_rpc_requests_total",
+		"identity_srv_rpc_request_duration_seconds_bucket",
+		"identity_srv_auth_account_lockouts_total",
+		"identity_srv_auth_accounts_expiring_soon 3",
+		"go_goroutines",
+	} {
+		assert.True(t, strings.Contains(string(body), name), "缺少指标 %s", name)
+	}
+}
@@ -0,0 +1,55 @@
+package mfa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	// recoveryCodeAlphabet 恢复码字符集，去除了易混淆的 0/1/l/o
+	recoveryCodeAlphabet = "23456789abcdefghijkmnpqrstuvwxyz"
+	// recoveryCodeLength 恢复码有效字符数，展示时每 5 位以短横线分隔
+	recoveryCodeLength = 10
+)
+
+// GenerateRecoveryCodes 生成指定数量的一次性恢复码（明文）
+func GenerateRecoveryCodes(count int) ([]string, error) {
+	codes := make([]string, 0, count)
+	buf := make([]byte, recoveryCodeLength)
+
+	for range count {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("生成恢复码失败: %w", err)
+		}
+
+		var sb strings.Builder
+
+		for i, b := range buf {
+			if i > 0 && i%5 == 0 {
+				sb.WriteByte('-')
+			}
+
+			// 字符集长度为 32，取模不会引入偏差
+			sb.WriteByte(recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)])
+		}
+
+		codes = append(codes, sb.String())
+	}
+
+	return codes, nil
+}
+
+// HashRecoveryCode 计算恢复码摘要，忽略大小写与分隔符
+// 恢复码为高熵随机串，使用 SHA-256 摘要存储即可
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.TrimSpace(code))
+	normalized = strings.ReplaceAll(normalized, "-", "")
+	normalized = strings.ReplaceAll(normalized, " ", "")
+
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,64 @@
+package mfa
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RFC 6238 附录 B 的 SHA1 测试向量（取后 6 位）
+func TestGenerateCode_RFC6238Vectors(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tc := range cases {
+		code, err := GenerateCode(secret, TimeStep(time.Unix(tc.unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, tc.code, code, "unix=%d", tc.unix)
+	}
+}
+
+func TestValidateCode_Skew(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Unix(1700000000, 0)
+	previous, err := GenerateCode(secret, TimeStep(now)-1)
+	require.NoError(t, err)
+
+	step, ok := ValidateCode(secret, previous, now, 1)
+	assert.True(t, ok)
+	assert.Equal(t, TimeStep(now)-1, step)
+
+	_, ok = ValidateCode(secret, previous, now, 0)
+	assert.False(t, ok)
+
+	_, ok = ValidateCode(secret, "12345", now, 1)
+	assert.False(t, ok)
+}
+
+func TestHashRecoveryCode_Normalizes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(3)
+	require.NoError(t, err)
+	require.Len(t, codes, 3)
+
+	for _, code := range codes {
+		assert.Len(t, code, 11)
+		assert.Equal(t, HashRecoveryCode(code), HashRecoveryCode(" "+code+" "))
+	}
+
+	assert.Equal(t, HashRecoveryCode("abcde-fghij"), HashRecoveryCode("ABCDEFGHIJ"))
+}
@@ -0,0 +1,425 @@
+// Package migrate 提供基于内嵌 SQL 文件的版本化数据库迁移（PostgreSQL）
+//
+// 已执行的迁移记录在 schema_migrations 表中；执行与回滚期间持有 PostgreSQL 会话级咨询锁，
+// 多个副本同时启动或与 migrate 子命令并发执行时只有一方会真正执行迁移。
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// TableName 记录已执行迁移的表名
+	TableName = "schema_migrations"
+
+	// lockName 迁移使用的咨询锁名称，经 hashtext 转换为锁ID
+	lockName = "identity_srv:schema_migrations"
+)
+
+// ErrPendingMigrations 数据库存在未执行的迁移
+var ErrPendingMigrations = errors.New("数据库存在未执行的迁移")
+
+// fileNamePattern 迁移文件命名规则：{版本号}_{描述}.up.sql / {版本号}_{描述}.down.sql
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration 单个版本的迁移
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status 迁移执行状态
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt int64 // 执行时间（毫秒），未执行时为 0
+
+	// Unknown 数据库已执行但当前程序未包含的迁移，通常由更新版本的服务执行
+	Unknown bool
+}
+
+// Migrator 迁移执行器
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New 创建迁移执行器，迁移文件从 fsys 根目录加载
+func New(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Load 加载并校验迁移文件，按版本号升序返回
+// 每个版本必须同时提供 up 与 down 文件，版本号不可重复
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("读取迁移目录失败: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+
+		matches := fileNamePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("迁移文件名不符合规则 {版本号}_{描述}.up|down.sql: %s", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("迁移文件版本号无效: %s", entry.Name())
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("读取迁移文件 %s 失败: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = migration
+		}
+
+		if migration.Name != matches[2] {
+			return nil, fmt.Errorf("迁移版本 %d 存在多个不同名称的文件", version)
+		}
+
+		if matches[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("迁移版本 %d_%s 需要同时提供 up 与 down 文件",
+				migration.Version, migration.Name)
+		}
+
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Up 按版本顺序执行全部未执行的迁移，返回本次执行的迁移
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var executed []Migration
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range pendingMigrations(m.migrations, applied) {
+			if err := apply(ctx, conn, migration); err != nil {
+				return err
+			}
+
+			executed = append(executed, migration)
+		}
+
+		return nil
+	})
+
+	return executed, err
+}
+
+// Down 按版本倒序回滚最近执行的 steps 个迁移，返回本次回滚的迁移
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if steps <= 0 {
+		return nil, fmt.Errorf("回滚步数必须大于 0")
+	}
+
+	known := make(map[int64]Migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = migration
+	}
+
+	var reverted []Migration
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		versions := make([]int64, 0, len(applied))
+		for version := range applied {
+			versions = append(versions, version)
+		}
+
+		sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+
+		for i := 0; i < steps && i < len(versions); i++ {
+			migration, ok := known[versions[i]]
+			if !ok {
+				return fmt.Errorf("迁移版本 %d 不在当前程序中，无法回滚", versions[i])
+			}
+
+			if err := revert(ctx, conn, migration); err != nil {
+				return err
+			}
+
+			reverted = append(reverted, migration)
+		}
+
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Status 返回全部迁移的执行状态，按版本号升序
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.readApplied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations)+len(applied))
+	known := make(map[int64]struct{}, len(m.migrations))
+
+	for _, migration := range m.migrations {
+		known[migration.Version] = struct{}{}
+		status := Status{Version: migration.Version, Name: migration.Name}
+
+		if record, ok := applied[migration.Version]; ok {
+			status.Applied = true
+			status.AppliedAt = record.appliedAt
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	for version, record := range applied {
+		if _, ok := known[version]; ok {
+			continue
+		}
+
+		statuses = append(statuses, Status{
+			Version:   version,
+			Name:      record.name,
+			Applied:   true,
+			AppliedAt: record.appliedAt,
+			Unknown:   true,
+		})
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Version < statuses[j].Version
+	})
+
+	return statuses, nil
+}
+
+// Verify 校验数据库已执行全部迁移，存在未执行迁移时返回 ErrPendingMigrations
+// 数据库包含当前程序未知的更新版本迁移不视为错误，以支持滚动发布期间新旧版本并存
+func (m *Migrator) Verify(ctx context.Context) error {
+	applied, err := m.readApplied(ctx)
+	if err != nil {
+		return err
+	}
+
+	pending := pendingMigrations(m.migrations, applied)
+	if len(pending) == 0 {
+		return nil
+	}
+
+	versions := make([]int64, 0, len(pending))
+	for _, migration := range pending {
+		versions = append(versions, migration.Version)
+	}
+
+	return fmt.Errorf("%w: %v", ErrPendingMigrations, versions)
+}
+
+// withLock 在持有咨询锁的独立连接上执行 fn
+// 会话级咨询锁与连接绑定，加锁、迁移与解锁必须使用同一连接
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("获取数据库连接失败: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock(hashtext($1))", lockName); err != nil {
+		return fmt.Errorf("获取迁移锁失败: %w", err)
+	}
+
+	defer func() {
+		// 上下文取消时仍需释放锁，否则连接归还连接池后锁会一直被持有
+		_, _ = conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock(hashtext($1))", lockName)
+	}()
+
+	if err := ensureTable(ctx, conn); err != nil {
+		return err
+	}
+
+	return fn(conn)
+}
+
+// appliedRecord 已执行迁移的记录
+type appliedRecord struct {
+	name      string
+	appliedAt int64
+}
+
+// readApplied 读取已执行的迁移，迁移表不存在时视为未执行任何迁移
+func (m *Migrator) readApplied(ctx context.Context) (map[int64]appliedRecord, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取数据库连接失败: %w", err)
+	}
+	defer conn.Close()
+
+	var exists bool
+	if err := conn.QueryRowContext(ctx,
+		"SELECT to_regclass($1) IS NOT NULL", TableName).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("检查迁移表失败: %w", err)
+	}
+
+	if !exists {
+		return map[int64]appliedRecord{}, nil
+	}
+
+	return appliedVersions(ctx, conn)
+}
+
+func ensureTable(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS "`+TableName+`" (
+    "version" bigint PRIMARY KEY,
+    "name" varchar(255) NOT NULL,
+    "applied_at" bigint NOT NULL
+)`)
+	if err != nil {
+		return fmt.Errorf("创建迁移表失败: %w", err)
+	}
+
+	return nil
+}
+
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int64]appliedRecord, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT "version", "name", "applied_at" FROM "`+TableName+`"`)
+	if err != nil {
+		return nil, fmt.Errorf("查询已执行迁移失败: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int64]appliedRecord)
+
+	for rows.Next() {
+		var (
+			version int64
+			record  appliedRecord
+		)
+
+		if err := rows.Scan(&version, &record.name, &record.appliedAt); err != nil {
+			return nil, fmt.Errorf("读取已执行迁移失败: %w", err)
+		}
+
+		applied[version] = record
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("读取已执行迁移失败: %w", err)
+	}
+
+	return applied, nil
+}
+
+// pendingMigrations 返回未执行的迁移，保持版本升序
+func pendingMigrations(migrations []Migration, applied map[int64]appliedRecord) []Migration {
+	var pending []Migration
+
+	for _, migration := range migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+
+	return pending
+}
+
+// apply 在事务中执行迁移并登记版本，失败时整体回滚
+func apply(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	return inTx(ctx, conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+			return fmt.Errorf("执行迁移 %d_%s 失败: %w", migration.Version, migration.Name, err)
+		}
+
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO "`+TableName+`" ("version", "name", "applied_at") VALUES ($1, $2, $3)`,
+			migration.Version, migration.Name, time.Now().UnixMilli())
+		if err != nil {
+			return fmt.Errorf("登记迁移 %d_%s 失败: %w", migration.Version, migration.Name, err)
+		}
+
+		return nil
+	})
+}
+
+// revert 在事务中回滚迁移并删除版本登记
+func revert(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	return inTx(ctx, conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+			return fmt.Errorf("回滚迁移 %d_%s 失败: %w", migration.Version, migration.Name, err)
+		}
+
+		_, err := tx.ExecContext(ctx,
+			`DELETE FROM "`+TableName+`" WHERE "version" = $1`, migration.Version)
+		if err != nil {
+			return fmt.Errorf("删除迁移登记 %d_%s 失败: %w", migration.Version, migration.Name, err)
+		}
+
+		return nil
+	})
+}
+
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("开启迁移事务失败: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交迁移事务失败: %w", err)
+	}
+
+	return nil
+}
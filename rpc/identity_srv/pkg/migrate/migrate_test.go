@@ -0,0 +1,80 @@
+package migrate
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/migrations"
+)
+
+func TestLoad_SortsByVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0002_add_index.up.sql":   {Data: []byte("CREATE INDEX a ON t (a);")},
+		"0002_add_index.down.sql": {Data: []byte("DROP INDEX a;")},
+		"0001_init.up.sql":        {Data: []byte("CREATE TABLE t (a int);")},
+		"0001_init.down.sql":      {Data: []byte("DROP TABLE t;")},
+		"README.md":               {Data: []byte("ignored")},
+	}
+
+	loaded, err := Load(fsys)
+	require.NoError(t, err)
+	require.Len(t, loaded, 2)
+
+	assert.Equal(t, int64(1), loaded[0].Version)
+	assert.Equal(t, "init", loaded[0].Name)
+	assert.Equal(t, "DROP TABLE t;", loaded[0].Down)
+	assert.Equal(t, int64(2), loaded[1].Version)
+	assert.Equal(t, "add_index", loaded[1].Name)
+}
+
+func TestLoad_RejectsInvalidFiles(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+	}{
+		{
+			name: "缺少 down 文件",
+			fsys: fstest.MapFS{"0001_init.up.sql": {Data: []byte("SELECT 1;")}},
+		},
+		{
+			name: "文件名不符合规则",
+			fsys: fstest.MapFS{"init.up.sql": {Data: []byte("SELECT 1;")}},
+		},
+		{
+			name: "同一版本名称不一致",
+			fsys: fstest.MapFS{
+				"0001_init.up.sql":    {Data: []byte("SELECT 1;")},
+				"0001_other.down.sql": {Data: []byte("SELECT 1;")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(tt.fsys)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPendingMigrations(t *testing.T) {
+	all := []Migration{{Version: 1}, {Version: 2}, {Version: 3}}
+
+	pending := pendingMigrations(all, map[int64]appliedRecord{1: {}, 3: {}, 9: {}})
+
+	require.Len(t, pending, 1)
+	assert.Equal(t, int64(2), pending[0].Version)
+}
+
+func TestLoad_EmbeddedMigrations(t *testing.T) {
+	loaded, err := Load(migrations.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, loaded)
+
+	for i, migration := range loaded {
+		assert.Equal(t, int64(i+1), migration.Version, "迁移版本号应连续递增")
+	}
+}
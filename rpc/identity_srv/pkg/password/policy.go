@@ -0,0 +1,139 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 密码策略规则标识，随校验结果返回给调用方，便于逐条展示
+const (
+	RuleMinLength        = "min_length"
+	RuleUppercase        = "uppercase"
+	RuleLowercase        = "lowercase"
+	RuleDigit            = "digit"
+	RuleSpecial          = "special"
+	RuleBanned           = "banned"
+	RuleContainsUserInfo = "contains_user_info"
+	RuleReused           = "reused"
+)
+
+// minUserInfoLength 用户名、邮箱名等短于该长度时不做包含检查，避免误判
+const minUserInfoLength = 3
+
+// Policy 密码复杂度策略
+type Policy struct {
+	MinLength        int      `mapstructure:"min_length"`         // 最小长度（按字符计）
+	RequireUppercase bool     `mapstructure:"require_uppercase"`  // 是否要求包含大写字母
+	RequireLowercase bool     `mapstructure:"require_lowercase"`  // 是否要求包含小写字母
+	RequireDigit     bool     `mapstructure:"require_digit"`      // 是否要求包含数字
+	RequireSpecial   bool     `mapstructure:"require_special"`    // 是否要求包含特殊字符
+	BannedPasswords  []string `mapstructure:"banned_passwords"`   // 禁用密码列表（不区分大小写）
+	DisallowUserInfo bool     `mapstructure:"disallow_user_info"` // 是否禁止包含用户名或邮箱
+}
+
+// Violation 违反的密码规则
+type Violation struct {
+	Rule    string
+	Message string
+}
+
+// Validate 按策略校验密码，返回全部违反的规则；userInfo 为用户名、邮箱等不允许出现在密码中的信息
+func (p Policy) Validate(password string, userInfo ...string) []Violation {
+	var violations []Violation
+
+	if p.MinLength > 0 && utf8.RuneCountInString(password) < p.MinLength {
+		violations = append(violations, Violation{
+			Rule:    RuleMinLength,
+			Message: fmt.Sprintf("密码长度不能少于%d位", p.MinLength),
+		})
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSpecial = true
+		}
+	}
+
+	if p.RequireUppercase && !hasUpper {
+		violations = append(violations, Violation{Rule: RuleUppercase, Message: "密码必须包含大写字母"})
+	}
+
+	if p.RequireLowercase && !hasLower {
+		violations = append(violations, Violation{Rule: RuleLowercase, Message: "密码必须包含小写字母"})
+	}
+
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, Violation{Rule: RuleDigit, Message: "密码必须包含数字"})
+	}
+
+	if p.RequireSpecial && !hasSpecial {
+		violations = append(violations, Violation{Rule: RuleSpecial, Message: "密码必须包含特殊字符"})
+	}
+
+	if p.isBanned(password) {
+		violations = append(violations, Violation{Rule: RuleBanned, Message: "密码过于常见，请更换"})
+	}
+
+	if p.DisallowUserInfo && containsUserInfo(password, userInfo) {
+		violations = append(violations, Violation{
+			Rule:    RuleContainsUserInfo,
+			Message: "密码不能包含用户名或邮箱",
+		})
+	}
+
+	return violations
+}
+
+// ViolationDetails 将违反的规则转换为 规则标识 -> 说明 的明细，用于错误详情返回
+func ViolationDetails(violations []Violation) map[string]string {
+	details := make(map[string]string, len(violations))
+	for _, v := range violations {
+		details[v.Rule] = v.Message
+	}
+
+	return details
+}
+
+// isBanned 检查密码是否在禁用列表中
+func (p Policy) isBanned(password string) bool {
+	for _, banned := range p.BannedPasswords {
+		if banned != "" && strings.EqualFold(password, banned) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containsUserInfo 检查密码是否包含用户信息，邮箱仅比较 @ 之前的部分
+func containsUserInfo(password string, userInfo []string) bool {
+	lower := strings.ToLower(password)
+
+	for _, info := range userInfo {
+		info = strings.ToLower(strings.TrimSpace(info))
+		if at := strings.Index(info, "@"); at >= 0 {
+			info = info[:at]
+		}
+
+		if utf8.RuneCountInString(info) < minUserInfoLength {
+			continue
+		}
+
+		if strings.Contains(lower, info) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,59 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func rules(violations []Violation) []string {
+	result := make([]string, 0, len(violations))
+	for _, v := range violations {
+		result = append(result, v.Rule)
+	}
+
+	return result
+}
+
+func TestPolicyValidate_CharacterClasses(t *testing.T) {
+	policy := Policy{
+		MinLength:        8,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSpecial:   true,
+	}
+
+	assert.Empty(t, policy.Validate("Str0ng!Pass"))
+	assert.Equal(t,
+		[]string{RuleMinLength, RuleUppercase, RuleDigit, RuleSpecial},
+		rules(policy.Validate("abc")),
+	)
+}
+
+func TestPolicyValidate_Banned(t *testing.T) {
+	policy := Policy{BannedPasswords: []string{"Passw0rd"}}
+
+	assert.Equal(t, []string{RuleBanned}, rules(policy.Validate("passw0rd")))
+	assert.Empty(t, policy.Validate("Passw0rd!"))
+}
+
+func TestPolicyValidate_UserInfo(t *testing.T) {
+	policy := Policy{DisallowUserInfo: true}
+
+	assert.Equal(t, []string{RuleContainsUserInfo}, rules(policy.Validate("xAlice2024", "alice")))
+	assert.Equal(t, []string{RuleContainsUserInfo}, rules(policy.Validate("bob.smith!1", "", "bob.smith@example.com")))
+	assert.Empty(t, policy.Validate("example.com1", "", "bob.smith@example.com"))
+
+	// 过短的用户信息不参与检查
+	assert.Empty(t, policy.Validate("Jo123456", "jo"))
+}
+
+func TestViolationDetails(t *testing.T) {
+	details := ViolationDetails([]Violation{
+		{Rule: RuleMinLength, Message: "too short"},
+		{Rule: RuleDigit, Message: "no digit"},
+	})
+
+	assert.Equal(t, map[string]string{RuleMinLength: "too short", RuleDigit: "no digit"}, details)
+}
@@ -1,7 +1,12 @@
 package wire
 
 import (
+	"fmt"
+
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/biz/casbin"
 	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/config"
+	"github.com/masonsxu/cloudwego-scaffold/rpc/identity-srv/internal/tracing"
 	"github.com/rs/zerolog"
 	"gorm.io/gorm"
 )
@@ -13,7 +18,19 @@ import (
 // ProvideDB 提供数据库连接实例
 // Wire 依赖注入提供者，委托给 config 层处理所有初始化逻辑
 func ProvideDB(cfg *config.Config, logger *zerolog.Logger) (*gorm.DB, error) {
-	return config.InitDB(cfg, logger)
+	db, err := config.InitDB(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// 启用链路追踪时为 GORM 注册追踪插件，SQL 执行作为 RPC Span 的子 Span 上报
+	if cfg.Tracing.Enabled {
+		if err := db.Use(tracing.NewGormPlugin()); err != nil {
+			return nil, fmt.Errorf("注册GORM链路追踪插件失败: %w", err)
+		}
+	}
+
+	return db, nil
 }
 
 // ProvideLogger 提供结构化日志实例
@@ -32,6 +49,27 @@ func ProvideCasbinConfig(cfg *config.Config) *config.CasbinConfig {
 	return &cfg.Casbin
 }
 
+// ProvideCasbinWatcher 提供多实例策略同步 Watcher
+// 配置为 none 时返回 nil，Casbin 管理器将不进行多实例同步
+func ProvideCasbinWatcher(
+	cfg *config.Config,
+	db *gorm.DB,
+	logger *zerolog.Logger,
+) (persist.Watcher, error) {
+	switch cfg.Casbin.Watcher {
+	case casbin.WatcherTypeNone, "":
+		return nil, nil
+	case casbin.WatcherTypePostgres:
+		if cfg.Database.Driver != "postgres" {
+			return nil, fmt.Errorf("casbin watcher %q requires postgres driver", cfg.Casbin.Watcher)
+		}
+
+		return casbin.NewPostgresWatcher(db, cfg.Database.PostgresDSN(), cfg.Casbin.WatcherChannel, logger)
+	default:
+		return nil, fmt.Errorf("unsupported casbin watcher %q", cfg.Casbin.Watcher)
+	}
+}
+
 // =============================================================================
 // Provider Options - 高级配置选项
 // =============================================================================
@@ -67,13 +105,6 @@ func WithDBDebugMode() DBOption {
 	}
 }
 
-// WithDBMigration 执行数据库迁移
-func WithDBMigration(models ...interface{}) DBOption {
-	return func(db *gorm.DB) error {
-		return db.AutoMigrate(models...)
-	}
-}
-
 // ProvideLoggerWithOptions 提供带自定义选项的日志器
 // 注意：zerolog 使用不同的配置方式，此函数保留以保持兼容性
 // 实际配置通过 config.CreateLogger 处理
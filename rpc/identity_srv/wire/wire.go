@@ -33,6 +33,7 @@ var ConverterSet = wire.NewSet(
 // CasbinSet Casbin 权限管理 Provider 集合
 var CasbinSet = wire.NewSet(
 	ProvideCasbinConfig,
+	ProvideCasbinWatcher,
 	casbin.NewCasbinManager,
 	casbin.NewMenuPermissionLogic,
 )
@@ -88,17 +89,26 @@ func InitializeTestService() (logic.Logic, error) {
 }
 
 // ServiceWithDB 包含服务逻辑和数据库连接的包装结构
-// 用于需要同时访问服务和数据库的场景（如健康检查）
+// 用于需要同时访问服务和数据库的场景（如健康检查、启动时的策略对账）
 type ServiceWithDB struct {
 	Service logic.Logic
 	DB      *gorm.DB
+	DAL     dal.DAL
+	Casbin  *casbin.CasbinManager
 }
 
 // ProvideServiceWithDB 提供包含服务和数据库的包装结构
-func ProvideServiceWithDB(service logic.Logic, db *gorm.DB) *ServiceWithDB {
+func ProvideServiceWithDB(
+	service logic.Logic,
+	db *gorm.DB,
+	d dal.DAL,
+	casbinManager *casbin.CasbinManager,
+) *ServiceWithDB {
 	return &ServiceWithDB{
 		Service: service,
 		DB:      db,
+		DAL:     d,
+		Casbin:  casbinManager,
 	}
 }
 
@@ -35,7 +35,11 @@ func InitializeService() (logic.Logic, error) {
 	}
 	dalDAL := dal.NewDALImpl(db)
 	casbinConfig := ProvideCasbinConfig(configConfig)
-	casbinManager, err := casbin.NewCasbinManager(db, casbinConfig, logger)
+	watcher, err := ProvideCasbinWatcher(configConfig, db, logger)
+	if err != nil {
+		return nil, err
+	}
+	casbinManager, err := casbin.NewCasbinManager(db, casbinConfig, watcher, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +76,11 @@ func InitializeTestService() (logic.Logic, error) {
 	}
 	dalDAL := dal.NewDALImpl(db)
 	casbinConfig := ProvideCasbinConfig(configConfig)
-	casbinManager, err := casbin.NewCasbinManager(db, casbinConfig, logger)
+	watcher, err := ProvideCasbinWatcher(configConfig, db, logger)
+	if err != nil {
+		return nil, err
+	}
+	casbinManager, err := casbin.NewCasbinManager(db, casbinConfig, watcher, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -96,12 +104,16 @@ func InitializeServiceWithDB() (*ServiceWithDB, error) {
 	}
 	dalDAL := dal.NewDALImpl(db)
 	casbinConfig := ProvideCasbinConfig(configConfig)
-	casbinManager, err := casbin.NewCasbinManager(db, casbinConfig, logger)
+	watcher, err := ProvideCasbinWatcher(configConfig, db, logger)
+	if err != nil {
+		return nil, err
+	}
+	casbinManager, err := casbin.NewCasbinManager(db, casbinConfig, watcher, logger)
 	if err != nil {
 		return nil, err
 	}
 	logicLogic := logic.NewLogicImpl(dalDAL, configConfig, casbinManager)
-	serviceWithDB := ProvideServiceWithDB(logicLogic, db)
+	serviceWithDB := ProvideServiceWithDB(logicLogic, db, dalDAL, casbinManager)
 	return serviceWithDB, nil
 }
 
@@ -118,7 +130,8 @@ var ConverterSet = wire.NewSet(converter.NewConverter)
 
 // CasbinSet Casbin 权限管理 Provider 集合
 var CasbinSet = wire.NewSet(
-	ProvideCasbinConfig, casbin.NewCasbinManager, casbin.NewMenuPermissionLogic,
+	ProvideCasbinConfig,
+	ProvideCasbinWatcher, casbin.NewCasbinManager, casbin.NewMenuPermissionLogic,
 )
 
 // DALSet 数据访问层 Provider 集合
@@ -146,16 +159,25 @@ var TestSet = wire.NewSet(
 )
 
 // ServiceWithDB 包含服务逻辑和数据库连接的包装结构
-// 用于需要同时访问服务和数据库的场景（如健康检查）
+// 用于需要同时访问服务和数据库的场景（如健康检查、启动时的策略对账）
 type ServiceWithDB struct {
 	Service logic.Logic
 	DB      *gorm.DB
+	DAL     dal.DAL
+	Casbin  *casbin.CasbinManager
 }
 
 // ProvideServiceWithDB 提供包含服务和数据库的包装结构
-func ProvideServiceWithDB(service logic.Logic, db *gorm.DB) *ServiceWithDB {
+func ProvideServiceWithDB(
+	service logic.Logic,
+	db *gorm.DB,
+	d dal.DAL,
+	casbinManager *casbin.CasbinManager,
+) *ServiceWithDB {
 	return &ServiceWithDB{
 		Service: service,
 		DB:      db,
+		DAL:     d,
+		Casbin:  casbinManager,
 	}
 }